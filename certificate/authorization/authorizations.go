@@ -21,6 +21,7 @@ import (
 	"storj.io/common/base58"
 	"storj.io/common/identity"
 	"storj.io/common/rpc/rpcpeer"
+	"storj.io/common/storj"
 	"storj.io/storj/certificate/certificatepb"
 )
 
@@ -47,10 +48,32 @@ var (
 // and grouping.
 type Group []*Authorization
 
-// Authorization represents a single-use authorization token and its status.
+// Authorization represents an authorization token and its status.
 type Authorization struct {
 	Token Token
+	// Claim is the first claim of the token.
 	Claim *Claim
+
+	// Quota is the number of certificates which can be signed in exchange for
+	// the token, zero means that the token can be claimed once.
+	Quota int
+	// Claims are all the claims of the token, including the first one.
+	Claims []*Claim
+	// ExpiresAt is when the token can't be claimed anymore, zero means never.
+	ExpiresAt time.Time
+	// RevokedAt is when the token was revoked, zero means it's not revoked.
+	RevokedAt time.Time
+}
+
+// AuditRecord describes a certificate, which was signed in exchange for an
+// authorization token.
+type AuditRecord struct {
+	UserID     string
+	Token      string
+	NodeID     storj.NodeID
+	Difficulty uint16
+	Addr       string
+	Timestamp  time.Time
 }
 
 // Token is a userID and a random byte array, when serialized, can be used like
@@ -160,6 +183,69 @@ func (group Group) GroupByClaimed() (claimed, open Group) {
 	return claimed, open
 }
 
+// ClaimCount returns the number of certificates signed in exchange for the token.
+func (a *Authorization) ClaimCount() int {
+	if len(a.Claims) == 0 && a.Claim != nil {
+		// authorizations claimed before quotas existed only have the first claim.
+		return 1
+	}
+	return len(a.Claims)
+}
+
+// Remaining returns the number of certificates which can still be signed in
+// exchange for the token.
+func (a *Authorization) Remaining() int {
+	quota := a.Quota
+	if quota < 1 {
+		quota = 1
+	}
+	if remaining := quota - a.ClaimCount(); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// Expired returns whether the token is expired at the given time.
+func (a *Authorization) Expired(now time.Time) bool {
+	return !a.ExpiresAt.IsZero() && !now.Before(a.ExpiresAt)
+}
+
+// Revoked returns whether the token was revoked.
+func (a *Authorization) Revoked() bool {
+	return !a.RevokedAt.IsZero()
+}
+
+// Available returns whether the token can be claimed at the given time.
+func (a *Authorization) Available(now time.Time) bool {
+	return !a.Revoked() && !a.Expired(now) && a.Remaining() > 0
+}
+
+// AuditRecords returns the audit records of the certificates signed in
+// exchange for the token.
+func (a *Authorization) AuditRecords() []AuditRecord {
+	claims := a.Claims
+	if len(claims) == 0 && a.Claim != nil {
+		claims = []*Claim{a.Claim}
+	}
+
+	records := make([]AuditRecord, 0, len(claims))
+	for _, claim := range claims {
+		record := AuditRecord{
+			UserID:    a.Token.UserID,
+			Token:     a.String(),
+			Addr:      claim.Addr,
+			Timestamp: time.Unix(claim.Timestamp, 0),
+		}
+		if claim.Identity != nil {
+			record.NodeID = claim.Identity.ID
+			// NB: the difficulty can't fail for a node ID of a claimed identity.
+			record.Difficulty, _ = claim.Identity.ID.Difficulty()
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
 // String implements the stringer interface and prevents authorization data
 // from completely leaking into logs and errors.
 func (a Authorization) String() string {
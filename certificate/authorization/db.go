@@ -6,6 +6,7 @@ package authorization
 import (
 	"context"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -29,6 +30,10 @@ var (
 	ErrInvalidClaim = errs.Class("invalid authorization claim")
 	// ErrAlreadyClaimed is used when a valid claim is attempted with a token that's been used already.
 	ErrAlreadyClaimed = errs.Class("authorization already claimed")
+	// ErrExpired is used when a claim is attempted with an expired token.
+	ErrExpired = errs.Class("authorization expired")
+	// ErrRevoked is used when a claim is attempted with a revoked token.
+	ErrRevoked = errs.Class("authorization revoked")
 	// ErrNotFound is used when there is no matching authorization in the DB for a given userID and token.
 	ErrNotFound = errs.Class("authorization not found")
 	// ErrDBInternal is used when an internal error occurs involving the authorization database.
@@ -98,22 +103,44 @@ func (authDB *DB) Close() error {
 	return ErrDB.Wrap(authDB.db.Close())
 }
 
+// CreateOpts hold parameters for creating authorizations.
+type CreateOpts struct {
+	// Count is the number of authorizations to create.
+	Count int
+	// Quota is the number of certificates which can be signed in exchange for
+	// each authorization, zero means one.
+	Quota int
+	// ExpiresAt is when the authorizations expire, zero means never.
+	ExpiresAt time.Time
+}
+
 // Create creates a new authorization and adds it to the authorization database.
 func (authDB *DB) Create(ctx context.Context, userID string, count int) (_ Group, err error) {
-	defer mon.Task()(&ctx, userID, count)(&err)
+	return authDB.CreateWithOpts(ctx, userID, CreateOpts{Count: count})
+}
+
+// CreateWithOpts creates new authorizations with a quota and an expiration and
+// adds them to the authorization database.
+func (authDB *DB) CreateWithOpts(ctx context.Context, userID string, opts CreateOpts) (_ Group, err error) {
+	defer mon.Task()(&ctx, userID, opts.Count)(&err)
 	if len(userID) == 0 {
 		return nil, ErrEmptyUserID
 	}
-	if count < 1 {
+	if opts.Count < 1 {
 		return nil, ErrCount
 	}
+	if opts.Quota < 0 {
+		return nil, ErrDB.New("quota cannot be negative: %d", opts.Quota)
+	}
 
 	var newAuths Group
-	for i := 0; i < count; i++ {
+	for i := 0; i < opts.Count; i++ {
 		auth, err := NewAuthorization(userID)
 		if err != nil {
 			return nil, ErrDBInternal.Wrap(err)
 		}
+		auth.Quota = opts.Quota
+		auth.ExpiresAt = opts.ExpiresAt
 		newAuths = append(newAuths, auth)
 	}
 
@@ -215,22 +242,31 @@ func (authDB *DB) Claim(ctx context.Context, opts *ClaimOpts) (err error) {
 	}
 
 	foundMatch := false
-	for i, auth := range auths {
+	for _, auth := range auths {
 		if auth.Token.Equal(token) {
 			foundMatch = true
-			if auth.Claim != nil {
+			switch {
+			case auth.Revoked():
+				return ErrRevoked.New("%s", auth.String())
+			case auth.Expired(now):
+				return ErrExpired.New("%s", auth.String())
+			case auth.Remaining() == 0:
 				return ErrAlreadyClaimed.New("%s", auth.String())
 			}
 
-			auths[i] = &Authorization{
-				Token: auth.Token,
-				Claim: &Claim{
-					Timestamp:        now.Unix(),
-					Addr:             opts.Peer.Addr.String(),
-					Identity:         ident,
-					SignedChainBytes: opts.ChainBytes,
-				},
+			claim := &Claim{
+				Timestamp:        now.Unix(),
+				Addr:             opts.Peer.Addr.String(),
+				Identity:         ident,
+				SignedChainBytes: opts.ChainBytes,
 			}
+			if auth.Claim == nil {
+				auth.Claim = claim
+			} else if len(auth.Claims) == 0 {
+				auth.Claims = append(auth.Claims, auth.Claim)
+			}
+			auth.Claims = append(auth.Claims, claim)
+
 			if err := authDB.put(ctx, token.UserID, auths); err != nil {
 				return err
 			}
@@ -264,6 +300,7 @@ func (authDB *DB) Unclaim(ctx context.Context, authToken string) (err error) {
 	for i, auth := range auths {
 		if auth.Token.Equal(token) {
 			auths[i].Claim = nil
+			auths[i].Claims = nil
 			return authDB.put(ctx, token.UserID, auths)
 		}
 	}
@@ -271,6 +308,76 @@ func (authDB *DB) Unclaim(ctx context.Context, authToken string) (err error) {
 	return errs.New("token not found in authorizations DB")
 }
 
+// Revoke revokes an authorization, so that it can't be claimed anymore.
+// Certificates which were already signed in exchange for it aren't affected.
+func (authDB *DB) Revoke(ctx context.Context, authToken string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	token, err := ParseToken(authToken)
+	if err != nil {
+		return err
+	}
+
+	auths, err := authDB.Get(ctx, token.UserID)
+	if err != nil {
+		return err
+	}
+
+	for _, auth := range auths {
+		if auth.Token.Equal(token) {
+			if auth.Revoked() {
+				return nil
+			}
+			auth.RevokedAt = time.Now()
+			mon.Meter("authorization_revoke").Mark(1)
+			return authDB.put(ctx, token.UserID, auths)
+		}
+	}
+	tokenFmt := Authorization{
+		Token: *token,
+	}
+	return ErrNotFound.New("%s", tokenFmt.String())
+}
+
+// ListRevoked returns all revoked authorizations in the database.
+func (authDB *DB) ListRevoked(ctx context.Context) (revoked Group, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auths, err := authDB.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, auth := range auths {
+		if auth.Revoked() {
+			revoked = append(revoked, auth)
+		}
+	}
+	return revoked, nil
+}
+
+// AuditTrail returns the audit records of all certificates signed since the
+// given time, ordered by the time they were signed.
+func (authDB *DB) AuditTrail(ctx context.Context, since time.Time) (records []AuditRecord, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auths, err := authDB.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, auth := range auths {
+		for _, record := range auth.AuditRecords() {
+			if record.Timestamp.Before(since) {
+				continue
+			}
+			records = append(records, record)
+		}
+	}
+
+	sort.SliceStable(records, func(i, k int) bool {
+		return records[i].Timestamp.Before(records[k].Timestamp)
+	})
+	return records, nil
+}
+
 func (authDB *DB) add(ctx context.Context, userID string, newAuths Group) (err error) {
 	defer mon.Task()(&ctx, userID)(&err)
 
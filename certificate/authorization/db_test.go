@@ -387,6 +387,115 @@ func TestAuthorizationDB_Claim_Invalid(t *testing.T) {
 	})
 }
 
+func TestAuthorizationDB_Claim_QuotaExpirationRevocation(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	authDB := newTestAuthDB(t, ctx)
+	defer ctx.Check(authDB.Close)
+
+	userID := "user@mail.test"
+
+	ident, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	peer := &rpcpeer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("1.2.3.4"),
+			Port: 5,
+		},
+		State: tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{ident.Leaf, ident.CA},
+		},
+	}
+	difficulty, err := ident.ID.Difficulty()
+	require.NoError(t, err)
+
+	claim := func(token Token) error {
+		return authDB.Claim(ctx, &ClaimOpts{
+			Req: &certificatepb.SigningRequest{
+				AuthToken: token.String(),
+				Timestamp: time.Now().Unix(),
+			},
+			Peer:          peer,
+			ChainBytes:    [][]byte{ident.CA.Raw},
+			MinDifficulty: difficulty,
+		})
+	}
+
+	t.Run("quota", func(t *testing.T) {
+		auths, err := authDB.CreateWithOpts(ctx, userID, CreateOpts{Count: 1, Quota: 2})
+		require.NoError(t, err)
+		require.Len(t, auths, 1)
+
+		require.NoError(t, claim(auths[0].Token))
+		require.NoError(t, claim(auths[0].Token))
+
+		err = claim(auths[0].Token)
+		require.Error(t, err)
+		require.True(t, ErrAlreadyClaimed.Has(err))
+
+		updatedAuths, err := authDB.Get(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, updatedAuths, 1)
+		require.NotNil(t, updatedAuths[0].Claim)
+		require.Len(t, updatedAuths[0].Claims, 2)
+		require.Equal(t, 0, updatedAuths[0].Remaining())
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		auths, err := authDB.CreateWithOpts(ctx, userID, CreateOpts{
+			Count:     1,
+			ExpiresAt: time.Now().Add(-time.Minute),
+		})
+		require.NoError(t, err)
+
+		err = claim(auths[0].Token)
+		require.Error(t, err)
+		require.True(t, ErrExpired.Has(err))
+		require.NotContains(t, err.Error(), auths[0].Token.String())
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+		auths, err := authDB.Create(ctx, userID, 1)
+		require.NoError(t, err)
+
+		require.NoError(t, authDB.Revoke(ctx, auths[0].Token.String()))
+
+		err = claim(auths[0].Token)
+		require.Error(t, err)
+		require.True(t, ErrRevoked.Has(err))
+		require.NotContains(t, err.Error(), auths[0].Token.String())
+
+		revoked, err := authDB.ListRevoked(ctx)
+		require.NoError(t, err)
+		require.Len(t, revoked, 1)
+		require.Equal(t, auths[0].Token, revoked[0].Token)
+
+		unknown, err := NewAuthorization(userID)
+		require.NoError(t, err)
+		err = authDB.Revoke(ctx, unknown.Token.String())
+		require.Error(t, err)
+		require.True(t, ErrNotFound.Has(err))
+	})
+
+	t.Run("audit trail", func(t *testing.T) {
+		records, err := authDB.AuditTrail(ctx, time.Time{})
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		for _, record := range records {
+			require.Equal(t, userID, record.UserID)
+			require.Equal(t, ident.ID, record.NodeID)
+			require.Equal(t, difficulty, record.Difficulty)
+			require.Equal(t, peer.Addr.String(), record.Addr)
+		}
+
+		records, err = authDB.AuditTrail(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, records)
+	})
+}
+
 func TestAuthorizationDB_Emails(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -5,11 +5,15 @@ package authorization
 
 import (
 	"context"
+	"crypto/subtle"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"path"
+	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -43,6 +47,9 @@ func NewEndpoint(log *zap.Logger, service *Service, listener net.Listener) *Endp
 	}
 
 	mux.HandleFunc("/v1/authorizations/", endpoint.handleAuthorization)
+	if service.config.AuditAPIKey != "" {
+		mux.HandleFunc("/v1/audit", endpoint.handleAudit)
+	}
 
 	return endpoint
 }
@@ -115,3 +122,74 @@ func (endpoint *Endpoint) handleAuthorization(writer http.ResponseWriter, httpRe
 		return
 	}
 }
+
+// auditRecord is the JSON representation of an audit record.
+type auditRecord struct {
+	UserID     string    `json:"userId"`
+	Token      string    `json:"token"`
+	NodeID     string    `json:"nodeId"`
+	Difficulty uint16    `json:"difficulty"`
+	Addr       string    `json:"addr"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+func (endpoint *Endpoint) handleAudit(writer http.ResponseWriter, httpReq *http.Request) {
+	var err error
+	ctx := httpReq.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	if httpReq.Method != http.MethodGet {
+		msg := fmt.Sprintf("unsupported HTTP method: %s", httpReq.Method)
+		err = ErrEndpoint.New("%s", msg)
+		http.Error(writer, msg, http.StatusMethodNotAllowed)
+		return
+	}
+
+	apiKey := strings.TrimPrefix(httpReq.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(apiKey), []byte(endpoint.service.config.AuditAPIKey)) != 1 {
+		msg := "invalid audit api key"
+		err = ErrEndpoint.New("%s", msg)
+		http.Error(writer, msg, http.StatusUnauthorized)
+		return
+	}
+
+	var since time.Time
+	if sinceParam := httpReq.URL.Query().Get("since"); sinceParam != "" {
+		since, err = time.Parse(time.RFC3339, sinceParam)
+		if err != nil {
+			msg := "invalid since parameter, expected RFC3339 time"
+			err = ErrEndpoint.Wrap(err)
+			http.Error(writer, msg, http.StatusBadRequest)
+			return
+		}
+	}
+
+	records, err := endpoint.service.AuditTrail(ctx, since)
+	if err != nil {
+		msg := "error getting audit trail"
+		err = ErrEndpoint.Wrap(err)
+		endpoint.log.Error(msg, zap.Error(err))
+		http.Error(writer, msg, http.StatusInternalServerError)
+		return
+	}
+
+	response := make([]auditRecord, 0, len(records))
+	for _, record := range records {
+		response = append(response, auditRecord{
+			UserID:     record.UserID,
+			Token:      record.Token,
+			NodeID:     record.NodeID.String(),
+			Difficulty: record.Difficulty,
+			Addr:       record.Addr,
+			Timestamp:  record.Timestamp,
+		})
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(writer).Encode(response); err != nil {
+		msg := "error writing response"
+		err = ErrEndpoint.Wrap(err)
+		endpoint.log.Error(msg, zap.Error(err))
+		return
+	}
+}
@@ -4,15 +4,18 @@
 package authorization
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/errs2"
+	"storj.io/common/identity/testidentity"
 	"storj.io/common/testcontext"
 )
 
@@ -28,7 +31,7 @@ func TestEndpoint_Run_httpSuccess(t *testing.T) {
 	authDB := newTestAuthDB(t, ctx)
 	defer ctx.Check(authDB.Close)
 
-	service := NewService(log, authDB)
+	service := NewService(log, authDB, ServiceConfig{})
 	endpoint := NewEndpoint(log, service, listener)
 	require.NotNil(t, endpoint)
 
@@ -74,7 +77,7 @@ func TestEndpoint_Run_httpErrors(t *testing.T) {
 	authDB := newTestAuthDB(t, ctx)
 	defer ctx.Check(authDB.Close)
 
-	service := NewService(log, authDB)
+	service := NewService(log, authDB, ServiceConfig{})
 	endpoint := NewEndpoint(log, service, listener)
 	require.NotNil(t, endpoint)
 
@@ -137,3 +140,65 @@ func TestEndpoint_Run_httpErrors(t *testing.T) {
 		require.Equal(t, testCase.statusCode, res.StatusCode)
 	}
 }
+
+func TestEndpoint_Run_httpAudit(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NotNil(t, listener)
+
+	log := zaptest.NewLogger(t)
+	authDB := newTestAuthDB(t, ctx)
+	defer ctx.Check(authDB.Close)
+
+	ident, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	auths, err := authDB.Create(ctx, "user@mail.test", 1)
+	require.NoError(t, err)
+	auths[0].Claim = &Claim{
+		Addr:      "1.2.3.4:5",
+		Timestamp: time.Now().Unix(),
+		Identity:  ident.PeerIdentity(),
+	}
+	require.NoError(t, authDB.put(ctx, "user@mail.test", auths))
+
+	service := NewService(log, authDB, ServiceConfig{AuditAPIKey: "secret"})
+	endpoint := NewEndpoint(log, service, listener)
+	require.NotNil(t, endpoint)
+
+	ctx.Go(func() error {
+		return errs2.IgnoreCanceled(endpoint.Run(ctx))
+	})
+	defer ctx.Check(endpoint.Close)
+
+	url := "http://" + listener.Addr().String() + "/v1/audit"
+	get := func(apiKey string) *http.Response {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+apiKey)
+
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return res
+	}
+
+	res := get("wrong")
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	res = get("secret")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var records []auditRecord
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&records))
+	require.NoError(t, res.Body.Close())
+
+	require.Len(t, records, 1)
+	require.Equal(t, "user@mail.test", records[0].UserID)
+	require.Equal(t, ident.ID.String(), records[0].NodeID)
+	require.Equal(t, "1.2.3.4:5", records[0].Addr)
+	require.NotContains(t, records[0].Token, auths[0].Token.String())
+}
@@ -6,6 +6,7 @@ package authorization
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -14,17 +15,26 @@ import (
 // ErrService is the default error class for the authorization service.
 var ErrService = errs.Class("authorization service")
 
+// ServiceConfig is the authorization service config.
+type ServiceConfig struct {
+	Expiration  time.Duration `default:"0s" help:"how long authorizations created by the authorization http proxy can be claimed, zero means forever"`
+	Quota       int           `default:"1" help:"number of certificates which can be signed in exchange for an authorization created by the authorization http proxy"`
+	AuditAPIKey string        `default:"" help:"key required to read the audit trail of signed certificates from the authorization http proxy, the audit trail isn't served when empty"`
+}
+
 // Service is the authorization service.
 type Service struct {
-	log *zap.Logger
-	db  *DB
+	log    *zap.Logger
+	db     *DB
+	config ServiceConfig
 }
 
 // NewService creates a new authorization service.
-func NewService(log *zap.Logger, db *DB) *Service {
+func NewService(log *zap.Logger, db *DB, config ServiceConfig) *Service {
 	return &Service{
-		log: log,
-		db:  db,
+		log:    log,
+		db:     db,
+		config: config,
 	}
 }
 
@@ -46,13 +56,22 @@ func (service *Service) GetOrCreate(ctx context.Context, userID string) (_ *Toke
 		return nil, err
 	}
 
+	now := time.Now()
 	for _, authorization := range existingGroup {
-		if authorization.Claim == nil {
+		if authorization.Claim == nil && authorization.Available(now) {
 			return &authorization.Token, nil
 		}
 	}
 
-	createdGroup, err := service.db.Create(ctx, userID, 1)
+	opts := CreateOpts{
+		Count: 1,
+		Quota: service.config.Quota,
+	}
+	if service.config.Expiration > 0 {
+		opts.ExpiresAt = now.Add(service.config.Expiration)
+	}
+
+	createdGroup, err := service.db.CreateWithOpts(ctx, userID, opts)
 	if err != nil {
 		msg := "error creating authorization"
 		err = ErrService.Wrap(err)
@@ -72,3 +91,15 @@ func (service *Service) GetOrCreate(ctx context.Context, userID string) (_ *Toke
 	authorization := createdGroup[0]
 	return &authorization.Token, nil
 }
+
+// AuditTrail returns the audit records of all certificates signed since the
+// given time.
+func (service *Service) AuditTrail(ctx context.Context, since time.Time) (_ []AuditRecord, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	records, err := service.db.AuditTrail(ctx, since)
+	if err != nil {
+		return nil, ErrService.Wrap(err)
+	}
+	return records, nil
+}
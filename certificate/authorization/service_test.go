@@ -20,7 +20,7 @@ func TestService_GetOrCreate(t *testing.T) {
 	authorizationDB := newTestAuthDB(t, ctx)
 	defer ctx.Check(authorizationDB.Close)
 
-	service := NewService(zaptest.NewLogger(t), authorizationDB)
+	service := NewService(zaptest.NewLogger(t), authorizationDB, ServiceConfig{})
 	require.NotNil(t, service)
 
 	{ // new user, no existing authorization tokens (create)
@@ -61,7 +61,7 @@ func TestService_GetOrCreate_error(t *testing.T) {
 	authorizationDB := newTestAuthDB(t, ctx)
 	defer ctx.Check(authorizationDB.Close)
 
-	service := NewService(zaptest.NewLogger(t), authorizationDB)
+	service := NewService(zaptest.NewLogger(t), authorizationDB, ServiceConfig{})
 	require.NotNil(t, service)
 
 	{ // empty user ID
@@ -34,6 +34,8 @@ func NewEndpoint(log *zap.Logger, ca *identity.FullCertificateAuthority, authori
 		&authorization.ErrInvalidClaim:   rpcstatus.InvalidArgument,
 		&authorization.ErrInvalidToken:   rpcstatus.InvalidArgument,
 		&authorization.ErrAlreadyClaimed: rpcstatus.AlreadyExists,
+		&authorization.ErrExpired:        rpcstatus.PermissionDenied,
+		&authorization.ErrRevoked:        rpcstatus.PermissionDenied,
 	})
 
 	return &Endpoint{
@@ -0,0 +1,347 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	pgx "github.com/jackc/pgx/v4"
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/process"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+var mon = monkit.Package()
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "bucket-consistency",
+		Short: "bucket-consistency",
+	}
+
+	reportCmd = &cobra.Command{
+		Use:   "report",
+		Short: "report buckets, which differ between satellitedb and metabase",
+		RunE:  reportCommand,
+	}
+
+	fixCmd = &cobra.Command{
+		Use:   "fix",
+		Short: "recreate the missing bucket rows of the buckets with objects",
+		RunE:  fixCommand,
+	}
+
+	config Config
+)
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+	rootCmd.AddCommand(fixCmd)
+
+	config.BindFlags(reportCmd.Flags())
+	config.BindFlags(fixCmd.Flags())
+}
+
+// Config defines configuration for the consistency check.
+type Config struct {
+	SatelliteDB        string
+	MetabaseDB         string
+	BatchSize          int
+	ReportEmptyBuckets bool
+}
+
+// BindFlags adds the flags to the flagset.
+func (config *Config) BindFlags(flag *flag.FlagSet) {
+	flag.StringVar(&config.SatelliteDB, "satellitedb", "", "connection URL for satelliteDB")
+	flag.StringVar(&config.MetabaseDB, "metabasedb", "", "connection URL for MetabaseDB")
+	flag.IntVar(&config.BatchSize, "batch-size", 1000, "number of bucket rows to read at once")
+	flag.BoolVar(&config.ReportEmptyBuckets, "report-empty-buckets", false, "report bucket rows without any object in metabase")
+}
+
+// VerifyFlags verifies whether the values provided are valid.
+func (config *Config) VerifyFlags() error {
+	var errlist errs.Group
+	if config.SatelliteDB == "" {
+		errlist.Add(errors.New("flag '--satellitedb' is not set"))
+	}
+	if config.MetabaseDB == "" {
+		errlist.Add(errors.New("flag '--metabasedb' is not set"))
+	}
+	if config.BatchSize <= 0 {
+		errlist.Add(errors.New("flag '--batch-size' must be positive"))
+	}
+	return errlist.Err()
+}
+
+func reportCommand(cmd *cobra.Command, args []string) error {
+	if err := config.VerifyFlags(); err != nil {
+		return err
+	}
+
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	_, err := Report(ctx, log, config)
+	return err
+}
+
+func fixCommand(cmd *cobra.Command, args []string) error {
+	if err := config.VerifyFlags(); err != nil {
+		return err
+	}
+
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	return Fix(ctx, log, config)
+}
+
+func main() {
+	process.Exec(rootCmd)
+}
+
+// Discrepancies are the buckets, which differ between satellitedb and
+// metabase.
+type Discrepancies struct {
+	// MissingBuckets are the buckets with objects in metabase, which don't
+	// have a bucket row in satellitedb.
+	MissingBuckets []metabase.BucketLocation
+	// EmptyBuckets are the bucket rows in satellitedb without any object in
+	// metabase. They are only collected with Config.ReportEmptyBuckets,
+	// because a bucket without objects is valid.
+	EmptyBuckets []metabase.BucketLocation
+}
+
+// Report finds and reports the discrepancies, nothing is changed.
+func Report(ctx context.Context, log *zap.Logger, config Config) (_ Discrepancies, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	discrepancies, err := findDiscrepancies(ctx, log, config)
+	if err != nil {
+		return Discrepancies{}, err
+	}
+
+	log.Info("buckets with objects, but without a bucket row", zap.Int("count", len(discrepancies.MissingBuckets)))
+	for _, bucket := range discrepancies.MissingBuckets {
+		log.Info("missing bucket",
+			zap.Stringer("project", bucket.ProjectID),
+			zap.String("bucket", bucket.BucketName))
+	}
+
+	if config.ReportEmptyBuckets {
+		log.Info("bucket rows without objects", zap.Int("count", len(discrepancies.EmptyBuckets)))
+		for _, bucket := range discrepancies.EmptyBuckets {
+			log.Info("empty bucket",
+				zap.Stringer("project", bucket.ProjectID),
+				zap.String("bucket", bucket.BucketName))
+		}
+	}
+
+	return discrepancies, nil
+}
+
+// Fix finds the buckets with objects, but without a bucket row, and recreates
+// their bucket rows. The buckets of the projects, which don't exist anymore,
+// are only reported.
+func Fix(ctx context.Context, log *zap.Logger, config Config) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	discrepancies, err := Report(ctx, log, config)
+	if err != nil {
+		return err
+	}
+	if len(discrepancies.MissingBuckets) == 0 {
+		return nil
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), config.SatelliteDB, satellitedb.Options{ApplicationName: "bucket-consistency"})
+	if err != nil {
+		return errs.New("unable to connect %q: %w", config.SatelliteDB, err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	var created int
+	for _, bucket := range discrepancies.MissingBuckets {
+		_, err := db.Console().Projects().Get(ctx, bucket.ProjectID)
+		if err != nil {
+			log.Warn("unable to recreate bucket row, project not found",
+				zap.Stringer("project", bucket.ProjectID),
+				zap.String("bucket", bucket.BucketName),
+				zap.Error(err))
+			continue
+		}
+
+		bucketID, err := uuid.New()
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		_, err = db.Buckets().CreateBucket(ctx, storj.Bucket{
+			ID:        bucketID,
+			Name:      bucket.BucketName,
+			ProjectID: bucket.ProjectID,
+		})
+		if err != nil {
+			return errs.New("unable to recreate bucket row %q/%q: %w", bucket.ProjectID, bucket.BucketName, err)
+		}
+		created++
+
+		log.Info("recreated bucket row",
+			zap.Stringer("project", bucket.ProjectID),
+			zap.String("bucket", bucket.BucketName))
+	}
+
+	log.Info("recreated bucket rows", zap.Int("count", created))
+	return nil
+}
+
+func findDiscrepancies(ctx context.Context, log *zap.Logger, config Config) (_ Discrepancies, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketRows, err := listBucketRows(ctx, config)
+	if err != nil {
+		return Discrepancies{}, err
+	}
+	log.Info("bucket rows listed", zap.Int("count", len(bucketRows)))
+
+	bucketsWithObjects, err := listBucketsWithObjects(ctx, config)
+	if err != nil {
+		return Discrepancies{}, err
+	}
+	log.Info("buckets with objects listed", zap.Int("count", len(bucketsWithObjects)))
+
+	var discrepancies Discrepancies
+	for bucket := range bucketsWithObjects {
+		if _, ok := bucketRows[bucket]; !ok {
+			discrepancies.MissingBuckets = append(discrepancies.MissingBuckets, bucket)
+		}
+	}
+	if config.ReportEmptyBuckets {
+		for bucket := range bucketRows {
+			if _, ok := bucketsWithObjects[bucket]; !ok {
+				discrepancies.EmptyBuckets = append(discrepancies.EmptyBuckets, bucket)
+			}
+		}
+	}
+
+	sortBuckets(discrepancies.MissingBuckets)
+	sortBuckets(discrepancies.EmptyBuckets)
+	return discrepancies, nil
+}
+
+// listBucketRows lists the buckets of satellitedb.
+func listBucketRows(ctx context.Context, config Config) (_ map[metabase.BucketLocation]struct{}, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := pgx.Connect(ctx, pgxConnStr(config.SatelliteDB))
+	if err != nil {
+		return nil, errs.New("unable to connect %q: %w", config.SatelliteDB, err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close(ctx)) }()
+
+	buckets := map[metabase.BucketLocation]struct{}{}
+
+	var last metabase.BucketLocation
+	for {
+		rows, err := conn.Query(ctx, `
+			SELECT project_id, name
+			FROM bucket_metainfos
+			WHERE (project_id, name) > ($1, $2)
+			ORDER BY project_id ASC, name ASC
+			LIMIT $3
+		`, last.ProjectID, []byte(last.BucketName), config.BatchSize)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+
+		var count int
+		for rows.Next() {
+			var name []byte
+			if err := rows.Scan(&last.ProjectID, &name); err != nil {
+				rows.Close()
+				return nil, errs.Wrap(err)
+			}
+			last.BucketName = string(name)
+			buckets[last] = struct{}{}
+			count++
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, errs.Wrap(err)
+		}
+
+		if count < config.BatchSize {
+			return buckets, nil
+		}
+	}
+}
+
+// listBucketsWithObjects lists the buckets, which have objects in metabase.
+//
+// The objects are sorted by the bucket, so every query skips to the next
+// bucket without reading its objects.
+func listBucketsWithObjects(ctx context.Context, config Config) (_ map[metabase.BucketLocation]struct{}, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := pgx.Connect(ctx, pgxConnStr(config.MetabaseDB))
+	if err != nil {
+		return nil, errs.New("unable to connect %q: %w", config.MetabaseDB, err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close(ctx)) }()
+
+	buckets := map[metabase.BucketLocation]struct{}{}
+
+	var last metabase.BucketLocation
+	for {
+		var next metabase.BucketLocation
+		var name []byte
+		err := conn.QueryRow(ctx, `
+			SELECT project_id, bucket_name
+			FROM objects
+			WHERE (project_id, bucket_name) > ($1, $2)
+			ORDER BY project_id ASC, bucket_name ASC
+			LIMIT 1
+		`, last.ProjectID, []byte(last.BucketName)).Scan(&next.ProjectID, &name)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return buckets, nil
+		}
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		next.BucketName = string(name)
+
+		buckets[next] = struct{}{}
+		last = next
+	}
+}
+
+// pgxConnStr returns the connection string understood by pgx, which doesn't
+// know the cockroach scheme.
+func pgxConnStr(connStr string) string {
+	if strings.HasPrefix(connStr, "cockroach://") {
+		return "postgres://" + strings.TrimPrefix(connStr, "cockroach://")
+	}
+	return connStr
+}
+
+func sortBuckets(buckets []metabase.BucketLocation) {
+	sort.Slice(buckets, func(i, k int) bool {
+		if c := bytes.Compare(buckets[i].ProjectID[:], buckets[k].ProjectID[:]); c != 0 {
+			return c < 0
+		}
+		return buckets[i].BucketName < buckets[k].BucketName
+	})
+}
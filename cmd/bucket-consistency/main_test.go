@@ -0,0 +1,108 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/private/dbutil/tempdb"
+	cmd "storj.io/storj/cmd/bucket-consistency"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestBucketConsistency(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+
+	for _, satelliteDB := range satellitedbtest.Databases() {
+		satelliteDB := satelliteDB
+		t.Run(satelliteDB.Name, func(t *testing.T) {
+			if satelliteDB.MasterDB.URL == "" || satelliteDB.MetabaseDB.URL == "" {
+				t.Skipf("Database %s connection string not provided. %s", satelliteDB.Name, satelliteDB.MasterDB.Message)
+			}
+
+			schemaSuffix := satellitedbtest.SchemaSuffix()
+
+			masterTempDB, err := tempdb.OpenUnique(ctx, satelliteDB.MasterDB.URL, satellitedbtest.SchemaName(t.Name(), "category", 0, schemaSuffix))
+			require.NoError(t, err)
+
+			db, err := satellitedbtest.CreateMasterDBOnTopOf(ctx, log, masterTempDB)
+			require.NoError(t, err)
+			defer ctx.Check(db.Close)
+
+			require.NoError(t, db.TestingMigrateToLatest(ctx))
+
+			metabaseTempDB, err := tempdb.OpenUnique(ctx, satelliteDB.MetabaseDB.URL, satellitedbtest.SchemaName(t.Name(), "category", 1, schemaSuffix))
+			require.NoError(t, err)
+
+			metabaseDB, err := satellitedbtest.CreateMetabaseDBOnTopOf(ctx, log, metabaseTempDB, metabase.Config{
+				ApplicationName:  "satellite-test",
+				MinPartSize:      5 * memory.MiB,
+				MaxNumberOfParts: 10000,
+			})
+			require.NoError(t, err)
+			defer ctx.Check(metabaseDB.Close)
+
+			require.NoError(t, metabaseDB.TestMigrateToLatest(ctx))
+
+			project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "test"})
+			require.NoError(t, err)
+
+			// bucket with a bucket row and objects.
+			consistent := metabasetest.RandObjectStream()
+			consistent.ProjectID = project.ID
+			_, err = db.Buckets().CreateBucket(ctx, storj.Bucket{ID: testrand.UUID(), Name: consistent.BucketName, ProjectID: project.ID})
+			require.NoError(t, err)
+			metabasetest.CreateObject(ctx, t, metabaseDB, consistent, 1)
+
+			// bucket row without objects.
+			empty := metabase.BucketLocation{ProjectID: project.ID, BucketName: "empty"}
+			_, err = db.Buckets().CreateBucket(ctx, storj.Bucket{ID: testrand.UUID(), Name: empty.BucketName, ProjectID: project.ID})
+			require.NoError(t, err)
+
+			// objects without a bucket row.
+			missing := metabasetest.RandObjectStream()
+			missing.ProjectID = project.ID
+			metabasetest.CreateObject(ctx, t, metabaseDB, missing, 1)
+
+			// objects without a bucket row and a project.
+			orphaned := metabasetest.RandObjectStream()
+			metabasetest.CreateObject(ctx, t, metabaseDB, orphaned, 1)
+
+			config := cmd.Config{
+				SatelliteDB:        masterTempDB.ConnStr,
+				MetabaseDB:         metabaseTempDB.ConnStr,
+				BatchSize:          1,
+				ReportEmptyBuckets: true,
+			}
+
+			discrepancies, err := cmd.Report(ctx, log, config)
+			require.NoError(t, err)
+			require.ElementsMatch(t, []metabase.BucketLocation{missing.Location().Bucket(), orphaned.Location().Bucket()}, discrepancies.MissingBuckets)
+			require.Equal(t, []metabase.BucketLocation{empty}, discrepancies.EmptyBuckets)
+
+			require.NoError(t, cmd.Fix(ctx, log, config))
+
+			exists, err := db.Buckets().HasBucket(ctx, []byte(missing.BucketName), project.ID)
+			require.NoError(t, err)
+			require.True(t, exists)
+
+			discrepancies, err = cmd.Report(ctx, log, config)
+			require.NoError(t, err)
+			require.Equal(t, []metabase.BucketLocation{orphaned.Location().Bucket()}, discrepancies.MissingBuckets)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
@@ -46,6 +47,19 @@ var (
 		Short: "Export authorization(s) from CSR authorization DB to a CSV file (or stdout)",
 		RunE:  cmdExportAuth,
 	}
+
+	authRevokeCmd = &cobra.Command{
+		Use:   "revoke <auth_token> [<auth_token>, ...]",
+		Short: "Revoke authorization(s), so that they can't be claimed anymore",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  cmdRevokeAuth,
+	}
+
+	authRevokedCmd = &cobra.Command{
+		Use:   "revoked",
+		Short: "List revoked authorizations",
+		RunE:  cmdRevokedAuth,
+	}
 )
 
 func parseEmailsList(fileName, delimiter string) (emails []string, err error) {
@@ -88,9 +102,17 @@ func cmdCreateAuth(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	opts := authorization.CreateOpts{
+		Count: count,
+		Quota: authCfg.Quota,
+	}
+	if authCfg.ExpiresIn > 0 {
+		opts.ExpiresAt = time.Now().Add(authCfg.ExpiresIn)
+	}
+
 	var incErrs errs.Group
 	for _, email := range emails {
-		if _, err := authDB.Create(ctx, email, count); err != nil {
+		if _, err := authDB.CreateWithOpts(ctx, email, opts); err != nil {
 			incErrs.Add(err)
 		}
 	}
@@ -263,10 +285,68 @@ func writeAuthExport(ctx context.Context, authDB *authorization.DB, email string
 		if auth.Claim != nil {
 			isClaimed = "true"
 		}
+		isRevoked := "false"
+		if auth.Revoked() {
+			isRevoked = "true"
+		}
+		expiresAt := ""
+		if !auth.ExpiresAt.IsZero() {
+			expiresAt = auth.ExpiresAt.Format(time.RFC3339)
+		}
 
-		if err := w.Write([]string{email, auth.Token.String(), isClaimed}); err != nil {
+		if err := w.Write([]string{email, auth.Token.String(), isClaimed, isRevoked, expiresAt}); err != nil {
 			authErrs.Add(err)
 		}
 	}
 	return authErrs.Err()
 }
+
+func cmdRevokeAuth(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	authDB, err := authorization.OpenDBFromCfg(ctx, authCfg.Config.AuthorizationDB)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errs.Combine(err, authDB.Close())
+	}()
+
+	var revokeErrs errs.Group
+	for _, token := range args {
+		if err := authDB.Revoke(ctx, token); err != nil {
+			revokeErrs.Add(err)
+		}
+	}
+	return revokeErrs.Err()
+}
+
+func cmdRevokedAuth(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	authDB, err := authorization.OpenDBFromCfg(ctx, authCfg.Config.AuthorizationDB)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errs.Combine(err, authDB.Close())
+	}()
+
+	revoked, err := authDB.ListRevoked(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	if _, err := fmt.Fprintln(w, "Email\tToken\tRevoked at\t"); err != nil {
+		return err
+	}
+	for _, auth := range revoked {
+		token := auth.String()
+		if authCfg.ShowTokens {
+			token = auth.Token.String()
+		}
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t\n", auth.Token.UserID, token, auth.RevokedAt.Format(time.RFC3339)); err != nil {
+			return err
+		}
+	}
+	return errs.Wrap(w.Flush())
+}
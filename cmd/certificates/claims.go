@@ -33,6 +33,12 @@ var (
 		Args:  cobra.ExactArgs(1),
 		RunE:  cmdDeleteClaim,
 	}
+
+	claimsAuditCmd = &cobra.Command{
+		Use:   "audit",
+		Short: "Export the audit trail of signed certificates as JSON",
+		RunE:  cmdAuditClaims,
+	}
 )
 
 func cmdExportClaims(cmd *cobra.Command, args []string) (err error) {
@@ -90,6 +96,67 @@ func cmdDeleteClaim(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+func cmdAuditClaims(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	authDB, err := authorization.OpenDBFromCfg(ctx, claimsAuditCfg.Config.AuthorizationDB)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errs.Combine(err, authDB.Close())
+	}()
+
+	var since time.Time
+	if claimsAuditCfg.Since != "" {
+		since, err = time.Parse(time.RFC3339, claimsAuditCfg.Since)
+		if err != nil {
+			return errs.New("since couldn't be parsed: %s", claimsAuditCfg.Since)
+		}
+	}
+
+	records, err := authDB.AuditTrail(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	var toPrint []*printableAuditRecord
+	for _, record := range records {
+		if record.Difficulty < uint16(claimsAuditCfg.MinDifficulty) {
+			continue
+		}
+		toPrint = append(toPrint, &printableAuditRecord{
+			UserID:     record.UserID,
+			Token:      record.Token,
+			NodeID:     record.NodeID.String(),
+			Difficulty: record.Difficulty,
+			Addr:       record.Addr,
+			Time:       record.Timestamp.String(),
+		})
+	}
+
+	if len(toPrint) == 0 {
+		fmt.Printf("no signed certificates in database: %s\n", claimsAuditCfg.Config.AuthorizationDB.URL)
+		return nil
+	}
+
+	jsonBytes, err := json.MarshalIndent(toPrint, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(jsonBytes))
+	return nil
+}
+
+type printableAuditRecord struct {
+	UserID     string
+	Token      string
+	NodeID     string
+	Difficulty uint16
+	Addr       string
+	Time       string
+}
+
 type printableAuth struct {
 	UserID string
 	Token  string
@@ -4,6 +4,8 @@
 package main
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -37,11 +39,13 @@ var (
 	}
 
 	authCfg struct {
-		All        bool   `help:"print the all authorizations for auth info/export subcommands" default:"false"`
-		Out        string `help:"output file path for auth export subcommand; if \"-\", will use STDOUT" default:"-"`
-		ShowTokens bool   `help:"if true, token strings will be printed for auth info command" default:"false"`
-		EmailsPath string `help:"optional path to a list of emails, delimited by <delimiter>, for batch processing"`
-		Delimiter  string `help:"delimiter to split emails loaded from <emails-path> on (e.g. comma, new-line)" default:"\n"`
+		All        bool          `help:"print the all authorizations for auth info/export subcommands" default:"false"`
+		Out        string        `help:"output file path for auth export subcommand; if \"-\", will use STDOUT" default:"-"`
+		ShowTokens bool          `help:"if true, token strings will be printed for auth info command" default:"false"`
+		EmailsPath string        `help:"optional path to a list of emails, delimited by <delimiter>, for batch processing"`
+		Delimiter  string        `help:"delimiter to split emails loaded from <emails-path> on (e.g. comma, new-line)" default:"\n"`
+		Quota      int           `help:"number of certificates which can be signed in exchange for each authorization created by the auth create subcommand" default:"1"`
+		ExpiresIn  time.Duration `help:"how long authorizations created by the auth create subcommand can be claimed, zero means forever" default:"0s"`
 
 		certificate.Config
 	}
@@ -51,6 +55,11 @@ var (
 		certificate.Config
 	}
 
+	claimsAuditCfg struct {
+		Since string `help:"only export certificates signed since this RFC3339 time" default:""`
+		certificate.Config
+	}
+
 	claimsDeleteCfg certificate.Config
 
 	confDir     string
@@ -105,19 +114,25 @@ func main() {
 	rootCmd.AddCommand(claimsCmd)
 	claimsCmd.AddCommand(claimsExportCmd)
 	claimsCmd.AddCommand(claimDeleteCmd)
+	claimsCmd.AddCommand(claimsAuditCmd)
 	authCmd.AddCommand(authCreateCmd)
 	authCmd.AddCommand(authInfoCmd)
 	authCmd.AddCommand(authExportCmd)
+	authCmd.AddCommand(authRevokeCmd)
+	authCmd.AddCommand(authRevokedCmd)
 
 	process.Bind(authCreateCmd, &authCfg, defaults, cfgstruct.ConfDir(confDir))
 	process.Bind(authInfoCmd, &authCfg, defaults, cfgstruct.ConfDir(confDir))
 	process.Bind(authExportCmd, &authCfg, defaults, cfgstruct.ConfDir(confDir))
+	process.Bind(authRevokeCmd, &authCfg, defaults, cfgstruct.ConfDir(confDir))
+	process.Bind(authRevokedCmd, &authCfg, defaults, cfgstruct.ConfDir(confDir))
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(signCmd, &signCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(verifyCmd, &verifyCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(claimsExportCmd, &claimsExportCfg, defaults, cfgstruct.ConfDir(confDir))
 	process.Bind(claimDeleteCmd, &claimsDeleteCfg, defaults, cfgstruct.ConfDir(confDir))
+	process.Bind(claimsAuditCmd, &claimsAuditCfg, defaults, cfgstruct.ConfDir(confDir))
 
 	process.Exec(rootCmd)
 }
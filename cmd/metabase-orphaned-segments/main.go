@@ -6,6 +6,7 @@ package main
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"github.com/jackc/pgx/v4"
 	"github.com/spacemonkeygo/monkit/v3"
@@ -55,6 +56,7 @@ func init() {
 type Config struct {
 	MetabaseDB      string
 	LoopBatchSize   int
+	LoopParallelism int
 	DeleteBatchSize int
 	Cockroach       bool
 }
@@ -63,6 +65,7 @@ type Config struct {
 func (config *Config) BindFlags(flag *flag.FlagSet) {
 	flag.StringVar(&config.MetabaseDB, "metabasedb", "", "connection URL for MetabaseDB")
 	flag.IntVar(&config.LoopBatchSize, "loop-batch-size", 10000, "number of objects to process at once")
+	flag.IntVar(&config.LoopParallelism, "loop-parallelism", 1, "number of stream id ranges to iterate segments concurrently")
 	flag.IntVar(&config.DeleteBatchSize, "delete-batch-size", 100, "number of entries to delete with single query")
 	flag.BoolVar(&config.Cockroach, "cockroach", true, "metabase is on CRDB")
 }
@@ -166,12 +169,19 @@ func findOrphanedSegments(ctx context.Context, log *zap.Logger, config Config) (
 		return nil, err
 	}
 
+	var mu sync.Mutex
 	streamIDs := make(map[uuid.UUID]struct{})
-	numberOfElements := 0
-	err = metabaseDB.IterateLoopSegments(ctx, metabase.IterateLoopSegments{
+	err = metabaseDB.IterateLoopSegmentsParallel(ctx, metabase.IterateLoopSegmentsParallel{
+		Parallelism:    config.LoopParallelism,
 		BatchSize:      config.LoopBatchSize,
 		AsOfSystemTime: startingTime,
-	}, func(ctx context.Context, it metabase.LoopSegmentsIterator) error {
+		Progress: func(progress metabase.LoopRangeProgress) {
+			log.Info("segments iterated",
+				zap.Int("range", progress.Range),
+				zap.Int64("segments", progress.Segments),
+				zap.Bool("done", progress.Done))
+		},
+	}, func(ctx context.Context, rangeIndex int, it metabase.LoopSegmentsIterator) error {
 		var entry metabase.LoopSegmentEntry
 		for it.Next(ctx, &entry) {
 			// avoid segments created after starting processing
@@ -179,13 +189,9 @@ func findOrphanedSegments(ctx context.Context, log *zap.Logger, config Config) (
 				continue
 			}
 
+			mu.Lock()
 			streamIDs[entry.StreamID] = struct{}{}
-
-			if numberOfElements%100000 == 0 {
-				log.Info("segments iterated", zap.Int("segments", numberOfElements))
-			}
-
-			numberOfElements++
+			mu.Unlock()
 		}
 		return nil
 	})
@@ -193,7 +199,7 @@ func findOrphanedSegments(ctx context.Context, log *zap.Logger, config Config) (
 		return nil, err
 	}
 
-	numberOfElements = 0
+	numberOfElements := 0
 	err = metabaseDB.IterateLoopObjects(ctx, metabase.IterateLoopObjects{
 		BatchSize:      config.LoopBatchSize,
 		AsOfSystemTime: startingTime,
@@ -4,6 +4,8 @@
 package main
 
 import (
+	"encoding/json"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -27,6 +29,7 @@ func main() {
 	IncludeProfiling(root)
 
 	root.AddCommand(VerifyCommand(log))
+	root.AddCommand(ConsistencyCommand(log))
 
 	process.Exec(root)
 }
@@ -52,6 +55,7 @@ func VerifyCommand(log *zap.Logger) *cobra.Command {
 	flag.DurationVar(&verifyConfig.Loop.CoalesceDuration, "loop.coalesce-duration", 5*time.Second, "how long to wait for new observers before starting iteration")
 	flag.Float64Var(&verifyConfig.Loop.RateLimit, "loop.rate-limit", 0, "rate limit (default is 0 which is unlimited segments per second)")
 	flag.IntVar(&verifyConfig.Loop.ListLimit, "loop.list-limit", 2500, "how many items to query in a batch")
+	flag.DurationVar(&verifyConfig.Loop.AsOfSystemInterval, "loop.as-of-system-interval", -5*time.Minute, "as of system interval, ignored on Postgres")
 
 	flag.Int64Var(&verifyConfig.ProgressPrintFrequency, "progress-frequency", 1000000, "how often should we print progress (every object)")
 
@@ -82,3 +86,74 @@ func VerifyCommand(log *zap.Logger) *cobra.Command {
 
 	return cmd
 }
+
+// ConsistencyCommand creates command for cross-checking the metabase invariants.
+func ConsistencyCommand(log *zap.Logger) *cobra.Command {
+	var metabaseDB string
+	var ignoreVersionMismatch bool
+	var output string
+	var config verify.ConsistencyConfig
+
+	cmd := &cobra.Command{
+		Use:   "consistency",
+		Short: "verify metabase invariants and output a JSON report",
+		Long: "Verifies that committed objects have as many segments as their segment count, " +
+			"that their total sizes are the sums of the segment sizes, that the plain offsets of their segments are contiguous, " +
+			"that every segment belongs to an object " +
+			"and that the segment pieces reference existing node aliases.",
+	}
+
+	flag := cmd.Flags()
+
+	flag.StringVar(&metabaseDB, "metabasedb", "", "connection URL for MetabaseDB")
+	_ = cmd.MarkFlagRequired("metabasedb")
+
+	flag.BoolVar(&ignoreVersionMismatch, "ignore-version-mismatch", false, "ignore version mismatch")
+	flag.StringVar(&output, "output", "", "file to write the JSON report to (default is stdout)")
+
+	flag.IntVar(&config.Ranges, "ranges", 256, "number of id ranges the tables are split into")
+	flag.IntVar(&config.Parallelism, "parallelism", 8, "number of ranges verified in parallel")
+	flag.IntVar(&config.BatchSize, "batch-size", 2500, "how many objects to query in a batch")
+	flag.IntVar(&config.MaxIssues, "max-issues", 1000, "maximum number of issues listed in the report, violations are counted regardless")
+	flag.Float64Var(&config.SampleRate, "sample-rate", 1, "fraction of the objects to verify, sampled by stream id")
+	flag.DurationVar(&config.AsOfSystemInterval, "as-of-system-interval", -5*time.Minute, "how far in the past the verified snapshot is taken, ignored on Postgres")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) (err error) {
+		ctx, cancel := process.Ctx(cmd)
+		defer cancel()
+
+		mdb, err := metabase.Open(ctx, log.Named("mdb"), metabaseDB, metabase.Config{ApplicationName: "metabase-verify"})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		defer func() { _ = mdb.Close() }()
+
+		versionErr := mdb.CheckVersion(ctx)
+		if versionErr != nil {
+			log.Error("versions skewed", zap.Error(versionErr))
+			if !ignoreVersionMismatch {
+				return Error.Wrap(versionErr)
+			}
+		}
+
+		report, err := verify.NewConsistency(log.Named("consistency"), mdb, config).Run(ctx)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		out := os.Stdout
+		if output != "" {
+			out, err = os.Create(output)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			defer func() { err = errs.Combine(err, Error.Wrap(out.Close())) }()
+		}
+
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return Error.Wrap(encoder.Encode(report))
+	}
+
+	return cmd
+}
@@ -0,0 +1,565 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package verify
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Check is the name of a metabase invariant.
+type Check string
+
+const (
+	// CheckSegmentCount verifies that committed objects have as many segments
+	// as their segment_count.
+	CheckSegmentCount = Check("segment_count")
+	// CheckTotalPlainSize verifies that the total_plain_size of committed
+	// objects is the sum of the plain sizes of their segments.
+	CheckTotalPlainSize = Check("total_plain_size")
+	// CheckTotalEncryptedSize verifies that the total_encrypted_size of
+	// committed objects is the sum of the encrypted sizes of their segments.
+	CheckTotalEncryptedSize = Check("total_encrypted_size")
+	// CheckPlainOffset verifies that the plain offsets of the segments of
+	// committed objects are contiguous.
+	CheckPlainOffset = Check("plain_offset")
+	// CheckOrphanSegments verifies that every segment belongs to an object.
+	CheckOrphanSegments = Check("orphan_segments")
+	// CheckNodeAlias verifies that the pieces of the segments reference
+	// existing node aliases.
+	CheckNodeAlias = Check("node_alias")
+)
+
+// ConsistencyConfig contains configuration for the consistency verification.
+type ConsistencyConfig struct {
+	Ranges      int
+	Parallelism int
+	BatchSize   int
+	MaxIssues   int
+
+	// SampleRate is the fraction of the objects, which are verified. The
+	// objects are sampled by their stream id, so the segments of the skipped
+	// objects aren't reported as orphaned. Zero verifies all the objects.
+	SampleRate float64
+
+	// AsOfSystemInterval moves the verified snapshot of the tables into the
+	// past, so that the reads don't contend with the writes on CockroachDB.
+	// It's ignored on Postgres.
+	AsOfSystemInterval time.Duration
+}
+
+// ConsistencyReport is the machine-readable result of the consistency
+// verification.
+type ConsistencyReport struct {
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	// SnapshotAt is the time of the verified snapshot. The objects and the
+	// segments created afterwards aren't verified.
+	SnapshotAt time.Time `json:"snapshotAt"`
+
+	SampleRate float64 `json:"sampleRate"`
+	Objects    int64   `json:"objects"`
+	Segments   int64   `json:"segments"`
+
+	Violations      map[Check]int64 `json:"violations"`
+	Issues          []Issue         `json:"issues"`
+	IssuesTruncated bool            `json:"issuesTruncated"`
+}
+
+// Issue is a single violation of an invariant.
+type Issue struct {
+	Check      Check      `json:"check"`
+	StreamID   uuid.UUID  `json:"streamId"`
+	ProjectID  *uuid.UUID `json:"projectId,omitempty"`
+	BucketName string     `json:"bucketName,omitempty"`
+	Expected   *int64     `json:"expected,omitempty"`
+	Actual     *int64     `json:"actual,omitempty"`
+	Detail     string     `json:"detail,omitempty"`
+}
+
+// Consistency cross-checks the objects and segments tables.
+//
+// The verification runs in two phases, both split in ranges which are
+// processed in parallel:
+//
+//   - the objects are iterated by ranges of project ids and compared with
+//     their segments;
+//   - the segments are iterated by ranges of stream ids to find the segments
+//     without an object.
+//
+// On CockroachDB both phases read the database as of the snapshot time, the
+// start of the verification moved by AsOfSystemInterval. On PostgreSQL the
+// snapshot time is the start of the verification and only the rows created
+// before it are considered, so concurrent deletions might be reported as
+// violations.
+type Consistency struct {
+	log    *zap.Logger
+	db     *metabase.DB
+	config ConsistencyConfig
+
+	startedAt  time.Time
+	snapshotAt time.Time
+	aliases    map[metabase.NodeAlias]struct{}
+
+	mu        sync.Mutex
+	streamIDs map[uuid.UUID]struct{}
+	report    ConsistencyReport
+}
+
+// NewConsistency creates a new consistency verification.
+func NewConsistency(log *zap.Logger, db *metabase.DB, config ConsistencyConfig) *Consistency {
+	if config.Ranges <= 0 {
+		config.Ranges = 1
+	}
+	if config.Parallelism <= 0 {
+		config.Parallelism = 1
+	}
+	if config.SampleRate <= 0 || config.SampleRate > 1 {
+		config.SampleRate = 1
+	}
+	return &Consistency{
+		log:    log,
+		db:     db,
+		config: config,
+	}
+}
+
+// Run verifies the metabase and returns the report.
+func (verify *Consistency) Run(ctx context.Context) (_ ConsistencyReport, err error) {
+	verify.startedAt, err = verify.db.Now(ctx)
+	if err != nil {
+		return ConsistencyReport{}, Error.Wrap(err)
+	}
+
+	// PostgreSQL can't read a snapshot in the past, so the rows created in
+	// the interval would be skipped without being verified.
+	verify.snapshotAt = verify.startedAt
+	if verify.config.AsOfSystemInterval < 0 && verify.db.Implementation() == dbutil.Cockroach {
+		verify.snapshotAt = verify.startedAt.Add(verify.config.AsOfSystemInterval)
+	}
+
+	verify.report = ConsistencyReport{
+		StartedAt:  verify.startedAt,
+		SnapshotAt: verify.snapshotAt,
+		SampleRate: verify.config.SampleRate,
+		Violations: map[Check]int64{},
+		Issues:     []Issue{},
+	}
+	verify.streamIDs = map[uuid.UUID]struct{}{}
+
+	// node aliases are never deleted, so reading them after the start
+	// doesn't hide any invalid reference.
+	entries, err := verify.db.ListNodeAliases(ctx)
+	if err != nil {
+		return ConsistencyReport{}, Error.Wrap(err)
+	}
+	verify.aliases = make(map[metabase.NodeAlias]struct{}, len(entries))
+	for _, entry := range entries {
+		verify.aliases[entry.Alias] = struct{}{}
+	}
+
+	if err := verify.parallel(ctx, "objects", verify.verifyObjects); err != nil {
+		return ConsistencyReport{}, err
+	}
+	if err := verify.parallel(ctx, "segments", verify.verifySegments); err != nil {
+		return ConsistencyReport{}, err
+	}
+
+	verify.report.FinishedAt = time.Now()
+	sort.SliceStable(verify.report.Issues, func(i, k int) bool {
+		return verify.report.Issues[i].Check < verify.report.Issues[k].Check
+	})
+	return verify.report, nil
+}
+
+// idRange is a range of ids, end is nil for the last range.
+type idRange struct {
+	start uuid.UUID
+	end   *uuid.UUID
+}
+
+// splitRanges splits the id space into count ranges using the first two bytes
+// of the ids.
+func splitRanges(count int) []idRange {
+	if count > 1<<16 {
+		count = 1 << 16
+	}
+
+	bound := func(i int) uuid.UUID {
+		var id uuid.UUID
+		v := i * (1 << 16) / count
+		id[0], id[1] = byte(v>>8), byte(v)
+		return id
+	}
+
+	ranges := make([]idRange, count)
+	for i := range ranges {
+		ranges[i].start = bound(i)
+		if i+1 < count {
+			end := bound(i + 1)
+			ranges[i].end = &end
+		}
+	}
+	return ranges
+}
+
+// sampled returns whether the stream is verified.
+func (verify *Consistency) sampled(streamID uuid.UUID) bool {
+	if verify.config.SampleRate >= 1 {
+		return true
+	}
+	// the stream ids are random, so their last bytes are uniformly
+	// distributed.
+	return float64(binary.BigEndian.Uint64(streamID[8:])) < verify.config.SampleRate*math.MaxUint64
+}
+
+// parallel runs fn on all the ranges with the configured parallelism.
+func (verify *Consistency) parallel(ctx context.Context, phase string, fn func(context.Context, idRange) error) error {
+	ranges := splitRanges(verify.config.Ranges)
+
+	work := make(chan idRange)
+	go func() {
+		defer close(work)
+		for _, r := range ranges {
+			select {
+			case work <- r:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	var errsMu sync.Mutex
+	var group errs.Group
+	for i := 0; i < verify.config.Parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range work {
+				if err := fn(ctx, r); err != nil {
+					errsMu.Lock()
+					group.Add(err)
+					errsMu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	verify.log.Info("phase finished", zap.String("phase", phase), zap.Int("ranges", len(ranges)))
+	return Error.Wrap(errs.Combine(ctx.Err(), group.Err()))
+}
+
+type objectEntry struct {
+	projectID          uuid.UUID
+	bucketName         string
+	streamID           uuid.UUID
+	status             metabase.ObjectStatus
+	segmentCount       int64
+	totalPlainSize     int64
+	totalEncryptedSize int64
+}
+
+type segmentsTotals struct {
+	count         int64
+	plainSize     int64
+	encryptedSize int64
+	offsets       []segmentOffset
+}
+
+type segmentOffset struct {
+	position    metabase.SegmentPosition
+	plainOffset int64
+	plainSize   int64
+}
+
+// verifyObjects compares the objects of the project id range with their
+// segments.
+func (verify *Consistency) verifyObjects(ctx context.Context, r idRange) (err error) {
+	type cursor struct {
+		projectID  uuid.UUID
+		bucketName []byte
+		objectKey  []byte
+		version    int64
+	}
+	// the bucket name and the object key can't be NULL, otherwise the
+	// comparison with the cursor is NULL.
+	next := cursor{projectID: r.start, bucketName: []byte{}, objectKey: []byte{}}
+
+	query := `
+		SELECT
+			project_id, bucket_name, object_key, version, stream_id, status,
+			segment_count, total_plain_size, total_encrypted_size
+		FROM objects
+		` + verify.db.Implementation().AsOfSystemTime(verify.snapshotAt) + `
+		WHERE (project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
+			AND created_at <= $5
+			AND ($6::BYTEA IS NULL OR project_id < $6)
+		ORDER BY project_id ASC, bucket_name ASC, object_key ASC, version ASC
+		LIMIT $7
+	`
+
+	var end []byte
+	if r.end != nil {
+		end = r.end.Bytes()
+	}
+
+	for {
+		var objects []objectEntry
+		err := withRows(verify.db.UnderlyingTagSQL().QueryContext(ctx, query,
+			next.projectID, next.bucketName, next.objectKey, next.version,
+			verify.snapshotAt, end, verify.config.BatchSize,
+		))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var object objectEntry
+				var bucketName []byte
+				err := rows.Scan(
+					&object.projectID, &bucketName, &next.objectKey, &next.version, &object.streamID, &object.status,
+					&object.segmentCount, &object.totalPlainSize, &object.totalEncryptedSize,
+				)
+				if err != nil {
+					return err
+				}
+				object.bucketName = string(bucketName)
+				next.projectID, next.bucketName = object.projectID, bucketName
+				objects = append(objects, object)
+			}
+			return nil
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(objects) == 0 {
+			return nil
+		}
+		scanned := len(objects)
+
+		sampled := objects[:0]
+		for _, object := range objects {
+			if verify.sampled(object.streamID) {
+				sampled = append(sampled, object)
+			}
+		}
+
+		if len(sampled) > 0 {
+			if err := verify.verifyObjectsBatch(ctx, sampled); err != nil {
+				return err
+			}
+		}
+		if scanned < verify.config.BatchSize {
+			return nil
+		}
+	}
+}
+
+func (verify *Consistency) verifyObjectsBatch(ctx context.Context, objects []objectEntry) (err error) {
+	streamIDs := make([]uuid.UUID, len(objects))
+	for i, object := range objects {
+		streamIDs[i] = object.streamID
+	}
+
+	totals := make(map[uuid.UUID]*segmentsTotals, len(objects))
+	var issues []Issue
+	var segmentCount int64
+
+	err = withRows(verify.db.UnderlyingTagSQL().QueryContext(ctx, `
+		SELECT stream_id, position, plain_offset, plain_size, encrypted_size, remote_alias_pieces
+		FROM segments
+		`+verify.db.Implementation().AsOfSystemTime(verify.snapshotAt)+`
+		WHERE stream_id = ANY($1) AND created_at <= $2
+	`, pgutil.UUIDArray(streamIDs), verify.snapshotAt))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var streamID uuid.UUID
+			var position metabase.SegmentPosition
+			var plainOffset, plainSize, encryptedSize int64
+			var aliasPieces metabase.AliasPieces
+			if err := rows.Scan(&streamID, &position, &plainOffset, &plainSize, &encryptedSize, &aliasPieces); err != nil {
+				return err
+			}
+			segmentCount++
+
+			t, ok := totals[streamID]
+			if !ok {
+				t = &segmentsTotals{}
+				totals[streamID] = t
+			}
+			t.count++
+			t.plainSize += plainSize
+			t.encryptedSize += encryptedSize
+			t.offsets = append(t.offsets, segmentOffset{
+				position:    position,
+				plainOffset: plainOffset,
+				plainSize:   plainSize,
+			})
+
+			for _, piece := range aliasPieces {
+				if _, ok := verify.aliases[piece.Alias]; !ok {
+					issues = append(issues, Issue{
+						Check:    CheckNodeAlias,
+						StreamID: streamID,
+						Detail: "piece " + strconv.Itoa(int(piece.Number)) +
+							" of segment " + strconv.FormatUint(position.Encode(), 10) +
+							" references missing node alias " + strconv.Itoa(int(piece.Alias)),
+					})
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, object := range objects {
+		if object.status != metabase.Committed {
+			continue
+		}
+
+		var t segmentsTotals
+		if found, ok := totals[object.streamID]; ok {
+			t = *found
+		}
+
+		issue := func(check Check, expected, actual int64) Issue {
+			projectID := object.projectID
+			return Issue{
+				Check:      check,
+				StreamID:   object.streamID,
+				ProjectID:  &projectID,
+				BucketName: object.bucketName,
+				Expected:   &expected,
+				Actual:     &actual,
+			}
+		}
+
+		if object.segmentCount != t.count {
+			issues = append(issues, issue(CheckSegmentCount, object.segmentCount, t.count))
+		}
+		// migrated objects don't have the plain size.
+		if object.totalPlainSize != 0 && object.totalPlainSize != t.plainSize {
+			issues = append(issues, issue(CheckTotalPlainSize, object.totalPlainSize, t.plainSize))
+		}
+		if object.totalEncryptedSize != t.encryptedSize {
+			issues = append(issues, issue(CheckTotalEncryptedSize, object.totalEncryptedSize, t.encryptedSize))
+		}
+		if object.totalPlainSize != 0 {
+			sort.Slice(t.offsets, func(i, k int) bool {
+				return t.offsets[i].position.Less(t.offsets[k].position)
+			})
+
+			var expected int64
+			for _, segment := range t.offsets {
+				if segment.plainOffset != expected {
+					offsetIssue := issue(CheckPlainOffset, expected, segment.plainOffset)
+					offsetIssue.Detail = "segment " + strconv.FormatUint(segment.position.Encode(), 10) + " isn't contiguous"
+					issues = append(issues, offsetIssue)
+					break
+				}
+				expected += segment.plainSize
+			}
+		}
+	}
+
+	verify.mu.Lock()
+	defer verify.mu.Unlock()
+
+	for _, streamID := range streamIDs {
+		verify.streamIDs[streamID] = struct{}{}
+	}
+	verify.report.Objects += int64(len(objects))
+	verify.report.Segments += segmentCount
+	verify.addIssues(issues)
+
+	return nil
+}
+
+// verifySegments finds the segments of the stream id range without an object.
+func (verify *Consistency) verifySegments(ctx context.Context, r idRange) (err error) {
+	var end []byte
+	if r.end != nil {
+		end = r.end.Bytes()
+	}
+
+	var issues []Issue
+	err = withRows(verify.db.UnderlyingTagSQL().QueryContext(ctx, `
+		SELECT stream_id, count(*)
+		FROM segments
+		`+verify.db.Implementation().AsOfSystemTime(verify.snapshotAt)+`
+		WHERE stream_id >= $1
+			AND ($2::BYTEA IS NULL OR stream_id < $2)
+			AND created_at <= $3
+		GROUP BY stream_id
+	`, r.start, end, verify.snapshotAt))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var streamID uuid.UUID
+			var count int64
+			if err := rows.Scan(&streamID, &count); err != nil {
+				return err
+			}
+
+			if !verify.sampled(streamID) {
+				continue
+			}
+			// the stream ids are only written during the objects phase.
+			if _, ok := verify.streamIDs[streamID]; ok {
+				continue
+			}
+
+			expected := int64(0)
+			issues = append(issues, Issue{
+				Check:    CheckOrphanSegments,
+				StreamID: streamID,
+				Expected: &expected,
+				Actual:   &count,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	verify.mu.Lock()
+	defer verify.mu.Unlock()
+	verify.addIssues(issues)
+
+	return nil
+}
+
+// addIssues adds the issues to the report, it must be called with mu held.
+func (verify *Consistency) addIssues(issues []Issue) {
+	for _, issue := range issues {
+		verify.report.Violations[issue.Check]++
+		if len(verify.report.Issues) >= verify.config.MaxIssues {
+			verify.report.IssuesTruncated = true
+			continue
+		}
+		verify.report.Issues = append(verify.report.Issues, issue)
+	}
+}
+
+func withRows(rows tagsql.Rows, err error) func(func(tagsql.Rows) error) error {
+	return func(callback func(tagsql.Rows) error) error {
+		if err != nil {
+			return err
+		}
+		err := callback(rows)
+		return errs.Combine(rows.Err(), rows.Close(), err)
+	}
+}
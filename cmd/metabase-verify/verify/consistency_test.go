@@ -0,0 +1,122 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package verify_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/private/dbutil"
+	"storj.io/storj/cmd/metabase-verify/verify"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestConsistency(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		run := func(sampleRate float64) verify.ConsistencyReport {
+			report, err := verify.NewConsistency(zaptest.NewLogger(t), db, verify.ConsistencyConfig{
+				Ranges:      16,
+				Parallelism: 4,
+				BatchSize:   2,
+				MaxIssues:   10,
+				SampleRate:  sampleRate,
+			}).Run(ctx)
+			require.NoError(t, err)
+			return report
+		}
+
+		for i := 0; i < 5; i++ {
+			metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+		}
+
+		report := run(1)
+		require.EqualValues(t, 5, report.Objects)
+		require.EqualValues(t, 10, report.Segments)
+		require.Empty(t, report.Violations)
+		require.Empty(t, report.Issues)
+
+		rawDB := db.UnderlyingTagSQL()
+
+		wrongCount := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+		_, err := rawDB.ExecContext(ctx, `UPDATE objects SET segment_count = 3 WHERE stream_id = $1`, wrongCount.StreamID)
+		require.NoError(t, err)
+
+		wrongSize := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+		_, err = rawDB.ExecContext(ctx, `
+			UPDATE objects SET total_plain_size = total_plain_size + 1, total_encrypted_size = total_encrypted_size + 1
+			WHERE stream_id = $1
+		`, wrongSize.StreamID)
+		require.NoError(t, err)
+
+		orphaned := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 3)
+		_, err = rawDB.ExecContext(ctx, `DELETE FROM objects WHERE stream_id = $1`, orphaned.StreamID)
+		require.NoError(t, err)
+
+		invalidAlias := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+		_, err = rawDB.ExecContext(ctx, `UPDATE segments SET remote_alias_pieces = $1 WHERE stream_id = $2`,
+			metabase.AliasPieces{{Number: 1, Alias: 9999}}, invalidAlias.StreamID)
+		require.NoError(t, err)
+
+		wrongOffset := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+		_, err = rawDB.ExecContext(ctx, `UPDATE segments SET plain_offset = 100 WHERE stream_id = $1 AND position = $2`,
+			wrongOffset.StreamID, metabase.SegmentPosition{Index: 1})
+		require.NoError(t, err)
+
+		report = run(1)
+		require.EqualValues(t, 9, report.Objects)
+		require.EqualValues(t, 16, report.Segments)
+		require.Equal(t, map[verify.Check]int64{
+			verify.CheckSegmentCount:       1,
+			verify.CheckTotalPlainSize:     1,
+			verify.CheckTotalEncryptedSize: 1,
+			verify.CheckPlainOffset:        1,
+			verify.CheckOrphanSegments:     1,
+			verify.CheckNodeAlias:          1,
+		}, report.Violations)
+		require.Len(t, report.Issues, 6)
+		require.False(t, report.IssuesTruncated)
+
+		for _, issue := range report.Issues {
+			switch issue.Check {
+			case verify.CheckSegmentCount:
+				require.Equal(t, wrongCount.StreamID, issue.StreamID)
+				require.EqualValues(t, 3, *issue.Expected)
+				require.EqualValues(t, 2, *issue.Actual)
+			case verify.CheckTotalPlainSize, verify.CheckTotalEncryptedSize:
+				require.Equal(t, wrongSize.StreamID, issue.StreamID)
+				require.Equal(t, *issue.Expected, *issue.Actual+1)
+			case verify.CheckOrphanSegments:
+				require.Equal(t, orphaned.StreamID, issue.StreamID)
+				require.EqualValues(t, 3, *issue.Actual)
+			case verify.CheckNodeAlias:
+				require.Equal(t, invalidAlias.StreamID, issue.StreamID)
+			case verify.CheckPlainOffset:
+				require.Equal(t, wrongOffset.StreamID, issue.StreamID)
+				require.EqualValues(t, 512, *issue.Expected)
+				require.EqualValues(t, 100, *issue.Actual)
+			}
+		}
+
+		// the segments of the objects, which aren't sampled, aren't orphaned.
+		report = run(1e-12)
+		require.EqualValues(t, 0, report.Objects)
+		require.Empty(t, report.Violations)
+
+		// PostgreSQL can't read in the past, so the recent objects must not
+		// be skipped.
+		if db.Implementation() == dbutil.Postgres {
+			report, err = verify.NewConsistency(zaptest.NewLogger(t), db, verify.ConsistencyConfig{
+				AsOfSystemInterval: -5 * time.Minute,
+			}).Run(ctx)
+			require.NoError(t, err)
+			require.Equal(t, report.StartedAt, report.SnapshotAt)
+			require.EqualValues(t, 9, report.Objects)
+		}
+	})
+}
@@ -49,6 +49,7 @@ func cmdAPIRun(cmd *cobra.Command, args []string) (err error) {
 		MinPartSize:      runCfg.Config.Metainfo.MinPartSize,
 		MaxNumberOfParts: runCfg.Config.Metainfo.MaxNumberOfParts,
 		ServerSideCopy:   runCfg.Config.Metainfo.ServerSideCopy,
+		ReadReplica:      runCfg.Config.Metainfo.ReadReplica,
 	})
 	if err != nil {
 		return errs.New("Error creating metabase connection on satellite api: %+v", err)
@@ -5,6 +5,8 @@ package main
 
 import (
 	"context"
+	"encoding/csv"
+	"io"
 	"strconv"
 	"strings"
 	"time"
@@ -65,6 +67,9 @@ func setupPayments(log *zap.Logger, db satellite.DB) (*stripecoinpayments.Servic
 		pc.StorageTBPrice,
 		pc.EgressTBPrice,
 		pc.SegmentPrice,
+		pc.PlacementPrices,
+		pc.PriceTiers,
+		pc.VolumeDiscounts,
 		pc.BonusRate)
 }
 
@@ -144,3 +149,46 @@ func cmdApplyFreeTierCoupons(cmd *cobra.Command, args []string) (err error) {
 		return payments.ApplyFreeTierCoupons(ctx)
 	})
 }
+
+// writeInvoiceReportCSV writes the invoice report as CSV. Every invoice has a
+// row per project followed by a row with the totals of the customer.
+func writeInvoiceReportCSV(report *stripecoinpayments.InvoiceReport, w io.Writer) error {
+	csvWriter := csv.NewWriter(w)
+
+	header := []string{
+		"type", "customer_id", "user_id", "project_id", "project_name",
+		"storage_mb_month", "egress_mb", "segment_month",
+		"storage_cents", "egress_cents", "segments_cents", "subtotal_cents",
+		"coupon_id", "discount_cents", "credit_cents", "total_cents",
+	}
+	if err := csvWriter.Write(header); err != nil {
+		return err
+	}
+
+	format := func(v int64) string { return strconv.FormatInt(v, 10) }
+
+	for _, customer := range report.Customers {
+		for _, project := range customer.Projects {
+			if err := csvWriter.Write([]string{
+				"project", customer.CustomerID, customer.UserID.String(), project.ProjectID.String(), project.ProjectName,
+				format(project.StorageMBMonth), format(project.EgressMB), format(project.SegmentMonth),
+				format(project.Storage), format(project.Egress), format(project.Segments), format(project.Total),
+				"", "", "", "",
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err := csvWriter.Write([]string{
+			"customer", customer.CustomerID, customer.UserID.String(), "", "",
+			"", "", "",
+			"", "", "", format(customer.Subtotal),
+			customer.CouponID, format(customer.Discount), format(customer.Credit), format(customer.Total),
+		}); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -151,6 +152,13 @@ var (
 		Args:  cobra.MinimumNArgs(2),
 		RunE:  cmdReportsGracefulExit,
 	}
+	reportsNodeSLOCmd = &cobra.Command{
+		Use:   "node-slo [period]",
+		Short: "Generate a node SLO report",
+		Long:  "Generate a report of the availability, audit success and repair contribution of the nodes for a period. Period is a UTC date formatted like YYYY-MM.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdReportsNodeSLO,
+	}
 	reportsVerifyGEReceiptCmd = &cobra.Command{
 		Use:   "verify-exit-receipt [storage node ID] [receipt]",
 		Short: "Verify a graceful exit receipt",
@@ -214,6 +222,20 @@ var (
 		Args:  cobra.ExactArgs(1),
 		RunE:  cmdCreateCustomerInvoices,
 	}
+	invoiceReportCmd = &cobra.Command{
+		Use:   "invoice-report [period]",
+		Short: "Reports the invoices of a period without creating them",
+		Long:  "Reports the per-project totals, coupons and credits of the invoices which would be created for a period, without creating anything in stripe or in the database.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdInvoiceReport,
+	}
+	exportInvoicesCmd = &cobra.Command{
+		Use:   "export-invoices [period]",
+		Short: "Exports the finalized invoices of a period",
+		Long:  "Exports the finalized invoices of a period with their line items as JSON to stdout and posts them to the invoice export webhook, when it's configured.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdExportInvoices,
+	}
 	finalizeCustomerInvoicesCmd = &cobra.Command{
 		Use:   "finalize-invoices",
 		Short: "Finalizes all draft stripe invoices",
@@ -257,6 +279,13 @@ var (
 		Args:  cobra.ExactArgs(3),
 		RunE:  cmdFetchPieces,
 	}
+	repairPlanCmd = &cobra.Command{
+		Use:   "repair-plan [limit]",
+		Short: "Report what the repairer would do with the segments in the repair queue",
+		Long:  "Evaluate up to limit (default 1000) segments of the repair queue and report the pieces which would be downloaded and uploaded and the selected nodes, without repairing the segments or removing them from the queue. Repair thresholds can be overridden with --checker.repair-overrides to validate threshold changes.",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE:  cmdRepairPlan,
+	}
 
 	runCfg   Satellite
 	setupCfg Satellite
@@ -290,6 +319,10 @@ var (
 		Output    string `help:"destination of report output" default:""`
 		Completed bool   `help:"whether to output (initiated and completed) or (initiated and not completed)" default:"false"`
 	}
+	reportsNodeSLOCfg struct {
+		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		Output   string `help:"destination of report output" default:""`
+	}
 	reportsVerifyGracefulExitReceiptCfg struct {
 	}
 	consistencyGECleanupCfg struct {
@@ -322,10 +355,12 @@ func init() {
 	rootCmd.AddCommand(restoreTrashCmd)
 	rootCmd.AddCommand(registerLostSegments)
 	rootCmd.AddCommand(fetchPiecesCmd)
+	rootCmd.AddCommand(repairPlanCmd)
 	reportsCmd.AddCommand(nodeUsageCmd)
 	reportsCmd.AddCommand(partnerAttributionCmd)
 	reportsCmd.AddCommand(reportsGracefulExitCmd)
 	reportsCmd.AddCommand(reportsVerifyGEReceiptCmd)
+	reportsCmd.AddCommand(reportsNodeSLOCmd)
 	compensationCmd.AddCommand(generateInvoicesCmd)
 	compensationCmd.AddCommand(recordPeriodCmd)
 	compensationCmd.AddCommand(recordOneOffPaymentsCmd)
@@ -333,7 +368,9 @@ func init() {
 	billingCmd.AddCommand(prepareCustomerInvoiceRecordsCmd)
 	billingCmd.AddCommand(createCustomerInvoiceItemsCmd)
 	billingCmd.AddCommand(createCustomerInvoicesCmd)
+	billingCmd.AddCommand(invoiceReportCmd)
 	billingCmd.AddCommand(finalizeCustomerInvoicesCmd)
+	billingCmd.AddCommand(exportInvoicesCmd)
 	billingCmd.AddCommand(stripeCustomerCmd)
 	consistencyCmd.AddCommand(consistencyGECleanupCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -345,6 +382,7 @@ func init() {
 	process.Bind(restoreTrashCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(registerLostSegments, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(fetchPiecesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(repairPlanCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(qdiagCmd, &qdiagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(nodeUsageCmd, &nodeUsageCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -353,12 +391,15 @@ func init() {
 	process.Bind(recordOneOffPaymentsCmd, &recordOneOffPaymentsCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(reportsGracefulExitCmd, &reportsGracefulExitCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(reportsVerifyGEReceiptCmd, &reportsVerifyGracefulExitReceiptCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(reportsNodeSLOCmd, &reportsNodeSLOCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(partnerAttributionCmd, &partnerAttribtionCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(applyFreeTierCouponsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(prepareCustomerInvoiceRecordsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(createCustomerInvoiceItemsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(createCustomerInvoicesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(invoiceReportCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(finalizeCustomerInvoicesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(exportInvoicesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(stripeCustomerCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(consistencyGECleanupCmd, &consistencyGECleanupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 
@@ -398,6 +439,7 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		MinPartSize:      runCfg.Config.Metainfo.MinPartSize,
 		MaxNumberOfParts: runCfg.Config.Metainfo.MaxNumberOfParts,
 		ServerSideCopy:   runCfg.Config.Metainfo.ServerSideCopy,
+		ReadReplica:      runCfg.Config.Metainfo.ReadReplica,
 	})
 	if err != nil {
 		return errs.New("Error creating metabase connection: %+v", err)
@@ -598,6 +640,26 @@ func cmdReportsGracefulExit(cmd *cobra.Command, args []string) (err error) {
 	return generateGracefulExitCSV(ctx, reportsGracefulExitCfg.Completed, start, end, file)
 }
 
+func cmdReportsNodeSLO(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	period, err := compensation.PeriodFromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := runWithOutput(reportsNodeSLOCfg.Output, func(out io.Writer) error {
+		return generateNodeSLOCSV(ctx, period, out)
+	}); err != nil {
+		return err
+	}
+
+	if reportsNodeSLOCfg.Output != "" {
+		fmt.Println("Generated node SLO report")
+	}
+	return nil
+}
+
 func cmdNodeUsage(cmd *cobra.Command, args []string) (err error) {
 	ctx, _ := process.Ctx(cmd)
 
@@ -744,6 +806,23 @@ func cmdCreateCustomerInvoices(cmd *cobra.Command, args []string) (err error) {
 	})
 }
 
+func cmdInvoiceReport(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	period, err := parseBillingPeriod(args[0])
+	if err != nil {
+		return errs.New("invalid period specified: %v", err)
+	}
+
+	return runBillingCmd(ctx, func(ctx context.Context, payments *stripecoinpayments.Service, _ satellite.DB) error {
+		report, err := payments.GenerateInvoiceReport(ctx, period)
+		if err != nil {
+			return err
+		}
+		return writeInvoiceReportCSV(report, os.Stdout)
+	})
+}
+
 func cmdFinalizeCustomerInvoices(cmd *cobra.Command, args []string) (err error) {
 	ctx, _ := process.Ctx(cmd)
 
@@ -752,6 +831,34 @@ func cmdFinalizeCustomerInvoices(cmd *cobra.Command, args []string) (err error)
 	})
 }
 
+func cmdExportInvoices(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	period, err := parseBillingPeriod(args[0])
+	if err != nil {
+		return errs.New("invalid period specified: %v", err)
+	}
+
+	return runBillingCmd(ctx, func(ctx context.Context, payments *stripecoinpayments.Service, _ satellite.DB) error {
+		export, err := payments.ExportInvoices(ctx, period)
+		if err != nil {
+			return err
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(export); err != nil {
+			return err
+		}
+
+		config := runCfg.Payments.StripeCoinPayments.InvoiceExport
+		if config.WebhookURL == "" {
+			return nil
+		}
+		return stripecoinpayments.NewInvoiceWebhook(config).Send(ctx, export)
+	})
+}
+
 func cmdStripeCustomer(cmd *cobra.Command, args []string) (err error) {
 	ctx, _ := process.Ctx(cmd)
 
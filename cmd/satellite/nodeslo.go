@@ -0,0 +1,35 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"io"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/nodeslo"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// generateNodeSLOCSV creates a report with the availability, audit success and
+// repair contribution of the nodes in a given period.
+func generateNodeSLOCSV(ctx context.Context, period compensation.Period, output io.Writer) (err error) {
+	db, err := satellitedb.Open(ctx, zap.L().Named("db"), reportsNodeSLOCfg.Database, satellitedb.Options{ApplicationName: "satellite-nodeslo"})
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	report, err := nodeslo.NewService(db).Generate(ctx, period)
+	if err != nil {
+		return err
+	}
+
+	return nodeslo.WriteCSV(output, report)
+}
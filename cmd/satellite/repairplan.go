@@ -0,0 +1,120 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/context2"
+	"storj.io/private/process"
+	"storj.io/private/version"
+	"storj.io/storj/private/revocation"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// repairPlanReport is the output of the repair-plan command.
+type repairPlanReport struct {
+	Summary repairer.PlanSummary  `json:"summary"`
+	Plans   []repairer.RepairPlan `json:"plans"`
+}
+
+func cmdRepairPlan(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	limit := 1000
+	if len(args) > 0 {
+		limit, err = strconv.Atoi(args[0])
+		if err != nil || limit <= 0 {
+			return errs.New("limit must be a positive number: %q", args[0])
+		}
+	}
+
+	identity, err := runCfg.Identity.Load()
+	if err != nil {
+		log.Error("Failed to load identity.", zap.Error(err))
+		return errs.New("Failed to load identity: %+v", err)
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-repair-plan"})
+	if err != nil {
+		return errs.New("Error starting master database: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), runCfg.Metainfo.DatabaseURL, metabase.Config{
+		ApplicationName:  "satellite-repair-plan",
+		MinPartSize:      runCfg.Config.Metainfo.MinPartSize,
+		MaxNumberOfParts: runCfg.Config.Metainfo.MaxNumberOfParts,
+	})
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	revocationDB, err := revocation.OpenDBFromCfg(ctx, runCfg.Server.Config)
+	if err != nil {
+		return errs.New("Error creating revocation database: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, revocationDB.Close())
+	}()
+
+	rollupsWriteCache := orders.NewRollupsWriteCache(log.Named("orders-write-cache"), db.Orders(), runCfg.Orders.FlushBatchSize)
+	defer func() {
+		err = errs.Combine(err, rollupsWriteCache.CloseAndFlush(context2.WithoutCancellation(ctx)))
+	}()
+
+	peer, err := satellite.NewRepairer(
+		log,
+		identity,
+		metabaseDB,
+		revocationDB,
+		db.RepairQueue(),
+		db.Buckets(),
+		db.OverlayCache(),
+		db.Reputation(),
+		db.Containment(),
+		rollupsWriteCache,
+		version.Build,
+		&runCfg.Config,
+		process.AtomicLevel(cmd),
+	)
+	if err != nil {
+		return err
+	}
+
+	segments, err := db.RepairQueue().SelectN(ctx, limit)
+	if err != nil {
+		return err
+	}
+
+	var report repairPlanReport
+	for i := range segments {
+		plan, err := peer.SegmentRepairer.Plan(ctx, &segments[i])
+		if err != nil {
+			return err
+		}
+		report.Summary.Add(plan)
+		report.Plans = append(report.Plans, plan)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
@@ -0,0 +1,196 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/private/process"
+	"storj.io/storj/private/prompt"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/collector"
+	"storj.io/storj/storagenode/forgetsatellite"
+	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/piecestore/usedserials"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+var (
+	forgetSatelliteCmd = &cobra.Command{
+		Use:   "forget-satellite",
+		Short: "Remove a satellite and its data",
+		Long: "Stop trusting a satellite, move all its pieces to the trash and delete its unsent orders, piece expirations, reputation, payouts, pricing and storage usage.\n" +
+			"It should only be used for satellites which were decommissioned or are no longer trusted, " +
+			"because the node fails the audits of the deleted pieces otherwise. " +
+			"The storage node should be stopped while running the command.",
+		RunE:        cmdForgetSatellite,
+		Annotations: map[string]string{"type": "helper"},
+		Args:        cobra.ExactArgs(0),
+	}
+	purgeTrashCmd = &cobra.Command{
+		Use:   "purge-trash",
+		Short: "Delete pieces from the trash",
+		Long: "Delete the pieces which have been in the trash longer than --older-than.\n" +
+			"The storage node should be stopped while running the command.",
+		RunE:        cmdPurgeTrash,
+		Annotations: map[string]string{"type": "helper"},
+		Args:        cobra.ExactArgs(0),
+	}
+	runGCCmd = &cobra.Command{
+		Use:   "run-gc",
+		Short: "Delete expired pieces and expired trash",
+		Long: "Delete the expired pieces and the pieces which have been in the trash longer than the trash expiration.\n" +
+			"This runs the storage node garbage chores once, without waiting for their interval. " +
+			"The storage node should be stopped while running the command.",
+		RunE:        cmdRunGC,
+		Annotations: map[string]string{"type": "helper"},
+		Args:        cobra.ExactArgs(0),
+	}
+
+	forgetSatelliteCfg struct {
+		storagenode.Config
+
+		SatelliteID string `help:"id of the satellite to forget" default:""`
+		Force       bool   `help:"do not ask for confirmation" default:"false"`
+		EmptyTrash  bool   `help:"delete the pieces right away, instead of keeping them in the trash until it expires" default:"false"`
+	}
+	purgeTrashCfg struct {
+		storagenode.Config
+
+		OlderThan   time.Duration `help:"delete pieces which have been in the trash for longer than this" default:"168h0m0s"`
+		SatelliteID string        `help:"only purge the trash of this satellite, instead of all the satellites" default:""`
+	}
+	runGCCfg storagenode.Config
+)
+
+// trashExpiryInterval is how long the pieces are kept in the trash by the
+// storage node, before they are deleted.
+const trashExpiryInterval = 7 * 24 * time.Hour
+
+// withPieceStore loads the node identity, to make sure that the command is
+// run by the operator of the node, and calls fn with the piece store of the node.
+func withPieceStore(ctx context.Context, config storagenode.Config, fn func(ctx context.Context, store *pieces.Store) error) (err error) {
+	return withDB(ctx, config, func(ctx context.Context, db *storagenodedb.DB, store *pieces.Store) error {
+		return fn(ctx, store)
+	})
+}
+
+// withDB loads the node identity, to make sure that the command is run by the
+// operator of the node, and calls fn with the database and the piece store of
+// the node.
+func withDB(ctx context.Context, config storagenode.Config, fn func(ctx context.Context, db *storagenodedb.DB, store *pieces.Store) error) (err error) {
+	ident, err := config.Identity.Load()
+	if err != nil {
+		return errs.New("Failed to load identity: %v", err)
+	}
+	zap.L().Info("Identity loaded.", zap.Stringer("Node ID", ident.ID))
+
+	db, err := storagenodedb.OpenExisting(ctx, zap.L().Named("db"), config.DatabaseConfig())
+	if err != nil {
+		return errs.New("Error starting master database on storage node: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	store := pieces.NewStore(zap.L().Named("pieces"), db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), config.Pieces)
+
+	return fn(ctx, db, store)
+}
+
+func cmdForgetSatellite(cmd *cobra.Command, args []string) error {
+	ctx, _ := process.Ctx(cmd)
+
+	satelliteID, err := storj.NodeIDFromString(forgetSatelliteCfg.SatelliteID)
+	if err != nil {
+		return errs.New("invalid --satellite-id %q: %v", forgetSatelliteCfg.SatelliteID, err)
+	}
+
+	if !forgetSatelliteCfg.Force {
+		confirmed, err := prompt.Confirm(fmt.Sprintf("Satellite %s will no longer be trusted and all its data will be deleted.\n"+
+			"This action can not be undone.\nAre you sure you want to continue? [y/n]\n", satelliteID))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	return withDB(ctx, forgetSatelliteCfg.Config, func(ctx context.Context, db *storagenodedb.DB, store *pieces.Store) error {
+		ordersStore, err := orders.NewFileStore(zap.L().Named("ordersfilestore"),
+			forgetSatelliteCfg.Storage2.Orders.Path, forgetSatelliteCfg.Storage2.OrderLimitGracePeriod)
+		if err != nil {
+			return errs.New("Error opening the orders of the storage node: %v", err)
+		}
+
+		service := forgetsatellite.NewService(zap.L().Named("forgetsatellite"), store, nil, nil, ordersStore,
+			db.Satellites(), db.Reputation(), db.Payout(), db.Pricing(), db.StorageUsage())
+		if err := service.ForgetSatellite(ctx, satelliteID); err != nil {
+			return errs.New("Error forgetting satellite %s: %v", satelliteID, err)
+		}
+
+		if forgetSatelliteCfg.EmptyTrash {
+			if err := store.ForgetSatellite(ctx, satelliteID); err != nil {
+				return errs.New("Error deleting the data of satellite %s: %v", satelliteID, err)
+			}
+			fmt.Printf("Forgot satellite %s and deleted its data.\n", satelliteID)
+			return nil
+		}
+
+		fmt.Printf("Forgot satellite %s and moved its pieces to the trash.\n", satelliteID)
+		return nil
+	})
+}
+
+func cmdPurgeTrash(cmd *cobra.Command, args []string) error {
+	ctx, _ := process.Ctx(cmd)
+
+	if purgeTrashCfg.OlderThan < 0 {
+		return errs.New("--older-than must not be negative")
+	}
+	trashedBefore := time.Now().Add(-purgeTrashCfg.OlderThan)
+
+	var satelliteID *storj.NodeID
+	if purgeTrashCfg.SatelliteID != "" {
+		id, err := storj.NodeIDFromString(purgeTrashCfg.SatelliteID)
+		if err != nil {
+			return errs.New("invalid --satellite-id %q: %v", purgeTrashCfg.SatelliteID, err)
+		}
+		satelliteID = &id
+	}
+
+	return withPieceStore(ctx, purgeTrashCfg.Config, func(ctx context.Context, store *pieces.Store) error {
+		if satelliteID != nil {
+			return store.EmptyTrash(ctx, *satelliteID, trashedBefore)
+		}
+		return store.EmptyAllTrash(ctx, trashedBefore)
+	})
+}
+
+func cmdRunGC(cmd *cobra.Command, args []string) error {
+	ctx, _ := process.Ctx(cmd)
+
+	return withPieceStore(ctx, runGCCfg, func(ctx context.Context, store *pieces.Store) error {
+		service := collector.NewService(zap.L().Named("collector"), store, usedserials.NewTable(runGCCfg.Storage2.MaxUsedSerialsSize), runGCCfg.Collector)
+		// the collector chore keeps expired pieces for 24 hours more, to
+		// avoid deleting them prematurely because of timezone issues.
+		if err := service.Collect(ctx, time.Now().Add(-24*time.Hour)); err != nil {
+			return errs.New("Error collecting expired pieces: %v", err)
+		}
+
+		if err := store.EmptyAllTrash(ctx, time.Now().Add(-trashExpiryInterval)); err != nil {
+			return errs.New("Error emptying the trash: %v", err)
+		}
+		return nil
+	})
+}
@@ -5,8 +5,10 @@ package main
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"strconv"
 	"strings"
@@ -35,11 +37,14 @@ type cmdCp struct {
 	dryrun    bool
 	progress  bool
 	byteRange string
+	resume    bool
 	expires   time.Time
 	metadata  map[string]string
+	filter    recursiveFilter
 
 	parallelism          int
 	parallelismChunkSize memory.Size
+	limitRate            memory.Size
 
 	source ulloc.Location
 	dest   ulloc.Location
@@ -74,6 +79,9 @@ func (c *cmdCp) Setup(params clingy.Parameters) {
 		clingy.Transform(strconv.ParseBool), clingy.Boolean,
 	).(bool)
 	c.byteRange = params.Flag("range", "Downloads the specified range bytes of an object. For more information about the HTTP Range header, see https://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.35", "").(string)
+	c.resume = params.Flag("continue", "Continue an interrupted download, verifying the already downloaded parts of the local file", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
 
 	c.parallelism = params.Flag("parallelism", "Controls how many parallel chunks to upload/download from a file", 1,
 		clingy.Short('p'),
@@ -95,6 +103,16 @@ func (c *cmdCp) Setup(params clingy.Parameters) {
 		}),
 	).(memory.Size)
 
+	c.limitRate = params.Flag("limit-rate", "Limit the combined transfer rate of all parallel uploads/downloads per second, 0 means unlimited", memory.Size(0),
+		clingy.Transform(memory.ParseString),
+		clingy.Transform(func(n int64) (memory.Size, error) {
+			if n < 0 {
+				return 0, errs.New("limit-rate cannot be below 0")
+			}
+			return memory.Size(n), nil
+		}),
+	).(memory.Size)
+
 	c.expires = params.Flag("expires",
 		"Schedule removal after this time (e.g. '+2h', 'now', '2020-01-02T15:04:05Z0700')",
 		time.Time{}, clingy.Transform(parseHumanDate), clingy.Type("relative_date")).(time.Time)
@@ -103,16 +121,14 @@ func (c *cmdCp) Setup(params clingy.Parameters) {
 		"optional metadata for the object. Please use a single level JSON object of string to string only",
 		nil, clingy.Transform(parseJSON), clingy.Type("string")).(map[string]string)
 
+	c.filter.Setup(params)
+
 	c.source = params.Arg("source", "Source to copy, use - for standard input", clingy.Transform(ulloc.Parse)).(ulloc.Location)
 	c.dest = params.Arg("dest", "Destination to copy, use - for standard output", clingy.Transform(ulloc.Parse)).(ulloc.Location)
 }
 
 func (c *cmdCp) Execute(ctx clingy.Context) error {
-	fs, err := c.ex.OpenFilesystem(ctx, c.access, ulext.ConnectionPoolOptions(rpcpool.Options{
-		Capacity:       100 * c.parallelism,
-		KeyCapacity:    5,
-		IdleExpiration: 2 * time.Minute,
-	}))
+	fs, err := c.ex.OpenFilesystem(ctx, c.access, c.filesystemOptions()...)
 	if err != nil {
 		return err
 	}
@@ -128,6 +144,19 @@ func (c *cmdCp) Execute(ctx clingy.Context) error {
 		c.dest = c.dest.AsDirectoryish()
 	}
 
+	if !c.recursive && c.filter.active() {
+		return errs.New("filters can only be used with a recursive copy")
+	}
+
+	if c.resume {
+		if !c.source.Remote() || !c.dest.Local() {
+			return errs.New("--continue can only be used to download objects into local files")
+		}
+		if c.byteRange != "" {
+			return errs.New("unable to continue a download with byte range")
+		}
+	}
+
 	if c.recursive {
 		if c.byteRange != "" {
 			return errs.New("unable to do recursive copy with byte range")
@@ -156,6 +185,20 @@ func (c *cmdCp) Execute(ctx clingy.Context) error {
 	return c.copyFile(ctx, fs, c.source, c.dest, c.progress)
 }
 
+// filesystemOptions returns the options used to open the filesystem. The rate
+// limit is applied by the filesystem to every transfer, so it covers all
+// parallel parts and files of the command.
+func (c *cmdCp) filesystemOptions() []ulext.Option {
+	return []ulext.Option{
+		ulext.ConnectionPoolOptions(rpcpool.Options{
+			Capacity:       100 * c.parallelism,
+			KeyCapacity:    5,
+			IdleExpiration: 2 * time.Minute,
+		}),
+		ulext.RateLimit(c.limitRate.Int64()),
+	}
+}
+
 func (c *cmdCp) copyRecursive(ctx clingy.Context, fs ulfs.Filesystem) error {
 	if c.source.Std() || c.dest.Std() {
 		return errs.New("cannot recursively copy to stdin/stdout")
@@ -193,7 +236,14 @@ func (c *cmdCp) copyRecursive(ctx clingy.Context, fs ulfs.Filesystem) error {
 	}
 
 	for iter.Next() {
-		source := iter.Item().Loc
+		item := iter.Item()
+		if ok, err := c.filter.match(c.source, item); err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+
+		source := item.Loc
 		rel, err := c.source.RelativeTo(source)
 		if err != nil {
 			return err
@@ -243,9 +293,31 @@ func (c *cmdCp) copyFile(ctx clingy.Context, fs ulfs.Filesystem, source, dest ul
 	}
 	defer func() { _ = mrh.Close() }()
 
+	var state *downloadState
+	if c.resume {
+		// the length of a remote object is known only after fetching its
+		// info, which is needed for the part size to be the same every time.
+		if _, err := mrh.Info(ctx); err != nil {
+			return err
+		}
+	}
+
+	partSize, err := c.calculatePartSize(mrh.Length(), c.parallelismChunkSize.Int64())
+	if err != nil {
+		return err
+	}
+
+	if c.resume {
+		state, err = openDownloadState(ctx, fs, mrh, source, dest, partSize)
+		if err != nil {
+			return err
+		}
+	}
+
 	mwh, err := fs.Create(ctx, dest, &ulfs.CreateOptions{
 		Expires:  c.expires,
 		Metadata: c.metadata,
+		Continue: c.resume,
 	})
 	if err != nil {
 		return err
@@ -258,18 +330,17 @@ func (c *cmdCp) copyFile(ctx clingy.Context, fs ulfs.Filesystem, source, dest ul
 		defer bar.Finish()
 	}
 
-	partSize, err := c.calculatePartSize(mrh.Length(), c.parallelismChunkSize.Int64())
-	if err != nil {
-		return err
-	}
-
-	return errs.Wrap(c.parallelCopy(
+	err = c.parallelCopy(
 		ctx,
 		mwh, mrh,
 		c.parallelism, partSize,
 		offset, length,
-		bar,
-	))
+		bar, state,
+	)
+	if err == nil && state != nil {
+		err = state.Remove(ctx)
+	}
+	return errs.Wrap(err)
 }
 
 // calculatePartSize returns the needed part size in order to upload the file with size of 'length'.
@@ -318,7 +389,8 @@ func (c *cmdCp) parallelCopy(
 	src ulfs.MultiReadHandle,
 	p int, chunkSize int64,
 	offset, length int64,
-	bar *progressbar.ProgressBar) error {
+	bar *progressbar.ProgressBar,
+	state *downloadState) error {
 
 	if offset != 0 {
 		if err := src.SetOffset(offset); err != nil {
@@ -372,6 +444,24 @@ func (c *cmdCp) parallelCopy(
 		}
 		length -= chunk
 
+		if state != nil && state.Downloaded(int64(i)) {
+			// the part was downloaded and verified before, hence it's only
+			// skipped in both the source and the destination.
+			if err := src.SetOffset(state.PartEnd(int64(i))); err != nil {
+				addError(errs.New("error skipping part %d: %v", i, err))
+				break
+			}
+			wh, err := dst.NextPart(ctx, chunk)
+			if err == nil {
+				err = wh.Commit()
+			}
+			if err != nil {
+				addError(errs.New("error skipping part %d: %v", i, err))
+				break
+			}
+			continue
+		}
+
 		rh, err := src.NextPart(ctx, chunk)
 		if err != nil {
 			if !errors.Is(err, io.EOF) {
@@ -405,10 +495,19 @@ func (c *cmdCp) parallelCopy(
 				w = bar.NewProxyWriter(w)
 			}
 
+			var h hash.Hash
+			if state != nil {
+				h = sha256.New()
+				w = io.MultiWriter(w, h)
+			}
+
 			_, err := sync2.Copy(ctx, w, rh)
 			if err == nil {
 				err = wh.Commit()
 			}
+			if err == nil && state != nil {
+				err = state.Complete(clctx, int64(i), h.Sum(nil))
+			}
 
 			if err != nil {
 				// TODO: it would be also nice to use wh.Abort and rh.Close directly
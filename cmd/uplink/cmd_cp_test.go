@@ -4,11 +4,14 @@
 package main
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/memory"
+	"storj.io/storj/cmd/uplink/ulext"
 	"storj.io/storj/cmd/uplink/ultest"
 )
 
@@ -75,6 +78,39 @@ func TestCpDownload(t *testing.T) {
 		state.Succeed(t, "cp", "sj://user/fo", "/home/user/dest", "--recursive").RequireLocalFiles(t)
 	})
 
+	t.Run("RecursiveFilters", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/file1.txt", "data1"),
+			ultest.WithFile("sj://user/file2.jpg", "data2"),
+			ultest.WithFile("sj://user/folder1/file3.txt", "data3"),
+			ultest.WithFile("sj://user/folder1/file4.tmp", "data4"),
+			ultest.WithFile("sj://user/folder1/folder2/file5.txt", "data5"),
+		)
+
+		state.Succeed(t, "cp", "sj://user", "/home/user/dest", "--recursive",
+			"--include", "*.txt", "--exclude", "folder1/folder2/*",
+		).RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/dest/file1.txt", Contents: "data1"},
+			ultest.File{Loc: "/home/user/dest/folder1/file3.txt", Contents: "data3"},
+		)
+
+		state.Succeed(t, "cp", "sj://user/folder1", "/home/user/dest", "--recursive", "--max-depth", "1").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/dest/folder1/file3.txt", Contents: "data3"},
+			ultest.File{Loc: "/home/user/dest/folder1/file4.tmp", Contents: "data4"},
+		)
+
+		// the files are created one second apart starting at the unix epoch.
+		state.Succeed(t, "cp", "sj://user", "/home/user/dest", "--recursive",
+			"--newer-than", "1970-01-01T00:00:01Z", "--older-than", "1970-01-01T00:00:04Z",
+		).RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/dest/file2.jpg", Contents: "data2"},
+			ultest.File{Loc: "/home/user/dest/folder1/file3.txt", Contents: "data3"},
+		)
+
+		state.Fail(t, "cp", "sj://user/file1.txt", "/home/user/dest", "--include", "*.txt")
+		state.Fail(t, "cp", "sj://user", "/home/user/dest", "--recursive", "--include", "[")
+	})
+
 	t.Run("Range", func(t *testing.T) {
 		state := ultest.Setup(commands,
 			ultest.WithFile("sj://user/file-for-byte-range", "abcdefghijklmnopqrstuvwxyz"),
@@ -96,6 +132,90 @@ func TestCpDownload(t *testing.T) {
 	})
 }
 
+func TestCpDownloadContinue(t *testing.T) {
+	downloadState := func(t *testing.T, length int64, contents string) string {
+		hash := sha256.Sum256([]byte(contents))
+		data, err := json.Marshal(downloadProgress{
+			Source:   "sj://user/file.txt",
+			Length:   length,
+			PartSize: memory.MiB.Int64() * 64,
+			Parts:    map[int64][]byte{0: hash[:]},
+		})
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	t.Run("Fresh", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/file.txt", "remote"),
+			ultest.WithFile("/home/user/file.txt", "a longer local file"),
+		)
+
+		state.Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--continue").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "remote"},
+		)
+	})
+
+	t.Run("SkipVerifiedParts", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/file.txt", "remote"),
+			ultest.WithFile("/home/user/file.txt", "REMOTE"),
+			ultest.WithFile("/home/user/file.txt.uplink-state", downloadState(t, 6, "REMOTE")),
+		)
+
+		state.Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--continue").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "REMOTE"},
+		)
+	})
+
+	t.Run("CorruptedParts", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/file.txt", "remote"),
+			ultest.WithFile("/home/user/file.txt", "XXXXXX"),
+			ultest.WithFile("/home/user/file.txt.uplink-state", downloadState(t, 6, "REMOTE")),
+		)
+
+		state.Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--continue").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "remote"},
+		)
+	})
+
+	t.Run("DifferentObject", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/file.txt", "remote"),
+			ultest.WithFile("/home/user/file.txt", "REMOT"),
+			ultest.WithFile("/home/user/file.txt.uplink-state", downloadState(t, 5, "REMOT")),
+		)
+
+		state.Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--continue").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "remote"},
+		)
+	})
+
+	t.Run("CorruptedState", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/file.txt", "remote"),
+			ultest.WithFile("/home/user/file.txt", "REMOTE"),
+			ultest.WithFile("/home/user/file.txt.uplink-state", "{"),
+		)
+
+		state.Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--continue").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "remote"},
+		)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/file.txt", "remote"),
+			ultest.WithFile("/home/user/file.txt", "local"),
+		)
+
+		state.Fail(t, "cp", "/home/user/file.txt", "sj://user/file2.txt", "--continue")
+		state.Fail(t, "cp", "sj://user/file.txt", "-", "--continue")
+		state.Fail(t, "cp", "sj://user/file.txt", "/home/user/file2.txt", "--continue", "--range", "bytes=0-1")
+	})
+}
+
 func TestCpPartSize(t *testing.T) {
 	c := newCmdCp(nil)
 
@@ -211,6 +331,37 @@ func TestCpUpload(t *testing.T) {
 	})
 }
 
+func TestCpLimitRate(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/file1.txt", "local"),
+		ultest.WithFile("sj://user/file1.txt", "remote"),
+	)
+
+	t.Run("Upload", func(t *testing.T) {
+		state.Succeed(t, "cp", "--limit-rate", "1MiB", "/home/user/file1.txt", "sj://user/file2.txt").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/file1.txt", Contents: "remote"},
+			ultest.File{Loc: "sj://user/file2.txt", Contents: "local"},
+		)
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		state.Succeed(t, "cp", "--limit-rate", "10KB", "sj://user/file1.txt", "/home/user/file2.txt").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file1.txt", Contents: "local"},
+			ultest.File{Loc: "/home/user/file2.txt", Contents: "remote"},
+		)
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		c := newCmdCp(nil)
+		c.parallelism = 1
+
+		require.Zero(t, ulext.LoadOptions(c.filesystemOptions()...).RateLimit)
+
+		c.limitRate = memory.MiB
+		require.Equal(t, memory.MiB.Int64(), ulext.LoadOptions(c.filesystemOptions()...).RateLimit)
+	})
+}
+
 func TestCpRecursiveDifficult(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		state := ultest.Setup(commands,
@@ -521,4 +672,5 @@ func TestCpInputValidation(t *testing.T) {
 	state := ultest.Setup(commands)
 
 	state.Fail(t, "cp", "/home/user/file1.txt", "sj://testbucket/", "--parallelism-chunk-size", "-1")
+	state.Fail(t, "cp", "/home/user/file1.txt", "sj://testbucket/", "--limit-rate", "-1")
 }
@@ -0,0 +1,233 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/grant"
+	"storj.io/common/identity"
+	"storj.io/common/pb"
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink/private/metaclient"
+	"storj.io/uplink/private/piecestore"
+)
+
+// ensures that cmdInspectObject implements clingy.Command.
+var _ clingy.Command = (*cmdInspectObject)(nil)
+
+// cmdInspectObject prints the segments and the pieces of an object, for
+// debugging download failures.
+type cmdInspectObject struct {
+	ex ulext.External
+
+	access  string
+	check   bool
+	timeout time.Duration
+
+	location ulloc.Location
+}
+
+func newCmdInspectObject(ex ulext.External) *cmdInspectObject {
+	return &cmdInspectObject{ex: ex}
+}
+
+func (c *cmdInspectObject) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.check = params.Flag("check", "Check the availability of every piece by downloading its first byte", true,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.timeout = params.Flag("timeout", "Timeout of the availability check of a single piece", 10*time.Second,
+		clingy.Transform(time.ParseDuration),
+	).(time.Duration)
+
+	c.location = params.Arg("location", "Location of object (sj://BUCKET/KEY)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+}
+
+func (c *cmdInspectObject) Execute(ctx clingy.Context) error {
+	bucket, key, ok := c.location.RemoteParts()
+	if !ok {
+		return errs.New("location must be remote")
+	}
+
+	access, err := c.ex.OpenAccess(c.access)
+	if err != nil {
+		return err
+	}
+	serializedAccess, err := access.Serialize()
+	if err != nil {
+		return errs.New("could not serialize access: %+v", err)
+	}
+	parsedAccess, err := grant.ParseAccess(serializedAccess)
+	if err != nil {
+		return errs.New("could not parse access: %+v", err)
+	}
+
+	dialer, err := newInspectDialer(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, err := metaclient.DialNodeURL(ctx, dialer, parsedAccess.SatelliteAddress, parsedAccess.APIKey, "uplink-cli")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { _ = client.Close() }()
+
+	object, err := metaclient.New(client, parsedAccess.EncAccess.Store).GetObject(ctx, bucket, key)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	fmt.Fprintf(ctx.Stdout(), "Object:    %s\n", c.location)
+	fmt.Fprintf(ctx.Stdout(), "Stream ID: %s\n", object.ID)
+	fmt.Fprintf(ctx.Stdout(), "Size:      %d\n", object.Size)
+	fmt.Fprintf(ctx.Stdout(), "Segments:  %d\n", object.SegmentCount)
+
+	var cursor storj.SegmentPosition
+	for {
+		segments, err := client.ListSegments(ctx, metaclient.ListSegmentsParams{
+			StreamID: object.ID,
+			Cursor:   cursor,
+		})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		for _, segment := range segments.Items {
+			if err := c.inspectSegment(ctx, dialer, client, object.ID, segment); err != nil {
+				return err
+			}
+			cursor = segment.Position
+		}
+
+		if !segments.More || len(segments.Items) == 0 {
+			return nil
+		}
+	}
+}
+
+// inspectSegment prints the pieces of the segment and their availability.
+func (c *cmdInspectObject) inspectSegment(ctx clingy.Context, dialer rpc.Dialer, client *metaclient.Client, streamID storj.StreamID, segment metaclient.SegmentListItem) error {
+	download, err := client.DownloadSegmentWithRS(ctx, metaclient.DownloadSegmentParams{
+		StreamID: streamID,
+		Position: segment.Position,
+	})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	fmt.Fprintf(ctx.Stdout(), "\nSegment %d/%d: offset %d, size %d, encrypted size %d\n",
+		segment.Position.PartNumber, segment.Position.Index,
+		segment.PlainOffset, segment.PlainSize, download.Info.EncryptedSize)
+
+	if len(download.Info.EncryptedInlineData) > 0 || len(download.Limits) == 0 {
+		fmt.Fprintln(ctx.Stdout(), "Inline segment, stored on the satellite")
+		return nil
+	}
+
+	rs := download.Info.RedundancyScheme
+
+	tw := newTabbedWriter(ctx.Stdout(), "PIECE", "NODE ID", "ADDRESS", "STATUS")
+	available := 0
+	for pieceNum, limit := range download.Limits {
+		if limit == nil {
+			continue
+		}
+
+		// without the check, every offered piece is assumed to be available.
+		status := "offered"
+		if c.check {
+			status = "ok"
+			if err := c.checkPiece(ctx, dialer, limit, download.Info.PiecePrivateKey); err != nil {
+				status = "failed: " + err.Error()
+			}
+		}
+		if status == "ok" || status == "offered" {
+			available++
+		}
+
+		tw.WriteLine(pieceNum, limit.Limit.StorageNodeId, limit.StorageNodeAddress.GetAddress(), status)
+	}
+	tw.Done()
+
+	fmt.Fprintf(ctx.Stdout(), "Redundancy: %d/%d/%d/%d, available pieces: %d, health: %s\n",
+		rs.RequiredShares, rs.RepairShares, rs.OptimalShares, rs.TotalShares,
+		available, segmentHealth(rs, available))
+	return nil
+}
+
+// checkPiece checks that the piece is available by downloading its first byte.
+func (c *cmdInspectObject) checkPiece(ctx context.Context, dialer rpc.Dialer, limit *pb.AddressedOrderLimit, piecePrivateKey storj.PiecePrivateKey) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	client, err := piecestore.Dial(ctx, dialer, storj.NodeURL{
+		ID:      limit.Limit.StorageNodeId,
+		Address: limit.StorageNodeAddress.GetAddress(),
+	}, piecestore.DefaultConfig)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	download, err := client.Download(ctx, limit.Limit, piecePrivateKey, 0, 1)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	_, err = io.ReadFull(download, make([]byte, 1))
+	return err
+}
+
+// segmentHealth describes the health of a segment with the given number of
+// available pieces.
+func segmentHealth(rs storj.RedundancyScheme, available int) string {
+	switch {
+	case available < int(rs.RequiredShares):
+		return "unrecoverable"
+	case available <= int(rs.RepairShares):
+		return "needs repair"
+	case available < int(rs.OptimalShares):
+		return "degraded"
+	default:
+		return "healthy"
+	}
+}
+
+// newInspectDialer creates a dialer with a new identity, like the uplink
+// library does.
+func newInspectDialer(ctx context.Context) (rpc.Dialer, error) {
+	ident, err := identity.NewFullIdentity(ctx, identity.NewCAOptions{
+		Difficulty:  0,
+		Concurrency: 1,
+	})
+	if err != nil {
+		return rpc.Dialer{}, errs.Wrap(err)
+	}
+
+	tlsOptions, err := tlsopts.NewOptions(ident, tlsopts.Config{
+		UsePeerCAWhitelist: false,
+		PeerIDVersions:     "0",
+	}, nil)
+	if err != nil {
+		return rpc.Dialer{}, errs.Wrap(err)
+	}
+
+	return rpc.NewDefaultDialer(tlsOptions), nil
+}
@@ -26,6 +26,7 @@ type cmdRm struct {
 	parallelism int
 	encrypted   bool
 	pending     bool
+	filter      recursiveFilter
 
 	location ulloc.Location
 }
@@ -57,6 +58,8 @@ func (c *cmdRm) Setup(params clingy.Parameters) {
 		clingy.Transform(strconv.ParseBool), clingy.Boolean,
 	).(bool)
 
+	c.filter.Setup(params)
+
 	c.location = params.Arg("location", "Location to remove (sj://BUCKET[/KEY])",
 		clingy.Transform(ulloc.Parse),
 	).(ulloc.Location)
@@ -74,6 +77,10 @@ func (c *cmdRm) Execute(ctx clingy.Context) error {
 	defer func() { _ = fs.Close() }()
 
 	if !c.recursive {
+		if c.filter.active() {
+			return errs.New("filters can only be used with a recursive remove")
+		}
+
 		err := fs.Remove(ctx, c.location, &ulfs.RemoveOptions{
 			Pending: c.pending,
 		})
@@ -114,7 +121,14 @@ func (c *cmdRm) Execute(ctx clingy.Context) error {
 	}
 
 	for iter.Next() {
-		loc := iter.Item().Loc
+		item := iter.Item()
+		if ok, err := c.filter.match(c.location, item); err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+
+		loc := item.Loc
 
 		ok := limiter.Go(ctx, func() {
 			err := fs.Remove(ctx, loc, &ulfs.RemoveOptions{
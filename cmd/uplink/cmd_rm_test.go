@@ -41,6 +41,28 @@ func TestRmRemote(t *testing.T) {
 		)
 	})
 
+	t.Run("Recursive Filters", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/files/file1.txt"),
+			ultest.WithFile("sj://user/files/file2.tmp"),
+			ultest.WithFile("sj://user/files/nested/file3.tmp"),
+			ultest.WithFile("sj://user/files/nested/file4.txt"),
+		)
+
+		state.Succeed(t, "rm", "sj://user/files", "-r", "--include", "*.tmp", "--max-depth", "1").RequireFiles(t,
+			ultest.File{Loc: "sj://user/files/file1.txt"},
+			ultest.File{Loc: "sj://user/files/nested/file3.tmp"},
+			ultest.File{Loc: "sj://user/files/nested/file4.txt"},
+		)
+
+		state.Succeed(t, "rm", "sj://user/files", "-r", "--exclude", "nested/*").RequireFiles(t,
+			ultest.File{Loc: "sj://user/files/nested/file3.tmp"},
+			ultest.File{Loc: "sj://user/files/nested/file4.txt"},
+		)
+
+		state.Fail(t, "rm", "sj://user/files/file1.txt", "--exclude", "*.txt")
+	})
+
 	t.Run("Pending", func(t *testing.T) {
 		state := ultest.Setup(commands,
 			ultest.WithPendingFile("sj://user/files/file1.txt"),
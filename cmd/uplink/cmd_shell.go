@@ -0,0 +1,409 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+	"golang.org/x/term"
+
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink"
+)
+
+// shellCommands are the names completed at the start of a shell line: the
+// shell builtins followed by the top level uplink commands.
+var shellCommands = []string{
+	"cd", "exit", "help", "history", "pwd", "quit",
+	"access", "cp", "inspect", "ls", "mb", "meta", "mv", "rb", "rm", "setup", "share", "version",
+}
+
+// shellAccessCommands are the uplink commands, which accept the --access flag.
+var shellAccessCommands = map[string]bool{
+	"cp": true, "inspect": true, "ls": true, "mb": true, "meta": true,
+	"mv": true, "rb": true, "rm": true, "share": true,
+}
+
+const shellHelp = `Commands:
+    cd [PREFIX]     Change the working prefix, the root without PREFIX
+    pwd             Print the working prefix
+    history         Print the command history
+    help            Print this help
+    exit, quit      Leave the shell
+    COMMAND ...     Run any uplink command, e.g. ls, cp, rm or mb
+
+Locations:
+    sj://BUCKET/KEY is an absolute location, sj:KEY is relative to the
+    working prefix, and the arguments of cd are always relative to the
+    working prefix unless they start with sj:// or /.
+    Press tab to complete commands and locations.
+`
+
+type cmdShell struct {
+	ex   ulext.External
+	cmds func(clingy.Commands, ulext.External)
+
+	access      string
+	cacheTTL    time.Duration
+	historySize int
+
+	// wd is the working prefix without the sj:// scheme, e.g. "bucket/dir/".
+	// It's empty at the root, where the buckets are listed.
+	wd string
+
+	cache   *listingCache
+	history *shellHistory
+	fs      ulfs.Filesystem
+	project *uplink.Project
+}
+
+func newCmdShell(ex ulext.External, cmds func(clingy.Commands, ulext.External)) *cmdShell {
+	return &cmdShell{ex: ex, cmds: cmds}
+}
+
+func (c *cmdShell) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.cacheTTL = params.Flag("cache-ttl", "How long the listings used for tab completion are cached", 30*time.Second,
+		clingy.Transform(time.ParseDuration),
+	).(time.Duration)
+	c.historySize = params.Flag("history-size", "Number of commands kept in the history, which isn't saved when 0", 1000,
+		clingy.Transform(strconv.Atoi),
+	).(int)
+}
+
+func (c *cmdShell) Execute(ctx clingy.Context) (err error) {
+	c.cache = newListingCache(c.cacheTTL, c.list)
+	c.history = &shellHistory{size: c.historySize}
+	defer func() { err = errs.Combine(err, c.close()) }()
+
+	readLine := c.scriptReader(ctx)
+	if fd, ok := terminalFd(ctx.Stdin()); ok {
+		if c.historySize > 0 {
+			c.history.path = filepath.Join(filepath.Dir(c.ex.ConfigFile()), "shell_history")
+			if err := c.history.Load(); err != nil {
+				fmt.Fprintln(ctx.Stderr(), "unable to load the history:", err)
+			}
+		}
+		readLine = c.terminalReader(ctx, fd)
+		fmt.Fprintln(ctx.Stdout(), `Type "help" for help.`)
+	}
+
+	for {
+		line, err := readLine()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if errors.Is(err, errInterrupted) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if err := c.history.Add(line); err != nil {
+			fmt.Fprintln(ctx.Stderr(), "unable to save the history:", err)
+		}
+
+		exit, err := c.runLine(ctx, line)
+		if err != nil {
+			fmt.Fprintln(ctx.Stderr(), "error:", err)
+		}
+		if exit {
+			return nil
+		}
+	}
+}
+
+// scriptReader returns a function reading the lines of a non-interactive
+// standard input, e.g. a piped script. No prompt is printed.
+func (c *cmdShell) scriptReader(ctx clingy.Context) func() (string, error) {
+	scanner := bufio.NewScanner(ctx.Stdin())
+	return func() (string, error) {
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return "", errs.Wrap(err)
+			}
+			return "", io.EOF
+		}
+		return scanner.Text(), nil
+	}
+}
+
+// terminalReader returns a function reading the lines of an interactive
+// terminal with line editing, history and tab completion. The terminal is in
+// raw mode only while a line is read, so that the commands print normally.
+func (c *cmdShell) terminalReader(ctx clingy.Context, fd int) func() (string, error) {
+	editor := &lineEditor{
+		in:  bufio.NewReader(ctx.Stdin()),
+		out: ctx.Stdout(),
+		complete: func(line string) (string, []string) {
+			return c.complete(ctx, line)
+		},
+	}
+	return func() (_ string, err error) {
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return "", errs.Wrap(err)
+		}
+		defer func() { err = errs.Combine(err, term.Restore(fd, state)) }()
+
+		return editor.ReadLine(c.prompt(), c.history.Lines())
+	}
+}
+
+// terminalFd returns the file descriptor of r, when r is a terminal.
+func terminalFd(r io.Reader) (int, bool) {
+	fh, ok := r.(interface{ Fd() uintptr })
+	if !ok || !term.IsTerminal(int(fh.Fd())) {
+		return 0, false
+	}
+	return int(fh.Fd()), true
+}
+
+func (c *cmdShell) prompt() string {
+	return "uplink " + c.location() + "> "
+}
+
+// location returns the working prefix as a location.
+func (c *cmdShell) location() string {
+	return "sj://" + c.wd
+}
+
+// runLine runs a single shell line and returns whether the shell should exit.
+func (c *cmdShell) runLine(ctx clingy.Context, line string) (exit bool, err error) {
+	args, err := splitShellArgs(line)
+	if err != nil || len(args) == 0 {
+		return false, err
+	}
+
+	switch args[0] {
+	case "exit", "quit":
+		return true, nil
+	case "help":
+		fmt.Fprint(ctx.Stdout(), shellHelp)
+	case "pwd":
+		fmt.Fprintln(ctx.Stdout(), c.location())
+	case "cd":
+		return false, c.cd(args[1:])
+	case "history":
+		for i, line := range c.history.Lines() {
+			fmt.Fprintf(ctx.Stdout(), "%5d  %s\n", i+1, line)
+		}
+	case "shell":
+		return false, errs.New("already in the shell")
+	default:
+		err := c.runCommand(ctx, c.commandArgs(args))
+		// any command other than a listing may have changed the objects.
+		if args[0] != "ls" {
+			c.cache.Invalidate()
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+// commandArgs resolves the relative locations of the arguments of an uplink
+// command against the working prefix.
+func (c *cmdShell) commandArgs(args []string) []string {
+	resolved := make([]string, 0, len(args)+3)
+	hasPositional := false
+	for i, arg := range args {
+		if strings.HasPrefix(arg, "sj:") && !strings.HasPrefix(arg, "sj://") {
+			arg = "sj://" + joinRemote(c.wd, strings.TrimPrefix(arg, "sj:"))
+		}
+		if i > 0 && !strings.HasPrefix(arg, "-") {
+			hasPositional = true
+		}
+		resolved = append(resolved, arg)
+	}
+
+	// ls without a location lists the working prefix.
+	if args[0] == "ls" && !hasPositional && c.wd != "" {
+		resolved = append(resolved, c.location())
+	}
+	if c.access != "" && shellAccessCommands[args[0]] {
+		resolved = append(resolved, "--access", c.access)
+	}
+	return resolved
+}
+
+// runCommand runs an uplink command in the shell.
+func (c *cmdShell) runCommand(ctx clingy.Context, args []string) error {
+	// the usage is printed by clingy, when the arguments are invalid.
+	_, err := clingy.Environment{
+		Name: "uplink",
+		Args: args,
+
+		Stdin:  ctx.Stdin(),
+		Stdout: ctx.Stdout(),
+		Stderr: ctx.Stderr(),
+
+		Wrap: func(ctx clingy.Context, cmd clingy.Command) error {
+			return cmd.Execute(ctx)
+		},
+	}.Run(ctx, func(cmds clingy.Commands) {
+		c.cmds(cmds, c.ex)
+	})
+	return err
+}
+
+// cd changes the working prefix.
+func (c *cmdShell) cd(args []string) error {
+	if len(args) > 1 {
+		return errs.New("cd takes a single prefix")
+	}
+	if len(args) == 0 {
+		c.wd = ""
+		return nil
+	}
+
+	target := args[0]
+	switch {
+	case strings.HasPrefix(target, "sj://"):
+		target = joinRemote("", "/"+strings.TrimPrefix(target, "sj://"))
+	case strings.HasPrefix(target, "sj:"):
+		target = joinRemote(c.wd, strings.TrimPrefix(target, "sj:"))
+	default:
+		target = joinRemote(c.wd, target)
+	}
+	if target != "" && !strings.HasSuffix(target, "/") {
+		target += "/"
+	}
+
+	c.wd = target
+	return nil
+}
+
+// joinRemote joins a working prefix and a relative key. A rel starting with
+// "/" is relative to the root. The result keeps the trailing slash of rel,
+// and it's empty when it's the root.
+func joinRemote(wd, rel string) string {
+	if strings.HasPrefix(rel, "/") {
+		wd = ""
+	}
+	if rel == "" {
+		return wd
+	}
+
+	joined := path.Join("/", wd, rel)[1:]
+	if joined == "" {
+		return ""
+	}
+
+	base := path.Base(rel)
+	if strings.HasSuffix(rel, "/") || base == "." || base == ".." {
+		joined += "/"
+	}
+	return joined
+}
+
+// splitShellArgs splits a shell line into arguments. Arguments are separated
+// by spaces, which can be escaped with a backslash or quoted with single or
+// double quotes.
+func splitShellArgs(line string) (args []string, err error) {
+	var arg strings.Builder
+	var quote rune
+	inArg, escaped := false, false
+
+	for _, r := range line {
+		switch {
+		case escaped:
+			arg.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			inArg, escaped = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				arg.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			inArg, quote = true, r
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			inArg = true
+			arg.WriteRune(r)
+		}
+	}
+
+	if escaped || quote != 0 {
+		return nil, errs.New("unterminated quote or escape")
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
+
+// list lists the names in a remote directory for the tab completion. The
+// buckets are listed at the root and the names of the prefixes end with "/".
+func (c *cmdShell) list(ctx context.Context, dir string) (names []string, err error) {
+	if dir == "" {
+		if c.project == nil {
+			c.project, err = c.ex.OpenProject(ctx, c.access)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		iter := c.project.ListBuckets(ctx, nil)
+		for iter.Next() {
+			names = append(names, iter.Item().Name+"/")
+		}
+		return names, iter.Err()
+	}
+
+	if c.fs == nil {
+		c.fs, err = c.ex.OpenFilesystem(ctx, c.access)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bucket, key := dir, ""
+	if i := strings.IndexByte(dir, '/'); i >= 0 {
+		bucket, key = dir[:i], dir[i+1:]
+	}
+
+	iter, err := c.fs.List(ctx, ulloc.NewRemote(bucket, key), nil)
+	if err != nil {
+		return nil, err
+	}
+	for iter.Next() {
+		names = append(names, iter.Item().Loc.Loc())
+	}
+	return names, iter.Err()
+}
+
+func (c *cmdShell) close() error {
+	var group errs.Group
+	if c.fs != nil {
+		group.Add(c.fs.Close())
+	}
+	if c.project != nil {
+		group.Add(c.project.Close())
+	}
+	return group.Err()
+}
@@ -0,0 +1,270 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/cmd/uplink/ultest"
+)
+
+func TestShell(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/dir/a"),
+		ultest.WithFile("sj://user/dir/sub/b"),
+		ultest.WithFile("sj://user/top"),
+	)
+
+	t.Run("WorkingPrefix", func(t *testing.T) {
+		state.With(ultest.WithStdin(strings.Join([]string{
+			"pwd",
+			"cd sj://user/dir",
+			"pwd",
+			"ls --utc",
+			"cd sub",
+			"pwd",
+			"cd ../..",
+			"pwd",
+			"cd",
+			"pwd",
+		}, "\n"))).Succeed(t, "shell").RequireStdout(t, `
+			sj://
+			sj://user/dir/
+			KIND    CREATED                SIZE    KEY
+			OBJ     1970-01-01 00:00:01    0       a
+			PRE                                    sub/
+			sj://user/dir/sub/
+			sj://user/
+			sj://
+		`)
+	})
+
+	t.Run("RelativeLocations", func(t *testing.T) {
+		state.With(ultest.WithStdin(strings.Join([]string{
+			"cd sj://user/dir/",
+			"cp sj:a sj:sub/copy",
+			"rm sj:../top",
+		}, "\n"))).Succeed(t, "shell").RequireFiles(t,
+			ultest.File{Loc: "sj://user/dir/a"},
+			ultest.File{Loc: "sj://user/dir/sub/b"},
+			ultest.File{Loc: "sj://user/dir/sub/copy", Contents: "sj://user/dir/a"},
+		)
+	})
+
+	t.Run("Exit", func(t *testing.T) {
+		state.With(ultest.WithStdin("pwd\nexit\npwd\n")).Succeed(t, "shell").RequireStdout(t, `
+			sj://
+		`)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		result := state.With(ultest.WithStdin("cd 'unterminated\nshell\npwd\n")).Succeed(t, "shell")
+		result.RequireStdout(t, `sj://`)
+		result.RequireStderr(t, `
+			error: unterminated quote or escape
+			error: already in the shell
+		`)
+	})
+}
+
+func TestShellComplete(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	listings := map[string][]string{
+		"":                    {"photos/", "videos/"},
+		"photos/":             {"2021/", "2022/", "cover.jpg", "summer trip/"},
+		"photos/2022/":        {"a.jpg"},
+		"videos/":             {"clip.mp4"},
+		"photos/summer trip/": {"beach.jpg"},
+	}
+	var listed []string
+	shell := &cmdShell{}
+	shell.cache = newListingCache(time.Minute, func(ctx context.Context, dir string) ([]string, error) {
+		listed = append(listed, dir)
+		return listings[dir], nil
+	})
+
+	for _, tt := range []struct {
+		wd         string
+		line       string
+		completed  string
+		candidates []string
+	}{
+		{line: "p", completed: "pwd "},
+		{line: "c", completed: "c", candidates: []string{"cd", "cp"}},
+		{line: "ls sj://ph", completed: "ls sj://photos/"},
+		{line: "ls sj://photos/20", completed: "ls sj://photos/202", candidates: []string{"2021/", "2022/"}},
+		{line: "cp sj://photos/c", completed: "cp sj://photos/cover.jpg "},
+		{line: "cp sj://photos/s", completed: `cp sj://photos/summer\ trip/`},
+		{line: `cp sj://photos/summer\ trip/b`, completed: `cp sj://photos/summer\ trip/beach.jpg `},
+		{wd: "photos/", line: "cp sj:2022/", completed: "cp sj:2022/a.jpg "},
+		{wd: "photos/", line: "cd ../v", completed: "cd ../videos/"},
+		{wd: "photos/", line: "cd c", completed: "cd c"},
+		{wd: "photos/", line: "cp c", completed: "cp c"},
+	} {
+		shell.wd = tt.wd
+		completed, candidates := shell.complete(ctx, tt.line)
+		require.Equal(t, tt.completed, completed, tt.line)
+		require.Equal(t, tt.candidates, candidates, tt.line)
+	}
+
+	// the listings are cached until they're invalidated.
+	listed = nil
+	shell.wd = ""
+	shell.complete(ctx, "ls sj://photos/")
+	require.Empty(t, listed)
+
+	shell.cache.Invalidate()
+	shell.complete(ctx, "ls sj://photos/")
+	require.Equal(t, []string{"photos/"}, listed)
+}
+
+func TestListingCache(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	calls := 0
+	cache := newListingCache(time.Minute, func(ctx context.Context, dir string) ([]string, error) {
+		calls++
+		return []string{dir}, nil
+	})
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		names, err := cache.Get(ctx, "bucket/")
+		require.NoError(t, err)
+		require.Equal(t, []string{"bucket/"}, names)
+	}
+	require.Equal(t, 1, calls)
+
+	now = now.Add(time.Minute)
+	_, err := cache.Get(ctx, "bucket/")
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+}
+
+func TestJoinRemote(t *testing.T) {
+	for _, tt := range []struct {
+		wd, rel, joined string
+	}{
+		{"", "", ""},
+		{"bucket/dir/", "", "bucket/dir/"},
+		{"bucket/dir/", "key", "bucket/dir/key"},
+		{"bucket/dir/", "sub/", "bucket/dir/sub/"},
+		{"bucket/dir/", "..", "bucket/"},
+		{"bucket/dir/", "../other", "bucket/other"},
+		{"bucket/dir/", "../../..", ""},
+		{"bucket/dir/", ".", "bucket/dir/"},
+		{"bucket/dir/", "/other/key", "other/key"},
+		{"", "bucket", "bucket"},
+	} {
+		require.Equal(t, tt.joined, joinRemote(tt.wd, tt.rel), "%q %q", tt.wd, tt.rel)
+	}
+}
+
+func TestSplitShellArgs(t *testing.T) {
+	for _, tt := range []struct {
+		line string
+		args []string
+	}{
+		{"", nil},
+		{"ls", []string{"ls"}},
+		{"  cp  a   b ", []string{"cp", "a", "b"}},
+		{`cp "a b" 'c d'`, []string{"cp", "a b", "c d"}},
+		{`cp a\ b c`, []string{"cp", "a b", "c"}},
+		{`cp 'a\b' "c\"d"`, []string{"cp", `a\b`, `c"d`}},
+		{`cp ""`, []string{"cp", ""}},
+	} {
+		args, err := splitShellArgs(tt.line)
+		require.NoError(t, err, tt.line)
+		require.Equal(t, tt.args, args, tt.line)
+	}
+
+	for _, line := range []string{`cp "a`, `cp 'a`, `cp a\`} {
+		_, err := splitShellArgs(line)
+		require.Error(t, err, line)
+	}
+}
+
+func TestLineEditor(t *testing.T) {
+	readLine := func(input string, history []string) (string, error) {
+		editor := &lineEditor{
+			in:  bufio.NewReader(strings.NewReader(input)),
+			out: io.Discard,
+			complete: func(line string) (string, []string) {
+				if line == "ls sj://b" {
+					return "ls sj://bucket/", nil
+				}
+				return line, nil
+			},
+		}
+		return editor.ReadLine("> ", history)
+	}
+
+	line, err := readLine("ls\r", nil)
+	require.NoError(t, err)
+	require.Equal(t, "ls", line)
+
+	// backspace, cursor movement and deletion.
+	line, err = readLine("lx\x7fs -r\x1b[D\x1b[D\x1b[3~-\r", nil)
+	require.NoError(t, err)
+	require.Equal(t, "ls -r", line)
+
+	line, err = readLine("ls sj://b\t\r", nil)
+	require.NoError(t, err)
+	require.Equal(t, "ls sj://bucket/", line)
+
+	// the history is recalled with the up and down keys.
+	history := []string{"first", "second"}
+	line, err = readLine("\x1b[A\x1b[A\r", history)
+	require.NoError(t, err)
+	require.Equal(t, "first", line)
+
+	line, err = readLine("new\x1b[A\x1b[B\r", history)
+	require.NoError(t, err)
+	require.Equal(t, "new", line)
+
+	_, err = readLine("abc\x03", nil)
+	require.ErrorIs(t, err, errInterrupted)
+
+	_, err = readLine("\x04", nil)
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestShellHistory(t *testing.T) {
+	ctx := testcontext.New(t)
+	path := filepath.Join(ctx.Dir("history"), "shell_history")
+
+	history := &shellHistory{path: path, size: 3}
+	require.NoError(t, history.Load())
+	require.Empty(t, history.Lines())
+
+	for _, line := range []string{"ls", "ls", "pwd", "cd bucket", "ls"} {
+		require.NoError(t, history.Add(line))
+	}
+	require.Equal(t, []string{"pwd", "cd bucket", "ls"}, history.Lines())
+
+	// the saved history is trimmed when it's loaded.
+	loaded := &shellHistory{path: path, size: 2}
+	require.NoError(t, loaded.Load())
+	require.Equal(t, []string{"cd bucket", "ls"}, loaded.Lines())
+
+	loaded = &shellHistory{path: path, size: 10}
+	require.NoError(t, loaded.Load())
+	require.Equal(t, []string{"cd bucket", "ls"}, loaded.Lines())
+
+	// the history isn't kept when the size is 0.
+	disabled := &shellHistory{size: 0}
+	require.NoError(t, disabled.Add("ls"))
+	require.Empty(t, disabled.Lines())
+}
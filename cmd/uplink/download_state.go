@@ -0,0 +1,263 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+// downloadStateSuffix is appended to the path of the destination file to name
+// the file which records the progress of the download.
+const downloadStateSuffix = ".uplink-state"
+
+// downloadProgress is the contents of the download state file.
+type downloadProgress struct {
+	Source   string    `json:"source"`
+	Created  time.Time `json:"created"`
+	Length   int64     `json:"length"`
+	PartSize int64     `json:"partSize"`
+	// Parts contains the SHA-256 hashes of the downloaded parts by their index.
+	Parts map[int64][]byte `json:"parts"`
+}
+
+// sameObject returns true when both progresses are about downloading the same
+// version of an object with the same part size.
+func (progress *downloadProgress) sameObject(other *downloadProgress) bool {
+	return progress.Source == other.Source &&
+		progress.Created.Equal(other.Created) &&
+		progress.Length == other.Length &&
+		progress.PartSize == other.PartSize
+}
+
+// partRange returns the offset and the length of the part with the given index.
+func (progress *downloadProgress) partRange(index int64) (offset, length int64) {
+	offset = index * progress.PartSize
+	length = progress.PartSize
+	if offset+length > progress.Length {
+		length = progress.Length - offset
+	}
+	return offset, length
+}
+
+// downloadState records which parts of a download were written into the local
+// destination file, so that an interrupted download can be continued by
+// downloading only the missing parts.
+type downloadState struct {
+	fs  ulfs.Filesystem
+	loc ulloc.Location
+
+	mu       sync.Mutex
+	progress downloadProgress
+}
+
+// openDownloadState loads the download state of the dest file. The parts which
+// were recorded as downloaded are kept only when their hash still matches the
+// contents of the dest file. The dest file is removed when the state is missing
+// or it's about a different object, so that the download starts over.
+func openDownloadState(ctx clingy.Context, fs ulfs.Filesystem, src ulfs.MultiReadHandle, source, dest ulloc.Location, partSize int64) (_ *downloadState, err error) {
+	path, ok := dest.LocalParts()
+	if !ok {
+		return nil, errs.New("unable to continue download to %q", dest)
+	}
+
+	info, err := src.Info(ctx)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	state := &downloadState{
+		fs:  fs,
+		loc: ulloc.NewLocal(path + downloadStateSuffix),
+		progress: downloadProgress{
+			Source:   source.String(),
+			Created:  info.Created,
+			Length:   info.ContentLength,
+			PartSize: partSize,
+			Parts:    make(map[int64][]byte),
+		},
+	}
+
+	previous, err := state.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous != nil && previous.sameObject(&state.progress) {
+		if err := state.verifyParts(ctx, dest, previous.Parts); err != nil {
+			return nil, err
+		}
+	} else if err := fs.Remove(ctx, dest, nil); err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return state, state.save(ctx)
+}
+
+// load reads the previously stored progress. It returns nil when there is no
+// stored progress, or it's unreadable.
+func (state *downloadState) load(ctx clingy.Context) (_ *downloadProgress, err error) {
+	mrh, err := state.fs.Open(ctx, state.loc)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer func() { _ = mrh.Close() }()
+
+	rh, err := mrh.NextPart(ctx, -1)
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer func() { _ = rh.Close() }()
+
+	data, err := ioutil.ReadAll(rh)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	var progress downloadProgress
+	if err := json.Unmarshal(data, &progress); err != nil {
+		// the download starts over when the state file is corrupted.
+		return nil, nil
+	}
+	return &progress, nil
+}
+
+// verifyParts hashes the dest file contents of every part and keeps the parts
+// which match their recorded hash.
+func (state *downloadState) verifyParts(ctx clingy.Context, dest ulloc.Location, parts map[int64][]byte) (err error) {
+	if len(parts) == 0 {
+		return nil
+	}
+
+	mrh, err := state.fs.Open(ctx, dest)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { _ = mrh.Close() }()
+
+	for index, expected := range parts {
+		offset, length := state.progress.partRange(index)
+		if index < 0 || length <= 0 {
+			continue
+		}
+
+		ok, err := hashMatches(ctx, mrh, offset, length, expected)
+		if err != nil {
+			return err
+		}
+		if ok {
+			state.progress.Parts[index] = expected
+		}
+	}
+	return nil
+}
+
+// hashMatches returns true when the SHA-256 hash of length bytes at offset
+// equals to expected.
+func hashMatches(ctx context.Context, mrh ulfs.MultiReadHandle, offset, length int64, expected []byte) (bool, error) {
+	if offset+length > mrh.Length() {
+		return false, nil
+	}
+	if err := mrh.SetOffset(offset); err != nil {
+		return false, errs.Wrap(err)
+	}
+	rh, err := mrh.NextPart(ctx, length)
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	defer func() { _ = rh.Close() }()
+
+	h := sha256.New()
+	n, err := io.Copy(h, rh)
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return n == length && bytes.Equal(h.Sum(nil), expected), nil
+}
+
+// Downloaded returns true when the part with the given index was already
+// downloaded.
+func (state *downloadState) Downloaded(index int64) bool {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	_, ok := state.progress.Parts[index]
+	return ok
+}
+
+// PartEnd returns the offset right after the part with the given index.
+func (state *downloadState) PartEnd(index int64) int64 {
+	offset, length := state.progress.partRange(index)
+	return offset + length
+}
+
+// Complete records the part with the given index as downloaded.
+func (state *downloadState) Complete(ctx clingy.Context, index int64, hash []byte) error {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	state.progress.Parts[index] = hash
+	return state.save(ctx)
+}
+
+// Remove deletes the state file once the download is finished.
+func (state *downloadState) Remove(ctx context.Context) error {
+	return errs.Wrap(state.fs.Remove(ctx, state.loc, nil))
+}
+
+// save writes the progress into a temporary file first and then moves it over
+// the state file, so that an interruption doesn't corrupt the state.
+func (state *downloadState) save(ctx clingy.Context) (err error) {
+	data, err := json.Marshal(state.progress)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	path, _ := state.loc.LocalParts()
+	tmp := ulloc.NewLocal(path + ".tmp")
+
+	mwh, err := state.fs.Create(ctx, tmp, nil)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { _ = mwh.Abort(ctx) }()
+
+	wh, err := mwh.NextPart(ctx, -1)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { _ = wh.Abort() }()
+
+	if _, err := wh.Write(data); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := wh.Commit(); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := mwh.Commit(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+
+	return errs.Wrap(state.fs.Move(ctx, tmp, state.loc))
+}
@@ -25,11 +25,13 @@ import (
 	"storj.io/common/rpc/rpctracing"
 	jaeger "storj.io/monkit-jaeger"
 	"storj.io/private/version"
+	"storj.io/storj/cmd/uplink/ulmetrics"
 )
 
 type external struct {
-	interactive bool // controls if interactive input is allowed
-	quic        bool // if set, use the quic transport
+	interactive bool          // controls if interactive input is allowed
+	quic        bool          // if set, use the quic transport
+	dialTimeout time.Duration // how long to wait for a connection to a peer
 
 	dirs struct {
 		loaded  bool   // true if Setup has been called
@@ -57,6 +59,11 @@ type external struct {
 		traceID      int64  // if non-zero, sets outgoing traces to the given id
 		traceAddress string // if non-zero, sampled spans are sent to this trace collector address.
 	}
+
+	metrics struct {
+		statsdAddress string        // if non-empty, transfer metrics are sent to this statsd address
+		interval      time.Duration // how often the transfer metrics are sent
+	}
 }
 
 func newExternal() *external {
@@ -76,6 +83,12 @@ func (ex *external) Setup(f clingy.Flags) {
 		clingy.Advanced,
 	).(bool)
 
+	ex.dialTimeout = f.Flag(
+		"dial-timeout", "How long to wait for a connection to a storage node before giving up on its piece", time.Duration(0),
+		clingy.Transform(time.ParseDuration),
+		clingy.Advanced,
+	).(time.Duration)
+
 	ex.dirs.current = f.Flag(
 		"config-dir", "Directory that stores the configuration",
 		appDir(false, defaultUplinkSubdir()...),
@@ -98,6 +111,17 @@ func (ex *external) Setup(f clingy.Flags) {
 		clingy.Advanced,
 	).(string)
 
+	ex.metrics.statsdAddress = f.Flag(
+		"statsd-addr", "Specify a statsd address to send the client-side transfer metrics to", "",
+		clingy.Advanced,
+	).(string)
+
+	ex.metrics.interval = f.Flag(
+		"statsd-interval", "How often the client-side transfer metrics are sent", 10*time.Second,
+		clingy.Transform(time.ParseDuration),
+		clingy.Advanced,
+	).(time.Duration)
+
 	ex.dirs.loaded = true
 }
 
@@ -170,6 +194,15 @@ func (ex *external) Wrap(ctx clingy.Context, cmd clingy.Command) (err error) {
 		defer cancel()
 	}
 
+	if ex.metrics.statsdAddress != "" {
+		exporter, err := ulmetrics.NewStatsD(ex.metrics.statsdAddress, "uplink.")
+		if err != nil {
+			return err
+		}
+		stop := exportMetrics(exporter, ex.metrics.interval)
+		defer func() { err = errs.Combine(err, stop()) }()
+	}
+
 	if ex.tracing.traceID != 0 {
 		trace := monkit.NewTrace(ex.tracing.traceID)
 		trace.Set(rpctracing.Sampled, true)
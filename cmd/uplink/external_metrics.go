@@ -0,0 +1,57 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/cmd/uplink/ulmetrics"
+)
+
+// exportMetrics starts collecting the client-side transfer metrics and sends
+// them to the exporter every interval. The returned function sends the final
+// metrics and closes the exporter.
+func exportMetrics(exporter ulmetrics.Exporter, interval time.Duration) (stop func() error) {
+	collector := ulmetrics.NewCollector(monkit.Default)
+	stopCollecting := collector.Start()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	if interval > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					// failing to send intermediate metrics is not fatal, the
+					// final metrics are sent when the command finishes.
+					if err := exporter.Export(ctx, collector.Metrics()); err != nil {
+						zap.L().Debug("failed to export metrics", zap.Error(err))
+					}
+				}
+			}
+		}()
+	}
+
+	return func() error {
+		cancel()
+		wg.Wait()
+		stopCollecting()
+
+		err := exporter.Export(context.Background(), collector.Metrics())
+		return errs.Combine(err, exporter.Close())
+	}
+}
@@ -18,11 +18,13 @@ import (
 const uplinkCLIUserAgent = "uplink-cli"
 
 func (ex *external) OpenFilesystem(ctx context.Context, accessName string, options ...ulext.Option) (ulfs.Filesystem, error) {
+	opts := ulext.LoadOptions(options...)
+
 	project, err := ex.OpenProject(ctx, accessName, options...)
 	if err != nil {
 		return nil, err
 	}
-	return ulfs.NewMixed(ulfs.NewLocal(ulfs.NewLocalBackendOS()), ulfs.NewRemote(project)), nil
+	return ulfs.NewMixed(ulfs.NewLocal(ulfs.NewLocalBackendOS()), ulfs.NewRemote(project, ulfs.NewThrottle(opts.RateLimit))), nil
 }
 
 func (ex *external) OpenProject(ctx context.Context, accessName string, options ...ulext.Option) (*uplink.Project, error) {
@@ -40,7 +42,8 @@ func (ex *external) OpenProject(ctx context.Context, accessName string, options
 	}
 
 	config := uplink.Config{
-		UserAgent: uplinkCLIUserAgent,
+		UserAgent:   uplinkCLIUserAgent,
+		DialTimeout: ex.dialTimeout,
 	}
 
 	if ex.quic {
@@ -63,6 +63,10 @@ func commands(cmds clingy.Commands, ex ulext.External) {
 	cmds.Group("meta", "Object metadata related commands", func() {
 		cmds.New("get", "Get an object's metadata", newCmdMetaGet(ex))
 	})
+	cmds.Group("inspect", "Debugging commands for power users", func() {
+		cmds.New("object", "Inspect the segments and the pieces of an object", newCmdInspectObject(ex))
+	})
 	cmds.New("share", "Shares restricted accesses to objects", newCmdShare(ex))
+	cmds.New("shell", "Interactive shell with tab completion of buckets and prefixes", newCmdShell(ex, commands))
 	cmds.New("version", "Prints version information", newCmdVersion())
 }
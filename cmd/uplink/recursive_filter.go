@@ -0,0 +1,125 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+// recursiveFilter selects the objects a recursive command operates on.
+//
+// Glob patterns and the depth are matched against the path of the object
+// relative to the location the command operates on. Patterns containing a
+// slash are matched against the whole relative path, other patterns only
+// against the last element of it.
+type recursiveFilter struct {
+	include   []string
+	exclude   []string
+	newerThan time.Time
+	olderThan time.Time
+	maxDepth  int
+}
+
+func (rf *recursiveFilter) Setup(params clingy.Parameters) {
+	rf.include = params.Flag("include", "Only process objects matching the glob pattern (e.g. '*.jpg', 'photos/*')", []string{},
+		clingy.Transform(parseGlob),
+		clingy.Repeated,
+	).([]string)
+	rf.exclude = params.Flag("exclude", "Skip objects matching the glob pattern (e.g. '*.tmp', 'cache/*')", []string{},
+		clingy.Transform(parseGlob),
+		clingy.Repeated,
+	).([]string)
+
+	rf.newerThan = params.Flag("newer-than",
+		"Only process objects created after this time (e.g. '-24h', '2020-01-02T15:04:05Z0700')",
+		time.Time{}, clingy.Transform(parseHumanDate), clingy.Type("relative_date")).(time.Time)
+	rf.olderThan = params.Flag("older-than",
+		"Only process objects created before this time (e.g. '-24h', '2020-01-02T15:04:05Z0700')",
+		time.Time{}, clingy.Transform(parseHumanDate), clingy.Type("relative_date")).(time.Time)
+
+	rf.maxDepth = params.Flag("max-depth", "Only process objects at most this many levels below the location, 0 means unlimited", 0,
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n < 0 {
+				return 0, errs.New("max-depth cannot be below 0")
+			}
+			return n, nil
+		}),
+	).(int)
+}
+
+// active returns true if any filter is set.
+func (rf *recursiveFilter) active() bool {
+	return len(rf.include) > 0 || len(rf.exclude) > 0 ||
+		!rf.newerThan.IsZero() || !rf.olderThan.IsZero() ||
+		rf.maxDepth > 0
+}
+
+// match returns true if the object listed by the recursive command on root
+// should be processed.
+func (rf *recursiveFilter) match(root ulloc.Location, info ulfs.ObjectInfo) (bool, error) {
+	if !rf.active() {
+		return true, nil
+	}
+
+	rel, err := filterPath(root, info.Loc)
+	if err != nil {
+		return false, err
+	}
+
+	if rf.maxDepth > 0 && strings.Count(rel, "/")+1 > rf.maxDepth {
+		return false, nil
+	}
+	if !rf.newerThan.IsZero() && !info.Created.After(rf.newerThan) {
+		return false, nil
+	}
+	if !rf.olderThan.IsZero() && !info.Created.Before(rf.olderThan) {
+		return false, nil
+	}
+	if len(rf.include) > 0 && !matchesAnyGlob(rf.include, rel) {
+		return false, nil
+	}
+	return !matchesAnyGlob(rf.exclude, rel), nil
+}
+
+// filterPath returns the path of loc relative to root. When root is a
+// directory the path doesn't contain its name.
+func filterPath(root, loc ulloc.Location) (string, error) {
+	if rel, err := root.AsDirectoryish().RelativeTo(loc); err == nil {
+		return rel, nil
+	}
+	return root.RelativeTo(loc)
+}
+
+func matchesAnyGlob(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(strings.TrimSuffix(rel, "/"))
+		}
+		// the patterns are validated when they are parsed.
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// parseGlob validates command-line flags which accept glob patterns.
+// It can be passed to clingy.Transform to create a clingy.Option.
+func parseGlob(pattern string) (string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return "", errs.New("invalid glob pattern %q: %v", pattern, err)
+	}
+	return pattern, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// listingCache caches the directory listings used for the tab completion, so
+// that pressing tab repeatedly doesn't list the same directory again.
+type listingCache struct {
+	ttl  time.Duration
+	list func(ctx context.Context, dir string) ([]string, error)
+	now  func() time.Time
+
+	entries map[string]cachedListing
+}
+
+type cachedListing struct {
+	names    []string
+	cachedAt time.Time
+}
+
+func newListingCache(ttl time.Duration, list func(ctx context.Context, dir string) ([]string, error)) *listingCache {
+	return &listingCache{
+		ttl:     ttl,
+		list:    list,
+		now:     time.Now,
+		entries: make(map[string]cachedListing),
+	}
+}
+
+// Get returns the names in the directory, listing it when it isn't cached or
+// the cached listing is older than the ttl.
+func (cache *listingCache) Get(ctx context.Context, dir string) ([]string, error) {
+	now := cache.now()
+	if entry, ok := cache.entries[dir]; ok && now.Sub(entry.cachedAt) < cache.ttl {
+		return entry.names, nil
+	}
+
+	names, err := cache.list(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	cache.entries[dir] = cachedListing{names: names, cachedAt: now}
+	return names, nil
+}
+
+// Invalidate drops all the cached listings.
+func (cache *listingCache) Invalidate() {
+	cache.entries = make(map[string]cachedListing)
+}
+
+// complete completes the last word of line. It returns the completed line and,
+// when the word is ambiguous, the candidates for it.
+func (c *cmdShell) complete(ctx context.Context, line string) (completed string, candidates []string) {
+	start := lastWordStart(line)
+	word := unescapeWord(line[start:])
+	previous := strings.Fields(line[:start])
+
+	var wordPrefix string
+	var names []string
+	switch {
+	case len(previous) == 0:
+		for _, name := range shellCommands {
+			if strings.HasPrefix(name, word) {
+				names = append(names, name)
+			}
+		}
+	case strings.HasPrefix(word, "sj://"):
+		wordPrefix, names = c.completeRemote(ctx, "sj://", "", strings.TrimPrefix(word, "sj://"), false)
+	case strings.HasPrefix(word, "sj:"):
+		wordPrefix, names = c.completeRemote(ctx, "sj:", c.wd, strings.TrimPrefix(word, "sj:"), false)
+	case previous[0] == "cd":
+		wordPrefix, names = c.completeRemote(ctx, "", c.wd, word, true)
+	}
+	if len(names) == 0 {
+		return line, nil
+	}
+
+	common := names[0]
+	for _, name := range names[1:] {
+		common = commonPrefix(common, name)
+	}
+
+	completed = line[:start] + escapeWord(wordPrefix+common)
+	if len(names) > 1 {
+		return completed, names
+	}
+	// a complete name, other than a prefix, ends the word.
+	if !strings.HasSuffix(common, "/") {
+		completed += " "
+	}
+	return completed, nil
+}
+
+// completeRemote returns the names in the remote directory of rel, which start
+// with the last part of rel. The completed word is wordPrefix followed by one
+// of the names. The directory is relative to wd, and only the prefixes are
+// returned when dirsOnly is true.
+func (c *cmdShell) completeRemote(ctx context.Context, scheme, wd, rel string, dirsOnly bool) (wordPrefix string, names []string) {
+	dirPart := rel[:strings.LastIndexByte(rel, '/')+1]
+	partial := rel[len(dirPart):]
+
+	dir := joinRemote(wd, dirPart)
+	if dir != "" && !strings.HasSuffix(dir, "/") {
+		dir += "/"
+	}
+
+	listing, err := c.cache.Get(ctx, dir)
+	if err != nil {
+		return "", nil
+	}
+
+	for _, name := range listing {
+		if dirsOnly && !strings.HasSuffix(name, "/") {
+			continue
+		}
+		if strings.HasPrefix(name, partial) {
+			names = append(names, name)
+		}
+	}
+	return scheme + dirPart, names
+}
+
+// lastWordStart returns the index where the last word of line starts.
+func lastWordStart(line string) int {
+	for i := len(line) - 1; i >= 0; i-- {
+		if line[i] == ' ' && (i == 0 || line[i-1] != '\\') {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func escapeWord(word string) string {
+	return strings.ReplaceAll(word, " ", `\ `)
+}
+
+func unescapeWord(word string) string {
+	return strings.ReplaceAll(word, `\ `, " ")
+}
+
+func commonPrefix(a, b string) string {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return a[:n]
+}
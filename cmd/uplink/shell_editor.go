@@ -0,0 +1,251 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// errInterrupted is returned when the line is interrupted with ctrl-c.
+var errInterrupted = errs.New("interrupted")
+
+const (
+	keyCtrlA     = 1
+	keyCtrlC     = 3
+	keyCtrlD     = 4
+	keyCtrlE     = 5
+	keyTab       = 9
+	keyEnter     = 13
+	keyCtrlU     = 21
+	keyEscape    = 27
+	keyBackspace = 127
+)
+
+// lineEditor reads lines from a terminal in raw mode, with cursor movement,
+// history and tab completion.
+type lineEditor struct {
+	in  *bufio.Reader
+	out io.Writer
+
+	// complete returns the completed line and, when the completion is
+	// ambiguous, the candidates to print.
+	complete func(line string) (completed string, candidates []string)
+
+	line []rune
+	pos  int
+}
+
+// ReadLine prints the prompt and reads a line. The previous lines of history
+// are recalled with the up and down keys.
+func (e *lineEditor) ReadLine(prompt string, history []string) (string, error) {
+	e.line, e.pos = nil, 0
+	// historyPos is len(history) while editing a new line.
+	historyPos := len(history)
+	var edited string
+
+	e.redraw(prompt)
+	for {
+		key, _, err := e.in.ReadRune()
+		if err != nil {
+			return "", err
+		}
+
+		switch key {
+		case keyEnter, '\n':
+			fmt.Fprint(e.out, "\r\n")
+			return string(e.line), nil
+		case keyCtrlC:
+			fmt.Fprint(e.out, "^C\r\n")
+			return "", errInterrupted
+		case keyCtrlD:
+			if len(e.line) == 0 {
+				fmt.Fprint(e.out, "\r\n")
+				return "", io.EOF
+			}
+			e.delete()
+		case keyBackspace, '\b':
+			if e.pos > 0 {
+				e.pos--
+				e.delete()
+			}
+		case keyCtrlA:
+			e.pos = 0
+		case keyCtrlE:
+			e.pos = len(e.line)
+		case keyCtrlU:
+			e.line, e.pos = e.line[e.pos:], 0
+		case keyTab:
+			e.completeLine(prompt)
+		case keyEscape:
+			switch e.readEscape() {
+			case 'A':
+				if historyPos > 0 {
+					if historyPos == len(history) {
+						edited = string(e.line)
+					}
+					historyPos--
+					e.setLine(history[historyPos])
+				}
+			case 'B':
+				if historyPos < len(history) {
+					historyPos++
+					if historyPos == len(history) {
+						e.setLine(edited)
+					} else {
+						e.setLine(history[historyPos])
+					}
+				}
+			case 'C':
+				if e.pos < len(e.line) {
+					e.pos++
+				}
+			case 'D':
+				if e.pos > 0 {
+					e.pos--
+				}
+			case 'H':
+				e.pos = 0
+			case 'F':
+				e.pos = len(e.line)
+			case '~':
+				e.delete()
+			}
+		default:
+			if key >= ' ' {
+				e.line = append(e.line[:e.pos], append([]rune{key}, e.line[e.pos:]...)...)
+				e.pos++
+			}
+		}
+		e.redraw(prompt)
+	}
+}
+
+// readEscape reads the rest of an escape sequence and returns its final
+// byte, e.g. 'A' for the up key. The delete key returns '~'.
+func (e *lineEditor) readEscape() rune {
+	next, _, err := e.in.ReadRune()
+	if err != nil || (next != '[' && next != 'O') {
+		return 0
+	}
+	for {
+		key, _, err := e.in.ReadRune()
+		if err != nil {
+			return 0
+		}
+		if key < '0' || key > '9' {
+			return key
+		}
+	}
+}
+
+// completeLine completes the line before the cursor, and prints the
+// candidates when the completion is ambiguous and can't be extended.
+func (e *lineEditor) completeLine(prompt string) {
+	if e.complete == nil {
+		return
+	}
+
+	before := string(e.line[:e.pos])
+	completed, candidates := e.complete(before)
+	if completed != before {
+		rest := e.line[e.pos:]
+		e.line = append([]rune(completed), rest...)
+		e.pos = len([]rune(completed))
+		return
+	}
+	if len(candidates) > 0 {
+		fmt.Fprint(e.out, "\r\n"+strings.Join(candidates, "  ")+"\r\n")
+	}
+}
+
+func (e *lineEditor) delete() {
+	if e.pos < len(e.line) {
+		e.line = append(e.line[:e.pos], e.line[e.pos+1:]...)
+	}
+}
+
+func (e *lineEditor) setLine(line string) {
+	e.line = []rune(line)
+	e.pos = len(e.line)
+}
+
+func (e *lineEditor) redraw(prompt string) {
+	fmt.Fprintf(e.out, "\r%s%s\x1b[K", prompt, string(e.line))
+	if back := len(e.line) - e.pos; back > 0 {
+		fmt.Fprintf(e.out, "\x1b[%dD", back)
+	}
+}
+
+// shellHistory keeps the last lines of the shell, and saves them to path
+// when the path is set.
+type shellHistory struct {
+	path  string
+	size  int
+	lines []string
+}
+
+// Load loads the saved history.
+func (history *shellHistory) Load() error {
+	data, err := os.ReadFile(history.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		lines = nil
+	}
+	if len(lines) > history.size {
+		lines = lines[len(lines)-history.size:]
+		// the saved history is trimmed, so that it doesn't grow forever.
+		err = os.WriteFile(history.path, []byte(strings.Join(lines, "\n")+"\n"), 0600)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	history.lines = lines
+	return nil
+}
+
+// Add adds a line to the history, unless it repeats the last line.
+func (history *shellHistory) Add(line string) (err error) {
+	if history.size <= 0 {
+		return nil
+	}
+	if n := len(history.lines); n > 0 && history.lines[n-1] == line {
+		return nil
+	}
+
+	history.lines = append(history.lines, line)
+	if len(history.lines) > history.size {
+		history.lines = history.lines[len(history.lines)-history.size:]
+	}
+	if history.path == "" {
+		return nil
+	}
+
+	fh, err := os.OpenFile(history.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, fh.Close()) }()
+
+	_, err = fmt.Fprintln(fh, line)
+	return errs.Wrap(err)
+}
+
+// Lines returns the lines of the history, the oldest first.
+func (history *shellHistory) Lines() []string {
+	return history.lines
+}
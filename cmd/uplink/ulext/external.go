@@ -45,6 +45,7 @@ type External interface {
 type Options struct {
 	EncryptionBypass      bool
 	ConnectionPoolOptions rpcpool.Options
+	RateLimit             int64
 }
 
 // LoadOptions takes a slice of Option values and returns a filled out Options struct.
@@ -70,6 +71,12 @@ func ConnectionPoolOptions(options rpcpool.Options) Option {
 	return Option{apply: func(opt *Options) { opt.ConnectionPoolOptions = options }}
 }
 
+// RateLimit will limit the combined upload and download rate of the filesystem
+// to bytesPerSecond. Zero means unlimited.
+func RateLimit(bytesPerSecond int64) Option {
+	return Option{apply: func(opt *Options) { opt.RateLimit = bytesPerSecond }}
+}
+
 // RegisterAccess registers an access grant with a Gateway Authorization Service.
 func RegisterAccess(ctx context.Context, access *uplink.Access, authService string, public bool, timeout time.Duration) (accessKey, secretKey, endpoint string, err error) {
 	if authService == "" {
@@ -18,8 +18,15 @@ import (
 type CreateOptions struct {
 	Expires  time.Time
 	Metadata map[string]string
+
+	// Continue keeps the existing contents of a local file, and keeps the
+	// file when the write is aborted, so that an interrupted download can be
+	// continued later.
+	Continue bool
 }
 
+func (co *CreateOptions) isContinue() bool { return co != nil && co.Continue }
+
 // ListOptions describes options to the List command.
 type ListOptions struct {
 	Recursive bool
@@ -54,7 +61,7 @@ type Filesystem interface {
 type FilesystemLocal interface {
 	IsLocalDir(ctx context.Context, path string) bool
 	Open(ctx context.Context, path string) (MultiReadHandle, error)
-	Create(ctx context.Context, path string) (MultiWriteHandle, error)
+	Create(ctx context.Context, path string, opts *CreateOptions) (MultiWriteHandle, error)
 	Move(ctx context.Context, oldpath string, newpath string) error
 	Copy(ctx context.Context, oldpath string, newpath string) error
 	Remove(ctx context.Context, path string, opts *RemoveOptions) error
@@ -32,22 +32,29 @@ func newOSMultiReadHandle(fh LocalBackendFile) (MultiReadHandle, error) {
 //
 
 type fileGenericWriter struct {
-	fs  LocalBackend
-	raw LocalBackendFile
+	fs   LocalBackend
+	raw  LocalBackendFile
+	keep bool
 }
 
 func (f *fileGenericWriter) WriteAt(b []byte, off int64) (int, error) { return f.raw.WriteAt(b, off) }
 func (f *fileGenericWriter) Commit() error                            { return f.raw.Close() }
 func (f *fileGenericWriter) Abort() error {
+	if f.keep {
+		return f.raw.Close()
+	}
 	return errs.Combine(
 		f.raw.Close(),
 		f.fs.Remove(f.raw.Name()),
 	)
 }
 
-func newOSMultiWriteHandle(fs LocalBackend, fh LocalBackendFile) MultiWriteHandle {
+// newOSMultiWriteHandle returns a MultiWriteHandle writing into fh. The file is
+// removed when the write is aborted, unless keep is set.
+func newOSMultiWriteHandle(fs LocalBackend, fh LocalBackendFile, keep bool) MultiWriteHandle {
 	return NewGenericMultiWriteHandle(&fileGenericWriter{
-		fs:  fs,
-		raw: fh,
+		fs:   fs,
+		raw:  fh,
+		keep: keep,
 	})
 }
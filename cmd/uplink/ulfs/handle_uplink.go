@@ -18,9 +18,10 @@ import (
 //
 
 type uplinkMultiReadHandle struct {
-	project *uplink.Project
-	bucket  string
-	key     string
+	project  *uplink.Project
+	bucket   string
+	key      string
+	throttle *Throttle
 
 	mu   sync.Mutex
 	done bool
@@ -29,11 +30,12 @@ type uplinkMultiReadHandle struct {
 	info *ObjectInfo
 }
 
-func newUplinkMultiReadHandle(project *uplink.Project, bucket, key string) *uplinkMultiReadHandle {
+func newUplinkMultiReadHandle(project *uplink.Project, bucket, key string, throttle *Throttle) *uplinkMultiReadHandle {
 	return &uplinkMultiReadHandle{
-		project: project,
-		bucket:  bucket,
-		key:     key,
+		project:  project,
+		bucket:   bucket,
+		key:      key,
+		throttle: throttle,
 	}
 }
 
@@ -108,10 +110,10 @@ func (u *uplinkMultiReadHandle) NextPart(ctx context.Context, length int64) (Rea
 		}
 	}
 
-	return &uplinkReadHandle{
+	return NewThrottledReadHandle(ctx, &uplinkReadHandle{
 		info: u.info,
 		dl:   dl,
-	}, nil
+	}, u.throttle), nil
 }
 
 func (u *uplinkMultiReadHandle) Info(ctx context.Context) (*ObjectInfo, error) {
@@ -171,18 +173,20 @@ type uplinkMultiWriteHandle struct {
 	bucket   string
 	info     uplink.UploadInfo
 	metadata uplink.CustomMetadata
+	throttle *Throttle
 
 	mu   sync.Mutex
 	tail bool
 	part uint32
 }
 
-func newUplinkMultiWriteHandle(project *uplink.Project, bucket string, info uplink.UploadInfo, metadata uplink.CustomMetadata) *uplinkMultiWriteHandle {
+func newUplinkMultiWriteHandle(project *uplink.Project, bucket string, info uplink.UploadInfo, metadata uplink.CustomMetadata, throttle *Throttle) *uplinkMultiWriteHandle {
 	return &uplinkMultiWriteHandle{
 		project:  project,
 		bucket:   bucket,
 		info:     info,
 		metadata: metadata,
+		throttle: throttle,
 	}
 }
 
@@ -209,9 +213,11 @@ func (u *uplinkMultiWriteHandle) NextPart(ctx context.Context, length int64) (Wr
 	}
 
 	return &uplinkWriteHandle{
-		ul:   ul,
-		tail: length < 0,
-		len:  length,
+		ctx:      ctx,
+		ul:       ul,
+		tail:     length < 0,
+		len:      length,
+		throttle: u.throttle,
 	}, nil
 }
 
@@ -228,9 +234,11 @@ func (u *uplinkMultiWriteHandle) Abort(ctx context.Context) error {
 
 // uplinkWriteHandle implements writeHandle for *uplink.Uploads.
 type uplinkWriteHandle struct {
-	ul   *uplink.PartUpload
-	tail bool
-	len  int64
+	ctx      context.Context
+	ul       *uplink.PartUpload
+	tail     bool
+	len      int64
+	throttle *Throttle
 }
 
 func (u *uplinkWriteHandle) Write(p []byte) (int, error) {
@@ -242,6 +250,32 @@ func (u *uplinkWriteHandle) Write(p []byte) (int, error) {
 		}
 	}
 
+	if u.throttle == nil {
+		return u.write(p)
+	}
+
+	// write in chunks of at most the throttle burst so that the parallel
+	// transfers sharing the throttle are interleaved.
+	var written int
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > u.throttle.burst {
+			chunk = chunk[:u.throttle.burst]
+		}
+		if err := u.throttle.Wait(u.ctx, len(chunk)); err != nil {
+			return written, err
+		}
+		n, err := u.write(chunk)
+		written += n
+		if err != nil {
+			return written, err
+		}
+		p = p[n:]
+	}
+	return written, nil
+}
+
+func (u *uplinkWriteHandle) write(p []byte) (int, error) {
 	n, err := u.ul.Write(p)
 
 	if !u.tail {
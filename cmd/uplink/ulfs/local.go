@@ -30,6 +30,7 @@ type LocalBackend interface {
 	Create(name string) (LocalBackendFile, error)
 	MkdirAll(path string, perm os.FileMode) error
 	Open(name string) (LocalBackendFile, error)
+	OpenFile(name string, flag int, perm os.FileMode) (LocalBackendFile, error)
 	Remove(name string) error
 	Rename(oldname, newname string) error
 	Stat(name string) (os.FileInfo, error)
@@ -57,7 +58,8 @@ func (l *Local) Open(ctx context.Context, path string) (MultiReadHandle, error)
 }
 
 // Create makes any directories necessary to create a file at path and returns a WriteHandle.
-func (l *Local) Create(ctx context.Context, path string) (MultiWriteHandle, error) {
+// An existing file is truncated unless opts.Continue is set.
+func (l *Local) Create(ctx context.Context, path string, opts *CreateOptions) (MultiWriteHandle, error) {
 	fi, err := l.fs.Stat(path)
 	if err != nil && !os.IsNotExist(err) {
 		return nil, errs.Wrap(err)
@@ -70,11 +72,16 @@ func (l *Local) Create(ctx context.Context, path string) (MultiWriteHandle, erro
 	}
 
 	// TODO: atomic rename
-	fh, err := l.fs.Create(path)
+	var fh LocalBackendFile
+	if opts.isContinue() {
+		fh, err = l.fs.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666)
+	} else {
+		fh, err = l.fs.Create(path)
+	}
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
-	return newOSMultiWriteHandle(l.fs, fh), nil
+	return newOSMultiWriteHandle(l.fs, fh, opts.isContinue()), nil
 }
 
 // Move moves file to provided path.
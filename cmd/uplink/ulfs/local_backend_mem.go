@@ -4,6 +4,7 @@
 package ulfs
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -117,6 +118,27 @@ func (l *LocalBackendMem) Open(name string) (LocalBackendFile, error) {
 	return root, nil
 }
 
+// OpenFile opens the file with the given name. The file is created if it does
+// not exist and flag contains os.O_CREATE, and it is truncated if flag contains
+// os.O_TRUNC. The permissions are ignored.
+func (l *LocalBackendMem) OpenFile(name string, flag int, perm os.FileMode) (LocalBackendFile, error) {
+	fh, err := l.Open(name)
+	if errors.Is(err, os.ErrNotExist) && flag&os.O_CREATE != 0 {
+		return l.Create(name)
+	} else if err != nil {
+		return nil, err
+	}
+
+	mf, ok := fh.(*memFile)
+	if !ok {
+		return nil, errs.New("not a regular file: %q", name)
+	}
+	if flag&os.O_TRUNC != 0 {
+		mf.buf = nil
+	}
+	return mf, nil
+}
+
 // Remove deletes the file with the given name.
 func (l *LocalBackendMem) Remove(name string) error {
 	name = filepath.Clean(name)
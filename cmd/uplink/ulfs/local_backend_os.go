@@ -28,6 +28,11 @@ func (l *LocalBackendOS) Open(name string) (LocalBackendFile, error) {
 	return os.Open(name)
 }
 
+// OpenFile calls os.OpenFile.
+func (l *LocalBackendOS) OpenFile(name string, flag int, perm os.FileMode) (LocalBackendFile, error) {
+	return os.OpenFile(name, flag, perm)
+}
+
 // Remove calls os.Remove.
 func (l *LocalBackendOS) Remove(name string) error {
 	return os.Remove(name)
@@ -46,7 +46,7 @@ func (m *Mixed) Create(ctx clingy.Context, loc ulloc.Location, opts *CreateOptio
 	if bucket, key, ok := loc.RemoteParts(); ok {
 		return m.remote.Create(ctx, bucket, key, opts)
 	} else if path, ok := loc.LocalParts(); ok {
-		return m.local.Create(ctx, path)
+		return m.local.Create(ctx, path, opts)
 	}
 	return newStdMultiWriteHandle(ctx.Stdout()), nil
 }
@@ -15,13 +15,16 @@ import (
 
 // Remote implements something close to a filesystem but backed by an uplink project.
 type Remote struct {
-	project *uplink.Project
+	project  *uplink.Project
+	throttle *Throttle
 }
 
 // NewRemote returns something close to a filesystem and returns objects using the project.
-func NewRemote(project *uplink.Project) *Remote {
+// The uploads and downloads are limited by throttle, if it is not nil.
+func NewRemote(project *uplink.Project, throttle *Throttle) *Remote {
 	return &Remote{
-		project: project,
+		project:  project,
+		throttle: throttle,
 	}
 }
 
@@ -32,7 +35,7 @@ func (r *Remote) Close() error {
 
 // Open returns a MultiReadHandle for the object identified by a given bucket and key.
 func (r *Remote) Open(ctx context.Context, bucket, key string) (MultiReadHandle, error) {
-	return newUplinkMultiReadHandle(r.project, bucket, key), nil
+	return newUplinkMultiReadHandle(r.project, bucket, key, r.throttle), nil
 }
 
 // Stat returns information about an object at the specified key.
@@ -62,7 +65,7 @@ func (r *Remote) Create(ctx context.Context, bucket, key string, opts *CreateOpt
 	if err != nil {
 		return nil, err
 	}
-	return newUplinkMultiWriteHandle(r.project, bucket, info, customMetadata), nil
+	return newUplinkMultiWriteHandle(r.project, bucket, info, customMetadata, r.throttle), nil
 }
 
 // Move moves object to provided key and bucket.
@@ -0,0 +1,108 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ulfs
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+)
+
+// Throttle is a token bucket limiting the number of bytes transferred per
+// second. A single Throttle is shared by all uploads and downloads of a
+// Remote, so the combined throughput of parallel segment transfers stays
+// under the limit.
+type Throttle struct {
+	limiter limiter
+	burst   int
+}
+
+// limiter is the subset of *rate.Limiter used by Throttle.
+type limiter interface {
+	WaitN(ctx context.Context, n int) error
+}
+
+// NewThrottle creates a throttle allowing bytesPerSecond bytes per second.
+// A nil *Throttle is returned when bytesPerSecond is not positive, meaning
+// the transfers are not throttled.
+func NewThrottle(bytesPerSecond int64) *Throttle {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+
+	// the burst is capped so that a single Wait never sleeps for long and
+	// parallel transfers are interleaved fairly.
+	burst := int(bytesPerSecond)
+	if burst > maxThrottleBurst {
+		burst = maxThrottleBurst
+	}
+
+	return &Throttle{
+		limiter: rate.NewLimiter(rate.Limit(bytesPerSecond), burst),
+		burst:   burst,
+	}
+}
+
+const maxThrottleBurst = 64 * 1024
+
+// Wait blocks until n bytes may be transferred.
+func (t *Throttle) Wait(ctx context.Context, n int) error {
+	if t == nil {
+		return nil
+	}
+	for n > 0 {
+		chunk := n
+		if chunk > t.burst {
+			chunk = t.burst
+		}
+		if err := t.limiter.WaitN(ctx, chunk); err != nil {
+			return err
+		}
+		n -= chunk
+	}
+	return nil
+}
+
+// ThrottledReadHandle wraps a ReadHandle so that reads are limited by a Throttle.
+type ThrottledReadHandle struct {
+	ctx      context.Context
+	reader   ReadHandle
+	throttle *Throttle
+}
+
+// NewThrottledReadHandle wraps reader with throttle. If throttle is nil, the
+// reader is returned unchanged.
+func NewThrottledReadHandle(ctx context.Context, reader ReadHandle, throttle *Throttle) ReadHandle {
+	if throttle == nil {
+		return reader
+	}
+	return &ThrottledReadHandle{
+		ctx:      ctx,
+		reader:   reader,
+		throttle: throttle,
+	}
+}
+
+// Read reads at most the throttle burst size from the wrapped reader and
+// waits for the throttle to allow the read bytes.
+func (t *ThrottledReadHandle) Read(p []byte) (int, error) {
+	if len(p) > t.throttle.burst {
+		p = p[:t.throttle.burst]
+	}
+	n, err := t.reader.Read(p)
+	if n > 0 {
+		if werr := t.throttle.Wait(t.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+// Close closes the wrapped ReadHandle.
+func (t *ThrottledReadHandle) Close() error {
+	return t.reader.Close()
+}
+
+// Info returns Info of the wrapped ReadHandle.
+func (t *ThrottledReadHandle) Info() ObjectInfo { return t.reader.Info() }
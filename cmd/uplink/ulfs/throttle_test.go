@@ -0,0 +1,85 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ulfs
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+type recordingLimiter struct {
+	waits []int
+}
+
+func (l *recordingLimiter) WaitN(ctx context.Context, n int) error {
+	l.waits = append(l.waits, n)
+	return nil
+}
+
+func TestThrottle(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	require.Nil(t, NewThrottle(0))
+	require.Nil(t, NewThrottle(-1))
+	require.NoError(t, (*Throttle)(nil).Wait(ctx, 100))
+
+	require.Equal(t, 1000, NewThrottle(1000).burst)
+	require.Equal(t, maxThrottleBurst, NewThrottle(memory.GiB.Int64()).burst)
+
+	limiter := &recordingLimiter{}
+	throttle := &Throttle{limiter: limiter, burst: 4}
+	require.NoError(t, throttle.Wait(ctx, 10))
+	require.Equal(t, []int{4, 4, 2}, limiter.waits)
+}
+
+func TestThrottledReadHandle(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	size := 1 * memory.KiB
+	content := testrand.Bytes(size)
+	info := ObjectInfo{
+		Loc:           ulloc.NewLocal("/test/path"),
+		Created:       time.Now(),
+		ContentLength: size.Int64(),
+	}
+
+	t.Run("unlimited", func(t *testing.T) {
+		rh := newTestReadHandle(content, info)
+		require.Equal(t, ReadHandle(rh), NewThrottledReadHandle(ctx, rh, nil))
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		rh := newTestReadHandle(content, info)
+
+		limiter := &recordingLimiter{}
+		trh := NewThrottledReadHandle(ctx, rh, &Throttle{limiter: limiter, burst: 256})
+		require.Equal(t, info, trh.Info())
+
+		data, err := io.ReadAll(trh)
+		require.NoError(t, err)
+		require.Equal(t, content, data)
+
+		// every read is limited to the burst size and waits for it.
+		var total int
+		for _, n := range limiter.waits {
+			require.LessOrEqual(t, n, 256)
+			total += n
+		}
+		require.Equal(t, size.Int(), total)
+
+		require.NoError(t, trh.Close())
+		require.True(t, rh.closed)
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package ulmetrics exports the client-side transfer metrics of the uplink
+// command to an external monitoring system.
+package ulmetrics
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+)
+
+// Metric is a single measurement of the transfers.
+type Metric struct {
+	Name  string
+	Tags  map[string]string
+	Value float64
+}
+
+// Exporter sends the collected metrics to an external monitoring system.
+//
+// Integrators can implement Exporter to send the metrics to the system of
+// their choice.
+type Exporter interface {
+	Export(ctx context.Context, metrics []Metric) error
+	Close() error
+}
+
+// scopePrefix selects the monkit series of the upload and download code.
+const scopePrefix = "storj.io/uplink/"
+
+// nodeArgPrefix is the prefix of the task argument, which identifies the
+// storage node of the piece transfers.
+const nodeArgPrefix = "node: "
+
+// Collector collects the transfer metrics of the process.
+//
+// The success rate and the duration of the piece transfers are collected per
+// storage node and task by observing the spans of the transfers. The rest of
+// the metrics, e.g. the number of failed and canceled piece uploads per
+// segment, are read from the monkit registry.
+type Collector struct {
+	registry *monkit.Registry
+
+	mu    sync.Mutex
+	nodes map[nodeTask]*nodeStats
+}
+
+// nodeTask identifies the transfers of a task to a storage node.
+type nodeTask struct {
+	node string
+	task string
+}
+
+// nodeStats are the statistics of the transfers of a task to a storage node.
+type nodeStats struct {
+	success int64
+	failure int64
+	total   time.Duration
+	max     time.Duration
+}
+
+// NewCollector creates a collector of the metrics of the registry.
+func NewCollector(registry *monkit.Registry) *Collector {
+	return &Collector{
+		registry: registry,
+		nodes:    map[nodeTask]*nodeStats{},
+	}
+}
+
+// Start starts observing the piece transfers. The returned function stops
+// observing them.
+func (collector *Collector) Start() (stop func()) {
+	return collector.registry.ObserveTraces(func(trace *monkit.Trace) {
+		trace.ObserveSpans(spanObserver{collector: collector})
+	})
+}
+
+// spanObserver records the finished piece transfers in the collector.
+type spanObserver struct {
+	collector *Collector
+}
+
+// Start implements monkit.SpanObserver.
+func (observer spanObserver) Start(span *monkit.Span) {}
+
+// Finish implements monkit.SpanObserver.
+func (observer spanObserver) Finish(span *monkit.Span, err error, panicked bool, finish time.Time) {
+	observer.collector.record(span, err != nil || panicked, finish)
+}
+
+// record records a finished span of the piece transfers.
+func (collector *Collector) record(span *monkit.Span, failed bool, finish time.Time) {
+	if !strings.HasPrefix(span.Func().Scope().Name(), scopePrefix) {
+		return
+	}
+
+	node, ok := spanNode(span)
+	if !ok {
+		return
+	}
+
+	key := nodeTask{node: node, task: span.Func().ShortName()}
+	duration := finish.Sub(span.Start())
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	stats, ok := collector.nodes[key]
+	if !ok {
+		stats = &nodeStats{}
+		collector.nodes[key] = stats
+	}
+	if failed {
+		stats.failure++
+	} else {
+		stats.success++
+	}
+	stats.total += duration
+	if duration > stats.max {
+		stats.max = duration
+	}
+}
+
+// spanNode returns the storage node of the span from its arguments.
+func spanNode(span *monkit.Span) (string, bool) {
+	for _, arg := range span.Args() {
+		unquoted, err := strconv.Unquote(arg)
+		if err != nil {
+			continue
+		}
+		if strings.HasPrefix(unquoted, nodeArgPrefix) {
+			return strings.TrimPrefix(unquoted, nodeArgPrefix), true
+		}
+	}
+	return "", false
+}
+
+// Metrics returns the metrics collected so far.
+func (collector *Collector) Metrics() []Metric {
+	var metrics []Metric
+
+	collector.mu.Lock()
+	for key, stats := range collector.nodes {
+		tags := map[string]string{"node": key.node, "task": key.task}
+		count := stats.success + stats.failure
+		metrics = append(metrics,
+			Metric{Name: "node_task_success", Tags: tags, Value: float64(stats.success)},
+			Metric{Name: "node_task_failure", Tags: tags, Value: float64(stats.failure)},
+			Metric{Name: "node_task_success_rate", Tags: tags, Value: float64(stats.success) / float64(count)},
+			Metric{Name: "node_task_duration_avg", Tags: tags, Value: (stats.total / time.Duration(count)).Seconds()},
+			Metric{Name: "node_task_duration_max", Tags: tags, Value: stats.max.Seconds()},
+		)
+	}
+	collector.mu.Unlock()
+
+	collector.registry.Stats(func(key monkit.SeriesKey, field string, val float64) {
+		tags := key.Tags.All()
+		if !strings.HasPrefix(tags["scope"], scopePrefix) {
+			return
+		}
+		metrics = append(metrics, Metric{Name: key.Measurement + "." + field, Tags: tags, Value: val})
+	})
+
+	sort.SliceStable(metrics, func(i, k int) bool { return metrics[i].Name < metrics[k].Name })
+	return metrics
+}
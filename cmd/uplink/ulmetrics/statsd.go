@@ -0,0 +1,115 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ulmetrics
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// maxStatsDPacketSize keeps the packets under the usual MTU, so they aren't
+// fragmented.
+const maxStatsDPacketSize = 1432
+
+// StatsD exports the metrics as gauges to a statsd server over UDP. The tags
+// are sent in the DogStatsD format, which most of the servers understand.
+type StatsD struct {
+	prefix string
+	conn   net.Conn
+}
+
+// NewStatsD creates an exporter to the statsd server at address. The names
+// of the metrics are prefixed with prefix.
+func NewStatsD(address, prefix string) (*StatsD, error) {
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &StatsD{prefix: prefix, conn: conn}, nil
+}
+
+// Export implements Exporter.
+func (statsd *StatsD) Export(ctx context.Context, metrics []Metric) (err error) {
+	var packet bytes.Buffer
+	for _, metric := range metrics {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if math.IsNaN(metric.Value) || math.IsInf(metric.Value, 0) {
+			continue
+		}
+
+		line := statsd.format(metric)
+		if packet.Len() > 0 && packet.Len()+1+len(line) > maxStatsDPacketSize {
+			if _, err := statsd.conn.Write(packet.Bytes()); err != nil {
+				return errs.Wrap(err)
+			}
+			packet.Reset()
+		}
+		if packet.Len() > 0 {
+			packet.WriteByte('\n')
+		}
+		packet.WriteString(line)
+	}
+
+	if packet.Len() > 0 {
+		if _, err := statsd.conn.Write(packet.Bytes()); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// format formats the metric as a statsd gauge.
+func (statsd *StatsD) format(metric Metric) string {
+	var line strings.Builder
+	line.WriteString(sanitize(statsd.prefix + metric.Name))
+	line.WriteByte(':')
+	line.WriteString(strconv.FormatFloat(metric.Value, 'g', -1, 64))
+	line.WriteString("|g")
+
+	if len(metric.Tags) > 0 {
+		keys := make([]string, 0, len(metric.Tags))
+		for key := range metric.Tags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		line.WriteString("|#")
+		for i, key := range keys {
+			if i > 0 {
+				line.WriteByte(',')
+			}
+			line.WriteString(sanitize(key))
+			line.WriteByte(':')
+			line.WriteString(sanitize(metric.Tags[key]))
+		}
+	}
+	return line.String()
+}
+
+// sanitize replaces the characters, which have a meaning in the statsd
+// protocol.
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ':', '|', ',', '#', '@', '\n', ' ':
+			return '_'
+		default:
+			return r
+		}
+	}, s)
+}
+
+// Close implements Exporter.
+func (statsd *StatsD) Close() error {
+	return errs.Wrap(statsd.conn.Close())
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ulmetrics
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+)
+
+func TestCollector(t *testing.T) {
+	registry := monkit.NewRegistry()
+	collector := NewCollector(registry)
+	stop := collector.Start()
+
+	mon := registry.ScopeNamed("storj.io/uplink/private/piecestore")
+	other := registry.ScopeNamed("storj.io/storj/other")
+
+	upload := func(node string, fail bool) {
+		ctx := context.Background()
+		var err error
+		if fail {
+			err = errors.New("failure")
+		}
+		mon.TaskNamed("upload")(&ctx, "node: "+node)(&err)
+	}
+
+	upload("node1", false)
+	upload("node1", false)
+	upload("node1", true)
+	upload("node2", false)
+
+	// tasks outside of the uplink aren't collected.
+	ctx := context.Background()
+	other.TaskNamed("upload")(&ctx, "node: node3")(nil)
+
+	stop()
+	upload("node2", true)
+
+	values := map[string]float64{}
+	for _, metric := range collector.Metrics() {
+		if strings.HasPrefix(metric.Name, "node_task_") {
+			require.Equal(t, "upload", metric.Tags["task"])
+			values[metric.Name+"/"+metric.Tags["node"]] = metric.Value
+		}
+	}
+
+	require.Equal(t, 2.0, values["node_task_success/node1"])
+	require.Equal(t, 1.0, values["node_task_failure/node1"])
+	require.InDelta(t, 2.0/3.0, values["node_task_success_rate/node1"], 1e-9)
+	require.Equal(t, 1.0, values["node_task_success/node2"])
+	require.Equal(t, 0.0, values["node_task_failure/node2"])
+	require.Equal(t, 1.0, values["node_task_success_rate/node2"])
+	require.NotContains(t, values, "node_task_success/node3")
+}
+
+func TestStatsD(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ctx.Check(server.Close)
+
+	statsd, err := NewStatsD(server.LocalAddr().String(), "uplink.")
+	require.NoError(t, err)
+	defer ctx.Check(statsd.Close)
+
+	err = statsd.Export(ctx, []Metric{
+		{Name: "node_task_success", Tags: map[string]string{"task": "upload", "node": "node1"}, Value: 2},
+		{Name: "put_segment_pieces_failed.recent", Value: 0.5},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, server.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf := make([]byte, maxStatsDPacketSize)
+	n, _, err := server.ReadFrom(buf)
+	require.NoError(t, err)
+
+	require.Equal(t, strings.Join([]string{
+		"uplink.node_task_success:2|g|#node:node1,task:upload",
+		"uplink.put_segment_pieces_failed.recent:0.5|g",
+	}, "\n"), string(buf[:n]))
+}
@@ -4,6 +4,7 @@
 package nodeoperator
 
 import (
+	"regexp"
 	"strings"
 
 	"github.com/zeebo/errs"
@@ -14,6 +15,7 @@ var DefaultWalletFeaturesValidation = WalletFeaturesValidation{
 	MaxListLength:      5,
 	MaxFeatureLength:   15,
 	ReservedCharacters: []rune{',', '|'},
+	Format:             regexp.MustCompile(`^[a-zA-Z0-9_-]+$`),
 }
 
 // WalletFeatureValidationError wallet feature validation errors class.
@@ -24,6 +26,8 @@ type WalletFeaturesValidation struct {
 	MaxListLength      int
 	MaxFeatureLength   int
 	ReservedCharacters []rune
+	// Format, when set, is the pattern every feature has to match.
+	Format *regexp.Regexp
 }
 
 // Validate validates wallet features list.
@@ -50,6 +54,10 @@ func (validation *WalletFeaturesValidation) Validate(features []string) error {
 				errGroup.Add(errs.New("feature %q contains reserved character '%c' at pos %d", feature, reserved, i))
 			}
 		}
+
+		if validation.Format != nil && !validation.Format.MatchString(feature) {
+			errGroup.Add(errs.New("feature %q doesn't match format %q", feature, validation.Format))
+		}
 	}
 
 	return WalletFeatureValidationError.Wrap(errGroup.Err())
@@ -75,4 +75,16 @@ func TestWalletFeaturesValidation(t *testing.T) {
 		err := validation.Validate(features)
 		require.Error(t, err)
 	})
+
+	t.Run("format", func(t *testing.T) {
+		validation := nodeoperator.DefaultWalletFeaturesValidation
+
+		require.NoError(t, validation.Validate([]string{"zksync", "zk_sync", "zk-sync", "L2"}))
+
+		for _, feature := range []string{"", " zksync", "zk.sync", "zk/sync", "\x00"} {
+			err := validation.Validate([]string{feature})
+			require.Error(t, err, feature)
+			require.True(t, nodeoperator.WalletFeatureValidationError.Has(err))
+		}
+	})
 }
@@ -0,0 +1,173 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package retainfilter
+
+import (
+	"encoding/binary"
+
+	"storj.io/common/storj"
+)
+
+const (
+	// compactHeaderSize is the maximum size of the header of a compact
+	// filter: the version, seed, hash count, shard bits and shard bytes
+	// followed by the number of bits of the table as an uvarint.
+	compactHeaderSize = 5 + binary.MaxVarintLen64
+	// minCompactBits is the minimum size of the table of a compact filter.
+	minCompactBits = 64
+)
+
+// rangeOffsets contains offsets for selecting subranges that minimize overlap
+// in the first hash functions. They're the same as the version 1 offsets.
+var rangeOffsets = [...]byte{9, 13, 19, 23}
+
+// compactFilter is a version 2 filter. Its table is addressed by bit, so that
+// its size doesn't have to be rounded to bytes, and it's one of the 2^shardBits
+// shards of the filter of a node. It contains the pieces of the other shards,
+// so that they're retained.
+type compactFilter struct {
+	seed      byte
+	hashCount byte
+	shardBits byte
+	shard     byte
+	bits      uint64
+	table     []byte
+}
+
+func newCompact(seed, hashCount, shardBits, shard byte, bits uint64) *compactFilter {
+	return &compactFilter{
+		seed:      seed,
+		hashCount: hashCount,
+		shardBits: shardBits,
+		shard:     shard,
+		bits:      bits,
+		table:     make([]byte, (bits+7)/8),
+	}
+}
+
+// Add adds a piece to the filter. Pieces of the other shards are ignored.
+func (filter *compactFilter) Add(pieceID storj.PieceID) {
+	if shardOf(pieceID, filter.shardBits) != filter.shard {
+		return
+	}
+
+	offset, rangeOffset := initialConditions(filter.seed)
+	for k := byte(0); k < filter.hashCount; k++ {
+		bit := subrange(offset, pieceID) % filter.bits
+		offset = nextOffset(offset, rangeOffset)
+
+		filter.table[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Contains returns true if the piece may be in the filter, or it belongs to
+// another shard.
+func (filter *compactFilter) Contains(pieceID storj.PieceID) bool {
+	if shardOf(pieceID, filter.shardBits) != filter.shard {
+		return true
+	}
+
+	offset, rangeOffset := initialConditions(filter.seed)
+	for k := byte(0); k < filter.hashCount; k++ {
+		bit := subrange(offset, pieceID) % filter.bits
+		offset = nextOffset(offset, rangeOffset)
+
+		if filter.table[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Parameters returns the hash count and the table size in bytes.
+func (filter *compactFilter) Parameters() (hashCount, size int) {
+	return int(filter.hashCount), len(filter.table)
+}
+
+// Bytes encodes the filter.
+func (filter *compactFilter) Bytes() []byte {
+	data := make([]byte, 5, filter.Size())
+	data[0] = byte(Version2)
+	data[1] = filter.seed
+	data[2] = filter.hashCount
+	data[3] = filter.shardBits
+	data[4] = filter.shard
+
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], filter.bits)
+	data = append(data, buf[:n]...)
+	return append(data, filter.table...)
+}
+
+// Size returns the size of Bytes.
+func (filter *compactFilter) Size() int64 {
+	var buf [binary.MaxVarintLen64]byte
+	return int64(5 + binary.PutUvarint(buf[:], filter.bits) + len(filter.table))
+}
+
+// decodeCompact decodes a version 2 filter.
+func decodeCompact(data []byte) (*compactFilter, error) {
+	if len(data) < 6 {
+		return nil, Error.New("not enough data")
+	}
+
+	filter := &compactFilter{
+		seed:      data[1],
+		hashCount: data[2],
+		shardBits: data[3],
+		shard:     data[4],
+	}
+	if filter.hashCount == 0 {
+		return nil, Error.New("invalid hash count %d", filter.hashCount)
+	}
+	if filter.shardBits > maxShardBits || int(filter.shard) >= 1<<filter.shardBits {
+		return nil, Error.New("invalid shard %d of %d bits", filter.shard, filter.shardBits)
+	}
+
+	bits, n := binary.Uvarint(data[5:])
+	if n <= 0 || bits == 0 {
+		return nil, Error.New("invalid table size")
+	}
+	filter.bits = bits
+	filter.table = data[5+n:]
+	if uint64(len(filter.table)) != (bits+7)/8 {
+		return nil, Error.New("table of %d bytes doesn't match %d bits", len(filter.table), bits)
+	}
+
+	return filter, nil
+}
+
+// shardOf returns the shard of pieceID, which is the top shardBits bits of its
+// last byte.
+func shardOf(pieceID storj.PieceID, shardBits byte) byte {
+	if shardBits == 0 {
+		return 0
+	}
+	return pieceID[len(pieceID)-1] >> (8 - shardBits)
+}
+
+func initialConditions(seed byte) (initialOffset, rangeOffset int) {
+	initialOffset = int(seed % 32)
+	rangeOffset = int(rangeOffsets[int(seed/32)%len(rangeOffsets)])
+	return initialOffset, rangeOffset
+}
+
+func nextOffset(offset, rangeOffset int) int {
+	offset += rangeOffset
+	if offset >= len(storj.PieceID{}) {
+		offset -= len(storj.PieceID{})
+	}
+	return offset
+}
+
+// subrange returns the 8 bytes of id starting at offset, wrapping around.
+func subrange(offset int, id storj.PieceID) uint64 {
+	if offset > len(id)-8 {
+		var unwrap [8]byte
+		n := copy(unwrap[:], id[offset:])
+		copy(unwrap[n:], id[:])
+		return binary.LittleEndian.Uint64(unwrap[:])
+	}
+	return binary.LittleEndian.Uint64(id[offset : offset+8])
+}
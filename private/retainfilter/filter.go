@@ -0,0 +1,156 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package retainfilter implements the versioned formats of the filters sent
+// to storage nodes in retain requests.
+package retainfilter
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/bloomfilter"
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+)
+
+// Error is the default error class for retain filters.
+var Error = errs.Class("retain filter")
+
+// Version is the version of the wire format of a retain filter. It's the
+// first byte of an encoded filter.
+type Version byte
+
+const (
+	// Version1 is the format of storj.io/common/bloomfilter, which every
+	// storage node supports. Its size is limited, so the false positive rate
+	// of a large node grows above the configured rate.
+	Version1 Version = 1
+	// Version2 is the format of a compact filter, whose table is sized in
+	// bits and which can be split into shards by piece ID, each sent in its
+	// own retain request.
+	Version2 Version = 2
+)
+
+// maxShardBits limits the number of shards of a version 2 filter to 256.
+const maxShardBits = 8
+
+// Filter is a filter of the pieces, which a storage node should retain.
+// Contains may return true for pieces, which weren't added.
+type Filter interface {
+	// Add adds a piece to the filter.
+	Add(pieceID storj.PieceID)
+	// Contains returns true if the piece may have been added.
+	Contains(pieceID storj.PieceID) bool
+	// Parameters returns the hash count and the table size in bytes.
+	Parameters() (hashCount, size int)
+	// Bytes encodes the filter to be sent in a retain request.
+	Bytes() []byte
+	// Size returns the size of the encoded filter.
+	Size() int64
+}
+
+var _ Filter = (*bloomfilter.Filter)(nil)
+
+// NewOptimal returns the filters for a node, which is expected to store
+// expectedPieces, in the format of version. A version 1 filter is a single
+// filter of at most maxSize, while a version 2 filter is split into as many
+// shards as it needs, so that each of them is at most maxSize.
+func NewOptimal(version Version, expectedPieces int, falsePositiveRate float64, maxSize memory.Size) []Filter {
+	if version != Version2 {
+		return []Filter{bloomfilter.NewOptimalMaxSize(expectedPieces, falsePositiveRate, maxSize)}
+	}
+
+	if expectedPieces < 1 {
+		expectedPieces = 1
+	}
+	bitsPerPiece := -math.Log2(falsePositiveRate) / math.Ln2
+	maxBits := 8 * (maxSize.Int64() - compactHeaderSize)
+	if maxBits < minCompactBits {
+		maxBits = minCompactBits
+	}
+
+	shardBits := 0
+	for shardBits < maxShardBits && shardTableBits(expectedPieces, shardBits, bitsPerPiece) > maxBits {
+		shardBits++
+	}
+
+	bits := shardTableBits(expectedPieces, shardBits, bitsPerPiece)
+	if bits > maxBits {
+		bits = maxBits
+	}
+	// the hash count is optimal for the actual bits per piece, which are
+	// fewer than requested when the size is limited.
+	piecesPerShard := float64(expectedPieces) / float64(int(1)<<shardBits)
+	hashCount := int(math.Round(float64(bits) / piecesPerShard * math.Ln2))
+	if hashCount < 1 {
+		hashCount = 1
+	} else if hashCount > 32 {
+		hashCount = 32
+	}
+
+	seed := byte(rand.Intn(255))
+	filters := make([]Filter, 1<<shardBits)
+	for shard := range filters {
+		filters[shard] = newCompact(seed, byte(hashCount), byte(shardBits), byte(shard), uint64(bits))
+	}
+	return filters
+}
+
+// shardTableBits returns the number of bits in the table of each of the
+// 2^shardBits shards.
+func shardTableBits(expectedPieces, shardBits int, bitsPerPiece float64) int64 {
+	piecesPerShard := float64(expectedPieces) / float64(int(1)<<shardBits)
+	bits := int64(math.Ceil(piecesPerShard * bitsPerPiece))
+	if bits < minCompactBits {
+		bits = minCompactBits
+	}
+	return bits
+}
+
+// ShardOf returns the index of the shard of pieceID, when a filter is split
+// into count shards. count must be a power of two, up to 256.
+func ShardOf(pieceID storj.PieceID, count int) int {
+	shardBits := 0
+	for 1<<shardBits < count {
+		shardBits++
+	}
+	return int(shardOf(pieceID, byte(shardBits)))
+}
+
+// Decode decodes a filter of any supported version.
+//
+// Note: data will be referenced inside the filter.
+func Decode(data []byte) (Filter, error) {
+	if len(data) == 0 {
+		return nil, Error.New("not enough data")
+	}
+
+	switch Version(data[0]) {
+	case Version1:
+		filter, err := bloomfilter.NewFromBytes(data)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return filter, nil
+	case Version2:
+		filter, err := decodeCompact(data)
+		if err != nil {
+			return nil, err
+		}
+		return filter, nil
+	default:
+		return nil, Error.New("unsupported version %d", data[0])
+	}
+}
+
+// ShardIndex returns the index of the shard of a filter and the number of
+// shards of the filter of the node. It's 0 of 1 when the filter isn't split.
+func ShardIndex(filter Filter) (index, count int) {
+	if compact, ok := filter.(*compactFilter); ok {
+		return int(compact.shard), 1 << compact.shardBits
+	}
+	return 0, 1
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package retainfilter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/retainfilter"
+)
+
+func TestVersion1(t *testing.T) {
+	const numPieces = 1000
+
+	filters := retainfilter.NewOptimal(retainfilter.Version1, numPieces, 0.1, 100*memory.B)
+	require.Len(t, filters, 1)
+
+	_, size := filters[0].Parameters()
+	require.Equal(t, 100, size)
+
+	pieceIDs := addPieces(filters, numPieces)
+
+	decoded, err := retainfilter.Decode(filters[0].Bytes())
+	require.NoError(t, err)
+	for _, pieceID := range pieceIDs {
+		require.True(t, decoded.Contains(pieceID))
+	}
+}
+
+func TestVersion2(t *testing.T) {
+	const numPieces = 10000
+
+	filters := retainfilter.NewOptimal(retainfilter.Version2, numPieces, 0.1, 2*memory.MiB)
+	require.Len(t, filters, 1)
+
+	// the table is sized in bits for the requested false positive rate,
+	// i.e. 1.44*log2(1/0.1) bits per piece.
+	hashCount, size := filters[0].Parameters()
+	require.Equal(t, 3, hashCount)
+	require.Equal(t, 5991, size)
+
+	pieceIDs := addPieces(filters, numPieces)
+
+	data := filters[0].Bytes()
+	require.EqualValues(t, len(data), filters[0].Size())
+	require.EqualValues(t, retainfilter.Version2, data[0])
+
+	decoded, err := retainfilter.Decode(data)
+	require.NoError(t, err)
+	for _, pieceID := range pieceIDs {
+		require.True(t, decoded.Contains(pieceID))
+	}
+	require.Less(t, falsePositiveRate(decoded), 0.15)
+}
+
+func TestVersion2Split(t *testing.T) {
+	const numPieces = 100000
+	const maxSize = 8 * memory.KiB
+
+	filters := retainfilter.NewOptimal(retainfilter.Version2, numPieces, 0.1, maxSize)
+	require.Len(t, filters, 8)
+
+	pieceIDs := addPieces(filters, numPieces)
+
+	decoded := make([]retainfilter.Filter, len(filters))
+	for i, filter := range filters {
+		data := filter.Bytes()
+		require.LessOrEqual(t, int64(len(data)), maxSize.Int64())
+
+		var err error
+		decoded[i], err = retainfilter.Decode(data)
+		require.NoError(t, err)
+	}
+
+	// every shard contains the pieces of the other shards, so a piece is
+	// only deleted by the filter of its own shard.
+	for _, pieceID := range pieceIDs {
+		for _, filter := range decoded {
+			require.True(t, filter.Contains(pieceID))
+		}
+	}
+
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		pieceID := testrand.PieceID()
+		if decoded[retainfilter.ShardOf(pieceID, len(decoded))].Contains(pieceID) {
+			falsePositives++
+		}
+	}
+	require.Less(t, float64(falsePositives)/10000, 0.15)
+}
+
+func TestVersion2MaxShards(t *testing.T) {
+	// the size of the shards is limited, when the maximum number of shards
+	// isn't enough for the false positive rate.
+	filters := retainfilter.NewOptimal(retainfilter.Version2, 100000, 0.1, 64*memory.B)
+	require.Len(t, filters, 256)
+	for _, filter := range filters {
+		require.LessOrEqual(t, filter.Size(), int64(64))
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	valid := retainfilter.NewOptimal(retainfilter.Version2, 100, 0.1, memory.MiB)[0].Bytes()
+
+	for _, data := range [][]byte{
+		nil,
+		{3, 1, 1, 0},
+		{byte(retainfilter.Version1), 1},
+		{byte(retainfilter.Version2), 1, 1, 0, 0},
+		{byte(retainfilter.Version2), 1, 0, 0, 0, 8, 0},
+		{byte(retainfilter.Version2), 1, 1, 9, 0, 8, 0},
+		{byte(retainfilter.Version2), 1, 1, 1, 2, 8, 0},
+		{byte(retainfilter.Version2), 1, 1, 0, 0, 0},
+		valid[:len(valid)-1],
+	} {
+		_, err := retainfilter.Decode(data)
+		require.Error(t, err, data)
+	}
+}
+
+func addPieces(filters []retainfilter.Filter, count int) []storj.PieceID {
+	pieceIDs := make([]storj.PieceID, count)
+	for i := range pieceIDs {
+		pieceIDs[i] = testrand.PieceID()
+		filters[retainfilter.ShardOf(pieceIDs[i], len(filters))].Add(pieceIDs[i])
+	}
+	return pieceIDs
+}
+
+func falsePositiveRate(filter retainfilter.Filter) float64 {
+	const count = 10000
+	falsePositives := 0
+	for i := 0; i < count; i++ {
+		if filter.Contains(testrand.PieceID()) {
+			falsePositives++
+		}
+	}
+	return float64(falsePositives) / count
+}
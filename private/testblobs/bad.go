@@ -208,6 +208,14 @@ func (bad *BadBlobs) FreeSpace(ctx context.Context) (int64, error) {
 	return bad.blobs.FreeSpace(ctx)
 }
 
+// TotalSpace returns the capacity of the filesystem of the blobstore.
+func (bad *BadBlobs) TotalSpace(ctx context.Context) (int64, error) {
+	if err := bad.err.Err(); err != nil {
+		return 0, err
+	}
+	return bad.blobs.TotalSpace(ctx)
+}
+
 // CheckWritability tests writability of the storage directory by creating and deleting a file.
 func (bad *BadBlobs) CheckWritability(ctx context.Context) error {
 	if err := bad.err.Err(); err != nil {
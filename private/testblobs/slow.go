@@ -180,6 +180,14 @@ func (slow *SlowBlobs) FreeSpace(ctx context.Context) (int64, error) {
 	return slow.blobs.FreeSpace(ctx)
 }
 
+// TotalSpace returns the capacity of the filesystem of the blobstore.
+func (slow *SlowBlobs) TotalSpace(ctx context.Context) (int64, error) {
+	if err := slow.sleep(ctx); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return slow.blobs.TotalSpace(ctx)
+}
+
 // CheckWritability tests writability of the storage directory by creating and deleting a file.
 func (slow *SlowBlobs) CheckWritability(ctx context.Context) error {
 	if err := slow.sleep(ctx); err != nil {
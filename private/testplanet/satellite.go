@@ -45,7 +45,9 @@ import (
 	"storj.io/storj/satellite/inspector"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/bucketlifecycle"
 	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/metabase/trashdeletion"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
@@ -145,6 +147,14 @@ type Satellite struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	BucketLifecycle struct {
+		Chore *bucketlifecycle.Chore
+	}
+
+	TrashDeletion struct {
+		Chore *trashdeletion.Chore
+	}
+
 	Accounting struct {
 		Tally            *tally.Service
 		NodeTally        *nodetally.Service
@@ -155,7 +165,8 @@ type Satellite struct {
 	}
 
 	LiveAccounting struct {
-		Cache accounting.Cache
+		Cache     accounting.Cache
+		Persister *live.Persister
 	}
 
 	ProjectLimits struct {
@@ -590,6 +601,8 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 
 	system.ExpiredDeletion.Chore = peer.ExpiredDeletion.Chore
 	system.ZombieDeletion.Chore = peer.ZombieDeletion.Chore
+	system.BucketLifecycle.Chore = peer.BucketLifecycle.Chore
+	system.TrashDeletion.Chore = peer.TrashDeletion.Chore
 
 	system.Accounting.Tally = peer.Accounting.Tally
 	system.Accounting.NodeTally = peer.Accounting.NodeTally
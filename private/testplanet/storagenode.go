@@ -31,6 +31,7 @@ import (
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
 	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/storagenode/healthcheck"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/nodestats"
 	"storj.io/storj/storagenode/operator"
@@ -160,10 +161,15 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 			Address:   planet.NewListenAddress(),
 			StaticDir: filepath.Join(developmentRoot, "web/storagenode/"),
 		},
+		Healthcheck: healthcheck.Config{
+			Interval:      defaultInterval,
+			MaxCheckInAge: time.Hour,
+			MaxClockSkew:  10 * time.Minute,
+		},
 		Storage2: piecestore.Config{
 			CacheSyncInterval:       defaultInterval,
 			ExpirationGracePeriod:   0,
-			MaxConcurrentRequests:   100,
+			MaxConcurrentUploads:    100,
 			OrderLimitGracePeriod:   time.Hour,
 			StreamOperationTimeout:  time.Hour,
 			ReportCapacityThreshold: 100 * memory.MB,
@@ -188,6 +194,11 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 				CachePath:       filepath.Join(storageDir, "trust-cache.json"),
 				RefreshInterval: defaultInterval,
 			},
+			Inventory: piecestore.InventoryConfig{
+				MaxLimit:        10000,
+				BatchSize:       100,
+				ExistsMaxPieces: 1000,
+			},
 			MaxUsedSerialsSize: memory.MiB,
 		},
 		Pieces:    pieces.DefaultConfig,
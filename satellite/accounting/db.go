@@ -91,6 +91,14 @@ type ProjectLimits struct {
 	Usage     *int64
 	Bandwidth *int64
 	Segments  *int64
+
+	// Exemptions are the limit exemptions of the project, which haven't expired.
+	Exemptions []LimitExemption
+	// Freeze is the freeze of the project, nil when the project isn't frozen.
+	Freeze *ProjectFreeze
+	// DeletedAt is when the project was deleted, nil when the project isn't
+	// deleted or was restored.
+	DeletedAt *time.Time
 }
 
 // ProjectDailyUsage holds project daily usage.
@@ -106,6 +114,23 @@ type ProjectUsageByDay struct {
 	Value int64     `json:"value"`
 }
 
+// ProjectHourlyUsage holds project hourly usage.
+type ProjectHourlyUsage struct {
+	StorageUsage            []ProjectUsageByHour `json:"storageUsage"`
+	AllocatedBandwidthUsage []ProjectUsageByHour `json:"allocatedBandwidthUsage"`
+	SettledBandwidthUsage   []ProjectUsageByHour `json:"settledBandwidthUsage"`
+	// UnflushedAllocatedBandwidth is the allocated bandwidth of the current
+	// month which is known by live accounting but isn't in the rollups yet.
+	// It can't be attributed to a specific hour.
+	UnflushedAllocatedBandwidth int64 `json:"unflushedAllocatedBandwidth"`
+}
+
+// ProjectUsageByHour holds project hourly usage.
+type ProjectUsageByHour struct {
+	Hour  time.Time `json:"hour"`
+	Value int64     `json:"value"`
+}
+
 // BucketUsage consist of total bucket usage for period.
 type BucketUsage struct {
 	ProjectID  uuid.UUID
@@ -161,6 +186,36 @@ type BucketUsageRollup struct {
 	Before time.Time `json:"before"`
 }
 
+// APIKeyUsage is the usage of a project, which is attributed to one of its
+// api keys, for certain period.
+type APIKeyUsage struct {
+	// APIKeyID and Name are empty when the api key was deleted.
+	APIKeyID uuid.UUID `json:"apiKeyId"`
+	Name     string    `json:"name"`
+
+	Egress   int64 `json:"egress"`
+	Ingress  int64 `json:"ingress"`
+	Requests int64 `json:"requests"`
+
+	Since  time.Time `json:"since"`
+	Before time.Time `json:"before"`
+}
+
+// SharedLinkUsage is the usage of an object of a project, which was downloaded
+// through shared links, for certain period.
+type SharedLinkUsage struct {
+	BucketName string `json:"bucketName"`
+	// EncryptedObjectKey is the encrypted key of the object, which can be
+	// decrypted by the clients which know the encryption passphrase.
+	EncryptedObjectKey []byte `json:"encryptedObjectKey"`
+
+	Egress   int64 `json:"egress"`
+	Requests int64 `json:"requests"`
+
+	Since  time.Time `json:"since"`
+	Before time.Time `json:"before"`
+}
+
 // Usage contains project's usage split on segments and storage.
 type Usage struct {
 	Storage  int64
@@ -207,6 +262,8 @@ type ProjectAccounting interface {
 	SaveTallies(ctx context.Context, intervalStart time.Time, bucketTallies map[metabase.BucketLocation]*BucketTally) error
 	// GetTallies retrieves all tallies ordered by interval start desc
 	GetTallies(ctx context.Context) ([]BucketTally, error)
+	// GetLatestBucketTally returns the latest tally of the bucket, which is empty when the bucket hasn't been tallied yet.
+	GetLatestBucketTally(ctx context.Context, bucket metabase.BucketLocation) (BucketTally, error)
 	// CreateStorageTally creates a record for BucketStorageTally in the accounting DB table
 	CreateStorageTally(ctx context.Context, tally BucketStorageTally) error
 	// GetAllocatedBandwidthTotal returns the sum of GET bandwidth usage allocated for a projectID in the past time frame
@@ -219,6 +276,8 @@ type ProjectAccounting interface {
 	DeleteProjectBandwidthBefore(ctx context.Context, before time.Time) error
 	// GetProjectDailyUsageByDateRange returns daily allocated, settled bandwidth and storage usage for the specified date range.
 	GetProjectDailyUsageByDateRange(ctx context.Context, projectID uuid.UUID, from, to time.Time, crdbInterval time.Duration) (*ProjectDailyUsage, error)
+	// GetProjectHourlyUsageByDateRange returns hourly allocated, settled bandwidth and storage usage for the specified date range.
+	GetProjectHourlyUsageByDateRange(ctx context.Context, projectID uuid.UUID, from, to time.Time, crdbInterval time.Duration) (*ProjectHourlyUsage, error)
 
 	// UpdateProjectUsageLimit updates project usage limit.
 	UpdateProjectUsageLimit(ctx context.Context, projectID uuid.UUID, limit memory.Size) error
@@ -234,14 +293,34 @@ type ProjectAccounting interface {
 	GetProjectSegmentLimit(ctx context.Context, projectID uuid.UUID) (_ *int64, err error)
 	// GetProjectLimits returns current project limit for both storage and bandwidth.
 	GetProjectLimits(ctx context.Context, projectID uuid.UUID) (ProjectLimits, error)
+	// SetProjectLimitExemption creates the limit exemption of a project or replaces the existing one for the same limit.
+	SetProjectLimitExemption(ctx context.Context, exemption LimitExemption) error
+	// DeleteProjectLimitExemption deletes the exemption of a project for the limit of the given kind.
+	DeleteProjectLimitExemption(ctx context.Context, projectID uuid.UUID, kind LimitKind) error
+	// GetProjectLimitExemptions returns all the limit exemptions of a project, including the expired ones.
+	GetProjectLimitExemptions(ctx context.Context, projectID uuid.UUID) ([]LimitExemption, error)
+	// FreezeProject freezes a project or replaces its existing freeze.
+	FreezeProject(ctx context.Context, freeze ProjectFreeze) error
+	// UnfreezeProject unfreezes a project.
+	UnfreezeProject(ctx context.Context, projectID uuid.UUID) error
+	// UnfreezeProjectsByInvoice unfreezes the projects frozen because of the invoice and returns their IDs.
+	UnfreezeProjectsByInvoice(ctx context.Context, invoiceID string) ([]uuid.UUID, error)
+	// GetProjectFreeze returns the freeze of a project, nil when the project isn't frozen.
+	GetProjectFreeze(ctx context.Context, projectID uuid.UUID) (*ProjectFreeze, error)
 	// GetProjectTotal returns project usage summary for specified period of time.
 	GetProjectTotal(ctx context.Context, projectID uuid.UUID, since, before time.Time) (*ProjectUsage, error)
+	// GetProjectTotalByPlacement returns project usage summary for specified period of time, split by the placement of the buckets.
+	GetProjectTotalByPlacement(ctx context.Context, projectID uuid.UUID, since, before time.Time) (map[storj.PlacementConstraint]ProjectUsage, error)
 	// GetProjectObjectsSegments returns project objects and segments for specified period of time.
 	GetProjectObjectsSegments(ctx context.Context, projectID uuid.UUID) (*ProjectObjectsSegments, error)
 	// GetBucketUsageRollups returns usage rollup per each bucket for specified period of time.
 	GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]BucketUsageRollup, error)
 	// GetSingleBucketUsageRollup returns usage rollup per single bucket for specified period of time.
 	GetSingleBucketUsageRollup(ctx context.Context, projectID uuid.UUID, bucket string, since, before time.Time) (*BucketUsageRollup, error)
+	// GetProjectAPIKeyUsage returns the usage attributed to each api key of the project for specified period of time.
+	GetProjectAPIKeyUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]APIKeyUsage, error)
+	// GetProjectSharedLinkUsage returns the usage of the objects of the project downloaded through shared links for specified period of time.
+	GetProjectSharedLinkUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]SharedLinkUsage, error)
 	// GetBucketTotals returns per bucket total usage summary since bucket creation.
 	GetBucketTotals(ctx context.Context, projectID uuid.UUID, cursor BucketUsageCursor, before time.Time) (*BucketUsagePage, error)
 	// ArchiveRollupsBefore archives rollups older than a given time and returns number of bucket bandwidth rollups archived.
@@ -250,6 +329,10 @@ type ProjectAccounting interface {
 	GetRollupsSince(ctx context.Context, since time.Time) ([]orders.BucketBandwidthRollup, error)
 	// GetArchivedRollupsSince retrieves all archived bandwidth rollup records since a given time. A hard limit batch size is used for results.
 	GetArchivedRollupsSince(ctx context.Context, since time.Time) ([]orders.BucketBandwidthRollup, error)
+	// SaveLiveUsages replaces the persisted live accounting usages with the given ones.
+	SaveLiveUsages(ctx context.Context, usages map[uuid.UUID]Usage, now time.Time) error
+	// GetLiveUsages returns the persisted live accounting usages.
+	GetLiveUsages(ctx context.Context) (map[uuid.UUID]Usage, error)
 }
 
 // Cache stores live information about project storage which has not yet been synced to ProjectAccounting.
@@ -302,6 +385,11 @@ type Cache interface {
 	AddProjectStorageUsageUpToLimit(ctx context.Context, projectID uuid.UUID, increment int64, spaceLimit int64) error
 	// GetAllProjectTotals return the total projects' storage and segments used space.
 	GetAllProjectTotals(ctx context.Context) (map[uuid.UUID]Usage, error)
+	// IsReconciled returns whether the cache was reconciled with the persisted
+	// usages since the cache lost its data.
+	IsReconciled(ctx context.Context) (bool, error)
+	// SetReconciled marks the cache as reconciled with the persisted usages.
+	SetReconciled(ctx context.Context) error
 	// Close the client, releasing any open resources. Once it's called any other
 	// method must be called.
 	Close() error
@@ -0,0 +1,85 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ErrInvalidLimitExemption is used when a limit exemption is not valid.
+var ErrInvalidLimitExemption = errs.Class("invalid limit exemption")
+
+// LimitKind is a kind of project usage limit.
+type LimitKind string
+
+const (
+	// LimitKindBandwidth is the monthly egress bandwidth limit.
+	LimitKindBandwidth = LimitKind("bandwidth")
+	// LimitKindStorage is the storage limit.
+	LimitKindStorage = LimitKind("storage")
+	// LimitKindSegments is the segments limit.
+	LimitKindSegments = LimitKind("segments")
+)
+
+// LimitExemptionReason is the code of why a project is exempted from a limit.
+type LimitExemptionReason string
+
+const (
+	// LimitExemptionReasonMigration is used while customer data is migrated to the project.
+	LimitExemptionReasonMigration = LimitExemptionReason("migration")
+	// LimitExemptionReasonEvaluation is used while a customer evaluates the service with more usage.
+	LimitExemptionReasonEvaluation = LimitExemptionReason("evaluation")
+	// LimitExemptionReasonSupport is used when the exemption is granted by support for other reasons.
+	LimitExemptionReasonSupport = LimitExemptionReason("support")
+)
+
+// LimitExemption allows a project to exceed one of its usage limits until
+// the exemption expires, without permanently raising the limit.
+type LimitExemption struct {
+	ProjectID uuid.UUID            `json:"projectId"`
+	Kind      LimitKind            `json:"kind"`
+	Reason    LimitExemptionReason `json:"reason"`
+	ExpiresAt time.Time            `json:"expiresAt"`
+	CreatedAt time.Time            `json:"createdAt"`
+}
+
+// Validate returns an error when the exemption kind or reason are unknown.
+func (exemption LimitExemption) Validate() error {
+	switch exemption.Kind {
+	case LimitKindBandwidth, LimitKindStorage, LimitKindSegments:
+	default:
+		return ErrInvalidLimitExemption.New("unknown limit kind %q", exemption.Kind)
+	}
+
+	switch exemption.Reason {
+	case LimitExemptionReasonMigration, LimitExemptionReasonEvaluation, LimitExemptionReasonSupport:
+	default:
+		return ErrInvalidLimitExemption.New("unknown reason %q", exemption.Reason)
+	}
+
+	if exemption.ExpiresAt.IsZero() {
+		return ErrInvalidLimitExemption.New("missing expiration")
+	}
+	return nil
+}
+
+// ActiveAt returns whether the exemption is in effect at the given time.
+func (exemption LimitExemption) ActiveAt(now time.Time) bool {
+	return now.Before(exemption.ExpiresAt)
+}
+
+// IsExempt returns whether the project is exempted from the limit of the given
+// kind at the given time.
+func (limits ProjectLimits) IsExempt(kind LimitKind, now time.Time) bool {
+	for _, exemption := range limits.Exemptions {
+		if exemption.Kind == kind && exemption.ActiveAt(now) {
+			return true
+		}
+	}
+	return false
+}
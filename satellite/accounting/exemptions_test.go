@@ -0,0 +1,50 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/accounting"
+)
+
+func TestLimitExemption(t *testing.T) {
+	now := time.Date(2022, time.August, 15, 0, 0, 0, 0, time.UTC)
+
+	valid := accounting.LimitExemption{
+		Kind:      accounting.LimitKindBandwidth,
+		Reason:    accounting.LimitExemptionReasonMigration,
+		ExpiresAt: now.Add(time.Hour),
+	}
+	require.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.Kind = "buckets"
+	require.True(t, accounting.ErrInvalidLimitExemption.Has(invalid.Validate()))
+
+	invalid = valid
+	invalid.Reason = ""
+	require.True(t, accounting.ErrInvalidLimitExemption.Has(invalid.Validate()))
+
+	invalid = valid
+	invalid.ExpiresAt = time.Time{}
+	require.True(t, accounting.ErrInvalidLimitExemption.Has(invalid.Validate()))
+
+	expired := accounting.LimitExemption{
+		Kind:      accounting.LimitKindStorage,
+		Reason:    accounting.LimitExemptionReasonSupport,
+		ExpiresAt: now,
+	}
+
+	limits := accounting.ProjectLimits{
+		Exemptions: []accounting.LimitExemption{valid, expired},
+	}
+	require.True(t, limits.IsExempt(accounting.LimitKindBandwidth, now))
+	require.False(t, limits.IsExempt(accounting.LimitKindBandwidth, now.Add(time.Hour)))
+	require.False(t, limits.IsExempt(accounting.LimitKindStorage, now))
+	require.False(t, limits.IsExempt(accounting.LimitKindSegments, now))
+}
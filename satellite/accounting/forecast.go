@@ -0,0 +1,123 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"time"
+)
+
+// ForecastProjectUsage projects the usage of a project at the end of the
+// month, based on the usage of the month so far.
+//
+// current is the usage from the beginning of the month until now and daily
+// is the daily usage in the same period. Stored bytes and settled bandwidth
+// are extrapolated with a linear regression over the daily usage, so that
+// growing or shrinking projects are forecasted following their trend. The
+// segment count is extrapolated linearly in time.
+func ForecastProjectUsage(current ProjectUsage, daily *ProjectDailyUsage, now time.Time) ProjectUsage {
+	now = now.UTC()
+	year, month, _ := now.Date()
+	monthStart := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := monthStart.AddDate(0, 1, 0)
+
+	forecast := current
+	forecast.Since = monthStart
+	forecast.Before = monthEnd
+
+	elapsedHours := now.Sub(monthStart).Hours()
+	remainingHours := monthEnd.Sub(now).Hours()
+	if remainingHours <= 0 {
+		return forecast
+	}
+
+	if elapsedHours > 0 {
+		forecast.SegmentCount += current.SegmentCount / elapsedHours * remainingHours
+		forecast.ObjectCount += current.ObjectCount / elapsedHours * remainingHours
+	}
+
+	if daily == nil {
+		return forecast
+	}
+
+	// the day of the month, in days since the month start, is the regression
+	// variable; the remaining period starts at the current time.
+	nowDays := now.Sub(monthStart).Hours() / 24
+	endDays := monthEnd.Sub(monthStart).Hours() / 24
+
+	// stored bytes: the byte-hours of the remaining period are the integral
+	// of the forecasted stored bytes.
+	if intercept, slope, ok := regression(monthStart, daily.StorageUsage); ok {
+		forecast.Storage += integrateNonNegative(intercept, slope, nowDays, endDays) * 24
+	}
+
+	// settled bandwidth: the egress of the remaining period is the integral
+	// of the forecasted egress per day.
+	if intercept, slope, ok := regression(monthStart, daily.SettledBandwidthUsage); ok {
+		forecast.Egress += int64(integrateNonNegative(intercept, slope, nowDays, endDays))
+	}
+
+	return forecast
+}
+
+// regression calculates the least squares linear regression of the usage
+// values over the days since monthStart. When there is a single value, the
+// forecast is constant.
+func regression(monthStart time.Time, usage []ProjectUsageByDay) (intercept, slope float64, ok bool) {
+	if len(usage) == 0 {
+		return 0, 0, false
+	}
+
+	var sumX, sumY, sumXY, sumXX float64
+	for _, u := range usage {
+		x := u.Date.Sub(monthStart).Hours() / 24
+		y := float64(u.Value)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	n := float64(len(usage))
+	denominator := n*sumXX - sumX*sumX
+	if len(usage) == 1 || denominator == 0 {
+		return sumY / n, 0, true
+	}
+
+	slope = (n*sumXY - sumX*sumY) / denominator
+	intercept = (sumY - slope*sumX) / n
+	return intercept, slope, true
+}
+
+// integrateNonNegative integrates intercept + slope*x between from and to,
+// treating negative values as zero, since usage can't be negative.
+func integrateNonNegative(intercept, slope, from, to float64) float64 {
+	if from >= to {
+		return 0
+	}
+
+	value := func(x float64) float64 { return intercept + slope*x }
+	area := func(from, to float64) float64 {
+		return (value(from) + value(to)) / 2 * (to - from)
+	}
+
+	if slope == 0 {
+		if intercept <= 0 {
+			return 0
+		}
+		return intercept * (to - from)
+	}
+
+	// zero is where the forecast crosses zero.
+	zero := -intercept / slope
+	switch {
+	case value(from) >= 0 && value(to) >= 0:
+		return area(from, to)
+	case value(from) <= 0 && value(to) <= 0:
+		return 0
+	case value(from) > 0:
+		return area(from, zero)
+	default:
+		return area(zero, to)
+	}
+}
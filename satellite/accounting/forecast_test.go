@@ -0,0 +1,83 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/accounting"
+)
+
+func TestForecastProjectUsage(t *testing.T) {
+	// April has 30 days, so at the middle of the month half of it is left.
+	now := time.Date(2022, time.April, 16, 0, 0, 0, 0, time.UTC)
+	monthStart := time.Date(2022, time.April, 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	days := func(value func(day int) int64) []accounting.ProjectUsageByDay {
+		var usage []accounting.ProjectUsageByDay
+		for day := 0; day < 15; day++ {
+			usage = append(usage, accounting.ProjectUsageByDay{
+				Date:  monthStart.AddDate(0, 0, day),
+				Value: value(day),
+			})
+		}
+		return usage
+	}
+
+	t.Run("constant usage", func(t *testing.T) {
+		current := accounting.ProjectUsage{
+			Storage:      1000 * 15 * 24,
+			Egress:       100 * 15,
+			SegmentCount: 10 * 15 * 24,
+			Since:        monthStart,
+			Before:       now,
+		}
+		daily := &accounting.ProjectDailyUsage{
+			StorageUsage:          days(func(int) int64 { return 1000 }),
+			SettledBandwidthUsage: days(func(int) int64 { return 100 }),
+		}
+
+		forecast := accounting.ForecastProjectUsage(current, daily, now)
+		require.Equal(t, monthStart, forecast.Since)
+		require.Equal(t, monthEnd, forecast.Before)
+		require.InDelta(t, 2*current.Storage, forecast.Storage, 1)
+		require.Equal(t, 2*current.Egress, forecast.Egress)
+		require.InDelta(t, 2*current.SegmentCount, forecast.SegmentCount, 1)
+	})
+
+	t.Run("growing usage", func(t *testing.T) {
+		daily := &accounting.ProjectDailyUsage{
+			StorageUsage:          days(func(day int) int64 { return int64(day) * 1000 }),
+			SettledBandwidthUsage: days(func(day int) int64 { return int64(day) * 100 }),
+		}
+
+		forecast := accounting.ForecastProjectUsage(accounting.ProjectUsage{}, daily, now)
+		// the usage keeps growing from day 15 to day 30.
+		require.InDelta(t, (15+30)*1000/2*15*24, forecast.Storage, 1)
+		require.InDelta(t, (15+30)*100/2*15, forecast.Egress, 1)
+	})
+
+	t.Run("shrinking usage", func(t *testing.T) {
+		daily := &accounting.ProjectDailyUsage{
+			StorageUsage: days(func(day int) int64 { return int64(20-day) * 1000 }),
+		}
+
+		forecast := accounting.ForecastProjectUsage(accounting.ProjectUsage{}, daily, now)
+		// the usage reaches zero at day 20 and it stays there.
+		require.InDelta(t, 5*1000/2*5*24, forecast.Storage, 1)
+		require.Zero(t, forecast.Egress)
+	})
+
+	t.Run("no daily usage", func(t *testing.T) {
+		current := accounting.ProjectUsage{Storage: 1000, Egress: 100}
+
+		forecast := accounting.ForecastProjectUsage(current, nil, now)
+		require.Equal(t, current.Storage, forecast.Storage)
+		require.Equal(t, current.Egress, forecast.Egress)
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ErrInvalidProjectFreeze is used when a project freeze is not valid.
+var ErrInvalidProjectFreeze = errs.Class("invalid project freeze")
+
+// FreezeReason is the code of why a project is frozen.
+type FreezeReason string
+
+const (
+	// FreezeReasonNonPayment is used when an invoice of the owner of the project wasn't paid.
+	FreezeReasonNonPayment = FreezeReason("non_payment")
+	// FreezeReasonSupport is used when the project is frozen by support for other reasons.
+	FreezeReasonSupport = FreezeReason("support")
+)
+
+// ProjectFreeze rejects the uploads, and optionally the downloads, of a
+// project until it's unfrozen. The data of a frozen project is preserved.
+type ProjectFreeze struct {
+	ProjectID uuid.UUID    `json:"projectId"`
+	Reason    FreezeReason `json:"reason"`
+	// Downloads is true when the downloads are rejected too.
+	Downloads bool `json:"downloads"`
+	// InvoiceID is the unpaid invoice, which froze the project. The project is
+	// unfrozen when the invoice is paid. It's empty when the project wasn't
+	// frozen because of an invoice.
+	InvoiceID string    `json:"invoiceId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Validate returns an error when the freeze reason is unknown.
+func (freeze ProjectFreeze) Validate() error {
+	switch freeze.Reason {
+	case FreezeReasonNonPayment, FreezeReasonSupport:
+	default:
+		return ErrInvalidProjectFreeze.New("unknown reason %q", freeze.Reason)
+	}
+	return nil
+}
+
+// FreezesUploads returns whether the uploads of the project are rejected.
+func (limits ProjectLimits) FreezesUploads() bool {
+	return limits.Freeze != nil
+}
+
+// FreezesDownloads returns whether the downloads of the project are rejected.
+func (limits ProjectLimits) FreezesDownloads() bool {
+	return limits.Freeze != nil && limits.Freeze.Downloads
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/accounting"
+)
+
+func TestProjectFreeze(t *testing.T) {
+	valid := accounting.ProjectFreeze{
+		Reason:    accounting.FreezeReasonNonPayment,
+		InvoiceID: "in_unpaid",
+	}
+	require.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.Reason = ""
+	require.True(t, accounting.ErrInvalidProjectFreeze.Has(invalid.Validate()))
+
+	limits := accounting.ProjectLimits{}
+	require.False(t, limits.FreezesUploads())
+	require.False(t, limits.FreezesDownloads())
+
+	limits.Freeze = &valid
+	require.True(t, limits.FreezesUploads())
+	require.False(t, limits.FreezesDownloads())
+
+	downloads := valid
+	downloads.Downloads = true
+	limits.Freeze = &downloads
+	require.True(t, limits.FreezesUploads())
+	require.True(t, limits.FreezesDownloads())
+}
@@ -26,6 +26,7 @@ type Config struct {
 	StorageBackend     string        `help:"what to use for storing real-time accounting data"`
 	BandwidthCacheTTL  time.Duration `default:"5m" help:"bandwidth cache key time to live"`
 	AsOfSystemInterval time.Duration `default:"-10s" help:"as of system interval"`
+	PersistInterval    time.Duration `default:"1m" help:"how frequently the live accounting usages are persisted to the database, disabled when 0"`
 }
 
 // OpenCache creates a new accounting.Cache instance using the type specified backend in
@@ -48,9 +49,9 @@ func OpenCache(ctx context.Context, log *zap.Logger, config Config) (accounting.
 
 	backendType = parts[0]
 	switch backendType {
-	case "redis":
+	case "redis", "redis-sentinel", "redis-cluster":
 		return openRedisLiveAccounting(ctx, config.StorageBackend)
 	default:
-		return nil, Error.New("unrecognized live accounting backend specifier %q. Currently only redis is supported", backendType)
+		return nil, Error.New("unrecognized live accounting backend specifier %q. Currently only redis, redis-sentinel and redis-cluster are supported", backendType)
 	}
 }
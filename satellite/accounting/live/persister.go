@@ -0,0 +1,109 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package live
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/accounting"
+)
+
+// Persister persists the live accounting usages to the database and restores
+// them into the cache when the cache lost its data, so the project limits
+// keep being enforced after a cache failure until the next tally.
+//
+// architecture: Chore
+type Persister struct {
+	log   *zap.Logger
+	cache accounting.Cache
+	db    accounting.ProjectAccounting
+	Loop  *sync2.Cycle
+
+	nowFn func() time.Time
+}
+
+// NewPersister creates a new live accounting persister.
+func NewPersister(log *zap.Logger, cache accounting.Cache, db accounting.ProjectAccounting, interval time.Duration) *Persister {
+	return &Persister{
+		log:   log,
+		cache: cache,
+		db:    db,
+		Loop:  sync2.NewCycle(interval),
+		nowFn: time.Now,
+	}
+}
+
+// Run the persister loop.
+func (persister *Persister) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return persister.Loop.Run(ctx, func(ctx context.Context) error {
+		err := persister.Persist(ctx)
+		if err != nil {
+			persister.log.Error("persisting live accounting failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Persist reconciles the cache with the persisted usages when the cache lost
+// its data and then persists the current cache usages.
+func (persister *Persister) Persist(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := persister.nowFn()
+
+	reconciled, err := persister.cache.IsReconciled(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !reconciled {
+		if err := persister.reconcile(ctx); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	usages, err := persister.cache.GetAllProjectTotals(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(persister.db.SaveLiveUsages(ctx, usages, now))
+}
+
+// reconcile adds the persisted usages to the cache. The cache may already
+// contain the deltas of the uploads since it lost its data, hence the
+// persisted usages are added instead of set.
+func (persister *Persister) reconcile(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	usages, err := persister.db.GetLiveUsages(ctx)
+	if err != nil {
+		return err
+	}
+
+	for projectID, usage := range usages {
+		if err := persister.cache.AddProjectStorageUsage(ctx, projectID, usage.Storage); err != nil {
+			return err
+		}
+		if err := persister.cache.UpdateProjectSegmentUsage(ctx, projectID, usage.Segments); err != nil {
+			return err
+		}
+	}
+
+	mon.IntVal("live_accounting_reconciled_projects").Observe(int64(len(usages)))
+	persister.log.Info("Reconciled live accounting cache.", zap.Int("Projects", len(usages)))
+
+	return persister.cache.SetReconciled(ctx)
+}
+
+// Close stops the persister and releases any resources.
+func (persister *Persister) Close() error {
+	persister.Loop.Close()
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package live_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testredis"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/live"
+)
+
+// liveUsagesDB stores the live usages in memory.
+type liveUsagesDB struct {
+	accounting.ProjectAccounting
+	usages map[uuid.UUID]accounting.Usage
+}
+
+func (db *liveUsagesDB) SaveLiveUsages(ctx context.Context, usages map[uuid.UUID]accounting.Usage, now time.Time) error {
+	db.usages = usages
+	return nil
+}
+
+func (db *liveUsagesDB) GetLiveUsages(ctx context.Context) (map[uuid.UUID]accounting.Usage, error) {
+	return db.usages, nil
+}
+
+func TestPersister(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	redis, err := testredis.Start(ctx)
+	require.NoError(t, err)
+	defer ctx.Check(redis.Close)
+
+	cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), live.Config{
+		StorageBackend: "redis://" + redis.Addr() + "?db=0",
+	})
+	require.NoError(t, err)
+	defer ctx.Check(cache.Close)
+
+	db := &liveUsagesDB{}
+	persister := live.NewPersister(zaptest.NewLogger(t), cache, db, time.Hour)
+	defer ctx.Check(persister.Close)
+
+	projectID := testrand.UUID()
+	require.NoError(t, cache.AddProjectStorageUsage(ctx, projectID, 1000))
+	require.NoError(t, cache.UpdateProjectSegmentUsage(ctx, projectID, 10))
+
+	// the first run reconciles the cache with the empty database.
+	require.NoError(t, persister.Persist(ctx))
+	reconciled, err := cache.IsReconciled(ctx)
+	require.NoError(t, err)
+	require.True(t, reconciled)
+	require.Equal(t, map[uuid.UUID]accounting.Usage{
+		projectID: {Storage: 1000, Segments: 10},
+	}, db.usages)
+
+	// the reconciliation marker isn't reported as a project.
+	totals, err := cache.GetAllProjectTotals(ctx)
+	require.NoError(t, err)
+	require.Len(t, totals, 1)
+
+	// the cache loses its data and receives new uploads.
+	client := goredis.NewClient(&goredis.Options{Addr: redis.Addr()})
+	defer ctx.Check(client.Close)
+	require.NoError(t, client.FlushAll(ctx).Err())
+	require.NoError(t, cache.AddProjectStorageUsage(ctx, projectID, 500))
+	require.NoError(t, cache.UpdateProjectSegmentUsage(ctx, projectID, 5))
+
+	require.NoError(t, persister.Persist(ctx))
+
+	storage, err := cache.GetProjectStorageUsage(ctx, projectID)
+	require.NoError(t, err)
+	require.EqualValues(t, 1500, storage)
+	segments, err := cache.GetProjectSegmentUsage(ctx, projectID)
+	require.NoError(t, err)
+	require.EqualValues(t, 15, segments)
+
+	// once reconciled, the usages aren't added again.
+	require.NoError(t, persister.Persist(ctx))
+	storage, err = cache.GetProjectStorageUsage(ctx, projectID)
+	require.NoError(t, err)
+	require.EqualValues(t, 1500, storage)
+}
+
+func TestOpenCache_RedisAddresses(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	for _, address := range []string{
+		"redis://localhost:6379",
+		"redis-sentinel://localhost:26379,localhost:26380?db=0",
+		"redis-sentinel://localhost:26379?master=mymaster&db=x",
+		"redis-cluster://localhost:7000,localhost:7001?db=0",
+	} {
+		_, err := live.OpenCache(ctx, log, live.Config{StorageBackend: address})
+		require.Error(t, err, address)
+		require.True(t, accounting.ErrInvalidArgument.Has(err), address)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -18,12 +19,26 @@ import (
 	"storj.io/storj/satellite/accounting"
 )
 
+// reconciledKey is the key, which marks that the cache was reconciled with
+// the persisted usages. It's lost together with the usages when the cache
+// loses its data.
+const reconciledKey = "live-accounting:reconciled"
+
 type redisLiveAccounting struct {
-	client *redis.Client
+	client redis.UniversalClient
 }
 
 // openRedisLiveAccounting returns a redisLiveAccounting cache instance.
 //
+// The address is one of:
+//
+//	redis://host:port?db=N&password=P
+//	redis-sentinel://host:port,host:port?master=NAME&db=N&password=P
+//	redis-cluster://host:port,host:port?password=P
+//
+// Sentinel and cluster clients follow the failovers of the master and of the
+// cluster nodes automatically.
+//
 // It returns accounting.ErrInvalidArgument if the connection address is invalid
 // according to Redis.
 //
@@ -37,26 +52,51 @@ func openRedisLiveAccounting(ctx context.Context, address string) (*redisLiveAcc
 		return nil, accounting.ErrInvalidArgument.New("address: invalid URL; %w", err)
 	}
 
-	if redisurl.Scheme != "redis" {
-		return nil, accounting.ErrInvalidArgument.New("address: not a redis:// formatted address")
-	}
-
 	q := redisurl.Query()
-	db := q.Get("db")
-	if db == "" {
-		return nil, accounting.ErrInvalidArgument.New("address: a database number has to be specified")
-	}
 
-	dbn, err := strconv.Atoi(db)
-	if err != nil {
-		return nil, accounting.ErrInvalidArgument.New("address: invalid database number %s", db)
-	}
+	var client redis.UniversalClient
+	switch redisurl.Scheme {
+	case "redis":
+		dbn, err := parseRedisDB(q)
+		if err != nil {
+			return nil, err
+		}
+
+		client = redis.NewClient(&redis.Options{
+			Addr:     redisurl.Host,
+			Password: q.Get("password"),
+			DB:       dbn,
+		})
+	case "redis-sentinel":
+		dbn, err := parseRedisDB(q)
+		if err != nil {
+			return nil, err
+		}
+
+		master := q.Get("master")
+		if master == "" {
+			return nil, accounting.ErrInvalidArgument.New("address: a sentinel master name has to be specified")
+		}
 
-	client := redis.NewClient(&redis.Options{
-		Addr:     redisurl.Host,
-		Password: q.Get("password"),
-		DB:       dbn,
-	})
+		client = redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       master,
+			SentinelAddrs:    strings.Split(redisurl.Host, ","),
+			SentinelPassword: q.Get("sentinel-password"),
+			Password:         q.Get("password"),
+			DB:               dbn,
+		})
+	case "redis-cluster":
+		if q.Get("db") != "" {
+			return nil, accounting.ErrInvalidArgument.New("address: redis cluster doesn't support database numbers")
+		}
+
+		client = redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:    strings.Split(redisurl.Host, ","),
+			Password: q.Get("password"),
+		})
+	default:
+		return nil, accounting.ErrInvalidArgument.New("address: not a redis://, redis-sentinel:// or redis-cluster:// formatted address")
+	}
 
 	cache := &redisLiveAccounting{
 		client: client,
@@ -70,6 +110,20 @@ func openRedisLiveAccounting(ctx context.Context, address string) (*redisLiveAcc
 	return cache, nil
 }
 
+// parseRedisDB returns the database number of the address query.
+func parseRedisDB(q url.Values) (int, error) {
+	db := q.Get("db")
+	if db == "" {
+		return 0, accounting.ErrInvalidArgument.New("address: a database number has to be specified")
+	}
+
+	dbn, err := strconv.Atoi(db)
+	if err != nil {
+		return 0, accounting.ErrInvalidArgument.New("address: invalid database number %s", db)
+	}
+	return dbn, nil
+}
+
 // GetProjectStorageUsage gets inline and remote storage totals for a given
 // project, back to the time of the last accounting tally.
 func (cache *redisLiveAccounting) GetProjectStorageUsage(ctx context.Context, projectID uuid.UUID) (totalUsed int64, err error) {
@@ -238,13 +292,15 @@ func (cache *redisLiveAccounting) AddProjectStorageUsageUpToLimit(ctx context.Co
 func (cache *redisLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ map[uuid.UUID]accounting.Usage, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	projects := make(map[uuid.UUID]accounting.Usage)
-	it := cache.client.Scan(ctx, 0, "*", 0).Iterator()
-	for it.Next(ctx) {
-		key := it.Val()
+	keys, err := cache.scanKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		// skip bandwidth keys
-		if strings.HasSuffix(key, "bandwidth") {
+	projects := make(map[uuid.UUID]accounting.Usage)
+	for _, key := range keys {
+		// skip bandwidth keys and the reconciliation marker
+		if strings.HasSuffix(key, "bandwidth") || key == reconciledKey {
 			continue
 		}
 
@@ -306,6 +362,60 @@ func (cache *redisLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ ma
 	return projects, nil
 }
 
+// scanKeys returns all the keys of the cache. The keys of a cluster are
+// scanned on each of its masters.
+func (cache *redisLiveAccounting) scanKeys(ctx context.Context) (_ []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var mu sync.Mutex
+	var keys []string
+	scan := func(ctx context.Context, client redis.Cmdable) error {
+		it := client.Scan(ctx, 0, "*", 0).Iterator()
+		for it.Next(ctx) {
+			mu.Lock()
+			keys = append(keys, it.Val())
+			mu.Unlock()
+		}
+		return it.Err()
+	}
+
+	if cluster, ok := cache.client.(*redis.ClusterClient); ok {
+		err = cluster.ForEachMaster(ctx, func(ctx context.Context, client *redis.Client) error {
+			return scan(ctx, client)
+		})
+	} else {
+		err = scan(ctx, cache.client)
+	}
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("Redis scan failed: %w", err)
+	}
+
+	return keys, nil
+}
+
+// IsReconciled returns whether the cache was reconciled with the persisted
+// usages since it lost its data.
+func (cache *redisLiveAccounting) IsReconciled(ctx context.Context) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	n, err := cache.client.Exists(ctx, reconciledKey).Result()
+	if err != nil {
+		return false, accounting.ErrSystemOrNetError.New("Redis exists failed: %w", err)
+	}
+	return n == 1, nil
+}
+
+// SetReconciled marks the cache as reconciled with the persisted usages.
+func (cache *redisLiveAccounting) SetReconciled(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = cache.client.Set(ctx, reconciledKey, time.Now().Unix(), 0).Err()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis set failed: %w", err)
+	}
+	return nil
+}
+
 // Close the DB connection.
 func (cache *redisLiveAccounting) Close() error {
 	err := cache.client.Close()
@@ -139,3 +139,35 @@ func (c *ProjectLimitCache) GetProjectSegmentLimit(ctx context.Context, projectI
 
 	return *projectLimits.Segments, nil
 }
+
+// IsExempt returns whether the project is exempted from the limit of the given
+// kind at the given time.
+func (c *ProjectLimitCache) IsExempt(ctx context.Context, projectID uuid.UUID, kind LimitKind, now time.Time) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	projectLimits, err := c.Get(ctx, projectID)
+	if err != nil {
+		return false, err
+	}
+	return projectLimits.IsExempt(kind, now), nil
+}
+
+// GetProjectFreeze returns the freeze of the project, nil when the project
+// isn't frozen.
+func (c *ProjectLimitCache) GetProjectFreeze(ctx context.Context, projectID uuid.UUID) (_ *ProjectFreeze, err error) {
+	defer mon.Task()(&ctx)(&err)
+	projectLimits, err := c.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return projectLimits.Freeze, nil
+}
+
+// IsProjectDeleted returns whether the project is deleted.
+func (c *ProjectLimitCache) IsProjectDeleted(ctx context.Context, projectID uuid.UUID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	projectLimits, err := c.Get(ctx, projectID)
+	if err != nil {
+		return false, err
+	}
+	return projectLimits.DeletedAt != nil, nil
+}
@@ -5,6 +5,7 @@ package accounting
 
 import (
 	"context"
+	"math"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -63,6 +64,7 @@ func (usage *Service) ExceedsBandwidthUsage(ctx context.Context, projectID uuid.
 	var (
 		group          errgroup.Group
 		bandwidthUsage int64
+		exempt         bool
 	)
 
 	group.Go(func() error {
@@ -70,6 +72,11 @@ func (usage *Service) ExceedsBandwidthUsage(ctx context.Context, projectID uuid.
 		limit, err = usage.projectLimitCache.GetProjectBandwidthLimit(ctx, projectID)
 		return err
 	})
+	group.Go(func() error {
+		var err error
+		exempt, err = usage.projectLimitCache.IsExempt(ctx, projectID, LimitKindBandwidth, usage.nowFn())
+		return err
+	})
 	group.Go(func() error {
 		var err error
 
@@ -103,6 +110,10 @@ func (usage *Service) ExceedsBandwidthUsage(ctx context.Context, projectID uuid.
 
 	// Verify the bandwidth usage cache.
 	if bandwidthUsage >= limit.Int64() {
+		if exempt {
+			mon.Meter("project_limit_exempted", monkit.NewSeriesTag("kind", string(LimitKindBandwidth))).Mark(1)
+			return false, limit, nil
+		}
 		return true, limit, nil
 	}
 
@@ -125,6 +136,13 @@ func (usage *Service) ExceedsUploadLimits(
 
 	var group errgroup.Group
 	var segmentUsage, storageUsage int64
+	var projectLimits ProjectLimits
+
+	group.Go(func() error {
+		var err error
+		projectLimits, err = usage.projectLimitCache.Get(ctx, projectID)
+		return err
+	})
 
 	group.Go(func() error {
 		var err error
@@ -160,6 +178,16 @@ func (usage *Service) ExceedsUploadLimits(
 	limit.ExceedsSegments = (segmentUsage + segmentCountHeadroom) > limit.SegmentsLimit
 	limit.ExceedsStorage = (storageUsage + storageSizeHeadroom) > limit.StorageLimit.Int64()
 
+	now := usage.nowFn()
+	if limit.ExceedsSegments && projectLimits.IsExempt(LimitKindSegments, now) {
+		mon.Meter("project_limit_exempted", monkit.NewSeriesTag("kind", string(LimitKindSegments))).Mark(1)
+		limit.ExceedsSegments = false
+	}
+	if limit.ExceedsStorage && projectLimits.IsExempt(LimitKindStorage, now) {
+		mon.Meter("project_limit_exempted", monkit.NewSeriesTag("kind", string(LimitKindStorage))).Mark(1)
+		limit.ExceedsStorage = false
+	}
+
 	return limit, nil
 }
 
@@ -173,12 +201,22 @@ func (usage *Service) AddProjectUsageUpToLimit(ctx context.Context, projectID uu
 		return err
 	}
 
-	err = usage.liveAccounting.AddProjectStorageUsageUpToLimit(ctx, projectID, storage, *limits.Usage)
+	// exempted limits are enforced as if there was no limit.
+	storageLimit, segmentsLimit := *limits.Usage, *limits.Segments
+	now := usage.nowFn()
+	if limits.IsExempt(LimitKindStorage, now) {
+		storageLimit = math.MaxInt64
+	}
+	if limits.IsExempt(LimitKindSegments, now) {
+		segmentsLimit = math.MaxInt64
+	}
+
+	err = usage.liveAccounting.AddProjectStorageUsageUpToLimit(ctx, projectID, storage, storageLimit)
 	if err != nil {
 		return err
 	}
 
-	err = usage.liveAccounting.AddProjectSegmentUsageUpToLimit(ctx, projectID, segments, *limits.Segments)
+	err = usage.liveAccounting.AddProjectSegmentUsageUpToLimit(ctx, projectID, segments, segmentsLimit)
 	if ErrProjectLimitExceeded.Has(err) {
 		// roll back storage increase
 		err = usage.liveAccounting.AddProjectStorageUsage(ctx, projectID, -1*storage)
@@ -243,6 +281,28 @@ func (usage *Service) GetProjectSegmentLimit(ctx context.Context, projectID uuid
 	return usage.projectLimitCache.GetProjectSegmentLimit(ctx, projectID)
 }
 
+// GetProjectFreeze returns the freeze of the project, nil when the project
+// isn't frozen.
+func (usage *Service) GetProjectFreeze(ctx context.Context, projectID uuid.UUID) (_ *ProjectFreeze, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+	return usage.projectLimitCache.GetProjectFreeze(ctx, projectID)
+}
+
+// IsProjectDeleted returns whether the project is deleted.
+func (usage *Service) IsProjectDeleted(ctx context.Context, projectID uuid.UUID) (_ bool, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+	return usage.projectLimitCache.IsProjectDeleted(ctx, projectID)
+}
+
+// GetLatestBucketTally returns the latest tally of the bucket, which is
+// empty when the bucket hasn't been tallied yet.
+func (usage *Service) GetLatestBucketTally(ctx context.Context, bucket metabase.BucketLocation) (_ BucketTally, err error) {
+	defer mon.Task()(&ctx, bucket.ProjectID)(&err)
+
+	tally, err := usage.projectAccountingDB.GetLatestBucketTally(ctx, bucket)
+	return tally, ErrProjectUsage.Wrap(err)
+}
+
 // UpdateProjectLimits sets new value for project's bandwidth and storage limit.
 // TODO remove because it's not used.
 func (usage *Service) UpdateProjectLimits(ctx context.Context, projectID uuid.UUID, limit memory.Size) (err error) {
@@ -15,6 +15,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/scheduler"
 )
 
 // Error is a standard error class for this package.
@@ -29,7 +30,8 @@ type Config struct {
 	SaveRollupBatchSize int           `help:"how large of batches SaveRollup should process at a time" default:"1000"`
 	ReadRollupBatchSize int           `help:"how large of batches GetBandwidthSince should process at a time" default:"10000"`
 
-	ListLimit          int           `help:"how many objects to query in a batch" default:"2500"`
+	UseObjectsLoop     bool          `help:"flag to switch between calculating bucket tallies using objects loop or custom query" default:"true"`
+	ListLimit          int           `help:"how many objects (or buckets, when the objects loop isn't used) to query in a batch" default:"2500"`
 	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
 }
 
@@ -45,11 +47,12 @@ type Service struct {
 	liveAccounting          accounting.Cache
 	storagenodeAccountingDB accounting.StoragenodeAccounting
 	projectAccountingDB     accounting.ProjectAccounting
+	scheduler               *scheduler.Scheduler
 	nowFn                   func() time.Time
 }
 
 // New creates a new tally Service.
-func New(log *zap.Logger, sdb accounting.StoragenodeAccounting, pdb accounting.ProjectAccounting, liveAccounting accounting.Cache, metabase *metabase.DB, config Config) *Service {
+func New(log *zap.Logger, sdb accounting.StoragenodeAccounting, pdb accounting.ProjectAccounting, liveAccounting accounting.Cache, metabase *metabase.DB, scheduler *scheduler.Scheduler, config Config) *Service {
 	return &Service{
 		log:    log,
 		config: config,
@@ -59,6 +62,7 @@ func New(log *zap.Logger, sdb accounting.StoragenodeAccounting, pdb accounting.P
 		liveAccounting:          liveAccounting,
 		storagenodeAccountingDB: sdb,
 		projectAccountingDB:     pdb,
+		scheduler:               scheduler,
 		nowFn:                   time.Now,
 	}
 }
@@ -68,7 +72,13 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	return service.Loop.Run(ctx, func(ctx context.Context) error {
-		err := service.Tally(ctx)
+		release, err := service.scheduler.Acquire(ctx, "tally")
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		err = service.Tally(ctx)
 		if err != nil {
 			service.log.Error("tally failed", zap.Error(err))
 		}
@@ -267,6 +277,10 @@ func (observer *BucketTallyCollector) Run(ctx context.Context) (err error) {
 		return err
 	}
 
+	if !observer.config.UseObjectsLoop {
+		return observer.collectBucketTallies(ctx, startingTime)
+	}
+
 	return observer.metabase.IterateLoopObjects(ctx, metabase.IterateLoopObjects{
 		BatchSize:          observer.config.ListLimit,
 		AsOfSystemTime:     startingTime,
@@ -283,6 +297,43 @@ func (observer *BucketTallyCollector) Run(ctx context.Context) (err error) {
 	})
 }
 
+// collectBucketTallies collects the bucket tallies aggregated by the metabase,
+// without iterating over the objects.
+func (observer *BucketTallyCollector) collectBucketTallies(ctx context.Context, startingTime time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var after metabase.BucketLocation
+	for {
+		tallies, err := observer.metabase.CollectBucketTallies(ctx, metabase.CollectBucketTallies{
+			After:              after,
+			Limit:              observer.config.ListLimit,
+			Now:                observer.Now,
+			AsOfSystemTime:     startingTime,
+			AsOfSystemInterval: observer.config.AsOfSystemInterval,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(tallies) == 0 {
+			return nil
+		}
+
+		for _, tally := range tallies {
+			observer.Bucket[tally.BucketLocation] = &accounting.BucketTally{
+				BucketLocation:     tally.BucketLocation,
+				ObjectCount:        tally.ObjectCount,
+				PendingObjectCount: tally.PendingObjectCount,
+				TotalSegments:      tally.TotalSegments,
+				TotalBytes:         tally.TotalEncryptedSize,
+				MetadataSize:       tally.MetadataSize,
+			}
+		}
+
+		after = tallies[len(tallies)-1].BucketLocation
+	}
+}
+
 // ensureBucket returns bucket corresponding to the passed in path.
 func (observer *BucketTallyCollector) ensureBucket(location metabase.ObjectLocation) *accounting.BucketTally {
 	bucketLocation := location.Bucket()
@@ -303,6 +354,10 @@ func (observer *BucketTallyCollector) object(ctx context.Context, object metabas
 	if object.Expired(observer.Now) {
 		return nil
 	}
+	// delete markers only hide the previous versions of objects
+	if object.Status == metabase.DeleteMarker {
+		return nil
+	}
 
 	bucket := observer.ensureBucket(object.ObjectStream.Location())
 	bucket.TotalSegments += int64(object.SegmentCount)
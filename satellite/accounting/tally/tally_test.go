@@ -171,10 +171,16 @@ func TestCalculateBucketAtRestData(t *testing.T) {
 		}
 		require.Len(t, expectedTotal, 3)
 
-		collector := tally.NewBucketTallyCollector(satellite.Log.Named("bucket tally"), time.Now(), satellite.Metabase.DB, planet.Satellites[0].Config.Tally)
-		err = collector.Run(ctx)
-		require.NoError(t, err)
-		require.Equal(t, expectedTotal, collector.Bucket)
+		for _, useObjectsLoop := range []bool{true, false} {
+			config := planet.Satellites[0].Config.Tally
+			config.UseObjectsLoop = useObjectsLoop
+			config.ListLimit = 1
+
+			collector := tally.NewBucketTallyCollector(satellite.Log.Named("bucket tally"), time.Now(), satellite.Metabase.DB, config)
+			err = collector.Run(ctx)
+			require.NoError(t, err)
+			require.Equal(t, expectedTotal, collector.Bucket)
+		}
 	})
 }
 
@@ -0,0 +1,493 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrAuthorizationNotEnabled is returned when no authentication method is configured.
+	ErrAuthorizationNotEnabled = errs.Class("authorization not enabled")
+	// ErrUnauthenticated is returned when the credentials of a request are missing or invalid.
+	ErrUnauthenticated = errs.Class("unauthenticated")
+)
+
+// OAuthConfig defines the configuration of the OAuth2/OIDC authentication of
+// the admin API.
+type OAuthConfig struct {
+	Issuer      string        `help:"the OIDC issuer URL. When set, requests must carry a bearer ID token issued by it instead of the static authorization token" default:""`
+	ClientID    string        `help:"the client ID of the admin API at the OIDC issuer. The audience of the ID tokens must contain it" default:""`
+	GroupsClaim string        `help:"the ID token claim which contains the groups of the user" default:"groups"`
+	ReadGroups  []string      `help:"the groups which are allowed to use the read-only endpoints" default:""`
+	WriteGroups []string      `help:"the groups which are allowed to use all the endpoints" default:""`
+	CacheTTL    time.Duration `help:"how long the result of validating an ID token is cached, including the rejected ones" default:"1m"`
+}
+
+// Permission is the level of access of an authenticated request.
+type Permission int
+
+const (
+	// PermissionNone doesn't allow using any endpoint.
+	PermissionNone Permission = iota
+	// PermissionRead allows using the read-only endpoints.
+	PermissionRead
+	// PermissionWrite allows using all the endpoints.
+	PermissionWrite
+)
+
+// Authenticator authenticates the requests to the admin API.
+type Authenticator interface {
+	// Authenticate returns the permission of the request.
+	Authenticate(r *http.Request) (Permission, error)
+}
+
+// NewAuthenticator returns the authenticator for the configuration. OAuth2
+// is used when an issuer is configured, the static token otherwise.
+func NewAuthenticator(log *zap.Logger, config Config) Authenticator {
+	if config.Oauth.Issuer != "" {
+		return newOIDCAuthenticator(log, config.Oauth, http.DefaultClient)
+	}
+	return tokenAuthenticator{token: config.AuthorizationToken}
+}
+
+// tokenAuthenticator gives full access to the requests which carry the
+// static authorization token.
+type tokenAuthenticator struct {
+	token string
+}
+
+// Authenticate implements Authenticator.
+func (auth tokenAuthenticator) Authenticate(r *http.Request) (Permission, error) {
+	if auth.token == "" {
+		return PermissionNone, ErrAuthorizationNotEnabled.New("")
+	}
+
+	equality := subtle.ConstantTimeCompare(
+		[]byte(r.Header.Get("Authorization")),
+		[]byte(auth.token),
+	)
+	if equality != 1 {
+		return PermissionNone, ErrUnauthenticated.New("invalid token")
+	}
+	return PermissionWrite, nil
+}
+
+// oidcAuthenticator validates bearer ID tokens with the signing keys of an
+// OIDC provider and gives access according to the groups of the user.
+type oidcAuthenticator struct {
+	log    *zap.Logger
+	config OAuthConfig
+	client *http.Client
+	nowFn  func() time.Time
+
+	mu            sync.Mutex
+	jwksURI       string
+	keys          map[string]interface{}
+	keysRefreshed time.Time
+	cache         map[string]cachedPermission
+}
+
+type cachedPermission struct {
+	permission Permission
+	err        error
+	expiresAt  time.Time
+}
+
+const (
+	// minKeysRefreshInterval is the minimum time between fetching the signing
+	// keys of the provider, so tokens with unknown key IDs can't be used to
+	// flood the provider with requests.
+	minKeysRefreshInterval = time.Minute
+
+	// maxCachedTokens is the maximum number of tokens whose validation result
+	// is cached.
+	maxCachedTokens = 10000
+)
+
+// signingMethods are the JWT signing algorithms, which are accepted for ID tokens.
+var signingMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+
+func newOIDCAuthenticator(log *zap.Logger, config OAuthConfig, client *http.Client) *oidcAuthenticator {
+	return &oidcAuthenticator{
+		log:    log,
+		config: config,
+		client: client,
+		nowFn:  time.Now,
+		keys:   map[string]interface{}{},
+		cache:  map[string]cachedPermission{},
+	}
+}
+
+// Authenticate implements Authenticator.
+func (auth *oidcAuthenticator) Authenticate(r *http.Request) (Permission, error) {
+	ctx := r.Context()
+
+	if auth.config.ClientID == "" {
+		return PermissionNone, Error.New("the client ID of the admin API isn't configured")
+	}
+
+	header := r.Header.Get("Authorization")
+	if len(header) < len("Bearer ") || !strings.EqualFold(header[:len("Bearer ")], "Bearer ") {
+		return PermissionNone, ErrUnauthenticated.New("missing bearer token")
+	}
+	token := header[len("Bearer "):]
+
+	now := auth.nowFn()
+
+	auth.mu.Lock()
+	cached, ok := auth.cache[token]
+	auth.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.permission, cached.err
+	}
+
+	expiresAt := now.Add(auth.config.CacheTTL)
+	claims, err := auth.verify(ctx, token)
+	if err != nil && !ErrUnauthenticated.Has(err) {
+		return PermissionNone, err
+	}
+
+	permission := PermissionNone
+	if err == nil {
+		permission = auth.permission(claims)
+		if exp, ok := claims["exp"].(float64); ok && time.Unix(int64(exp), 0).Before(expiresAt) {
+			expiresAt = time.Unix(int64(exp), 0)
+		}
+	}
+
+	auth.mu.Lock()
+	for key, cached := range auth.cache {
+		if !now.Before(cached.expiresAt) {
+			delete(auth.cache, key)
+		}
+	}
+	if len(auth.cache) < maxCachedTokens {
+		auth.cache[token] = cachedPermission{
+			permission: permission,
+			err:        err,
+			expiresAt:  expiresAt,
+		}
+	}
+	auth.mu.Unlock()
+
+	return permission, err
+}
+
+// verify returns the claims of the ID token, after checking its signature,
+// issuer, audience and expiration.
+func (auth *oidcAuthenticator) verify(ctx context.Context, token string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	parser := jwt.Parser{ValidMethods: signingMethods}
+
+	var keyErr error
+	_, err := parser.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := auth.signingKey(ctx, kid)
+		if err != nil {
+			keyErr = err
+		}
+		return key, err
+	})
+	if keyErr != nil && !ErrUnauthenticated.Has(keyErr) {
+		return nil, keyErr
+	}
+	if err != nil {
+		return nil, ErrUnauthenticated.Wrap(err)
+	}
+
+	if !claims.VerifyIssuer(auth.config.Issuer, true) {
+		return nil, ErrUnauthenticated.New("invalid issuer")
+	}
+	if !claims.VerifyAudience(auth.config.ClientID, true) {
+		return nil, ErrUnauthenticated.New("invalid audience")
+	}
+	if !claims.VerifyExpiresAt(auth.nowFn().Unix(), true) {
+		return nil, ErrUnauthenticated.New("token is expired")
+	}
+	return claims, nil
+}
+
+// signingKey returns the signing key of the provider with the key ID. The
+// keys are fetched again when the key ID is unknown, at most once per
+// minKeysRefreshInterval.
+func (auth *oidcAuthenticator) signingKey(ctx context.Context, kid string) (interface{}, error) {
+	auth.mu.Lock()
+	key, ok := auth.keys[kid]
+	refresh := auth.nowFn().Sub(auth.keysRefreshed) >= minKeysRefreshInterval
+	if !ok && refresh {
+		auth.keysRefreshed = auth.nowFn()
+	}
+	auth.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+	if !refresh {
+		return nil, ErrUnauthenticated.New("unknown signing key %q", kid)
+	}
+
+	keys, err := auth.fetchKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	auth.mu.Lock()
+	auth.keys = keys
+	auth.mu.Unlock()
+
+	key, ok = keys[kid]
+	if !ok {
+		return nil, ErrUnauthenticated.New("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// jsonWebKey is a public key of a JSON Web Key Set.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// fetchKeys returns the signing keys of the provider by their key IDs.
+func (auth *oidcAuthenticator) fetchKeys(ctx context.Context) (map[string]interface{}, error) {
+	jwksURI, err := auth.discoverJWKSURI(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	status, err := auth.getJSON(req, &jwks)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, Error.New("unexpected JWKS response status: %d", status)
+	}
+
+	keys := map[string]interface{}{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			auth.log.Warn("skipping invalid signing key", zap.String("Key ID", jwk.Kid), zap.Error(err))
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	auth.log.Debug("fetched signing keys", zap.Int("Keys", len(keys)))
+
+	return keys, nil
+}
+
+// publicKey returns the RSA or ECDSA public key.
+func (jwk jsonWebKey) publicKey() (interface{}, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() > math.MaxInt32 {
+			return nil, Error.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, Error.New("unsupported curve %q", jwk.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, Error.New("point isn't on curve %q", jwk.Crv)
+		}
+		return key, nil
+	default:
+		return nil, Error.New("unsupported key type %q", jwk.Kty)
+	}
+}
+
+// discoverJWKSURI returns the JWKS URI of the issuer, from its OpenID
+// provider configuration.
+func (auth *oidcAuthenticator) discoverJWKSURI(ctx context.Context) (string, error) {
+	auth.mu.Lock()
+	jwksURI := auth.jwksURI
+	auth.mu.Unlock()
+	if jwksURI != "" {
+		return jwksURI, nil
+	}
+
+	discoveryURL := strings.TrimSuffix(auth.config.Issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	var discovery struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	status, err := auth.getJSON(req, &discovery)
+	if err != nil {
+		return "", err
+	}
+	if status != http.StatusOK {
+		return "", Error.New("unexpected openid configuration response status: %d", status)
+	}
+	if discovery.JWKSURI == "" {
+		return "", Error.New("issuer doesn't provide a JWKS URI")
+	}
+
+	auth.mu.Lock()
+	auth.jwksURI = discovery.JWKSURI
+	auth.mu.Unlock()
+
+	auth.log.Debug("discovered JWKS URI", zap.String("URI", discovery.JWKSURI))
+
+	return discovery.JWKSURI, nil
+}
+
+// getJSON sends the request and decodes the JSON response body into v when
+// the response status is 200 OK.
+func (auth *oidcAuthenticator) getJSON(req *http.Request, v interface{}) (status int, err error) {
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := auth.client.Do(req)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, nil
+	}
+	return resp.StatusCode, Error.Wrap(json.NewDecoder(resp.Body).Decode(v))
+}
+
+// permission returns the permission given by the groups of the claims.
+func (auth *oidcAuthenticator) permission(claims jwt.MapClaims) Permission {
+	var groups []string
+	switch value := claims[auth.config.GroupsClaim].(type) {
+	case string:
+		groups = []string{value}
+	case []interface{}:
+		for _, group := range value {
+			if group, ok := group.(string); ok {
+				groups = append(groups, group)
+			}
+		}
+	}
+
+	permission := PermissionNone
+	for _, group := range groups {
+		if containsString(auth.config.WriteGroups, group) {
+			return PermissionWrite
+		}
+		if containsString(auth.config.ReadGroups, group) {
+			permission = PermissionRead
+		}
+	}
+	return permission
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type permissionKey struct{}
+
+func allowedAuthorization(log *zap.Logger, auth Authenticator) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			permission, err := auth.Authenticate(r)
+			if err != nil {
+				if ErrAuthorizationNotEnabled.Has(err) {
+					sendJSONError(w, "Authorization not enabled.",
+						"", http.StatusForbidden)
+					return
+				}
+				if !ErrUnauthenticated.Has(err) {
+					log.Error("failed to authenticate request", zap.Error(err))
+				}
+				sendJSONError(w, "Forbidden",
+					"", http.StatusForbidden)
+				return
+			}
+
+			r.Header.Set("Cache-Control", "must-revalidate")
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), permissionKey{}, permission)))
+		})
+	}
+}
+
+// requirePermission returns a wrapper of handlers, which only lets the
+// requests authenticated with at least the permission through.
+func requirePermission(required Permission) func(next http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			permission, _ := r.Context().Value(permissionKey{}).(Permission)
+			if permission < required {
+				sendJSONError(w, "Forbidden",
+					"", http.StatusForbidden)
+				return
+			}
+			next(w, r)
+		}
+	}
+}
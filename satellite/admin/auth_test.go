@@ -0,0 +1,194 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestOIDCAuthorization(t *testing.T) {
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwksRequests := 0
+	mux := http.NewServeMux()
+	provider := httptest.NewServer(mux)
+	defer provider.Close()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   provider.URL,
+			"jwks_uri": provider.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		jwksRequests++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "key",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(signingKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(signingKey.E)).Bytes()),
+			}},
+		})
+	})
+
+	const clientID = "admin"
+	config := Config{
+		AuthorizationToken: "static-token",
+		Oauth: OAuthConfig{
+			Issuer:      provider.URL,
+			ClientID:    clientID,
+			GroupsClaim: "groups",
+			ReadGroups:  []string{"support"},
+			WriteGroups: []string{"operations"},
+			CacheTTL:    time.Minute,
+		},
+	}
+
+	ok := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+	router := http.NewServeMux()
+	router.HandleFunc("/read", requirePermission(PermissionRead)(ok))
+	router.HandleFunc("/write", requirePermission(PermissionWrite)(ok))
+	handler := allowedAuthorization(zaptest.NewLogger(t), NewAuthenticator(zaptest.NewLogger(t), config))(router)
+
+	status := func(path, authorization string) int {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	sign := func(key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		return "Bearer " + signed
+	}
+	idToken := func(groups ...string) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":    provider.URL,
+			"aud":    []string{clientID, "other"},
+			"sub":    "user",
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"groups": groups,
+		}
+	}
+	with := func(claims jwt.MapClaims, key string, value interface{}) jwt.MapClaims {
+		claims[key] = value
+		return claims
+	}
+
+	reader := sign(signingKey, "key", idToken("support"))
+	writer := sign(signingKey, "key", idToken("support", "operations"))
+
+	for _, tt := range []struct {
+		name          string
+		authorization string
+		read, write   int
+	}{
+		{"no token", "", http.StatusForbidden, http.StatusForbidden},
+		{"static token", "static-token", http.StatusForbidden, http.StatusForbidden},
+		{"not a JWT", "Bearer access-token", http.StatusForbidden, http.StatusForbidden},
+		{"other group", sign(signingKey, "key", idToken("marketing")), http.StatusForbidden, http.StatusForbidden},
+		{"reader", reader, http.StatusOK, http.StatusForbidden},
+		{"writer", writer, http.StatusOK, http.StatusOK},
+		{"lowercase scheme", "bearer" + writer[len("Bearer"):], http.StatusOK, http.StatusOK},
+		{"other audience", sign(signingKey, "key", with(idToken("operations"), "aud", "other")), http.StatusForbidden, http.StatusForbidden},
+		{"no audience", sign(signingKey, "key", with(idToken("operations"), "aud", nil)), http.StatusForbidden, http.StatusForbidden},
+		{"other issuer", sign(signingKey, "key", with(idToken("operations"), "iss", "https://example.test")), http.StatusForbidden, http.StatusForbidden},
+		{"expired", sign(signingKey, "key", with(idToken("operations"), "exp", time.Now().Add(-time.Minute).Unix())), http.StatusForbidden, http.StatusForbidden},
+		{"no expiration", sign(signingKey, "key", with(idToken("operations"), "exp", nil)), http.StatusForbidden, http.StatusForbidden},
+		{"other signing key", sign(otherKey, "key", idToken("operations")), http.StatusForbidden, http.StatusForbidden},
+	} {
+		require.Equal(t, tt.read, status("/read", tt.authorization), tt.name)
+		require.Equal(t, tt.write, status("/write", tt.authorization), tt.name)
+	}
+	require.Equal(t, 1, jwksRequests)
+
+	// the keys are fetched again for an unknown key ID, but only once per
+	// refresh interval.
+	unknownKey := sign(otherKey, "other-key", idToken("operations"))
+	require.Equal(t, http.StatusForbidden, status("/read", unknownKey))
+	require.Equal(t, http.StatusForbidden, status("/read", sign(otherKey, "another-key", idToken("operations"))))
+	require.Equal(t, 1, jwksRequests)
+
+	config.Oauth.CacheTTL = 5 * minKeysRefreshInterval
+	auth := NewAuthenticator(zaptest.NewLogger(t), config).(*oidcAuthenticator)
+	now := time.Now()
+	auth.nowFn = func() time.Time { return now }
+	authenticate := func(authorization string) (Permission, error) {
+		req := httptest.NewRequest(http.MethodGet, "/read", nil)
+		req.Header.Set("Authorization", authorization)
+		return auth.Authenticate(req)
+	}
+
+	permission, err := authenticate(writer)
+	require.NoError(t, err)
+	require.Equal(t, PermissionWrite, permission)
+	_, err = authenticate(unknownKey)
+	require.True(t, ErrUnauthenticated.Has(err))
+	require.Equal(t, 2, jwksRequests)
+
+	// the rejected tokens are cached too.
+	now = now.Add(minKeysRefreshInterval)
+	_, err = authenticate(unknownKey)
+	require.True(t, ErrUnauthenticated.Has(err))
+	require.Equal(t, 2, jwksRequests)
+
+	// the keys are fetched again once the rejection isn't cached anymore.
+	now = now.Add(config.Oauth.CacheTTL)
+	_, err = authenticate(unknownKey)
+	require.True(t, ErrUnauthenticated.Has(err))
+	require.Equal(t, 3, jwksRequests)
+
+	// the tokens are rejected when the client ID isn't configured.
+	config.Oauth.ClientID = ""
+	handler = allowedAuthorization(zaptest.NewLogger(t), NewAuthenticator(zaptest.NewLogger(t), config))(router)
+	require.Equal(t, http.StatusForbidden, status("/read", writer))
+}
+
+func TestTokenAuthorization(t *testing.T) {
+	handler := func(token string) http.Handler {
+		return allowedAuthorization(zaptest.NewLogger(t), NewAuthenticator(zaptest.NewLogger(t), Config{AuthorizationToken: token}))(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}),
+		)
+	}
+
+	status := func(handler http.Handler, method, authorization string) int {
+		req := httptest.NewRequest(method, "/api/projects/id", nil)
+		req.Header.Set("Authorization", authorization)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusForbidden, status(handler(""), http.MethodGet, ""))
+	require.Equal(t, http.StatusForbidden, status(handler("token"), http.MethodGet, "wrong-token"))
+	require.Equal(t, http.StatusOK, status(handler("token"), http.MethodGet, "token"))
+	require.Equal(t, http.StatusOK, status(handler("token"), http.MethodDelete, "token"))
+}
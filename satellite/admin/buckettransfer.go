@@ -0,0 +1,103 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+func (server *Server) transferBucket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		ProjectID uuid.UUID `json:"projectId"`
+		Copy      bool      `json:"copy"`
+	}
+	if err := json.Unmarshal(body, &input); err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if input.ProjectID.IsZero() {
+		sendJSONError(w, "projectId missing", "", http.StatusBadRequest)
+		return
+	}
+	if input.ProjectID == project.UUID {
+		sendJSONError(w, "invalid projectId",
+			"the bucket is already in the project", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := server.db.Console().Projects().Get(ctx, input.ProjectID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendJSONError(w, "project not found", input.ProjectID.String(), http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "unable to fetch project details",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	result, err := server.buckets.TransferBucket(ctx, buckets.TransferBucket{
+		Bucket: metabase.BucketLocation{
+			ProjectID:  project.UUID,
+			BucketName: string(bucket),
+		},
+		NewProjectID: input.ProjectID,
+		Copy:         input.Copy,
+	})
+	if err != nil {
+		switch {
+		case storj.ErrBucketNotFound.Has(err):
+			sendJSONError(w, "bucket not found", string(bucket), http.StatusNotFound)
+		case buckets.ErrBucketAlreadyExists.Has(err):
+			sendJSONError(w, "bucket already exists",
+				"the project has a bucket with the same name", http.StatusConflict)
+		case metabase.ErrInvalidRequest.Has(err):
+			sendJSONError(w, "invalid request", err.Error(), http.StatusBadRequest)
+		default:
+			sendJSONError(w, "unable to transfer bucket",
+				err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(struct {
+		Objects int64 `json:"objects"`
+	}{
+		Objects: result.Objects,
+	})
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
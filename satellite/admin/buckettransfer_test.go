@@ -0,0 +1,104 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+)
+
+func TestTransferBucket(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 4,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplink := planet.Uplinks[0]
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+		source := uplink.Projects[0]
+
+		destination, err := sat.AddProject(ctx, source.Owner.ID, "destination")
+		require.NoError(t, err)
+
+		for _, bucket := range []string{"moved", "copied", "existing"} {
+			err = uplink.Upload(ctx, sat, bucket, "README.md", testrand.Bytes(10*1024))
+			require.NoError(t, err)
+		}
+		_, err = sat.DB.Buckets().CreateBucket(ctx, storj.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "existing",
+			ProjectID: destination.ID,
+		})
+		require.NoError(t, err)
+
+		transferURL := func(bucket string) string {
+			return fmt.Sprintf("http://%s/api/projects/%s/buckets/%s/transfer", address, source.ID, bucket)
+		}
+
+		t.Run("invalid project", func(t *testing.T) {
+			assertReq(ctx, t, transferURL("moved"), http.MethodPost, `{"projectId":"`+source.ID.String()+`"}`,
+				http.StatusBadRequest, `{"error":"invalid projectId","detail":"the bucket is already in the project"}`, authToken)
+
+			unknown := testrand.UUID()
+			assertReq(ctx, t, transferURL("moved"), http.MethodPost, `{"projectId":"`+unknown.String()+`"}`,
+				http.StatusNotFound, `{"error":"project not found","detail":"`+unknown.String()+`"}`, authToken)
+		})
+
+		t.Run("bucket not found", func(t *testing.T) {
+			assertReq(ctx, t, transferURL("missing"), http.MethodPost, `{"projectId":"`+destination.ID.String()+`"}`,
+				http.StatusNotFound, `{"error":"bucket not found","detail":"missing"}`, authToken)
+		})
+
+		t.Run("bucket already exists", func(t *testing.T) {
+			assertReq(ctx, t, transferURL("existing"), http.MethodPost, `{"projectId":"`+destination.ID.String()+`"}`,
+				http.StatusConflict, `{"error":"bucket already exists","detail":"the project has a bucket with the same name"}`, authToken)
+		})
+
+		t.Run("move", func(t *testing.T) {
+			assertReq(ctx, t, transferURL("moved"), http.MethodPost, `{"projectId":"`+destination.ID.String()+`"}`,
+				http.StatusOK, `{"objects":1}`, authToken)
+
+			_, err := sat.DB.Buckets().GetBucket(ctx, []byte("moved"), source.ID)
+			require.True(t, storj.ErrBucketNotFound.Has(err))
+			_, err = sat.DB.Buckets().GetBucket(ctx, []byte("moved"), destination.ID)
+			require.NoError(t, err)
+
+			objects, err := sat.Metabase.DB.TestingAllCommittedObjects(ctx, destination.ID, "moved")
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+		})
+
+		t.Run("copy", func(t *testing.T) {
+			assertReq(ctx, t, transferURL("copied"), http.MethodPost, `{"projectId":"`+destination.ID.String()+`","copy":true}`,
+				http.StatusOK, `{"objects":1}`, authToken)
+
+			_, err := sat.DB.Buckets().GetBucket(ctx, []byte("copied"), destination.ID)
+			require.NoError(t, err)
+
+			objects, err := sat.Metabase.DB.TestingAllCommittedObjects(ctx, destination.ID, "copied")
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+
+			_, err = uplink.Download(ctx, sat, "copied", "README.md")
+			require.NoError(t, err)
+		})
+	})
+}
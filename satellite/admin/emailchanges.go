@@ -0,0 +1,149 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/mail"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/console"
+)
+
+func (server *Server) listEmailChanges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	user, ok := server.getUserByEmailVar(w, r)
+	if !ok {
+		return
+	}
+
+	changes, err := server.db.Console().EmailChanges().ListByUserID(ctx, user.ID)
+	if err != nil {
+		sendJSONError(w, "failed to list email changes",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if changes == nil {
+		changes = []console.EmailChange{}
+	}
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+// changeUserEmail changes the email of the user without verifying the new
+// address, and cancels the pending email changes of the user.
+func (server *Server) changeUserEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	user, ok := server.getUserByEmailVar(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Email string `json:"email"`
+	}
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, err := mail.ParseAddress(input.Email); err != nil {
+		sendJSONError(w, "invalid email",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	verified, unverified, err := server.db.Console().Users().GetByEmailWithUnverified(ctx, input.Email)
+	if err != nil {
+		sendJSONError(w, "failed to check for user email",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if verified != nil || len(unverified) != 0 {
+		sendJSONError(w, fmt.Sprintf("user with email %q already exists", input.Email),
+			"", http.StatusConflict)
+		return
+	}
+
+	err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		err := tx.Users().Update(ctx, user.ID, console.UpdateUserRequest{
+			Email: &input.Email,
+		})
+		if err != nil {
+			return err
+		}
+		return tx.EmailChanges().DeleteByUserID(ctx, user.ID)
+	})
+	if err != nil {
+		sendJSONError(w, "failed to change user email",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) cancelEmailChanges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	user, ok := server.getUserByEmailVar(w, r)
+	if !ok {
+		return
+	}
+
+	err := server.db.Console().EmailChanges().DeleteByUserID(ctx, user.ID)
+	if err != nil {
+		sendJSONError(w, "failed to cancel email changes",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// getUserByEmailVar returns the user with the email of the useremail path
+// variable. It sends an error response when the user can't be returned.
+func (server *Server) getUserByEmailVar(w http.ResponseWriter, r *http.Request) (*console.User, bool) {
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing",
+			"", http.StatusBadRequest)
+		return nil, false
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(r.Context(), userEmail)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+			"", http.StatusNotFound)
+		return nil, false
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get user",
+			err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	return user, true
+}
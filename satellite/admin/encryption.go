@@ -0,0 +1,183 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// deprecatedCipherSuites are the cipher suites, which are still accepted,
+// but which aren't used by default by current uplinks.
+var deprecatedCipherSuites = map[storj.CipherSuite]bool{
+	storj.EncSecretBox: true,
+}
+
+// cipherSuiteName returns the name of the cipher suite used in reports.
+func cipherSuiteName(cipher storj.CipherSuite) string {
+	switch cipher {
+	case storj.EncUnspecified:
+		return "unspecified"
+	case storj.EncNull:
+		return "null"
+	case storj.EncAESGCM:
+		return "aesgcm"
+	case storj.EncSecretBox:
+		return "secretbox"
+	case storj.EncNullBase64URL:
+		return "null-base64url"
+	default:
+		return fmt.Sprintf("unknown(%d)", cipher)
+	}
+}
+
+// isUnencrypted returns whether the cipher suite doesn't encrypt.
+func isUnencrypted(cipher storj.CipherSuite) bool {
+	return cipher == storj.EncNull || cipher == storj.EncNullBase64URL
+}
+
+// encryptionReport is the report of the encryption modes used by a project.
+type encryptionReport struct {
+	ProjectID uuid.UUID `json:"projectId"`
+
+	Buckets []bucketEncryptionReport `json:"buckets"`
+
+	UnencryptedPathBuckets  int   `json:"unencryptedPathBuckets"`
+	UnencryptedObjects      int64 `json:"unencryptedObjects"`
+	DeprecatedCipherObjects int64 `json:"deprecatedCipherObjects"`
+}
+
+// bucketEncryptionReport is the report of the encryption modes used in a bucket.
+type bucketEncryptionReport struct {
+	Name          string `json:"name"`
+	PathCipher    string `json:"pathCipher"`
+	PathEncrypted bool   `json:"pathEncrypted"`
+
+	Objects []cipherObjectCount `json:"objects"`
+
+	UnencryptedObjects      int64 `json:"unencryptedObjects"`
+	DeprecatedCipherObjects int64 `json:"deprecatedCipherObjects"`
+}
+
+// cipherObjectCount is the number of objects, which use the encryption parameters.
+type cipherObjectCount struct {
+	Cipher     string `json:"cipher"`
+	BlockSize  int32  `json:"blockSize"`
+	Count      int64  `json:"count"`
+	Deprecated bool   `json:"deprecated"`
+}
+
+func (server *Server) getProjectEncryptionReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	projectUUID, err := uuid.FromString(projectUUIDString)
+	if err != nil {
+		sendJSONError(w, "invalid project-uuid",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, err := server.db.Console().Projects().Get(ctx, projectUUID); err != nil {
+		sendJSONError(w, "unable to fetch project details",
+			err.Error(), http.StatusNotFound)
+		return
+	}
+
+	report := encryptionReport{
+		ProjectID: projectUUID,
+		Buckets:   []bucketEncryptionReport{},
+	}
+	bucketIndex := map[string]int{}
+
+	options := storj.BucketListOptions{Direction: storj.Forward, Limit: 1000}
+	for {
+		buckets, err := server.buckets.ListBuckets(ctx, projectUUID, options, macaroon.AllowedBuckets{All: true})
+		if err != nil {
+			sendJSONError(w, "unable to list buckets",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		for _, bucket := range buckets.Items {
+			pathEncrypted := !isUnencrypted(bucket.PathCipher)
+			if !pathEncrypted {
+				report.UnencryptedPathBuckets++
+			}
+
+			bucketIndex[bucket.Name] = len(report.Buckets)
+			report.Buckets = append(report.Buckets, bucketEncryptionReport{
+				Name:          bucket.Name,
+				PathCipher:    cipherSuiteName(bucket.PathCipher),
+				PathEncrypted: pathEncrypted,
+				Objects:       []cipherObjectCount{},
+			})
+		}
+
+		if !buckets.More {
+			break
+		}
+		options = options.NextPage(buckets)
+	}
+
+	stats, err := server.metabase.GetProjectEncryptionStats(ctx, metabase.GetProjectEncryptionStats{
+		ProjectID: projectUUID,
+	})
+	if err != nil {
+		sendJSONError(w, "unable to get encryption statistics",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for _, stat := range stats {
+		index, ok := bucketIndex[stat.BucketName]
+		if !ok {
+			// the bucket was deleted after listing the buckets.
+			continue
+		}
+		bucket := &report.Buckets[index]
+
+		cipher := stat.Encryption.CipherSuite
+		deprecated := deprecatedCipherSuites[cipher]
+		bucket.Objects = append(bucket.Objects, cipherObjectCount{
+			Cipher:     cipherSuiteName(cipher),
+			BlockSize:  stat.Encryption.BlockSize,
+			Count:      stat.ObjectCount,
+			Deprecated: deprecated,
+		})
+
+		switch {
+		case isUnencrypted(cipher):
+			bucket.UnencryptedObjects += stat.ObjectCount
+			report.UnencryptedObjects += stat.ObjectCount
+		case deprecated:
+			bucket.DeprecatedCipherObjects += stat.ObjectCount
+			report.DeprecatedCipherObjects += stat.ObjectCount
+		}
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
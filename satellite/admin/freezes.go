@@ -0,0 +1,120 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"storj.io/storj/satellite/accounting"
+)
+
+func (server *Server) getProjectFreeze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := projectUUIDFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	freeze, err := server.db.ProjectAccounting().GetProjectFreeze(ctx, projectUUID)
+	if err != nil {
+		sendJSONError(w, "failed to get project freeze",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if freeze == nil {
+		sendJSONError(w, "project is not frozen",
+			"", http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(freeze)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) putProjectFreeze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := projectUUIDFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	_, err := server.db.Console().Projects().Get(ctx, projectUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, "project with specified uuid does not exist",
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get project",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Reason    accounting.FreezeReason `json:"reason"`
+		Downloads bool                    `json:"downloads"`
+		InvoiceID string                  `json:"invoiceId"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	freeze := accounting.ProjectFreeze{
+		ProjectID: projectUUID,
+		Reason:    input.Reason,
+		Downloads: input.Downloads,
+		InvoiceID: input.InvoiceID,
+	}
+	if err := freeze.Validate(); err != nil {
+		sendJSONError(w, "invalid project freeze",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = server.db.ProjectAccounting().FreezeProject(ctx, freeze)
+	if err != nil {
+		sendJSONError(w, "failed to freeze project",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) deleteProjectFreeze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := projectUUIDFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	err := server.db.ProjectAccounting().UnfreezeProject(ctx, projectUUID)
+	if err != nil {
+		sendJSONError(w, "failed to unfreeze project",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
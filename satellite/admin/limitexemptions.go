@@ -0,0 +1,145 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+)
+
+func (server *Server) getProjectLimitExemptions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := projectUUIDFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	exemptions, err := server.db.ProjectAccounting().GetProjectLimitExemptions(ctx, projectUUID)
+	if err != nil {
+		sendJSONError(w, "failed to get project limit exemptions",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(exemptions)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) putProjectLimitExemption(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := projectUUIDFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	_, err := server.db.Console().Projects().Get(ctx, projectUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, "project with specified uuid does not exist",
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get project",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Kind      accounting.LimitKind            `json:"kind"`
+		Reason    accounting.LimitExemptionReason `json:"reason"`
+		ExpiresAt time.Time                       `json:"expiresAt"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	exemption := accounting.LimitExemption{
+		ProjectID: projectUUID,
+		Kind:      input.Kind,
+		Reason:    input.Reason,
+		ExpiresAt: input.ExpiresAt,
+	}
+	if err := exemption.Validate(); err != nil {
+		sendJSONError(w, "invalid limit exemption",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !exemption.ActiveAt(time.Now()) {
+		sendJSONError(w, "invalid limit exemption",
+			"expiration is in the past", http.StatusBadRequest)
+		return
+	}
+
+	err = server.db.ProjectAccounting().SetProjectLimitExemption(ctx, exemption)
+	if err != nil {
+		sendJSONError(w, "failed to set project limit exemption",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) deleteProjectLimitExemption(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := projectUUIDFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	kind := accounting.LimitKind(mux.Vars(r)["kind"])
+
+	err := server.db.ProjectAccounting().DeleteProjectLimitExemption(ctx, projectUUID, kind)
+	if err != nil {
+		sendJSONError(w, "failed to delete project limit exemption",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// projectUUIDFromVars parses the project UUID of the request. It sends an
+// error response and returns false when it's missing or invalid.
+func projectUUIDFromVars(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	projectUUIDString, ok := mux.Vars(r)["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+
+	projectUUID, err := uuid.FromString(projectUUIDString)
+	if err != nil {
+		sendJSONError(w, "invalid project-uuid",
+			err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+	return projectUUID, true
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/nodeslo"
+)
+
+func (server *Server) getNodeSLOReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	periodStr := r.URL.Query().Get("period")
+	if periodStr == "" {
+		sendJSONError(w, "period parameter is missing",
+			"", http.StatusBadRequest)
+		return
+	}
+	period, err := compensation.PeriodFromString(periodStr)
+	if err != nil {
+		sendJSONError(w, "invalid period",
+			fmt.Sprintf("period must be formatted like YYYY-MM, got %q", periodStr), http.StatusBadRequest)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		sendJSONError(w, "invalid format",
+			fmt.Sprintf("format must be json or csv, got %q", format), http.StatusBadRequest)
+		return
+	}
+
+	report, err := nodeslo.NewService(server.db).Generate(ctx, period)
+	if err != nil {
+		sendJSONError(w, "failed to generate node SLO report",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if format == "csv" {
+		var data bytes.Buffer
+		if err := nodeslo.WriteCSV(&data, report); err != nil {
+			sendJSONError(w, "csv encoding failed",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=node-slo-%s.csv", report.Period))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(data.Bytes()) // any error here entitles a client side disconnect or similar, which we do not care about.
+		return
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -883,3 +883,62 @@ func TestProjectDelete_withUsagePreviousMonthCharged(t *testing.T) {
 		require.NoError(t, response.Body.Close())
 	})
 }
+
+func TestProjectEncryptionReport(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		// inline objects don't need storage nodes.
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "first", "a", []byte("data")))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "first", "b", []byte("data")))
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "second"))
+
+		link := "http://" + address.String() + "/api/projects/" + projectID.String() + "/encryption"
+		body := assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", sat.Config.Console.AuthToken)
+
+		var report struct {
+			ProjectID uuid.UUID `json:"projectId"`
+			Buckets   []struct {
+				Name          string `json:"name"`
+				PathEncrypted bool   `json:"pathEncrypted"`
+				Objects       []struct {
+					Cipher string `json:"cipher"`
+					Count  int64  `json:"count"`
+				} `json:"objects"`
+			} `json:"buckets"`
+			UnencryptedObjects      int64 `json:"unencryptedObjects"`
+			DeprecatedCipherObjects int64 `json:"deprecatedCipherObjects"`
+		}
+		require.NoError(t, json.Unmarshal(body, &report))
+
+		require.Equal(t, projectID, report.ProjectID)
+		require.Len(t, report.Buckets, 2)
+		require.Equal(t, "first", report.Buckets[0].Name)
+		require.Len(t, report.Buckets[0].Objects, 1)
+		require.Equal(t, "aesgcm", report.Buckets[0].Objects[0].Cipher)
+		require.EqualValues(t, 2, report.Buckets[0].Objects[0].Count)
+		require.Equal(t, "second", report.Buckets[1].Name)
+		require.Empty(t, report.Buckets[1].Objects)
+		require.Zero(t, report.UnencryptedObjects)
+		require.Zero(t, report.DeprecatedCipherObjects)
+
+		t.Run("Not Found", func(t *testing.T) {
+			id, err := uuid.New()
+			require.NoError(t, err)
+
+			link := "http://" + address.String() + "/api/projects/" + id.String() + "/encryption"
+			assertReq(ctx, t, link, http.MethodGet, "", http.StatusNotFound, "", sat.Config.Console.AuthToken)
+		})
+	})
+}
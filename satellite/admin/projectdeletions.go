@@ -0,0 +1,73 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+func (server *Server) getProjectDeletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := projectUUIDFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	deletion, err := server.db.Console().ProjectDeletions().Get(ctx, projectUUID)
+	if err != nil {
+		sendJSONError(w, "failed to get project deletion",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if deletion == nil {
+		sendJSONError(w, "project is not deleted",
+			"", http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(deletion)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) restoreProject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := projectUUIDFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	deletion, err := server.db.Console().ProjectDeletions().Get(ctx, projectUUID)
+	if err != nil {
+		sendJSONError(w, "failed to get project deletion",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if deletion == nil {
+		sendJSONError(w, "project is not deleted",
+			"", http.StatusNotFound)
+		return
+	}
+	if !deletion.Restorable(time.Now()) {
+		sendJSONError(w, "project can't be restored anymore",
+			"the data of the project is being purged since "+deletion.PurgeAfter.Format(time.RFC3339), http.StatusConflict)
+		return
+	}
+
+	err = server.db.Console().ProjectDeletions().Delete(ctx, projectUUID)
+	if err != nil {
+		sendJSONError(w, "failed to restore project",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
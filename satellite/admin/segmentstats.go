@@ -0,0 +1,36 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"storj.io/storj/satellite/segmentstats"
+)
+
+func (server *Server) getSegmentStatistics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	report, err := server.db.SegmentStatistics().GetLatest(ctx)
+	if segmentstats.ErrReportNotFound.Has(err) {
+		sendJSONError(w, "no segment statistics report exists",
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get segment statistics",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -6,7 +6,6 @@ package admin
 
 import (
 	"context"
-	"crypto/subtle"
 	"errors"
 	"net"
 	"net/http"
@@ -23,9 +22,12 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/reputation"
+	"storj.io/storj/satellite/segmentstats"
 )
 
 // Config defines configuration for debug server.
@@ -33,6 +35,8 @@ type Config struct {
 	Address   string `help:"admin peer http listening address" releaseDefault:"" devDefault:""`
 	StaticDir string `help:"an alternate directory path which contains the static assets to serve. When empty, it uses the embedded assets" releaseDefault:"" devDefault:""`
 
+	Oauth OAuthConfig
+
 	AuthorizationToken string `internal:"true"`
 }
 
@@ -46,6 +50,12 @@ type DB interface {
 	OIDC() oidc.DB
 	// StripeCoinPayments returns database for satellite stripe coin payments
 	StripeCoinPayments() stripecoinpayments.DB
+	// SegmentStatistics returns database for segment statistics reports.
+	SegmentStatistics() segmentstats.DB
+	// Reputation returns database for storage node reputation.
+	Reputation() reputation.DB
+	// StoragenodeAccounting returns database for storing information about storagenode use.
+	StoragenodeAccounting() accounting.StoragenodeAccounting
 }
 
 // Server provides endpoints for administrative tasks.
@@ -56,6 +66,7 @@ type Server struct {
 	server   http.Server
 
 	db       DB
+	metabase *metabase.DB
 	payments payments.Accounts
 	buckets  *buckets.Service
 	restKeys *restkeys.Service
@@ -67,13 +78,14 @@ type Server struct {
 }
 
 // NewServer returns a new administration Server.
-func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.Service, restKeys *restkeys.Service, accounts payments.Accounts, console consoleweb.Config, config Config) *Server {
+func NewServer(log *zap.Logger, listener net.Listener, db DB, metabaseDB *metabase.DB, buckets *buckets.Service, restKeys *restkeys.Service, accounts payments.Accounts, console consoleweb.Config, config Config) *Server {
 	server := &Server{
 		log: log,
 
 		listener: listener,
 
 		db:       db,
+		metabase: metabaseDB,
 		payments: accounts,
 		buckets:  buckets,
 		restKeys: restKeys,
@@ -87,33 +99,57 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	root := mux.NewRouter()
 
 	api := root.PathPrefix("/api/").Subrouter()
-	api.Use(allowedAuthorization(config.AuthorizationToken))
+	api.Use(allowedAuthorization(log, NewAuthenticator(log.Named("auth"), config)))
+
+	read := requirePermission(PermissionRead)
+	write := requirePermission(PermissionWrite)
 
 	// When adding new options, also update README.md
-	api.HandleFunc("/users", server.addUser).Methods("POST")
-	api.HandleFunc("/users/{useremail}", server.updateUser).Methods("PUT")
-	api.HandleFunc("/users/{useremail}", server.userInfo).Methods("GET")
-	api.HandleFunc("/users/{useremail}", server.deleteUser).Methods("DELETE")
-	api.HandleFunc("/users/{useremail}/mfa", server.disableUserMFA).Methods("DELETE")
-	api.HandleFunc("/oauth/clients", server.createOAuthClient).Methods("POST")
-	api.HandleFunc("/oauth/clients/{id}", server.updateOAuthClient).Methods("PUT")
-	api.HandleFunc("/oauth/clients/{id}", server.deleteOAuthClient).Methods("DELETE")
-	api.HandleFunc("/projects", server.addProject).Methods("POST")
-	api.HandleFunc("/projects/{project}/usage", server.checkProjectUsage).Methods("GET")
-	api.HandleFunc("/projects/{project}/limit", server.getProjectLimit).Methods("GET")
-	api.HandleFunc("/projects/{project}/limit", server.putProjectLimit).Methods("PUT", "POST")
-	api.HandleFunc("/projects/{project}", server.getProject).Methods("GET")
-	api.HandleFunc("/projects/{project}", server.renameProject).Methods("PUT")
-	api.HandleFunc("/projects/{project}", server.deleteProject).Methods("DELETE")
-	api.HandleFunc("/projects/{project}/apikeys", server.listAPIKeys).Methods("GET")
-	api.HandleFunc("/projects/{project}/apikeys", server.addAPIKey).Methods("POST")
-	api.HandleFunc("/projects/{project}/apikeys/{name}", server.deleteAPIKeyByName).Methods("DELETE")
-	api.HandleFunc("/projects/{project}/buckets/{bucket}", server.getBucketInfo).Methods("GET")
-	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.createGeofenceForBucket).Methods("POST")
-	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.deleteGeofenceForBucket).Methods("DELETE")
-	api.HandleFunc("/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
-	api.HandleFunc("/restkeys/{useremail}", server.addRESTKey).Methods("POST")
-	api.HandleFunc("/restkeys/{apikey}/revoke", server.revokeRESTKey).Methods("PUT")
+	api.HandleFunc("/users", write(server.addUser)).Methods("POST")
+	api.HandleFunc("/users/{useremail}", write(server.updateUser)).Methods("PUT")
+	api.HandleFunc("/users/{useremail}", read(server.userInfo)).Methods("GET")
+	api.HandleFunc("/users/{useremail}", write(server.deleteUser)).Methods("DELETE")
+	api.HandleFunc("/users/{useremail}/mfa", write(server.disableUserMFA)).Methods("DELETE")
+	api.HandleFunc("/users/{useremail}/activate", write(server.activateUser)).Methods("PUT")
+	api.HandleFunc("/users/{useremail}/email", write(server.changeUserEmail)).Methods("PUT")
+	api.HandleFunc("/users/{useremail}/email-changes", read(server.listEmailChanges)).Methods("GET")
+	api.HandleFunc("/users/{useremail}/email-changes", write(server.cancelEmailChanges)).Methods("DELETE")
+	api.HandleFunc("/oauth/clients", write(server.createOAuthClient)).Methods("POST")
+	api.HandleFunc("/oauth/clients/{id}", write(server.updateOAuthClient)).Methods("PUT")
+	api.HandleFunc("/oauth/clients/{id}", write(server.deleteOAuthClient)).Methods("DELETE")
+	api.HandleFunc("/projects", write(server.addProject)).Methods("POST")
+	api.HandleFunc("/projects/{project}/usage", read(server.checkProjectUsage)).Methods("GET")
+	api.HandleFunc("/projects/{project}/encryption", read(server.getProjectEncryptionReport)).Methods("GET")
+	api.HandleFunc("/projects/{project}/limit", read(server.getProjectLimit)).Methods("GET")
+	api.HandleFunc("/projects/{project}/limit", write(server.putProjectLimit)).Methods("PUT", "POST")
+	api.HandleFunc("/projects/{project}/limit-exemptions", read(server.getProjectLimitExemptions)).Methods("GET")
+	api.HandleFunc("/projects/{project}/limit-exemptions", write(server.putProjectLimitExemption)).Methods("PUT")
+	api.HandleFunc("/projects/{project}/limit-exemptions/{kind}", write(server.deleteProjectLimitExemption)).Methods("DELETE")
+	api.HandleFunc("/projects/{project}/freeze", read(server.getProjectFreeze)).Methods("GET")
+	api.HandleFunc("/projects/{project}/freeze", write(server.putProjectFreeze)).Methods("PUT")
+	api.HandleFunc("/projects/{project}/freeze", write(server.deleteProjectFreeze)).Methods("DELETE")
+	api.HandleFunc("/projects/{project}/deletion", read(server.getProjectDeletion)).Methods("GET")
+	api.HandleFunc("/projects/{project}/restore", write(server.restoreProject)).Methods("POST")
+	api.HandleFunc("/projects/{project}", read(server.getProject)).Methods("GET")
+	api.HandleFunc("/projects/{project}", write(server.renameProject)).Methods("PUT")
+	api.HandleFunc("/projects/{project}", write(server.deleteProject)).Methods("DELETE")
+	api.HandleFunc("/projects/{project}/apikeys", read(server.listAPIKeys)).Methods("GET")
+	api.HandleFunc("/projects/{project}/apikeys", write(server.addAPIKey)).Methods("POST")
+	api.HandleFunc("/projects/{project}/apikeys/{name}", write(server.deleteAPIKeyByName)).Methods("DELETE")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}", read(server.getBucketInfo)).Methods("GET")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", write(server.createGeofenceForBucket)).Methods("POST")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", write(server.deleteGeofenceForBucket)).Methods("DELETE")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/trash", read(server.listTrashedObjects)).Methods("GET")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/trash/restore", write(server.restoreTrashedObject)).Methods("POST")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/transfer", write(server.transferBucket)).Methods("POST")
+	api.HandleFunc("/apikeys/{apikey}", write(server.deleteAPIKey)).Methods("DELETE")
+	api.HandleFunc("/restkeys/{useremail}", write(server.addRESTKey)).Methods("POST")
+	api.HandleFunc("/restkeys/{apikey}/revoke", write(server.revokeRESTKey)).Methods("PUT")
+	api.HandleFunc("/segments/statistics", read(server.getSegmentStatistics)).Methods("GET")
+	api.HandleFunc("/nodes/slo-report", read(server.getNodeSLOReport)).Methods("GET")
+	api.HandleFunc("/signup-reviews", read(server.listSignupReviews)).Methods("GET")
+	api.HandleFunc("/signup-reviews/{useremail}/approve", write(server.approveSignupReview)).Methods("POST")
+	api.HandleFunc("/signup-reviews/{useremail}/reject", write(server.rejectSignupReview)).Methods("POST")
 
 	// This handler must be the last one because it uses the root as prefix,
 	// otherwise will try to serve all the handlers set after this one.
@@ -158,28 +194,3 @@ func (server *Server) SetNow(nowFn func() time.Time) {
 func (server *Server) Close() error {
 	return Error.Wrap(server.server.Close())
 }
-
-func allowedAuthorization(token string) func(next http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if token == "" {
-				sendJSONError(w, "Authorization not enabled.",
-					"", http.StatusForbidden)
-				return
-			}
-
-			equality := subtle.ConstantTimeCompare(
-				[]byte(r.Header.Get("Authorization")),
-				[]byte(token),
-			)
-			if equality != 1 {
-				sendJSONError(w, "Forbidden",
-					"", http.StatusForbidden)
-				return
-			}
-
-			r.Header.Set("Cache-Control", "must-revalidate")
-			next.ServeHTTP(w, r)
-		})
-	}
-}
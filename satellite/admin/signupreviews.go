@@ -0,0 +1,126 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/console"
+)
+
+// defaultSignupReviewsLimit is the number of pending signup reviews returned
+// when the request doesn't specify a limit.
+const defaultSignupReviewsLimit = 100
+
+func (server *Server) listSignupReviews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit := defaultSignupReviewsLimit
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			sendJSONError(w, "invalid limit",
+				fmt.Sprintf("limit must be a positive number, got %q", limitStr), http.StatusBadRequest)
+			return
+		}
+	}
+
+	reviews, err := server.db.Console().SignupReviews().ListPending(ctx, limit)
+	if err != nil {
+		sendJSONError(w, "failed to list signup reviews",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if reviews == nil {
+		reviews = []console.SignupReview{}
+	}
+
+	data, err := json.Marshal(reviews)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) approveSignupReview(w http.ResponseWriter, r *http.Request) {
+	server.reviewSignup(w, r, true)
+}
+
+func (server *Server) rejectSignupReview(w http.ResponseWriter, r *http.Request) {
+	server.reviewSignup(w, r, false)
+}
+
+// reviewSignup marks the signup of the user as reviewed. When the signup is
+// approved, the user gets the free tier project limit.
+func (server *Server) reviewSignup(w http.ResponseWriter, r *http.Request, approved bool) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get user",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	review, err := server.db.Console().SignupReviews().Get(ctx, user.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, fmt.Sprintf("signup of user with email %q was not flagged for review", userEmail),
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get signup review",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !review.Pending() {
+		sendJSONError(w, fmt.Sprintf("signup of user with email %q was already reviewed", userEmail),
+			"", http.StatusConflict)
+		return
+	}
+
+	if approved && user.ProjectLimit < server.console.UsageLimits.Project.Free {
+		projectLimit := server.console.UsageLimits.Project.Free
+		err = server.db.Console().Users().Update(ctx, user.ID, console.UpdateUserRequest{
+			ProjectLimit: &projectLimit,
+		})
+		if err != nil {
+			sendJSONError(w, "failed to update user project limit",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	err = server.db.Console().SignupReviews().Review(ctx, user.ID, approved, time.Now())
+	if err != nil {
+		sendJSONError(w, "failed to update signup review",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
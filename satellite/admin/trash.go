@@ -0,0 +1,161 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+)
+
+// trashedObject is a trashed object of a bucket. The key is encrypted.
+type trashedObject struct {
+	Key             []byte    `json:"key"`
+	Version         int64     `json:"version"`
+	CreatedAt       time.Time `json:"createdAt"`
+	RestoreDeadline time.Time `json:"restoreDeadline"`
+	TotalPlainSize  int64     `json:"totalPlainSize"`
+}
+
+func (server *Server) listTrashedObjects(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	opts := metabase.ListTrashedObjects{
+		ProjectID:  project.UUID,
+		BucketName: string(bucket),
+	}
+
+	query := r.URL.Query()
+	if cursor := query.Get("cursor"); cursor != "" {
+		key, err := base64.StdEncoding.DecodeString(cursor)
+		if err != nil {
+			sendJSONError(w, "invalid cursor", err.Error(), http.StatusBadRequest)
+			return
+		}
+		opts.Cursor.Key = metabase.ObjectKey(key)
+	}
+	if version := query.Get("version"); version != "" {
+		v, err := strconv.ParseInt(version, 10, 64)
+		if err != nil {
+			sendJSONError(w, "invalid version", err.Error(), http.StatusBadRequest)
+			return
+		}
+		opts.Cursor.Version = metabase.Version(v)
+	}
+	if limit := query.Get("limit"); limit != "" {
+		opts.Limit, err = strconv.Atoi(limit)
+		if err != nil {
+			sendJSONError(w, "invalid limit", err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	result, err := server.metabase.ListTrashedObjects(ctx, opts)
+	if err != nil {
+		if metabase.ErrInvalidRequest.Has(err) {
+			sendJSONError(w, "invalid request", err.Error(), http.StatusBadRequest)
+			return
+		}
+		sendJSONError(w, "unable to list trashed objects",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := struct {
+		Objects []trashedObject `json:"objects"`
+		More    bool            `json:"more"`
+	}{
+		Objects: []trashedObject{},
+		More:    result.More,
+	}
+	for _, object := range result.Objects {
+		trashed := trashedObject{
+			Key:            []byte(object.ObjectKey),
+			Version:        int64(object.Version),
+			CreatedAt:      object.CreatedAt,
+			TotalPlainSize: object.TotalPlainSize,
+		}
+		if object.RestoreDeadline != nil {
+			trashed.RestoreDeadline = *object.RestoreDeadline
+		}
+		output.Objects = append(output.Objects, trashed)
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) restoreTrashedObject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Key     []byte `json:"key"`
+		Version int64  `json:"version"`
+	}
+	if err := json.Unmarshal(body, &input); err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, err = server.metabase.RestoreObject(ctx, metabase.RestoreObject{
+		ObjectLocation: metabase.ObjectLocation{
+			ProjectID:  project.UUID,
+			BucketName: string(bucket),
+			ObjectKey:  metabase.ObjectKey(input.Key),
+		},
+		Version: metabase.Version(input.Version),
+	})
+	if err != nil {
+		switch {
+		case metabase.ErrInvalidRequest.Has(err):
+			sendJSONError(w, "invalid request", err.Error(), http.StatusBadRequest)
+		case storj.ErrObjectNotFound.Has(err):
+			sendJSONError(w, "trashed object not found",
+				"the object isn't in the trash or its restore deadline passed", http.StatusNotFound)
+		case metabase.ErrObjectAlreadyExists.Has(err):
+			sendJSONError(w, "object already exists",
+				"the object was uploaded again after it was deleted", http.StatusConflict)
+		default:
+			sendJSONError(w, "unable to restore object",
+				err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -4,6 +4,7 @@
 package admin
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -11,6 +12,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 	"golang.org/x/crypto/bcrypt"
@@ -326,6 +328,60 @@ func (server *Server) disableUserMFA(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// activateUser activates the account of the user without the activation
+// email, and deletes the pending activation tokens of the user.
+func (server *Server) activateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing", "", http.StatusBadRequest)
+		return
+	}
+
+	verified, unverified, err := server.db.Console().Users().GetByEmailWithUnverified(ctx, userEmail)
+	if err != nil {
+		sendJSONError(w, "failed to get user details",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if verified != nil {
+		sendJSONError(w, fmt.Sprintf("user with email %q is already active", userEmail),
+			"", http.StatusConflict)
+		return
+	}
+
+	var user *console.User
+	for i := range unverified {
+		if unverified[i].Status == console.Inactive {
+			user = &unverified[i]
+			break
+		}
+	}
+	if user == nil {
+		sendJSONError(w, fmt.Sprintf("user with email %q waiting for activation does not exist", userEmail),
+			"", http.StatusNotFound)
+		return
+	}
+
+	status := console.Active
+	err = server.db.Console().WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		err := tx.Users().Update(ctx, user.ID, console.UpdateUserRequest{
+			Status: &status,
+		})
+		if err != nil {
+			return err
+		}
+		return tx.ActivationTokens().MarkUsedByUserID(ctx, user.ID, time.Now())
+	})
+	if err != nil {
+		sendJSONError(w, "failed to activate user",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
 func (server *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -39,9 +39,11 @@ import (
 	"storj.io/storj/satellite/inspector"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/maintenance"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
+	"storj.io/storj/satellite/metainfo/securitylog"
 	"storj.io/storj/satellite/nodestats"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
@@ -53,6 +55,7 @@ import (
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/rewards"
 	"storj.io/storj/satellite/snopayouts"
+	"storj.io/storj/satellite/tuning"
 )
 
 // API is the satellite API process.
@@ -80,6 +83,11 @@ type API struct {
 		Server   *debug.Server
 	}
 
+	Tuning struct {
+		Listener net.Listener
+		Service  *tuning.Service
+	}
+
 	Contact struct {
 		Service  *contact.Service
 		Endpoint *contact.Endpoint
@@ -101,9 +109,15 @@ type API struct {
 		Chore    *orders.Chore
 	}
 
+	Maintenance struct {
+		Service  *maintenance.Service
+		Endpoint *maintenance.Endpoint
+	}
+
 	Metainfo struct {
 		Metabase      *metabase.DB
 		PieceDeletion *piecedeletion.Service
+		SecurityLog   *securitylog.Service
 		Endpoint      *metainfo.Endpoint
 	}
 
@@ -163,9 +177,10 @@ type API struct {
 	}
 
 	SNOPayouts struct {
-		Endpoint *snopayouts.Endpoint
-		Service  *snopayouts.Service
-		DB       snopayouts.DB
+		Endpoint  *snopayouts.Endpoint
+		Service   *snopayouts.Service
+		Estimator *snopayouts.Estimator
+		DB        snopayouts.DB
 	}
 
 	GracefulExit struct {
@@ -219,6 +234,22 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	{ // setup tuning
+		if config.Tuning.Address != "" {
+			var err error
+			peer.Tuning.Listener, err = net.Listen("tcp", config.Tuning.Address)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+		}
+		peer.Tuning.Service = tuning.NewService(log.Named("tuning"), peer.Tuning.Listener, config.Tuning)
+		peer.Servers.Add(lifecycle.Item{
+			Name:  "tuning",
+			Run:   peer.Tuning.Service.Run,
+			Close: peer.Tuning.Service.Close,
+		})
+	}
+
 	var err error
 
 	{
@@ -413,6 +444,14 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	{ // setup maintenance
+		peer.Maintenance.Service = maintenance.NewService(peer.Log.Named("maintenance"), config.Maintenance)
+		peer.Maintenance.Endpoint = maintenance.NewEndpoint(peer.Maintenance.Service)
+		if err := internalpb.DRPCRegisterMaintenance(peer.Server.DRPC(), peer.Maintenance.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
 	{ // setup metainfo
 		peer.Metainfo.Metabase = metabaseDB
 
@@ -432,6 +471,15 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			Close: peer.Metainfo.PieceDeletion.Close,
 		})
 
+		peer.Metainfo.SecurityLog, err = securitylog.NewService(
+			peer.Log.Named("metainfo:securitylog"),
+			peer.DB.Console().SecurityLogs(),
+			config.SecurityLog,
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
 		peer.Metainfo.Endpoint, err = metainfo.NewEndpoint(
 			peer.Log.Named("metainfo:endpoint"),
 			peer.Buckets.Service,
@@ -445,8 +493,11 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.Console().APIKeys(),
 			peer.Accounting.ProjectUsage,
 			peer.DB.Console().Projects(),
+			peer.DB.Console().ProjectActivities(),
+			peer.Metainfo.SecurityLog,
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.DB.Revocation(),
+			peer.Maintenance.Service,
 			config.Metainfo,
 		)
 		if err != nil {
@@ -461,6 +512,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			Name:  "metainfo:endpoint",
 			Close: peer.Metainfo.Endpoint.Close,
 		})
+		peer.Tuning.Service.Add(peer.Metainfo.Endpoint.TuningSettings()...)
 	}
 
 	{ // setup inspector
@@ -511,6 +563,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			pc.StorageTBPrice,
 			pc.EgressTBPrice,
 			pc.SegmentPrice,
+			pc.PlacementPrices,
+			pc.PriceTiers,
+			pc.VolumeDiscounts,
 			pc.BonusRate)
 
 		if err != nil {
@@ -594,6 +649,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Mail.Service,
 			peer.Marketing.PartnersService,
 			peer.Analytics.Service,
+			peer.Maintenance.Service,
 			peer.Console.Listener,
 			config.Payments.StripeCoinPayments.StripePublicKey,
 			pricing,
@@ -625,11 +681,27 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.SNOPayouts.Service = snopayouts.NewService(
 			peer.Log.Named("payouts:service"),
 			peer.SNOPayouts.DB)
+		peer.SNOPayouts.Estimator = snopayouts.NewEstimator(
+			peer.Log.Named("payouts:estimator"),
+			peer.DB.StoragenodeAccounting(),
+			peer.Overlay.DB,
+			peer.DB.Compensation(),
+			config.Compensation,
+			config.SNOPayouts)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "payouts:estimator",
+			Run:   peer.SNOPayouts.Estimator.Run,
+			Close: peer.SNOPayouts.Estimator.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Payouts Estimator", peer.SNOPayouts.Estimator.Loop))
+
 		peer.SNOPayouts.Endpoint = snopayouts.NewEndpoint(
 			peer.Log.Named("payouts:endpoint"),
 			peer.DB.StoragenodeAccounting(),
 			peer.Overlay.DB,
-			peer.SNOPayouts.Service)
+			peer.SNOPayouts.Service,
+			peer.SNOPayouts.Estimator)
 		if err := pb.DRPCRegisterHeldAmount(peer.Server.DRPC(), peer.SNOPayouts.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -647,6 +719,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 				peer.Metainfo.Metabase,
 				peer.Orders.Service,
 				peer.DB.PeerIdentities(),
+				config.Compensation.WithheldPercents,
 				config.GracefulExit)
 
 			if err := pb.DRPCRegisterSatelliteGracefulExit(peer.Server.DRPC(), peer.GracefulExit.Endpoint); err != nil {
@@ -12,6 +12,7 @@ import (
 
 	"storj.io/common/sync2"
 	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/scheduler"
 )
 
 // Chore populates reservoirs and the audit queue.
@@ -24,11 +25,12 @@ type Chore struct {
 	Loop   *sync2.Cycle
 
 	segmentLoop *segmentloop.Service
+	scheduler   *scheduler.Scheduler
 	config      Config
 }
 
 // NewChore instantiates Chore.
-func NewChore(log *zap.Logger, queues *Queues, loop *segmentloop.Service, config Config) *Chore {
+func NewChore(log *zap.Logger, queues *Queues, loop *segmentloop.Service, scheduler *scheduler.Scheduler, config Config) *Chore {
 	return &Chore{
 		log:    log,
 		rand:   rand.New(rand.NewSource(time.Now().Unix())),
@@ -36,6 +38,7 @@ func NewChore(log *zap.Logger, queues *Queues, loop *segmentloop.Service, config
 		Loop:   sync2.NewCycle(config.ChoreInterval),
 
 		segmentLoop: loop,
+		scheduler:   scheduler,
 		config:      config,
 	}
 }
@@ -52,6 +55,12 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 			return err
 		}
 
+		release, err := chore.scheduler.Acquire(ctx, "audit")
+		if err != nil {
+			return err
+		}
+		defer release()
+
 		collector := NewCollector(chore.config.Slots, chore.rand)
 		err = chore.segmentLoop.Join(ctx, collector)
 		if err != nil {
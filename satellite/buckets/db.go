@@ -19,6 +19,18 @@ type Bucket struct {
 	CreatedAt time.Time
 }
 
+// Quota is the object count and size quota of a bucket.
+// A nil limit means that the bucket isn't limited by it.
+type Quota struct {
+	MaxObjects *int64 `json:"maxObjects"`
+	MaxBytes   *int64 `json:"maxBytes"`
+}
+
+// IsZero returns whether the quota doesn't limit the bucket.
+func (quota Quota) IsZero() bool {
+	return quota.MaxObjects == nil && quota.MaxBytes == nil
+}
+
 // DB is the interface for the database to interact with buckets.
 //
 // architecture: Database
@@ -39,8 +51,20 @@ type DB interface {
 	UpdateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error)
 	// DeleteBucket deletes a bucket
 	DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error)
+	// MoveBucket moves an existing bucket to another project, keeping its id and settings.
+	MoveBucket(ctx context.Context, bucketName []byte, projectID, newProjectID uuid.UUID) (err error)
 	// ListBuckets returns all buckets for a project
 	ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storj.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error)
 	// CountBuckets returns the number of buckets a project currently has
 	CountBuckets(ctx context.Context, projectID uuid.UUID) (int, error)
+	// GetBucketQuota returns the quota of an existing bucket. The quota is zero when the bucket isn't limited.
+	GetBucketQuota(ctx context.Context, bucketName []byte, projectID uuid.UUID) (Quota, error)
+	// UpdateBucketQuota replaces the quota of an existing bucket. A zero quota removes the limits.
+	UpdateBucketQuota(ctx context.Context, bucketName []byte, projectID uuid.UUID, quota Quota) error
+	// GetLifecycleRules returns the lifecycle rules of an existing bucket ordered by the id.
+	GetLifecycleRules(ctx context.Context, bucketName []byte, projectID uuid.UUID) ([]LifecycleRule, error)
+	// UpdateLifecycleRules replaces the lifecycle rules of an existing bucket. No rules removes all of them.
+	UpdateLifecycleRules(ctx context.Context, bucketName []byte, projectID uuid.UUID, rules []LifecycleRule) error
+	// ListLifecycleRules returns the lifecycle rules of all buckets.
+	ListLifecycleRules(ctx context.Context) ([]BucketLifecycleRule, error)
 }
@@ -14,6 +14,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 )
 
@@ -105,6 +106,134 @@ func TestBasicBucketOperations(t *testing.T) {
 	})
 }
 
+func TestBucketQuota(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := sat.API.Buckets.Service
+
+		_, err = bucketsDB.GetBucketQuota(ctx, []byte("testbucket"), project.ID)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+		err = bucketsDB.UpdateBucketQuota(ctx, []byte("testbucket"), project.ID, buckets.Quota{})
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		quota, err := bucketsDB.GetBucketQuota(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, quota.IsZero())
+
+		maxObjects := int64(10)
+		err = bucketsDB.UpdateBucketQuota(ctx, []byte("testbucket"), project.ID, buckets.Quota{MaxObjects: &maxObjects})
+		require.NoError(t, err)
+
+		quota, err = bucketsDB.GetBucketQuota(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, buckets.Quota{MaxObjects: &maxObjects}, quota)
+
+		maxBytes := int64(1000)
+		err = bucketsDB.UpdateBucketQuota(ctx, []byte("testbucket"), project.ID, buckets.Quota{MaxBytes: &maxBytes})
+		require.NoError(t, err)
+
+		quota, err = bucketsDB.GetBucketQuota(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, buckets.Quota{MaxBytes: &maxBytes}, quota)
+
+		// a recreated bucket doesn't inherit the quota.
+		err = bucketsDB.DeleteBucket(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		quota, err = bucketsDB.GetBucketQuota(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, quota.IsZero())
+	})
+}
+
+func TestBucketLifecycleRules(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := sat.API.Buckets.Service
+
+		_, err = bucketsDB.GetLifecycleRules(ctx, []byte("testbucket"), project.ID)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+		err = bucketsDB.UpdateLifecycleRules(ctx, []byte("testbucket"), project.ID, nil)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		rules, err := bucketsDB.GetLifecycleRules(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Empty(t, rules)
+
+		days := func(n int) *int { return &n }
+		expected := []buckets.LifecycleRule{
+			{ID: "a", Prefix: []byte("logs/"), ExpirationDays: days(30)},
+			{ID: "b", Prefix: []byte{}, AbortIncompleteMultipartUploadDays: days(7)},
+		}
+		err = bucketsDB.UpdateLifecycleRules(ctx, []byte("testbucket"), project.ID, expected)
+		require.NoError(t, err)
+
+		rules, err = bucketsDB.GetLifecycleRules(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, rules)
+
+		all, err := bucketsDB.ListLifecycleRules(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		require.Equal(t, project.ID, all[0].Bucket.ProjectID)
+		require.Equal(t, "testbucket", all[0].Bucket.BucketName)
+		require.Equal(t, expected[0], all[0].LifecycleRule)
+
+		// the rules are replaced.
+		err = bucketsDB.UpdateLifecycleRules(ctx, []byte("testbucket"), project.ID, expected[1:])
+		require.NoError(t, err)
+
+		rules, err = bucketsDB.GetLifecycleRules(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected[1:], rules)
+
+		// a deleted bucket doesn't keep the rules.
+		err = bucketsDB.DeleteBucket(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+
+		all, err = bucketsDB.ListLifecycleRules(ctx)
+		require.NoError(t, err)
+		require.Empty(t, all)
+	})
+}
+
+func TestValidateLifecycleRules(t *testing.T) {
+	days := func(n int) *int { return &n }
+
+	require.NoError(t, buckets.ValidateLifecycleRules(nil))
+	require.NoError(t, buckets.ValidateLifecycleRules([]buckets.LifecycleRule{
+		{ID: "a", ExpirationDays: days(1)},
+		{ID: "b", Prefix: []byte("prefix"), AbortIncompleteMultipartUploadDays: days(1)},
+	}))
+
+	for _, rules := range [][]buckets.LifecycleRule{
+		{{ExpirationDays: days(1)}},
+		{{ID: "a"}},
+		{{ID: "a", ExpirationDays: days(0)}},
+		{{ID: "a", AbortIncompleteMultipartUploadDays: days(-1)}},
+		{{ID: "a", ExpirationDays: days(1)}, {ID: "a", ExpirationDays: days(2)}},
+	} {
+		err := buckets.ValidateLifecycleRules(rules)
+		require.True(t, buckets.ErrInvalidLifecycleRule.Has(err), err)
+	}
+}
+
 func TestListBucketsAllAllowed(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -0,0 +1,77 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// ErrInvalidLifecycleRule is returned when a lifecycle rule isn't valid.
+	ErrInvalidLifecycleRule = errs.Class("invalid lifecycle rule")
+)
+
+// MaxLifecycleRules is the maximum number of lifecycle rules of a bucket.
+const MaxLifecycleRules = 100
+
+// LifecycleRule deletes the objects of a bucket, whose key starts with the
+// prefix, after they are old enough. A nil number of days means that the
+// rule doesn't apply to the objects.
+//
+// The object keys in the metabase are encrypted, so the prefix is the
+// encrypted prefix of the keys.
+type LifecycleRule struct {
+	ID     string `json:"id"`
+	Prefix []byte `json:"prefix"`
+	// ExpirationDays is the age after which the committed objects are deleted.
+	ExpirationDays *int `json:"expirationDays"`
+	// AbortIncompleteMultipartUploadDays is the age after which the pending
+	// objects, i.e. the incomplete uploads, are deleted.
+	AbortIncompleteMultipartUploadDays *int `json:"abortIncompleteMultipartUploadDays"`
+}
+
+// Validate returns an error when the rule isn't valid.
+func (rule LifecycleRule) Validate() error {
+	switch {
+	case rule.ID == "":
+		return ErrInvalidLifecycleRule.New("id missing")
+	case len(rule.ID) > 255:
+		return ErrInvalidLifecycleRule.New("id %q is too long", rule.ID)
+	case rule.ExpirationDays == nil && rule.AbortIncompleteMultipartUploadDays == nil:
+		return ErrInvalidLifecycleRule.New("rule %q has no action", rule.ID)
+	case rule.ExpirationDays != nil && *rule.ExpirationDays <= 0:
+		return ErrInvalidLifecycleRule.New("rule %q: expiration days must be positive", rule.ID)
+	case rule.AbortIncompleteMultipartUploadDays != nil && *rule.AbortIncompleteMultipartUploadDays <= 0:
+		return ErrInvalidLifecycleRule.New("rule %q: abort incomplete multipart upload days must be positive", rule.ID)
+	}
+	return nil
+}
+
+// ValidateLifecycleRules returns an error when the rules of a bucket aren't
+// valid or their ids aren't unique.
+func ValidateLifecycleRules(rules []LifecycleRule) error {
+	if len(rules) > MaxLifecycleRules {
+		return ErrInvalidLifecycleRule.New("too many rules: %d, max %d", len(rules), MaxLifecycleRules)
+	}
+
+	ids := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		if _, ok := ids[rule.ID]; ok {
+			return ErrInvalidLifecycleRule.New("duplicate rule id %q", rule.ID)
+		}
+		ids[rule.ID] = struct{}{}
+	}
+	return nil
+}
+
+// BucketLifecycleRule is a lifecycle rule together with its bucket.
+type BucketLifecycleRule struct {
+	Bucket metabase.BucketLocation
+	LifecycleRule
+}
@@ -9,12 +9,15 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
 
 var (
 	// ErrBucketNotEmpty is returned when a caller attempts to change placement constraints.
 	ErrBucketNotEmpty = errs.Class("bucket must be empty")
+	// ErrBucketAlreadyExists is returned when a bucket is transferred to a project which has a bucket with the same name.
+	ErrBucketAlreadyExists = errs.Class("bucket already exists")
 )
 
 // NewService converts the provided db and metabase calls into a single DB interface.
@@ -56,3 +59,82 @@ func (buckets *Service) UpdateBucket(ctx context.Context, bucket storj.Bucket) (
 
 	return buckets.DB.UpdateBucket(ctx, bucket)
 }
+
+// TransferBucket contains arguments for transferring a bucket to another project.
+type TransferBucket struct {
+	Bucket       metabase.BucketLocation
+	NewProjectID uuid.UUID
+	// Copy keeps the bucket in its project, and creates a bucket with the same
+	// settings and copies of the objects in the new project.
+	Copy bool
+}
+
+// TransferBucket moves or copies the bucket and the metadata of its objects
+// to another project. The bucket keeps its name, and no piece data is
+// rewritten.
+//
+// A move first moves the objects and then the bucket, so a failed move can be
+// resumed by running it again. A copy creates the bucket first, so the
+// destination bucket has to be deleted before a failed copy is retried.
+func (buckets *Service) TransferBucket(ctx context.Context, opts TransferBucket) (metabase.TransferBucketObjectsResult, error) {
+	bucket, err := buckets.GetBucket(ctx, []byte(opts.Bucket.BucketName), opts.Bucket.ProjectID)
+	if err != nil {
+		return metabase.TransferBucketObjectsResult{}, err
+	}
+
+	exists, err := buckets.HasBucket(ctx, []byte(opts.Bucket.BucketName), opts.NewProjectID)
+	if err != nil {
+		return metabase.TransferBucketObjectsResult{}, err
+	}
+	if exists {
+		return metabase.TransferBucketObjectsResult{}, ErrBucketAlreadyExists.New("%s", opts.Bucket.BucketName)
+	}
+
+	transfer := metabase.TransferBucketObjects{
+		Bucket:       opts.Bucket,
+		NewProjectID: opts.NewProjectID,
+		Copy:         opts.Copy,
+	}
+
+	if !opts.Copy {
+		result, err := buckets.metabase.TransferBucketObjects(ctx, transfer)
+		if err != nil {
+			return result, err
+		}
+		return result, buckets.MoveBucket(ctx, []byte(opts.Bucket.BucketName), opts.Bucket.ProjectID, opts.NewProjectID)
+	}
+
+	bucket.ID, err = uuid.New()
+	if err != nil {
+		return metabase.TransferBucketObjectsResult{}, err
+	}
+	bucket.ProjectID = opts.NewProjectID
+
+	quota, err := buckets.GetBucketQuota(ctx, []byte(opts.Bucket.BucketName), opts.Bucket.ProjectID)
+	if err != nil {
+		return metabase.TransferBucketObjectsResult{}, err
+	}
+	rules, err := buckets.GetLifecycleRules(ctx, []byte(opts.Bucket.BucketName), opts.Bucket.ProjectID)
+	if err != nil {
+		return metabase.TransferBucketObjectsResult{}, err
+	}
+
+	_, err = buckets.CreateBucket(ctx, bucket)
+	if err != nil {
+		return metabase.TransferBucketObjectsResult{}, err
+	}
+	if !quota.IsZero() {
+		err = buckets.UpdateBucketQuota(ctx, []byte(bucket.Name), bucket.ProjectID, quota)
+		if err != nil {
+			return metabase.TransferBucketObjectsResult{}, err
+		}
+	}
+	if len(rules) > 0 {
+		err = buckets.UpdateLifecycleRules(ctx, []byte(bucket.Name), bucket.ProjectID, rules)
+		if err != nil {
+			return metabase.TransferBucketObjectsResult{}, err
+		}
+	}
+
+	return buckets.metabase.TransferBucketObjects(ctx, transfer)
+}
@@ -34,6 +34,8 @@ type Paystub struct {
 	Disposed       currency.MicroUnit `csv:"disposed"`
 	Paid           currency.MicroUnit `csv:"paid"`
 	Distributed    currency.MicroUnit `csv:"distributed"`
+	Wallet         string             `csv:"wallet"`
+	WalletFeatures WalletFeatures     `csv:"wallet-features"`
 }
 
 // LoadPaystubs loads a collection of Paystubs in CSV form from the provided file.
@@ -0,0 +1,123 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ActivationTokens exposes methods to manage the account activation tokens
+// of users. Only the hash of the secret of a token is stored.
+//
+// architecture: Database
+type ActivationTokens interface {
+	// Insert adds an activation token.
+	Insert(ctx context.Context, token ActivationToken) error
+	// Get returns the activation token with the secret hash.
+	Get(ctx context.Context, hash ActivationSecretHash) (*ActivationToken, error)
+	// ListByUserID returns the activation tokens of the user.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]ActivationToken, error)
+	// Delete deletes the activation token with the secret hash.
+	Delete(ctx context.Context, hash ActivationSecretHash) error
+	// MarkUsedByUserID marks the unused activation tokens of the user as used.
+	MarkUsedByUserID(ctx context.Context, userID uuid.UUID, now time.Time) error
+	// DeleteExpired deletes the activation tokens which expired before now.
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// ActivationSecret is the secret of an activation token which is sent to the
+// user. It's never stored.
+type ActivationSecret [32]byte
+
+// ActivationSecretHash is the hash of an activation secret.
+type ActivationSecretHash [sha256.Size]byte
+
+// NewActivationSecret creates a new activation secret.
+func NewActivationSecret() (ActivationSecret, error) {
+	var secret ActivationSecret
+
+	_, err := rand.Read(secret[:])
+	if err != nil {
+		return secret, errs.New("error creating activation secret")
+	}
+
+	return secret, nil
+}
+
+// String implements fmt.Stringer.
+func (secret ActivationSecret) String() string {
+	return base64.URLEncoding.EncodeToString(secret[:])
+}
+
+// Hash returns the hash of the secret under which the token is stored.
+func (secret ActivationSecret) Hash() ActivationSecretHash {
+	return sha256.Sum256(secret[:])
+}
+
+// ActivationSecretFromBase64 parses an activation secret from a base64 string.
+func ActivationSecretFromBase64(s string) (ActivationSecret, error) {
+	var secret ActivationSecret
+
+	b, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return secret, err
+	}
+	if len(b) != len(secret) {
+		return secret, errs.New("invalid activation secret length")
+	}
+
+	copy(secret[:], b)
+
+	return secret, nil
+}
+
+// ActivationToken is an activation of the account of a user. The token is
+// kept after it's used, so that the links can still be resolved to the user.
+type ActivationToken struct {
+	SecretHash ActivationSecretHash
+	UserID     uuid.UUID
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	UsedAt     *time.Time
+}
+
+// NewActivationToken creates an activation token for the user which is valid
+// for expiration. The returned secret is the only way to use the token.
+func NewActivationToken(userID uuid.UUID, now time.Time, expiration time.Duration) (ActivationSecret, ActivationToken, error) {
+	secret, err := NewActivationSecret()
+	if err != nil {
+		return secret, ActivationToken{}, err
+	}
+
+	return secret, ActivationToken{
+		SecretHash: secret.Hash(),
+		UserID:     userID,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(expiration),
+	}, nil
+}
+
+// Expired returns whether the activation token can't be used anymore.
+func (token *ActivationToken) Expired(now time.Time) bool {
+	return !now.Before(token.ExpiresAt)
+}
+
+// Used returns whether the activation token was used already.
+func (token *ActivationToken) Used() bool {
+	return token.UsedAt != nil
+}
+
+// ActivationConfig contains configurations for activating the accounts of users.
+type ActivationConfig struct {
+	TokenExpiration time.Duration `help:"how long the link to activate an account is valid" default:"24h"`
+	ResendCooldown  time.Duration `help:"how long a user has to wait before another activation email is sent" default:"5m"`
+}
@@ -9,6 +9,7 @@ import (
 	"storj.io/common/macaroon"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -30,4 +31,21 @@ type Buckets interface {
 	ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storj.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error)
 	// CountBuckets returns the number of buckets a project currently has.
 	CountBuckets(ctx context.Context, projectID uuid.UUID) (int, error)
+	// GetBucketQuota returns the quota of an existing bucket.
+	GetBucketQuota(ctx context.Context, bucketName []byte, projectID uuid.UUID) (buckets.Quota, error)
+	// UpdateBucketQuota replaces the quota of an existing bucket.
+	UpdateBucketQuota(ctx context.Context, bucketName []byte, projectID uuid.UUID, quota buckets.Quota) error
+	// GetLifecycleRules returns the lifecycle rules of an existing bucket.
+	GetLifecycleRules(ctx context.Context, bucketName []byte, projectID uuid.UUID) ([]buckets.LifecycleRule, error)
+	// UpdateLifecycleRules replaces the lifecycle rules of an existing bucket.
+	UpdateLifecycleRules(ctx context.Context, bucketName []byte, projectID uuid.UUID, rules []buckets.LifecycleRule) error
+}
+
+// BucketQuota is the quota of a bucket together with the usage of the bucket
+// from its latest tally, which the quota is enforced against.
+type BucketQuota struct {
+	buckets.Quota
+
+	ObjectCount int64 `json:"objectCount"`
+	TotalBytes  int64 `json:"totalBytes"`
 }
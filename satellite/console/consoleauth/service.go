@@ -18,7 +18,7 @@ var mon = monkit.Package()
 
 // Config contains configuration parameters for console auth.
 type Config struct {
-	TokenExpirationTime time.Duration `help:"expiration time for account recovery and activation tokens" default:"24h"`
+	TokenExpirationTime time.Duration `help:"expiration time for account recovery tokens" default:"24h"`
 }
 
 // Service handles creating, signing, and checking the expiration of auth tokens.
@@ -52,6 +52,8 @@ type Auth struct {
 	PasswordRecoveryURL       string
 	CancelPasswordRecoveryURL string
 	ActivateAccountURL        string
+	VerifyEmailChangeURL      string
+	RevertEmailChangeURL      string
 	SatelliteName             string
 	service                   *console.Service
 	analytics                 *analytics.Service
@@ -73,6 +75,8 @@ func NewAuth(log *zap.Logger, service *console.Service, mailService *mailservice
 		PasswordRecoveryURL:       externalAddress + "password-recovery/",
 		CancelPasswordRecoveryURL: externalAddress + "cancel-password-recovery/",
 		ActivateAccountURL:        externalAddress + "activation/",
+		VerifyEmailChangeURL:      externalAddress + "change-email/verify/",
+		RevertEmailChangeURL:      externalAddress + "change-email/revert/",
 		service:                   service,
 		mailService:               mailService,
 		cookieAuth:                cookieAuth,
@@ -314,8 +318,18 @@ func (a *Auth) Register(w http.ResponseWriter, r *http.Request) {
 		a.analytics.TrackCreateUser(trackCreateUserFields)
 	}
 
-	token, err := a.service.GenerateActivationToken(ctx, user.ID, user.Email)
+	var token string
+	if len(unverified) > 0 {
+		token, err = a.service.ResendActivationToken(ctx, user.ID, user.Email)
+	} else {
+		token, err = a.service.GenerateActivationToken(ctx, user.ID, user.Email)
+	}
 	if err != nil {
+		// respond as if the email was sent, so that the response doesn't
+		// reveal whether the account exists.
+		if console.ErrActivationThrottled.Has(err) {
+			return
+		}
 		a.serveJSONError(w, err)
 		return
 	}
@@ -441,7 +455,8 @@ func (a *Auth) DeleteAccount(w http.ResponseWriter, r *http.Request) {
 	a.serveJSONError(w, errNotImplemented)
 }
 
-// ChangeEmail auth user, changes users email for a new one.
+// ChangeEmail auth user, requests changing users email for a new one.
+// The email is changed once the new address is verified.
 func (a *Auth) ChangeEmail(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
@@ -449,6 +464,7 @@ func (a *Auth) ChangeEmail(w http.ResponseWriter, r *http.Request) {
 
 	var emailChange struct {
 		NewEmail string `json:"newEmail"`
+		Password string `json:"password"`
 	}
 
 	err = json.NewDecoder(r.Body).Decode(&emailChange)
@@ -457,11 +473,80 @@ func (a *Auth) ChangeEmail(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = a.service.ChangeEmail(ctx, emailChange.NewEmail)
+	change, err := a.service.RequestEmailChange(ctx, emailChange.NewEmail, emailChange.Password)
 	if err != nil {
 		a.serveJSONError(w, err)
 		return
 	}
+
+	a.mailService.SendRenderedAsync(
+		ctx,
+		[]post.Address{{Address: change.NewEmail}},
+		&consoleql.EmailChangeVerificationEmail{
+			Satellite:  a.SatelliteName,
+			NewEmail:   change.NewEmail,
+			VerifyLink: a.VerifyEmailChangeURL + "?token=" + change.Secret.String(),
+			ExpiresAt:  change.ExpiresAt.UTC().Format(time.RFC1123),
+		},
+	)
+	a.mailService.SendRenderedAsync(
+		ctx,
+		[]post.Address{{Address: change.OldEmail}},
+		&consoleql.EmailChangeRequestedEmail{
+			Satellite:       a.SatelliteName,
+			OldEmail:        change.OldEmail,
+			NewEmail:        change.NewEmail,
+			SupportTeamLink: a.GeneralRequestURL,
+		},
+	)
+}
+
+// VerifyEmailChange changes users email once the new address is verified and
+// sends a link to revert the change to the previous address.
+func (a *Auth) VerifyEmailChange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	revert, err := a.service.VerifyEmailChange(ctx, r.URL.Query().Get("token"))
+	if err != nil {
+		a.log.Debug("email change verification", zap.Error(err))
+		http.Redirect(w, r, a.ExternalAddress+"login?emailChanged=false", http.StatusTemporaryRedirect)
+		return
+	}
+
+	a.mailService.SendRenderedAsync(
+		ctx,
+		[]post.Address{{Address: revert.OldEmail}},
+		&consoleql.EmailChangedEmail{
+			Satellite:       a.SatelliteName,
+			OldEmail:        revert.OldEmail,
+			NewEmail:        revert.NewEmail,
+			RevertLink:      a.RevertEmailChangeURL + "?token=" + revert.Secret.String(),
+			RevertDeadline:  revert.ExpiresAt.UTC().Format(time.RFC1123),
+			SupportTeamLink: a.GeneralRequestURL,
+		},
+	)
+
+	http.Redirect(w, r, a.ExternalAddress+"login?emailChanged=true", http.StatusTemporaryRedirect)
+}
+
+// RevertEmailChange restores users previous email during the cooling-off
+// period of an email change.
+func (a *Auth) RevertEmailChange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = a.service.RevertEmailChange(ctx, r.URL.Query().Get("token"))
+	if err != nil {
+		a.log.Debug("email change revert", zap.Error(err))
+		http.Redirect(w, r, a.ExternalAddress+"login?emailReverted=false", http.StatusTemporaryRedirect)
+		return
+	}
+
+	a.cookieAuth.RemoveTokenCookie(w)
+	http.Redirect(w, r, a.ExternalAddress+"login?emailReverted=true", http.StatusTemporaryRedirect)
 }
 
 // ChangePassword auth user, changes users password for a new one.
@@ -608,8 +693,13 @@ func (a *Auth) ResendEmail(w http.ResponseWriter, r *http.Request) {
 
 	user := unverified[0]
 
-	token, err := a.service.GenerateActivationToken(ctx, user.ID, user.Email)
+	token, err := a.service.ResendActivationToken(ctx, user.ID, user.Email)
 	if err != nil {
+		// respond as if the email was sent, so that the response doesn't
+		// reveal whether the account exists.
+		if console.ErrActivationThrottled.Has(err) {
+			return
+		}
 		a.serveJSONError(w, err)
 		return
 	}
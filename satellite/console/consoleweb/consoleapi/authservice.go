@@ -0,0 +1,89 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+var (
+	// ErrAuthService - auth service client error type.
+	ErrAuthService = errs.Class("auth service")
+)
+
+// gatewayCredentials are the S3 compatible credentials which the auth service
+// returns for a registered access grant.
+type gatewayCredentials struct {
+	AccessKeyID string `json:"access_key_id"`
+	SecretKey   string `json:"secret_key"`
+	Endpoint    string `json:"endpoint"`
+}
+
+// authServiceClient registers access grants with the auth service of the
+// hosted gateway.
+type authServiceClient struct {
+	client *http.Client
+	url    string
+}
+
+// newAuthServiceClient creates a client of the auth service at url.
+func newAuthServiceClient(url string) *authServiceClient {
+	return &authServiceClient{
+		client: &http.Client{Timeout: 10 * time.Second},
+		url:    strings.TrimSuffix(url, "/"),
+	}
+}
+
+// configured returns whether the auth service url is configured.
+func (auth *authServiceClient) configured() bool {
+	return auth.url != ""
+}
+
+// registerAccess registers the access grant with the auth service and returns
+// its gateway credentials. Public access grants can be used by the
+// linksharing service without the secret key.
+func (auth *authServiceClient) registerAccess(ctx context.Context, access string, public bool) (_ gatewayCredentials, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(map[string]interface{}{
+		"access_grant": access,
+		"public":       public,
+	})
+	if err != nil {
+		return gatewayCredentials{}, ErrAuthService.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, auth.url+"/v1/access", bytes.NewReader(body))
+	if err != nil {
+		return gatewayCredentials{}, ErrAuthService.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := auth.client.Do(req)
+	if err != nil {
+		return gatewayCredentials{}, ErrAuthService.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return gatewayCredentials{}, ErrAuthService.New("auth service responded with status %d", resp.StatusCode)
+	}
+
+	var credentials gatewayCredentials
+	if err := json.NewDecoder(resp.Body).Decode(&credentials); err != nil {
+		return gatewayCredentials{}, ErrAuthService.Wrap(err)
+	}
+	if credentials.AccessKeyID == "" {
+		return gatewayCredentials{}, ErrAuthService.New("auth service didn't return an access key id")
+	}
+
+	return credentials, nil
+}
@@ -7,10 +7,13 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/gorilla/mux"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 )
 
@@ -66,6 +69,148 @@ func (b *Buckets) AllBucketNames(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetQuota returns the quota and the usage of a bucket of the project.
+func (b *Buckets) GetQuota(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.bucketParams(w, r)
+	if !ok {
+		return
+	}
+
+	quota, err := b.service.GetBucketQuota(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveBucketQuotaError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(quota)
+	if err != nil {
+		b.log.Error("failed to write json bucket quota response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// UpdateQuota replaces the quota of a bucket of the project.
+func (b *Buckets) UpdateQuota(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.bucketParams(w, r)
+	if !ok {
+		return
+	}
+
+	var quota buckets.Quota
+	if err = json.NewDecoder(r.Body).Decode(&quota); err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.UpdateBucketQuota(ctx, projectID, bucketName, quota)
+	if err != nil {
+		b.serveBucketQuotaError(w, err)
+		return
+	}
+}
+
+// GetLifecycle returns the lifecycle rules of a bucket of the project.
+func (b *Buckets) GetLifecycle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.bucketParams(w, r)
+	if !ok {
+		return
+	}
+
+	rules, err := b.service.GetBucketLifecycleRules(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveBucketQuotaError(w, err)
+		return
+	}
+	if rules == nil {
+		rules = []buckets.LifecycleRule{}
+	}
+
+	err = json.NewEncoder(w).Encode(rules)
+	if err != nil {
+		b.log.Error("failed to write json bucket lifecycle response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// UpdateLifecycle replaces the lifecycle rules of a bucket of the project.
+func (b *Buckets) UpdateLifecycle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.bucketParams(w, r)
+	if !ok {
+		return
+	}
+
+	var rules []buckets.LifecycleRule
+	if err = json.NewDecoder(r.Body).Decode(&rules); err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.UpdateBucketLifecycleRules(ctx, projectID, bucketName, rules)
+	if err != nil {
+		b.serveBucketQuotaError(w, err)
+		return
+	}
+}
+
+// bucketParams returns the project id and the bucket name route params.
+// It serves the error and returns false when they're invalid.
+func (b *Buckets) bucketParams(w http.ResponseWriter, r *http.Request) (projectID uuid.UUID, bucketName string, ok bool) {
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return uuid.UUID{}, "", false
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return uuid.UUID{}, "", false
+	}
+
+	bucketName, ok = mux.Vars(r)["bucket"]
+	if !ok || bucketName == "" {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("missing bucket route param"))
+		return uuid.UUID{}, "", false
+	}
+
+	return projectID, bucketName, true
+}
+
+// serveBucketQuotaError writes the error of a bucket quota or lifecycle request.
+func (b *Buckets) serveBucketQuotaError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		b.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrValidation.Has(err):
+		b.serveJSONError(w, http.StatusBadRequest, err)
+	case storj.ErrBucketNotFound.Has(err):
+		b.serveJSONError(w, http.StatusNotFound, err)
+	default:
+		b.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (b *Buckets) serveJSONError(w http.ResponseWriter, status int, err error) {
 	serveJSONError(b.log, w, status, err)
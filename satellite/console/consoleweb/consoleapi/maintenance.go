@@ -0,0 +1,48 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/maintenance"
+)
+
+var (
+	// ErrMaintenanceAPI - console maintenance api error type.
+	ErrMaintenanceAPI = errs.Class("console api maintenance")
+)
+
+// Maintenance is an api controller that exposes the maintenance windows of the satellite.
+type Maintenance struct {
+	log     *zap.Logger
+	service *maintenance.Service
+}
+
+// NewMaintenance is a constructor for api maintenance controller.
+func NewMaintenance(log *zap.Logger, service *maintenance.Service) *Maintenance {
+	return &Maintenance{
+		log:     log,
+		service: service,
+	}
+}
+
+// Status returns the active and upcoming maintenance windows, which the
+// dashboard shows as banners.
+func (m *Maintenance) Status(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(m.service.Status(ctx))
+	if err != nil {
+		m.log.Error("failed to write json maintenance status response", zap.Error(ErrMaintenanceAPI.Wrap(err)))
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrOnboardingAPI - console onboarding api error type.
+	ErrOnboardingAPI = errs.Class("console api onboarding")
+)
+
+// Onboarding is an api controller that exposes the progress of the user
+// through the onboarding of the satellite UI.
+type Onboarding struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewOnboarding is a constructor for api onboarding controller.
+func NewOnboarding(log *zap.Logger, service *console.Service) *Onboarding {
+	return &Onboarding{
+		log:     log,
+		service: service,
+	}
+}
+
+// GetProgress returns the onboarding progress of the user.
+func (o *Onboarding) GetProgress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	progress, err := o.service.GetOnboardingProgress(ctx)
+	if err != nil {
+		o.serveOnboardingError(w, err)
+		return
+	}
+
+	o.serveProgress(w, progress)
+}
+
+// CompleteStep marks the onboarding step as completed by the user and
+// returns the updated progress.
+func (o *Onboarding) CompleteStep(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	step, ok := mux.Vars(r)["step"]
+	if !ok {
+		o.serveJSONError(w, http.StatusBadRequest, errs.New("missing step route param"))
+		return
+	}
+
+	progress, err := o.service.CompleteOnboardingStep(ctx, console.OnboardingStep(step))
+	if err != nil {
+		o.serveOnboardingError(w, err)
+		return
+	}
+
+	o.serveProgress(w, progress)
+}
+
+// Reset restarts the onboarding of the user from the first step.
+func (o *Onboarding) Reset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = o.service.ResetOnboarding(ctx)
+	if err != nil {
+		o.serveOnboardingError(w, err)
+		return
+	}
+}
+
+// serveProgress writes the onboarding progress to response output stream.
+func (o *Onboarding) serveProgress(w http.ResponseWriter, progress *console.OnboardingProgress) {
+	err := json.NewEncoder(w).Encode(progress)
+	if err != nil {
+		o.log.Error("error encoding onboarding progress", zap.Error(ErrOnboardingAPI.Wrap(err)))
+	}
+}
+
+// serveOnboardingError writes the error of an onboarding request.
+func (o *Onboarding) serveOnboardingError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err):
+		o.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrValidation.Has(err):
+		o.serveJSONError(w, http.StatusBadRequest, err)
+	default:
+		o.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (o *Onboarding) serveJSONError(w http.ResponseWriter, status int, err error) {
+	serveJSONError(o.log, w, status, err)
+}
@@ -17,6 +17,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
 )
 
 var (
@@ -25,6 +26,9 @@ var (
 	mon            = monkit.Package()
 )
 
+// maxWebhookPayloadSize is the maximum size of a webhook event of the payment provider.
+const maxWebhookPayloadSize = 64 * 1024
+
 // Payments is an api controller that exposes all payment related functionality.
 type Payments struct {
 	log     *zap.Logger
@@ -446,6 +450,31 @@ func (p *Payments) Transactions(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Webhook handles the webhook events of the payment provider, e.g. the failed
+// invoice payments.
+func (p *Payments) Webhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	payload, err := ioutil.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = p.service.Payments().HandleWebhookEvent(ctx, payload, r.Header.Get("Stripe-Signature"))
+	if err != nil {
+		if payments.ErrInvalidWebhookEvent.Has(err) {
+			p.serveJSONError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (p *Payments) serveJSONError(w http.ResponseWriter, status int, err error) {
 	serveJSONError(p.log, w, status, err)
@@ -0,0 +1,95 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrProjectActivitiesAPI - console project activities api error type.
+	ErrProjectActivitiesAPI = errs.Class("console api project activities")
+)
+
+// ProjectActivities is an api controller that exposes the activity feed of projects.
+type ProjectActivities struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewProjectActivities is a constructor for api project activities controller.
+func NewProjectActivities(log *zap.Logger, service *console.Service) *ProjectActivities {
+	return &ProjectActivities{
+		log:     log,
+		service: service,
+	}
+}
+
+// GetPage returns a page of the activity feed of the project.
+func (pa *ProjectActivities) GetPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		pa.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		pa.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	var cursor console.ProjectActivityCursor
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		limit, err := strconv.ParseUint(limitParam, 10, 32)
+		if err != nil {
+			pa.serveJSONError(w, http.StatusBadRequest, errs.New("invalid limit: %v", err))
+			return
+		}
+		cursor.Limit = uint(limit)
+	}
+	if pageParam := r.URL.Query().Get("page"); pageParam != "" {
+		page, err := strconv.ParseUint(pageParam, 10, 32)
+		if err != nil {
+			pa.serveJSONError(w, http.StatusBadRequest, errs.New("invalid page: %v", err))
+			return
+		}
+		cursor.Page = uint(page)
+	}
+
+	page, err := pa.service.GetProjectActivities(ctx, projectID, cursor)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) || console.ErrNoMembership.Has(err) {
+			pa.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		pa.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(page)
+	if err != nil {
+		pa.log.Error("error encoding project activities", zap.Error(ErrProjectActivitiesAPI.Wrap(err)))
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (pa *ProjectActivities) serveJSONError(w http.ResponseWriter, status int, err error) {
+	serveJSONError(pa.log, w, status, err)
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrProjectDeletionsAPI - console project deletions api error type.
+	ErrProjectDeletionsAPI = errs.Class("console api project deletions")
+)
+
+// ProjectDeletions is an api controller that exposes the deletion state of
+// projects and restores deleted projects.
+type ProjectDeletions struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewProjectDeletions is a constructor for api project deletions controller.
+func NewProjectDeletions(log *zap.Logger, service *console.Service) *ProjectDeletions {
+	return &ProjectDeletions{
+		log:     log,
+		service: service,
+	}
+}
+
+// Get returns the deletion of the project, null when the project isn't deleted.
+func (pd *ProjectDeletions) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, ok := pd.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	deletion, err := pd.service.GetProjectDeletion(ctx, projectID)
+	if err != nil {
+		pd.serveProjectDeletionError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(deletion)
+	if err != nil {
+		pd.log.Error("error encoding project deletion", zap.Error(ErrProjectDeletionsAPI.Wrap(err)))
+	}
+}
+
+// Restore restores the deleted project.
+func (pd *ProjectDeletions) Restore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, ok := pd.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	err = pd.service.RestoreProject(ctx, projectID)
+	if err != nil {
+		pd.serveProjectDeletionError(w, err)
+		return
+	}
+}
+
+// projectID returns the project id route param. It serves the error and
+// returns false when it's invalid.
+func (pd *ProjectDeletions) projectID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		pd.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return uuid.UUID{}, false
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		pd.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return uuid.UUID{}, false
+	}
+	return projectID, true
+}
+
+// serveProjectDeletionError writes the error of a project deletion request.
+func (pd *ProjectDeletions) serveProjectDeletionError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		pd.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrValidation.Has(err):
+		pd.serveJSONError(w, http.StatusConflict, err)
+	default:
+		pd.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (pd *ProjectDeletions) serveJSONError(w http.ResponseWriter, status int, err error) {
+	serveJSONError(pd.log, w, status, err)
+}
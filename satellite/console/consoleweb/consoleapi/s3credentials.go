@@ -0,0 +1,130 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/grant"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrS3CredentialsAPI - console S3 credentials api error type.
+	ErrS3CredentialsAPI = errs.Class("console api s3 credentials")
+)
+
+// S3Credentials is an api controller that generates the S3 compatible
+// credentials of the hosted gateway for the access grants of projects.
+type S3Credentials struct {
+	log         *zap.Logger
+	service     *console.Service
+	authService *authServiceClient
+}
+
+// NewS3Credentials is a constructor for api S3 credentials controller.
+func NewS3Credentials(log *zap.Logger, service *console.Service, authServiceURL string) *S3Credentials {
+	return &S3Credentials{
+		log:         log,
+		service:     service,
+		authService: newAuthServiceClient(authServiceURL),
+	}
+}
+
+// Create restricts the access grant of the project, registers it with the auth
+// service and returns its S3 credentials.
+func (c *S3Credentials) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if !c.authService.configured() {
+		c.serveJSONError(w, http.StatusNotImplemented, ErrS3CredentialsAPI.New("auth service is not configured"))
+		return
+	}
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		c.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	var request struct {
+		AccessGrant string   `json:"accessGrant"`
+		Buckets     []string `json:"buckets"`
+		Permissions struct {
+			Download bool `json:"download"`
+			Upload   bool `json:"upload"`
+			List     bool `json:"list"`
+			Delete   bool `json:"delete"`
+		} `json:"permissions"`
+		ExpiresAt *time.Time `json:"expiresAt"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		c.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	permission := grant.Permission{
+		AllowDownload: request.Permissions.Download,
+		AllowUpload:   request.Permissions.Upload,
+		AllowList:     request.Permissions.List,
+		AllowDelete:   request.Permissions.Delete,
+	}
+	if request.ExpiresAt != nil {
+		permission.NotAfter = *request.ExpiresAt
+	}
+
+	access, err := c.service.CreateS3Access(ctx, projectID, request.AccessGrant, permission, request.Buckets)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err), console.ErrNoAPIKey.Has(err):
+			c.serveJSONError(w, http.StatusUnauthorized, err)
+		case console.ErrValidation.Has(err):
+			c.serveJSONError(w, http.StatusBadRequest, err)
+		default:
+			c.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	credentials, err := c.authService.registerAccess(ctx, access, false)
+	if err != nil {
+		c.serveJSONError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	var response struct {
+		AccessKeyID string `json:"accessKeyId"`
+		SecretKey   string `json:"secretKey"`
+		Endpoint    string `json:"endpoint"`
+	}
+	response.AccessKeyID = credentials.AccessKeyID
+	response.SecretKey = credentials.SecretKey
+	response.Endpoint = credentials.Endpoint
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		c.log.Error("failed to write json s3 credentials response", zap.Error(ErrS3CredentialsAPI.Wrap(err)))
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (c *S3Credentials) serveJSONError(w http.ResponseWriter, status int, err error) {
+	serveJSONError(c.log, w, status, err)
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrSecurityLogAPI - console project security log api error type.
+	ErrSecurityLogAPI = errs.Class("console api security log")
+)
+
+// SecurityLog is an api controller that exposes the security log of projects.
+type SecurityLog struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewSecurityLog is a constructor for api security log controller.
+func NewSecurityLog(log *zap.Logger, service *console.Service) *SecurityLog {
+	return &SecurityLog{
+		log:     log,
+		service: service,
+	}
+}
+
+// GetPage returns a page of the security log of the project.
+func (sl *SecurityLog) GetPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		sl.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		sl.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	var cursor console.SecurityLogCursor
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		limit, err := strconv.ParseUint(limitParam, 10, 32)
+		if err != nil {
+			sl.serveJSONError(w, http.StatusBadRequest, errs.New("invalid limit: %v", err))
+			return
+		}
+		cursor.Limit = uint(limit)
+	}
+	if pageParam := r.URL.Query().Get("page"); pageParam != "" {
+		page, err := strconv.ParseUint(pageParam, 10, 32)
+		if err != nil {
+			sl.serveJSONError(w, http.StatusBadRequest, errs.New("invalid page: %v", err))
+			return
+		}
+		cursor.Page = uint(page)
+	}
+
+	page, err := sl.service.GetProjectSecurityLog(ctx, projectID, cursor)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) || console.ErrNoMembership.Has(err) {
+			sl.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		sl.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(page)
+	if err != nil {
+		sl.log.Error("error encoding project security log", zap.Error(ErrSecurityLogAPI.Wrap(err)))
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (sl *SecurityLog) serveJSONError(w http.ResponseWriter, status int, err error) {
+	serveJSONError(sl.log, w, status, err)
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrShareLinksAPI - console share links api error type.
+	ErrShareLinksAPI = errs.Class("console api share links")
+)
+
+// ShareLinks is an api controller that creates links to share objects with
+// the linksharing service.
+type ShareLinks struct {
+	log            *zap.Logger
+	service        *console.Service
+	authService    *authServiceClient
+	linksharingURL string
+}
+
+// NewShareLinks is a constructor for api share links controller.
+func NewShareLinks(log *zap.Logger, service *console.Service, authServiceURL, linksharingURL string) *ShareLinks {
+	return &ShareLinks{
+		log:            log,
+		service:        service,
+		authService:    newAuthServiceClient(authServiceURL),
+		linksharingURL: strings.TrimSuffix(linksharingURL, "/"),
+	}
+}
+
+// Create restricts the access grant to the object, registers it with the
+// auth service and returns the link sharing URL of the object.
+func (links *ShareLinks) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if !links.authService.configured() || links.linksharingURL == "" {
+		links.serveJSONError(w, http.StatusNotImplemented, ErrShareLinksAPI.New("link sharing is not configured"))
+		return
+	}
+
+	var request struct {
+		AccessGrant string    `json:"accessGrant"`
+		Bucket      string    `json:"bucket"`
+		Key         string    `json:"key"`
+		ExpiresAt   time.Time `json:"expiresAt"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		links.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	access, err := links.service.CreateShareAccess(ctx, request.AccessGrant, request.Bucket, request.Key, request.ExpiresAt)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err), console.ErrNoAPIKey.Has(err):
+			links.serveJSONError(w, http.StatusUnauthorized, err)
+		case console.ErrValidation.Has(err):
+			links.serveJSONError(w, http.StatusBadRequest, err)
+		default:
+			links.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	credentials, err := links.authService.registerAccess(ctx, access, true)
+	if err != nil {
+		links.serveJSONError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	var response struct {
+		URL       string    `json:"url"`
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+	response.URL = links.shareURL(credentials.AccessKeyID, request.Bucket, request.Key)
+	response.ExpiresAt = request.ExpiresAt
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		links.log.Error("failed to write json share link response", zap.Error(ErrShareLinksAPI.Wrap(err)))
+	}
+}
+
+// shareURL returns the link sharing URL of the object.
+func (links *ShareLinks) shareURL(accessKeyID, bucket, key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return links.linksharingURL + "/s/" + url.PathEscape(accessKeyID) + "/" +
+		url.PathEscape(bucket) + "/" + strings.Join(segments, "/")
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (links *ShareLinks) serveJSONError(w http.ResponseWriter, status int, err error) {
+	serveJSONError(links.log, w, status, err)
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/grant"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestCreateShareLink(t *testing.T) {
+	var mu sync.Mutex
+	var registered []string
+	authService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			AccessGrant string `json:"access_grant"`
+			Public      bool   `json:"public"`
+		}
+		if r.URL.Path != "/v1/access" || json.NewDecoder(r.Body).Decode(&body) != nil || !body.Public {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		registered = append(registered, body.AccessGrant)
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"access_key_id":"accesskeyid","secret_key":"secret","endpoint":"https://gateway.test"}`))
+	}))
+	defer authService.Close()
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 2,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.GatewayCredentialsRequestURL = authService.URL
+				config.Console.LinksharingURL = "https://link.test/"
+				config.Console.ShareLinkMaxDuration = 24 * time.Hour
+				config.Console.RateLimit.Burst = 10
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		accessGrant, err := upl.Access[sat.ID()].Serialize()
+		require.NoError(t, err)
+
+		login := upl.User[sat.ID()]
+		token, err := sat.API.Console.Service.Token(ctx, console.AuthUser{Email: login.Email, Password: login.Password})
+		require.NoError(t, err)
+
+		createLink := func(accessGrant string, expiresAt time.Time) *http.Response {
+			body, err := json.Marshal(map[string]interface{}{
+				"accessGrant": accessGrant,
+				"bucket":      "bucket",
+				"key":         "dir/some object",
+				"expiresAt":   expiresAt,
+			})
+			require.NoError(t, err)
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+				"http://"+sat.API.Console.Listener.Addr().String()+"/api/v0/share-links", bytes.NewReader(body))
+			require.NoError(t, err)
+			req.AddCookie(&http.Cookie{
+				Name:    "_tokenKey",
+				Path:    "/",
+				Value:   token.String(),
+				Expires: time.Now().AddDate(0, 0, 1),
+			})
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			return resp
+		}
+
+		expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+		resp := createLink(accessGrant, expiresAt)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var link struct {
+			URL       string    `json:"url"`
+			ExpiresAt time.Time `json:"expiresAt"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&link))
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, "https://link.test/s/accesskeyid/bucket/dir/some%20object", link.URL)
+		require.True(t, expiresAt.Equal(link.ExpiresAt))
+
+		mu.Lock()
+		require.Len(t, registered, 1)
+		restricted, err := grant.ParseAccess(registered[0])
+		mu.Unlock()
+		require.NoError(t, err)
+		original, err := grant.ParseAccess(accessGrant)
+		require.NoError(t, err)
+		require.NotEqual(t, original.APIKey.Serialize(), restricted.APIKey.Serialize())
+
+		// expiration beyond the maximum duration
+		resp = createLink(accessGrant, time.Now().Add(48*time.Hour))
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.NoError(t, resp.Body.Close())
+
+		// access grant of a project the user isn't a member of
+		otherAccessGrant, err := planet.Uplinks[1].Access[sat.ID()].Serialize()
+		require.NoError(t, err)
+		resp = createLink(otherAccessGrant, expiresAt)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		require.NoError(t, resp.Body.Close())
+
+		resp = createLink("invalid", expiresAt)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.NoError(t, resp.Body.Close())
+
+		mu.Lock()
+		require.Len(t, registered, 1)
+		mu.Unlock()
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrUsageAnomaliesAPI - console usage anomalies api error type.
+	ErrUsageAnomaliesAPI = errs.Class("console api usage anomalies")
+)
+
+// UsageAnomalies is an api controller that exposes the setting of the usage
+// anomaly detection of projects.
+type UsageAnomalies struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewUsageAnomalies is a constructor for api usage anomalies controller.
+func NewUsageAnomalies(log *zap.Logger, service *console.Service) *UsageAnomalies {
+	return &UsageAnomalies{
+		log:     log,
+		service: service,
+	}
+}
+
+// GetSetting returns the setting of the usage anomaly detection of the project.
+func (ua *UsageAnomalies) GetSetting(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, ok := ua.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	setting, err := ua.service.GetUsageAnomalySetting(ctx, projectID)
+	if err != nil {
+		ua.serveUsageAnomalyError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(setting)
+	if err != nil {
+		ua.log.Error("error encoding usage anomaly setting", zap.Error(ErrUsageAnomaliesAPI.Wrap(err)))
+	}
+}
+
+// UpdateSensitivity replaces the sensitivity of the usage anomaly detection of the project.
+func (ua *UsageAnomalies) UpdateSensitivity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, ok := ua.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	var request struct {
+		Sensitivity console.UsageAnomalySensitivity `json:"sensitivity"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ua.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = ua.service.UpdateUsageAnomalySensitivity(ctx, projectID, request.Sensitivity)
+	if err != nil {
+		ua.serveUsageAnomalyError(w, err)
+		return
+	}
+}
+
+// projectID returns the project id route param. It serves the error and
+// returns false when it's invalid.
+func (ua *UsageAnomalies) projectID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		ua.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return uuid.UUID{}, false
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		ua.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return uuid.UUID{}, false
+	}
+	return projectID, true
+}
+
+// serveUsageAnomalyError writes the error of a usage anomaly setting request.
+func (ua *UsageAnomalies) serveUsageAnomalyError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		ua.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrValidation.Has(err):
+		ua.serveJSONError(w, http.StatusBadRequest, err)
+	default:
+		ua.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (ua *UsageAnomalies) serveJSONError(w http.ResponseWriter, status int, err error) {
+	serveJSONError(ua.log, w, status, err)
+}
@@ -153,6 +153,207 @@ func (ul *UsageLimits) DailyUsage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// HourlyUsage returns hourly usage by project ID for at most the last 72 hours.
+func (ul *UsageLimits) HourlyUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var ok bool
+	var idParam string
+
+	if idParam, ok = mux.Vars(r)["id"]; !ok {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	sinceStamp, err := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+	beforeStamp, err := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	since := time.Unix(sinceStamp, 0)
+	before := time.Unix(beforeStamp, 0)
+
+	hourlyUsage, err := ul.service.GetHourlyProjectUsage(ctx, projectID, since, before)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			ul.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		case console.ErrValidation.Has(err):
+			ul.serveJSONError(w, http.StatusBadRequest, err)
+			return
+		default:
+			ul.serveJSONError(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	err = json.NewEncoder(w).Encode(hourlyUsage)
+	if err != nil {
+		ul.log.Error("error encoding hourly project usage", zap.Error(ErrUsageLimitsAPI.Wrap(err)))
+	}
+}
+
+// APIKeyUsage returns the usage of the project by project ID, which is
+// attributed to each of its api keys.
+func (ul *UsageLimits) APIKeyUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var ok bool
+	var idParam string
+
+	if idParam, ok = mux.Vars(r)["id"]; !ok {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	sinceStamp, err := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+	beforeStamp, err := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	since := time.Unix(sinceStamp, 0)
+	before := time.Unix(beforeStamp, 0)
+
+	apiKeyUsage, err := ul.service.GetAPIKeyProjectUsage(ctx, projectID, since, before)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			ul.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		case console.ErrValidation.Has(err):
+			ul.serveJSONError(w, http.StatusBadRequest, err)
+			return
+		default:
+			ul.serveJSONError(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	err = json.NewEncoder(w).Encode(apiKeyUsage)
+	if err != nil {
+		ul.log.Error("error encoding api key project usage", zap.Error(ErrUsageLimitsAPI.Wrap(err)))
+	}
+}
+
+// SharedLinkUsage returns the usage of the objects of the project by project
+// ID, which were downloaded through shared links.
+func (ul *UsageLimits) SharedLinkUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var ok bool
+	var idParam string
+
+	if idParam, ok = mux.Vars(r)["id"]; !ok {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	sinceStamp, err := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+	beforeStamp, err := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	since := time.Unix(sinceStamp, 0)
+	before := time.Unix(beforeStamp, 0)
+
+	sharedLinkUsage, err := ul.service.GetSharedLinkProjectUsage(ctx, projectID, since, before)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			ul.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		case console.ErrValidation.Has(err):
+			ul.serveJSONError(w, http.StatusBadRequest, err)
+			return
+		default:
+			ul.serveJSONError(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	err = json.NewEncoder(w).Encode(sharedLinkUsage)
+	if err != nil {
+		ul.log.Error("error encoding shared link project usage", zap.Error(ErrUsageLimitsAPI.Wrap(err)))
+	}
+}
+
+// UsageForecast returns the usage and charges of the project for the current
+// month so far, and their forecast at the end of the month.
+func (ul *UsageLimits) UsageForecast(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var ok bool
+	var idParam string
+
+	if idParam, ok = mux.Vars(r)["id"]; !ok {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	forecast, err := ul.service.GetProjectUsageForecast(ctx, projectID)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			ul.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		ul.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(forecast)
+	if err != nil {
+		ul.log.Error("error encoding project usage forecast", zap.Error(ErrUsageLimitsAPI.Wrap(err)))
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (ul *UsageLimits) serveJSONError(w http.ResponseWriter, status int, err error) {
 	serveJSONError(ul.log, w, status, err)
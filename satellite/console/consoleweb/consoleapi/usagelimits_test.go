@@ -231,3 +231,326 @@ func Test_DailyUsage(t *testing.T) {
 		}()
 	})
 }
+
+func Test_HourlyUsage(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.OpenRegistrationEnabled = true
+				config.Console.RateLimit.Burst = 10
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		var (
+			satelliteSys = planet.Satellites[0]
+			projectID    = planet.Uplinks[0].Projects[0].ID
+			now          = time.Now()
+			since        = strconv.FormatInt(now.Add(-time.Hour).Unix(), 10)
+			before       = strconv.FormatInt(now.Unix(), 10)
+		)
+
+		user, err := satelliteSys.AddUser(ctx, console.CreateUser{
+			FullName: "Hourly Usage Test",
+			Email:    "hu@test.test",
+		}, 3)
+		require.NoError(t, err)
+
+		_, err = satelliteSys.DB.Console().ProjectMembers().Insert(ctx, user.ID, projectID)
+		require.NoError(t, err)
+
+		require.NoError(t, satelliteSys.API.Accounting.ProjectUsage.AddProjectStorageUsage(ctx, projectID, 15*memory.KiB.Int64()))
+
+		// we are using full name as a password
+		token, err := satelliteSys.API.Console.Service.Token(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
+		require.NoError(t, err)
+
+		doRequest := func(query string) (int, []byte) {
+			req, err := http.NewRequestWithContext(
+				ctx,
+				"GET",
+				fmt.Sprintf("http://%s/api/v0/projects/%s/hourly-usage?%s", satelliteSys.API.Console.Listener.Addr().String(), projectID.String(), query),
+				nil,
+			)
+			require.NoError(t, err)
+
+			req.AddCookie(&http.Cookie{
+				Name:    "_tokenKey",
+				Path:    "/",
+				Value:   token.String(),
+				Expires: time.Now().AddDate(0, 0, 1),
+			})
+
+			result, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, result.Body.Close()) }()
+
+			body, err := ioutil.ReadAll(result.Body)
+			require.NoError(t, err)
+
+			return result.StatusCode, body
+		}
+
+		status, _ := doRequest("to=" + before)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = doRequest("from=" + since + "&to=now")
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = doRequest("from=" + before + "&to=" + since)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, body := doRequest("from=" + since + "&to=" + before)
+		require.Equal(t, http.StatusOK, status)
+
+		var output accounting.ProjectHourlyUsage
+		require.NoError(t, json.Unmarshal(body, &output))
+
+		require.Len(t, output.StorageUsage, 1)
+		require.Equal(t, 15*memory.KiB.Int64(), output.StorageUsage[0].Value)
+	})
+}
+
+func Test_UsageForecast(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.OpenRegistrationEnabled = true
+				config.Console.RateLimit.Burst = 10
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		var (
+			satelliteSys = planet.Satellites[0]
+			projectID    = planet.Uplinks[0].Projects[0].ID
+		)
+
+		user, err := satelliteSys.AddUser(ctx, console.CreateUser{
+			FullName: "Usage Forecast Test",
+			Email:    "uf@test.test",
+		}, 3)
+		require.NoError(t, err)
+
+		_, err = satelliteSys.DB.Console().ProjectMembers().Insert(ctx, user.ID, projectID)
+		require.NoError(t, err)
+
+		// we are using full name as a password
+		token, err := satelliteSys.API.Console.Service.Token(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
+		require.NoError(t, err)
+
+		req, err := http.NewRequestWithContext(
+			ctx,
+			"GET",
+			fmt.Sprintf("http://%s/api/v0/projects/%s/usage-forecast", satelliteSys.API.Console.Listener.Addr().String(), projectID.String()),
+			nil,
+		)
+		require.NoError(t, err)
+
+		req.AddCookie(&http.Cookie{
+			Name:    "_tokenKey",
+			Path:    "/",
+			Value:   token.String(),
+			Expires: time.Now().AddDate(0, 0, 1),
+		})
+
+		result, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer func() { require.NoError(t, result.Body.Close()) }()
+		require.Equal(t, http.StatusOK, result.StatusCode)
+
+		var output console.ProjectUsageForecast
+		require.NoError(t, json.NewDecoder(result.Body).Decode(&output))
+
+		require.Equal(t, projectID, output.Current.ProjectID)
+		require.Equal(t, projectID, output.Projected.ProjectID)
+		require.True(t, output.Projected.Before.After(output.Current.Before) || output.Projected.Before.Equal(output.Current.Before))
+		require.GreaterOrEqual(t, output.Projected.Storage, output.Current.Storage)
+		require.GreaterOrEqual(t, output.Projected.Egress, output.Current.Egress)
+	})
+}
+
+func Test_APIKeyUsage(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.OpenRegistrationEnabled = true
+				config.Console.RateLimit.Burst = 10
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		var (
+			satelliteSys = planet.Satellites[0]
+			uplink       = planet.Uplinks[0]
+			projectID    = uplink.Projects[0].ID
+			now          = time.Now()
+			since        = strconv.FormatInt(now.Add(-time.Hour).Unix(), 10)
+			before       = strconv.FormatInt(now.Add(time.Hour).Unix(), 10)
+		)
+
+		// inline segments are accounted immediately, without the settlement of orders.
+		data := testrand.Bytes(memory.KiB)
+		require.NoError(t, uplink.Upload(ctx, satelliteSys, "testbucket", "test/path", data))
+		_, err := uplink.Download(ctx, satelliteSys, "testbucket", "test/path")
+		require.NoError(t, err)
+
+		satelliteSys.Orders.Chore.Loop.TriggerWait()
+
+		user, err := satelliteSys.AddUser(ctx, console.CreateUser{
+			FullName: "API Key Usage Test",
+			Email:    "aku@test.test",
+		}, 3)
+		require.NoError(t, err)
+
+		_, err = satelliteSys.DB.Console().ProjectMembers().Insert(ctx, user.ID, projectID)
+		require.NoError(t, err)
+
+		// we are using full name as a password
+		token, err := satelliteSys.API.Console.Service.Token(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
+		require.NoError(t, err)
+
+		doRequest := func(query string) (int, []byte) {
+			req, err := http.NewRequestWithContext(
+				ctx,
+				"GET",
+				fmt.Sprintf("http://%s/api/v0/projects/%s/api-key-usage?%s", satelliteSys.API.Console.Listener.Addr().String(), projectID.String(), query),
+				nil,
+			)
+			require.NoError(t, err)
+
+			req.AddCookie(&http.Cookie{
+				Name:    "_tokenKey",
+				Path:    "/",
+				Value:   token.String(),
+				Expires: time.Now().AddDate(0, 0, 1),
+			})
+
+			result, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, result.Body.Close()) }()
+
+			body, err := ioutil.ReadAll(result.Body)
+			require.NoError(t, err)
+
+			return result.StatusCode, body
+		}
+
+		status, _ := doRequest("to=" + before)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = doRequest("from=" + before + "&to=" + since)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, body := doRequest("from=" + since + "&to=" + before)
+		require.Equal(t, http.StatusOK, status)
+
+		var output []accounting.APIKeyUsage
+		require.NoError(t, json.Unmarshal(body, &output))
+
+		keys, err := satelliteSys.DB.Console().APIKeys().GetPagedByProjectID(ctx, projectID, console.APIKeyCursor{Limit: 10, Page: 1})
+		require.NoError(t, err)
+		require.Len(t, keys.APIKeys, 1)
+
+		require.Len(t, output, 1)
+		require.Equal(t, keys.APIKeys[0].ID, output[0].APIKeyID)
+		require.Equal(t, keys.APIKeys[0].Name, output[0].Name)
+		require.Positive(t, output[0].Egress)
+		require.Positive(t, output[0].Ingress)
+		require.GreaterOrEqual(t, output[0].Requests, int64(2))
+	})
+}
+
+func Test_SharedLinkUsage(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.OpenRegistrationEnabled = true
+				config.Console.RateLimit.Burst = 10
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		var (
+			satelliteSys = planet.Satellites[0]
+			uplink       = planet.Uplinks[0]
+			projectID    = uplink.Projects[0].ID
+			now          = time.Now()
+			since        = strconv.FormatInt(now.Add(-time.Hour).Unix(), 10)
+			before       = strconv.FormatInt(now.Add(time.Hour).Unix(), 10)
+		)
+
+		data := testrand.Bytes(memory.KiB)
+		require.NoError(t, uplink.Upload(ctx, satelliteSys, "testbucket", "private/path", data))
+		require.NoError(t, uplink.Upload(ctx, satelliteSys, "testbucket", "shared/path", data))
+
+		// downloads of regular clients aren't attributed to shared links.
+		_, err := uplink.Download(ctx, satelliteSys, "testbucket", "private/path")
+		require.NoError(t, err)
+
+		uplink.Config.UserAgent = "linksharing/v1.0.0"
+		for i := 0; i < 2; i++ {
+			_, err = uplink.Download(ctx, satelliteSys, "testbucket", "shared/path")
+			require.NoError(t, err)
+		}
+
+		satelliteSys.Orders.Chore.Loop.TriggerWait()
+
+		user, err := satelliteSys.AddUser(ctx, console.CreateUser{
+			FullName: "Shared Link Usage Test",
+			Email:    "slu@test.test",
+		}, 3)
+		require.NoError(t, err)
+
+		_, err = satelliteSys.DB.Console().ProjectMembers().Insert(ctx, user.ID, projectID)
+		require.NoError(t, err)
+
+		// we are using full name as a password
+		token, err := satelliteSys.API.Console.Service.Token(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
+		require.NoError(t, err)
+
+		doRequest := func(query string) (int, []byte) {
+			req, err := http.NewRequestWithContext(
+				ctx,
+				"GET",
+				fmt.Sprintf("http://%s/api/v0/projects/%s/shared-link-usage?%s", satelliteSys.API.Console.Listener.Addr().String(), projectID.String(), query),
+				nil,
+			)
+			require.NoError(t, err)
+
+			req.AddCookie(&http.Cookie{
+				Name:    "_tokenKey",
+				Path:    "/",
+				Value:   token.String(),
+				Expires: time.Now().AddDate(0, 0, 1),
+			})
+
+			result, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, result.Body.Close()) }()
+
+			body, err := ioutil.ReadAll(result.Body)
+			require.NoError(t, err)
+
+			return result.StatusCode, body
+		}
+
+		status, _ := doRequest("to=" + before)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = doRequest("from=" + before + "&to=" + since)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, body := doRequest("from=" + since + "&to=" + before)
+		require.Equal(t, http.StatusOK, status)
+
+		var output []accounting.SharedLinkUsage
+		require.NoError(t, json.Unmarshal(body, &output))
+
+		require.Len(t, output, 1)
+		require.Equal(t, "testbucket", output[0].BucketName)
+		require.NotEmpty(t, output[0].EncryptedObjectKey)
+		require.Positive(t, output[0].Egress)
+		require.Equal(t, int64(2), output[0].Requests)
+	})
+}
@@ -105,3 +105,47 @@ func (*AccountAlreadyExistsEmail) Template() string { return "AccountAlreadyExis
 func (*AccountAlreadyExistsEmail) Subject() string {
 	return "Are you trying to sign in?"
 }
+
+// EmailChangeVerificationEmail is mailservice template sent to the new address of an email change.
+type EmailChangeVerificationEmail struct {
+	Satellite  string
+	NewEmail   string
+	VerifyLink string
+	ExpiresAt  string
+}
+
+// Template returns email template name.
+func (*EmailChangeVerificationEmail) Template() string { return "EmailChangeVerification" }
+
+// Subject gets email subject.
+func (*EmailChangeVerificationEmail) Subject() string { return "Verify your new email address" }
+
+// EmailChangeRequestedEmail is mailservice template sent to the current address when an email change is requested.
+type EmailChangeRequestedEmail struct {
+	Satellite       string
+	OldEmail        string
+	NewEmail        string
+	SupportTeamLink string
+}
+
+// Template returns email template name.
+func (*EmailChangeRequestedEmail) Template() string { return "EmailChangeRequested" }
+
+// Subject gets email subject.
+func (*EmailChangeRequestedEmail) Subject() string { return "Email change requested" }
+
+// EmailChangedEmail is mailservice template sent to the previous address once the email was changed.
+type EmailChangedEmail struct {
+	Satellite       string
+	OldEmail        string
+	NewEmail        string
+	RevertLink      string
+	RevertDeadline  string
+	SupportTeamLink string
+}
+
+// Template returns email template name.
+func (*EmailChangedEmail) Template() string { return "EmailChanged" }
+
+// Subject gets email subject.
+func (*EmailChangedEmail) Subject() string { return "Your email was changed" }
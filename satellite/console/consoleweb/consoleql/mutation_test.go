@@ -92,6 +92,9 @@ func TestGraphqlMutation(t *testing.T) {
 			pc.StorageTBPrice,
 			pc.EgressTBPrice,
 			pc.SegmentPrice,
+			pc.PlacementPrices,
+			pc.PriceTiers,
+			pc.VolumeDiscounts,
 			pc.BonusRate)
 		require.NoError(t, err)
 
@@ -114,6 +117,9 @@ func TestGraphqlMutation(t *testing.T) {
 				PasswordCost:        console.TestPasswordCost,
 				DefaultProjectLimit: 5,
 				SessionDuration:     time.Hour,
+				Activation: console.ActivationConfig{
+					TokenExpiration: time.Hour,
+				},
 			},
 		)
 		require.NoError(t, err)
@@ -76,6 +76,9 @@ func TestGraphqlQuery(t *testing.T) {
 			pc.StorageTBPrice,
 			pc.EgressTBPrice,
 			pc.SegmentPrice,
+			pc.PlacementPrices,
+			pc.PriceTiers,
+			pc.VolumeDiscounts,
 			pc.BonusRate)
 		require.NoError(t, err)
 
@@ -98,6 +101,9 @@ func TestGraphqlQuery(t *testing.T) {
 				PasswordCost:        console.TestPasswordCost,
 				DefaultProjectLimit: 5,
 				SessionDuration:     time.Hour,
+				Activation: console.ActivationConfig{
+					TokenExpiration: time.Hour,
+				},
 			},
 		)
 		require.NoError(t, err)
@@ -40,6 +40,7 @@ import (
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 	"storj.io/storj/satellite/console/consoleweb/consolewebauth"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/maintenance"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/rewards"
@@ -122,6 +123,7 @@ type Server struct {
 	mailService *mailservice.Service
 	partners    *rewards.PartnersService
 	analytics   *analytics.Service
+	maintenance *maintenance.Service
 
 	listener          net.Listener
 	server            http.Server
@@ -203,7 +205,7 @@ func (a *apiAuth) RemoveAuthCookie(w http.ResponseWriter) {
 }
 
 // NewServer creates new instance of console server.
-func NewServer(logger *zap.Logger, config Config, service *console.Service, oidcService *oidc.Service, mailService *mailservice.Service, partners *rewards.PartnersService, analytics *analytics.Service, listener net.Listener, stripePublicKey string, pricing paymentsconfig.PricingValues, nodeURL storj.NodeURL) *Server {
+func NewServer(logger *zap.Logger, config Config, service *console.Service, oidcService *oidc.Service, mailService *mailservice.Service, partners *rewards.PartnersService, analytics *analytics.Service, maintenance *maintenance.Service, listener net.Listener, stripePublicKey string, pricing paymentsconfig.PricingValues, nodeURL storj.NodeURL) *Server {
 	server := Server{
 		log:               logger,
 		config:            config,
@@ -212,6 +214,7 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 		mailService:       mailService,
 		partners:          partners,
 		analytics:         analytics,
+		maintenance:       maintenance,
 		stripePublicKey:   stripePublicKey,
 		ipRateLimiter:     web.NewIPRateLimiter(config.RateLimit),
 		userIDRateLimiter: NewUserIDRateLimiter(config.RateLimit),
@@ -267,6 +270,54 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 		"/api/v0/projects/{id}/daily-usage",
 		server.withAuth(http.HandlerFunc(usageLimitsController.DailyUsage)),
 	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/hourly-usage",
+		server.withAuth(http.HandlerFunc(usageLimitsController.HourlyUsage)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/api-key-usage",
+		server.withAuth(http.HandlerFunc(usageLimitsController.APIKeyUsage)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/shared-link-usage",
+		server.withAuth(http.HandlerFunc(usageLimitsController.SharedLinkUsage)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/usage-forecast",
+		server.withAuth(http.HandlerFunc(usageLimitsController.UsageForecast)),
+	).Methods(http.MethodGet)
+
+	projectActivitiesController := consoleapi.NewProjectActivities(logger, service)
+	router.Handle(
+		"/api/v0/projects/{id}/activity",
+		server.withAuth(http.HandlerFunc(projectActivitiesController.GetPage)),
+	).Methods(http.MethodGet)
+
+	usageAnomaliesController := consoleapi.NewUsageAnomalies(logger, service)
+	router.Handle(
+		"/api/v0/projects/{id}/usage-anomalies/setting",
+		server.withAuth(http.HandlerFunc(usageAnomaliesController.GetSetting)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/usage-anomalies/setting",
+		server.withAuth(http.HandlerFunc(usageAnomaliesController.UpdateSensitivity)),
+	).Methods(http.MethodPut)
+
+	projectDeletionsController := consoleapi.NewProjectDeletions(logger, service)
+	router.Handle(
+		"/api/v0/projects/{id}/deletion",
+		server.withAuth(http.HandlerFunc(projectDeletionsController.Get)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/restore",
+		server.withAuth(http.HandlerFunc(projectDeletionsController.Restore)),
+	).Methods(http.MethodPost)
+
+	securityLogController := consoleapi.NewSecurityLog(logger, service)
+	router.Handle(
+		"/api/v0/projects/{id}/security-log",
+		server.withAuth(http.HandlerFunc(securityLogController.GetPage)),
+	).Methods(http.MethodGet)
 
 	authController := consoleapi.NewAuth(logger, service, mailService, server.cookieAuth, partners, server.analytics, config.SatelliteName, server.config.ExternalAddress, config.LetUsKnowURL, config.TermsAndConditionsURL, config.ContactInfoURL, config.GeneralRequestURL)
 	authRouter := router.PathPrefix("/api/v0/auth").Subrouter()
@@ -275,6 +326,10 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	authRouter.Handle("/account/change-email", server.withAuth(http.HandlerFunc(authController.ChangeEmail))).Methods(http.MethodPost)
 	authRouter.Handle("/account/change-password", server.withAuth(http.HandlerFunc(authController.ChangePassword))).Methods(http.MethodPost)
 	authRouter.Handle("/account/delete", server.withAuth(http.HandlerFunc(authController.DeleteAccount))).Methods(http.MethodPost)
+	onboardingController := consoleapi.NewOnboarding(logger, service)
+	authRouter.Handle("/account/onboarding", server.withAuth(http.HandlerFunc(onboardingController.GetProgress))).Methods(http.MethodGet)
+	authRouter.Handle("/account/onboarding", server.withAuth(http.HandlerFunc(onboardingController.Reset))).Methods(http.MethodDelete)
+	authRouter.Handle("/account/onboarding/{step}", server.withAuth(http.HandlerFunc(onboardingController.CompleteStep))).Methods(http.MethodPut)
 	authRouter.Handle("/mfa/enable", server.withAuth(http.HandlerFunc(authController.EnableUserMFA))).Methods(http.MethodPost)
 	authRouter.Handle("/mfa/disable", server.withAuth(http.HandlerFunc(authController.DisableUserMFA))).Methods(http.MethodPost)
 	authRouter.Handle("/mfa/generate-secret-key", server.withAuth(http.HandlerFunc(authController.GenerateMFASecretKey))).Methods(http.MethodPost)
@@ -287,6 +342,8 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	authRouter.Handle("/reset-password", server.ipRateLimiter.Limit(http.HandlerFunc(authController.ResetPassword))).Methods(http.MethodPost)
 
 	paymentController := consoleapi.NewPayments(logger, service)
+	// the webhook events are signed by the payment provider instead of a user session.
+	router.HandleFunc("/api/v0/payments/webhook", paymentController.Webhook).Methods(http.MethodPost)
 	paymentsRouter := router.PathPrefix("/api/v0/payments").Subrouter()
 	paymentsRouter.Use(server.withAuth)
 	paymentsRouter.HandleFunc("/cards", paymentController.AddCreditCard).Methods(http.MethodPost)
@@ -308,12 +365,37 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	bucketsRouter := router.PathPrefix("/api/v0/buckets").Subrouter()
 	bucketsRouter.Use(server.withAuth)
 	bucketsRouter.HandleFunc("/bucket-names", bucketsController.AllBucketNames).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/buckets/{bucket}/quota",
+		server.withAuth(http.HandlerFunc(bucketsController.GetQuota)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/buckets/{bucket}/quota",
+		server.withAuth(http.HandlerFunc(bucketsController.UpdateQuota)),
+	).Methods(http.MethodPut)
+	router.Handle(
+		"/api/v0/projects/{id}/buckets/{bucket}/lifecycle",
+		server.withAuth(http.HandlerFunc(bucketsController.GetLifecycle)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/buckets/{bucket}/lifecycle",
+		server.withAuth(http.HandlerFunc(bucketsController.UpdateLifecycle)),
+	).Methods(http.MethodPut)
 
 	apiKeysController := consoleapi.NewAPIKeys(logger, service)
 	apiKeysRouter := router.PathPrefix("/api/v0/api-keys").Subrouter()
 	apiKeysRouter.Use(server.withAuth)
 	apiKeysRouter.HandleFunc("/delete-by-name", apiKeysController.DeleteByNameAndProjectID).Methods(http.MethodDelete)
 
+	shareLinksController := consoleapi.NewShareLinks(logger, service, server.config.GatewayCredentialsRequestURL, server.config.LinksharingURL)
+	router.Handle("/api/v0/share-links", server.withAuth(server.userIDRateLimiter.Limit(http.HandlerFunc(shareLinksController.Create)))).Methods(http.MethodPost)
+
+	s3CredentialsController := consoleapi.NewS3Credentials(logger, service, server.config.GatewayCredentialsRequestURL)
+	router.Handle("/api/v0/projects/{id}/s3-credentials", server.withAuth(server.userIDRateLimiter.Limit(http.HandlerFunc(s3CredentialsController.Create)))).Methods(http.MethodPost)
+
+	maintenanceController := consoleapi.NewMaintenance(logger, server.maintenance)
+	router.HandleFunc("/api/v0/maintenance", maintenanceController.Status).Methods(http.MethodGet)
+
 	analyticsController := consoleapi.NewAnalytics(logger, service, server.analytics)
 	analyticsRouter := router.PathPrefix("/api/v0/analytics").Subrouter()
 	analyticsRouter.Use(server.withAuth)
@@ -333,7 +415,9 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 		fs := http.FileServer(http.Dir(server.config.StaticDir))
 		router.PathPrefix("/static/").Handler(server.brotliMiddleware(http.StripPrefix("/static", fs)))
 
-		router.HandleFunc("/activation/", server.accountActivationHandler)
+		router.Handle("/activation/", server.ipRateLimiter.Limit(http.HandlerFunc(server.accountActivationHandler)))
+		router.Handle("/change-email/verify/", server.ipRateLimiter.Limit(http.HandlerFunc(authController.VerifyEmailChange))).Methods(http.MethodGet)
+		router.Handle("/change-email/revert/", server.ipRateLimiter.Limit(http.HandlerFunc(authController.RevertEmailChange))).Methods(http.MethodGet)
 		router.HandleFunc("/cancel-password-recovery/", server.cancelPasswordRecoveryHandler)
 		router.HandleFunc("/usage-report", server.bucketUsageReportHandler)
 		router.PathPrefix("/").Handler(http.HandlerFunc(server.appHandler))
@@ -649,28 +733,19 @@ func (server *Server) accountActivationHandler(w http.ResponseWriter, r *http.Re
 	user, err := server.service.ActivateAccount(ctx, activationToken)
 	if err != nil {
 		if console.ErrTokenInvalid.Has(err) {
-			server.log.Debug("account activation",
-				zap.String("token", activationToken),
-				zap.Error(err),
-			)
+			server.log.Debug("account activation", zap.Error(err))
 			server.serveError(w, http.StatusBadRequest)
 			return
 		}
 
 		if console.ErrTokenExpiration.Has(err) {
-			server.log.Debug("account activation",
-				zap.String("token", activationToken),
-				zap.Error(err),
-			)
+			server.log.Debug("account activation", zap.Error(err))
 			server.serveError(w, http.StatusNotFound)
 			return
 		}
 
 		if console.ErrEmailUsed.Has(err) {
-			server.log.Debug("account activation",
-				zap.String("token", activationToken),
-				zap.Error(err),
-			)
+			server.log.Debug("account activation", zap.Error(err))
 			http.Redirect(w, r, server.config.ExternalAddress+"login?activated=false", http.StatusTemporaryRedirect)
 			return
 		}
@@ -27,6 +27,22 @@ type DB interface {
 	ResetPasswordTokens() ResetPasswordTokens
 	// WebappSessions is a getter for WebappSessions repository.
 	WebappSessions() consoleauth.WebappSessions
+	// SignupReviews is a getter for SignupReviews repository.
+	SignupReviews() SignupReviews
+	// EmailChanges is a getter for EmailChanges repository.
+	EmailChanges() EmailChanges
+	// ActivationTokens is a getter for ActivationTokens repository.
+	ActivationTokens() ActivationTokens
+	// ProjectActivities is a getter for ProjectActivities repository.
+	ProjectActivities() ProjectActivities
+	// SecurityLogs is a getter for SecurityLogs repository.
+	SecurityLogs() SecurityLogs
+	// UsageAnomalySettings is a getter for UsageAnomalySettings repository.
+	UsageAnomalySettings() UsageAnomalySettings
+	// ProjectDeletions is a getter for ProjectDeletions repository.
+	ProjectDeletions() ProjectDeletions
+	// OnboardingSteps is a getter for OnboardingSteps repository.
+	OnboardingSteps() OnboardingSteps
 
 	// WithTx is a method for executing transactions with retrying as necessary.
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx DBTx) error) error
@@ -0,0 +1,119 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// EmailChanges exposes methods to manage the pending email changes of users.
+//
+// architecture: Database
+type EmailChanges interface {
+	// Insert adds a pending email change.
+	Insert(ctx context.Context, change EmailChange) error
+	// Get returns the email change with the secret.
+	Get(ctx context.Context, secret EmailChangeSecret) (*EmailChange, error)
+	// ListByUserID returns the email changes of the user.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]EmailChange, error)
+	// Delete deletes the email change with the secret.
+	Delete(ctx context.Context, secret EmailChangeSecret) error
+	// DeleteByUserID deletes all the email changes of the user.
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
+
+// EmailChangeKind is the purpose of an email change token.
+type EmailChangeKind int
+
+const (
+	// EmailChangeVerify is the kind of the token sent to the new address to
+	// verify it before the email is changed.
+	EmailChangeVerify EmailChangeKind = 0
+	// EmailChangeRevert is the kind of the token sent to the previous address
+	// to revert the change during the cooling-off period.
+	EmailChangeRevert EmailChangeKind = 1
+)
+
+// String implements fmt.Stringer.
+func (kind EmailChangeKind) String() string {
+	switch kind {
+	case EmailChangeVerify:
+		return "verify"
+	case EmailChangeRevert:
+		return "revert"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (kind EmailChangeKind) MarshalText() ([]byte, error) {
+	return []byte(kind.String()), nil
+}
+
+// EmailChangeSecret is the secret of an email change token.
+type EmailChangeSecret [32]byte
+
+// NewEmailChangeSecret creates a new email change secret.
+func NewEmailChangeSecret() (EmailChangeSecret, error) {
+	var secret EmailChangeSecret
+
+	_, err := rand.Read(secret[:])
+	if err != nil {
+		return secret, errs.New("error creating email change secret")
+	}
+
+	return secret, nil
+}
+
+// String implements fmt.Stringer.
+func (secret EmailChangeSecret) String() string {
+	return base64.URLEncoding.EncodeToString(secret[:])
+}
+
+// EmailChangeSecretFromBase64 parses an email change secret from a base64 string.
+func EmailChangeSecretFromBase64(s string) (EmailChangeSecret, error) {
+	var secret EmailChangeSecret
+
+	b, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return secret, err
+	}
+	if len(b) != len(secret) {
+		return secret, errs.New("invalid email change secret length")
+	}
+
+	copy(secret[:], b)
+
+	return secret, nil
+}
+
+// EmailChange is a pending change of the email of a user.
+type EmailChange struct {
+	Secret    EmailChangeSecret `json:"-"`
+	UserID    uuid.UUID         `json:"userId"`
+	Kind      EmailChangeKind   `json:"kind"`
+	OldEmail  string            `json:"oldEmail"`
+	NewEmail  string            `json:"newEmail"`
+	CreatedAt time.Time         `json:"createdAt"`
+	ExpiresAt time.Time         `json:"expiresAt"`
+}
+
+// Expired returns whether the email change token can't be used anymore.
+func (change *EmailChange) Expired(now time.Time) bool {
+	return !now.Before(change.ExpiresAt)
+}
+
+// EmailChangeConfig contains configurations for changing the email of users.
+type EmailChangeConfig struct {
+	VerificationExpiration time.Duration `help:"how long the link to verify a new email address is valid" default:"24h"`
+	CoolingOffPeriod       time.Duration `help:"how long after an email change the previous address can revert it, during which the email can't be changed again" default:"168h"`
+}
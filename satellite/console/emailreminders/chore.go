@@ -14,7 +14,6 @@ import (
 	"storj.io/common/sync2"
 	"storj.io/storj/private/post"
 	"storj.io/storj/satellite/console"
-	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb/consoleapi"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 	"storj.io/storj/satellite/mailservice"
@@ -37,7 +36,8 @@ type Chore struct {
 	log  *zap.Logger
 	Loop *sync2.Cycle
 
-	tokens          *consoleauth.Service
+	activations     console.ActivationTokens
+	tokenExpiration time.Duration
 	usersDB         console.Users
 	mailService     *mailservice.Service
 	config          Config
@@ -46,14 +46,15 @@ type Chore struct {
 }
 
 // NewChore instantiates Chore.
-func NewChore(log *zap.Logger, tokens *consoleauth.Service, usersDB console.Users, mailservice *mailservice.Service, config Config, address string) *Chore {
+func NewChore(log *zap.Logger, activations console.ActivationTokens, tokenExpiration time.Duration, usersDB console.Users, mailservice *mailservice.Service, config Config, address string) *Chore {
 	if !strings.HasSuffix(address, "/") {
 		address += "/"
 	}
 	return &Chore{
 		log:             log,
 		Loop:            sync2.NewCycle(config.ChoreInterval),
-		tokens:          tokens,
+		activations:     activations,
+		tokenExpiration: tokenExpiration,
 		usersDB:         usersDB,
 		config:          config,
 		mailService:     mailservice,
@@ -70,6 +71,12 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 
 		now := time.Now()
 
+		deleted, err := chore.activations.DeleteExpired(ctx, now)
+		if err != nil {
+			chore.log.Error("error deleting expired activation tokens", zap.Error(err))
+		}
+		mon.IntVal("activation_tokens_expired_deleted").Observe(deleted)
+
 		// cutoff to avoid emailing users multiple times due to email duplicates in the DB.
 		// TODO: remove cutoff once duplicates are removed.
 		cutoff := now.Add(30 * (-24 * time.Hour))
@@ -82,12 +89,16 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 		mon.IntVal("unverified_needing_reminder").Observe(int64(len(users)))
 
 		for _, u := range users {
-			token, err := chore.tokens.CreateToken(ctx, u.ID, u.Email)
-
+			secret, activation, err := console.NewActivationToken(u.ID, now, chore.tokenExpiration)
 			if err != nil {
 				chore.log.Error("error generating activation token", zap.Error(err))
 				return nil
 			}
+			if err = chore.activations.Insert(ctx, activation); err != nil {
+				chore.log.Error("error storing activation token", zap.Error(err))
+				return nil
+			}
+			token := secret.String()
 			authController := consoleapi.NewAuth(chore.log, nil, nil, nil, nil, nil, "", chore.address, "", "", "", "")
 
 			link := authController.ActivateAccountURL + "?token=" + token
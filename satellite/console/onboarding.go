@@ -0,0 +1,93 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// OnboardingSteps exposes methods to manage the progress of users through
+// the onboarding of the satellite UI.
+//
+// architecture: Database
+type OnboardingSteps interface {
+	// GetCompleted returns when each of the completed onboarding steps of
+	// the user was completed.
+	GetCompleted(ctx context.Context, userID uuid.UUID) (map[OnboardingStep]time.Time, error)
+	// Complete records that the user completed the onboarding step. Completing
+	// an already completed step keeps its original completion time.
+	Complete(ctx context.Context, userID uuid.UUID, step OnboardingStep, completedAt time.Time) error
+	// Reset forgets all the completed onboarding steps of the user.
+	Reset(ctx context.Context, userID uuid.UUID) error
+}
+
+// OnboardingStep is a step of the guided first-run experience of the satellite UI.
+type OnboardingStep string
+
+const (
+	// OnboardingStepCreatedProject is completed when the user creates a project.
+	OnboardingStepCreatedProject OnboardingStep = "created_project"
+	// OnboardingStepCreatedAPIKey is completed when the user creates an api key.
+	OnboardingStepCreatedAPIKey OnboardingStep = "created_api_key"
+	// OnboardingStepUploadedObject is completed when the user uploads the first object.
+	OnboardingStepUploadedObject OnboardingStep = "uploaded_object"
+	// OnboardingStepInstalledCLI is completed when the user installs the uplink CLI.
+	OnboardingStepInstalledCLI OnboardingStep = "installed_cli"
+)
+
+// OnboardingStepsOrder is the order in which the onboarding steps are
+// presented to the users.
+var OnboardingStepsOrder = []OnboardingStep{
+	OnboardingStepCreatedProject,
+	OnboardingStepCreatedAPIKey,
+	OnboardingStepInstalledCLI,
+	OnboardingStepUploadedObject,
+}
+
+// Valid returns true when the onboarding step is known.
+func (step OnboardingStep) Valid() bool {
+	switch step {
+	case OnboardingStepCreatedProject, OnboardingStepCreatedAPIKey, OnboardingStepUploadedObject, OnboardingStepInstalledCLI:
+		return true
+	default:
+		return false
+	}
+}
+
+// OnboardingStepState is the state of an onboarding step of a user.
+type OnboardingStepState struct {
+	Step OnboardingStep `json:"step"`
+	// CompletedAt is nil when the user hasn't completed the step yet.
+	CompletedAt *time.Time `json:"completedAt"`
+}
+
+// OnboardingProgress is the progress of a user through the onboarding.
+type OnboardingProgress struct {
+	// Steps contains all the onboarding steps in OnboardingStepsOrder.
+	Steps []OnboardingStepState `json:"steps"`
+	// Completed is true when the user has completed all the steps.
+	Completed bool `json:"completed"`
+}
+
+// NewOnboardingProgress returns the onboarding progress given the completed steps.
+func NewOnboardingProgress(completed map[OnboardingStep]time.Time) OnboardingProgress {
+	progress := OnboardingProgress{
+		Steps:     make([]OnboardingStepState, 0, len(OnboardingStepsOrder)),
+		Completed: true,
+	}
+	for _, step := range OnboardingStepsOrder {
+		state := OnboardingStepState{Step: step}
+		if completedAt, ok := completed[step]; ok {
+			completedAt := completedAt
+			state.CompletedAt = &completedAt
+		} else {
+			progress.Completed = false
+		}
+		progress.Steps = append(progress.Steps, state)
+	}
+	return progress
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+)
+
+func TestNewOnboardingProgress(t *testing.T) {
+	progress := console.NewOnboardingProgress(nil)
+	require.False(t, progress.Completed)
+	require.Len(t, progress.Steps, len(console.OnboardingStepsOrder))
+	for i, state := range progress.Steps {
+		require.Equal(t, console.OnboardingStepsOrder[i], state.Step)
+		require.Nil(t, state.CompletedAt)
+	}
+}
+
+func TestOnboarding(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Onboarding User",
+			Email:    "onboarding@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		userCtx, err := sat.UserContext(ctx, user.ID)
+		require.NoError(t, err)
+
+		completedSteps := func(progress *console.OnboardingProgress) []console.OnboardingStep {
+			var steps []console.OnboardingStep
+			for _, state := range progress.Steps {
+				if state.CompletedAt != nil {
+					steps = append(steps, state.Step)
+				}
+			}
+			return steps
+		}
+
+		progress, err := service.GetOnboardingProgress(userCtx)
+		require.NoError(t, err)
+		require.False(t, progress.Completed)
+		require.Empty(t, completedSteps(progress))
+
+		// the steps made through the satellite UI are completed automatically.
+		project, err := service.CreateProject(userCtx, console.ProjectInfo{Name: "onboarding"})
+		require.NoError(t, err)
+		_, _, err = service.CreateAPIKey(userCtx, project.ID, "onboarding key")
+		require.NoError(t, err)
+
+		progress, err = service.GetOnboardingProgress(userCtx)
+		require.NoError(t, err)
+		require.Equal(t, []console.OnboardingStep{
+			console.OnboardingStepCreatedProject,
+			console.OnboardingStepCreatedAPIKey,
+		}, completedSteps(progress))
+
+		_, err = service.CompleteOnboardingStep(userCtx, "unknown")
+		require.True(t, console.ErrValidation.Has(err))
+
+		progress, err = service.CompleteOnboardingStep(userCtx, console.OnboardingStepInstalledCLI)
+		require.NoError(t, err)
+		require.False(t, progress.Completed)
+		installedAt := *progress.Steps[2].CompletedAt
+
+		progress, err = service.CompleteOnboardingStep(userCtx, console.OnboardingStepUploadedObject)
+		require.NoError(t, err)
+		require.True(t, progress.Completed)
+
+		// completing a step again keeps its original completion time.
+		progress, err = service.CompleteOnboardingStep(userCtx, console.OnboardingStepInstalledCLI)
+		require.NoError(t, err)
+		require.True(t, installedAt.Equal(*progress.Steps[2].CompletedAt))
+
+		require.NoError(t, service.ResetOnboarding(userCtx))
+
+		progress, err = service.GetOnboardingProgress(userCtx)
+		require.NoError(t, err)
+		require.False(t, progress.Completed)
+		require.Empty(t, completedSteps(progress))
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// ProjectActivities exposes methods to manage the activity feed of projects.
+//
+// architecture: Database
+type ProjectActivities interface {
+	// Insert records a project activity.
+	Insert(ctx context.Context, activity *ProjectActivity) (*ProjectActivity, error)
+	// GetPagedByProjectID returns the activities of the project, most recent first.
+	GetPagedByProjectID(ctx context.Context, projectID uuid.UUID, cursor ProjectActivityCursor) (*ProjectActivityPage, error)
+}
+
+// ProjectActivityKind is the kind of change made to a project.
+type ProjectActivityKind string
+
+const (
+	// ProjectActivityBucketCreated is recorded when a bucket is created.
+	ProjectActivityBucketCreated ProjectActivityKind = "bucket_created"
+	// ProjectActivityAPIKeyCreated is recorded when an api key is created.
+	ProjectActivityAPIKeyCreated ProjectActivityKind = "api_key_created"
+	// ProjectActivityAPIKeyRevoked is recorded when an api key is deleted.
+	ProjectActivityAPIKeyRevoked ProjectActivityKind = "api_key_revoked"
+	// ProjectActivityMemberAdded is recorded when a member is added to the project.
+	ProjectActivityMemberAdded ProjectActivityKind = "member_added"
+	// ProjectActivityMemberRemoved is recorded when a member is removed from the project.
+	ProjectActivityMemberRemoved ProjectActivityKind = "member_removed"
+	// ProjectActivityLimitsChanged is recorded when the storage or bandwidth
+	// limits of the project are changed.
+	ProjectActivityLimitsChanged ProjectActivityKind = "limits_changed"
+	// ProjectActivityBucketQuotaChanged is recorded when the quota of a bucket is changed.
+	ProjectActivityBucketQuotaChanged ProjectActivityKind = "bucket_quota_changed"
+	// ProjectActivityBucketLifecycleChanged is recorded when the lifecycle rules of a bucket are changed.
+	ProjectActivityBucketLifecycleChanged ProjectActivityKind = "bucket_lifecycle_changed"
+	// ProjectActivityProjectDeleted is recorded when the project is deleted.
+	ProjectActivityProjectDeleted ProjectActivityKind = "project_deleted"
+	// ProjectActivityProjectRestored is recorded when the deleted project is restored.
+	ProjectActivityProjectRestored ProjectActivityKind = "project_restored"
+)
+
+// ProjectActivity is an event which changed a project.
+type ProjectActivity struct {
+	ID        uuid.UUID           `json:"id"`
+	ProjectID uuid.UUID           `json:"projectId"`
+	Kind      ProjectActivityKind `json:"kind"`
+	// Details describes the subject of the change, e.g. the bucket name.
+	Details string `json:"details"`
+
+	// ActorID is the user who made the change. It's nil when the change
+	// wasn't made through the console, e.g. a bucket created by an uplink.
+	ActorID *uuid.UUID `json:"actorId"`
+	// ActorEmail is the email of the actor when it's known.
+	ActorEmail string `json:"actorEmail"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ProjectActivityCursor holds info for project activities cursor pagination.
+type ProjectActivityCursor struct {
+	Limit uint
+	Page  uint
+}
+
+// ProjectActivityPage represents a page of project activities.
+type ProjectActivityPage struct {
+	Activities []ProjectActivity `json:"activities"`
+
+	Limit  uint   `json:"limit"`
+	Offset uint64 `json:"offset"`
+
+	PageCount   uint   `json:"pageCount"`
+	CurrentPage uint   `json:"currentPage"`
+	TotalCount  uint64 `json:"totalCount"`
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+)
+
+func TestProjectActivities(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		project := planet.Uplinks[0].Projects[0]
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "testbucket"))
+
+		owner, err := sat.API.DB.Console().Users().Get(ctx, project.Owner.ID)
+		require.NoError(t, err)
+		member, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Project Member",
+			Email:    "member@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		userCtx, err := sat.UserContext(ctx, owner.ID)
+		require.NoError(t, err)
+
+		_, _, err = service.CreateAPIKey(userCtx, project.ID, "testkey")
+		require.NoError(t, err)
+		_, err = service.AddProjectMembers(userCtx, project.ID, []string{member.Email})
+		require.NoError(t, err)
+		require.NoError(t, service.DeleteAPIKeyByNameAndProjectID(userCtx, "testkey", project.ID))
+
+		page, err := service.GetProjectActivities(userCtx, project.ID, console.ProjectActivityCursor{Limit: 3, Page: 1})
+		require.NoError(t, err)
+		require.EqualValues(t, 4, page.TotalCount)
+		require.EqualValues(t, 2, page.PageCount)
+		require.Len(t, page.Activities, 3)
+
+		next, err := service.GetProjectActivities(userCtx, project.ID, console.ProjectActivityCursor{Limit: 3, Page: 2})
+		require.NoError(t, err)
+		require.Len(t, next.Activities, 1)
+
+		activities := map[console.ProjectActivityKind]console.ProjectActivity{}
+		for _, activity := range append(page.Activities, next.Activities...) {
+			activities[activity.Kind] = activity
+		}
+
+		bucketCreated := activities[console.ProjectActivityBucketCreated]
+		require.Equal(t, "testbucket", bucketCreated.Details)
+		require.Nil(t, bucketCreated.ActorID)
+
+		keyCreated := activities[console.ProjectActivityAPIKeyCreated]
+		require.Equal(t, "testkey", keyCreated.Details)
+		require.NotNil(t, keyCreated.ActorID)
+		require.Equal(t, owner.ID, *keyCreated.ActorID)
+		require.Equal(t, owner.Email, keyCreated.ActorEmail)
+
+		require.Equal(t, "testkey", activities[console.ProjectActivityAPIKeyRevoked].Details)
+		require.Equal(t, member.Email, activities[console.ProjectActivityMemberAdded].Details)
+
+		// only members of the project can see its activities.
+		memberCtx, err := sat.UserContext(ctx, member.ID)
+		require.NoError(t, err)
+		_, err = service.GetProjectActivities(memberCtx, project.ID, console.ProjectActivityCursor{})
+		require.NoError(t, err)
+
+		outsider, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Outsider",
+			Email:    "outsider@mail.test",
+		}, 1)
+		require.NoError(t, err)
+		outsiderCtx, err := sat.UserContext(ctx, outsider.ID)
+		require.NoError(t, err)
+		_, err = service.GetProjectActivities(outsiderCtx, project.ID, console.ProjectActivityCursor{})
+		require.True(t, console.ErrNoMembership.Has(err))
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// ProjectDeletions exposes methods to manage the deletions of projects. A
+// deleted project can be restored until its data is purged.
+//
+// architecture: Database
+type ProjectDeletions interface {
+	// Insert marks the project as deleted.
+	Insert(ctx context.Context, deletion ProjectDeletion) error
+	// Get returns the deletion of the project, nil when the project isn't deleted.
+	Get(ctx context.Context, projectID uuid.UUID) (*ProjectDeletion, error)
+	// Delete removes the deletion of the project, which restores the project.
+	Delete(ctx context.Context, projectID uuid.UUID) error
+	// ListPurgeable returns up to limit deletions, whose grace period ended
+	// before now, ordered by the project ID after cursor.
+	ListPurgeable(ctx context.Context, now time.Time, cursor uuid.UUID, limit int) ([]ProjectDeletion, error)
+}
+
+// ProjectDeletion is the deletion of a project. The uploads and downloads of a
+// deleted project are rejected, and its data is purged after PurgeAfter,
+// unless it's restored before.
+type ProjectDeletion struct {
+	ProjectID uuid.UUID `json:"projectId"`
+	// DeletedBy is the user, who deleted the project. It's zero when the
+	// project was deleted by an admin.
+	DeletedBy uuid.UUID `json:"deletedBy"`
+	DeletedAt time.Time `json:"deletedAt"`
+	// PurgeAfter is the end of the grace period, after which the project
+	// can't be restored anymore.
+	PurgeAfter time.Time `json:"purgeAfter"`
+}
+
+// Restorable returns whether the project can still be restored at the given time.
+func (deletion ProjectDeletion) Restorable(now time.Time) bool {
+	return now.Before(deletion.PurgeAfter)
+}
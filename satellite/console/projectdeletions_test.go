@@ -0,0 +1,74 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestProjectDeletions(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		deletions := db.Console().ProjectDeletions()
+		now := time.Now().UTC().Truncate(time.Second)
+
+		deletion, err := deletions.Get(ctx, testrand.UUID())
+		require.NoError(t, err)
+		require.Nil(t, deletion)
+
+		byUser := console.ProjectDeletion{
+			ProjectID:  testrand.UUID(),
+			DeletedBy:  testrand.UUID(),
+			DeletedAt:  now.Add(-2 * time.Hour),
+			PurgeAfter: now.Add(-time.Hour),
+		}
+		byAdmin := console.ProjectDeletion{
+			ProjectID:  testrand.UUID(),
+			DeletedAt:  now,
+			PurgeAfter: now.Add(time.Hour),
+		}
+		require.NoError(t, deletions.Insert(ctx, byUser))
+		require.NoError(t, deletions.Insert(ctx, byAdmin))
+
+		// a project can't be deleted twice.
+		require.Error(t, deletions.Insert(ctx, byUser))
+
+		for _, expected := range []console.ProjectDeletion{byUser, byAdmin} {
+			deletion, err := deletions.Get(ctx, expected.ProjectID)
+			require.NoError(t, err)
+			require.NotNil(t, deletion)
+			require.Equal(t, expected.DeletedBy, deletion.DeletedBy)
+			require.WithinDuration(t, expected.DeletedAt, deletion.DeletedAt, time.Second)
+			require.WithinDuration(t, expected.PurgeAfter, deletion.PurgeAfter, time.Second)
+		}
+
+		purgeable, err := deletions.ListPurgeable(ctx, now, uuid.UUID{}, 10)
+		require.NoError(t, err)
+		require.Len(t, purgeable, 1)
+		require.Equal(t, byUser.ProjectID, purgeable[0].ProjectID)
+		require.False(t, purgeable[0].Restorable(now))
+
+		purgeable, err = deletions.ListPurgeable(ctx, now.Add(2*time.Hour), uuid.UUID{}, 10)
+		require.NoError(t, err)
+		require.Len(t, purgeable, 2)
+
+		purgeable, err = deletions.ListPurgeable(ctx, now.Add(2*time.Hour), purgeable[0].ProjectID, 10)
+		require.NoError(t, err)
+		require.Len(t, purgeable, 1)
+
+		require.NoError(t, deletions.Delete(ctx, byUser.ProjectID))
+		deletion, err = deletions.Get(ctx, byUser.ProjectID)
+		require.NoError(t, err)
+		require.Nil(t, deletion)
+	})
+}
@@ -0,0 +1,233 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectpurge
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/payments"
+)
+
+var (
+	// Error is the standard error class for project purges.
+	Error = errs.Class("project purge")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the project purge chore.
+type Config struct {
+	Enabled   bool          `help:"whether the data of the deleted projects is purged after their grace period" default:"true"`
+	Interval  time.Duration `help:"how often the deleted projects are checked for ended grace periods" default:"1h"`
+	ListLimit int           `help:"how many deleted projects are purged in a batch" default:"100"`
+}
+
+// Chore purges the data of the deleted projects, whose grace period ended.
+// The objects, buckets and API keys of a project are deleted first, and the
+// project itself once all its usage is invoiced. The pieces of the deleted
+// objects are left to garbage collection.
+//
+// architecture: Chore
+type Chore struct {
+	log  *zap.Logger
+	Loop *sync2.Cycle
+
+	deletions console.ProjectDeletions
+	projects  console.Projects
+	users     console.Users
+	apiKeys   console.APIKeys
+	buckets   buckets.DB
+	metabase  *metabase.DB
+	accounts  payments.Accounts
+	config    Config
+
+	nowFn func() time.Time
+}
+
+// NewChore instantiates Chore.
+func NewChore(log *zap.Logger, consoleDB console.DB, buckets buckets.DB, metabase *metabase.DB, accounts payments.Accounts, config Config) *Chore {
+	return &Chore{
+		log:       log,
+		Loop:      sync2.NewCycle(config.Interval),
+		deletions: consoleDB.ProjectDeletions(),
+		projects:  consoleDB.Projects(),
+		users:     consoleDB.Users(),
+		apiKeys:   consoleDB.APIKeys(),
+		buckets:   buckets,
+		metabase:  metabase,
+		accounts:  accounts,
+		config:    config,
+		nowFn:     time.Now,
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.PurgeProjects(ctx); err != nil {
+			chore.log.Error("error purging deleted projects", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// PurgeProjects purges the deleted projects, whose grace period ended. A
+// project, which can't be removed yet, is retried in the next cycle.
+func (chore *Chore) PurgeProjects(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn()
+
+	var cursor uuid.UUID
+	for {
+		deletions, err := chore.deletions.ListPurgeable(ctx, now, cursor, chore.config.ListLimit)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, deletion := range deletions {
+			if err := chore.purgeProject(ctx, deletion.ProjectID); err != nil {
+				chore.log.Error("error purging deleted project",
+					zap.Stringer("Project ID", deletion.ProjectID),
+					zap.Error(err))
+			}
+		}
+
+		if len(deletions) < chore.config.ListLimit {
+			return nil
+		}
+		cursor = deletions[len(deletions)-1].ProjectID
+	}
+}
+
+// purgeProject deletes the objects, buckets and API keys of the project, and
+// the project itself when all its usage is invoiced.
+func (chore *Chore) purgeProject(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := chore.projects.Get(ctx, projectID)
+	if errors.Is(err, sql.ErrNoRows) {
+		// the project was removed without the chore, e.g. by an admin.
+		return Error.Wrap(chore.deletions.Delete(ctx, projectID))
+	}
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err := chore.deleteBuckets(ctx, projectID); err != nil {
+		return err
+	}
+
+	if err := chore.deleteAPIKeys(ctx, projectID); err != nil {
+		return err
+	}
+
+	// the usage of the purged data is still invoiced to the owner, so the
+	// project is kept until then.
+	paidTier, err := chore.users.GetUserPaidTier(ctx, project.OwnerID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if paidTier {
+		if err := chore.accounts.CheckProjectUsageStatus(ctx, projectID); err != nil {
+			chore.log.Debug("project usage isn't invoiced yet", zap.Stringer("Project ID", projectID), zap.Error(err))
+			return nil
+		}
+	}
+	if err := chore.accounts.CheckProjectInvoicingStatus(ctx, projectID); err != nil {
+		chore.log.Debug("project usage isn't invoiced yet", zap.Stringer("Project ID", projectID), zap.Error(err))
+		return nil
+	}
+
+	if err := chore.projects.Delete(ctx, projectID); err != nil {
+		return Error.Wrap(err)
+	}
+
+	chore.log.Info("deleted project purged", zap.Stringer("Project ID", projectID))
+
+	return Error.Wrap(chore.deletions.Delete(ctx, projectID))
+}
+
+// deleteBuckets deletes the objects and the buckets of the project.
+func (chore *Chore) deleteBuckets(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		list, err := chore.buckets.ListBuckets(ctx, projectID, storj.BucketListOptions{
+			Limit:     chore.config.ListLimit,
+			Direction: storj.Forward,
+		}, macaroon.AllowedBuckets{All: true})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, bucket := range list.Items {
+			_, err := chore.metabase.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+				Bucket: metabase.BucketLocation{ProjectID: projectID, BucketName: bucket.Name},
+			})
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			err = chore.buckets.DeleteBucket(ctx, []byte(bucket.Name), projectID)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+		}
+
+		if !list.More {
+			return nil
+		}
+	}
+}
+
+// deleteAPIKeys deletes the API keys of the project.
+func (chore *Chore) deleteAPIKeys(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		page, err := chore.apiKeys.GetPagedByProjectID(ctx, projectID, console.APIKeyCursor{
+			Limit: uint(chore.config.ListLimit),
+			Page:  1,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(page.APIKeys) == 0 {
+			return nil
+		}
+
+		for _, key := range page.APIKeys {
+			if err := chore.apiKeys.Delete(ctx, key.ID); err != nil {
+				return Error.Wrap(err)
+			}
+		}
+	}
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestSetNow allows tests to have the chore act as if the current time is t.
+func (chore *Chore) TestSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
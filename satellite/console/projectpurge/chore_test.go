@@ -0,0 +1,82 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectpurge_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+)
+
+func TestChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.ProjectPurge.Enabled = true
+				// the deletion is enforced without waiting for the cached project limits.
+				config.ProjectLimit.CacheExpiration = time.Nanosecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		chore := sat.Core.ProjectPurge.Chore
+		chore.Loop.Pause()
+
+		projectID := upl.Projects[0].ID
+		service := sat.API.Console.Service
+
+		// the inline object doesn't need storage nodes.
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "testobject", testrand.Bytes(memory.KiB)))
+
+		userCtx, err := sat.UserContext(ctx, upl.Projects[0].Owner.ID)
+		require.NoError(t, err)
+		require.NoError(t, service.DeleteProject(userCtx, projectID))
+
+		// the uploads and downloads of the deleted project are rejected.
+		err = upl.Upload(ctx, sat, "testbucket", "other", testrand.Bytes(memory.KiB))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Project Deleted")
+		_, err = upl.Download(ctx, sat, "testbucket", "testobject")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Project Deleted")
+
+		// the project isn't purged during the grace period.
+		require.NoError(t, chore.PurgeProjects(ctx))
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		chore.TestSetNow(func() time.Time {
+			return time.Now().Add(sat.Config.Console.ProjectDeletionGracePeriod + time.Hour)
+		})
+		require.NoError(t, chore.PurgeProjects(ctx))
+
+		objects, err = sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Empty(t, objects)
+
+		count, err := sat.DB.Buckets().CountBuckets(ctx, projectID)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		_, err = sat.DB.Console().Projects().Get(ctx, projectID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		deletion, err := sat.DB.Console().ProjectDeletions().Get(ctx, projectID)
+		require.NoError(t, err)
+		require.Nil(t, deletion)
+	})
+}
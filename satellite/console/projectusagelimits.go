@@ -3,7 +3,10 @@
 
 package console
 
-import "storj.io/common/memory"
+import (
+	"storj.io/common/memory"
+	"storj.io/storj/satellite/payments"
+)
 
 // ProjectUsageLimits holds project usage limits and current usage.
 type ProjectUsageLimits struct {
@@ -21,3 +24,10 @@ type UserProjectLimits struct {
 	StorageLimit   memory.Size `json:"storageUsed"`
 	SegmentLimit   int64       `json:"segmentLimit"`
 }
+
+// ProjectUsageForecast holds the usage and charges of a project for the current
+// month so far, and the forecast of the usage and charges at the end of the month.
+type ProjectUsageForecast struct {
+	Current   payments.ProjectCharge `json:"current"`
+	Projected payments.ProjectCharge `json:"projected"`
+}
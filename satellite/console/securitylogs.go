@@ -0,0 +1,79 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// SecurityLogs exposes methods to manage the security logs of projects.
+//
+// architecture: Database
+type SecurityLogs interface {
+	// Insert records a security log event.
+	Insert(ctx context.Context, event *SecurityLogEvent) error
+	// GetPagedByProjectID returns the security log events of the project, most recent first.
+	GetPagedByProjectID(ctx context.Context, projectID uuid.UUID, cursor SecurityLogCursor) (*SecurityLogPage, error)
+	// DeleteBefore deletes the security log events created before the given time.
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// SecurityLogOperation is the metainfo operation recorded in the security log.
+type SecurityLogOperation string
+
+const (
+	// SecurityLogCreateBucket is recorded when a bucket is created.
+	SecurityLogCreateBucket SecurityLogOperation = "create_bucket"
+	// SecurityLogDeleteBucket is recorded when a bucket is deleted.
+	SecurityLogDeleteBucket SecurityLogOperation = "delete_bucket"
+	// SecurityLogListBuckets is recorded when the buckets are listed.
+	SecurityLogListBuckets SecurityLogOperation = "list_buckets"
+	// SecurityLogUploadObject is recorded when an object upload is started.
+	SecurityLogUploadObject SecurityLogOperation = "upload_object"
+	// SecurityLogGetObject is recorded when the metadata of an object is read.
+	SecurityLogGetObject SecurityLogOperation = "get_object"
+	// SecurityLogDownloadObject is recorded when an object is downloaded.
+	SecurityLogDownloadObject SecurityLogOperation = "download_object"
+	// SecurityLogDeleteObject is recorded when an object is deleted.
+	SecurityLogDeleteObject SecurityLogOperation = "delete_object"
+	// SecurityLogListObjects is recorded when the objects of a bucket are listed.
+	SecurityLogListObjects SecurityLogOperation = "list_objects"
+)
+
+// SecurityLogEvent is a metainfo operation made with an api key of a project.
+type SecurityLogEvent struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"projectId"`
+
+	APIKeyID   uuid.UUID `json:"apiKeyId"`
+	APIKeyName string    `json:"apiKeyName"`
+	// RemoteAddress is the address of the client which made the request.
+	RemoteAddress string `json:"remoteAddress"`
+
+	Operation  SecurityLogOperation `json:"operation"`
+	BucketName string               `json:"bucketName"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// SecurityLogCursor holds info for security log cursor pagination.
+type SecurityLogCursor struct {
+	Limit uint
+	Page  uint
+}
+
+// SecurityLogPage represents a page of security log events.
+type SecurityLogPage struct {
+	Events []SecurityLogEvent `json:"events"`
+
+	Limit  uint   `json:"limit"`
+	Offset uint64 `json:"offset"`
+
+	PageCount   uint   `json:"pageCount"`
+	CurrentPage uint   `json:"currentPage"`
+	TotalCount  uint64 `json:"totalCount"`
+}
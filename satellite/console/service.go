@@ -5,12 +5,14 @@ package console
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math"
 	"math/big"
 	"net/http"
 	"net/mail"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -20,6 +22,7 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 
+	"storj.io/common/grant"
 	"storj.io/common/macaroon"
 	"storj.io/common/memory"
 	"storj.io/common/storj"
@@ -29,7 +32,9 @@ import (
 	"storj.io/storj/private/blockchain"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/monetary"
 	"storj.io/storj/satellite/rewards"
@@ -41,6 +46,9 @@ const (
 	// maxLimit specifies the limit for all paged queries.
 	maxLimit = 50
 
+	// maxHourlyUsagePeriod specifies how far back hourly usage can be requested.
+	maxHourlyUsagePeriod = 72 * time.Hour
+
 	// TestPasswordCost is the hashing complexity to use for testing.
 	TestPasswordCost = bcrypt.MinCost
 )
@@ -60,9 +68,22 @@ const (
 	apiKeyWithNameDoesntExistErrMsg      = "An API Key with this name doesn't exist in this project."
 	teamMemberDoesNotExistErrMsg         = `There is no account on this Satellite for the user(s) you have entered.
 									     Please add team members with active accounts`
-	activationTokenExpiredErrMsg = "This activation token has expired, please request another one"
-	usedRegTokenErrMsg           = "This registration token has already been used"
-	projLimitErrMsg              = "Sorry, project creation is limited for your account. Please contact support!"
+	activationTokenExpiredErrMsg  = "This activation token has expired, please request another one"
+	usedRegTokenErrMsg            = "This registration token has already been used"
+	projLimitErrMsg               = "Sorry, project creation is limited for your account. Please contact support!"
+	projLimitUnderReviewErrMsg    = "Your account is being reviewed. You will be able to create projects once the review is complete."
+	shareExpirationErrMsg         = "The expiration of the shared link must be in the future and within %s"
+	s3PermissionsErrMsg           = "At least one permission must be granted to the S3 credentials"
+	s3ExpirationErrMsg            = "The expiration of the S3 credentials must be in the future"
+	bucketQuotaErrMsg             = "The quota of the bucket can't be negative"
+	usageAnomalySensitivityErrMsg = "The sensitivity must be one of off, low, medium or high"
+	onboardingStepErrMsg          = "The onboarding step is unknown"
+	emailChangeCoolingOffErrMsg   = "Your email was changed recently, please try again later"
+	emailChangeTokenExpiredErrMsg = "This email change link has expired, please request another one"
+	projectDeletedErrMsg          = "The project is already deleted"
+	projectNotDeletedErrMsg       = "The project isn't deleted"
+	projectNotRestorableErrMsg    = "The project can't be restored anymore, its data is being purged"
+	activationThrottledErrMsg     = "An activation email was sent recently, please try again later"
 )
 
 var (
@@ -113,6 +134,9 @@ var (
 
 	// ErrRecoveryToken describes account recovery token errors.
 	ErrRecoveryToken = errs.Class("recovery token")
+
+	// ErrActivationThrottled occurs when activation emails are requested too often.
+	ErrActivationThrottled = errs.Class("activation throttled")
 )
 
 // Service is handling accounts related logic.
@@ -129,10 +153,13 @@ type Service struct {
 	accounts          payments.Accounts
 	depositWallets    payments.DepositWallets
 	captchaHandler    CaptchaHandler
+	signupThrottle    *signupThrottle
 	analytics         *analytics.Service
 	tokens            *consoleauth.Service
 
 	config Config
+
+	nowFn func() time.Time
 }
 
 func init() {
@@ -156,9 +183,14 @@ type Config struct {
 	LoginAttemptsWithoutPenalty int           `help:"number of times user can try to login without penalty" default:"3"`
 	FailedLoginPenalty          float64       `help:"incremental duration of penalty for failed login attempts in minutes" default:"2.0"`
 	SessionDuration             time.Duration `help:"duration a session is valid for" default:"168h"`
+	ShareLinkMaxDuration        time.Duration `help:"maximum duration for which the shared links created from the console are valid" default:"720h"`
+	ProjectDeletionGracePeriod  time.Duration `help:"duration during which a deleted project can be restored before its data is purged" default:"720h"`
 	UsageLimits                 UsageLimitsConfig
 	Recaptcha                   RecaptchaConfig
 	Hcaptcha                    HcaptchaConfig
+	SignupThrottle              SignupThrottleConfig
+	EmailChange                 EmailChangeConfig
+	Activation                  ActivationConfig
 }
 
 // RecaptchaConfig contains configurations for the reCAPTCHA system.
@@ -211,12 +243,19 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 		accounts:          accounts,
 		depositWallets:    depositWallets,
 		captchaHandler:    captchaHandler,
+		signupThrottle:    newSignupThrottle(config.SignupThrottle),
 		analytics:         analytics,
 		tokens:            tokens,
 		config:            config,
+		nowFn:             time.Now,
 	}, nil
 }
 
+// SetNow allows tests to have the Service act as if the current time is whatever they want.
+func (s *Service) SetNow(nowFn func() time.Time) {
+	s.nowFn = nowFn
+}
+
 func getRequestingIP(ctx context.Context) (source, forwardedFor string) {
 	if req := GetRequest(ctx); req != nil {
 		return req.RemoteAddr, req.Header.Get("X-Forwarded-For")
@@ -391,6 +430,14 @@ func (payment Payments) RemoveCreditCard(ctx context.Context, cardID string) (er
 	return payment.service.accounts.CreditCards().Remove(ctx, user.ID, cardID)
 }
 
+// HandleWebhookEvent handles a signed webhook event of the payment provider.
+// The event isn't sent by a user, so it's handled without authorization.
+func (payment Payments) HandleWebhookEvent(ctx context.Context, payload []byte, signature string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(payment.service.accounts.Invoices().HandleWebhookEvent(ctx, payload, signature))
+}
+
 // BillingHistory returns a list of billing history items for payment account.
 func (payment Payments) BillingHistory(ctx context.Context) (billingHistory []*BillingHistoryItem, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -543,31 +590,6 @@ func (payment Payments) checkOutstandingInvoice(ctx context.Context) (err error)
 	return nil
 }
 
-// checkProjectInvoicingStatus returns error if for the given project there are outstanding project records and/or usage
-// which have not been applied/invoiced yet (meaning sent over to stripe).
-func (payment Payments) checkProjectInvoicingStatus(ctx context.Context, projectID uuid.UUID) (err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	_, err = payment.service.getUserAndAuditLog(ctx, "project invoicing status")
-	if err != nil {
-		return Error.Wrap(err)
-	}
-
-	return payment.service.accounts.CheckProjectInvoicingStatus(ctx, projectID)
-}
-
-// checkProjectUsageStatus returns error if for the given project there is some usage for current or previous month.
-func (payment Payments) checkProjectUsageStatus(ctx context.Context, projectID uuid.UUID) (err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	_, err = payment.service.getUserAndAuditLog(ctx, "project usage status")
-	if err != nil {
-		return Error.Wrap(err)
-	}
-
-	return payment.service.accounts.CheckProjectUsageStatus(ctx, projectID)
-}
-
 // ApplyCouponCode applies a coupon code to a Stripe customer
 // and returns the coupon corresponding to the code.
 func (payment Payments) ApplyCouponCode(ctx context.Context, couponCode string) (coupon *payments.Coupon, err error) {
@@ -671,6 +693,13 @@ func (s *Service) CreateUser(ctx context.Context, user CreateUser, tokenSecret R
 		return nil, Error.Wrap(err)
 	}
 
+	// signups with a registration token were vetted by whoever issued the
+	// token, so they aren't throttled.
+	var flagged []string
+	if s.config.SignupThrottle.Enabled && registrationToken == nil {
+		flagged = s.signupThrottle.Check(user.IP, user.Email, s.nowFn())
+	}
+
 	// store data
 	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
 		userID, err := uuid.New()
@@ -697,9 +726,13 @@ func (s *Service) CreateUser(ctx context.Context, user CreateUser, tokenSecret R
 			newUser.UserAgent = user.UserAgent
 		}
 
-		if registrationToken != nil {
+		switch {
+		case registrationToken != nil:
 			newUser.ProjectLimit = registrationToken.ProjectLimit
-		} else {
+		case len(flagged) > 0:
+			// flagged users can't create projects until their signup is approved.
+			newUser.ProjectLimit = 0
+		default:
 			newUser.ProjectLimit = s.config.UsageLimits.Project.Free
 		}
 
@@ -722,6 +755,17 @@ func (s *Service) CreateUser(ctx context.Context, user CreateUser, tokenSecret R
 			}
 		}
 
+		if len(flagged) > 0 {
+			err = tx.SignupReviews().Insert(ctx, SignupReview{
+				UserID:    u.ID,
+				Reason:    strings.Join(flagged, "; "),
+				CreatedAt: s.nowFn(),
+			})
+			if err != nil {
+				return Error.Wrap(err)
+			}
+		}
+
 		return nil
 	})
 
@@ -732,6 +776,11 @@ func (s *Service) CreateUser(ctx context.Context, user CreateUser, tokenSecret R
 	s.auditLog(ctx, "create user", nil, user.Email)
 	mon.Counter("create_user_success").Inc(1) //mon:locked
 
+	if len(flagged) > 0 {
+		mon.Counter("create_user_flagged").Inc(1)
+		s.log.Info("signup flagged for review", zap.Stringer("userID", u.ID), zap.Strings("reasons", flagged))
+	}
+
 	return u, nil
 }
 
@@ -741,11 +790,44 @@ func (s *Service) TestSwapCaptchaHandler(h CaptchaHandler) {
 	s.captchaHandler = h
 }
 
-// GenerateActivationToken - is a method for generating activation token.
+// GenerateActivationToken creates a single-use activation token for the user.
+// Only the hash of the returned token is stored.
 func (s *Service) GenerateActivationToken(ctx context.Context, id uuid.UUID, email string) (token string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return s.tokens.CreateToken(ctx, id, email)
+	secret, activation, err := NewActivationToken(id, s.nowFn(), s.config.Activation.TokenExpiration)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	err = s.store.ActivationTokens().Insert(ctx, activation)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	s.auditLog(ctx, "generate activation token", &id, email)
+
+	return secret.String(), nil
+}
+
+// ResendActivationToken creates another activation token for the user, unless
+// one was created within the resend cooldown.
+func (s *Service) ResendActivationToken(ctx context.Context, id uuid.UUID, email string) (token string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	activations, err := s.store.ActivationTokens().ListByUserID(ctx, id)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	if len(activations) > 0 {
+		latest := activations[len(activations)-1]
+		if s.nowFn().Before(latest.CreatedAt.Add(s.config.Activation.ResendCooldown)) {
+			mon.Counter("activation_resend_throttled").Inc(1)
+			return "", ErrActivationThrottled.New(activationThrottledErrMsg)
+		}
+	}
+
+	return s.GenerateActivationToken(ctx, id, email)
 }
 
 // GeneratePasswordRecoveryToken - is a method for generating password recovery token.
@@ -801,45 +883,45 @@ func (s *Service) GenerateSessionToken(ctx context.Context, userID uuid.UUID, em
 func (s *Service) ActivateAccount(ctx context.Context, activationToken string) (user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	parsedActivationToken, err := consoleauth.FromBase64URLString(activationToken)
+	userID, used, err := s.activationTokenUserID(ctx, activationToken)
 	if err != nil {
-		return nil, ErrTokenInvalid.Wrap(err)
+		return nil, err
 	}
 
-	valid, err := s.tokens.ValidateToken(parsedActivationToken)
+	user, err = s.store.Users().Get(ctx, userID)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
-	if !valid {
-		return nil, ErrTokenInvalid.New("incorrect signature")
-	}
-
-	claims, err := consoleauth.FromJSON(parsedActivationToken.Payload)
-	if err != nil {
-		return nil, ErrTokenInvalid.New("JSON decoder: %w", err)
+	// the link was clicked again after the activation.
+	if user.Status == Active {
+		return nil, ErrEmailUsed.New(emailUsedErrMsg)
 	}
-
-	if time.Now().After(claims.Expiration) {
-		return nil, ErrTokenExpiration.New(activationTokenExpiredErrMsg)
+	if used || user.Status != Inactive {
+		return nil, ErrTokenInvalid.New("account isn't waiting for activation")
 	}
 
-	_, err = s.store.Users().GetByEmail(ctx, claims.Email)
+	_, err = s.store.Users().GetByEmail(ctx, user.Email)
 	if err == nil {
 		return nil, ErrEmailUsed.New(emailUsedErrMsg)
 	}
 
-	user, err = s.store.Users().Get(ctx, claims.ID)
-	if err != nil {
-		return nil, Error.Wrap(err)
-	}
-
+	// the tokens are marked used with the activation, so that none of them
+	// can be used again.
 	status := Active
-	err = s.store.Users().Update(ctx, user.ID, UpdateUserRequest{
-		Status: &status,
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		err := tx.Users().Update(ctx, user.ID, UpdateUserRequest{
+			Status: &status,
+		})
+		if err != nil {
+			return err
+		}
+		return tx.ActivationTokens().MarkUsedByUserID(ctx, user.ID, s.nowFn())
 	})
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
+	user.Status = status
+
 	s.auditLog(ctx, "activate account", &user.ID, user.Email)
 
 	s.analytics.TrackAccountVerified(user.ID, user.Email)
@@ -847,6 +929,67 @@ func (s *Service) ActivateAccount(ctx context.Context, activationToken string) (
 	return user, nil
 }
 
+// activationTokenUserID returns the user whom the activation token was sent to
+// and whether the token was used already.
+func (s *Service) activationTokenUserID(ctx context.Context, activationToken string) (_ uuid.UUID, used bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// activation links sent before the tokens were stored contain a signed
+	// token, which is accepted until it expires.
+	if strings.Contains(activationToken, ".") {
+		userID, err := s.legacyActivationTokenUserID(ctx, activationToken)
+		return userID, false, err
+	}
+
+	secret, err := ActivationSecretFromBase64(activationToken)
+	if err != nil {
+		return uuid.UUID{}, false, ErrTokenInvalid.Wrap(err)
+	}
+
+	activation, err := s.store.ActivationTokens().Get(ctx, secret.Hash())
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return uuid.UUID{}, false, ErrTokenInvalid.New("activation token not found")
+		}
+		return uuid.UUID{}, false, Error.Wrap(err)
+	}
+	if activation.Expired(s.nowFn()) {
+		return uuid.UUID{}, false, ErrTokenExpiration.New(activationTokenExpiredErrMsg)
+	}
+
+	return activation.UserID, activation.Used(), nil
+}
+
+// legacyActivationTokenUserID returns the user of a signed activation token.
+//
+// TODO: remove once the signed activation tokens have expired.
+func (s *Service) legacyActivationTokenUserID(ctx context.Context, activationToken string) (_ uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	token, err := consoleauth.FromBase64URLString(activationToken)
+	if err != nil {
+		return uuid.UUID{}, ErrTokenInvalid.Wrap(err)
+	}
+
+	valid, err := s.tokens.ValidateToken(token)
+	if err != nil {
+		return uuid.UUID{}, Error.Wrap(err)
+	}
+	if !valid {
+		return uuid.UUID{}, ErrTokenInvalid.New("incorrect signature")
+	}
+
+	claims, err := consoleauth.FromJSON(token.Payload)
+	if err != nil {
+		return uuid.UUID{}, ErrTokenInvalid.New("JSON decoder: %w", err)
+	}
+	if s.nowFn().After(claims.Expiration) {
+		return uuid.UUID{}, ErrTokenExpiration.New(activationTokenExpiredErrMsg)
+	}
+
+	return claims.ID, nil
+}
+
 // ResetPassword - is a method for resetting user password.
 func (s *Service) ResetPassword(ctx context.Context, resetPasswordToken, password string, passcode string, recoveryCode string, t time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1191,35 +1334,211 @@ func (s *Service) UpdateAccount(ctx context.Context, fullName string, shortName
 	return nil
 }
 
-// ChangeEmail updates email for a given user.
-func (s *Service) ChangeEmail(ctx context.Context, newEmail string) (err error) {
+// RequestEmailChange starts changing the email of the user to newEmail. The
+// returned email change holds the secret which verifies the new address.
+func (s *Service) RequestEmailChange(ctx context.Context, newEmail, password string) (_ *EmailChange, err error) {
 	defer mon.Task()(&ctx)(&err)
-	user, err := s.getUserAndAuditLog(ctx, "change email")
+	user, err := s.getUserAndAuditLog(ctx, "request email change", zap.String("newEmail", newEmail))
 	if err != nil {
-		return Error.Wrap(err)
+		return nil, Error.Wrap(err)
+	}
+
+	err = bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(password))
+	if err != nil {
+		return nil, ErrUnauthorized.New(credentialsErrMsg)
 	}
 
 	if _, err := mail.ParseAddress(newEmail); err != nil {
-		return ErrValidation.Wrap(err)
+		return nil, ErrValidation.Wrap(err)
 	}
 
 	verified, unverified, err := s.store.Users().GetByEmailWithUnverified(ctx, newEmail)
 	if err != nil {
-		return Error.Wrap(err)
+		return nil, Error.Wrap(err)
 	}
 	if verified != nil || len(unverified) != 0 {
-		return ErrEmailUsed.New(emailUsedErrMsg)
+		return nil, ErrEmailUsed.New(emailUsedErrMsg)
 	}
 
-	user.Email = newEmail
-	err = s.store.Users().Update(ctx, user.ID, UpdateUserRequest{
-		Email: &user.Email,
+	now := s.nowFn()
+	changes, err := s.store.EmailChanges().ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, change := range changes {
+		if change.Kind == EmailChangeRevert && !change.Expired(now) {
+			return nil, ErrValidation.New(emailChangeCoolingOffErrMsg)
+		}
+	}
+
+	secret, err := NewEmailChangeSecret()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	change := EmailChange{
+		Secret:    secret,
+		UserID:    user.ID,
+		Kind:      EmailChangeVerify,
+		OldEmail:  user.Email,
+		NewEmail:  newEmail,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.config.EmailChange.VerificationExpiration),
+	}
+
+	// only the latest requested change can be verified.
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		err := tx.EmailChanges().DeleteByUserID(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		return tx.EmailChanges().Insert(ctx, change)
 	})
 	if err != nil {
-		return Error.Wrap(err)
+		return nil, Error.Wrap(err)
 	}
 
-	return nil
+	return &change, nil
+}
+
+// VerifyEmailChange changes the email of the user once the new address is
+// verified. The returned email change holds the secret which reverts the
+// change from the previous address during the cooling-off period.
+func (s *Service) VerifyEmailChange(ctx context.Context, token string) (_ *EmailChange, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	change, err := s.getEmailChange(ctx, token, EmailChangeVerify)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.store.Users().Get(ctx, change.UserID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if user.Email != change.OldEmail {
+		return nil, ErrTokenInvalid.New("email was changed since the token was issued")
+	}
+
+	verified, unverified, err := s.store.Users().GetByEmailWithUnverified(ctx, change.NewEmail)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if verified != nil || len(unverified) != 0 {
+		return nil, ErrEmailUsed.New(emailUsedErrMsg)
+	}
+
+	secret, err := NewEmailChangeSecret()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	now := s.nowFn()
+	revert := EmailChange{
+		Secret:    secret,
+		UserID:    user.ID,
+		Kind:      EmailChangeRevert,
+		OldEmail:  change.OldEmail,
+		NewEmail:  change.NewEmail,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.config.EmailChange.CoolingOffPeriod),
+	}
+
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		err := tx.Users().Update(ctx, user.ID, UpdateUserRequest{
+			Email: &change.NewEmail,
+		})
+		if err != nil {
+			return err
+		}
+		err = tx.EmailChanges().DeleteByUserID(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		return tx.EmailChanges().Insert(ctx, revert)
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	s.auditLog(ctx, "verify email change", &user.ID, change.NewEmail, zap.String("oldEmail", change.OldEmail))
+
+	return &revert, nil
+}
+
+// RevertEmailChange restores the previous email of the user during the
+// cooling-off period and signs out all of the user's sessions.
+func (s *Service) RevertEmailChange(ctx context.Context, token string) (_ *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	change, err := s.getEmailChange(ctx, token, EmailChangeRevert)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.store.Users().Get(ctx, change.UserID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if user.Email != change.NewEmail {
+		return nil, ErrTokenInvalid.New("email was changed since the token was issued")
+	}
+
+	verified, unverified, err := s.store.Users().GetByEmailWithUnverified(ctx, change.OldEmail)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if (verified != nil && verified.ID != user.ID) || len(unverified) != 0 {
+		return nil, ErrEmailUsed.New(emailUsedErrMsg)
+	}
+
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		err := tx.Users().Update(ctx, user.ID, UpdateUserRequest{
+			Email: &change.OldEmail,
+		})
+		if err != nil {
+			return err
+		}
+		return tx.EmailChanges().DeleteByUserID(ctx, user.ID)
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	user.Email = change.OldEmail
+
+	_, err = s.store.WebappSessions().DeleteAllByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	s.auditLog(ctx, "revert email change", &user.ID, change.OldEmail, zap.String("revertedEmail", change.NewEmail))
+
+	return user, nil
+}
+
+// getEmailChange returns the email change of the token, when it has the
+// expected kind and it isn't expired.
+func (s *Service) getEmailChange(ctx context.Context, token string, kind EmailChangeKind) (_ *EmailChange, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	secret, err := EmailChangeSecretFromBase64(token)
+	if err != nil {
+		return nil, ErrTokenInvalid.Wrap(err)
+	}
+
+	change, err := s.store.EmailChanges().Get(ctx, secret)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return nil, ErrTokenInvalid.New("email change token not found")
+		}
+		return nil, Error.Wrap(err)
+	}
+	if change.Kind != kind {
+		return nil, ErrTokenInvalid.New("unexpected email change token kind %s", change.Kind)
+	}
+	if change.Expired(s.nowFn()) {
+		return nil, ErrTokenExpiration.New(emailChangeTokenExpiredErrMsg)
+	}
+
+	return change, nil
 }
 
 // ChangePassword updates password for a given user.
@@ -1409,6 +1728,7 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 	}
 
 	s.analytics.TrackProjectCreated(user.ID, user.Email, projectID, currentProjectCount+1)
+	s.completeOnboardingStep(ctx, user.ID, OnboardingStepCreatedProject)
 
 	return p, nil
 }
@@ -1478,11 +1798,14 @@ func (s *Service) GenCreateProject(ctx context.Context, projectInfo ProjectInfo)
 	}
 
 	s.analytics.TrackProjectCreated(user.ID, user.Email, projectID, currentProjectCount+1)
+	s.completeOnboardingStep(ctx, user.ID, OnboardingStepCreatedProject)
 
 	return p, httpError
 }
 
-// DeleteProject is a method for deleting project by id.
+// DeleteProject is a method for deleting project by id. The project is only
+// marked as deleted, and its data is purged after the grace period, unless it's
+// restored before.
 func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -1496,12 +1819,7 @@ func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err e
 		return Error.Wrap(err)
 	}
 
-	err = s.checkProjectCanBeDeleted(ctx, user, projectID)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-
-	err = s.store.Projects().Delete(ctx, projectID)
+	err = s.markProjectDeleted(ctx, user, projectID)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -1530,18 +1848,14 @@ func (s *Service) GenDeleteProject(ctx context.Context, projectID uuid.UUID) (ht
 		}
 	}
 
-	err = s.checkProjectCanBeDeleted(ctx, user, projectID)
+	err = s.markProjectDeleted(ctx, user, projectID)
 	if err != nil {
-		return api.HTTPError{
-			Status: http.StatusConflict,
-			Err:    Error.Wrap(err),
+		status := http.StatusInternalServerError
+		if ErrValidation.Has(err) {
+			status = http.StatusConflict
 		}
-	}
-
-	err = s.store.Projects().Delete(ctx, projectID)
-	if err != nil {
 		return api.HTTPError{
-			Status: http.StatusInternalServerError,
+			Status: status,
 			Err:    Error.Wrap(err),
 		}
 	}
@@ -1549,36 +1863,125 @@ func (s *Service) GenDeleteProject(ctx context.Context, projectID uuid.UUID) (ht
 	return httpError
 }
 
-// UpdateProject is a method for updating project name and description by id.
-func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, updatedProject ProjectInfo) (p *Project, err error) {
+// GetProjectDeletion returns the deletion of the project, nil when the project
+// isn't deleted.
+func (s *Service) GetProjectDeletion(ctx context.Context, projectID uuid.UUID) (_ *ProjectDeletion, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	user, err := s.getUserAndAuditLog(ctx, "update project name and description", zap.String("projectID", projectID.String()))
+	user, err := s.getUserAndAuditLog(ctx, "get project deletion", zap.String("projectID", projectID.String()))
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
-	err = ValidateNameAndDescription(updatedProject.Name, updatedProject.Description)
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	deletion, err := s.store.ProjectDeletions().Get(ctx, projectID)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
-	project := isMember.project
-	project.Name = updatedProject.Name
-	project.Description = updatedProject.Description
 
-	if user.PaidTier {
-		if project.BandwidthLimit != nil && *project.BandwidthLimit == 0 {
-			return nil, Error.New("current bandwidth limit for project is set to 0 (updating disabled)")
-		}
-		if project.StorageLimit != nil && *project.StorageLimit == 0 {
-			return nil, Error.New("current storage limit for project is set to 0 (updating disabled)")
-		}
-		if updatedProject.StorageLimit <= 0 || updatedProject.BandwidthLimit <= 0 {
+	return deletion, nil
+}
+
+// RestoreProject restores the deleted project, unless its grace period has
+// ended.
+func (s *Service) RestoreProject(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "restore project", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	deletion, err := s.store.ProjectDeletions().Get(ctx, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if deletion == nil {
+		return ErrValidation.New(projectNotDeletedErrMsg)
+	}
+	if !deletion.Restorable(s.nowFn()) {
+		return ErrValidation.New(projectNotRestorableErrMsg)
+	}
+
+	err = s.store.ProjectDeletions().Delete(ctx, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	s.recordProjectActivity(ctx, projectID, user, ProjectActivityProjectRestored, "")
+
+	return nil
+}
+
+// markProjectDeleted marks the project as deleted by the user. Its data is
+// purged by the project purge chore after the grace period.
+func (s *Service) markProjectDeleted(ctx context.Context, user *User, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deletion, err := s.store.ProjectDeletions().Get(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if deletion != nil {
+		return ErrValidation.New(projectDeletedErrMsg)
+	}
+
+	now := s.nowFn()
+	err = s.store.ProjectDeletions().Insert(ctx, ProjectDeletion{
+		ProjectID:  projectID,
+		DeletedBy:  user.ID,
+		DeletedAt:  now,
+		PurgeAfter: now.Add(s.config.ProjectDeletionGracePeriod),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.recordProjectActivity(ctx, projectID, user, ProjectActivityProjectDeleted, "")
+
+	return nil
+}
+
+// UpdateProject is a method for updating project name and description by id.
+func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, updatedProject ProjectInfo) (p *Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update project name and description", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	err = ValidateNameAndDescription(updatedProject.Name, updatedProject.Description)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	project := isMember.project
+	project.Name = updatedProject.Name
+	project.Description = updatedProject.Description
+
+	var limitsChange string
+	if user.PaidTier {
+		if project.BandwidthLimit != nil && *project.BandwidthLimit == 0 {
+			return nil, Error.New("current bandwidth limit for project is set to 0 (updating disabled)")
+		}
+		if project.StorageLimit != nil && *project.StorageLimit == 0 {
+			return nil, Error.New("current storage limit for project is set to 0 (updating disabled)")
+		}
+		if updatedProject.StorageLimit <= 0 || updatedProject.BandwidthLimit <= 0 {
 			return nil, Error.New("project limits must be greater than 0")
 		}
 
@@ -1606,6 +2009,8 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, update
 			return nil, Error.New("cannot set bandwidth limit below current usage")
 		}
 
+		limitsChange = projectLimitsChange(project, updatedProject.StorageLimit, updatedProject.BandwidthLimit)
+
 		project.StorageLimit = new(memory.Size)
 		*project.StorageLimit = updatedProject.StorageLimit
 		project.BandwidthLimit = new(memory.Size)
@@ -1617,6 +2022,10 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, update
 		return nil, Error.Wrap(err)
 	}
 
+	if limitsChange != "" {
+		s.recordProjectActivity(ctx, projectID, user, ProjectActivityLimitsChanged, limitsChange)
+	}
+
 	return project, nil
 }
 
@@ -1652,6 +2061,7 @@ func (s *Service) GenUpdateProject(ctx context.Context, projectID uuid.UUID, pro
 	project.Name = projectInfo.Name
 	project.Description = projectInfo.Description
 
+	var limitsChange string
 	if user.PaidTier {
 		if project.BandwidthLimit != nil && *project.BandwidthLimit == 0 {
 			return nil, api.HTTPError{
@@ -1714,6 +2124,8 @@ func (s *Service) GenUpdateProject(ctx context.Context, projectID uuid.UUID, pro
 			}
 		}
 
+		limitsChange = projectLimitsChange(project, projectInfo.StorageLimit, projectInfo.BandwidthLimit)
+
 		project.StorageLimit = new(memory.Size)
 		*project.StorageLimit = projectInfo.StorageLimit
 		project.BandwidthLimit = new(memory.Size)
@@ -1728,6 +2140,10 @@ func (s *Service) GenUpdateProject(ctx context.Context, projectID uuid.UUID, pro
 		}
 	}
 
+	if limitsChange != "" {
+		s.recordProjectActivity(ctx, projectID, user, ProjectActivityLimitsChanged, limitsChange)
+	}
+
 	return project, httpError
 }
 
@@ -1773,6 +2189,10 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 		return nil, Error.Wrap(err)
 	}
 
+	for _, member := range users {
+		s.recordProjectActivity(ctx, projectID, user, ProjectActivityMemberAdded, member.Email)
+	}
+
 	return users, nil
 }
 
@@ -1788,7 +2208,7 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 		return Error.Wrap(err)
 	}
 
-	var userIDs []uuid.UUID
+	var members []*User
 	var userErr errs.Group
 
 	// collect user querying errors
@@ -1807,7 +2227,7 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 			return Error.Wrap(err)
 		}
 
-		userIDs = append(userIDs, user.ID)
+		members = append(members, user)
 	}
 
 	if err = userErr.Err(); err != nil {
@@ -1816,15 +2236,23 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 
 	// delete project members in transaction scope
 	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
-		for _, uID := range userIDs {
-			err = tx.ProjectMembers().Delete(ctx, uID, projectID)
+		for _, member := range members {
+			err = tx.ProjectMembers().Delete(ctx, member.ID, projectID)
 			if err != nil {
 				return err
 			}
 		}
 		return nil
 	})
-	return Error.Wrap(err)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, member := range members {
+		s.recordProjectActivity(ctx, projectID, user, ProjectActivityMemberRemoved, member.Email)
+	}
+
+	return nil
 }
 
 // GetProjectMembers returns ProjectMembers for given Project.
@@ -1853,6 +2281,225 @@ func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, cu
 	return
 }
 
+// GetProjectActivities returns a page of the activity feed of the project.
+func (s *Service) GetProjectActivities(ctx context.Context, projectID uuid.UUID, cursor ProjectActivityCursor) (_ *ProjectActivityPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project activities", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if cursor.Limit == 0 || cursor.Limit > maxLimit {
+		cursor.Limit = maxLimit
+	}
+	if cursor.Page == 0 {
+		cursor.Page = 1
+	}
+
+	page, err := s.store.ProjectActivities().GetPagedByProjectID(ctx, projectID, cursor)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return page, nil
+}
+
+// GetProjectSecurityLog returns a page of the security log of the project.
+func (s *Service) GetProjectSecurityLog(ctx context.Context, projectID uuid.UUID, cursor SecurityLogCursor) (_ *SecurityLogPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project security log", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if cursor.Limit == 0 || cursor.Limit > maxLimit {
+		cursor.Limit = maxLimit
+	}
+	if cursor.Page == 0 {
+		cursor.Page = 1
+	}
+
+	page, err := s.store.SecurityLogs().GetPagedByProjectID(ctx, projectID, cursor)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return page, nil
+}
+
+// GetUsageAnomalySetting returns the setting of the usage anomaly detection of the project.
+func (s *Service) GetUsageAnomalySetting(ctx context.Context, projectID uuid.UUID) (_ *UsageAnomalySetting, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get usage anomaly setting", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	setting, err := s.store.UsageAnomalySettings().Get(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &setting, nil
+}
+
+// UpdateUsageAnomalySensitivity replaces the sensitivity of the usage anomaly
+// detection of the project. Only the owner of the project, who is notified
+// about the anomalies, can change it.
+func (s *Service) UpdateUsageAnomalySensitivity(ctx context.Context, projectID uuid.UUID, sensitivity UsageAnomalySensitivity) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update usage anomaly sensitivity", zap.String("projectID", projectID.String()), zap.String("sensitivity", string(sensitivity)))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if !sensitivity.Valid() {
+		return ErrValidation.New(usageAnomalySensitivityErrMsg)
+	}
+
+	err = s.store.UsageAnomalySettings().UpdateSensitivity(ctx, projectID, sensitivity)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// GetOnboardingProgress returns the progress of the user through the onboarding.
+func (s *Service) GetOnboardingProgress(ctx context.Context) (_ *OnboardingProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get onboarding progress")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	completed, err := s.store.OnboardingSteps().GetCompleted(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	progress := NewOnboardingProgress(completed)
+	return &progress, nil
+}
+
+// CompleteOnboardingStep marks the onboarding step as completed by the user.
+// It's used for the steps, which happen outside of the satellite UI, e.g.
+// installing the uplink CLI.
+func (s *Service) CompleteOnboardingStep(ctx context.Context, step OnboardingStep) (_ *OnboardingProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "complete onboarding step", zap.String("step", string(step)))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if !step.Valid() {
+		return nil, ErrValidation.New(onboardingStepErrMsg)
+	}
+
+	err = s.store.OnboardingSteps().Complete(ctx, user.ID, step, s.nowFn())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	completed, err := s.store.OnboardingSteps().GetCompleted(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	progress := NewOnboardingProgress(completed)
+	return &progress, nil
+}
+
+// ResetOnboarding restarts the onboarding of the user from the first step.
+func (s *Service) ResetOnboarding(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "reset onboarding")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = s.store.OnboardingSteps().Reset(ctx, user.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// completeOnboardingStep records that the user completed the onboarding step
+// through the satellite UI. The step has already happened, hence failing to
+// record it is only logged.
+func (s *Service) completeOnboardingStep(ctx context.Context, userID uuid.UUID, step OnboardingStep) {
+	if err := s.store.OnboardingSteps().Complete(ctx, userID, step, s.nowFn()); err != nil {
+		s.log.Error("failed to complete onboarding step",
+			zap.Stringer("userID", userID),
+			zap.String("step", string(step)),
+			zap.Error(err))
+	}
+}
+
+// recordProjectActivity adds the change of the project to its activity feed.
+// The change has already happened, hence failing to record it is only logged.
+func (s *Service) recordProjectActivity(ctx context.Context, projectID uuid.UUID, actor *User, kind ProjectActivityKind, details string) {
+	activity := &ProjectActivity{
+		ProjectID: projectID,
+		Kind:      kind,
+		Details:   details,
+		CreatedAt: s.nowFn(),
+	}
+	if actor != nil {
+		actorID := actor.ID
+		activity.ActorID = &actorID
+	}
+
+	if _, err := s.store.ProjectActivities().Insert(ctx, activity); err != nil {
+		s.log.Error("failed to record project activity",
+			zap.Stringer("projectID", projectID),
+			zap.String("kind", string(kind)),
+			zap.Error(err))
+	}
+}
+
+// projectLimitsChange describes the change of the storage and bandwidth limits
+// of the project. It returns an empty string when the limits don't change.
+func projectLimitsChange(project *Project, storageLimit, bandwidthLimit memory.Size) string {
+	var changes []string
+	if project.StorageLimit == nil || *project.StorageLimit != storageLimit {
+		changes = append(changes, "storage limit: "+storageLimit.String())
+	}
+	if project.BandwidthLimit == nil || *project.BandwidthLimit != bandwidthLimit {
+		changes = append(changes, "bandwidth limit: "+bandwidthLimit.String())
+	}
+	return strings.Join(changes, ", ")
+}
+
 // CreateAPIKey creates new api key.
 func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name string) (_ *APIKeyInfo, _ *macaroon.APIKey, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1895,6 +2542,9 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, Error.Wrap(err)
 	}
 
+	s.recordProjectActivity(ctx, projectID, user, ProjectActivityAPIKeyCreated, info.Name)
+	s.completeOnboardingStep(ctx, user.ID, OnboardingStepCreatedAPIKey)
+
 	return info, key, nil
 }
 
@@ -1967,6 +2617,9 @@ func (s *Service) GenCreateAPIKey(ctx context.Context, requestInfo CreateAPIKeyR
 		}
 	}
 
+	s.recordProjectActivity(ctx, projectID, user, ProjectActivityAPIKeyCreated, info.Name)
+	s.completeOnboardingStep(ctx, user.ID, OnboardingStepCreatedAPIKey)
+
 	return &CreateAPIKeyResponse{
 		Key:     key.Serialize(),
 		KeyInfo: info,
@@ -2033,6 +2686,7 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 		return Error.Wrap(err)
 	}
 
+	var keys []*APIKeyInfo
 	var keysErr errs.Group
 
 	for _, keyID := range ids {
@@ -2047,6 +2701,8 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 			keysErr.Add(ErrUnauthorized.Wrap(err))
 			continue
 		}
+
+		keys = append(keys, key)
 	}
 
 	if err = keysErr.Err(); err != nil {
@@ -2063,7 +2719,15 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 
 		return nil
 	})
-	return Error.Wrap(err)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, key := range keys {
+		s.recordProjectActivity(ctx, key.ProjectID, user, ProjectActivityAPIKeyRevoked, key.Name)
+	}
+
+	return nil
 }
 
 // DeleteAPIKeyByNameAndProjectID deletes api key by name and project ID.
@@ -2090,40 +2754,158 @@ func (s *Service) DeleteAPIKeyByNameAndProjectID(ctx context.Context, name strin
 		return Error.Wrap(err)
 	}
 
+	s.recordProjectActivity(ctx, projectID, user, ProjectActivityAPIKeyRevoked, key.Name)
+
 	return nil
 }
 
-// GetAPIKeys returns paged api key list for given Project.
-func (s *Service) GetAPIKeys(ctx context.Context, projectID uuid.UUID, cursor APIKeyCursor) (page *APIKeyPage, err error) {
+// CreateShareAccess restricts the access grant to download only the object
+// until expiresAt, so it can be registered for link sharing.
+//
+// The access grant must belong to a project the user is a member of. It's
+// only restricted in memory and never stored. Access grants are restricted by
+// key prefix, so the objects whose key starts with key are shared too.
+func (s *Service) CreateShareAccess(ctx context.Context, accessGrant, bucket, key string, expiresAt time.Time) (_ string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	user, err := s.getUserAndAuditLog(ctx, "get api keys", zap.String("projectID", projectID.String()))
+	user, err := s.getUserAndAuditLog(ctx, "create share access", zap.String("bucket", bucket))
 	if err != nil {
-		return nil, Error.Wrap(err)
+		return "", Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, user.ID, projectID)
-	if err != nil {
-		return nil, Error.Wrap(err)
+	if bucket == "" || key == "" {
+		return "", ErrValidation.New("bucket and object key are required")
 	}
 
-	if cursor.Limit > maxLimit {
-		cursor.Limit = maxLimit
+	now := s.nowFn()
+	if !expiresAt.After(now) || expiresAt.After(now.Add(s.config.ShareLinkMaxDuration)) {
+		return "", ErrValidation.New(shareExpirationErrMsg, s.config.ShareLinkMaxDuration)
 	}
 
-	page, err = s.store.APIKeys().GetPagedByProjectID(ctx, projectID, cursor)
+	access, err := grant.ParseAccess(accessGrant)
 	if err != nil {
-		return nil, Error.Wrap(err)
+		return "", ErrValidation.Wrap(err)
 	}
 
-	return
-}
-
-// CreateRESTKey creates a satellite rest key.
-func (s *Service) CreateRESTKey(ctx context.Context, expiration time.Duration) (apiKey string, expiresAt time.Time, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	user, err := s.getUserAndAuditLog(ctx, "create rest key")
+	info, err := s.store.APIKeys().GetByHead(ctx, access.APIKey.Head())
+	if err != nil {
+		return "", ErrNoAPIKey.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, info.ProjectID)
+	if err != nil {
+		return "", ErrUnauthorized.Wrap(err)
+	}
+
+	restricted, err := access.Restrict(grant.Permission{
+		AllowDownload: true,
+		NotAfter:      expiresAt,
+	}, grant.SharePrefix{
+		Bucket: bucket,
+		Prefix: key,
+	})
+	if err != nil {
+		return "", ErrValidation.Wrap(err)
+	}
+
+	serialized, err := restricted.Serialize()
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	return serialized, nil
+}
+
+// CreateS3Access restricts the access grant of the project to the permission
+// and buckets, so it can be registered with the auth service to obtain S3
+// compatible credentials. All the buckets are accessible when buckets is empty.
+//
+// The access grant is only restricted in memory and never stored.
+func (s *Service) CreateS3Access(ctx context.Context, projectID uuid.UUID, accessGrant string, permission grant.Permission, buckets []string) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "create s3 access", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return "", ErrUnauthorized.Wrap(err)
+	}
+
+	if !permission.AllowDownload && !permission.AllowUpload && !permission.AllowList && !permission.AllowDelete {
+		return "", ErrValidation.New(s3PermissionsErrMsg)
+	}
+	if permission.NotAfter != (time.Time{}) && !permission.NotAfter.After(s.nowFn()) {
+		return "", ErrValidation.New(s3ExpirationErrMsg)
+	}
+
+	access, err := grant.ParseAccess(accessGrant)
+	if err != nil {
+		return "", ErrValidation.Wrap(err)
+	}
+
+	info, err := s.store.APIKeys().GetByHead(ctx, access.APIKey.Head())
+	if err != nil {
+		return "", ErrNoAPIKey.Wrap(err)
+	}
+	if info.ProjectID != projectID {
+		return "", ErrUnauthorized.New("access grant doesn't belong to the project")
+	}
+
+	prefixes := make([]grant.SharePrefix, 0, len(buckets))
+	for _, bucket := range buckets {
+		if bucket == "" {
+			return "", ErrValidation.New("bucket name can't be empty")
+		}
+		prefixes = append(prefixes, grant.SharePrefix{Bucket: bucket})
+	}
+
+	restricted, err := access.Restrict(permission, prefixes...)
+	if err != nil {
+		return "", ErrValidation.Wrap(err)
+	}
+
+	serialized, err := restricted.Serialize()
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	return serialized, nil
+}
+
+// GetAPIKeys returns paged api key list for given Project.
+func (s *Service) GetAPIKeys(ctx context.Context, projectID uuid.UUID, cursor APIKeyCursor) (page *APIKeyPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get api keys", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if cursor.Limit > maxLimit {
+		cursor.Limit = maxLimit
+	}
+
+	page, err = s.store.APIKeys().GetPagedByProjectID(ctx, projectID, cursor)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return
+}
+
+// CreateRESTKey creates a satellite rest key.
+func (s *Service) CreateRESTKey(ctx context.Context, expiration time.Duration) (apiKey string, expiresAt time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "create rest key")
 	if err != nil {
 		return "", time.Time{}, Error.Wrap(err)
 	}
@@ -2230,6 +3012,121 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 	return list, nil
 }
 
+// GetBucketQuota returns the quota of the bucket with the usage of the bucket
+// from its latest tally.
+func (s *Service) GetBucketQuota(ctx context.Context, projectID uuid.UUID, bucketName string) (_ *BucketQuota, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket quota", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	quota, err := s.buckets.GetBucketQuota(ctx, []byte(bucketName), projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	tally, err := s.projectUsage.GetLatestBucketTally(ctx, metabase.BucketLocation{
+		ProjectID:  projectID,
+		BucketName: bucketName,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &BucketQuota{
+		Quota:       quota,
+		ObjectCount: tally.ObjectCount,
+		TotalBytes:  tally.TotalBytes,
+	}, nil
+}
+
+// UpdateBucketQuota replaces the quota of the bucket. A zero quota removes
+// the limits of the bucket.
+func (s *Service) UpdateBucketQuota(ctx context.Context, projectID uuid.UUID, bucketName string, quota buckets.Quota) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update bucket quota", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if (quota.MaxObjects != nil && *quota.MaxObjects < 0) || (quota.MaxBytes != nil && *quota.MaxBytes < 0) {
+		return ErrValidation.New(bucketQuotaErrMsg)
+	}
+
+	err = s.buckets.UpdateBucketQuota(ctx, []byte(bucketName), projectID, quota)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	s.recordProjectActivity(ctx, projectID, user, ProjectActivityBucketQuotaChanged, bucketName)
+
+	return nil
+}
+
+// GetBucketLifecycleRules returns the lifecycle rules of the bucket.
+func (s *Service) GetBucketLifecycleRules(ctx context.Context, projectID uuid.UUID, bucketName string) (_ []buckets.LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket lifecycle rules", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	rules, err := s.buckets.GetLifecycleRules(ctx, []byte(bucketName), projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return rules, nil
+}
+
+// UpdateBucketLifecycleRules replaces the lifecycle rules of the bucket. No
+// rules removes all of them.
+func (s *Service) UpdateBucketLifecycleRules(ctx context.Context, projectID uuid.UUID, bucketName string, rules []buckets.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update bucket lifecycle rules", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err := buckets.ValidateLifecycleRules(rules); err != nil {
+		return ErrValidation.Wrap(err)
+	}
+
+	err = s.buckets.UpdateLifecycleRules(ctx, []byte(bucketName), projectID, rules)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	s.recordProjectActivity(ctx, projectID, user, ProjectActivityBucketLifecycleChanged, bucketName)
+
+	return nil
+}
+
 // GetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period.
 func (s *Service) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.BucketUsageRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -2338,6 +3235,182 @@ func (s *Service) GetDailyProjectUsage(ctx context.Context, projectID uuid.UUID,
 	return usage, nil
 }
 
+// GetAPIKeyProjectUsage returns the usage of the project by project ID, which is
+// attributed to each of its api keys.
+func (s *Service) GetAPIKeyProjectUsage(ctx context.Context, projectID uuid.UUID, from, to time.Time) (_ []accounting.APIKeyUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get api key usage by project ID")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if from.After(to) {
+		return nil, ErrValidation.New("invalid usage period: %s - %s", from, to)
+	}
+
+	usage, err := s.projectAccounting.GetProjectAPIKeyUsage(ctx, projectID, from, to)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return usage, nil
+}
+
+// GetSharedLinkProjectUsage returns the usage of the objects of the project by
+// project ID, which were downloaded through shared links.
+func (s *Service) GetSharedLinkProjectUsage(ctx context.Context, projectID uuid.UUID, from, to time.Time) (_ []accounting.SharedLinkUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get shared link usage by project ID")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if from.After(to) {
+		return nil, ErrValidation.New("invalid usage period: %s - %s", from, to)
+	}
+
+	usage, err := s.projectAccounting.GetProjectSharedLinkUsage(ctx, projectID, from, to)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return usage, nil
+}
+
+// GetHourlyProjectUsage returns hourly usage by project ID for at most the last 72 hours.
+//
+// The storage of the current hour is taken from live accounting. Allocated
+// bandwidth which wasn't flushed to the rollups yet is returned separately, as
+// it can't be attributed to an hour. Settled bandwidth of the current hour is
+// only known once storage nodes submit their orders.
+func (s *Service) GetHourlyProjectUsage(ctx context.Context, projectID uuid.UUID, from, to time.Time) (_ *accounting.ProjectHourlyUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get hourly usage by project ID")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	now := s.nowFn().UTC()
+	if earliest := now.Add(-maxHourlyUsagePeriod); from.Before(earliest) {
+		from = earliest
+	}
+	if to.After(now) {
+		to = now
+	}
+	if from.After(to) {
+		return nil, ErrValidation.New("invalid usage period: %s - %s", from, to)
+	}
+
+	usage, err := s.projectAccounting.GetProjectHourlyUsageByDateRange(ctx, projectID, from, to, s.config.AsOfSystemTimeDuration)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	currentHour := now.Truncate(time.Hour)
+	if to.Before(currentHour) {
+		return usage, nil
+	}
+
+	storage, err := s.projectUsage.GetProjectStorageTotals(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	usage.StorageUsage = setCurrentHourUsage(usage.StorageUsage, currentHour, storage)
+
+	liveBandwidth, err := s.projectUsage.GetProjectBandwidthUsage(ctx, projectID)
+	switch {
+	case accounting.ErrKeyNotFound.Has(err):
+		return usage, nil
+	case err != nil:
+		return nil, Error.Wrap(err)
+	}
+
+	flushedBandwidth, err := s.projectUsage.GetProjectBandwidthTotals(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if unflushed := liveBandwidth - flushedBandwidth; unflushed > 0 {
+		usage.UnflushedAllocatedBandwidth = unflushed
+	}
+
+	return usage, nil
+}
+
+// setCurrentHourUsage sets the value of the current hour, which is the last
+// one of the series, adding it when it's missing.
+func setCurrentHourUsage(series []accounting.ProjectUsageByHour, currentHour time.Time, value int64) []accounting.ProjectUsageByHour {
+	last := len(series) - 1
+	if last >= 0 && series[last].Hour.Equal(currentHour) {
+		series[last].Value = value
+		return series
+	}
+	return append(series, accounting.ProjectUsageByHour{
+		Hour:  currentHour,
+		Value: value,
+	})
+}
+
+// GetProjectUsageForecast returns the usage and charges of the project for the
+// current month so far, and their forecast at the end of the month, projected
+// from the trend of the daily usage.
+func (s *Service) GetProjectUsageForecast(ctx context.Context, projectID uuid.UUID) (_ *ProjectUsageForecast, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project usage forecast", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	now := s.nowFn().UTC()
+	year, month, _ := now.Date()
+	monthStart := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+
+	usage, err := s.projectAccounting.GetProjectTotal(ctx, projectID, monthStart, now)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	daily, err := s.projectAccounting.GetProjectDailyUsageByDateRange(ctx, projectID, monthStart, now, s.config.AsOfSystemTimeDuration)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	projected := accounting.ForecastProjectUsage(*usage, daily, now)
+
+	forecast := &ProjectUsageForecast{
+		Current:   s.accounts.ProjectUsagePrice(*usage),
+		Projected: s.accounts.ProjectUsagePrice(projected),
+	}
+	forecast.Current.ProjectID = projectID
+	forecast.Projected.ProjectID = projectID
+
+	return forecast, nil
+}
+
 // GetProjectUsageLimits returns project limits and current usage.
 //
 // Among others,it can return one of the following errors returned by
@@ -2496,42 +3569,6 @@ func (s *Service) KeyAuth(ctx context.Context, apikey string, authTime time.Time
 	return ctx, nil
 }
 
-// checkProjectCanBeDeleted ensures that all data, api-keys and buckets are deleted and usage has been accounted.
-// no error means the project status is clean.
-func (s *Service) checkProjectCanBeDeleted(ctx context.Context, user *User, projectID uuid.UUID) (err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	buckets, err := s.buckets.CountBuckets(ctx, projectID)
-	if err != nil {
-		return err
-	}
-	if buckets > 0 {
-		return ErrUsage.New("some buckets still exist")
-	}
-
-	keys, err := s.store.APIKeys().GetPagedByProjectID(ctx, projectID, APIKeyCursor{Limit: 1, Page: 1})
-	if err != nil {
-		return err
-	}
-	if keys.TotalCount > 0 {
-		return ErrUsage.New("some api keys still exist")
-	}
-
-	if user.PaidTier {
-		err = s.Payments().checkProjectUsageStatus(ctx, projectID)
-		if err != nil {
-			return ErrUsage.Wrap(err)
-		}
-	}
-
-	err = s.Payments().checkProjectInvoicingStatus(ctx, projectID)
-	if err != nil {
-		return ErrUsage.Wrap(err)
-	}
-
-	return nil
-}
-
 // checkProjectLimit is used to check if user is able to create a new project.
 func (s *Service) checkProjectLimit(ctx context.Context, userID uuid.UUID) (currentProjects int, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -2547,6 +3584,13 @@ func (s *Service) checkProjectLimit(ctx context.Context, userID uuid.UUID) (curr
 	}
 
 	if len(projects) >= limit {
+		review, err := s.store.SignupReviews().Get(ctx, userID)
+		if err != nil && !errs.Is(err, sql.ErrNoRows) {
+			return 0, Error.Wrap(err)
+		}
+		if review != nil && review.Pending() {
+			return 0, ErrProjLimit.New(projLimitUnderReviewErrMsg)
+		}
 		return 0, ErrProjLimit.New(projLimitErrMsg)
 	}
 
@@ -17,6 +17,7 @@ import (
 
 	"storj.io/common/macaroon"
 	"storj.io/common/memory"
+	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
@@ -24,7 +25,9 @@ import (
 	"storj.io/storj/private/blockchain"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
 )
 
 func TestService(t *testing.T) {
@@ -202,46 +205,125 @@ func TestService(t *testing.T) {
 			})
 
 			t.Run("DeleteProject", func(t *testing.T) {
-				// Deleting the own project should not work before deleting the API-Key
-				err := service.DeleteProject(userCtx1, up1Pro1.ID)
+				// Deleting someone else project should not work
+				err := service.DeleteProject(userCtx1, up2Pro1.ID)
 				require.Error(t, err)
 
-				keys, err := service.GetAPIKeys(userCtx1, up1Pro1.ID, console.APIKeyCursor{Page: 1, Limit: 10})
+				// Deleting the own project should work with API keys and buckets,
+				// the project is only marked as deleted.
+				err = service.DeleteProject(userCtx1, up1Pro1.ID)
 				require.NoError(t, err)
-				require.Len(t, keys.APIKeys, 1)
 
-				err = service.DeleteAPIKeys(userCtx1, []uuid.UUID{keys.APIKeys[0].ID})
+				deletion, err := service.GetProjectDeletion(userCtx1, up1Pro1.ID)
 				require.NoError(t, err)
+				require.NotNil(t, deletion)
+				require.Equal(t, up1Pro1.OwnerID, deletion.DeletedBy)
+				require.Equal(t, sat.Config.Console.ProjectDeletionGracePeriod, deletion.PurgeAfter.Sub(deletion.DeletedAt))
 
-				// Deleting the own project should now work
-				err = service.DeleteProject(userCtx1, up1Pro1.ID)
+				_, err = sat.API.DB.Console().Projects().Get(ctx, up1Pro1.ID)
 				require.NoError(t, err)
 
-				// Deleting someone else project should not work
-				err = service.DeleteProject(userCtx1, up2Pro1.ID)
+				// the project can't be deleted twice
+				err = service.DeleteProject(userCtx1, up1Pro1.ID)
+				require.True(t, console.ErrValidation.Has(err))
+
+				// only the owner can restore the project
+				err = service.RestoreProject(userCtx2, up1Pro1.ID)
 				require.Error(t, err)
 
+				err = service.RestoreProject(userCtx1, up1Pro1.ID)
+				require.NoError(t, err)
+
+				deletion, err = service.GetProjectDeletion(userCtx1, up1Pro1.ID)
+				require.NoError(t, err)
+				require.Nil(t, deletion)
+
+				err = service.RestoreProject(userCtx1, up1Pro1.ID)
+				require.True(t, console.ErrValidation.Has(err))
+
+				// deleting a project with a bucket keeps the bucket until the
+				// project is purged.
 				err = planet.Uplinks[1].CreateBucket(ctx, sat, "testbucket")
 				require.NoError(t, err)
 
-				// deleting a project with a bucket should fail
 				err = service.DeleteProject(userCtx2, up2Pro1.ID)
-				require.Error(t, err)
-				require.Equal(t, "console service: project usage: some buckets still exist", err.Error())
+				require.NoError(t, err)
+
+				count, err := sat.API.DB.Buckets().CountBuckets(ctx, up2Pro1.ID)
+				require.NoError(t, err)
+				require.Equal(t, 1, count)
+
+				err = service.RestoreProject(userCtx2, up2Pro1.ID)
+				require.NoError(t, err)
+
+				// the project can't be restored after the grace period
+				err = service.DeleteProject(userCtx1, up1Pro1.ID)
+				require.NoError(t, err)
+
+				service.SetNow(func() time.Time {
+					return time.Now().Add(sat.Config.Console.ProjectDeletionGracePeriod + time.Hour)
+				})
+				defer service.SetNow(time.Now)
+
+				err = service.RestoreProject(userCtx1, up1Pro1.ID)
+				require.True(t, console.ErrValidation.Has(err))
 			})
 
 			t.Run("ChangeEmail", func(t *testing.T) {
 				const newEmail = "newEmail@example.com"
+				oldEmail := up2User.Email
 
-				err = service.ChangeEmail(userCtx2, newEmail)
+				_, err := service.RequestEmailChange(userCtx2, newEmail, "wrong password")
+				require.True(t, console.ErrUnauthorized.Has(err))
+
+				_, err = service.RequestEmailChange(userCtx2, "not an email", up2User.FullName)
+				require.True(t, console.ErrValidation.Has(err))
+
+				change, err := service.RequestEmailChange(userCtx2, newEmail, up2User.FullName)
 				require.NoError(t, err)
+				require.Equal(t, console.EmailChangeVerify, change.Kind)
 
-				user, _, err := service.GetUserByEmailWithUnverified(userCtx2, newEmail)
+				// the email isn't changed until the new address is verified.
+				user, err := service.GetUser(ctx, up2User.ID)
 				require.NoError(t, err)
-				require.Equal(t, newEmail, user.Email)
+				require.Equal(t, oldEmail, user.Email)
 
-				err = service.ChangeEmail(userCtx2, newEmail)
-				require.Error(t, err)
+				// a verify token can't be used to revert.
+				_, err = service.RevertEmailChange(ctx, change.Secret.String())
+				require.True(t, console.ErrTokenInvalid.Has(err))
+
+				revert, err := service.VerifyEmailChange(ctx, change.Secret.String())
+				require.NoError(t, err)
+				require.Equal(t, console.EmailChangeRevert, revert.Kind)
+
+				user, _, err = service.GetUserByEmailWithUnverified(ctx, newEmail)
+				require.NoError(t, err)
+				require.Equal(t, up2User.ID, user.ID)
+
+				// the verify token is single use.
+				_, err = service.VerifyEmailChange(ctx, change.Secret.String())
+				require.True(t, console.ErrTokenInvalid.Has(err))
+
+				// the email can't be changed again during the cooling-off period.
+				_, err = service.RequestEmailChange(userCtx2, "another@example.com", up2User.FullName)
+				require.True(t, console.ErrValidation.Has(err))
+
+				user, err = service.RevertEmailChange(ctx, revert.Secret.String())
+				require.NoError(t, err)
+				require.Equal(t, oldEmail, user.Email)
+
+				_, err = service.RevertEmailChange(ctx, revert.Secret.String())
+				require.True(t, console.ErrTokenInvalid.Has(err))
+
+				change, err = service.RequestEmailChange(userCtx2, newEmail, up2User.FullName)
+				require.NoError(t, err)
+
+				now := time.Now().Add(sat.Config.Console.EmailChange.VerificationExpiration)
+				service.SetNow(func() time.Time { return now })
+				defer service.SetNow(time.Now)
+
+				_, err = service.VerifyEmailChange(ctx, change.Secret.String())
+				require.True(t, console.ErrTokenExpiration.Has(err))
 			})
 
 			t.Run("GetAllBucketNames", func(t *testing.T) {
@@ -274,6 +356,66 @@ func TestService(t *testing.T) {
 				require.Nil(t, bucketsForUnauthorizedUser)
 			})
 
+			t.Run("GetHourlyProjectUsage", func(t *testing.T) {
+				now := time.Now().UTC()
+				service.SetNow(func() time.Time { return now })
+				defer service.SetNow(time.Now)
+
+				projectID := up1Pro1.ID
+				bucket := metabase.BucketLocation{ProjectID: projectID, BucketName: "hourly"}
+				tallies := map[metabase.BucketLocation]*accounting.BucketTally{
+					bucket: {BucketLocation: bucket, TotalBytes: 100},
+				}
+
+				twoHoursAgo := now.Add(-2 * time.Hour)
+				// older than the hourly usage window, must be ignored.
+				err := sat.DB.ProjectAccounting().SaveTallies(ctx, now.Add(-100*time.Hour), tallies)
+				require.NoError(t, err)
+				err = sat.DB.ProjectAccounting().SaveTallies(ctx, twoHoursAgo, tallies)
+				require.NoError(t, err)
+				err = sat.DB.Orders().UpdateBucketBandwidthAllocation(ctx, projectID, []byte(bucket.BucketName), pb.PieceAction_GET, 200, twoHoursAgo)
+				require.NoError(t, err)
+
+				projectUsage := sat.API.Accounting.ProjectUsage
+				require.NoError(t, projectUsage.AddProjectStorageUsage(ctx, projectID, 300))
+				require.NoError(t, projectUsage.UpdateProjectBandwidthUsage(ctx, projectID, 1000))
+
+				liveBandwidth, err := projectUsage.GetProjectBandwidthUsage(ctx, projectID)
+				require.NoError(t, err)
+				flushedBandwidth, err := projectUsage.GetProjectBandwidthTotals(ctx, projectID)
+				require.NoError(t, err)
+
+				usage, err := service.GetHourlyProjectUsage(userCtx1, projectID, now.Add(-100*time.Hour), now.Add(time.Hour))
+				require.NoError(t, err)
+
+				require.Equal(t, []accounting.ProjectUsageByHour{
+					{Hour: twoHoursAgo.Truncate(time.Hour), Value: 100},
+					{Hour: now.Truncate(time.Hour), Value: 300},
+				}, usage.StorageUsage)
+				// unflushed bandwidth isn't attributed to the current hour.
+				require.Equal(t, []accounting.ProjectUsageByHour{
+					{Hour: twoHoursAgo.Truncate(time.Hour), Value: 200},
+				}, usage.AllocatedBandwidthUsage)
+				require.Equal(t, liveBandwidth-flushedBandwidth, usage.UnflushedAllocatedBandwidth)
+				require.Positive(t, usage.UnflushedAllocatedBandwidth)
+
+				// a period in the past isn't completed from live accounting.
+				usage, err = service.GetHourlyProjectUsage(userCtx1, projectID, twoHoursAgo, twoHoursAgo)
+				require.NoError(t, err)
+				require.Len(t, usage.StorageUsage, 1)
+				require.Len(t, usage.AllocatedBandwidthUsage, 1)
+				require.Zero(t, usage.UnflushedAllocatedBandwidth)
+
+				// from after to should fail validation.
+				_, err = service.GetHourlyProjectUsage(userCtx1, projectID, now, now.Add(-time.Hour))
+				require.True(t, console.ErrValidation.Has(err))
+
+				// getting someone else's project usage should not work.
+				usage, err = service.GetHourlyProjectUsage(userCtx2, projectID, twoHoursAgo, now)
+				require.Error(t, err)
+				require.Nil(t, usage)
+			})
+
 			t.Run("DeleteAPIKeyByNameAndProjectID", func(t *testing.T) {
 				secret, err := macaroon.NewSecret()
 				require.NoError(t, err)
@@ -733,6 +875,98 @@ func TestResetPassword(t *testing.T) {
 	})
 }
 
+func TestActivateAccount(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		config := sat.Config.Console.Activation
+
+		regToken, err := service.CreateRegToken(ctx, 1)
+		require.NoError(t, err)
+
+		user, err := service.CreateUser(ctx, console.CreateUser{
+			FullName: "Test User",
+			Email:    "test@mail.test",
+			Password: "123a123",
+		}, regToken.Secret)
+		require.NoError(t, err)
+
+		now := time.Now()
+		service.SetNow(func() time.Time { return now })
+
+		token, err := service.GenerateActivationToken(ctx, user.ID, user.Email)
+		require.NoError(t, err)
+
+		// Expect only the hash of the token to be stored.
+		secret, err := console.ActivationSecretFromBase64(token)
+		require.NoError(t, err)
+		activations, err := sat.DB.Console().ActivationTokens().ListByUserID(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, activations, 1)
+		require.Equal(t, secret.Hash(), activations[0].SecretHash)
+		require.NotEqual(t, secret[:], activations[0].SecretHash[:])
+
+		// Expect resending to be throttled during the cooldown.
+		_, err = service.ResendActivationToken(ctx, user.ID, user.Email)
+		require.True(t, console.ErrActivationThrottled.Has(err))
+
+		service.SetNow(func() time.Time { return now.Add(config.ResendCooldown) })
+		resent, err := service.ResendActivationToken(ctx, user.ID, user.Email)
+		require.NoError(t, err)
+		require.NotEqual(t, token, resent)
+
+		// Expect error when providing bad token.
+		_, err = service.ActivateAccount(ctx, "badToken")
+		require.True(t, console.ErrTokenInvalid.Has(err))
+
+		// Expect error when providing good but expired token.
+		service.SetNow(func() time.Time { return now.Add(config.TokenExpiration) })
+		_, err = service.ActivateAccount(ctx, token)
+		require.True(t, console.ErrTokenExpiration.Has(err))
+
+		// Expect success when providing good token.
+		service.SetNow(time.Now)
+		activated, err := service.ActivateAccount(ctx, resent)
+		require.NoError(t, err)
+		require.Equal(t, console.Active, activated.Status)
+
+		// Expect the tokens to be single-use, but still resolve to the
+		// activated account.
+		_, err = service.ActivateAccount(ctx, resent)
+		require.True(t, console.ErrEmailUsed.Has(err))
+
+		activations, err = sat.DB.Console().ActivationTokens().ListByUserID(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, activations, 2)
+		for _, activation := range activations {
+			require.True(t, activation.Used())
+		}
+
+		// Expect signed tokens from older activation links to be accepted until they expire.
+		legacyUser, err := service.CreateUser(ctx, console.CreateUser{
+			FullName: "Legacy User",
+			Email:    "legacy@mail.test",
+			Password: "123a123",
+		}, regToken.Secret)
+		require.NoError(t, err)
+
+		legacyToken, err := sat.API.Console.AuthTokens.CreateToken(ctx, legacyUser.ID, legacyUser.Email)
+		require.NoError(t, err)
+
+		service.SetNow(func() time.Time { return time.Now().Add(sat.Config.ConsoleAuth.TokenExpirationTime + time.Minute) })
+		_, err = service.ActivateAccount(ctx, legacyToken)
+		require.True(t, console.ErrTokenExpiration.Has(err))
+
+		service.SetNow(time.Now)
+		activated, err = service.ActivateAccount(ctx, legacyToken)
+		require.NoError(t, err)
+		require.Equal(t, legacyUser.ID, activated.ID)
+		require.Equal(t, console.Active, activated.Status)
+	})
+}
+
 func TestRESTKeys(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
@@ -0,0 +1,125 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// SignupReviews exposes methods to manage the review queue of the signups
+// which were flagged by the signup throttles.
+//
+// architecture: Database
+type SignupReviews interface {
+	// Insert adds the signup of a user to the review queue.
+	Insert(ctx context.Context, review SignupReview) error
+	// Get returns the review of the signup of the user.
+	Get(ctx context.Context, userID uuid.UUID) (*SignupReview, error)
+	// ListPending returns the oldest signups which weren't reviewed yet.
+	ListPending(ctx context.Context, limit int) ([]SignupReview, error)
+	// Review marks the signup of the user as reviewed.
+	Review(ctx context.Context, userID uuid.UUID, approved bool, reviewedAt time.Time) error
+}
+
+// SignupReview is a signup which was flagged for review.
+type SignupReview struct {
+	UserID     uuid.UUID  `json:"userId"`
+	Email      string     `json:"email"`
+	Reason     string     `json:"reason"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ReviewedAt *time.Time `json:"reviewedAt"`
+	Approved   bool       `json:"approved"`
+}
+
+// Pending returns whether the signup wasn't reviewed yet.
+func (review *SignupReview) Pending() bool {
+	return review.ReviewedAt == nil
+}
+
+// SignupThrottleConfig contains configurations for the signup throttles.
+type SignupThrottleConfig struct {
+	Enabled           bool          `help:"whether signups exceeding the throttles are flagged for review. signups with a registration token are never flagged" default:"false"`
+	Window            time.Duration `help:"the period during which the signups are counted for the throttles" default:"24h"`
+	MaxPerIP          int           `help:"how many signups from the same IP address are allowed within the window before signups are flagged (0 to disable)" default:"5"`
+	MaxPerEmailDomain int           `help:"how many signups with the same email domain are allowed within the window before signups are flagged (0 to disable)" default:"0"`
+}
+
+// signupThrottle counts the recent signups per IP address and email domain.
+//
+// The counts are kept in memory, so every console server throttles the
+// signups it receives on its own.
+type signupThrottle struct {
+	config SignupThrottleConfig
+
+	mu        sync.Mutex
+	signups   map[string][]time.Time
+	lastPrune time.Time
+}
+
+func newSignupThrottle(config SignupThrottleConfig) *signupThrottle {
+	return &signupThrottle{
+		config:  config,
+		signups: map[string][]time.Time{},
+	}
+}
+
+// Check records the signup and returns the reasons why it exceeds the
+// throttles, if any.
+func (throttle *signupThrottle) Check(ip, email string, now time.Time) (reasons []string) {
+	throttle.mu.Lock()
+	defer throttle.mu.Unlock()
+
+	if now.Sub(throttle.lastPrune) > throttle.config.Window {
+		throttle.prune(now)
+	}
+
+	if throttle.config.MaxPerIP > 0 && ip != "" {
+		if throttle.record("ip:"+ip, now) > throttle.config.MaxPerIP {
+			reasons = append(reasons, "too many signups from IP address "+ip)
+		}
+	}
+
+	if throttle.config.MaxPerEmailDomain > 0 {
+		if at := strings.LastIndexByte(email, '@'); at >= 0 {
+			domain := strings.ToLower(email[at+1:])
+			if throttle.record("domain:"+domain, now) > throttle.config.MaxPerEmailDomain {
+				reasons = append(reasons, "too many signups with email domain "+domain)
+			}
+		}
+	}
+
+	return reasons
+}
+
+// record records a signup for the key and returns the number of signups of
+// the key within the window.
+func (throttle *signupThrottle) record(key string, now time.Time) int {
+	signups := append(throttle.recent(throttle.signups[key], now), now)
+	throttle.signups[key] = signups
+	return len(signups)
+}
+
+// recent returns the signups which are within the window.
+func (throttle *signupThrottle) recent(signups []time.Time, now time.Time) []time.Time {
+	cutoff := now.Add(-throttle.config.Window)
+	for len(signups) > 0 && !signups[0].After(cutoff) {
+		signups = signups[1:]
+	}
+	return signups
+}
+
+// prune removes the keys without signups within the window.
+func (throttle *signupThrottle) prune(now time.Time) {
+	for key, signups := range throttle.signups {
+		if len(throttle.recent(signups, now)) == 0 {
+			delete(throttle.signups, key)
+		}
+	}
+	throttle.lastPrune = now
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestSignupThrottle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.SignupThrottle.Enabled = true
+				config.Console.SignupThrottle.Window = time.Hour
+				config.Console.SignupThrottle.MaxPerIP = 1
+				config.Console.UsageLimits.Project.Free = 1
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		createUser := func(email string) *console.User {
+			user, err := service.CreateUser(ctx, console.CreateUser{
+				FullName: "Test User",
+				Email:    email,
+				Password: "123a123",
+				IP:       "127.0.0.1",
+			}, console.RegistrationSecret{})
+			require.NoError(t, err)
+			return user
+		}
+
+		allowed := createUser("allowed@mail.test")
+		require.Equal(t, 1, allowed.ProjectLimit)
+		_, err := sat.DB.Console().SignupReviews().Get(ctx, allowed.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		flagged := createUser("flagged@mail.test")
+		require.Equal(t, 0, flagged.ProjectLimit)
+
+		review, err := sat.DB.Console().SignupReviews().Get(ctx, flagged.ID)
+		require.NoError(t, err)
+		require.True(t, review.Pending())
+		require.Equal(t, "flagged@mail.test", review.Email)
+
+		userCtx, err := sat.UserContext(ctx, flagged.ID)
+		require.NoError(t, err)
+
+		_, err = service.CreateProject(userCtx, console.ProjectInfo{Name: "test"})
+		require.True(t, console.ErrProjLimit.Has(err))
+		require.Contains(t, err.Error(), "being reviewed")
+	})
+}
+
+func TestSignupReviews(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		reviews := db.Console().SignupReviews()
+
+		user, err := db.Console().Users().Insert(ctx, &console.User{
+			ID:           testrand.UUID(),
+			FullName:     "Test User",
+			Email:        "flagged@mail.test",
+			PasswordHash: []byte("123a123"),
+		})
+		require.NoError(t, err)
+
+		_, err = reviews.Get(ctx, user.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		require.NoError(t, reviews.Insert(ctx, console.SignupReview{
+			UserID:    user.ID,
+			Reason:    "too many signups from IP address 127.0.0.1",
+			CreatedAt: now,
+		}))
+		require.NoError(t, reviews.Insert(ctx, console.SignupReview{
+			UserID:    testrand.UUID(),
+			Reason:    "too many signups with email domain mail.test",
+			CreatedAt: now.Add(time.Minute),
+		}))
+
+		pending, err := reviews.ListPending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		require.Equal(t, user.ID, pending[0].UserID)
+		require.Equal(t, user.Email, pending[0].Email)
+		require.Empty(t, pending[1].Email)
+
+		pending, err = reviews.ListPending(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+
+		require.NoError(t, reviews.Review(ctx, user.ID, true, now.Add(time.Hour)))
+		require.ErrorIs(t, reviews.Review(ctx, testrand.UUID(), false, now), sql.ErrNoRows)
+
+		review, err := reviews.Get(ctx, user.ID)
+		require.NoError(t, err)
+		require.False(t, review.Pending())
+		require.True(t, review.Approved)
+		require.True(t, review.ReviewedAt.Equal(now.Add(time.Hour)))
+
+		pending, err = reviews.ListPending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.NotEqual(t, user.ID, pending[0].UserID)
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// UsageAnomalySettings exposes methods to manage the settings of the usage
+// anomaly detection of projects.
+//
+// architecture: Database
+type UsageAnomalySettings interface {
+	// Get returns the settings of the project. The default settings are
+	// returned when they haven't been changed for the project.
+	Get(ctx context.Context, projectID uuid.UUID) (UsageAnomalySetting, error)
+	// UpdateSensitivity replaces the sensitivity of the detection for the project.
+	UpdateSensitivity(ctx context.Context, projectID uuid.UUID, sensitivity UsageAnomalySensitivity) error
+	// UpdateLastNotified records when the owner of the project was last
+	// notified about a usage anomaly.
+	UpdateLastNotified(ctx context.Context, projectID uuid.UUID, notifiedAt time.Time) error
+}
+
+// UsageAnomalySensitivity is how much the daily usage of a project may exceed
+// its usual usage before the owner of the project is notified.
+type UsageAnomalySensitivity string
+
+const (
+	// UsageAnomalySensitivityOff disables the notifications about usage anomalies.
+	UsageAnomalySensitivityOff UsageAnomalySensitivity = "off"
+	// UsageAnomalySensitivityLow notifies only about a usage 10 times the usual.
+	UsageAnomalySensitivityLow UsageAnomalySensitivity = "low"
+	// UsageAnomalySensitivityMedium notifies about a usage 5 times the usual.
+	UsageAnomalySensitivityMedium UsageAnomalySensitivity = "medium"
+	// UsageAnomalySensitivityHigh notifies about a usage 3 times the usual.
+	UsageAnomalySensitivityHigh UsageAnomalySensitivity = "high"
+
+	// DefaultUsageAnomalySensitivity is the sensitivity of the projects,
+	// whose sensitivity hasn't been changed.
+	DefaultUsageAnomalySensitivity = UsageAnomalySensitivityMedium
+)
+
+// Factor returns how many times the usual usage the daily usage must be to be
+// an anomaly. It returns 0 when the notifications are disabled.
+func (sensitivity UsageAnomalySensitivity) Factor() float64 {
+	switch sensitivity {
+	case UsageAnomalySensitivityLow:
+		return 10
+	case UsageAnomalySensitivityMedium:
+		return 5
+	case UsageAnomalySensitivityHigh:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Valid returns true when the sensitivity is known.
+func (sensitivity UsageAnomalySensitivity) Valid() bool {
+	switch sensitivity {
+	case UsageAnomalySensitivityOff, UsageAnomalySensitivityLow, UsageAnomalySensitivityMedium, UsageAnomalySensitivityHigh:
+		return true
+	default:
+		return false
+	}
+}
+
+// UsageAnomalySetting is the setting of the usage anomaly detection of a project.
+type UsageAnomalySetting struct {
+	ProjectID   uuid.UUID               `json:"projectId"`
+	Sensitivity UsageAnomalySensitivity `json:"sensitivity"`
+	// LastNotifiedAt is when the owner of the project was last notified about
+	// a usage anomaly, nil when the owner has never been notified.
+	LastNotifiedAt *time.Time `json:"lastNotifiedAt"`
+}
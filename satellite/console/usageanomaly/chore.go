@@ -0,0 +1,188 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usageanomaly
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/sync2"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/mailservice"
+)
+
+var (
+	// Error is the standard error class for usage anomalies.
+	Error = errs.Class("usage anomaly")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the usage anomaly chore.
+type Config struct {
+	Enabled              bool          `help:"whether project owners are emailed about the usage of their projects far above the usual usage" releaseDefault:"false" devDefault:"true"`
+	Interval             time.Duration `help:"how often the daily usage of the projects is checked" default:"24h"`
+	BaselineDays         int           `help:"number of days before the checked day, whose average daily usage is the usual usage of a project" default:"14"`
+	MinimumEgress        memory.Size   `help:"daily egress, below which the egress is never an anomaly" default:"10GB"`
+	MinimumStorageGrowth memory.Size   `help:"daily storage growth, below which the growth is never an anomaly" default:"10GB"`
+	NotificationCooldown time.Duration `help:"minimum time between two emails about the usage anomalies of the same project" default:"72h"`
+	ListLimit            int           `help:"how many projects are checked in a batch" default:"100"`
+	AsOfSystemInterval   time.Duration `help:"as of system interval of the usage queries" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+}
+
+// Chore checks the daily usage of the projects for a usage far above the
+// usual usage of the project, e.g. because of leaked credentials, and emails
+// the owners of the projects about it.
+//
+// architecture: Chore
+type Chore struct {
+	log  *zap.Logger
+	Loop *sync2.Cycle
+
+	projects          console.Projects
+	users             console.Users
+	settings          console.UsageAnomalySettings
+	projectAccounting accounting.ProjectAccounting
+	mailService       *mailservice.Service
+	config            Config
+	satelliteAddress  string
+
+	nowFn func() time.Time
+}
+
+// NewChore instantiates Chore.
+func NewChore(log *zap.Logger, consoleDB console.DB, projectAccounting accounting.ProjectAccounting, mailService *mailservice.Service, config Config, satelliteAddress string) *Chore {
+	return &Chore{
+		log:               log,
+		Loop:              sync2.NewCycle(config.Interval),
+		projects:          consoleDB.Projects(),
+		users:             consoleDB.Users(),
+		settings:          consoleDB.UsageAnomalySettings(),
+		projectAccounting: projectAccounting,
+		mailService:       mailService,
+		config:            config,
+		satelliteAddress:  satelliteAddress,
+		nowFn:             time.Now,
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.CheckProjects(ctx); err != nil {
+			chore.log.Error("error checking usage anomalies", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// CheckProjects checks the usage of the projects on the previous day and
+// emails the owners of the projects with usage anomalies.
+func (chore *Chore) CheckProjects(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn()
+	day := beginningOfDay(now).AddDate(0, 0, -1)
+	// the projects without a whole baseline don't have a usual usage yet.
+	baselineStart := day.AddDate(0, 0, -chore.config.BaselineDays)
+
+	var offset int64
+	for {
+		page, err := chore.projects.List(ctx, offset, chore.config.ListLimit, baselineStart)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, project := range page.Projects {
+			if err := chore.checkProject(ctx, project, day, now); err != nil {
+				chore.log.Error("error checking usage anomalies of project",
+					zap.Stringer("Project ID", project.ID),
+					zap.Error(err))
+			}
+		}
+
+		if !page.Next {
+			return nil
+		}
+		offset = page.NextOffset
+	}
+}
+
+// checkProject checks the usage of the project on day and emails the owner of
+// the project when the usage is an anomaly.
+func (chore *Chore) checkProject(ctx context.Context, project console.Project, day, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	setting, err := chore.settings.Get(ctx, project.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	factor := setting.Sensitivity.Factor()
+	if factor <= 0 {
+		return nil
+	}
+	if setting.LastNotifiedAt != nil && now.Sub(*setting.LastNotifiedAt) < chore.config.NotificationCooldown {
+		return nil
+	}
+
+	// the storage growth of the first baseline day needs the day before it.
+	from := day.AddDate(0, 0, -chore.config.BaselineDays-1)
+	usage, err := chore.projectAccounting.GetProjectDailyUsageByDateRange(ctx, project.ID, from, day, chore.config.AsOfSystemInterval)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	anomalies := chore.config.Detect(usage, day, factor)
+	if len(anomalies) == 0 {
+		return nil
+	}
+	for _, anomaly := range anomalies {
+		mon.Meter("usage_anomalies", monkit.NewSeriesTag("kind", string(anomaly.Kind))).Mark(1)
+	}
+
+	owner, err := chore.users.Get(ctx, project.OwnerID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	userName := owner.ShortName
+	if userName == "" {
+		userName = owner.FullName
+	}
+
+	err = chore.mailService.SendRendered(ctx,
+		[]post.Address{{Address: owner.Email, Name: userName}},
+		&UsageAnomalyEmail{
+			UserName:         userName,
+			ProjectName:      project.Name,
+			Day:              day.Format("2006-01-02"),
+			Anomalies:        anomalies,
+			SatelliteAddress: chore.satelliteAddress,
+		},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	mon.Meter("usage_anomaly_emails_sent").Mark(1)
+
+	return Error.Wrap(chore.settings.UpdateLastNotified(ctx, project.ID, now))
+}
+
+// Close closes chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestSetNow allows tests to have the chore act as if the current time is t.
+func (chore *Chore) TestSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
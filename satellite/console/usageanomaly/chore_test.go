@@ -0,0 +1,79 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usageanomaly_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.UsageAnomaly.Enabled = true
+				config.UsageAnomaly.BaselineDays = 7
+				config.UsageAnomaly.MinimumEgress = memory.GB
+				config.UsageAnomaly.NotificationCooldown = 24 * time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		chore := sat.Core.Mail.UsageAnomaly
+		chore.Loop.Pause()
+
+		settings := sat.DB.Console().UsageAnomalySettings()
+		projectID := planet.Uplinks[0].Projects[0].ID
+		bucketName := []byte("testbucket")
+
+		// the project needs a whole baseline before its usage is checked.
+		now := time.Now().AddDate(0, 0, 10)
+		chore.TestSetNow(func() time.Time { return now })
+		day := time.Date(now.Year(), now.Month(), now.Day(), 12, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+
+		for i := 1; i <= 7; i++ {
+			err := sat.DB.Orders().UpdateBucketBandwidthAllocation(ctx, projectID, bucketName, pb.PieceAction_GET, memory.MB.Int64(), day.AddDate(0, 0, -i))
+			require.NoError(t, err)
+		}
+		err := sat.DB.Orders().UpdateBucketBandwidthAllocation(ctx, projectID, bucketName, pb.PieceAction_GET, 2*memory.GB.Int64(), day)
+		require.NoError(t, err)
+
+		// the owner isn't notified, when the notifications are disabled.
+		require.NoError(t, settings.UpdateSensitivity(ctx, projectID, console.UsageAnomalySensitivityOff))
+		require.NoError(t, chore.CheckProjects(ctx))
+
+		setting, err := settings.Get(ctx, projectID)
+		require.NoError(t, err)
+		require.Nil(t, setting.LastNotifiedAt)
+
+		require.NoError(t, settings.UpdateSensitivity(ctx, projectID, console.UsageAnomalySensitivityHigh))
+		require.NoError(t, chore.CheckProjects(ctx))
+
+		setting, err = settings.Get(ctx, projectID)
+		require.NoError(t, err)
+		require.Equal(t, console.UsageAnomalySensitivityHigh, setting.Sensitivity)
+		require.NotNil(t, setting.LastNotifiedAt)
+		require.WithinDuration(t, now, *setting.LastNotifiedAt, time.Second)
+
+		// the owner isn't notified again before the cooldown.
+		notifiedAt := now
+		now = now.Add(time.Hour)
+		require.NoError(t, chore.CheckProjects(ctx))
+
+		setting, err = settings.Get(ctx, projectID)
+		require.NoError(t, err)
+		require.WithinDuration(t, notifiedAt, *setting.LastNotifiedAt, time.Second)
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usageanomaly
+
+import (
+	"fmt"
+	"time"
+
+	"storj.io/common/memory"
+	"storj.io/storj/satellite/accounting"
+)
+
+// Kind is the kind of usage, which is far above the usual usage.
+type Kind string
+
+const (
+	// KindEgress is a daily egress far above the usual daily egress.
+	KindEgress Kind = "egress"
+	// KindStorageGrowth is a daily storage growth far above the usual daily
+	// storage growth.
+	KindStorageGrowth Kind = "storage growth"
+)
+
+// Anomaly is a daily usage of a project far above its usual daily usage.
+type Anomaly struct {
+	Kind Kind
+	// Usage is the usage of the checked day.
+	Usage int64
+	// Baseline is the average daily usage of the days before the checked day.
+	Baseline int64
+}
+
+// Description describes the anomaly for the owner of the project.
+func (anomaly Anomaly) Description() string {
+	return fmt.Sprintf("%s of %s, while it's usually %s per day",
+		anomaly.Kind, memory.Size(anomaly.Usage).Base10String(), memory.Size(anomaly.Baseline).Base10String())
+}
+
+// Detect returns the anomalies of the daily usage of the project on day.
+// The usage of day is an anomaly, when it exceeds both the minimum of the
+// config and factor times the average daily usage of the baseline days before
+// day. usage must contain the baseline days and, for the storage growth, the
+// day before them.
+func (config Config) Detect(usage *accounting.ProjectDailyUsage, day time.Time, factor float64) (anomalies []Anomaly) {
+	if factor <= 0 || config.BaselineDays <= 0 {
+		return nil
+	}
+	day = beginningOfDay(day)
+
+	egress := dailyValues(usage.AllocatedBandwidthUsage)
+	var egressBaseline int64
+	for i := 1; i <= config.BaselineDays; i++ {
+		egressBaseline += egress[day.AddDate(0, 0, -i)]
+	}
+	egressBaseline /= int64(config.BaselineDays)
+
+	if exceeds(egress[day], egressBaseline, config.MinimumEgress.Int64(), factor) {
+		anomalies = append(anomalies, Anomaly{
+			Kind:     KindEgress,
+			Usage:    egress[day],
+			Baseline: egressBaseline,
+		})
+	}
+
+	storage := dailyValues(usage.StorageUsage)
+	growth := func(day time.Time) (int64, bool) {
+		current, ok := storage[day]
+		if !ok {
+			return 0, false
+		}
+		previous, ok := storage[day.AddDate(0, 0, -1)]
+		if !ok {
+			return 0, false
+		}
+		return current - previous, true
+	}
+
+	dayGrowth, ok := growth(day)
+	if !ok {
+		return anomalies
+	}
+
+	// the days without tallies are skipped, and the deletions don't lower the
+	// baseline of the growth.
+	var growthTotal, growthDays int64
+	for i := 1; i <= config.BaselineDays; i++ {
+		g, ok := growth(day.AddDate(0, 0, -i))
+		if !ok {
+			continue
+		}
+		if g > 0 {
+			growthTotal += g
+		}
+		growthDays++
+	}
+	if growthDays == 0 {
+		// without history the growth can't be compared.
+		return anomalies
+	}
+	growthBaseline := growthTotal / growthDays
+
+	if exceeds(dayGrowth, growthBaseline, config.MinimumStorageGrowth.Int64(), factor) {
+		anomalies = append(anomalies, Anomaly{
+			Kind:     KindStorageGrowth,
+			Usage:    dayGrowth,
+			Baseline: growthBaseline,
+		})
+	}
+
+	return anomalies
+}
+
+// exceeds returns true when usage is at least minimum and more than factor
+// times baseline.
+func exceeds(usage, baseline, minimum int64, factor float64) bool {
+	return usage >= minimum && float64(usage) > factor*float64(baseline)
+}
+
+// dailyValues sums the values of the same day.
+func dailyValues(usage []accounting.ProjectUsageByDay) map[time.Time]int64 {
+	values := make(map[time.Time]int64, len(usage))
+	for _, u := range usage {
+		values[beginningOfDay(u.Date)] += u.Value
+	}
+	return values
+}
+
+// beginningOfDay returns the beginning of the UTC day of t.
+func beginningOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
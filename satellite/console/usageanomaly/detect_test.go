@@ -0,0 +1,103 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usageanomaly_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console/usageanomaly"
+)
+
+func TestDetect(t *testing.T) {
+	config := usageanomaly.Config{
+		BaselineDays:         7,
+		MinimumEgress:        10 * memory.GB,
+		MinimumStorageGrowth: 10 * memory.GB,
+	}
+	day := time.Date(2022, time.August, 15, 0, 0, 0, 0, time.UTC)
+
+	// dailyUsage returns the usage of the baseline days, the day before them
+	// and the checked day.
+	dailyUsage := func(baseline, checked int64) []accounting.ProjectUsageByDay {
+		var usage []accounting.ProjectUsageByDay
+		for i := config.BaselineDays + 1; i >= 1; i-- {
+			usage = append(usage, accounting.ProjectUsageByDay{Date: day.AddDate(0, 0, -i), Value: baseline})
+		}
+		return append(usage, accounting.ProjectUsageByDay{Date: day, Value: checked})
+	}
+	// growingStorage returns the storage growing by baseline every baseline
+	// day and by checked on the checked day.
+	growingStorage := func(baseline, checked int64) []accounting.ProjectUsageByDay {
+		var usage []accounting.ProjectUsageByDay
+		var total int64
+		for i := config.BaselineDays + 1; i >= 1; i-- {
+			total += baseline
+			usage = append(usage, accounting.ProjectUsageByDay{Date: day.AddDate(0, 0, -i), Value: total})
+		}
+		return append(usage, accounting.ProjectUsageByDay{Date: day, Value: total + checked})
+	}
+
+	t.Run("usual usage", func(t *testing.T) {
+		usage := &accounting.ProjectDailyUsage{
+			AllocatedBandwidthUsage: dailyUsage(20e9, 40e9),
+			StorageUsage:            growingStorage(20e9, 40e9),
+		}
+		require.Empty(t, config.Detect(usage, day, 5))
+	})
+
+	t.Run("egress spike", func(t *testing.T) {
+		usage := &accounting.ProjectDailyUsage{
+			AllocatedBandwidthUsage: dailyUsage(10e9, 60e9),
+		}
+		require.Equal(t, []usageanomaly.Anomaly{
+			{Kind: usageanomaly.KindEgress, Usage: 60e9, Baseline: 10e9},
+		}, config.Detect(usage, day, 5))
+
+		// a lower sensitivity tolerates the spike.
+		require.Empty(t, config.Detect(usage, day, 10))
+		// the notifications are disabled.
+		require.Empty(t, config.Detect(usage, day, 0))
+	})
+
+	t.Run("egress below minimum", func(t *testing.T) {
+		usage := &accounting.ProjectDailyUsage{
+			AllocatedBandwidthUsage: dailyUsage(0, 5e9),
+		}
+		require.Empty(t, config.Detect(usage, day, 5))
+	})
+
+	t.Run("storage growth spike", func(t *testing.T) {
+		usage := &accounting.ProjectDailyUsage{
+			StorageUsage: growingStorage(1e9, 50e9),
+		}
+		require.Equal(t, []usageanomaly.Anomaly{
+			{Kind: usageanomaly.KindStorageGrowth, Usage: 50e9, Baseline: 1e9},
+		}, config.Detect(usage, day, 5))
+	})
+
+	t.Run("storage without history", func(t *testing.T) {
+		usage := &accounting.ProjectDailyUsage{
+			StorageUsage: []accounting.ProjectUsageByDay{
+				{Date: day.AddDate(0, 0, -1), Value: 0},
+				{Date: day, Value: 100e9},
+			},
+		}
+		require.Empty(t, config.Detect(usage, day, 5))
+	})
+
+	t.Run("deletions don't lower the baseline", func(t *testing.T) {
+		storage := growingStorage(10e9, 40e9)
+		// a deletion on a baseline day.
+		for i := 3; i < len(storage); i++ {
+			storage[i].Value -= 50e9
+		}
+		usage := &accounting.ProjectDailyUsage{StorageUsage: storage}
+		require.Empty(t, config.Detect(usage, day, 5))
+	})
+}
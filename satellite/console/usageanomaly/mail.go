@@ -0,0 +1,22 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usageanomaly
+
+// UsageAnomalyEmail is the mailservice template of the emails about the usage
+// anomalies of a project.
+type UsageAnomalyEmail struct {
+	UserName         string
+	ProjectName      string
+	Day              string
+	Anomalies        []Anomaly
+	SatelliteAddress string
+}
+
+// Template returns email template name.
+func (*UsageAnomalyEmail) Template() string { return "UsageAnomaly" }
+
+// Subject gets email subject.
+func (email *UsageAnomalyEmail) Subject() string {
+	return "Unusual usage of your project " + email.ProjectName
+}
@@ -6,6 +6,7 @@ package contact
 import (
 	"context"
 	"fmt"
+	"net"
 	"sync"
 	"time"
 
@@ -75,6 +76,11 @@ func (service *Service) Local() overlay.NodeDossier {
 // Close closes resources.
 func (service *Service) Close() error { return nil }
 
+// isIPAllowed returns whether the node may be reached at the IP address.
+func (service *Service) isIPAllowed(ip net.IP) bool {
+	return service.allowPrivateIP || (ip.IsGlobalUnicast() && !isPrivateIP(ip))
+}
+
 // PingBack pings the node to test connectivity.
 func (service *Service) PingBack(ctx context.Context, nodeurl storj.NodeURL) (_ bool, _ bool, _ string, err error) {
 	defer mon.Task()(&ctx)(&err)
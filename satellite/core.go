@@ -25,6 +25,7 @@ import (
 	"storj.io/storj/private/lifecycle"
 	version_checker "storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/live"
 	"storj.io/storj/satellite/accounting/nodetally"
 	"storj.io/storj/satellite/accounting/projectbwcleanup"
 	"storj.io/storj/satellite/accounting/rollup"
@@ -32,15 +33,21 @@ import (
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/buckets"
-	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/emailreminders"
+	"storj.io/storj/satellite/console/projectpurge"
+	"storj.io/storj/satellite/console/usageanomaly"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/bucketlifecycle"
 	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/metabase/trashdeletion"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
+	"storj.io/storj/satellite/metainfo/piecedeletion"
+	"storj.io/storj/satellite/metainfo/securitylog"
 	"storj.io/storj/satellite/metrics"
+	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/overlay/straynodes"
@@ -48,7 +55,11 @@ import (
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/queuemonitor"
 	"storj.io/storj/satellite/reputation"
+	"storj.io/storj/satellite/scheduler"
+	"storj.io/storj/satellite/segmentstats"
+	"storj.io/storj/satellite/tuning"
 )
 
 // Core is the satellite core process that runs chores.
@@ -65,6 +76,8 @@ type Core struct {
 
 	Dialer rpc.Dialer
 
+	Scheduler *scheduler.Scheduler
+
 	Version struct {
 		Chore   *version_checker.Chore
 		Service *version_checker.Service
@@ -73,6 +86,8 @@ type Core struct {
 	Mail struct {
 		Service        *mailservice.Service
 		EmailReminders *emailreminders.Chore
+		NodeEvents     *nodeevents.Chore
+		UsageAnomaly   *usageanomaly.Chore
 	}
 
 	Debug struct {
@@ -80,6 +95,11 @@ type Core struct {
 		Server   *debug.Server
 	}
 
+	Tuning struct {
+		Listener net.Listener
+		Service  *tuning.Service
+	}
+
 	// services and endpoints
 	Overlay struct {
 		DB           overlay.DB
@@ -103,7 +123,8 @@ type Core struct {
 	}
 
 	Repair struct {
-		Checker *checker.Checker
+		Checker      *checker.Checker
+		QueueMonitor *queuemonitor.Monitor
 	}
 
 	Audit struct {
@@ -115,13 +136,30 @@ type Core struct {
 	}
 
 	ExpiredDeletion struct {
-		Chore *expireddeletion.Chore
+		Chore         *expireddeletion.Chore
+		PieceDeletion *piecedeletion.Service
 	}
 
 	ZombieDeletion struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	BucketLifecycle struct {
+		Chore *bucketlifecycle.Chore
+	}
+
+	TrashDeletion struct {
+		Chore *trashdeletion.Chore
+	}
+
+	SecurityLog struct {
+		Chore *securitylog.Chore
+	}
+
+	ProjectPurge struct {
+		Chore *projectpurge.Chore
+	}
+
 	Accounting struct {
 		Tally                 *tally.Service
 		NodeTally             *nodetally.Service
@@ -131,7 +169,8 @@ type Core struct {
 	}
 
 	LiveAccounting struct {
-		Cache accounting.Cache
+		Cache     accounting.Cache
+		Persister *live.Persister
 	}
 
 	Payments struct {
@@ -146,7 +185,8 @@ type Core struct {
 	}
 
 	Metrics struct {
-		Chore *metrics.Chore
+		Chore        *metrics.Chore
+		SegmentStats *segmentstats.Chore
 	}
 
 	Buckets struct {
@@ -191,6 +231,22 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	{ // setup tuning
+		if config.Tuning.Address != "" {
+			var err error
+			peer.Tuning.Listener, err = net.Listen("tcp", config.Tuning.Address)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+		}
+		peer.Tuning.Service = tuning.NewService(log.Named("tuning"), peer.Tuning.Listener, config.Tuning)
+		peer.Servers.Add(lifecycle.Item{
+			Name:  "tuning",
+			Run:   peer.Tuning.Service.Run,
+			Close: peer.Tuning.Service.Close,
+		})
+	}
+
 	var err error
 
 	{ // setup version control
@@ -209,6 +265,10 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	{ // setup background task scheduler
+		peer.Scheduler = scheduler.New(peer.Log.Named("scheduler"), config.Scheduler)
+	}
+
 	{ // setup listener and server
 		sc := config.Server
 
@@ -234,14 +294,10 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 
 	{ // setup email reminders
 		if config.EmailReminders.Enable {
-			authTokens := consoleauth.NewService(config.ConsoleAuth, &consoleauth.Hmac{Secret: []byte(config.Console.AuthTokenSecret)})
-			if err != nil {
-				return nil, errs.Combine(err, peer.Close())
-			}
-
 			peer.Mail.EmailReminders = emailreminders.NewChore(
 				peer.Log.Named("console:chore"),
-				authTokens,
+				peer.DB.Console().ActivationTokens(),
+				config.Console.Activation.TokenExpiration,
 				peer.DB.Console().Users(),
 				peer.Mail.Service,
 				config.EmailReminders,
@@ -256,6 +312,43 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		}
 	}
 
+	{ // setup node events
+		if config.NodeEvents.Enabled {
+			peer.Mail.NodeEvents = nodeevents.NewChore(
+				peer.Log.Named("mail:node-events"),
+				peer.DB.NodeEvents(),
+				peer.Mail.Service,
+				config.NodeEvents,
+				config.Contact.ExternalAddress,
+			)
+
+			peer.Services.Add(lifecycle.Item{
+				Name:  "mail:node-events",
+				Run:   peer.Mail.NodeEvents.Run,
+				Close: peer.Mail.NodeEvents.Close,
+			})
+		}
+	}
+
+	{ // setup usage anomaly notifications
+		if config.UsageAnomaly.Enabled {
+			peer.Mail.UsageAnomaly = usageanomaly.NewChore(
+				peer.Log.Named("mail:usage-anomaly"),
+				peer.DB.Console(),
+				peer.DB.ProjectAccounting(),
+				peer.Mail.Service,
+				config.UsageAnomaly,
+				config.Contact.ExternalAddress,
+			)
+
+			peer.Services.Add(lifecycle.Item{
+				Name:  "mail:usage-anomaly",
+				Run:   peer.Mail.UsageAnomaly.Run,
+				Close: peer.Mail.UsageAnomaly.Close,
+			})
+		}
+	}
+
 	{ // setup overlay
 		peer.Overlay.DB = peer.DB.OverlayCache()
 		peer.Overlay.Service, err = overlay.NewService(peer.Log.Named("overlay"), peer.Overlay.DB, config.Overlay)
@@ -326,9 +419,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.Repair.Checker = checker.NewChecker(
 			peer.Log.Named("repair:checker"),
 			peer.DB.RepairQueue(),
+			peer.DB.RemediationQueue(),
 			peer.Metainfo.Metabase,
 			peer.Metainfo.SegmentLoop,
 			peer.Overlay.Service,
+			peer.Scheduler,
 			config.Checker)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "repair:checker",
@@ -337,6 +432,24 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Repair Checker", peer.Repair.Checker.Loop))
+		peer.Tuning.Service.Add(peer.Repair.Checker.TuningSettings(config.Checker.Interval)...)
+
+		var notifier queuemonitor.Notifier
+		if config.RepairQueueMonitor.WebhookURL != "" {
+			notifier = queuemonitor.NewWebhook(config.RepairQueueMonitor)
+		}
+		peer.Repair.QueueMonitor = queuemonitor.NewMonitor(
+			peer.Log.Named("repair:queuemonitor"),
+			peer.DB.RepairQueue(),
+			notifier,
+			config.RepairQueueMonitor)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "repair:queuemonitor",
+			Run:   peer.Repair.QueueMonitor.Run,
+			Close: peer.Repair.QueueMonitor.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Repair Queue Monitor", peer.Repair.QueueMonitor.Loop))
 	}
 
 	{ // setup reputation
@@ -411,6 +524,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.Audit.Chore = audit.NewChore(peer.Log.Named("audit:chore"),
 			peer.Audit.Queues,
 			peer.Metainfo.SegmentLoop,
+			peer.Scheduler,
 			config,
 		)
 		peer.Services.Add(lifecycle.Item{
@@ -423,10 +537,28 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 	}
 
 	{ // setup expired segment cleanup
+		if config.ExpiredDeletion.DeletePieces {
+			peer.ExpiredDeletion.PieceDeletion, err = piecedeletion.NewService(
+				peer.Log.Named("core-expired-deletion:piecedeletion"),
+				peer.Dialer,
+				peer.Overlay.Service.DownloadSelectionCache,
+				config.Metainfo.PieceDeletion,
+			)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Services.Add(lifecycle.Item{
+				Name:  "expireddeletion:piecedeletion",
+				Run:   peer.ExpiredDeletion.PieceDeletion.Run,
+				Close: peer.ExpiredDeletion.PieceDeletion.Close,
+			})
+		}
+
 		peer.ExpiredDeletion.Chore = expireddeletion.NewChore(
 			peer.Log.Named("core-expired-deletion"),
 			config.ExpiredDeletion,
 			peer.Metainfo.Metabase,
+			peer.ExpiredDeletion.PieceDeletion,
 		)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "expireddeletion:chore",
@@ -437,6 +569,21 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Expired Segments Chore", peer.ExpiredDeletion.Chore.Loop))
 	}
 
+	{ // setup security log cleanup
+		peer.SecurityLog.Chore = securitylog.NewChore(
+			peer.Log.Named("core-security-log"),
+			peer.DB.Console().SecurityLogs(),
+			config.SecurityLog,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "securitylog:chore",
+			Run:   peer.SecurityLog.Chore.Run,
+			Close: peer.SecurityLog.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Security Log Chore", peer.SecurityLog.Chore.Loop))
+	}
+
 	{ // setup zombie objects cleanup
 		peer.ZombieDeletion.Chore = zombiedeletion.NewChore(
 			peer.Log.Named("core-zombie-deletion"),
@@ -452,8 +599,39 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Zombie Objects Chore", peer.ZombieDeletion.Chore.Loop))
 	}
 
+	{ // setup trashed objects cleanup
+		peer.TrashDeletion.Chore = trashdeletion.NewChore(
+			peer.Log.Named("core-trash-deletion"),
+			config.TrashDeletion,
+			peer.Metainfo.Metabase,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "trashdeletion:chore",
+			Run:   peer.TrashDeletion.Chore.Run,
+			Close: peer.TrashDeletion.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Trashed Objects Chore", peer.TrashDeletion.Chore.Loop))
+	}
+
+	{ // setup bucket lifecycle rules
+		peer.BucketLifecycle.Chore = bucketlifecycle.NewChore(
+			peer.Log.Named("core-bucket-lifecycle"),
+			config.BucketLifecycle,
+			peer.DB.Buckets(),
+			peer.Metainfo.Metabase,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "bucketlifecycle:chore",
+			Run:   peer.BucketLifecycle.Chore.Run,
+			Close: peer.BucketLifecycle.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Lifecycle Chore", peer.BucketLifecycle.Chore.Loop))
+	}
+
 	{ // setup accounting
-		peer.Accounting.Tally = tally.New(peer.Log.Named("accounting:tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Cache, peer.Metainfo.Metabase, config.Tally)
+		peer.Accounting.Tally = tally.New(peer.Log.Named("accounting:tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Cache, peer.Metainfo.Metabase, peer.Scheduler, config.Tally)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "accounting:tally",
 			Run:   peer.Accounting.Tally.Run,
@@ -461,6 +639,19 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Accounting Tally", peer.Accounting.Tally.Loop))
+		peer.Tuning.Service.Add(
+			tuning.Cycle("tally.interval", peer.Accounting.Tally.Loop, config.Tally.Interval))
+
+		if config.LiveAccounting.PersistInterval > 0 {
+			peer.LiveAccounting.Persister = live.NewPersister(peer.Log.Named("live-accounting:persister"), peer.LiveAccounting.Cache, peer.DB.ProjectAccounting(), config.LiveAccounting.PersistInterval)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "live-accounting:persister",
+				Run:   peer.LiveAccounting.Persister.Run,
+				Close: peer.LiveAccounting.Persister.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Live Accounting Persister", peer.LiveAccounting.Persister.Loop))
+		}
 
 		// storage nodes tally
 		peer.Accounting.NodeTally = nodetally.New(peer.Log.Named("accounting:nodetally"), peer.DB.StoragenodeAccounting(), peer.Metainfo.SegmentLoop, config.Tally.Interval)
@@ -530,6 +721,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			pc.StorageTBPrice,
 			pc.EgressTBPrice,
 			pc.SegmentPrice,
+			pc.PlacementPrices,
+			pc.PriceTiers,
+			pc.VolumeDiscounts,
 			pc.BonusRate)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -574,6 +768,26 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		)
 	}
 
+	{ // setup purging of deleted projects
+		if config.ProjectPurge.Enabled {
+			peer.ProjectPurge.Chore = projectpurge.NewChore(
+				peer.Log.Named("console:project-purge"),
+				peer.DB.Console(),
+				peer.DB.Buckets(),
+				peer.Metainfo.Metabase,
+				peer.Payments.Accounts,
+				config.ProjectPurge,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "console:project-purge",
+				Run:   peer.ProjectPurge.Chore.Run,
+				Close: peer.ProjectPurge.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Project Purge Chore", peer.ProjectPurge.Chore.Loop))
+		}
+	}
+
 	{ // setup graceful exit
 		if config.GracefulExit.Enabled {
 			peer.GracefulExit.Chore = gracefulexit.NewChore(peer.Log.Named("gracefulexit"), peer.DB.GracefulExit(), peer.Overlay.DB, peer.Metainfo.SegmentLoop, config.GracefulExit)
@@ -604,6 +818,24 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Metrics", peer.Metrics.Chore.Loop))
 	}
 
+	{ // setup segment statistics
+		if config.SegmentStats.Enabled {
+			peer.Metrics.SegmentStats = segmentstats.NewChore(
+				peer.Log.Named("segment-stats"),
+				peer.DB.SegmentStatistics(),
+				peer.Metainfo.SegmentLoop,
+				config.SegmentStats,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "segment-stats",
+				Run:   peer.Metrics.SegmentStats.Run,
+				Close: peer.Metrics.SegmentStats.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Segment Statistics", peer.Metrics.SegmentStats.Loop))
+		}
+	}
+
 	return peer, nil
 }
 
@@ -27,6 +27,7 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/scheduler"
 )
 
 // GarbageCollection is the satellite garbage collection process.
@@ -42,6 +43,8 @@ type GarbageCollection struct {
 
 	Dialer rpc.Dialer
 
+	Scheduler *scheduler.Scheduler
+
 	Version struct {
 		Chore   *version_checker.Chore
 		Service *version_checker.Service
@@ -113,6 +116,10 @@ func NewGarbageCollection(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	{ // setup background task scheduler
+		peer.Scheduler = scheduler.New(peer.Log.Named("scheduler"), config.Scheduler)
+	}
+
 	{ // setup listener and server
 		sc := config.Server
 
@@ -152,6 +159,7 @@ func NewGarbageCollection(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Dialer,
 			peer.Overlay.DB,
 			peer.Metainfo.SegmentLoop,
+			peer.Scheduler,
 		)
 		peer.Services.Add(lifecycle.Item{
 			Name: "garbage-collection",
@@ -16,6 +16,12 @@ The gc.Service will send that request to the storagenode after a full segments l
 iteration, and the storage node will use that request to delete the "garbage" pieces
 that are not in the bloom filter.
 
+Storage nodes, whose version is at least CompactFilterMinimumVersion, get
+compact version 2 filters of the private/retainfilter package. A compact filter
+larger than MaxFilterSize is split into shards by piece ID, which are sent in
+separate retain requests. The other nodes get version 1 filters, whose size is
+limited to MaxFilterSize.
+
 See storj/docs/design/garbage-collection.md for more info.
 */
 package gc
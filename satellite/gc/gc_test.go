@@ -332,7 +332,8 @@ func TestGarbageCollection_PendingObject(t *testing.T) {
 		pieceTracker := gc.NewPieceTracker(satellite.Log.Named("gc observer"), gc.Config{
 			FalsePositiveRate: 0.000000001,
 			InitialPieces:     10,
-		}, lastPieceCounts)
+			MaxFilterSize:     2 * memory.MiB,
+		}, lastPieceCounts, nil)
 
 		err = satellite.Metabase.SegmentLoop.Join(ctx, pieceTracker)
 		require.NoError(t, err)
@@ -10,9 +10,8 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
-	"storj.io/common/bloomfilter"
-	"storj.io/common/memory"
 	"storj.io/common/storj"
+	"storj.io/storj/private/retainfilter"
 	"storj.io/storj/satellite/metabase/segmentloop"
 )
 
@@ -29,17 +28,21 @@ type PieceTracker struct {
 	creationDate time.Time
 	// TODO: should we use int or int64 consistently for piece count (db type is int64)?
 	pieceCounts map[storj.NodeID]int
+	// filterVersions are the filter versions supported by the nodes, which
+	// get version 1 filters when they're missing.
+	filterVersions map[storj.NodeID]retainfilter.Version
 
 	RetainInfos map[storj.NodeID]*RetainInfo
 }
 
 // NewPieceTracker instantiates a new gc piece tracker to be subscribed to the metainfo loop.
-func NewPieceTracker(log *zap.Logger, config Config, pieceCounts map[storj.NodeID]int) *PieceTracker {
+func NewPieceTracker(log *zap.Logger, config Config, pieceCounts map[storj.NodeID]int, filterVersions map[storj.NodeID]retainfilter.Version) *PieceTracker {
 	return &PieceTracker{
-		log:          log,
-		config:       config,
-		creationDate: time.Now().UTC(),
-		pieceCounts:  pieceCounts,
+		log:            log,
+		config:         config,
+		creationDate:   time.Now().UTC(),
+		pieceCounts:    pieceCounts,
+		filterVersions: filterVersions,
 
 		RetainInfos: make(map[storj.NodeID]*RetainInfo, len(pieceCounts)),
 	}
@@ -80,15 +83,19 @@ func (pieceTracker *PieceTracker) add(nodeID storj.NodeID, pieceID storj.PieceID
 		if pieceTracker.pieceCounts[nodeID] > 0 {
 			numPieces = pieceTracker.pieceCounts[nodeID]
 		}
-		// limit size of bloom filter to ensure we are under the limit for RPC
-		filter := bloomfilter.NewOptimalMaxSize(numPieces, pieceTracker.config.FalsePositiveRate, 2*memory.MiB)
+		version, ok := pieceTracker.filterVersions[nodeID]
+		if !ok {
+			version = retainfilter.Version1
+		}
+		// limit size of bloom filters to ensure we are under the limit for RPC
+		filters := retainfilter.NewOptimal(version, numPieces, pieceTracker.config.FalsePositiveRate, pieceTracker.config.MaxFilterSize)
 		info = &RetainInfo{
-			Filter:       filter,
+			Filters:      filters,
 			CreationDate: pieceTracker.creationDate,
 		}
 		pieceTracker.RetainInfos[nodeID] = info
 	}
 
-	info.Filter.Add(pieceID)
+	info.Filters[retainfilter.ShardOf(pieceID, len(info.Filters))].Add(pieceID)
 	info.Count++
 }
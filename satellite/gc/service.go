@@ -11,13 +11,16 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
-	"storj.io/common/bloomfilter"
+	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/private/version"
+	"storj.io/storj/private/retainfilter"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/scheduler"
 	"storj.io/uplink/private/piecestore"
 )
 
@@ -37,6 +40,9 @@ type Config struct {
 	FalsePositiveRate float64       `help:"the false positive rate used for creating a garbage collection bloom filter" releaseDefault:"0.1" devDefault:"0.1"`
 	ConcurrentSends   int           `help:"the number of nodes to concurrently send garbage collection bloom filters to" releaseDefault:"1" devDefault:"1"`
 	RetainSendTimeout time.Duration `help:"the amount of time to allow a node to handle a retain request" default:"1m"`
+
+	MaxFilterSize               memory.Size `help:"the maximum size of a retain filter, larger compact filters are split into multiple retain requests" default:"2MiB"`
+	CompactFilterMinimumVersion string      `help:"the minimum storage node version, which supports compact retain filters, they aren't sent when empty" releaseDefault:"" devDefault:"v0.0.0"`
 }
 
 // Service implements the garbage collection service.
@@ -50,17 +56,28 @@ type Service struct {
 	dialer      rpc.Dialer
 	overlay     overlay.DB
 	segmentLoop *segmentloop.Service
+	scheduler   *scheduler.Scheduler
 }
 
 // RetainInfo contains info needed for a storage node to retain important data and delete garbage data.
 type RetainInfo struct {
-	Filter       *bloomfilter.Filter
+	// Filters contains a single filter, unless a compact filter is split into
+	// shards by piece ID, which are sent in separate retain requests.
+	Filters      []retainfilter.Filter
 	CreationDate time.Time
 	Count        int
 }
 
+// Size returns the total size of the filters.
+func (info *RetainInfo) Size() (size int64) {
+	for _, filter := range info.Filters {
+		size += filter.Size()
+	}
+	return size
+}
+
 // NewService creates a new instance of the gc service.
-func NewService(log *zap.Logger, config Config, dialer rpc.Dialer, overlay overlay.DB, loop *segmentloop.Service) *Service {
+func NewService(log *zap.Logger, config Config, dialer rpc.Dialer, overlay overlay.DB, loop *segmentloop.Service, scheduler *scheduler.Scheduler) *Service {
 	return &Service{
 		log:         log,
 		config:      config,
@@ -68,6 +85,7 @@ func NewService(log *zap.Logger, config Config, dialer rpc.Dialer, overlay overl
 		dialer:      dialer,
 		overlay:     overlay,
 		segmentLoop: loop,
+		scheduler:   scheduler,
 	}
 }
 
@@ -91,7 +109,18 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	return service.Loop.Run(ctx, func(ctx context.Context) (err error) {
 		defer mon.Task()(&ctx)(&err)
 
-		pieceTracker := NewPieceTracker(service.log.Named("gc observer"), service.config, lastPieceCounts)
+		release, err := service.scheduler.Acquire(ctx, "gc")
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		filterVersions, err := service.filterVersions(ctx)
+		if err != nil {
+			service.log.Error("error getting node versions, sending version 1 filters", zap.Error(err))
+		}
+
+		pieceTracker := NewPieceTracker(service.log.Named("gc observer"), service.config, lastPieceCounts, filterVersions)
 
 		// collect things to retain
 		err = service.segmentLoop.Join(ctx, pieceTracker)
@@ -117,7 +146,8 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		// monitor information
 		for _, info := range pieceTracker.RetainInfos {
 			mon.IntVal("node_piece_count").Observe(int64(info.Count))
-			mon.IntVal("retain_filter_size_bytes").Observe(info.Filter.Size())
+			mon.IntVal("retain_filter_size_bytes").Observe(info.Size())
+			mon.IntVal("retain_filter_count").Observe(int64(len(info.Filters)))
 		}
 
 		// send retain requests
@@ -164,9 +194,45 @@ func (service *Service) sendRetainRequest(ctx context.Context, id storj.NodeID,
 		err = errs.Combine(err, Error.Wrap(client.Close()))
 	}()
 
-	err = client.Retain(ctx, &pb.RetainRequest{
-		CreationDate: info.CreationDate,
-		Filter:       info.Filter.Bytes(),
+	// the shards of a split filter are sent one by one, so that each request
+	// stays under the RPC message size limit.
+	for _, filter := range info.Filters {
+		err = client.Retain(ctx, &pb.RetainRequest{
+			CreationDate: info.CreationDate,
+			Filter:       filter.Bytes(),
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// filterVersions returns the retain filter versions of the nodes, which
+// support compact filters. The other nodes get version 1 filters.
+func (service *Service) filterVersions(ctx context.Context) (_ map[storj.NodeID]retainfilter.Version, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versions := make(map[storj.NodeID]retainfilter.Version)
+	if service.config.CompactFilterMinimumVersion == "" {
+		return versions, nil
+	}
+
+	minimum, err := version.NewSemVer(service.config.CompactFilterMinimumVersion)
+	if err != nil {
+		return versions, Error.Wrap(err)
+	}
+
+	err = service.overlay.IterateAllNodeDossiers(ctx, func(ctx context.Context, node *overlay.NodeDossier) error {
+		nodeVersion, err := version.NewSemVer(node.Version.GetVersion())
+		if err != nil {
+			// nodes with an unknown version get version 1 filters.
+			return nil
+		}
+		if nodeVersion.Compare(minimum) >= 0 {
+			versions[node.Id] = retainfilter.Version2
+		}
+		return nil
 	})
-	return Error.Wrap(err)
+	return versions, Error.Wrap(err)
 }
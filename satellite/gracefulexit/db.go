@@ -74,6 +74,10 @@ type DB interface {
 	GetIncompleteNotFailed(ctx context.Context, nodeID storj.NodeID, limit int, offset int64) ([]*TransferQueueItem, error)
 	// GetIncompleteNotFailed gets incomplete graceful exit transfer queue entries that have failed <= maxFailures times, ordered by durability ratio and queued date ascending.
 	GetIncompleteFailed(ctx context.Context, nodeID storj.NodeID, maxFailures int, limit int, offset int64) ([]*TransferQueueItem, error)
+	// GetFinished gets finished graceful exit transfer queue entries ordered by queued date ascending.
+	GetFinished(ctx context.Context, nodeID storj.NodeID, limit int, offset int64) ([]*TransferQueueItem, error)
+	// RequeueTransferQueueItem marks a finished graceful exit transfer queue entry as incomplete again.
+	RequeueTransferQueueItem(ctx context.Context, nodeID storj.NodeID, StreamID uuid.UUID, Position metabase.SegmentPosition, pieceNum int32) error
 	// IncrementOrderLimitSendCount increments the number of times a node has been sent an order limit for transferring.
 	IncrementOrderLimitSendCount(ctx context.Context, nodeID storj.NodeID, StreamID uuid.UUID, Position metabase.SegmentPosition, pieceNum int32) error
 	// CountFinishedTransferQueueItemsByNode return a map of the nodes which has
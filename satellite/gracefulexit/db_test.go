@@ -155,6 +155,35 @@ func TestSegmentTransferQueueItem(t *testing.T) {
 				require.Equal(t, streamID2, queueItem.StreamID)
 				require.Equal(t, position2, queueItem.Position)
 			}
+
+			finishedItems, err := geDB.GetFinished(ctx, nodeID1, 10, 0)
+			require.NoError(t, err)
+			require.Len(t, finishedItems, 1)
+			require.Equal(t, streamID1, finishedItems[0].StreamID)
+			require.NotNil(t, finishedItems[0].FinishedAt)
+		}
+
+		// re-queue the finished item and finish it again
+		{
+			err := geDB.IncrementOrderLimitSendCount(ctx, nodeID1, streamID1, position1, 1)
+			require.NoError(t, err)
+
+			err = geDB.RequeueTransferQueueItem(ctx, nodeID1, streamID1, position1, 1)
+			require.NoError(t, err)
+
+			item, err := geDB.GetTransferQueueItem(ctx, nodeID1, streamID1, position1, 1)
+			require.NoError(t, err)
+			require.Nil(t, item.FinishedAt)
+			require.Zero(t, item.OrderLimitSendCount)
+
+			queueItems, err := geDB.GetIncomplete(ctx, nodeID1, 10, 0)
+			require.NoError(t, err)
+			require.Len(t, queueItems, 2)
+
+			now := time.Now()
+			item.FinishedAt = &now
+			err = geDB.UpdateTransferQueueItem(ctx, *item)
+			require.NoError(t, err)
 		}
 
 		// test delete finished queue items. Only key1 should be removed
@@ -0,0 +1,178 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/uplink/private/eestream"
+)
+
+// verifyTransfers verifies the finished transfers of the exiting node before
+// the exit is completed. The exiting node is asked to delete the pieces whose
+// segments are durable. The transfers whose segments aren't durable anymore,
+// because the receiving node has failed since, are re-queued. It returns the
+// number of re-queued transfers.
+func (endpoint *Endpoint) verifyTransfers(ctx context.Context, stream pb.DRPCSatelliteGracefulExit_ProcessStream, nodeID storj.NodeID) (requeued int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		// every verified transfer is either deleted or re-queued, hence the
+		// next batch always starts from the beginning.
+		items, err := endpoint.db.GetFinished(ctx, nodeID, endpoint.config.EndpointBatchSize, 0)
+		if err != nil {
+			return requeued, Error.Wrap(err)
+		}
+		if len(items) == 0 {
+			return requeued, nil
+		}
+
+		for _, item := range items {
+			durable, err := endpoint.verifyTransfer(ctx, item)
+			if err != nil {
+				return requeued, err
+			}
+			if !durable {
+				requeued++
+				continue
+			}
+
+			err = stream.Send(&pb.SatelliteMessage{
+				Message: &pb.SatelliteMessage_DeletePiece{
+					DeletePiece: &pb.DeletePiece{
+						OriginalPieceId: item.RootPieceID.Derive(nodeID, item.PieceNum),
+					},
+				},
+			})
+			if err != nil {
+				return requeued, Error.Wrap(err)
+			}
+
+			err = endpoint.db.DeleteTransferQueueItem(ctx, nodeID, item.StreamID, item.Position, item.PieceNum)
+			if err != nil {
+				return requeued, Error.Wrap(err)
+			}
+		}
+	}
+}
+
+// verifyTransfer checks whether the segment of a transferred piece is still
+// durable. A segment isn't durable when the node which holds the transferred
+// piece is unreliable, offline or out of the segment placement, and the segment
+// doesn't have more healthy pieces than its repair threshold without it. The
+// transfer is re-queued in that case and false is returned.
+func (endpoint *Endpoint) verifyTransfer(ctx context.Context, item *TransferQueueItem) (durable bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segment, err := endpoint.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: item.StreamID,
+		Position: item.Position,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			// the segment was deleted, so its durability doesn't matter.
+			return true, nil
+		}
+		return false, Error.Wrap(err)
+	}
+	if !item.RootPieceID.IsZero() && item.RootPieceID != segment.RootPieceID {
+		// the segment was replaced since the transfer.
+		return true, nil
+	}
+
+	var transferred *metabase.Piece
+	nodeIDs := make(storj.NodeIDList, 0, len(segment.Pieces))
+	for i, piece := range segment.Pieces {
+		nodeIDs = append(nodeIDs, piece.StorageNode)
+		if int32(piece.Number) == item.PieceNum {
+			transferred = &segment.Pieces[i]
+		}
+	}
+	if transferred == nil || transferred.StorageNode == item.NodeID {
+		// the piece was removed or repaired since the transfer.
+		return true, nil
+	}
+
+	unhealthy, err := endpoint.overlay.KnownUnreliableOrOffline(ctx, nodeIDs)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	numHealthy := len(segment.Pieces) - len(unhealthy)
+
+	lost := false
+	for _, nodeID := range unhealthy {
+		if nodeID == transferred.StorageNode {
+			lost = true
+			break
+		}
+	}
+	if !lost && segment.Placement != storj.EveryCountry {
+		node, err := endpoint.overlay.Get(ctx, transferred.StorageNode)
+		if err != nil {
+			return false, Error.Wrap(err)
+		}
+		if !segment.Placement.AllowedCountry(node.CountryCode) {
+			lost = true
+			numHealthy--
+		}
+	}
+
+	if !lost {
+		return true, nil
+	}
+	mon.Meter("graceful_exit_transferred_piece_lost").Mark(1)
+
+	if numHealthy > int(segment.Redundancy.RepairShares) {
+		// the segment is durable without the transferred piece.
+		return true, nil
+	}
+
+	return false, endpoint.requeueTransfer(ctx, segment, *transferred, item)
+}
+
+// requeueTransfer gives the piece back to the exiting node in the segment and
+// queues its transfer again. The exiting node still has the piece, because
+// it's asked to delete the piece only after the transfer is verified.
+func (endpoint *Endpoint) requeueTransfer(ctx context.Context, segment metabase.Segment, transferred metabase.Piece, item *TransferQueueItem) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.log.Info("re-queueing transfer of lost piece",
+		zap.Stringer("exiting node ID", item.NodeID),
+		zap.Stringer("receiving node ID", transferred.StorageNode),
+		zap.Stringer("stream ID", item.StreamID),
+		zap.Uint64("position", item.Position.Encode()),
+		zap.Int32("piece num", item.PieceNum))
+
+	toAdd := metabase.Pieces{{Number: transferred.Number, StorageNode: item.NodeID}}
+	toRemove := metabase.Pieces{transferred}
+	err = endpoint.UpdatePiecesCheckDuplicates(ctx, segment, toAdd, toRemove, true)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	pieceSize := eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy)
+
+	// the transfer is counted again when it succeeds.
+	err = endpoint.db.IncrementProgress(ctx, item.NodeID, -pieceSize, -1, 0)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = endpoint.db.RequeueTransferQueueItem(ctx, item.NodeID, item.StreamID, item.Position, item.PieceNum)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	mon.Meter("graceful_exit_transfer_piece_requeued").Mark(1)
+	return nil
+}
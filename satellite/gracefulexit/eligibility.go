@@ -0,0 +1,60 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"time"
+
+	"storj.io/storj/satellite/compensation"
+)
+
+// Eligibility describes whether a node is allowed to initiate graceful exit.
+type Eligibility struct {
+	Allowed bool
+	// EligibleAt is the time from which the node is allowed to initiate graceful exit.
+	EligibleAt time.Time
+	// MonthsRequired is the age in months the node needs to initiate graceful exit.
+	MonthsRequired int
+	// MonthsRemaining is the number of months until the node is allowed to
+	// initiate graceful exit, it's zero when the node is allowed.
+	MonthsRemaining int
+}
+
+// CheckEligibility checks whether a node created at the given time is allowed
+// to initiate graceful exit. The node must be at least NodeMinAgeInMonths old
+// and the satellite must withhold at most NodeMaxWithheldPercent of its
+// earnings according to the withholding schedule.
+func CheckEligibility(config Config, withheldPercents []int, createdAt, now time.Time) Eligibility {
+	monthsRequired := config.NodeMinAgeInMonths
+	if withheldMonths := monthsUntilWithheldPercent(withheldPercents, config.NodeMaxWithheldPercent); withheldMonths > monthsRequired {
+		monthsRequired = withheldMonths
+	}
+
+	eligibility := Eligibility{
+		EligibleAt:     createdAt.AddDate(0, monthsRequired, 0),
+		MonthsRequired: monthsRequired,
+	}
+	eligibility.Allowed = !now.Before(eligibility.EligibleAt)
+
+	for !eligibility.Allowed && now.AddDate(0, eligibility.MonthsRemaining, 0).Before(eligibility.EligibleAt) {
+		eligibility.MonthsRemaining++
+	}
+
+	return eligibility
+}
+
+// monthsUntilWithheldPercent returns the age in months from which at most
+// maxPercent of the earnings of a node are withheld.
+func monthsUntilWithheldPercent(withheldPercents []int, maxPercent int) int {
+	if len(withheldPercents) == 0 {
+		withheldPercents = compensation.DefaultWithheldPercents
+	}
+	for month, percent := range withheldPercents {
+		if percent <= maxPercent {
+			return month
+		}
+	}
+	// nothing is withheld after the withholding period.
+	return len(withheldPercents)
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/gracefulexit"
+)
+
+func TestCheckEligibility(t *testing.T) {
+	createdAt := time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC)
+	withheldPercents := []int{75, 75, 75, 50, 50, 50, 25, 25, 25}
+
+	tests := []struct {
+		name   string
+		config gracefulexit.Config
+		now    time.Time
+
+		allowed         bool
+		monthsRequired  int
+		monthsRemaining int
+	}{
+		{
+			name:            "too young",
+			config:          gracefulexit.Config{NodeMinAgeInMonths: 6, NodeMaxWithheldPercent: 100},
+			now:             time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+			monthsRequired:  6,
+			monthsRemaining: 5,
+		},
+		{
+			name:           "old enough",
+			config:         gracefulexit.Config{NodeMinAgeInMonths: 6, NodeMaxWithheldPercent: 100},
+			now:            time.Date(2022, 7, 15, 0, 0, 0, 0, time.UTC),
+			allowed:        true,
+			monthsRequired: 6,
+		},
+		{
+			name:            "withheld percent too high",
+			config:          gracefulexit.Config{NodeMinAgeInMonths: 6, NodeMaxWithheldPercent: 0},
+			now:             time.Date(2022, 7, 15, 0, 0, 0, 0, time.UTC),
+			monthsRequired:  9,
+			monthsRemaining: 3,
+		},
+		{
+			name:           "withheld percent low enough",
+			config:         gracefulexit.Config{NodeMinAgeInMonths: 1, NodeMaxWithheldPercent: 50},
+			now:            time.Date(2022, 4, 15, 0, 0, 0, 0, time.UTC),
+			allowed:        true,
+			monthsRequired: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			eligibility := gracefulexit.CheckEligibility(tt.config, withheldPercents, createdAt, tt.now)
+			require.Equal(t, tt.allowed, eligibility.Allowed)
+			require.Equal(t, tt.monthsRequired, eligibility.MonthsRequired)
+			require.Equal(t, tt.monthsRemaining, eligibility.MonthsRemaining)
+			require.Equal(t, createdAt.AddDate(0, tt.monthsRequired, 0), eligibility.EligibleAt)
+		})
+	}
+}
@@ -56,6 +56,8 @@ type Endpoint struct {
 	peerIdentities overlay.PeerIdentities
 	config         Config
 	recvTimeout    time.Duration
+
+	withheldPercents []int
 }
 
 // connectionsTracker for tracking ongoing connections on this api server.
@@ -94,7 +96,7 @@ func (pm *connectionsTracker) delete(nodeID storj.NodeID) {
 
 // NewEndpoint creates a new graceful exit endpoint.
 func NewEndpoint(log *zap.Logger, signer signing.Signer, db DB, overlaydb overlay.DB, overlay *overlay.Service, reputation *reputation.Service, metabase *metabase.DB, orders *orders.Service,
-	peerIdentities overlay.PeerIdentities, config Config) *Endpoint {
+	peerIdentities overlay.PeerIdentities, withheldPercents []int, config Config) *Endpoint {
 	return &Endpoint{
 		log:            log,
 		interval:       time.Millisecond * buildQueueMillis,
@@ -109,6 +111,8 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, db DB, overlaydb overla
 		peerIdentities: peerIdentities,
 		config:         config,
 		recvTimeout:    config.RecvTimeout,
+
+		withheldPercents: withheldPercents,
 	}
 }
 
@@ -246,6 +250,14 @@ func (endpoint *Endpoint) Process(stream pb.DRPCSatelliteGracefulExit_ProcessStr
 				return rpcstatus.Error(rpcstatus.FailedPrecondition, "Disqualified nodes cannot graceful exit")
 			}
 
+			requeued, err := endpoint.verifyTransfers(ctx, stream, nodeID)
+			if err != nil {
+				return rpcstatus.Error(rpcstatus.Internal, err.Error())
+			}
+			if requeued > 0 {
+				return rpcstatus.Error(rpcstatus.Canceled, "transferred pieces were lost and re-queued (node should reconnect and continue)")
+			}
+
 			// update exit status
 			exitStatusRequest, exitFailedReason, err := endpoint.generateExitStatusRequest(ctx, nodeID)
 			if err != nil {
@@ -286,7 +298,7 @@ func (endpoint *Endpoint) Process(stream pb.DRPCSatelliteGracefulExit_ProcessStr
 
 		switch m := request.GetMessage().(type) {
 		case *pb.StorageNodeMessage_Succeeded:
-			err = endpoint.handleSucceeded(ctx, stream, pending, nodeID, m)
+			err = endpoint.handleSucceeded(ctx, pending, nodeID, m)
 			if err != nil {
 				if metainfo.ErrNodeAlreadyExists.Has(err) {
 					// this will get retried
@@ -455,7 +467,7 @@ func (endpoint *Endpoint) processIncomplete(ctx context.Context, stream pb.DRPCS
 	return err
 }
 
-func (endpoint *Endpoint) handleSucceeded(ctx context.Context, stream pb.DRPCSatelliteGracefulExit_ProcessStream, pending *PendingMap, exitingNodeID storj.NodeID, message *pb.StorageNodeMessage_Succeeded) (err error) {
+func (endpoint *Endpoint) handleSucceeded(ctx context.Context, pending *PendingMap, exitingNodeID storj.NodeID, message *pb.StorageNodeMessage_Succeeded) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	originalPieceID := message.Succeeded.OriginalPieceId
@@ -505,7 +517,12 @@ func (endpoint *Endpoint) handleSucceeded(ctx context.Context, stream pb.DRPCSat
 		return Error.Wrap(err)
 	}
 
-	err = endpoint.db.DeleteTransferQueueItem(ctx, exitingNodeID, transfer.StreamID, transfer.Position, int32(transfer.PieceNum))
+	// the transfer is kept as finished, so that it can be verified before the
+	// exit is completed. The exiting node is asked to delete the piece only
+	// after the verification.
+	now := time.Now().UTC()
+	transferQueueItem.FinishedAt = &now
+	err = endpoint.db.UpdateTransferQueueItem(ctx, *transferQueueItem)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -515,19 +532,6 @@ func (endpoint *Endpoint) handleSucceeded(ctx context.Context, stream pb.DRPCSat
 		return err
 	}
 
-	deleteMsg := &pb.SatelliteMessage{
-		Message: &pb.SatelliteMessage_DeletePiece{
-			DeletePiece: &pb.DeletePiece{
-				OriginalPieceId: originalPieceID,
-			},
-		},
-	}
-
-	err = stream.Send(deleteMsg)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-
 	mon.Meter("graceful_exit_transfer_piece_success").Mark(1) //mon:locked
 	return nil
 }
@@ -786,9 +790,11 @@ func (endpoint *Endpoint) checkExitStatus(ctx context.Context, nodeID storj.Node
 			endpoint.log.Error("unable to retrieve node dossier for attempted exiting node", zap.Stringer("node ID", nodeID))
 			return nil, Error.Wrap(err)
 		}
-		geEligibilityDate := nodeDossier.CreatedAt.AddDate(0, endpoint.config.NodeMinAgeInMonths, 0)
-		if time.Now().Before(geEligibilityDate) {
-			return nil, ErrIneligibleNodeAge.New("will be eligible after %s", geEligibilityDate.String())
+		eligibility := CheckEligibility(endpoint.config, endpoint.withheldPercents, nodeDossier.CreatedAt, time.Now())
+		if !eligibility.Allowed {
+			mon.Meter("graceful_exit_ineligible").Mark(1)
+			return nil, ErrIneligibleNodeAge.New("months required: %d, months remaining: %d, will be eligible after %s",
+				eligibility.MonthsRequired, eligibility.MonthsRemaining, eligibility.EligibleAt.Format(time.RFC3339))
 		}
 
 		request := &overlay.ExitStatusRequest{NodeID: nodeID, ExitInitiatedAt: time.Now().UTC()}
@@ -930,15 +936,11 @@ func (endpoint *Endpoint) GracefulExitFeasibility(ctx context.Context, req *pb.G
 		return nil, Error.Wrap(err)
 	}
 
-	eligibilityDate := nodeDossier.CreatedAt.AddDate(0, endpoint.config.NodeMinAgeInMonths, 0)
-	if time.Now().Before(eligibilityDate) {
-		response.IsAllowed = false
-	} else {
-		response.IsAllowed = true
-	}
+	eligibility := CheckEligibility(endpoint.config, endpoint.withheldPercents, nodeDossier.CreatedAt, time.Now())
 
+	response.IsAllowed = eligibility.Allowed
 	response.JoinedAt = nodeDossier.CreatedAt
-	response.MonthsRequired = int32(endpoint.config.NodeMinAgeInMonths)
+	response.MonthsRequired = int32(eligibility.MonthsRequired)
 	return &response, nil
 }
 
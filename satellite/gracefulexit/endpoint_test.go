@@ -827,6 +827,89 @@ func testSuccessSegmentUpdate(t *testing.T, ctx *testcontext.Context, nodeFullID
 	require.Equal(t, 1, found)
 }
 
+func TestRequeueLostTransfer(t *testing.T) {
+	testTransfers(t, 1, 0, func(t *testing.T, ctx *testcontext.Context, nodeFullIDs map[storj.NodeID]*identity.FullIdentity, satellite *testplanet.Satellite, processClient exitProcessClient, exitingNode *storagenode.Peer, numPieces int) {
+		response, err := processClient.Recv()
+		require.NoError(t, err)
+
+		m, ok := response.GetMessage().(*pb.SatelliteMessage_TransferPiece)
+		require.True(t, ok, "did not get a TransferPiece message")
+
+		pieceReader, err := exitingNode.Storage2.Store.Reader(ctx, satellite.ID(), m.TransferPiece.OriginalPieceId)
+		require.NoError(t, err)
+
+		header, err := pieceReader.GetPieceHeader()
+		require.NoError(t, err)
+
+		orderLimit := header.OrderLimit
+		originalPieceHash := &pb.PieceHash{
+			PieceId:   orderLimit.PieceId,
+			Hash:      header.GetHash(),
+			PieceSize: pieceReader.Size(),
+			Timestamp: header.GetCreationTime(),
+			Signature: header.GetSignature(),
+		}
+
+		newPieceHash := &pb.PieceHash{
+			PieceId:   m.TransferPiece.AddressedOrderLimit.Limit.PieceId,
+			Hash:      originalPieceHash.Hash,
+			PieceSize: originalPieceHash.PieceSize,
+			Timestamp: time.Now(),
+		}
+
+		receivingIdentity := nodeFullIDs[m.TransferPiece.AddressedOrderLimit.Limit.StorageNodeId]
+		require.NotNil(t, receivingIdentity)
+
+		signer := signing.SignerFromFullIdentity(receivingIdentity)
+		signedNewPieceHash, err := signing.SignPieceHash(ctx, signer, newPieceHash)
+		require.NoError(t, err)
+
+		// the receiving node fails before the exit is completed, which leaves
+		// the segment at its repair threshold.
+		err = satellite.DB.OverlayCache().DisqualifyNode(ctx, receivingIdentity.ID, time.Now(), overlay.DisqualificationReasonUnknown)
+		require.NoError(t, err)
+
+		err = processClient.Send(&pb.StorageNodeMessage{
+			Message: &pb.StorageNodeMessage_Succeeded{
+				Succeeded: &pb.TransferSucceeded{
+					OriginalPieceId:      m.TransferPiece.OriginalPieceId,
+					OriginalPieceHash:    originalPieceHash,
+					OriginalOrderLimit:   &orderLimit,
+					ReplacementPieceHash: signedNewPieceHash,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		// the transfer is re-queued instead of completing the exit.
+		_, err = processClient.Recv()
+		require.True(t, errs2.IsRPC(err, rpcstatus.Canceled))
+
+		incomplete, err := satellite.DB.GracefulExit().GetIncomplete(ctx, exitingNode.ID(), 10, 0)
+		require.NoError(t, err)
+		require.Len(t, incomplete, numPieces)
+
+		progress, err := satellite.DB.GracefulExit().GetProgress(ctx, exitingNode.ID())
+		require.NoError(t, err)
+		require.EqualValues(t, 0, progress.PiecesTransferred)
+
+		// the exiting node holds the piece in the segment again.
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		var nodes []storj.NodeID
+		for _, piece := range segments[0].Pieces {
+			nodes = append(nodes, piece.StorageNode)
+		}
+		require.Contains(t, nodes, exitingNode.ID())
+		require.NotContains(t, nodes, receivingIdentity.ID)
+
+		exitStatus, err := satellite.Overlay.DB.GetExitStatus(ctx, exitingNode.ID())
+		require.NoError(t, err)
+		require.Nil(t, exitStatus.ExitFinishedAt)
+	})
+}
+
 func TestUpdateSegmentFailure_DuplicatedNodeID(t *testing.T) {
 	testTransfers(t, 1, 0, testUpdateSegmentFailureDuplicatedNodeID)
 }
@@ -1429,6 +1512,7 @@ func TestIneligibleNodeAge(t *testing.T) {
 		// expect the node ineligible error here
 		require.Error(t, err)
 		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+		require.Contains(t, err.Error(), "months remaining: 1")
 
 		// check that there are still no exiting nodes
 		exitingNodes, err = satellite.DB.OverlayCache().GetExitingNodes(ctx)
@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maintenance.proto
+
+package internalpb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MaintenanceStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaintenanceStatusRequest) Reset()         { *m = MaintenanceStatusRequest{} }
+func (m *MaintenanceStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceStatusRequest) ProtoMessage()    {}
+func (*MaintenanceStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{0}
+}
+func (m *MaintenanceStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintenanceStatusRequest.Unmarshal(m, b)
+}
+func (m *MaintenanceStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintenanceStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *MaintenanceStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceStatusRequest.Merge(m, src)
+}
+func (m *MaintenanceStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_MaintenanceStatusRequest.Size(m)
+}
+func (m *MaintenanceStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceStatusRequest proto.InternalMessageInfo
+
+type MaintenanceWindow struct {
+	Start   time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	End     time.Time `protobuf:"bytes,2,opt,name=end,proto3,stdtime" json:"end"`
+	Message string    `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// set when the satellite rejects writes during the window.
+	WriteFreeze          bool     `protobuf:"varint,4,opt,name=write_freeze,json=writeFreeze,proto3" json:"write_freeze,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{1}
+}
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintenanceWindow.Unmarshal(m, b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return xxx_messageInfo_MaintenanceWindow.Size(m)
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
+func (m *MaintenanceWindow) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *MaintenanceWindow) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
+}
+
+func (m *MaintenanceWindow) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetWriteFreeze() bool {
+	if m != nil {
+		return m.WriteFreeze
+	}
+	return false
+}
+
+type MaintenanceStatusResponse struct {
+	// windows which are in progress.
+	Active []MaintenanceWindow `protobuf:"bytes,1,rep,name=active,proto3" json:"active"`
+	// windows which will start soon, ordered by start time.
+	Upcoming []MaintenanceWindow `protobuf:"bytes,2,rep,name=upcoming,proto3" json:"upcoming"`
+	// set when the satellite currently rejects writes.
+	WritesFrozen         bool     `protobuf:"varint,3,opt,name=writes_frozen,json=writesFrozen,proto3" json:"writes_frozen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaintenanceStatusResponse) Reset()         { *m = MaintenanceStatusResponse{} }
+func (m *MaintenanceStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceStatusResponse) ProtoMessage()    {}
+func (*MaintenanceStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{2}
+}
+func (m *MaintenanceStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintenanceStatusResponse.Unmarshal(m, b)
+}
+func (m *MaintenanceStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintenanceStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *MaintenanceStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceStatusResponse.Merge(m, src)
+}
+func (m *MaintenanceStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_MaintenanceStatusResponse.Size(m)
+}
+func (m *MaintenanceStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceStatusResponse proto.InternalMessageInfo
+
+func (m *MaintenanceStatusResponse) GetActive() []MaintenanceWindow {
+	if m != nil {
+		return m.Active
+	}
+	return nil
+}
+
+func (m *MaintenanceStatusResponse) GetUpcoming() []MaintenanceWindow {
+	if m != nil {
+		return m.Upcoming
+	}
+	return nil
+}
+
+func (m *MaintenanceStatusResponse) GetWritesFrozen() bool {
+	if m != nil {
+		return m.WritesFrozen
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*MaintenanceStatusRequest)(nil), "satellite.maintenance.MaintenanceStatusRequest")
+	proto.RegisterType((*MaintenanceWindow)(nil), "satellite.maintenance.MaintenanceWindow")
+	proto.RegisterType((*MaintenanceStatusResponse)(nil), "satellite.maintenance.MaintenanceStatusResponse")
+}
+
+func init() { proto.RegisterFile("maintenance.proto", fileDescriptor_6053ae89a3b3f561) }
+
+var fileDescriptor_6053ae89a3b3f561 = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0xc7, 0xbb, 0xb6, 0xeb, 0xca, 0xab, 0xf6, 0xe0, 0x85, 0xc2, 0x56, 0x17, 0xab, 0x36, 0x05,
+	0x9d, 0x56, 0xc5, 0x85, 0x1e, 0x7a, 0xf4, 0xc1, 0x85, 0x40, 0x2e, 0x4a, 0x20, 0x90, 0x8b, 0x59,
+	0xdb, 0x63, 0xb1, 0x41, 0xda, 0x55, 0xb4, 0xa3, 0x18, 0x0c, 0x79, 0x87, 0x3c, 0x54, 0x0e, 0x79,
+	0x80, 0x9c, 0x93, 0x57, 0x09, 0x5e, 0xc5, 0x8e, 0x43, 0x0c, 0xf9, 0xb8, 0xed, 0x7c, 0xfc, 0xe6,
+	0xbf, 0xff, 0x19, 0xda, 0xcd, 0xa5, 0xd2, 0x08, 0x5a, 0xea, 0x19, 0x88, 0xa2, 0x34, 0x68, 0xd8,
+	0x77, 0x2b, 0x11, 0xb2, 0x4c, 0x21, 0x88, 0x9d, 0x62, 0x40, 0x53, 0x93, 0x9a, 0xba, 0x25, 0xe8,
+	0xa5, 0xc6, 0xa4, 0x19, 0xc4, 0x2e, 0x9a, 0x56, 0x8b, 0x18, 0x55, 0x0e, 0x16, 0x65, 0x5e, 0xd4,
+	0x0d, 0xfd, 0x80, 0xf2, 0xc3, 0x27, 0xf6, 0x08, 0x25, 0x56, 0x36, 0x81, 0xf3, 0x0a, 0x2c, 0xf6,
+	0xaf, 0x09, 0xed, 0xee, 0x14, 0x4f, 0x94, 0x9e, 0x9b, 0x25, 0xfb, 0x47, 0x3f, 0x5b, 0x94, 0x25,
+	0x72, 0x12, 0x92, 0xc8, 0x1f, 0x06, 0xa2, 0x96, 0x10, 0x1b, 0x09, 0x71, 0xbc, 0x91, 0x18, 0x79,
+	0x37, 0x77, 0xbd, 0x4f, 0x57, 0xf7, 0x3d, 0x92, 0xd4, 0x08, 0xfb, 0x4b, 0x9b, 0xa0, 0xe7, 0xbc,
+	0xf1, 0x0e, 0x72, 0x0d, 0x30, 0x4e, 0xbf, 0xe4, 0x60, 0xad, 0x4c, 0x81, 0x37, 0x43, 0x12, 0x75,
+	0x92, 0x4d, 0xc8, 0x7e, 0xd2, 0xaf, 0xcb, 0x52, 0x21, 0x4c, 0x16, 0x25, 0xc0, 0x0a, 0x78, 0x2b,
+	0x24, 0x91, 0x97, 0xf8, 0x2e, 0x37, 0x76, 0xa9, 0xfe, 0x2d, 0xa1, 0x3f, 0xf6, 0x78, 0xb4, 0x85,
+	0xd1, 0x16, 0xd8, 0x98, 0xb6, 0xe5, 0x0c, 0xd5, 0x05, 0x70, 0x12, 0x36, 0x23, 0x7f, 0x18, 0x89,
+	0xbd, 0x5b, 0x15, 0x2f, 0x16, 0x31, 0x6a, 0xad, 0xff, 0x98, 0x3c, 0xd2, 0xec, 0x80, 0x7a, 0x55,
+	0x31, 0x33, 0xb9, 0xd2, 0x29, 0x6f, 0x7c, 0x68, 0xd2, 0x96, 0x67, 0x03, 0xfa, 0xcd, 0x19, 0xb0,
+	0x93, 0x45, 0x69, 0x56, 0xa0, 0x9d, 0x69, 0x2f, 0xa9, 0x9d, 0xda, 0xb1, 0xcb, 0x0d, 0x2f, 0xa9,
+	0xbf, 0x33, 0x89, 0x69, 0xda, 0xf9, 0x0f, 0x58, 0x9b, 0x63, 0xf1, 0xeb, 0xd2, 0xcf, 0x4e, 0x1d,
+	0xfc, 0x7e, 0x3b, 0x50, 0xef, 0x6d, 0xf4, 0xeb, 0x74, 0x60, 0xd1, 0x94, 0x67, 0x42, 0x99, 0xd8,
+	0x3d, 0xe2, 0xed, 0x84, 0x78, 0xcd, 0x94, 0x5a, 0x66, 0xc5, 0x74, 0xda, 0x76, 0xc7, 0xfd, 0xf3,
+	0x30, 0x00, 0xb0, 0x42, 0x10, 0xb1, 0xbf, 0x02, 0x00, 0x00,
+}
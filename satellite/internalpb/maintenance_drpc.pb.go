@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.24
+// source: maintenance.proto
+
+package internalpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_maintenance_proto struct{}
+
+func (drpcEncoding_File_maintenance_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_maintenance_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_maintenance_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_maintenance_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCMaintenanceClient interface {
+	DRPCConn() drpc.Conn
+
+	GetStatus(ctx context.Context, in *MaintenanceStatusRequest) (*MaintenanceStatusResponse, error)
+}
+
+type drpcMaintenanceClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCMaintenanceClient(cc drpc.Conn) DRPCMaintenanceClient {
+	return &drpcMaintenanceClient{cc}
+}
+
+func (c *drpcMaintenanceClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcMaintenanceClient) GetStatus(ctx context.Context, in *MaintenanceStatusRequest) (*MaintenanceStatusResponse, error) {
+	out := new(MaintenanceStatusResponse)
+	err := c.cc.Invoke(ctx, "/satellite.maintenance.Maintenance/GetStatus", drpcEncoding_File_maintenance_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCMaintenanceServer interface {
+	GetStatus(context.Context, *MaintenanceStatusRequest) (*MaintenanceStatusResponse, error)
+}
+
+type DRPCMaintenanceUnimplementedServer struct{}
+
+func (s *DRPCMaintenanceUnimplementedServer) GetStatus(context.Context, *MaintenanceStatusRequest) (*MaintenanceStatusResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCMaintenanceDescription struct{}
+
+func (DRPCMaintenanceDescription) NumMethods() int { return 1 }
+
+func (DRPCMaintenanceDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/satellite.maintenance.Maintenance/GetStatus", drpcEncoding_File_maintenance_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMaintenanceServer).
+					GetStatus(
+						ctx,
+						in1.(*MaintenanceStatusRequest),
+					)
+			}, DRPCMaintenanceServer.GetStatus, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterMaintenance(mux drpc.Mux, impl DRPCMaintenanceServer) error {
+	return mux.Register(impl, DRPCMaintenanceDescription{})
+}
+
+type DRPCMaintenance_GetStatusStream interface {
+	drpc.Stream
+	SendAndClose(*MaintenanceStatusResponse) error
+}
+
+type drpcMaintenance_GetStatusStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMaintenance_GetStatusStream) SendAndClose(m *MaintenanceStatusResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_maintenance_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
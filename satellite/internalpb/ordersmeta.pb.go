@@ -27,6 +27,7 @@ type OrderLimitMetadata struct {
 	BucketId                   []byte   `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3" json:"bucket_id,omitempty"`
 	ProjectBucketPrefix        []byte   `protobuf:"bytes,2,opt,name=project_bucket_prefix,json=projectBucketPrefix,proto3" json:"project_bucket_prefix,omitempty"`
 	CompactProjectBucketPrefix []byte   `protobuf:"bytes,3,opt,name=compact_project_bucket_prefix,json=compactProjectBucketPrefix,proto3" json:"compact_project_bucket_prefix,omitempty"`
+	ApiKeyHead                 []byte   `protobuf:"bytes,4,opt,name=api_key_head,json=apiKeyHead,proto3" json:"api_key_head,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
 	XXX_unrecognized           []byte   `json:"-"`
 	XXX_sizecache              int32    `json:"-"`
@@ -77,6 +78,13 @@ func (m *OrderLimitMetadata) GetCompactProjectBucketPrefix() []byte {
 	return nil
 }
 
+func (m *OrderLimitMetadata) GetApiKeyHead() []byte {
+	if m != nil {
+		return m.ApiKeyHead
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*OrderLimitMetadata)(nil), "satellite.ordersmeta.OrderLimitMetadata")
 }
@@ -84,17 +92,19 @@ func init() {
 func init() { proto.RegisterFile("ordersmeta.proto", fileDescriptor_e00ef1afe54bb544) }
 
 var fileDescriptor_e00ef1afe54bb544 = []byte{
-	// 192 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xc8, 0x2f, 0x4a, 0x49,
-	0x2d, 0x2a, 0xce, 0x4d, 0x2d, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x29, 0x4e,
-	0x2c, 0x49, 0xcd, 0xc9, 0xc9, 0x2c, 0x49, 0xd5, 0x43, 0xc8, 0x29, 0xad, 0x60, 0xe4, 0x12, 0xf2,
-	0x07, 0x71, 0x7d, 0x32, 0x73, 0x33, 0x4b, 0x7c, 0x53, 0x4b, 0x12, 0x53, 0x12, 0x4b, 0x12, 0x85,
-	0xa4, 0xb9, 0x38, 0x93, 0x4a, 0x93, 0xb3, 0x53, 0x4b, 0xe2, 0x33, 0x53, 0x24, 0x18, 0x15, 0x18,
-	0x35, 0x78, 0x82, 0x38, 0x20, 0x02, 0x9e, 0x29, 0x42, 0x46, 0x5c, 0xa2, 0x05, 0x45, 0xf9, 0x59,
-	0xa9, 0xc9, 0x25, 0xf1, 0x50, 0x45, 0x05, 0x45, 0xa9, 0x69, 0x99, 0x15, 0x12, 0x4c, 0x60, 0x85,
-	0xc2, 0x50, 0x49, 0x27, 0xb0, 0x5c, 0x00, 0x58, 0x4a, 0xc8, 0x91, 0x4b, 0x36, 0x39, 0x3f, 0xb7,
-	0x20, 0x31, 0x19, 0xa4, 0x18, 0x9b, 0x5e, 0x66, 0xb0, 0x5e, 0x29, 0xa8, 0xa2, 0x00, 0x4c, 0x23,
-	0x9c, 0x54, 0xa3, 0x94, 0x8b, 0x4b, 0xf2, 0x8b, 0xb2, 0xf4, 0x32, 0xf3, 0xf5, 0xc1, 0x0c, 0x7d,
-	0xb8, 0x8f, 0xf4, 0x33, 0xf3, 0x4a, 0x52, 0x8b, 0xf2, 0x12, 0x73, 0x0a, 0x92, 0x92, 0xd8, 0xc0,
-	0xde, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x00, 0xcc, 0x15, 0xb0, 0x02, 0x01, 0x00, 0x00,
+	// 217 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xcf, 0x4a, 0xc4, 0x30,
+	0x10, 0x87, 0xa9, 0x8a, 0x68, 0xe8, 0x41, 0xa2, 0x42, 0x51, 0x84, 0xa2, 0x08, 0x9e, 0x5a, 0xd0,
+	0x27, 0xb0, 0x27, 0x45, 0xc5, 0xe2, 0xd1, 0x4b, 0x98, 0x26, 0x23, 0x4e, 0xff, 0x25, 0xa4, 0x23,
+	0xd8, 0x57, 0xdc, 0xa7, 0x5a, 0x36, 0x1b, 0x76, 0x61, 0xd9, 0xdb, 0xcc, 0x7c, 0xbf, 0x6f, 0x60,
+	0x46, 0x9c, 0x59, 0x6f, 0xd0, 0x4f, 0x03, 0x32, 0x14, 0xce, 0x5b, 0xb6, 0xf2, 0x62, 0x02, 0xc6,
+	0xbe, 0x27, 0xc6, 0x62, 0xcb, 0x6e, 0x17, 0x89, 0x90, 0x9f, 0xab, 0xf6, 0x9d, 0x06, 0xe2, 0x0f,
+	0x64, 0x30, 0xc0, 0x20, 0xaf, 0xc5, 0x69, 0xf3, 0xa7, 0x3b, 0x64, 0x45, 0x26, 0x4b, 0xf2, 0xe4,
+	0x21, 0xfd, 0x3a, 0x59, 0x0f, 0x5e, 0x8d, 0x7c, 0x14, 0x97, 0xce, 0xdb, 0x16, 0x35, 0xab, 0x18,
+	0x72, 0x1e, 0x7f, 0xe8, 0x3f, 0x3b, 0x08, 0xc1, 0xf3, 0x08, 0xab, 0xc0, 0xea, 0x80, 0xe4, 0xb3,
+	0xb8, 0xd1, 0x76, 0x70, 0xa0, 0x59, 0x45, 0xbc, 0xe3, 0x1e, 0x06, 0xf7, 0x2a, 0x86, 0xea, 0x3d,
+	0x2b, 0x72, 0x91, 0x82, 0x23, 0xd5, 0xe1, 0xac, 0x7e, 0x11, 0x4c, 0x76, 0x14, 0x0c, 0x01, 0x8e,
+	0xde, 0x70, 0x7e, 0x41, 0x30, 0xd5, 0xfd, 0xf7, 0xdd, 0xc4, 0xd6, 0xb7, 0x05, 0xd9, 0x32, 0x14,
+	0xe5, 0xe6, 0xe6, 0x92, 0x46, 0x46, 0x3f, 0x42, 0xef, 0x9a, 0xe6, 0x38, 0x3c, 0xe4, 0x69, 0x39,
+	0x00, 0x82, 0x7f, 0xd9, 0x74, 0x24, 0x01, 0x00, 0x00,
 }
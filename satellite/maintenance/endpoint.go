@@ -0,0 +1,52 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package maintenance
+
+import (
+	"context"
+
+	"storj.io/storj/satellite/internalpb"
+)
+
+// Endpoint implements the maintenance status RPC for uplinks. It's only
+// reachable by clients which compile satellite/internalpb, see the package
+// documentation.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	internalpb.DRPCMaintenanceUnimplementedServer
+
+	service *Service
+}
+
+// NewEndpoint creates a new maintenance status endpoint.
+func NewEndpoint(service *Service) *Endpoint {
+	return &Endpoint{service: service}
+}
+
+// GetStatus returns the active and upcoming maintenance windows of the satellite.
+func (endpoint *Endpoint) GetStatus(ctx context.Context, req *internalpb.MaintenanceStatusRequest) (_ *internalpb.MaintenanceStatusResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	status := endpoint.service.Status(ctx)
+
+	return &internalpb.MaintenanceStatusResponse{
+		Active:       windowsToPB(status.Active),
+		Upcoming:     windowsToPB(status.Upcoming),
+		WritesFrozen: status.WritesFrozen,
+	}, nil
+}
+
+func windowsToPB(windows []Window) []internalpb.MaintenanceWindow {
+	list := make([]internalpb.MaintenanceWindow, 0, len(windows))
+	for _, window := range windows {
+		list = append(list, internalpb.MaintenanceWindow{
+			Start:       window.Start,
+			End:         window.End,
+			Message:     window.Message,
+			WriteFreeze: window.WriteFreeze,
+		})
+	}
+	return list
+}
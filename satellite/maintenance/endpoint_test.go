@@ -0,0 +1,73 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package maintenance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/maintenance"
+)
+
+func TestWriteFreeze(t *testing.T) {
+	now := time.Now()
+	window := maintenance.Window{
+		Start:       now.Add(time.Hour),
+		End:         now.Add(2 * time.Hour),
+		Message:     "database upgrade",
+		WriteFreeze: true,
+	}
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Maintenance.Windows = maintenance.Windows{window}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		err := uplink.Upload(ctx, sat, "bucket", "before", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+
+		conn, err := uplink.Dialer.DialNodeURL(ctx, sat.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := internalpb.NewDRPCMaintenanceClient(conn)
+
+		status, err := client.GetStatus(ctx, &internalpb.MaintenanceStatusRequest{})
+		require.NoError(t, err)
+		require.Empty(t, status.Active)
+		require.Len(t, status.Upcoming, 1)
+		require.Equal(t, window.Message, status.Upcoming[0].Message)
+		require.False(t, status.WritesFrozen)
+
+		sat.API.Maintenance.Service.SetNow(func() time.Time { return window.Start })
+		defer sat.API.Maintenance.Service.SetNow(time.Now)
+
+		status, err = client.GetStatus(ctx, &internalpb.MaintenanceStatusRequest{})
+		require.NoError(t, err)
+		require.Len(t, status.Active, 1)
+		require.True(t, status.WritesFrozen)
+
+		err = uplink.Upload(ctx, sat, "bucket", "during", testrand.Bytes(memory.KiB))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "writes are disabled during satellite maintenance")
+
+		// reads are still allowed.
+		_, err = uplink.Download(ctx, sat, "bucket", "before")
+		require.NoError(t, err)
+	})
+}
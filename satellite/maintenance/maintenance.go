@@ -0,0 +1,154 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package maintenance announces the maintenance windows of the satellite to
+// uplinks and console users, and freezes writes during them when configured.
+//
+// The Maintenance RPC is defined in satellite/internalpb, because the public
+// protocol in storj.io/common/pb can't be extended from this repository, so
+// uplink and gateway can't call it yet. Until the status is part of the
+// public protocol, clients only learn about a write freeze from the message
+// of the Unavailable error returned by metainfo, which contains the end of
+// the window, and the upcoming windows are only announced in the console.
+package maintenance
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the maintenance package.
+	Error = errs.Class("maintenance")
+)
+
+// Config contains configurable values for maintenance windows.
+type Config struct {
+	Windows        Windows       `help:"scheduled maintenance windows in JSON list format, e.g. [{\"start\":\"2022-08-01T00:00:00Z\",\"end\":\"2022-08-01T02:00:00Z\",\"message\":\"database upgrade\",\"writeFreeze\":true}]" default:"[]"`
+	AnnounceBefore time.Duration `help:"how long before it starts a maintenance window is announced" default:"168h"`
+}
+
+// Window is a scheduled maintenance of the satellite.
+type Window struct {
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+	Message string    `json:"message"`
+	// WriteFreeze is set when the satellite rejects writes during the window.
+	WriteFreeze bool `json:"writeFreeze"`
+}
+
+// Active returns whether the window is in progress.
+func (window Window) Active(now time.Time) bool {
+	return !now.Before(window.Start) && now.Before(window.End)
+}
+
+// Windows is a configuration value that contains a list of maintenance windows.
+//
+// Can be used as a flag.
+type Windows []Window
+
+// Type implements pflag.Value.
+func (Windows) Type() string { return "maintenance.Windows" }
+
+// String is required for pflag.Value.
+func (windows *Windows) String() string {
+	data, err := json.Marshal(*windows)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// Set sets the value from a JSON list of windows.
+func (windows *Windows) Set(s string) error {
+	var list []Window
+	if err := json.Unmarshal([]byte(s), &list); err != nil {
+		return Error.New("invalid maintenance windows: %w", err)
+	}
+	for _, window := range list {
+		if !window.Start.Before(window.End) {
+			return Error.New("maintenance window must end after it starts: %s - %s", window.Start, window.End)
+		}
+	}
+	sort.Slice(list, func(i, k int) bool {
+		return list[i].Start.Before(list[k].Start)
+	})
+
+	*windows = list
+	return nil
+}
+
+// Status is the maintenance status of the satellite.
+type Status struct {
+	// Active are the windows which are in progress.
+	Active []Window `json:"active"`
+	// Upcoming are the windows which will start soon, ordered by start time.
+	Upcoming []Window `json:"upcoming"`
+	// WritesFrozen is set when the satellite currently rejects writes.
+	WritesFrozen bool `json:"writesFrozen"`
+}
+
+// Service provides the maintenance status of the satellite.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	config Config
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new maintenance service.
+func NewService(log *zap.Logger, config Config) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+		nowFn:  time.Now,
+	}
+}
+
+// SetNow allows tests to have the service act as if the current time is
+// whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// Status returns the active and upcoming maintenance windows.
+func (service *Service) Status(ctx context.Context) Status {
+	defer mon.Task()(&ctx)(nil)
+
+	now := service.nowFn()
+	status := Status{
+		Active:   []Window{},
+		Upcoming: []Window{},
+	}
+	for _, window := range service.config.Windows {
+		switch {
+		case window.Active(now):
+			status.Active = append(status.Active, window)
+			status.WritesFrozen = status.WritesFrozen || window.WriteFreeze
+		case window.Start.After(now) && window.Start.Sub(now) <= service.config.AnnounceBefore:
+			status.Upcoming = append(status.Upcoming, window)
+		}
+	}
+	return status
+}
+
+// WriteFreeze returns the active window which freezes writes, if there is one.
+func (service *Service) WriteFreeze(ctx context.Context) (_ Window, frozen bool) {
+	now := service.nowFn()
+	for _, window := range service.config.Windows {
+		if window.WriteFreeze && window.Active(now) {
+			return window, true
+		}
+	}
+	return Window{}, false
+}
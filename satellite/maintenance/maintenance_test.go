@@ -0,0 +1,69 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package maintenance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/maintenance"
+)
+
+func TestWindowsSet(t *testing.T) {
+	var windows maintenance.Windows
+	require.NoError(t, windows.Set(`[]`))
+	require.Empty(t, windows)
+	require.Equal(t, `[]`, windows.String())
+
+	err := windows.Set(`[
+		{"start": "2022-08-02T00:00:00Z", "end": "2022-08-02T01:00:00Z", "message": "second"},
+		{"start": "2022-08-01T00:00:00Z", "end": "2022-08-01T01:00:00Z", "message": "first", "writeFreeze": true}
+	]`)
+	require.NoError(t, err)
+	require.Len(t, windows, 2)
+	require.Equal(t, "first", windows[0].Message)
+	require.True(t, windows[0].WriteFreeze)
+	require.Equal(t, "second", windows[1].Message)
+
+	require.Error(t, windows.Set(`not json`))
+	require.Error(t, windows.Set(`[{"start": "2022-08-01T01:00:00Z", "end": "2022-08-01T00:00:00Z"}]`))
+}
+
+func TestServiceStatus(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	now := time.Date(2022, 8, 1, 12, 0, 0, 0, time.UTC)
+	active := maintenance.Window{Start: now.Add(-time.Hour), End: now.Add(time.Hour), Message: "active", WriteFreeze: true}
+	upcoming := maintenance.Window{Start: now.Add(24 * time.Hour), End: now.Add(25 * time.Hour), Message: "upcoming"}
+	distant := maintenance.Window{Start: now.Add(30 * 24 * time.Hour), End: now.Add(31 * 24 * time.Hour), Message: "distant"}
+	past := maintenance.Window{Start: now.Add(-3 * time.Hour), End: now.Add(-2 * time.Hour), Message: "past", WriteFreeze: true}
+
+	service := maintenance.NewService(zaptest.NewLogger(t), maintenance.Config{
+		Windows:        maintenance.Windows{past, active, upcoming, distant},
+		AnnounceBefore: 7 * 24 * time.Hour,
+	})
+	service.SetNow(func() time.Time { return now })
+
+	status := service.Status(ctx)
+	require.Equal(t, []maintenance.Window{active}, status.Active)
+	require.Equal(t, []maintenance.Window{upcoming}, status.Upcoming)
+	require.True(t, status.WritesFrozen)
+
+	window, frozen := service.WriteFreeze(ctx)
+	require.True(t, frozen)
+	require.Equal(t, active, window)
+
+	// the end of the window is exclusive.
+	service.SetNow(func() time.Time { return active.End })
+	status = service.Status(ctx)
+	require.Empty(t, status.Active)
+	require.False(t, status.WritesFrozen)
+
+	_, frozen = service.WriteFreeze(ctx)
+	require.False(t, frozen)
+}
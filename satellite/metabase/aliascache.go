@@ -22,12 +22,20 @@ type NodeAliasCache struct {
 	db         NodeAliasDB
 	refreshing sync.Mutex
 	latest     atomic.Value // *NodeAliasMap
+
+	// ensuring allows a single batch of missing nodes to be added at a time.
+	ensuring sync.Mutex
+	// pending contains the missing nodes, which will be added in the next batch.
+	pendingMu  sync.Mutex
+	pending    []storj.NodeID
+	pendingSet map[storj.NodeID]struct{}
 }
 
 // NewNodeAliasCache creates a new cache using the specified database.
 func NewNodeAliasCache(db NodeAliasDB) *NodeAliasCache {
 	cache := &NodeAliasCache{
-		db: db,
+		db:         db,
+		pendingSet: map[storj.NodeID]struct{}{},
 	}
 	cache.latest.Store(NewNodeAliasMap(nil))
 	return cache
@@ -91,15 +99,66 @@ func (cache *NodeAliasCache) Aliases(ctx context.Context, nodes []storj.NodeID)
 }
 
 // ensure tries to ensure that the specified missing node ID-s are assigned a alias.
+//
+// The node ID-s, which are missing in concurrent calls, are batched, so that
+// they are added to the database with a single query while the previous batch
+// is being added.
 func (cache *NodeAliasCache) ensure(ctx context.Context, missing ...storj.NodeID) (_ *NodeAliasMap, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	cache.addPending(missing)
+
+	cache.ensuring.Lock()
+	defer cache.ensuring.Unlock()
+
+	// Maybe some other goroutine already added the nodes in its batch, double-check.
+	latest := cache.getLatest()
+	if latest.ContainsAll(missing, nil) {
+		return latest, nil
+	}
+
+	// The nodes are added to the batch again, since the batch, which took
+	// them, may have failed.
+	batch := cache.takePending(missing)
+	mon.IntVal("node_alias_ensure_batch_size").Observe(int64(len(batch)))
+
 	if err := cache.db.EnsureNodeAliases(ctx, EnsureNodeAliases{
-		Nodes: missing,
+		Nodes: batch,
 	}); err != nil {
 		return nil, Error.New("failed to update node alias db: %w", err)
 	}
-	return cache.refresh(ctx, missing, nil)
+	return cache.refresh(ctx, batch, nil)
+}
+
+// addPending adds the nodes to the next batch.
+func (cache *NodeAliasCache) addPending(nodes []storj.NodeID) {
+	cache.pendingMu.Lock()
+	defer cache.pendingMu.Unlock()
+
+	cache.addPendingLocked(nodes)
+}
+
+// takePending adds the nodes to the next batch and returns the batch.
+func (cache *NodeAliasCache) takePending(nodes []storj.NodeID) []storj.NodeID {
+	cache.pendingMu.Lock()
+	defer cache.pendingMu.Unlock()
+
+	cache.addPendingLocked(nodes)
+
+	batch := cache.pending
+	cache.pending = nil
+	cache.pendingSet = map[storj.NodeID]struct{}{}
+	return batch
+}
+
+func (cache *NodeAliasCache) addPendingLocked(nodes []storj.NodeID) {
+	for _, node := range nodes {
+		if _, ok := cache.pendingSet[node]; ok {
+			continue
+		}
+		cache.pendingSet[node] = struct{}{}
+		cache.pending = append(cache.pending, node)
+	}
 }
 
 // refresh refreshses the state of the cache.
@@ -101,6 +101,78 @@ func TestNodeAliasCache(t *testing.T) {
 		}
 	})
 
+	t.Run("Aliases ensure once", func(t *testing.T) {
+		for repeat := 0; repeat < 3; repeat++ {
+			database := &NodeAliasDB{}
+			cache := metabase.NewNodeAliasCache(database)
+			n1, n2 := testrand.NodeID(), testrand.NodeID()
+
+			start := make(chan struct{})
+			const N = 4
+			var waiting sync.WaitGroup
+			waiting.Add(N)
+
+			var group errgroup.Group
+			for k := 0; k < N; k++ {
+				group.Go(func() error {
+					waiting.Done()
+					<-start
+
+					_, err := cache.Aliases(ctx, []storj.NodeID{n1, n2})
+					return err
+				})
+			}
+
+			waiting.Wait()
+			close(start)
+			require.NoError(t, group.Wait())
+
+			require.Equal(t, int64(1), database.EnsureNodeAliasesCount())
+		}
+	})
+
+	t.Run("Aliases batched", func(t *testing.T) {
+		database := &NodeAliasDB{}
+		cache := metabase.NewNodeAliasCache(database)
+
+		const N = 16
+		nodes := make([]storj.NodeID, N)
+		for i := range nodes {
+			nodes[i] = testrand.NodeID()
+		}
+
+		start := make(chan struct{})
+		var waiting sync.WaitGroup
+		waiting.Add(N)
+
+		aliases := make([]metabase.NodeAlias, N)
+		var group errgroup.Group
+		for k := range nodes {
+			k := k
+			group.Go(func() error {
+				waiting.Done()
+				<-start
+
+				xs, err := cache.Aliases(ctx, []storj.NodeID{nodes[k]})
+				if err != nil {
+					return err
+				}
+				aliases[k] = xs[0]
+				return nil
+			})
+		}
+
+		waiting.Wait()
+		close(start)
+		require.NoError(t, group.Wait())
+
+		require.LessOrEqual(t, database.EnsureNodeAliasesCount(), int64(N))
+
+		result, err := cache.Nodes(ctx, aliases)
+		require.NoError(t, err)
+		require.Equal(t, nodes, result)
+	})
+
 	t.Run("Nodes refresh once", func(t *testing.T) {
 		for repeat := 0; repeat < 3; repeat++ {
 			n1, n2 := testrand.NodeID(), testrand.NodeID()
@@ -0,0 +1,94 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlifecycle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestBucketLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.BucketLifecycle.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+		chore := sat.Core.BucketLifecycle.Chore
+
+		chore.Loop.Pause()
+
+		for _, bucket := range []string{"expiring", "aborting", "unchanged"} {
+			require.NoError(t, upl.CreateBucket(ctx, sat, bucket))
+			require.NoError(t, upl.Upload(ctx, sat, bucket, "committed_object", testrand.Bytes(1*memory.KiB)))
+		}
+
+		project, err := upl.OpenProject(ctx, sat)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		for _, bucket := range []string{"expiring", "aborting", "unchanged"} {
+			_, err = project.BeginUpload(ctx, bucket, "pending_object", nil)
+			require.NoError(t, err)
+		}
+
+		days := func(n int) *int { return &n }
+		err = sat.DB.Buckets().UpdateLifecycleRules(ctx, []byte("expiring"), projectID, []buckets.LifecycleRule{
+			{ID: "expire", ExpirationDays: days(1)},
+		})
+		require.NoError(t, err)
+		err = sat.DB.Buckets().UpdateLifecycleRules(ctx, []byte("aborting"), projectID, []buckets.LifecycleRule{
+			{ID: "abort", AbortIncompleteMultipartUploadDays: days(1)},
+		})
+		require.NoError(t, err)
+		err = sat.DB.Buckets().UpdateLifecycleRules(ctx, []byte("unchanged"), projectID, []buckets.LifecycleRule{
+			{ID: "later", ExpirationDays: days(3), AbortIncompleteMultipartUploadDays: days(3)},
+		})
+		require.NoError(t, err)
+
+		// nothing is old enough yet.
+		chore.Loop.TriggerWait()
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 6)
+
+		chore.TestingSetNow(func() time.Time {
+			return time.Now().Add(25 * time.Hour)
+		})
+		chore.Loop.TriggerWait()
+
+		objects, err = sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+
+		require.Len(t, objects, 4)
+
+		// only the pending object of the expiring bucket and the committed
+		// object of the aborting bucket are left from the two buckets.
+		for _, object := range objects {
+			switch object.BucketName {
+			case "expiring":
+				require.Equal(t, metabase.Pending, object.Status)
+			case "aborting":
+				require.Equal(t, metabase.Committed, object.Status)
+			}
+		}
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlifecycle
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error defines the bucketlifecycle chore errors class.
+	Error = errs.Class("bucket lifecycle chore")
+	mon   = monkit.Package()
+)
+
+// Day is the unit of the ages in the lifecycle rules.
+const Day = 24 * time.Hour
+
+// Config contains configurable values for the bucket lifecycle chore.
+type Config struct {
+	Interval           time.Duration `help:"the time between each attempt to apply the bucket lifecycle rules" releaseDefault:"24h" devDefault:"1h"`
+	Enabled            bool          `help:"set if applying the bucket lifecycle rules is enabled or not" default:"false"`
+	ListLimit          int           `help:"how many objects to delete in a batch" default:"100"`
+	AsOfSystemInterval time.Duration `help:"as of system interval for querying the objects to delete" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+}
+
+// Chore implements the bucket lifecycle chore.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	buckets  buckets.DB
+	metabase *metabase.DB
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the bucketlifecycle chore.
+func NewChore(log *zap.Logger, config Config, buckets buckets.DB, metabase *metabase.DB) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		buckets:  buckets,
+		metabase: metabase,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the bucketlifecycle loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.applyRules)
+}
+
+// Close stops the bucketlifecycle chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestingSetNow allows tests to have the server act as if the current time is whatever they want.
+func (chore *Chore) TestingSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+func (chore *Chore) applyRules(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	chore.log.Debug("applying bucket lifecycle rules")
+
+	rules, err := chore.buckets.ListLifecycleRules(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	now := chore.nowFn()
+	for _, rule := range rules {
+		// a failing rule shouldn't prevent applying the rules of the other buckets.
+		if err := chore.applyRule(ctx, now, rule); err != nil {
+			chore.log.Error("failed to apply bucket lifecycle rule",
+				zap.Stringer("Project ID", rule.Bucket.ProjectID),
+				zap.String("Bucket", rule.Bucket.BucketName),
+				zap.String("Rule", rule.ID),
+				zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+func (chore *Chore) applyRule(ctx context.Context, now time.Time, rule buckets.BucketLifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if rule.ExpirationDays != nil {
+		deleted, err := chore.deleteObjects(ctx, now, rule, metabase.Committed, *rule.ExpirationDays)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		mon.Meter("bucket_lifecycle_expired_objects").Mark64(deleted)
+	}
+
+	if rule.AbortIncompleteMultipartUploadDays != nil {
+		deleted, err := chore.deleteObjects(ctx, now, rule, metabase.Pending, *rule.AbortIncompleteMultipartUploadDays)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		mon.Meter("bucket_lifecycle_aborted_uploads").Mark64(deleted)
+	}
+
+	return nil
+}
+
+func (chore *Chore) deleteObjects(ctx context.Context, now time.Time, rule buckets.BucketLifecycleRule, status metabase.ObjectStatus, days int) (int64, error) {
+	deleted, err := chore.metabase.DeleteObjectsCreatedBefore(ctx, metabase.DeleteObjectsCreatedBefore{
+		Bucket:         rule.Bucket,
+		Prefix:         metabase.ObjectKey(rule.Prefix),
+		Status:         status,
+		CreatedBefore:  now.Add(-time.Duration(days) * Day),
+		AsOfSystemTime: time.Now().Add(chore.config.AsOfSystemInterval),
+		BatchSize:      chore.config.ListLimit,
+	})
+	if deleted > 0 {
+		chore.log.Debug("deleted objects by bucket lifecycle rule",
+			zap.Stringer("Project ID", rule.Bucket.ProjectID),
+			zap.String("Bucket", rule.Bucket.BucketName),
+			zap.String("Rule", rule.ID),
+			zap.Int("Status", int(status)),
+			zap.Int64("Deleted", deleted))
+	}
+	return deleted, err
+}
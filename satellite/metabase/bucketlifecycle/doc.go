@@ -0,0 +1,11 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package bucketlifecycle contains the functions needed to run the bucket lifecycle chore.
+
+The bucketlifecycle chore will periodically apply the lifecycle rules of the
+buckets, deleting the committed objects and the incomplete uploads, which are
+older than the rules allow, with their segments.
+*/
+package bucketlifecycle
@@ -0,0 +1,167 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+)
+
+// BucketTally contains information about aggregate data stored in a bucket.
+type BucketTally struct {
+	BucketLocation
+
+	ObjectCount        int64
+	PendingObjectCount int64
+
+	TotalSegments      int64
+	TotalPlainSize     int64
+	TotalEncryptedSize int64
+
+	MetadataSize int64
+}
+
+// CollectBucketTallies contains arguments necessary for looking up the
+// tallies of the buckets.
+type CollectBucketTallies struct {
+	// After is the last bucket collected by the previous call, zero for the
+	// first call.
+	After BucketLocation
+	// Limit is the maximum number of buckets collected by a call.
+	Limit int
+
+	// Now controls when the objects are considered to be expired. The current
+	// time is used when it's zero.
+	Now time.Time
+
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
+}
+
+// CollectBucketTallies collects the tallies of the buckets after opts.After,
+// in the order of the buckets. Expired objects and delete markers are not
+// counted. A bucket without any counted object is not returned.
+//
+// The tallies are aggregated by the database, so the objects aren't sent to
+// the caller.
+func (db *DB) CollectBucketTallies(ctx context.Context, opts CollectBucketTallies) (result []BucketTally, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.Limit < 0 {
+		return nil, ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	}
+
+	ListLimit.Ensure(&opts.Limit)
+
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			project_id, bucket_name,
+			`+bucketTallyColumns+`
+		FROM objects
+		`+db.asOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval)+`
+		WHERE
+			(project_id, bucket_name) > ($1, $2) AND
+			status <> `+deleteMarkerStatus+` AND
+			(expires_at IS NULL OR expires_at > $3)
+		GROUP BY project_id, bucket_name
+		ORDER BY project_id ASC, bucket_name ASC
+		LIMIT $4
+	`, opts.After.ProjectID, []byte(opts.After.BucketName), opts.Now, opts.Limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var tally BucketTally
+			var bucketName []byte
+			err := rows.Scan(
+				&tally.ProjectID, &bucketName,
+				&tally.ObjectCount, &tally.PendingObjectCount,
+				&tally.TotalSegments, &tally.TotalPlainSize, &tally.TotalEncryptedSize,
+				&tally.MetadataSize,
+			)
+			if err != nil {
+				return Error.New("failed to scan bucket tallies: %w", err)
+			}
+			tally.BucketName = string(bucketName)
+
+			result = append(result, tally)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to collect bucket tallies: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetBucketTotals contains arguments necessary for looking up the totals of
+// a bucket.
+type GetBucketTotals struct {
+	ProjectID  uuid.UUID
+	BucketName string
+
+	// Now controls when the objects are considered to be expired. The current
+	// time is used when it's zero.
+	Now time.Time
+
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
+}
+
+// GetBucketTotals returns the totals of a single bucket. Expired objects and
+// delete markers are not counted. The totals are zero for an empty bucket.
+// This method doesn't check bucket existence.
+func (db *DB) GetBucketTotals(ctx context.Context, opts GetBucketTotals) (tally BucketTally, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case opts.ProjectID.IsZero():
+		return BucketTally{}, ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return BucketTally{}, ErrInvalidRequest.New("BucketName missing")
+	}
+
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	tally.ProjectID = opts.ProjectID
+	tally.BucketName = opts.BucketName
+
+	err = db.db.QueryRowContext(ctx, `
+		SELECT
+			`+bucketTallyColumns+`
+		FROM objects
+		`+db.asOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval)+`
+		WHERE
+			project_id  = $1 AND
+			bucket_name = $2 AND
+			status <> `+deleteMarkerStatus+` AND
+			(expires_at IS NULL OR expires_at > $3)
+	`, opts.ProjectID, []byte(opts.BucketName), opts.Now).Scan(
+		&tally.ObjectCount, &tally.PendingObjectCount,
+		&tally.TotalSegments, &tally.TotalPlainSize, &tally.TotalEncryptedSize,
+		&tally.MetadataSize,
+	)
+	if err != nil {
+		return BucketTally{}, Error.New("unable to query bucket totals: %w", err)
+	}
+
+	return tally, nil
+}
+
+// bucketTallyColumns are the aggregates scanned into BucketTally.
+const bucketTallyColumns = `
+	count(*)::INT8,
+	COALESCE(SUM(CASE WHEN status = ` + pendingStatus + ` THEN 1 ELSE 0 END), 0)::INT8,
+	COALESCE(SUM(segment_count), 0)::INT8,
+	COALESCE(SUM(total_plain_size), 0)::INT8,
+	COALESCE(SUM(total_encrypted_size), 0)::INT8,
+	COALESCE(SUM(LENGTH(COALESCE(encrypted_metadata, ''))), 0)::INT8
+`
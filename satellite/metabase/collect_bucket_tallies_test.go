@@ -0,0 +1,182 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestCollectBucketTallies(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CollectBucketTallies{
+				Opts:     metabase.CollectBucketTallies{Limit: -1},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Invalid limit: -1",
+			}.Check(ctx, t, db)
+
+			metabasetest.GetBucketTotals{
+				Opts:     metabase.GetBucketTotals{},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.GetBucketTotals{
+				Opts:     metabase.GetBucketTotals{ProjectID: uuid.UUID{1}},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("empty", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CollectBucketTallies{}.Check(ctx, t, db)
+
+			metabasetest.GetBucketTotals{
+				Opts: metabase.GetBucketTotals{
+					ProjectID:  uuid.UUID{1},
+					BucketName: "bucket",
+				},
+				Result: metabase.BucketTally{
+					BucketLocation: metabase.BucketLocation{
+						ProjectID:  uuid.UUID{1},
+						BucketName: "bucket",
+					},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("buckets", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			now := time.Now()
+
+			first := metabasetest.RandObjectStream()
+			first.ProjectID = uuid.UUID{1}
+			first.BucketName = "a"
+
+			second := metabasetest.RandObjectStream()
+			second.ProjectID = uuid.UUID{1}
+			second.BucketName = "a"
+
+			pending := metabasetest.RandObjectStream()
+			pending.ProjectID = uuid.UUID{1}
+			pending.BucketName = "a"
+
+			expired := metabasetest.RandObjectStream()
+			expired.ProjectID = uuid.UUID{1}
+			expired.BucketName = "a"
+
+			other := metabasetest.RandObjectStream()
+			other.ProjectID = uuid.UUID{2}
+			other.BucketName = "b"
+
+			onlyExpired := metabasetest.RandObjectStream()
+			onlyExpired.ProjectID = uuid.UUID{3}
+			onlyExpired.BucketName = "c"
+
+			firstObject := metabasetest.CreateObject(ctx, t, db, first, 2)
+			secondObject := metabasetest.CreateObject(ctx, t, db, second, 1)
+			metabasetest.CreatePendingObject(ctx, t, db, pending, 0)
+			metabasetest.CreateExpiredObject(ctx, t, db, expired, 1, now.Add(-time.Hour))
+			otherObject := metabasetest.CreateObject(ctx, t, db, other, 3)
+			metabasetest.CreateExpiredObject(ctx, t, db, onlyExpired, 1, now.Add(-time.Hour))
+
+			tallyOf := func(objects ...metabase.Object) metabase.BucketTally {
+				tally := metabase.BucketTally{BucketLocation: objects[0].Location().Bucket()}
+				for _, object := range objects {
+					tally.ObjectCount++
+					tally.TotalSegments += int64(object.SegmentCount)
+					tally.TotalPlainSize += object.TotalPlainSize
+					tally.TotalEncryptedSize += object.TotalEncryptedSize
+					tally.MetadataSize += int64(len(object.EncryptedMetadata))
+				}
+				return tally
+			}
+
+			bucketA := tallyOf(firstObject, secondObject)
+			bucketA.ObjectCount++
+			bucketA.PendingObjectCount++
+			bucketB := tallyOf(otherObject)
+
+			metabasetest.CollectBucketTallies{
+				Opts: metabase.CollectBucketTallies{
+					Now: now,
+				},
+				Result: []metabase.BucketTally{bucketA, bucketB},
+			}.Check(ctx, t, db)
+
+			metabasetest.CollectBucketTallies{
+				Opts: metabase.CollectBucketTallies{
+					Limit: 1,
+					Now:   now,
+				},
+				Result: []metabase.BucketTally{bucketA},
+			}.Check(ctx, t, db)
+
+			metabasetest.CollectBucketTallies{
+				Opts: metabase.CollectBucketTallies{
+					After: bucketA.BucketLocation,
+					Limit: 1,
+					Now:   now,
+				},
+				Result: []metabase.BucketTally{bucketB},
+			}.Check(ctx, t, db)
+
+			metabasetest.CollectBucketTallies{
+				Opts: metabase.CollectBucketTallies{
+					After: bucketB.BucketLocation,
+					Now:   now,
+				},
+			}.Check(ctx, t, db)
+
+			// the expired objects are counted, when they haven't expired yet.
+			bucketC := metabase.BucketTally{
+				BucketLocation: onlyExpired.Location().Bucket(),
+				ObjectCount:    1,
+			}
+			tallies, err := db.CollectBucketTallies(ctx, metabase.CollectBucketTallies{
+				After: bucketB.BucketLocation,
+				Now:   now.Add(-2 * time.Hour),
+			})
+			require.NoError(t, err)
+			require.Len(t, tallies, 1)
+			require.Equal(t, bucketC.BucketLocation, tallies[0].BucketLocation)
+			require.Equal(t, bucketC.ObjectCount, tallies[0].ObjectCount)
+
+			metabasetest.GetBucketTotals{
+				Opts: metabase.GetBucketTotals{
+					ProjectID:  first.ProjectID,
+					BucketName: first.BucketName,
+					Now:        now,
+				},
+				Result: bucketA,
+			}.Check(ctx, t, db)
+
+			metabasetest.GetBucketTotals{
+				Opts: metabase.GetBucketTotals{
+					ProjectID:  onlyExpired.ProjectID,
+					BucketName: onlyExpired.BucketName,
+					Now:        now,
+				},
+				Result: metabase.BucketTally{BucketLocation: bucketC.BucketLocation},
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -15,7 +15,6 @@ import (
 	"storj.io/common/memory"
 	"storj.io/common/storj"
 	"storj.io/private/dbutil/pgutil/pgerrcode"
-	"storj.io/private/dbutil/txutil"
 	"storj.io/private/tagsql"
 )
 
@@ -498,13 +497,18 @@ func (c *CommitObject) Verify() error {
 
 // CommitObject adds a pending object to the database.
 func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Object, err error) {
+	return db.commitObject(ctx, opts, nil)
+}
+
+// commitObject commits the object in tx, or in a new transaction when tx is nil.
+func (db *DB) commitObject(ctx context.Context, opts CommitObject, tx tagsql.Tx) (object Object, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if err := opts.Verify(); err != nil {
 		return Object{}, err
 	}
 
-	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+	err = db.withTx(ctx, tx, func(ctx context.Context, tx tagsql.Tx) error {
 		segments, err := fetchSegmentsForCommit(ctx, tx, opts.StreamID)
 		if err != nil {
 			return Error.New("failed to fetch segments: %w", err)
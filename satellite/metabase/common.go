@@ -23,6 +23,9 @@ var (
 	ErrObjectAlreadyExists = errs.Class("object already exists")
 	// ErrPendingObjectMissing is used to indicate a pending object is no longer accessible.
 	ErrPendingObjectMissing = errs.Class("pending object missing")
+	// ErrObjectModified is used to indicate that the object was replaced by
+	// another upload since the request read it.
+	ErrObjectModified = errs.Class("object modified")
 )
 
 // Common constants for segment keys.
@@ -326,9 +329,17 @@ const (
 	Pending = ObjectStatus(1)
 	// Committed means that the object is finished and should be visible for general listing.
 	Committed = ObjectStatus(3)
-
-	pendingStatus   = "1"
-	committedStatus = "3"
+	// DeleteMarker means that the object was deleted, while its previous versions are kept.
+	// A delete marker is the latest version of the object and it doesn't have any segments.
+	DeleteMarker = ObjectStatus(4)
+	// Trashed means that the object was deleted, but it can be restored until its restore deadline.
+	// A trashed object keeps its segments and it isn't visible for general listing.
+	Trashed = ObjectStatus(5)
+
+	pendingStatus      = "1"
+	committedStatus    = "3"
+	deleteMarkerStatus = "4"
+	trashedStatus      = "5"
 )
 
 // Pieces defines information for pieces.
@@ -14,7 +14,6 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/private/dbutil/pgutil"
-	"storj.io/private/dbutil/txutil"
 	"storj.io/private/tagsql"
 )
 
@@ -90,31 +89,43 @@ func (db *DB) BeginCopyObject(ctx context.Context, opts BeginCopyObject) (result
 		}
 	}
 
+	result.EncryptedKeysNonces, err = db.listSegmentKeys(ctx, result.StreamID)
+	if err != nil {
+		return BeginCopyObjectResult{}, err
+	}
+
+	return result, nil
+}
+
+// listSegmentKeys returns the encrypted keys of the segments of the stream.
+func (db *DB) listSegmentKeys(ctx context.Context, streamID uuid.UUID) (keys []EncryptedKeyAndNonce, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	err = withRows(db.db.QueryContext(ctx, `
 		SELECT
 			position, encrypted_key_nonce, encrypted_key
 		FROM segments
 		WHERE stream_id = $1
 		ORDER BY stream_id, position ASC
-	`, result.StreamID))(func(rows tagsql.Rows) error {
+	`, streamID))(func(rows tagsql.Rows) error {
 		for rows.Next() {
-			var keys EncryptedKeyAndNonce
+			var key EncryptedKeyAndNonce
 
-			err = rows.Scan(&keys.Position, &keys.EncryptedKeyNonce, &keys.EncryptedKey)
+			err = rows.Scan(&key.Position, &key.EncryptedKeyNonce, &key.EncryptedKey)
 			if err != nil {
 				return Error.New("failed to scan segments: %w", err)
 			}
 
-			result.EncryptedKeysNonces = append(result.EncryptedKeysNonces, keys)
+			keys = append(keys, key)
 		}
 
 		return nil
 	})
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return BeginCopyObjectResult{}, Error.New("unable to fetch object segments: %w", err)
+		return nil, Error.New("unable to fetch object segments: %w", err)
 	}
 
-	return result, nil
+	return keys, nil
 }
 
 // FinishCopyObject holds all data needed to finish object copy.
@@ -124,6 +135,10 @@ type FinishCopyObject struct {
 	NewEncryptedObjectKey ObjectKey
 	NewStreamID           uuid.UUID
 
+	// NewProjectID is the project of the copy. The copy is made in the
+	// project of the source object when it's zero.
+	NewProjectID uuid.UUID
+
 	OverrideMetadata             bool
 	NewEncryptedMetadata         []byte
 	NewEncryptedMetadataKeyNonce storj.Nonce
@@ -172,9 +187,22 @@ func (finishCopy FinishCopyObject) Verify() error {
 	return nil
 }
 
+// newProjectID returns the project of the copy.
+func (finishCopy FinishCopyObject) newProjectID() uuid.UUID {
+	if finishCopy.NewProjectID.IsZero() {
+		return finishCopy.ProjectID
+	}
+	return finishCopy.NewProjectID
+}
+
 // FinishCopyObject accepts new encryption keys for copied object and insert the corresponding new object ObjectKey and segments EncryptedKey.
 // It returns the object at the destination location.
 func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (object Object, err error) {
+	return db.finishCopyObject(ctx, opts, nil)
+}
+
+// finishCopyObject copies the object in tx, or in a new transaction when tx is nil.
+func (db *DB) finishCopyObject(ctx context.Context, opts FinishCopyObject, tx tagsql.Tx) (object Object, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if err := opts.Verify(); err != nil {
@@ -184,7 +212,7 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 	newObject := Object{}
 	var copyMetadata []byte
 
-	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+	err = db.withTx(ctx, tx, func(ctx context.Context, tx tagsql.Tx) (err error) {
 
 		sourceObject, ancestorStreamID, objectAtDestination, err := getObjectAtCopySourceAndDestination(ctx, tx, opts)
 		if err != nil {
@@ -225,7 +253,7 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 		inlineDatas := make([][]byte, sourceObject.SegmentCount)
 
 		redundancySchemes := make([]int64, sourceObject.SegmentCount)
-		err = withRows(db.db.QueryContext(ctx, `
+		err = withRows(tx.QueryContext(ctx, `
 			SELECT
 				position,
 				expires_at,
@@ -328,7 +356,7 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 			)
 			RETURNING
 				created_at`,
-			opts.ProjectID, opts.NewBucket, opts.NewEncryptedObjectKey, opts.Version, opts.NewStreamID,
+			opts.newProjectID(), opts.NewBucket, opts.NewEncryptedObjectKey, opts.Version, opts.NewStreamID,
 			sourceObject.ExpiresAt, sourceObject.SegmentCount,
 			encryptionParameters{&sourceObject.Encryption},
 			copyMetadata, opts.NewEncryptedMetadataKeyNonce, opts.NewEncryptedMetadataKey,
@@ -390,6 +418,7 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 	}
 
 	newObject.StreamID = opts.NewStreamID
+	newObject.ProjectID = opts.newProjectID()
 	newObject.BucketName = opts.NewBucket
 	newObject.ObjectKey = opts.NewEncryptedObjectKey
 	newObject.EncryptedMetadata = copyMetadata
@@ -446,14 +475,15 @@ func getObjectAtCopySourceAndDestination(
 			NULL
 		FROM objects
 		WHERE
-			project_id   = $1 AND
+			project_id   = $7 AND
 			bucket_name  = $5 AND
 			object_key   = $6 AND
 			version      = $2 AND
 			status       = `+committedStatus,
 		opts.ProjectID, opts.Version,
 		[]byte(opts.BucketName), opts.ObjectKey,
-		opts.NewBucket, opts.NewEncryptedObjectKey)
+		opts.NewBucket, opts.NewEncryptedObjectKey,
+		opts.newProjectID())
 	if err != nil {
 		return Object{}, uuid.UUID{}, nil, err
 	}
@@ -505,7 +535,7 @@ func getObjectAtCopySourceAndDestination(
 	if rows.Next() {
 		var _bogusBytes []byte
 		destinationObject = &Object{}
-		destinationObject.ProjectID = opts.ProjectID
+		destinationObject.ProjectID = opts.newProjectID()
 		destinationObject.BucketName = opts.NewBucket
 		destinationObject.ObjectKey = opts.NewEncryptedObjectKey
 		// There is an object at the destination.
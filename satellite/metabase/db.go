@@ -36,6 +36,8 @@ type Config struct {
 	// TODO remove this flag when server-side copy implementation will be finished
 	ServerSideCopy         bool
 	ServerSideCopyDisabled bool
+
+	ReadReplica ReadReplicaConfig
 }
 
 // DB implements a database for storing objects and segments.
@@ -45,6 +47,9 @@ type DB struct {
 	connstr string
 	impl    dbutil.Implementation
 
+	// replica is nil when no read replica is configured.
+	replica *readReplica
+
 	aliasCache *NodeAliasCache
 
 	testCleanup func() error
@@ -89,6 +94,21 @@ func Open(ctx context.Context, log *zap.Logger, connstr string, config Config) (
 	}
 	db.aliasCache = NewNodeAliasCache(db)
 
+	if config.ReadReplica.DatabaseURL != "" {
+		_, _, replicaImpl, err := dbutil.SplitConnStr(config.ReadReplica.DatabaseURL)
+		if err != nil {
+			return nil, errs.Combine(Error.Wrap(err), rawdb.Close())
+		}
+		if replicaImpl != impl {
+			return nil, errs.Combine(Error.New("read replica implementation %s differs from %s", replicaImpl, impl), rawdb.Close())
+		}
+
+		db.replica, err = openReadReplica(ctx, log.Named("replica"), driverName, impl, config.ApplicationName, config.ReadReplica)
+		if err != nil {
+			return nil, errs.Combine(err, rawdb.Close())
+		}
+	}
+
 	log.Debug("Connected", zap.String("db source", connstr))
 
 	return db, nil
@@ -113,7 +133,11 @@ func (db *DB) TestingSetCleanup(cleanup func() error) {
 
 // Close closes the connection to database.
 func (db *DB) Close() error {
-	return errs.Combine(Error.Wrap(db.db.Close()), db.testCleanup())
+	var replicaErr error
+	if db.replica != nil {
+		replicaErr = Error.Wrap(db.replica.Close())
+	}
+	return errs.Combine(Error.Wrap(db.db.Close()), replicaErr, db.testCleanup())
 }
 
 // DestroyTables deletes all tables.
@@ -170,7 +194,7 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 			{
 				DB:          &db.db,
 				Description: "Test snapshot",
-				Version:     39,
+				Version:     40,
 				Action: migrate.SQL{
 
 					`CREATE TABLE objects (
@@ -197,8 +221,10 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 						encryption INT8 NOT NULL default 0,
 
 						zombie_deletion_deadline TIMESTAMPTZ default now() + '1 day',
+						restore_deadline         TIMESTAMPTZ,
 
-						PRIMARY KEY (project_id, bucket_name, object_key, version)
+						PRIMARY KEY (project_id, bucket_name, object_key, version),
+						CONSTRAINT objects_delete_marker_without_segments CHECK (status <> ` + deleteMarkerStatus + ` OR segment_count = 0)
 					);
 					CREATE TABLE segments (
 						stream_id  BYTEA NOT NULL,
@@ -224,8 +250,11 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 						placement integer,
 						encrypted_etag BYTEA default NULL,
 
+						health DOUBLE PRECISION,
+
 						PRIMARY KEY (stream_id, position)
 					);
+					CREATE INDEX segments_health_index ON segments (health) WHERE health IS NOT NULL;
 					CREATE SEQUENCE node_alias_seq
 						INCREMENT BY 1
 						MINVALUE 1 MAXVALUE 2147483647 -- MaxInt32
@@ -471,6 +500,38 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					`CREATE INDEX ON segment_copies (ancestor_stream_id)`,
 				},
 			},
+			{
+				DB:          &db.db,
+				Description: "add constraint for delete markers",
+				Version:     16,
+				Action: migrate.SQL{
+					`ALTER TABLE objects ADD CONSTRAINT objects_delete_marker_without_segments CHECK (status <> ` + deleteMarkerStatus + ` OR segment_count = 0)`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add restore_deadline column to objects table",
+				Version:     17,
+				Action: migrate.SQL{
+					`ALTER TABLE objects ADD COLUMN restore_deadline TIMESTAMPTZ`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add health column to segments table",
+				Version:     18,
+				Action: migrate.SQL{
+					`ALTER TABLE segments ADD COLUMN health DOUBLE PRECISION`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add index for the unhealthy segments",
+				Version:     19,
+				Action: migrate.SQL{
+					`CREATE INDEX segments_health_index ON segments (health) WHERE health IS NOT NULL`,
+				},
+			},
 		},
 	}
 }
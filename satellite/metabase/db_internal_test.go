@@ -4,12 +4,16 @@
 package metabase
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 
 	"storj.io/private/dbutil"
+	"storj.io/private/tagsql"
 )
 
 func TestLimitedAsOfSystemTime(t *testing.T) {
@@ -69,3 +73,37 @@ func TestLimitedAsOfSystemTime(t *testing.T) {
 	check("", unixNano, 0, 0)
 	check("", 0, unixNano, 0)
 }
+
+func TestReadReplicaReader(t *testing.T) {
+	ctx := context.Background()
+
+	type fakeDB struct{ tagsql.DB }
+	primary, secondary := &fakeDB{}, &fakeDB{}
+
+	now := time.Now()
+	db := &DB{
+		log: zap.NewNop(),
+		db:  primary,
+	}
+
+	// without a replica every read goes to the primary.
+	require.Equal(t, tagsql.DB(primary), db.reader(ctx, time.Hour))
+
+	db.replica = &readReplica{
+		db:     secondary,
+		config: ReadReplicaConfig{LagCheckInterval: time.Minute},
+		nowFn:  func() time.Time { return now },
+		// pretend the lag was just checked, so it's not queried.
+		checkedAt: now,
+		lag:       5 * time.Second,
+	}
+
+	require.Equal(t, tagsql.DB(primary), db.reader(ctx, 0))
+	require.Equal(t, tagsql.DB(primary), db.reader(ctx, time.Second))
+	require.Equal(t, tagsql.DB(secondary), db.reader(ctx, 5*time.Second))
+	require.Equal(t, tagsql.DB(secondary), db.reader(ctx, time.Minute))
+
+	// lag check failures fall back to the primary.
+	db.replica.lagErr = errs.New("replica unavailable")
+	require.Equal(t, tagsql.DB(primary), db.reader(ctx, time.Minute))
+}
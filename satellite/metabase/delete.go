@@ -482,7 +482,8 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 	return result, nil
 }
 
-// DeleteObjectAnyStatusAllVersions deletes all object versions.
+// DeleteObjectAnyStatusAllVersions deletes all object versions. The trashed
+// versions are kept until their restore deadline.
 func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteObjectAnyStatusAllVersions) (result DeleteObjectResult, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -500,7 +501,8 @@ func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteO
 				WHERE
 				project_id   = $1 AND
 				bucket_name  = $2 AND
-				object_key   = $3
+				object_key   = $3 AND
+				status       <> `+trashedStatus+`
 				RETURNING
 					version, stream_id,
 					created_at, expires_at,
@@ -96,6 +96,17 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureEnabled(ctx context.Context, opt
 		return deletedObjectCount, err
 	}
 
+	return db.deleteObjectBatchesWithCopyFeature(ctx, opts.Bucket, opts.DeletePieces, query,
+		opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize)
+}
+
+// deleteObjectBatchesWithCopyFeature runs query, which deletes a batch of
+// objects, until there are no objects left to delete. Pieces of the deleted
+// segments, which aren't linked to a promoted ancestor, are passed to
+// deletePieces.
+func (db *DB) deleteObjectBatchesWithCopyFeature(ctx context.Context, bucket BucketLocation, deletePieces func(context.Context, []DeletedSegmentInfo) error, query string, args ...interface{}) (deletedObjectCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	for {
 		if err := ctx.Err(); err != nil {
 			return deletedObjectCount, err
@@ -104,9 +115,9 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureEnabled(ctx context.Context, opt
 		objects := []deletedObjectInfo{}
 		err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
 			err = withRows(
-				tx.QueryContext(ctx, query, opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize),
+				tx.QueryContext(ctx, query, args...),
 			)(func(rows tagsql.Rows) error {
-				objects, err = db.scanBucketObjectsDeletionServerSideCopy(ctx, opts.Bucket, rows)
+				objects, err = db.scanBucketObjectsDeletionServerSideCopy(ctx, bucket, rows)
 				return err
 			})
 			if err != nil {
@@ -122,7 +133,7 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureEnabled(ctx context.Context, opt
 			return deletedObjectCount, err
 		}
 
-		if opts.DeletePieces == nil {
+		if deletePieces == nil {
 			// no callback, should only be in test path
 			continue
 		}
@@ -134,7 +145,7 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureEnabled(ctx context.Context, opt
 			}
 			for _, segment := range object.Segments {
 				// Is there an advantage to batching this?
-				err := opts.DeletePieces(ctx, []DeletedSegmentInfo{
+				err := deletePieces(ctx, []DeletedSegmentInfo{
 					{
 						RootPieceID: segment.RootPieceID,
 						Pieces:      segment.Pieces,
@@ -231,6 +242,16 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureDisabled(ctx context.Context, op
 		return 0, Error.New("unhandled database: %v", db.impl)
 	}
 
+	return db.deleteObjectBatches(ctx, opts.DeletePieces, query,
+		opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize)
+}
+
+// deleteObjectBatches runs query, which deletes a batch of objects with their
+// segments, until there are no segments left to delete. Pieces of the deleted
+// segments are passed to deletePieces.
+func (db *DB) deleteObjectBatches(ctx context.Context, deletePieces func(context.Context, []DeletedSegmentInfo) error, query string, args ...interface{}) (deletedObjectCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	// TODO: fix the count for objects without segments
 	deletedSegments := make([]DeletedSegmentInfo, 0, 100)
 	for {
@@ -240,8 +261,7 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureDisabled(ctx context.Context, op
 
 		deletedSegments = deletedSegments[:0]
 		deletedObjects := 0
-		err = withRows(db.db.QueryContext(ctx, query,
-			opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize))(func(rows tagsql.Rows) error {
+		err = withRows(db.db.QueryContext(ctx, query, args...))(func(rows tagsql.Rows) error {
 			ids := map[uuid.UUID]struct{}{} // TODO: avoid map here
 			for rows.Next() {
 				var streamID uuid.UUID
@@ -278,8 +298,8 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureDisabled(ctx context.Context, op
 			return deletedObjectCount, nil
 		}
 
-		if opts.DeletePieces != nil {
-			err = opts.DeletePieces(ctx, deletedSegments)
+		if deletePieces != nil {
+			err = deletePieces(ctx, deletedSegments)
 			if err != nil {
 				return deletedObjectCount, Error.Wrap(err)
 			}
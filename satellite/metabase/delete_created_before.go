@@ -0,0 +1,127 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/private/tagsql"
+)
+
+// DeleteObjectsCreatedBefore contains arguments for deleting the objects of
+// a bucket, whose key starts with Prefix and which were created before
+// CreatedBefore.
+type DeleteObjectsCreatedBefore struct {
+	Bucket BucketLocation
+	// Prefix is the prefix of the object keys, empty for the whole bucket.
+	Prefix ObjectKey
+	// Status is the status of the deleted objects, either Pending or Committed.
+	Status        ObjectStatus
+	CreatedBefore time.Time
+
+	AsOfSystemTime time.Time
+	BatchSize      int
+
+	// DeletePieces is called for every batch of objects with the remote
+	// segments that were deleted. When nil, the pieces are left for the
+	// garbage collection.
+	DeletePieces func(ctx context.Context, segments []DeletedSegmentInfo) error
+}
+
+// Verify verifies delete objects created before request fields.
+func (opts *DeleteObjectsCreatedBefore) Verify() error {
+	if err := opts.Bucket.Verify(); err != nil {
+		return err
+	}
+	if opts.Status != Pending && opts.Status != Committed {
+		return ErrInvalidRequest.New("Status must be pending or committed, got %v", opts.Status)
+	}
+	if opts.CreatedBefore.IsZero() {
+		return ErrInvalidRequest.New("CreatedBefore missing")
+	}
+	return nil
+}
+
+// DeleteObjectsCreatedBefore deletes the objects of the bucket with the
+// status, whose key starts with the prefix and which were created before
+// the time, together with their segments. Deletion performs in batches, so
+// in case of error while processing, this method will return the number of
+// objects deleted to the moment when an error occurs.
+func (db *DB) DeleteObjectsCreatedBefore(ctx context.Context, opts DeleteObjectsCreatedBefore) (deletedObjectCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return 0, err
+	}
+
+	batchQuery := func(startAfter ObjectStream, batchsize int) (string, []interface{}) {
+		args := []interface{}{
+			opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName),
+			[]byte(startAfter.ObjectKey), startAfter.Version,
+			opts.Status, opts.CreatedBefore,
+			batchsize,
+			[]byte(opts.Prefix),
+		}
+
+		prefixLimitCondition := ""
+		if opts.Prefix != "" {
+			prefixLimitCondition = "AND object_key < $9"
+			args = append(args, []byte(prefixLimit(opts.Prefix)))
+		}
+
+		return `
+			SELECT
+				project_id, bucket_name, object_key, version, stream_id
+			FROM objects
+			` + db.impl.AsOfSystemTime(opts.AsOfSystemTime) + `
+			WHERE
+				(project_id, bucket_name) = ($1, $2) AND
+				(object_key, version) > ($3, $4) AND
+				status = $5 AND
+				created_at < $6 AND
+				object_key >= $8 ` + prefixLimitCondition + `
+			ORDER BY project_id, bucket_name, object_key, version
+			LIMIT $7`, args
+	}
+
+	err = db.deleteObjectsAndSegmentsBatch(ctx, opts.BatchSize, func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error) {
+		objects := make([]ObjectStream, 0, batchsize)
+
+		query, args := batchQuery(startAfter, batchsize)
+		err = withRows(db.db.QueryContext(ctx, query, args...))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				err = rows.Scan(&last.ProjectID, &last.BucketName, &last.ObjectKey, &last.Version, &last.StreamID)
+				if err != nil {
+					return Error.New("unable to scan objects: %w", err)
+				}
+				objects = append(objects, last)
+			}
+			return nil
+		})
+		if err != nil {
+			return ObjectStream{}, Error.New("unable to delete objects created before: %w", err)
+		}
+
+		var deletedSegments []DeletedSegmentInfo
+		if db.config.ServerSideCopy && opts.Status == Committed {
+			deletedSegments, err = db.deleteObjectsAndSegmentsWithCopies(ctx, objects)
+		} else {
+			deletedSegments, err = db.deleteObjectsAndSegments(ctx, objects)
+		}
+		if err != nil {
+			return ObjectStream{}, err
+		}
+		deletedObjectCount += int64(len(objects))
+
+		if opts.DeletePieces != nil && len(deletedSegments) > 0 {
+			if err := opts.DeletePieces(ctx, deletedSegments); err != nil {
+				return ObjectStream{}, Error.New("unable to delete pieces of objects created before: %w", err)
+			}
+		}
+
+		return last, nil
+	})
+	return deletedObjectCount, err
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestDeleteObjectsCreatedBefore(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("invalid options", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsCreatedBefore{
+				Opts: metabase.DeleteObjectsCreatedBefore{
+					Bucket: metabase.BucketLocation{
+						BucketName: obj.BucketName,
+					},
+					Status:        metabase.Committed,
+					CreatedBefore: time.Now(),
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsCreatedBefore{
+				Opts: metabase.DeleteObjectsCreatedBefore{
+					Bucket:        obj.Location().Bucket(),
+					Status:        metabase.DeleteMarker,
+					CreatedBefore: time.Now(),
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Status must be pending or committed, got 4",
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsCreatedBefore{
+				Opts: metabase.DeleteObjectsCreatedBefore{
+					Bucket: obj.Location().Bucket(),
+					Status: metabase.Committed,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "CreatedBefore missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("no matching objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			metabasetest.DeleteObjectsCreatedBefore{
+				Opts: metabase.DeleteObjectsCreatedBefore{
+					Bucket:        obj.Location().Bucket(),
+					Status:        metabase.Committed,
+					CreatedBefore: object.CreatedAt.Add(-time.Hour),
+					DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+						return errors.New("shouldn't be called")
+					},
+				},
+				Deleted: 0,
+			}.Check(ctx, t, db)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+		})
+
+		t.Run("objects with prefix and status", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			var kept []metabase.RawObject
+			for _, key := range []metabase.ObjectKey{"a", "a/1", "a/2", "a/b/3", "b/1"} {
+				stream := metabasetest.RandObjectStream()
+				stream.ProjectID, stream.BucketName = obj.ProjectID, obj.BucketName
+				stream.ObjectKey = key
+				object := metabasetest.CreateObject(ctx, t, db, stream, 2)
+
+				if key == "a" || key == "b/1" {
+					kept = append(kept, metabase.RawObject(object))
+				}
+			}
+
+			// pending objects with the prefix aren't deleted with the committed ones.
+			pending := metabasetest.RandObjectStream()
+			pending.ProjectID, pending.BucketName = obj.ProjectID, obj.BucketName
+			pending.ObjectKey = "a/pending"
+			metabasetest.CreatePendingObject(ctx, t, db, pending, 1)
+
+			nSegments := 0
+			metabasetest.DeleteObjectsCreatedBefore{
+				Opts: metabase.DeleteObjectsCreatedBefore{
+					Bucket:        obj.Location().Bucket(),
+					Prefix:        "a/",
+					Status:        metabase.Committed,
+					CreatedBefore: time.Now().Add(time.Hour),
+					BatchSize:     2,
+					DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+						nSegments += len(segments)
+						return nil
+					},
+				},
+				Deleted: 3,
+			}.Check(ctx, t, db)
+
+			require.Equal(t, 6, nSegments)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 3)
+
+			committed, err := db.TestingAllCommittedObjects(ctx, obj.ProjectID, obj.BucketName)
+			require.NoError(t, err)
+			require.Len(t, committed, 2)
+
+			metabasetest.DeleteObjectsCreatedBefore{
+				Opts: metabase.DeleteObjectsCreatedBefore{
+					Bucket:        obj.Location().Bucket(),
+					Status:        metabase.Pending,
+					CreatedBefore: time.Now().Add(time.Hour),
+				},
+				Deleted: 1,
+			}.Check(ctx, t, db)
+
+			objects, err = db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.ElementsMatch(t, kept, rawObjects(objects))
+		})
+	})
+}
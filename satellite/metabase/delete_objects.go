@@ -6,13 +6,16 @@ package metabase
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"time"
 
 	"github.com/jackc/pgx/v4"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/storj"
 	"storj.io/private/dbutil/pgxutil"
+	"storj.io/private/dbutil/txutil"
 	"storj.io/private/tagsql"
 )
 
@@ -20,11 +23,23 @@ const (
 	deleteBatchsizeLimit = intLimitRange(1000)
 )
 
+var deleteObjectStreamWithCopyFeatureSQL = fmt.Sprintf(
+	deleteBucketObjectsWithCopyFeatureSQL,
+	`DELETE FROM objects
+	WHERE (project_id, bucket_name, object_key, version, stream_id) = ($1, $2, $3, $4, $5)`,
+	"", "",
+)
+
 // DeleteExpiredObjects contains all the information necessary to delete expired objects and segments.
 type DeleteExpiredObjects struct {
 	ExpiredBefore  time.Time
 	AsOfSystemTime time.Time
 	BatchSize      int
+
+	// DeletePieces is called for every batch of objects with the remote
+	// segments that were deleted. When nil, the pieces are left for the
+	// garbage collection.
+	DeletePieces func(ctx context.Context, segments []DeletedSegmentInfo) error
 }
 
 // DeleteExpiredObjects deletes all objects that expired before expiredBefore.
@@ -77,11 +92,22 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 			return ObjectStream{}, Error.New("unable to delete expired objects: %w", err)
 		}
 
-		err = db.deleteObjectsAndSegments(ctx, expiredObjects)
+		var deletedSegments []DeletedSegmentInfo
+		if db.config.ServerSideCopy {
+			deletedSegments, err = db.deleteObjectsAndSegmentsWithCopies(ctx, expiredObjects)
+		} else {
+			deletedSegments, err = db.deleteObjectsAndSegments(ctx, expiredObjects)
+		}
 		if err != nil {
 			return ObjectStream{}, err
 		}
 
+		if opts.DeletePieces != nil && len(deletedSegments) > 0 {
+			if err := opts.DeletePieces(ctx, deletedSegments); err != nil {
+				return ObjectStream{}, Error.New("unable to delete pieces of expired objects: %w", err)
+			}
+		}
+
 		return last, nil
 	})
 }
@@ -94,11 +120,17 @@ type DeleteZombieObjects struct {
 	BatchSize        int
 }
 
+// DeleteZombieObjectsResult contains the number of deleted zombie objects and segments.
+type DeleteZombieObjectsResult struct {
+	Objects  int64
+	Segments int64
+}
+
 // DeleteZombieObjects deletes all objects that zombie deletion deadline passed.
-func (db *DB) DeleteZombieObjects(ctx context.Context, opts DeleteZombieObjects) (err error) {
+func (db *DB) DeleteZombieObjects(ctx context.Context, opts DeleteZombieObjects) (result DeleteZombieObjectsResult, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return db.deleteObjectsAndSegmentsBatch(ctx, opts.BatchSize, func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error) {
+	err = db.deleteObjectsAndSegmentsBatch(ctx, opts.BatchSize, func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error) {
 		query := `
 			SELECT
 				project_id, bucket_name, object_key, version, stream_id
@@ -140,13 +172,16 @@ func (db *DB) DeleteZombieObjects(ctx context.Context, opts DeleteZombieObjects)
 			return ObjectStream{}, Error.New("unable to delete zombie objects: %w", err)
 		}
 
-		err = db.deleteInactiveObjectsAndSegments(ctx, objects, opts.InactiveDeadline)
+		deleted, err := db.deleteInactiveObjectsAndSegments(ctx, objects, opts.InactiveDeadline)
 		if err != nil {
 			return ObjectStream{}, err
 		}
+		result.Objects += deleted.Objects
+		result.Segments += deleted.Segments
 
 		return last, nil
 	})
+	return result, err
 }
 
 func (db *DB) deleteObjectsAndSegmentsBatch(ctx context.Context, batchsize int, deleteBatch func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error)) (err error) {
@@ -167,13 +202,19 @@ func (db *DB) deleteObjectsAndSegmentsBatch(ctx context.Context, batchsize int,
 	}
 }
 
-func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStream) (err error) {
+func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStream) (_ []DeletedSegmentInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if len(objects) == 0 {
-		return nil
+		return nil, nil
 	}
 
+	type deletedSegment struct {
+		rootPieceID storj.PieceID
+		aliasPieces AliasPieces
+	}
+	var deleted []deletedSegment
+
 	err = pgxutil.Conn(ctx, db.db, func(conn *pgx.Conn) error {
 		var batch pgx.Batch
 		for _, obj := range objects {
@@ -187,6 +228,7 @@ func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStre
 				)
 				DELETE FROM segments
 				WHERE segments.stream_id = $5::BYTEA
+				RETURNING root_piece_id, remote_alias_pieces
 			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID)
 		}
 
@@ -197,10 +239,28 @@ func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStre
 
 		var errlist errs.Group
 		for i := 0; i < batch.Len(); i++ {
-			result, err := results.Exec()
+			affectedSegmentCount, err := func() (count int64, err error) {
+				rows, err := results.Query()
+				if err != nil {
+					return 0, err
+				}
+				defer rows.Close()
+
+				for rows.Next() {
+					var segment deletedSegment
+					if err := rows.Scan(&segment.rootPieceID, &segment.aliasPieces); err != nil {
+						return count, err
+					}
+					count++
+					if len(segment.aliasPieces) > 0 {
+						deleted = append(deleted, segment)
+					}
+				}
+				return count, rows.Err()
+			}()
 			errlist.Add(err)
 
-			if affectedSegmentCount := result.RowsAffected(); affectedSegmentCount > 0 {
+			if affectedSegmentCount > 0 {
 				// Note, this slightly miscounts objects without any segments
 				// there doesn't seem to be a simple work around for this.
 				// Luckily, this is used only for metrics, where it's not a
@@ -216,16 +276,80 @@ func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStre
 		return errlist.Err()
 	})
 	if err != nil {
-		return Error.New("unable to delete expired objects: %w", err)
+		return nil, Error.New("unable to delete expired objects: %w", err)
+	}
+
+	// inline segments don't have any pieces, so only remote segments are returned.
+	segments := make([]DeletedSegmentInfo, 0, len(deleted))
+	for _, segment := range deleted {
+		pieces, err := db.aliasCache.ConvertAliasesToPieces(ctx, segment.aliasPieces)
+		if err != nil {
+			return nil, Error.New("unable to delete expired objects: %w", err)
+		}
+		segments = append(segments, DeletedSegmentInfo{
+			RootPieceID: segment.rootPieceID,
+			Pieces:      pieces,
+		})
 	}
-	return nil
+	return segments, nil
 }
 
-func (db *DB) deleteInactiveObjectsAndSegments(ctx context.Context, objects []ObjectStream, inactiveDeadline time.Time) (err error) {
+// deleteObjectsAndSegmentsWithCopies deletes the objects and their segments
+// while keeping the segments of their copies intact. When a deleted object is
+// the ancestor of copies, its pieces are moved to one of the copies, which
+// becomes the new ancestor, and a deleted copy is removed from the copies of
+// its ancestor. Pieces are only unreferenced once the last copy is deleted.
+func (db *DB) deleteObjectsAndSegmentsWithCopies(ctx context.Context, objects []ObjectStream) (segments []DeletedSegmentInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var objectsDeleted, segmentsDeleted int64
+	for _, obj := range objects {
+		var deleted []deletedObjectInfo
+		err := txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+			err = withRows(
+				tx.QueryContext(ctx, deleteObjectStreamWithCopyFeatureSQL,
+					obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID),
+			)(func(rows tagsql.Rows) error {
+				deleted, err = db.scanBucketObjectsDeletionServerSideCopy(ctx, obj.Location().Bucket(), rows)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			return db.promoteNewAncestors(ctx, tx, deleted)
+		})
+		if err != nil {
+			return nil, Error.New("unable to delete expired objects: %w", err)
+		}
+
+		for _, object := range deleted {
+			objectsDeleted++
+			if object.PromotedAncestor != nil {
+				// don't remove pieces, they are now linked to the new ancestor
+				continue
+			}
+			segmentsDeleted += int64(len(object.Segments))
+			for _, segment := range object.Segments {
+				segments = append(segments, DeletedSegmentInfo{
+					RootPieceID: segment.RootPieceID,
+					Pieces:      segment.Pieces,
+				})
+			}
+		}
+	}
+
+	mon.Meter("object_delete").Mark64(objectsDeleted)
+	mon.Meter("segment_delete").Mark64(segmentsDeleted)
+
+	return segments, nil
+}
+
+func (db *DB) deleteInactiveObjectsAndSegments(ctx context.Context, objects []ObjectStream, inactiveDeadline time.Time) (result DeleteZombieObjectsResult, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if len(objects) == 0 {
-		return nil
+		return result, nil
 	}
 
 	err = pgxutil.Conn(ctx, db.db, func(conn *pgx.Conn) error {
@@ -244,37 +368,44 @@ func (db *DB) deleteInactiveObjectsAndSegments(ctx context.Context, objects []Ob
 							NOT EXISTS (SELECT stream_id FROM segments WHERE stream_id = $5::BYTEA AND created_at > $6)
 						)
 						RETURNING version -- return anything
+				), deleted_segments AS (
+					DELETE FROM segments
+					WHERE
+						segments.stream_id = $5::BYTEA AND
+						NOT EXISTS (SELECT stream_id FROM segments WHERE stream_id = $5::BYTEA AND created_at > $6)
+					RETURNING segments.stream_id -- return anything
 				)
-				DELETE FROM segments
-				WHERE
-					segments.stream_id = $5::BYTEA AND
-					NOT EXISTS (SELECT stream_id FROM segments WHERE stream_id = $5::BYTEA AND created_at > $6)
+				SELECT
+					(SELECT count(*) FROM deleted_objects),
+					(SELECT count(*) FROM deleted_segments)
 			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID, inactiveDeadline)
 		}
 
 		results := conn.SendBatch(ctx, &batch)
 		defer func() { err = errs.Combine(err, results.Close()) }()
 
-		var segmentsDeleted int64
 		var errlist errs.Group
 		for i := 0; i < batch.Len(); i++ {
-			result, err := results.Exec()
+			var objectCount, segmentCount int64
+			err := results.QueryRow().Scan(&objectCount, &segmentCount)
 			errlist.Add(err)
 
 			if err == nil {
-				segmentsDeleted += result.RowsAffected()
+				result.Objects += objectCount
+				result.Segments += segmentCount
 			}
 		}
 
-		// TODO calculate deleted objects
-		mon.Meter("zombie_segment_delete").Mark64(segmentsDeleted)
-		mon.Meter("segment_delete").Mark64(segmentsDeleted)
+		mon.Meter("zombie_object_delete").Mark64(result.Objects)
+		mon.Meter("object_delete").Mark64(result.Objects)
+		mon.Meter("zombie_segment_delete").Mark64(result.Segments)
+		mon.Meter("segment_delete").Mark64(result.Segments)
 
 		return errlist.Err()
 	})
 	if err != nil {
-		return Error.New("unable to delete zombie objects: %w", err)
+		return result, Error.New("unable to delete zombie objects: %w", err)
 	}
 
-	return nil
+	return result, nil
 }
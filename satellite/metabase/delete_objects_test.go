@@ -165,6 +165,32 @@ func TestDeleteExpiredObjects(t *testing.T) {
 				},
 			}.Check(ctx, t, db)
 		})
+
+		t.Run("expired object with copy", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			originalObj, _ := metabasetest.CreateTestObject{
+				BeginObjectExactVersion: &metabase.BeginObjectExactVersion{
+					ObjectStream: obj1,
+					ExpiresAt:    &pastTime,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+			}.Run(ctx, t, db, obj1, 2)
+
+			// the copy inherits the expiration time of the original
+			metabasetest.CreateObjectCopy{
+				OriginalObject: originalObj,
+			}.Run(ctx, t, db)
+
+			metabasetest.DeleteExpiredObjects{
+				Opts: metabase.DeleteExpiredObjects{
+					ExpiredBefore: time.Now(),
+				},
+			}.Check(ctx, t, db)
+
+			// both objects, their segments and the copy reference are gone
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
 	})
 }
 
@@ -227,6 +253,7 @@ func TestDeleteZombieObjects(t *testing.T) {
 					DeadlineBefore:   now,
 					InactiveDeadline: now,
 				},
+				Result: metabase.DeleteZombieObjectsResult{Objects: 1},
 			}.Check(ctx, t, db)
 
 			metabasetest.Verify{ // the object with zombie deadline time in the past is gone
@@ -333,6 +360,7 @@ func TestDeleteZombieObjects(t *testing.T) {
 					DeadlineBefore:   now.Add(1 * time.Hour),
 					InactiveDeadline: now.Add(2 * time.Hour),
 				},
+				Result: metabase.DeleteZombieObjectsResult{Objects: 1, Segments: 1},
 			}.Check(ctx, t, db)
 
 			metabasetest.Verify{}.Check(ctx, t, db)
@@ -390,6 +418,7 @@ func TestDeleteZombieObjects(t *testing.T) {
 					InactiveDeadline: now.Add(48 * time.Hour),
 					BatchSize:        4,
 				},
+				Result: metabase.DeleteZombieObjectsResult{Objects: 33, Segments: 99},
 			}.Check(ctx, t, db)
 
 			metabasetest.Verify{}.Check(ctx, t, db)
@@ -0,0 +1,135 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"fmt"
+
+	"storj.io/private/dbutil"
+)
+
+// DeleteObjectsByPrefix contains arguments for deleting all objects,
+// whose key starts with Prefix.
+type DeleteObjectsByPrefix struct {
+	Bucket    BucketLocation
+	Prefix    ObjectKey
+	BatchSize int
+
+	// DeletePieces is called for every batch of objects.
+	// Slice `segments` will be reused between calls.
+	DeletePieces func(ctx context.Context, segments []DeletedSegmentInfo) error
+}
+
+// Verify verifies delete objects by prefix request fields.
+func (opts *DeleteObjectsByPrefix) Verify() error {
+	if err := opts.Bucket.Verify(); err != nil {
+		return err
+	}
+	if opts.Prefix == "" {
+		return ErrInvalidRequest.New("Prefix missing")
+	}
+	return nil
+}
+
+var deleteObjectsByPrefixCockroachSubSQL = `
+DELETE FROM objects
+WHERE
+	project_id = $1 AND bucket_name = $2 AND
+	object_key >= $4 AND object_key < $5
+LIMIT $3
+`
+
+// postgres does not support LIMIT in DELETE.
+var deleteObjectsByPrefixPostgresSubSQL = `
+DELETE FROM objects
+WHERE (objects.project_id, objects.bucket_name, objects.object_key, objects.version) IN (
+	SELECT project_id, bucket_name, object_key, version FROM objects
+	WHERE
+		project_id = $1 AND bucket_name = $2 AND
+		object_key >= $4 AND object_key < $5
+	LIMIT $3
+)`
+
+var deleteObjectsByPrefixWithCopyFeaturePostgresSQL = fmt.Sprintf(
+	deleteBucketObjectsWithCopyFeatureSQL,
+	deleteObjectsByPrefixPostgresSubSQL,
+	"", "",
+)
+var deleteObjectsByPrefixWithCopyFeatureCockroachSQL = fmt.Sprintf(
+	deleteBucketObjectsWithCopyFeatureSQL,
+	deleteObjectsByPrefixCockroachSubSQL,
+	"", "",
+)
+
+// DeleteObjectsByPrefix deletes all versions of the objects, whose key starts
+// with the prefix, together with their segments. Deletion performs in batches
+// inside the database, so in case of error while processing, this method will
+// return the number of objects deleted to the moment when an error occurs.
+func (db *DB) DeleteObjectsByPrefix(ctx context.Context, opts DeleteObjectsByPrefix) (deletedObjectCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return 0, err
+	}
+
+	deleteBatchSizeLimit.Ensure(&opts.BatchSize)
+
+	args := []interface{}{
+		opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize,
+		[]byte(opts.Prefix), []byte(prefixLimit(opts.Prefix)),
+	}
+
+	if db.config.ServerSideCopy {
+		var query string
+		switch db.impl {
+		case dbutil.Cockroach:
+			query = deleteObjectsByPrefixWithCopyFeatureCockroachSQL
+		case dbutil.Postgres:
+			query = deleteObjectsByPrefixWithCopyFeaturePostgresSQL
+		default:
+			return 0, Error.New("unhandled database: %v", db.impl)
+		}
+		return db.deleteObjectBatchesWithCopyFeature(ctx, opts.Bucket, opts.DeletePieces, query, args...)
+	}
+
+	var query string
+	switch db.impl {
+	case dbutil.Cockroach:
+		query = `
+		WITH deleted_objects AS (
+			DELETE FROM objects
+			WHERE
+				project_id = $1 AND bucket_name = $2 AND
+				object_key >= $4 AND object_key < $5
+			LIMIT $3
+			RETURNING objects.stream_id
+		)
+		DELETE FROM segments
+		WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
+		RETURNING segments.stream_id, segments.root_piece_id, segments.remote_alias_pieces
+	`
+	case dbutil.Postgres:
+		query = `
+		WITH deleted_objects AS (
+			DELETE FROM objects
+			WHERE stream_id IN (
+				SELECT stream_id FROM objects
+				WHERE
+					project_id = $1 AND bucket_name = $2 AND
+					object_key >= $4 AND object_key < $5
+				LIMIT $3
+			)
+			RETURNING objects.stream_id
+		)
+		DELETE FROM segments
+		WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
+		RETURNING segments.stream_id, segments.root_piece_id, segments.remote_alias_pieces
+	`
+	default:
+		return 0, Error.New("unhandled database: %v", db.impl)
+	}
+
+	return db.deleteObjectBatches(ctx, opts.DeletePieces, query, args...)
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestDeleteObjectsByPrefix(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("invalid options", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket: metabase.BucketLocation{
+						BucketName: obj.BucketName,
+					},
+					Prefix: "a/",
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket: metabase.BucketLocation{
+						ProjectID: obj.ProjectID,
+					},
+					Prefix: "a/",
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket: obj.Location().Bucket(),
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Prefix missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("no matching objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket: obj.Location().Bucket(),
+					Prefix: "a/",
+					DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+						return errors.New("shouldn't be called")
+					},
+				},
+				Deleted: 0,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("only objects with prefix", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			var kept []metabase.RawObject
+			for _, key := range []metabase.ObjectKey{"a", "a/1", "a/2", "a/b/3", "a0", "b/1"} {
+				stream := metabasetest.RandObjectStream()
+				stream.ProjectID, stream.BucketName = obj.ProjectID, obj.BucketName
+				stream.ObjectKey = key
+				object := metabasetest.CreateObject(ctx, t, db, stream, 2)
+
+				if key == "a" || key == "a0" || key == "b/1" {
+					kept = append(kept, metabase.RawObject(object))
+				}
+			}
+
+			// other bucket with the same keys isn't affected
+			other := metabasetest.RandObjectStream()
+			other.ProjectID = obj.ProjectID
+			other.ObjectKey = "a/1"
+			otherObject := metabasetest.CreateObject(ctx, t, db, other, 0)
+			kept = append(kept, metabase.RawObject(otherObject))
+
+			nSegments := 0
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket:    obj.Location().Bucket(),
+					Prefix:    "a/",
+					BatchSize: 2,
+					DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+						nSegments += len(segments)
+
+						for _, s := range segments {
+							if len(s.Pieces) != 1 {
+								return errors.New("expected 1 piece per segment")
+							}
+						}
+						return nil
+					},
+				},
+				Deleted: 3,
+			}.Check(ctx, t, db)
+
+			require.Equal(t, 6, nSegments)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.ElementsMatch(t, kept, rawObjects(objects))
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 6)
+		})
+
+		t.Run("all versions", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			for version := metabase.Version(1); version <= 3; version++ {
+				stream := obj
+				stream.ObjectKey = "a/x"
+				stream.Version = version
+				stream.StreamID[0] += byte(version)
+				metabasetest.CreateObject(ctx, t, db, stream, 1)
+			}
+
+			metabasetest.DeleteObjectsByPrefix{
+				Opts: metabase.DeleteObjectsByPrefix{
+					Bucket:    obj.Location().Bucket(),
+					Prefix:    "a/",
+					BatchSize: 1,
+				},
+				Deleted: 3,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+	})
+}
+
+func rawObjects(objects []metabase.Object) []metabase.RawObject {
+	raw := make([]metabase.RawObject, len(objects))
+	for i, object := range objects {
+		raw[i] = metabase.RawObject(object)
+	}
+	return raw
+}
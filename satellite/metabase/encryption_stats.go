@@ -0,0 +1,71 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+)
+
+// GetProjectEncryptionStats contains arguments necessary for getting the
+// encryption statistics of a project.
+type GetProjectEncryptionStats struct {
+	ProjectID uuid.UUID
+}
+
+// Verify verifies get project encryption stats request fields.
+func (opts *GetProjectEncryptionStats) Verify() error {
+	if opts.ProjectID.IsZero() {
+		return ErrInvalidRequest.New("ProjectID missing")
+	}
+	return nil
+}
+
+// EncryptionStats is the number of committed objects in a bucket, which use
+// the same encryption parameters.
+type EncryptionStats struct {
+	BucketName  string
+	Encryption  storj.EncryptionParameters
+	ObjectCount int64
+}
+
+// GetProjectEncryptionStats returns the number of committed objects grouped by
+// bucket and encryption parameters, ordered by bucket name.
+func (db *DB) GetProjectEncryptionStats(ctx context.Context, opts GetProjectEncryptionStats) (result []EncryptionStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+
+	reader := db.reader(ctx, db.config.ReadReplica.StatsMaxStaleness)
+
+	err = withRows(reader.QueryContext(ctx, `
+		SELECT bucket_name, encryption, count(*)
+		FROM objects
+		WHERE
+			project_id = $1 AND
+			status     = `+committedStatus+`
+		GROUP BY bucket_name, encryption
+		ORDER BY bucket_name, encryption
+	`, opts.ProjectID))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var stats EncryptionStats
+			err := rows.Scan(&stats.BucketName, encryptionParameters{&stats.Encryption}, &stats.ObjectCount)
+			if err != nil {
+				return Error.New("unable to scan encryption stats: %w", err)
+			}
+			result = append(result, stats)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to query encryption stats: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestGetProjectEncryptionStats(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("missing project", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.GetProjectEncryptionStats{
+				Opts:     metabase.GetProjectEncryptionStats{},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("grouped by bucket and encryption", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			projectID := uuid.UUID{1}
+			unencrypted := storj.EncryptionParameters{CipherSuite: storj.EncNull}
+
+			create := func(bucketName string, encryption storj.EncryptionParameters) {
+				obj := metabasetest.RandObjectStream()
+				obj.ProjectID = projectID
+				obj.BucketName = bucketName
+				metabasetest.CreateTestObject{
+					BeginObjectExactVersion: &metabase.BeginObjectExactVersion{
+						ObjectStream: obj,
+						Encryption:   encryption,
+					},
+				}.Run(ctx, t, db, obj, 0)
+			}
+
+			create("a", metabasetest.DefaultEncryption)
+			create("a", metabasetest.DefaultEncryption)
+			create("a", unencrypted)
+			create("b", metabasetest.DefaultEncryption)
+
+			// other projects and pending objects are not counted.
+			metabasetest.CreateTestObject{}.Run(ctx, t, db, metabasetest.RandObjectStream(), 0)
+			pending := metabasetest.RandObjectStream()
+			pending.ProjectID = projectID
+			metabasetest.CreatePendingObject(ctx, t, db, pending, 0)
+
+			metabasetest.GetProjectEncryptionStats{
+				Opts: metabase.GetProjectEncryptionStats{ProjectID: projectID},
+				Result: []metabase.EncryptionStats{
+					{BucketName: "a", Encryption: unencrypted, ObjectCount: 1},
+					{BucketName: "a", Encryption: metabasetest.DefaultEncryption, ObjectCount: 2},
+					{BucketName: "b", Encryption: metabasetest.DefaultEncryption, ObjectCount: 1},
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
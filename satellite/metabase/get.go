@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"math"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -70,6 +71,11 @@ func (obj *GetObjectExactVersion) Verify() error {
 
 // GetObjectExactVersion returns object information for exact version.
 func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVersion) (_ Object, err error) {
+	return db.getObjectExactVersion(ctx, opts, db.db)
+}
+
+// implementation of DB.GetObjectExactVersion for re-use internally in metabase package.
+func (db *DB) getObjectExactVersion(ctx context.Context, opts GetObjectExactVersion, q execQueryer) (_ Object, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if err := opts.Verify(); err != nil {
@@ -77,7 +83,7 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 	}
 
 	object := Object{}
-	err = db.db.QueryRowContext(ctx, `
+	err = q.QueryRowContext(ctx, `
 		SELECT
 			stream_id,
 			created_at, expires_at,
@@ -192,6 +198,80 @@ func (db *DB) GetSegmentByPosition(ctx context.Context, opts GetSegmentByPositio
 	return segment, nil
 }
 
+// GetSegmentByOffset contains arguments necessary for fetching the segment,
+// which contains the plain offset of an object.
+type GetSegmentByOffset struct {
+	StreamID uuid.UUID
+	// FixedSegmentSize is the fixed segment size of the object. When it's
+	// positive, it's used to calculate the position of the segment.
+	FixedSegmentSize int32
+	PlainOffset      int64
+}
+
+// Verify verifies get segment request fields.
+func (seg *GetSegmentByOffset) Verify() error {
+	switch {
+	case seg.StreamID.IsZero():
+		return ErrInvalidRequest.New("StreamID missing")
+	case seg.PlainOffset < 0:
+		return ErrInvalidRequest.New("PlainOffset negative")
+	}
+	return nil
+}
+
+// GetSegmentByOffset returns information about the segment, which contains
+// the specified plain offset.
+//
+// Migrated objects don't have plain offsets, hence their segments can't be
+// found by offset.
+func (db *DB) GetSegmentByOffset(ctx context.Context, opts GetSegmentByOffset) (segment Segment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return Segment{}, err
+	}
+
+	// With a fixed segment size, the position can be calculated, unless
+	// the object was uploaded in multiple parts.
+	if opts.FixedSegmentSize > 0 {
+		index := opts.PlainOffset / int64(opts.FixedSegmentSize)
+		if index <= math.MaxUint32 {
+			segment, err = db.GetSegmentByPosition(ctx, GetSegmentByPosition{
+				StreamID: opts.StreamID,
+				Position: SegmentPosition{Index: uint32(index)},
+			})
+			switch {
+			case err == nil && segment.PlainOffset <= opts.PlainOffset && opts.PlainOffset < segment.PlainOffset+int64(segment.PlainSize):
+				return segment, nil
+			case err != nil && !ErrSegmentNotFound.Has(err):
+				return Segment{}, err
+			}
+		}
+	}
+
+	var position SegmentPosition
+	err = db.db.QueryRowContext(ctx, `
+		SELECT position
+		FROM segments
+		WHERE
+			stream_id = $1 AND
+			plain_offset <= $2 AND $2 < plain_offset + plain_size
+		ORDER BY position ASC
+		LIMIT 1
+	`, opts.StreamID, opts.PlainOffset).Scan(&position)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Segment{}, ErrSegmentNotFound.New("segment missing")
+		}
+		return Segment{}, Error.New("unable to query segment: %w", err)
+	}
+
+	return db.GetSegmentByPosition(ctx, GetSegmentByPosition{
+		StreamID: opts.StreamID,
+		Position: position,
+	})
+}
+
 // GetLatestObjectLastSegment contains arguments necessary for fetching a last segment information.
 type GetLatestObjectLastSegment struct {
 	ObjectLocation
@@ -392,14 +472,22 @@ func (db *DB) TestingAllObjectSegments(ctx context.Context, objectLocation Objec
 		return nil, Error.Wrap(err)
 	}
 
-	response, err := db.ListSegments(ctx, ListSegments{
-		StreamID: object.StreamID,
-	})
-	if err != nil {
-		return nil, Error.Wrap(err)
-	}
+	var cursor SegmentPosition
+	for {
+		response, err := db.ListSegments(ctx, ListSegments{
+			StreamID: object.StreamID,
+			Cursor:   cursor,
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		segments = append(segments, response.Segments...)
 
-	return response.Segments, nil
+		if !response.More {
+			return segments, nil
+		}
+		cursor = segments[len(segments)-1].Position
+	}
 }
 
 // TestingAllObjects gets all objects.
@@ -809,6 +809,102 @@ func TestGetSegmentByPosition(t *testing.T) {
 	})
 }
 
+func TestGetSegmentByOffset(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("StreamID missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.GetSegmentByOffset{
+				Opts:     metabase.GetSegmentByOffset{},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "StreamID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("PlainOffset negative", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.GetSegmentByOffset{
+				Opts: metabase.GetSegmentByOffset{
+					StreamID:    obj.StreamID,
+					PlainOffset: -1,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "PlainOffset negative",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("Segment missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.GetSegmentByOffset{
+				Opts: metabase.GetSegmentByOffset{
+					StreamID: obj.StreamID,
+				},
+				ErrClass: &metabase.ErrSegmentNotFound,
+				ErrText:  "segment missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("Get segment", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 3)
+			require.EqualValues(t, 512, object.FixedSegmentSize)
+
+			segments := make([]metabase.Segment, 3)
+			for i := range segments {
+				var err error
+				segments[i], err = db.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+					StreamID: obj.StreamID,
+					Position: metabase.SegmentPosition{Index: uint32(i)},
+				})
+				require.NoError(t, err)
+			}
+
+			offsets := []struct {
+				PlainOffset int64
+				Index       int
+			}{
+				{0, 0}, {511, 0}, {512, 1}, {1000, 1}, {1024, 2}, {1535, 2},
+			}
+
+			// with and without the fixed segment size of the object
+			for _, fixedSegmentSize := range []int32{object.FixedSegmentSize, 0} {
+				for _, offset := range offsets {
+					metabasetest.GetSegmentByOffset{
+						Opts: metabase.GetSegmentByOffset{
+							StreamID:         obj.StreamID,
+							FixedSegmentSize: fixedSegmentSize,
+							PlainOffset:      offset.PlainOffset,
+						},
+						Result: segments[offset.Index],
+					}.Check(ctx, t, db)
+				}
+
+				// offset after the end of the object
+				metabasetest.GetSegmentByOffset{
+					Opts: metabase.GetSegmentByOffset{
+						StreamID:         obj.StreamID,
+						FixedSegmentSize: fixedSegmentSize,
+						PlainOffset:      1536,
+					},
+					ErrClass: &metabase.ErrSegmentNotFound,
+					ErrText:  "segment missing",
+				}.Check(ctx, t, db)
+			}
+		})
+	})
+}
+
 func TestGetLatestObjectLastSegment(t *testing.T) {
 	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
 		obj := metabasetest.RandObjectStream()
@@ -1387,3 +1483,52 @@ func TestBucketEmpty(t *testing.T) {
 		})
 	})
 }
+
+func TestTestingAllObjectSegments(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("more segments than a listing page", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: obj,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: obj.Version,
+			}.Check(ctx, t, db)
+
+			// spread the segments over parts, like a multipart upload does.
+			const numberOfSegments = int(metabase.ListLimit)*2 + 1
+			var expected []metabase.SegmentPosition
+			for i := 0; i < numberOfSegments; i++ {
+				position := metabase.SegmentPosition{Part: uint32(i / 100), Index: uint32(i % 100)}
+				expected = append(expected, position)
+
+				err := db.CommitInlineSegment(ctx, metabase.CommitInlineSegment{
+					ObjectStream:      obj,
+					Position:          position,
+					EncryptedKey:      []byte{3},
+					EncryptedKeyNonce: []byte{4},
+					PlainSize:         1,
+					InlineData:        []byte{1},
+				})
+				require.NoError(t, err)
+			}
+
+			_, err := db.CommitObject(ctx, metabase.CommitObject{
+				ObjectStream: obj,
+			})
+			require.NoError(t, err)
+
+			segments, err := db.TestingAllObjectSegments(ctx, obj.Location())
+			require.NoError(t, err)
+			require.Len(t, segments, numberOfSegments)
+
+			for i, segment := range segments {
+				require.Equal(t, expected[i], segment.Position)
+			}
+		})
+	})
+}
@@ -6,7 +6,9 @@ package metabase
 import (
 	"bytes"
 	"context"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -17,6 +19,8 @@ import (
 // objectIterator enables iteration on objects in a bucket.
 type objectsIterator struct {
 	db *DB
+	// reader is the database used for the queries, which may be a read replica.
+	reader tagsql.DB
 
 	projectID             uuid.UUID
 	bucketName            []byte
@@ -28,6 +32,20 @@ type objectsIterator struct {
 	includeCustomMetadata bool
 	includeSystemMetadata bool
 
+	// versions is set when the committed versions and the delete markers of
+	// the objects are listed, from the newest version of each key.
+	versions bool
+	// latestOnly is set when only the latest version of each key is listed,
+	// unless it's a delete marker. latestKey is the key of the last version,
+	// which hides the older versions of the key.
+	latestOnly bool
+	latestKey  ObjectKey
+
+	// asOfSystemTime and asOfSystemInterval allow reading the objects at a
+	// bounded staleness, which is only supported by CockroachDB.
+	asOfSystemTime     time.Time
+	asOfSystemInterval time.Duration
+
 	curIndex int
 	curRows  tagsql.Rows
 	cursor   iterateCursor // not relative to prefix
@@ -50,7 +68,8 @@ func iterateAllVersionsWithStatus(ctx context.Context, db *DB, opts IterateObjec
 	defer mon.Task()(&ctx)(&err)
 
 	it := &objectsIterator{
-		db: db,
+		db:     db,
+		reader: db.reader(ctx, db.config.ReadReplica.ListingMaxStaleness),
 
 		projectID:             opts.ProjectID,
 		bucketName:            []byte(opts.BucketName),
@@ -61,6 +80,8 @@ func iterateAllVersionsWithStatus(ctx context.Context, db *DB, opts IterateObjec
 		recursive:             opts.Recursive,
 		includeCustomMetadata: opts.IncludeCustomMetadata,
 		includeSystemMetadata: opts.IncludeSystemMetadata,
+		asOfSystemTime:        opts.AsOfSystemTime,
+		asOfSystemInterval:    opts.AsOfSystemInterval,
 
 		curIndex: 0,
 		cursor:   firstIterateCursor(opts.Recursive, opts.Cursor, opts.Prefix),
@@ -78,6 +99,82 @@ func iterateAllVersionsWithStatus(ctx context.Context, db *DB, opts IterateObjec
 	return iterate(ctx, it, fn)
 }
 
+func iterateObjectVersions(ctx context.Context, db *DB, opts IterateObjectVersions, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	it := &objectsIterator{
+		db:     db,
+		reader: db.reader(ctx, db.config.ReadReplica.ListingMaxStaleness),
+
+		projectID:             opts.ProjectID,
+		bucketName:            []byte(opts.BucketName),
+		status:                Committed,
+		prefix:                opts.Prefix,
+		prefixLimit:           prefixLimit(opts.Prefix),
+		batchSize:             opts.BatchSize,
+		recursive:             opts.Recursive,
+		includeCustomMetadata: opts.IncludeCustomMetadata,
+		includeSystemMetadata: opts.IncludeSystemMetadata,
+		versions:              true,
+		asOfSystemTime:        opts.AsOfSystemTime,
+		asOfSystemInterval:    opts.AsOfSystemInterval,
+
+		curIndex: 0,
+		cursor:   firstIterateCursor(opts.Recursive, opts.Cursor, opts.Prefix),
+
+		doNextQuery: doNextQueryObjectVersions,
+	}
+
+	// start from either the cursor or prefix, depending on which is larger
+	if lessKey(it.cursor.Key, opts.Prefix) {
+		it.cursor.Key = opts.Prefix
+		it.cursor.Inclusive = true
+	}
+
+	return iterate(ctx, it, fn)
+}
+
+func iterateObjectsLatestVersion(ctx context.Context, db *DB, opts IterateObjectsWithStatus, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	it := &objectsIterator{
+		db:     db,
+		reader: db.reader(ctx, db.config.ReadReplica.ListingMaxStaleness),
+
+		projectID:             opts.ProjectID,
+		bucketName:            []byte(opts.BucketName),
+		status:                opts.Status,
+		prefix:                opts.Prefix,
+		prefixLimit:           prefixLimit(opts.Prefix),
+		batchSize:             opts.BatchSize,
+		recursive:             opts.Recursive,
+		includeCustomMetadata: opts.IncludeCustomMetadata,
+		includeSystemMetadata: opts.IncludeSystemMetadata,
+		versions:              true,
+		latestOnly:            true,
+		asOfSystemTime:        opts.AsOfSystemTime,
+		asOfSystemInterval:    opts.AsOfSystemInterval,
+
+		curIndex: 0,
+		cursor:   firstIterateCursor(opts.Recursive, opts.Cursor, opts.Prefix),
+
+		doNextQuery: doNextQueryObjectVersions,
+	}
+
+	// start from either the cursor or prefix, depending on which is larger
+	if lessKey(it.cursor.Key, opts.Prefix) {
+		it.cursor.Key = opts.Prefix
+		it.cursor.Inclusive = true
+	}
+
+	// the listing continues after all versions of the cursor key
+	if !it.cursor.Inclusive {
+		it.latestKey = it.cursor.Key
+	}
+
+	return iterate(ctx, it, fn)
+}
+
 func iteratePendingObjectsByKey(ctx context.Context, db *DB, opts IteratePendingObjectsByKey, fn func(context.Context, ObjectsIterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -88,7 +185,8 @@ func iteratePendingObjectsByKey(ctx context.Context, db *DB, opts IteratePending
 	}
 
 	it := &objectsIterator{
-		db: db,
+		db:     db,
+		reader: db.db,
 
 		projectID:             opts.ProjectID,
 		bucketName:            []byte(opts.BucketName),
@@ -98,6 +196,8 @@ func iteratePendingObjectsByKey(ctx context.Context, db *DB, opts IteratePending
 		recursive:             true,
 		includeCustomMetadata: true,
 		includeSystemMetadata: true,
+		asOfSystemTime:        opts.AsOfSystemTime,
+		asOfSystemInterval:    opts.AsOfSystemInterval,
 
 		curIndex: 0,
 		cursor: iterateCursor{
@@ -137,103 +237,110 @@ func (it *objectsIterator) Next(ctx context.Context, item *ObjectEntry) bool {
 		return it.next(ctx, item)
 	}
 
-	// TODO: implement this on the database side
-
-	// skip prefix to avoid listing all objects from prefixes
-	// inside listed prefix
-	if it.skipPrefix != "" {
-		// drop current results page
-		if rowsErr := it.curRows.Err(); rowsErr != nil {
-			it.failErr = rowsErr
+	for {
+		ok := it.next(ctx, item)
+		if !ok {
 			return false
 		}
 
-		if closeErr := it.curRows.Close(); closeErr != nil {
-			it.failErr = closeErr
-			return false
+		// skip the objects of the last listed prefix, which are in the
+		// current batch. the next batch starts after the prefix.
+		if it.skipPrefix != "" && strings.HasPrefix(string(item.ObjectKey), string(it.skipPrefix)) {
+			continue
 		}
-
-		// set new cursor after prefix we would like to skip
-		it.cursor.Key = it.prefix + prefixLimit(it.skipPrefix)
-		it.cursor.StreamID = uuid.UUID{}
-		it.cursor.Version = 0
-
-		// bump curIndex to not stop iteration because of no more results
-		it.curIndex = it.batchSize
-
 		it.skipPrefix = ""
-	}
 
-	ok := it.next(ctx, item)
-	if !ok {
-		return false
-	}
-
-	// should this be treated as a prefix?
-	p := strings.IndexByte(string(item.ObjectKey), Delimiter)
-	if p >= 0 {
-		it.skipPrefix = item.ObjectKey[:p+1]
-		*item = ObjectEntry{
-			IsPrefix:  true,
-			ObjectKey: item.ObjectKey[:p+1],
-			Status:    it.status,
+		// should this be treated as a prefix?
+		p := strings.IndexByte(string(item.ObjectKey), Delimiter)
+		if p >= 0 {
+			it.skipPrefix = item.ObjectKey[:p+1]
+			*item = ObjectEntry{
+				IsPrefix:  true,
+				ObjectKey: item.ObjectKey[:p+1],
+				Status:    it.status,
+			}
 		}
-	}
 
-	return true
+		return true
+	}
 }
 
 // next returns true if there was another item and copy it in item.
 func (it *objectsIterator) next(ctx context.Context, item *ObjectEntry) bool {
-	next := it.curRows.Next()
-	if !next {
-		if it.curIndex < it.batchSize {
-			return false
+	for {
+		next := it.curRows.Next()
+		if !next {
+			if it.curIndex < it.batchSize {
+				return false
+			}
+
+			if it.curRows.Err() != nil {
+				return false
+			}
+
+			// skip prefix to avoid listing all objects from prefixes
+			// inside listed prefix
+			if it.skipPrefix != "" {
+				// set new cursor after prefix we would like to skip,
+				// which includes all versions of its key
+				it.cursor.Key = it.prefix + prefixLimit(it.skipPrefix)
+				it.cursor.StreamID = uuid.UUID{}
+				it.cursor.Version = 0
+				it.cursor.Inclusive = true
+
+				it.skipPrefix = ""
+			}
+
+			rows, err := it.doNextQuery(ctx, it)
+			if err != nil {
+				it.failErr = errs.Combine(it.failErr, err)
+				return false
+			}
+			it.cursor.Inclusive = false
+
+			if closeErr := it.curRows.Close(); closeErr != nil {
+				it.failErr = errs.Combine(it.failErr, closeErr, rows.Close())
+				return false
+			}
+
+			it.curRows = rows
+			it.curIndex = 0
+			if !it.curRows.Next() {
+				return false
+			}
 		}
 
-		if it.curRows.Err() != nil {
-			return false
-		}
-
-		rows, err := it.doNextQuery(ctx, it)
+		err := it.scanItem(item)
 		if err != nil {
 			it.failErr = errs.Combine(it.failErr, err)
 			return false
 		}
 
-		if closeErr := it.curRows.Close(); closeErr != nil {
-			it.failErr = errs.Combine(it.failErr, closeErr, rows.Close())
-			return false
-		}
+		it.curIndex++
+		it.cursor.Key = item.ObjectKey
+		it.cursor.Version = item.Version
+		it.cursor.StreamID = item.StreamID
 
-		it.curRows = rows
-		it.curIndex = 0
-		if !it.curRows.Next() {
-			return false
+		if it.prefix != "" {
+			if !strings.HasPrefix(string(item.ObjectKey), string(it.prefix)) {
+				return false
+			}
 		}
-	}
-
-	err := it.scanItem(item)
-	if err != nil {
-		it.failErr = errs.Combine(it.failErr, err)
-		return false
-	}
-
-	it.curIndex++
-	it.cursor.Key = item.ObjectKey
-	it.cursor.Version = item.Version
-	it.cursor.StreamID = item.StreamID
 
-	if it.prefix != "" {
-		if !strings.HasPrefix(string(item.ObjectKey), string(it.prefix)) {
-			return false
+		if it.latestOnly {
+			// skip the older versions and the objects, which are deleted
+			isLatest := item.ObjectKey != it.latestKey
+			it.latestKey = item.ObjectKey
+			if !isLatest || item.Status == DeleteMarker {
+				continue
+			}
 		}
-	}
 
-	// TODO this should be done with SQL query
-	item.ObjectKey = item.ObjectKey[len(it.prefix):]
+		// TODO this should be done with SQL query
+		item.ObjectKey = item.ObjectKey[len(it.prefix):]
 
-	return true
+		return true
+	}
 }
 
 func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error) {
@@ -270,10 +377,11 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 	}
 
 	if it.prefixLimit == "" {
-		return it.db.db.QueryContext(ctx, `
+		return it.reader.QueryContext(ctx, `
 			SELECT
 				`+querySelectFields+`
 			FROM objects
+			`+it.db.asOfTime(it.asOfSystemTime, it.asOfSystemInterval)+`
 			WHERE
 				(project_id, bucket_name, object_key, version) `+cursorCompare+` ($1, $2, $4, $5)
 				AND (project_id, bucket_name) < ($1, $7)
@@ -291,10 +399,11 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 
 	// TODO this query should use SUBSTRING(object_key from $8) but there is a problem how it
 	// works with CRDB.
-	return it.db.db.QueryContext(ctx, `
+	return it.reader.QueryContext(ctx, `
 		SELECT
 			`+querySelectFields+`
 		FROM objects
+		`+it.db.asOfTime(it.asOfSystemTime, it.asOfSystemInterval)+`
 		WHERE
 			(project_id, bucket_name, object_key, version) `+cursorCompare+` ($1, $2, $4, $5)
 			AND (project_id, bucket_name, object_key) < ($1, $2, $6)
@@ -311,6 +420,81 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 	)
 }
 
+func doNextQueryObjectVersions(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// minimum needed for cursor and for skipping delete markers
+	querySelectFields := `
+		object_key
+		,stream_id
+		,version
+		,encryption
+		,status`
+
+	if it.includeSystemMetadata {
+		querySelectFields += `
+			,created_at
+			,expires_at
+			,segment_count
+			,total_plain_size
+			,total_encrypted_size
+			,fixed_segment_size`
+	}
+
+	if it.includeCustomMetadata {
+		querySelectFields += `
+			,encrypted_metadata_nonce
+			,encrypted_metadata
+			,encrypted_metadata_encrypted_key`
+	}
+
+	// the versions of a key are listed from the newest, so the listing
+	// continues with the older versions of the cursor key.
+	cursorVersion := it.cursor.Version
+	if it.cursor.Inclusive {
+		// the version column is INT4
+		cursorVersion = math.MaxInt32
+	}
+
+	if it.prefixLimit == "" {
+		return it.reader.QueryContext(ctx, `
+			SELECT
+				`+querySelectFields+`
+			FROM objects
+			`+it.db.asOfTime(it.asOfSystemTime, it.asOfSystemInterval)+`
+			WHERE
+				project_id = $1 AND bucket_name = $2
+				AND (object_key > $3 OR (object_key = $3 AND version < $4))
+				AND status IN (`+committedStatus+`, `+deleteMarkerStatus+`)
+				AND (expires_at IS NULL OR expires_at > now())
+				ORDER BY object_key ASC, version DESC
+			LIMIT $5
+			`, it.projectID, it.bucketName,
+			[]byte(it.cursor.Key), int64(cursorVersion),
+			it.batchSize,
+		)
+	}
+
+	return it.reader.QueryContext(ctx, `
+		SELECT
+			`+querySelectFields+`
+		FROM objects
+		`+it.db.asOfTime(it.asOfSystemTime, it.asOfSystemInterval)+`
+		WHERE
+			project_id = $1 AND bucket_name = $2
+			AND (object_key > $3 OR (object_key = $3 AND version < $4))
+			AND object_key < $5
+			AND status IN (`+committedStatus+`, `+deleteMarkerStatus+`)
+			AND (expires_at IS NULL OR expires_at > now())
+			ORDER BY object_key ASC, version DESC
+		LIMIT $6
+		`, it.projectID, it.bucketName,
+		[]byte(it.cursor.Key), int64(cursorVersion),
+		[]byte(it.prefixLimit),
+		it.batchSize,
+	)
+}
+
 // nextBucket returns the lexicographically next bucket.
 func nextBucket(b []byte) []byte {
 	xs := make([]byte, len(b)+1)
@@ -322,7 +506,7 @@ func nextBucket(b []byte) []byte {
 func doNextQueryStreamsByKey(ctx context.Context, it *objectsIterator) (_ tagsql.Rows, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return it.db.db.QueryContext(ctx, `
+	return it.reader.QueryContext(ctx, `
 			SELECT
 				object_key, stream_id, version, encryption, status,
 				created_at, expires_at,
@@ -330,6 +514,7 @@ func doNextQueryStreamsByKey(ctx context.Context, it *objectsIterator) (_ tagsql
 				total_plain_size, total_encrypted_size, fixed_segment_size,
 				encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key
 			FROM objects
+			`+it.db.asOfTime(it.asOfSystemTime, it.asOfSystemInterval)+`
 			WHERE
 				project_id = $1 AND bucket_name = $2
 				AND object_key = $3
@@ -355,9 +540,16 @@ func (it *objectsIterator) scanItem(item *ObjectEntry) (err error) {
 		encryptionParameters{&item.Encryption},
 	}
 
+	// the status is always needed for the versions
+	if it.versions {
+		fields = append(fields, &item.Status)
+	}
+
 	if it.includeSystemMetadata {
+		if !it.versions {
+			fields = append(fields, &item.Status)
+		}
 		fields = append(fields,
-			&item.Status,
 			&item.CreatedAt,
 			&item.ExpiresAt,
 			&item.SegmentCount,
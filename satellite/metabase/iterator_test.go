@@ -197,6 +197,29 @@ func TestIterateObjectsWithStatus(t *testing.T) {
 			metabasetest.Verify{Objects: objects}.Check(ctx, t, db)
 		})
 
+		t.Run("as of system time", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+			numberOfObjects := 3
+			expected := make([]metabase.ObjectEntry, numberOfObjects)
+			objects := createObjects(ctx, t, db, numberOfObjects, uuid.UUID{1}, "mybucket")
+			for i, obj := range objects {
+				expected[i] = objectEntryFromRaw(obj)
+			}
+			metabasetest.IterateObjectsWithStatus{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:             uuid.UUID{1},
+					BucketName:            "mybucket",
+					Recursive:             true,
+					Status:                metabase.Committed,
+					IncludeCustomMetadata: true,
+					IncludeSystemMetadata: true,
+					AsOfSystemTime:        time.Now(),
+				},
+				Result: expected,
+			}.Check(ctx, t, db)
+			metabasetest.Verify{Objects: objects}.Check(ctx, t, db)
+		})
+
 		t.Run("more objects than limit", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 			numberOfObjects := 10
@@ -1145,6 +1168,44 @@ func TestIterateObjectsSkipCursor(t *testing.T) {
 				},
 			}.Check(ctx, t, db)
 		})
+
+		t.Run("prefixes across batches", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			objects := createObjectsWithKeys(ctx, t, db, projectID, bucketName, []metabase.ObjectKey{
+				"a/1",
+				"a/2",
+				"a/3",
+				"a/4",
+				"b",
+				"c/1",
+				"c/2",
+				"d",
+			})
+
+			// the objects of a prefix are skipped within a batch and the
+			// next batch starts after the prefix.
+			for _, batchSize := range []int{1, 2, 3, 10} {
+				metabasetest.IterateObjectsWithStatus{
+					Opts: metabase.IterateObjectsWithStatus{
+						ProjectID:  projectID,
+						BucketName: bucketName,
+						Recursive:  false,
+						BatchSize:  batchSize,
+						Status:     metabase.Committed,
+
+						IncludeCustomMetadata: true,
+						IncludeSystemMetadata: true,
+					},
+					Result: []metabase.ObjectEntry{
+						prefixEntry(metabase.ObjectKey("a/"), metabase.Committed),
+						objects["b"],
+						prefixEntry(metabase.ObjectKey("c/"), metabase.Committed),
+						objects["d"],
+					},
+				}.Check(ctx, t, db)
+			}
+		})
 	})
 }
 
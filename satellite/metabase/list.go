@@ -59,6 +59,9 @@ type IteratePendingObjectsByKey struct {
 	ObjectLocation
 	BatchSize int
 	Cursor    StreamIDCursor
+
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
 }
 
 // IterateObjectsWithStatus contains arguments necessary for listing objects in a bucket.
@@ -72,6 +75,9 @@ type IterateObjectsWithStatus struct {
 	Status                ObjectStatus
 	IncludeCustomMetadata bool
 	IncludeSystemMetadata bool
+
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
 }
 
 // IterateObjectsAllVersionsWithStatus iterates through all versions of all objects with specified status.
@@ -0,0 +1,152 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+)
+
+// ListNodeSegments contains arguments for listing the segments with a piece
+// on a node.
+type ListNodeSegments struct {
+	NodeID storj.NodeID
+
+	// Cursor is the last segment scanned by the previous call, zero for the
+	// first call.
+	Cursor NodeSegmentsCursor
+	// BatchSize is the number of segments scanned by a call.
+	BatchSize int
+
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
+}
+
+// NodeSegmentsCursor is the position of the segments scan.
+type NodeSegmentsCursor struct {
+	StreamID uuid.UUID
+	Position SegmentPosition
+}
+
+// ListNodeSegmentsResult is the result of ListNodeSegments.
+type ListNodeSegmentsResult struct {
+	Segments []NodeSegment
+
+	// Cursor is the last segment scanned by the call.
+	Cursor NodeSegmentsCursor
+	// More is whether there are more segments to scan.
+	More bool
+}
+
+// NodeSegment is a segment with a piece on the node.
+type NodeSegment struct {
+	StreamID uuid.UUID
+	Position SegmentPosition
+
+	RootPieceID   storj.PieceID
+	EncryptedSize int32
+	Redundancy    storj.RedundancyScheme
+	Placement     storj.PlacementConstraint
+
+	// PieceNumber is the number of the piece stored on the node.
+	PieceNumber uint16
+	// Pieces are all the pieces of the segment.
+	Pieces Pieces
+}
+
+// ListNodeSegments lists the segments with a piece on the node.
+//
+// The pieces of the segments can't be filtered by the database, so every call
+// scans BatchSize segments in the order of the segments table and returns the
+// ones with a piece on the node. The result may be empty even when there are
+// more segments to scan. This scans the whole table, so it's meant for the
+// infrequent flows, e.g. graceful exit and node decommissioning.
+func (db *DB) ListNodeSegments(ctx context.Context, opts ListNodeSegments) (result ListNodeSegmentsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.NodeID.IsZero() {
+		return ListNodeSegmentsResult{}, ErrInvalidRequest.New("NodeID missing")
+	}
+
+	if opts.BatchSize < 0 {
+		return ListNodeSegmentsResult{}, ErrInvalidRequest.New("Invalid batch size: %d", opts.BatchSize)
+	}
+
+	ListLimit.Ensure(&opts.BatchSize)
+
+	aliases, err := db.aliasCache.Aliases(ctx, []storj.NodeID{opts.NodeID})
+	if err != nil {
+		return ListNodeSegmentsResult{}, Error.New("unable to convert node to alias: %w", err)
+	}
+	alias := aliases[0]
+
+	result.Cursor = opts.Cursor
+
+	var scanned int
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			stream_id, position,
+			root_piece_id, encrypted_size,
+			redundancy,
+			remote_alias_pieces,
+			placement
+		FROM segments
+		`+db.asOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval)+`
+		WHERE
+			(stream_id, position) > ($1, $2) AND
+			remote_alias_pieces IS NOT NULL
+		ORDER BY stream_id ASC, position ASC
+		LIMIT $3
+	`, opts.Cursor.StreamID, opts.Cursor.Position, opts.BatchSize))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var segment NodeSegment
+			var aliasPieces AliasPieces
+			err := rows.Scan(
+				&segment.StreamID, &segment.Position,
+				&segment.RootPieceID, &segment.EncryptedSize,
+				redundancyScheme{&segment.Redundancy},
+				&aliasPieces,
+				&segment.Placement,
+			)
+			if err != nil {
+				return Error.New("failed to scan segments: %w", err)
+			}
+
+			scanned++
+			result.Cursor = NodeSegmentsCursor{
+				StreamID: segment.StreamID,
+				Position: segment.Position,
+			}
+
+			found := false
+			for _, piece := range aliasPieces {
+				if piece.Alias == alias {
+					segment.PieceNumber = piece.Number
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+
+			segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+			if err != nil {
+				return Error.New("failed to convert aliases to pieces: %w", err)
+			}
+			result.Segments = append(result.Segments, segment)
+		}
+		return nil
+	})
+	if err != nil {
+		return ListNodeSegmentsResult{}, Error.New("unable to list node segments: %w", err)
+	}
+
+	result.More = scanned == opts.BatchSize
+	return result, nil
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestListNodeSegments(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.ListNodeSegments{
+				Opts:     metabase.ListNodeSegments{},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NodeID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.ListNodeSegments{
+				Opts: metabase.ListNodeSegments{
+					NodeID:    testrand.NodeID(),
+					BatchSize: -1,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Invalid batch size: -1",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("segments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			n01 := testrand.NodeID()
+			n02 := testrand.NodeID()
+
+			commit := func(obj metabase.ObjectStream, index uint32, pieces metabase.Pieces) metabase.NodeSegment {
+				rootPieceID := testrand.PieceID()
+
+				metabasetest.CommitSegment{
+					Opts: metabase.CommitSegment{
+						ObjectStream: obj,
+						Position:     metabase.SegmentPosition{Index: index},
+						RootPieceID:  rootPieceID,
+						Pieces:       pieces,
+
+						EncryptedKey:      testrand.Bytes(32),
+						EncryptedKeyNonce: testrand.Bytes(32),
+
+						EncryptedSize: 1024,
+						PlainSize:     512,
+						Redundancy:    metabasetest.DefaultRedundancy,
+					},
+				}.Check(ctx, t, db)
+
+				return metabase.NodeSegment{
+					StreamID:      obj.StreamID,
+					Position:      metabase.SegmentPosition{Index: index},
+					RootPieceID:   rootPieceID,
+					EncryptedSize: 1024,
+					Redundancy:    metabasetest.DefaultRedundancy,
+					Pieces:        pieces,
+				}
+			}
+
+			obj1 := metabasetest.RandObjectStream()
+			obj1.StreamID = uuid.UUID{1}
+			obj2 := metabasetest.RandObjectStream()
+			obj2.StreamID = uuid.UUID{2}
+
+			for _, obj := range []metabase.ObjectStream{obj1, obj2} {
+				metabasetest.BeginObjectExactVersion{
+					Opts: metabase.BeginObjectExactVersion{
+						ObjectStream: obj,
+						Encryption:   metabasetest.DefaultEncryption,
+					},
+					Version: 1,
+				}.Check(ctx, t, db)
+			}
+
+			segment1 := commit(obj1, 0, metabase.Pieces{
+				{Number: 1, StorageNode: n01},
+				{Number: 2, StorageNode: n02},
+			})
+			_ = commit(obj1, 1, metabase.Pieces{
+				{Number: 1, StorageNode: n02},
+			})
+			segment3 := commit(obj2, 0, metabase.Pieces{
+				{Number: 3, StorageNode: n01},
+			})
+
+			segment1.PieceNumber = 1
+			segment3.PieceNumber = 3
+
+			metabasetest.ListNodeSegments{
+				Opts: metabase.ListNodeSegments{
+					NodeID: n01,
+				},
+				Result: metabase.ListNodeSegmentsResult{
+					Segments: []metabase.NodeSegment{segment1, segment3},
+					Cursor: metabase.NodeSegmentsCursor{
+						StreamID: obj2.StreamID,
+					},
+				},
+			}.Check(ctx, t, db)
+
+			// segments without a piece on the node are scanned, but not returned.
+			metabasetest.ListNodeSegments{
+				Opts: metabase.ListNodeSegments{
+					NodeID: n01,
+					Cursor: metabase.NodeSegmentsCursor{
+						StreamID: obj1.StreamID,
+						Position: metabase.SegmentPosition{Index: 0},
+					},
+					BatchSize: 1,
+				},
+				Result: metabase.ListNodeSegmentsResult{
+					Cursor: metabase.NodeSegmentsCursor{
+						StreamID: obj1.StreamID,
+						Position: metabase.SegmentPosition{Index: 1},
+					},
+					More: true,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.ListNodeSegments{
+				Opts: metabase.ListNodeSegments{
+					NodeID: storj.NodeID{1},
+				},
+				Result: metabase.ListNodeSegmentsResult{
+					Cursor: metabase.NodeSegmentsCursor{
+						StreamID: obj2.StreamID,
+					},
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -19,6 +19,9 @@ type ListSegments struct {
 	StreamID uuid.UUID
 	Cursor   SegmentPosition
 	Limit    int
+
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
 }
 
 // ListSegmentsResult result of listing segments.
@@ -52,6 +55,7 @@ func (db *DB) ListSegments(ctx context.Context, opts ListSegments) (result ListS
 			redundancy,
 			inline_data, remote_alias_pieces
 		FROM segments
+		`+db.asOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval)+`
 		WHERE
 			stream_id = $1 AND
 			($2 = 0::INT8 OR position > $2)
@@ -108,6 +112,7 @@ func (db *DB) ListSegments(ctx context.Context, opts ListSegments) (result ListS
 						root_piece_id,
 						remote_alias_pieces
 					FROM segments
+					`+db.asOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval)+`
 					WHERE
 						stream_id = (SELECT ancestor_stream_id FROM segment_copies WHERE stream_id = $1)
 						AND position IN (SELECT position FROM UNNEST($2::INT8[]) as position)
@@ -158,6 +163,9 @@ type ListStreamPositions struct {
 	Limit    int
 
 	Range *StreamRange
+
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
 }
 
 // StreamRange allows to limit stream positions based on the plain offsets.
@@ -213,6 +221,7 @@ func (db *DB) ListStreamPositions(ctx context.Context, opts ListStreamPositions)
 				position, plain_size, plain_offset, created_at,
 				encrypted_etag, encrypted_key_nonce, encrypted_key
 			FROM segments
+			`+db.asOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval)+`
 			WHERE
 				stream_id = $1 AND
 				($2 = 0::INT8 OR position > $2)
@@ -225,6 +234,7 @@ func (db *DB) ListStreamPositions(ctx context.Context, opts ListStreamPositions)
 				position, plain_size, plain_offset, created_at,
 				encrypted_etag, encrypted_key_nonce, encrypted_key
 			FROM segments
+			`+db.asOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval)+`
 			WHERE
 				stream_id = $1 AND
 				($2 = 0::INT8 OR position > $2) AND
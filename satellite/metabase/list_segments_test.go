@@ -100,6 +100,17 @@ func TestListSegments(t *testing.T) {
 				},
 			}.Check(ctx, t, db)
 
+			metabasetest.ListSegments{
+				Opts: metabase.ListSegments{
+					StreamID:       obj.StreamID,
+					Limit:          10,
+					AsOfSystemTime: time.Now(),
+				},
+				Result: metabase.ListSegmentsResult{
+					Segments: expectedSegments,
+				},
+			}.Check(ctx, t, db)
+
 			metabasetest.ListSegments{
 				Opts: metabase.ListSegments{
 					StreamID: obj.StreamID,
@@ -62,7 +62,8 @@ func (db *DB) IterateLoopObjects(ctx context.Context, opts IterateLoopObjects, f
 	}
 
 	it := &loopIterator{
-		db: db,
+		db:     db,
+		reader: db.reader(ctx, db.config.ReadReplica.LoopMaxStaleness),
 
 		batchSize: opts.BatchSize,
 
@@ -92,6 +93,8 @@ func (db *DB) IterateLoopObjects(ctx context.Context, opts IterateLoopObjects, f
 // loopIterator enables iteration of all objects in metabase.
 type loopIterator struct {
 	db *DB
+	// reader is the database used for the queries, which may be a read replica.
+	reader tagsql.DB
 
 	batchSize          int
 	asOfSystemTime     time.Time
@@ -160,7 +163,7 @@ func (it *loopIterator) Next(ctx context.Context, item *LoopObjectEntry) bool {
 func (it *loopIterator) doNextQuery(ctx context.Context) (_ tagsql.Rows, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return it.db.db.QueryContext(ctx, `
+	return it.reader.QueryContext(ctx, `
 		SELECT
 			project_id, bucket_name,
 			object_key, stream_id, version,
@@ -225,6 +228,12 @@ type IterateLoopSegments struct {
 	BatchSize          int
 	AsOfSystemTime     time.Time
 	AsOfSystemInterval time.Duration
+
+	// StartStreamID is the first stream ID to iterate, inclusive.
+	StartStreamID uuid.UUID
+	// EndStreamID is the stream ID where the iteration ends, exclusive.
+	// The iteration continues to the end of the segments when it's zero.
+	EndStreamID uuid.UUID
 }
 
 // Verify verifies segments request fields.
@@ -232,6 +241,9 @@ func (opts *IterateLoopSegments) Verify() error {
 	if opts.BatchSize < 0 {
 		return ErrInvalidRequest.New("BatchSize is negative")
 	}
+	if !opts.EndStreamID.IsZero() && opts.EndStreamID.Less(opts.StartStreamID) {
+		return ErrInvalidRequest.New("EndStreamID is smaller than StartStreamID")
+	}
 	return nil
 }
 
@@ -244,19 +256,24 @@ func (db *DB) IterateLoopSegments(ctx context.Context, opts IterateLoopSegments,
 	}
 
 	it := &loopSegmentIterator{
-		db: db,
+		db:     db,
+		reader: db.reader(ctx, db.config.ReadReplica.LoopMaxStaleness),
 
 		asOfSystemTime:     opts.AsOfSystemTime,
 		asOfSystemInterval: opts.AsOfSystemInterval,
 		batchSize:          opts.BatchSize,
+		endStreamID:        opts.EndStreamID,
 
 		curIndex: 0,
-		cursor:   loopSegmentIteratorCursor{},
+		cursor: loopSegmentIteratorCursor{
+			StreamID: opts.StartStreamID,
+		},
 	}
 
 	loopIteratorBatchSizeLimit.Ensure(&it.batchSize)
 
-	it.curRows, err = it.doNextQuery(ctx)
+	// the first segment of StartStreamID is part of the iteration.
+	it.curRows, err = it.doNextQuery(ctx, !opts.StartStreamID.IsZero())
 	if err != nil {
 		return err
 	}
@@ -274,10 +291,13 @@ func (db *DB) IterateLoopSegments(ctx context.Context, opts IterateLoopSegments,
 // loopSegmentIterator enables iteration of all segments in metabase.
 type loopSegmentIterator struct {
 	db *DB
+	// reader is the database used for the queries, which may be a read replica.
+	reader tagsql.DB
 
 	batchSize          int
 	asOfSystemTime     time.Time
 	asOfSystemInterval time.Duration
+	endStreamID        uuid.UUID
 
 	curIndex int
 	curRows  tagsql.Rows
@@ -304,7 +324,7 @@ func (it *loopSegmentIterator) Next(ctx context.Context, item *LoopSegmentEntry)
 			return false
 		}
 
-		rows, err := it.doNextQuery(ctx)
+		rows, err := it.doNextQuery(ctx, false)
 		if err != nil {
 			it.failErr = errs.Combine(it.failErr, err)
 			return false
@@ -335,10 +355,25 @@ func (it *loopSegmentIterator) Next(ctx context.Context, item *LoopSegmentEntry)
 	return true
 }
 
-func (it *loopSegmentIterator) doNextQuery(ctx context.Context) (_ tagsql.Rows, err error) {
+// doNextQuery queries the next batch of segments after the cursor. The
+// segment at the cursor is included when inclusive is true.
+func (it *loopSegmentIterator) doNextQuery(ctx context.Context, inclusive bool) (_ tagsql.Rows, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return it.db.db.QueryContext(ctx, `
+	cursorCondition := "(stream_id, position) > ($1, $2)"
+	if inclusive {
+		cursorCondition = "(stream_id, position) >= ($1, $2)"
+	}
+
+	args := []interface{}{it.cursor.StreamID, it.cursor.Position, it.batchSize}
+
+	endCondition := ""
+	if !it.endStreamID.IsZero() {
+		endCondition = "AND stream_id < $4"
+		args = append(args, it.endStreamID)
+	}
+
+	return it.reader.QueryContext(ctx, `
 		SELECT
 			stream_id, position,
 			created_at, expires_at, repaired_at,
@@ -351,11 +386,11 @@ func (it *loopSegmentIterator) doNextQuery(ctx context.Context) (_ tagsql.Rows,
 		FROM segments
 		`+it.db.asOfTime(it.asOfSystemTime, it.asOfSystemInterval)+`
 		WHERE
-			(stream_id, position) > ($1, $2)
+			`+cursorCondition+`
+			`+endCondition+`
 		ORDER BY (stream_id, position) ASC
 		LIMIT $3
-		`, it.cursor.StreamID, it.cursor.Position,
-		it.batchSize,
+		`, args...,
 	)
 }
 
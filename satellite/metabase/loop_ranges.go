@@ -0,0 +1,151 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/uuid"
+)
+
+// StreamIDRange is a range of stream IDs, from Start inclusive to End
+// exclusive. A zero End means that the range continues to the last stream ID.
+type StreamIDRange struct {
+	Start uuid.UUID
+	End   uuid.UUID
+}
+
+// SplitStreamIDs splits the stream ID keyspace into n ranges of about the same
+// size. The ranges are ordered and together they cover the whole keyspace.
+func SplitStreamIDs(n int) []StreamIDRange {
+	if n <= 1 {
+		return []StreamIDRange{{}}
+	}
+
+	// the stream IDs are random, hence splitting the keyspace by the first
+	// eight bytes spreads the segments evenly.
+	step := ^uint64(0)/uint64(n) + 1
+
+	ranges := make([]StreamIDRange, n)
+	for i := 1; i < n; i++ {
+		var boundary uuid.UUID
+		binary.BigEndian.PutUint64(boundary[:8], uint64(i)*step)
+
+		ranges[i-1].End = boundary
+		ranges[i].Start = boundary
+	}
+	return ranges
+}
+
+// IterateLoopSegmentsParallel contains arguments necessary for iterating
+// over all segments in parallel.
+type IterateLoopSegmentsParallel struct {
+	// Parallelism is the number of stream ID ranges iterated concurrently.
+	Parallelism        int
+	BatchSize          int
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
+
+	// Progress is called after every batch of segments of a range and once
+	// more when the range is done. It may be called concurrently.
+	Progress func(progress LoopRangeProgress)
+}
+
+// LoopRangeProgress describes how far the iteration of a range got.
+type LoopRangeProgress struct {
+	// Range is the index of the range in the result of SplitStreamIDs.
+	Range int
+	// Segments is the number of segments iterated in the range so far.
+	Segments int64
+	// Done is true when the range is finished.
+	Done bool
+}
+
+// Verify verifies segments request fields.
+func (opts *IterateLoopSegmentsParallel) Verify() error {
+	if opts.Parallelism < 0 {
+		return ErrInvalidRequest.New("Parallelism is negative")
+	}
+	if opts.BatchSize < 0 {
+		return ErrInvalidRequest.New("BatchSize is negative")
+	}
+	return nil
+}
+
+// IterateLoopSegmentsParallel splits the stream ID keyspace into
+// opts.Parallelism ranges and iterates the segments of every range
+// concurrently. Every range has its own iterator, which runs independent
+// queries against the database. fn is called concurrently with the index of
+// the range and it must be safe for concurrent use.
+//
+// The iteration stops at the first error returned by fn or a query.
+func (db *DB) IterateLoopSegmentsParallel(ctx context.Context, opts IterateLoopSegmentsParallel, fn func(ctx context.Context, rangeIndex int, it LoopSegmentsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	for i, streamIDRange := range SplitStreamIDs(opts.Parallelism) {
+		i, streamIDRange := i, streamIDRange
+		group.Go(func() error {
+			return db.IterateLoopSegments(ctx, IterateLoopSegments{
+				BatchSize:          opts.BatchSize,
+				AsOfSystemTime:     opts.AsOfSystemTime,
+				AsOfSystemInterval: opts.AsOfSystemInterval,
+				StartStreamID:      streamIDRange.Start,
+				EndStreamID:        streamIDRange.End,
+			}, func(ctx context.Context, it LoopSegmentsIterator) error {
+				if opts.Progress == nil {
+					return fn(ctx, i, it)
+				}
+
+				progress := &progressIterator{
+					iterator:  it,
+					batchSize: opts.BatchSize,
+					report: func(segments int64, done bool) {
+						opts.Progress(LoopRangeProgress{Range: i, Segments: segments, Done: done})
+					},
+				}
+				loopIteratorBatchSizeLimit.Ensure(&progress.batchSize)
+
+				if err := fn(ctx, i, progress); err != nil {
+					return err
+				}
+				progress.report(progress.segments, true)
+				return nil
+			})
+		})
+	}
+
+	return group.Wait()
+}
+
+// progressIterator reports the progress of the wrapped iterator after every
+// batch of segments.
+type progressIterator struct {
+	iterator  LoopSegmentsIterator
+	batchSize int
+	report    func(segments int64, done bool)
+
+	segments int64
+}
+
+// Next returns true if there was another item and copy it in item.
+func (it *progressIterator) Next(ctx context.Context, item *LoopSegmentEntry) bool {
+	if !it.iterator.Next(ctx, item) {
+		return false
+	}
+
+	it.segments++
+	if it.segments%int64(it.batchSize) == 0 {
+		it.report(it.segments, false)
+	}
+	return true
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestSplitStreamIDs(t *testing.T) {
+	require.Equal(t, []metabase.StreamIDRange{{}}, metabase.SplitStreamIDs(0))
+	require.Equal(t, []metabase.StreamIDRange{{}}, metabase.SplitStreamIDs(1))
+
+	for _, n := range []int{2, 3, 7, 16, 1000} {
+		ranges := metabase.SplitStreamIDs(n)
+		require.Len(t, ranges, n)
+
+		// the ranges are adjacent and cover the whole keyspace.
+		require.True(t, ranges[0].Start.IsZero())
+		require.True(t, ranges[n-1].End.IsZero())
+		for i := 1; i < n; i++ {
+			require.Equal(t, ranges[i-1].End, ranges[i].Start)
+			require.True(t, ranges[i].Start.Less(ranges[i].End) || ranges[i].End.IsZero())
+		}
+	}
+}
+
+func TestIterateLoopSegmentsParallel(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("Parallelism is negative", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			err := db.IterateLoopSegmentsParallel(ctx, metabase.IterateLoopSegmentsParallel{
+				Parallelism: -1,
+			}, func(ctx context.Context, rangeIndex int, it metabase.LoopSegmentsIterator) error {
+				return nil
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("EndStreamID is smaller than StartStreamID", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.IterateLoopSegments{
+				Opts: metabase.IterateLoopSegments{
+					StartStreamID: uuid.UUID{2},
+					EndStreamID:   uuid.UUID{1},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "EndStreamID is smaller than StartStreamID",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("every segment is iterated once", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			const numberOfObjects, numberOfSegments = 20, 3
+			for i := 0; i < numberOfObjects; i++ {
+				metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), numberOfSegments)
+			}
+
+			const parallelism = 4
+			ranges := metabase.SplitStreamIDs(parallelism)
+
+			var mu sync.Mutex
+			segments := make(map[uuid.UUID]int)
+			done := make(map[int]int64)
+
+			err := db.IterateLoopSegmentsParallel(ctx, metabase.IterateLoopSegmentsParallel{
+				Parallelism: parallelism,
+				BatchSize:   2,
+				Progress: func(progress metabase.LoopRangeProgress) {
+					if progress.Done {
+						mu.Lock()
+						done[progress.Range] = progress.Segments
+						mu.Unlock()
+					}
+				},
+			}, func(ctx context.Context, rangeIndex int, it metabase.LoopSegmentsIterator) error {
+				var entry metabase.LoopSegmentEntry
+				for it.Next(ctx, &entry) {
+					streamRange := ranges[rangeIndex]
+					require.False(t, entry.StreamID.Less(streamRange.Start))
+					require.True(t, streamRange.End.IsZero() || entry.StreamID.Less(streamRange.End))
+
+					mu.Lock()
+					segments[entry.StreamID]++
+					mu.Unlock()
+				}
+				return nil
+			})
+			require.NoError(t, err)
+
+			require.Len(t, segments, numberOfObjects)
+			for _, count := range segments {
+				require.Equal(t, numberOfSegments, count)
+			}
+
+			require.Len(t, done, parallelism)
+			var total int64
+			for _, count := range done {
+				total += count
+			}
+			require.EqualValues(t, numberOfObjects*numberOfSegments, total)
+		})
+	})
+}
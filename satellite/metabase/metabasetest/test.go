@@ -154,6 +154,36 @@ func (step DeleteBucketObjects) Check(ctx *testcontext.Context, t testing.TB, db
 	checkError(t, err, step.ErrClass, step.ErrText)
 }
 
+// DeleteObjectsByPrefix is for testing metabase.DeleteObjectsByPrefix.
+type DeleteObjectsByPrefix struct {
+	Opts     metabase.DeleteObjectsByPrefix
+	Deleted  int64
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step DeleteObjectsByPrefix) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	deleted, err := db.DeleteObjectsByPrefix(ctx, step.Opts)
+	require.Equal(t, step.Deleted, deleted)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
+// DeleteObjectsCreatedBefore is for testing metabase.DeleteObjectsCreatedBefore.
+type DeleteObjectsCreatedBefore struct {
+	Opts     metabase.DeleteObjectsCreatedBefore
+	Deleted  int64
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step DeleteObjectsCreatedBefore) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	deleted, err := db.DeleteObjectsCreatedBefore(ctx, step.Opts)
+	require.Equal(t, step.Deleted, deleted)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
 // UpdateObjectMetadata is for testing metabase.UpdateObjectMetadata.
 type UpdateObjectMetadata struct {
 	Opts     metabase.UpdateObjectMetadata
@@ -180,6 +210,34 @@ func (step UpdateSegmentPieces) Check(ctx *testcontext.Context, t testing.TB, db
 	checkError(t, err, step.ErrClass, step.ErrText)
 }
 
+// UpdateSegmentPiecesBatch is for testing metabase.UpdateSegmentPiecesBatch.
+type UpdateSegmentPiecesBatch struct {
+	Opts []metabase.UpdateSegmentPieces
+	// Failures contains the expected error class of every segment, nil when
+	// the segment is expected to be updated.
+	Failures []*errs.Class
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step UpdateSegmentPiecesBatch) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	failures, err := db.UpdateSegmentPiecesBatch(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	if err != nil {
+		return
+	}
+
+	require.Len(t, failures, len(step.Failures))
+	for i, class := range step.Failures {
+		if class == nil {
+			require.NoError(t, failures[i], "segment %d", i)
+		} else {
+			require.True(t, class.Has(failures[i]), "segment %d: %v", i, failures[i])
+		}
+	}
+}
+
 // GetObjectExactVersion is for testing metabase.GetObjectExactVersion.
 type GetObjectExactVersion struct {
 	Opts     metabase.GetObjectExactVersion
@@ -197,6 +255,44 @@ func (step GetObjectExactVersion) Check(ctx *testcontext.Context, t testing.TB,
 	require.Zero(t, diff)
 }
 
+// GetObjectLastCommitted is for testing metabase.GetObjectLastCommitted.
+type GetObjectLastCommitted struct {
+	Opts     metabase.GetObjectLastCommitted
+	Result   metabase.Object
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step GetObjectLastCommitted) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.GetObjectLastCommitted(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result, DefaultTimeDiff())
+	require.Zero(t, diff)
+}
+
+// InsertDeleteMarker is for testing metabase.InsertDeleteMarker.
+type InsertDeleteMarker struct {
+	Opts     metabase.InsertDeleteMarker
+	Version  metabase.Version
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step InsertDeleteMarker) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) metabase.Object {
+	marker, err := db.InsertDeleteMarker(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	if err == nil {
+		require.Equal(t, step.Opts.ObjectLocation, marker.Location())
+		require.Equal(t, step.Version, marker.Version)
+		require.Equal(t, metabase.DeleteMarker, marker.Status)
+		require.WithinDuration(t, time.Now(), marker.CreatedAt, 5*time.Second)
+	}
+	return marker
+}
+
 // GetSegmentByPosition is for testing metabase.GetSegmentByPosition.
 type GetSegmentByPosition struct {
 	Opts     metabase.GetSegmentByPosition
@@ -214,6 +310,23 @@ func (step GetSegmentByPosition) Check(ctx *testcontext.Context, t testing.TB, d
 	require.Zero(t, diff)
 }
 
+// GetSegmentByOffset is for testing metabase.GetSegmentByOffset.
+type GetSegmentByOffset struct {
+	Opts     metabase.GetSegmentByOffset
+	Result   metabase.Segment
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step GetSegmentByOffset) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.GetSegmentByOffset(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result, DefaultTimeDiff())
+	require.Zero(t, diff)
+}
+
 // GetLatestObjectLastSegment is for testing metabase.GetLatestObjectLastSegment.
 type GetLatestObjectLastSegment struct {
 	Opts     metabase.GetLatestObjectLastSegment
@@ -302,6 +415,104 @@ func (step GetStreamPieceCountByNodeID) Check(ctx *testcontext.Context, t testin
 	require.Zero(t, diff)
 }
 
+// GetStreamPieceCountByAlias is for testing metabase.GetStreamPieceCountByAlias.
+type GetStreamPieceCountByAlias struct {
+	Opts     metabase.GetStreamPieceCountByAlias
+	Result   map[metabase.NodeAlias]int64
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step GetStreamPieceCountByAlias) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.GetStreamPieceCountByAlias(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result)
+	require.Zero(t, diff)
+}
+
+// ListNodeSegments is for testing metabase.ListNodeSegments.
+type ListNodeSegments struct {
+	Opts     metabase.ListNodeSegments
+	Result   metabase.ListNodeSegmentsResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step ListNodeSegments) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.ListNodeSegments(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result, cmpopts.EquateEmpty())
+	require.Zero(t, diff)
+}
+
+// UpdateSegmentHealth is for testing metabase.UpdateSegmentHealth.
+type UpdateSegmentHealth struct {
+	Opts     metabase.UpdateSegmentHealth
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step UpdateSegmentHealth) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	err := db.UpdateSegmentHealth(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
+// ListUnhealthiestSegments is for testing metabase.ListUnhealthiestSegments.
+type ListUnhealthiestSegments struct {
+	Opts     metabase.ListUnhealthiestSegments
+	Result   []metabase.UnhealthySegment
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step ListUnhealthiestSegments) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.ListUnhealthiestSegments(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result, DefaultTimeDiff(), cmpopts.EquateEmpty())
+	require.Zero(t, diff)
+}
+
+// CollectBucketTallies is for testing metabase.CollectBucketTallies.
+type CollectBucketTallies struct {
+	Opts     metabase.CollectBucketTallies
+	Result   []metabase.BucketTally
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step CollectBucketTallies) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.CollectBucketTallies(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result, cmpopts.EquateEmpty())
+	require.Zero(t, diff)
+}
+
+// GetBucketTotals is for testing metabase.GetBucketTotals.
+type GetBucketTotals struct {
+	Opts     metabase.GetBucketTotals
+	Result   metabase.BucketTally
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step GetBucketTotals) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.GetBucketTotals(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result)
+	require.Zero(t, diff)
+}
+
 // IterateLoopSegments is for testing metabase.IterateLoopSegments.
 type IterateLoopSegments struct {
 	Opts     metabase.IterateLoopSegments
@@ -442,7 +653,8 @@ func (step DeleteExpiredObjects) Check(ctx *testcontext.Context, t testing.TB, d
 
 // DeleteZombieObjects is for testing metabase.DeleteZombieObjects.
 type DeleteZombieObjects struct {
-	Opts metabase.DeleteZombieObjects
+	Opts   metabase.DeleteZombieObjects
+	Result metabase.DeleteZombieObjectsResult
 
 	ErrClass *errs.Class
 	ErrText  string
@@ -450,7 +662,76 @@ type DeleteZombieObjects struct {
 
 // Check runs the test.
 func (step DeleteZombieObjects) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
-	err := db.DeleteZombieObjects(ctx, step.Opts)
+	result, err := db.DeleteZombieObjects(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	require.Equal(t, step.Result, result)
+}
+
+// TrashObject is for testing metabase.TrashObject.
+type TrashObject struct {
+	Opts     metabase.TrashObject
+	Result   metabase.DeleteObjectResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step TrashObject) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.TrashObject(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	sortObjects(result.Objects)
+	sortObjects(step.Result.Objects)
+
+	diff := cmp.Diff(step.Result, result, DefaultTimeDiff(), cmpopts.EquateEmpty())
+	require.Zero(t, diff)
+}
+
+// RestoreObject is for testing metabase.RestoreObject.
+type RestoreObject struct {
+	Opts     metabase.RestoreObject
+	Result   metabase.Object
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step RestoreObject) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.RestoreObject(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result, DefaultTimeDiff())
+	require.Zero(t, diff)
+}
+
+// ListTrashedObjects is for testing metabase.ListTrashedObjects.
+type ListTrashedObjects struct {
+	Opts     metabase.ListTrashedObjects
+	Result   metabase.ListTrashedObjectsResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step ListTrashedObjects) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.ListTrashedObjects(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result, DefaultTimeDiff(), cmpopts.EquateEmpty())
+	require.Zero(t, diff)
+}
+
+// DeleteTrashedObjects is for testing metabase.DeleteTrashedObjects.
+type DeleteTrashedObjects struct {
+	Opts metabase.DeleteTrashedObjects
+
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step DeleteTrashedObjects) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	err := db.DeleteTrashedObjects(ctx, step.Opts)
 	checkError(t, err, step.ErrClass, step.ErrText)
 }
 
@@ -522,6 +803,46 @@ func (step IterateObjectsWithStatus) Check(ctx *testcontext.Context, t testing.T
 	require.Zero(t, diff)
 }
 
+// IterateObjectVersions is for testing metabase.IterateObjectVersions.
+type IterateObjectVersions struct {
+	Opts metabase.IterateObjectVersions
+
+	Result   []metabase.ObjectEntry
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step IterateObjectVersions) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	var result IterateCollector
+
+	err := db.IterateObjectVersions(ctx, step.Opts, result.Add)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, []metabase.ObjectEntry(result), DefaultTimeDiff())
+	require.Zero(t, diff)
+}
+
+// IterateObjectsLatestVersion is for testing metabase.IterateObjectsLatestVersion.
+type IterateObjectsLatestVersion struct {
+	Opts metabase.IterateObjectsWithStatus
+
+	Result   []metabase.ObjectEntry
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step IterateObjectsLatestVersion) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	var result IterateCollector
+
+	err := db.IterateObjectsLatestVersion(ctx, step.Opts, result.Add)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, []metabase.ObjectEntry(result), DefaultTimeDiff())
+	require.Zero(t, diff)
+}
+
 // IterateLoopObjects is for testing metabase.IterateLoopObjects.
 type IterateLoopObjects struct {
 	Opts metabase.IterateLoopObjects
@@ -588,6 +909,25 @@ func (step GetTableStats) Check(ctx *testcontext.Context, t testing.TB, db *meta
 	return result
 }
 
+// GetProjectEncryptionStats is for testing metabase.GetProjectEncryptionStats.
+type GetProjectEncryptionStats struct {
+	Opts     metabase.GetProjectEncryptionStats
+	Result   []metabase.EncryptionStats
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step GetProjectEncryptionStats) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) []metabase.EncryptionStats {
+	result, err := db.GetProjectEncryptionStats(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result)
+	require.Zero(t, diff)
+
+	return result
+}
+
 // BeginMoveObject is for testing metabase.BeginMoveObject.
 type BeginMoveObject struct {
 	Opts     metabase.BeginMoveObject
@@ -5,8 +5,11 @@ package metabase
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 )
 
 // UpdateObjectMetadata contains arguments necessary for replacing an object metadata.
@@ -18,8 +21,17 @@ type UpdateObjectMetadata struct {
 	EncryptedMetadataEncryptedKey []byte
 }
 
-// UpdateObjectMetadata updates an object metadata.
+// UpdateObjectMetadata replaces the metadata of a committed object in place,
+// without creating a new version of the object.
+//
+// The update is rejected with ErrObjectModified when the object at the location
+// and version was replaced by another upload, i.e. its stream ID doesn't match.
 func (db *DB) UpdateObjectMetadata(ctx context.Context, opts UpdateObjectMetadata) (err error) {
+	return db.updateObjectMetadata(ctx, opts, db.db)
+}
+
+// implementation of DB.UpdateObjectMetadata for re-use internally in metabase package.
+func (db *DB) updateObjectMetadata(ctx context.Context, opts UpdateObjectMetadata, q execQueryer) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if err := opts.ObjectStream.Verify(); err != nil {
@@ -35,7 +47,7 @@ func (db *DB) UpdateObjectMetadata(ctx context.Context, opts UpdateObjectMetadat
 	// to CommitObject, they will need to account for them being optional.
 	// Leading to scenarios where uplink calls update metadata, but wants to clear them
 	// during commit object.
-	result, err := db.db.ExecContext(ctx, `
+	result, err := q.ExecContext(ctx, `
 		UPDATE objects SET
 			encrypted_metadata_nonce         = $6,
 			encrypted_metadata               = $7,
@@ -59,6 +71,25 @@ func (db *DB) UpdateObjectMetadata(ctx context.Context, opts UpdateObjectMetadat
 	}
 
 	if affected == 0 {
+		var streamID uuid.UUID
+		err = q.QueryRowContext(ctx, `
+			SELECT stream_id
+			FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version      = $4 AND
+				status       = `+committedStatus,
+			opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version,
+		).Scan(&streamID)
+		if err == nil {
+			return ErrObjectModified.New("object stream id %s doesn't match the requested %s", streamID, opts.StreamID)
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return Error.New("unable to query object stream id: %w", err)
+		}
+
 		return storj.ErrObjectNotFound.Wrap(
 			Error.New("object with specified version and committed status is missing"),
 		)
@@ -66,6 +66,30 @@ func TestUpdateObjectMetadata(t *testing.T) {
 			metabasetest.Verify{}.Check(ctx, t, db)
 		})
 
+		t.Run("Stream ID mismatch", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object, _ := metabasetest.CreateTestObject{}.Run(ctx, t, db, obj, 0)
+
+			replaced := obj
+			replaced.StreamID = testrand.UUID()
+
+			metabasetest.UpdateObjectMetadata{
+				Opts: metabase.UpdateObjectMetadata{
+					ObjectStream:      replaced,
+					EncryptedMetadata: testrand.Bytes(32),
+				},
+				ErrClass: &metabase.ErrObjectModified,
+				ErrText:  "object stream id " + obj.StreamID.String() + " doesn't match the requested " + replaced.StreamID.String(),
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+
 		t.Run("Update metadata", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -39,6 +39,9 @@ type RawObject struct {
 	// This is as a safeguard against objects that failed to upload and the client has not indicated
 	// whether they want to continue uploading or delete the already uploaded data.
 	ZombieDeletionDeadline *time.Time
+
+	// RestoreDeadline defines until when the trashed raw object can be restored.
+	RestoreDeadline *time.Time
 }
 
 // RawSegment defines the full segment that is stored in the database. It should be rarely used directly.
@@ -129,7 +132,8 @@ func (db *DB) testingGetAllObjects(ctx context.Context) (_ []RawObject, err erro
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
 			encryption,
-			zombie_deletion_deadline
+			zombie_deletion_deadline,
+			restore_deadline
 		FROM objects
 		ORDER BY project_id ASC, bucket_name ASC, object_key ASC, version ASC
 	`)
@@ -162,6 +166,7 @@ func (db *DB) testingGetAllObjects(ctx context.Context) (_ []RawObject, err erro
 
 			encryptionParameters{&obj.Encryption},
 			&obj.ZombieDeletionDeadline,
+			&obj.RestoreDeadline,
 		)
 		if err != nil {
 			return nil, Error.New("testingGetAllObjects scan failed: %w", err)
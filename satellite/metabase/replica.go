@@ -0,0 +1,143 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/private/dbutil"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// ReadReplicaConfig is the configuration for sending reads, which tolerate
+// stale data, to a read replica of the metabase.
+//
+// Every kind of read has its own staleness tolerance. Zero means that the
+// reads are always sent to the primary.
+type ReadReplicaConfig struct {
+	DatabaseURL string `help:"the database connection string of a metabase read replica, reads are only sent to the primary when empty" default:""`
+
+	LoopMaxStaleness    time.Duration `help:"maximum replication lag tolerated by the objects and segments loops (e.g. tally, audit, repair checker), zero to always read from the primary" default:"0s"`
+	ListingMaxStaleness time.Duration `help:"maximum replication lag tolerated when listing objects, zero to always read from the primary" default:"0s"`
+	StatsMaxStaleness   time.Duration `help:"maximum replication lag tolerated by statistics and accounting reads, zero to always read from the primary" default:"0s"`
+
+	LagCheckInterval time.Duration `help:"how often the replication lag of the read replica is checked" default:"10s"`
+}
+
+// readReplica is a connection to a read replica of the metabase, which keeps
+// track of the replication lag.
+type readReplica struct {
+	log    *zap.Logger
+	db     tagsql.DB
+	impl   dbutil.Implementation
+	config ReadReplicaConfig
+
+	nowFn func() time.Time
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	lag       time.Duration
+	lagErr    error
+}
+
+// openReadReplica opens the read replica configured in config.
+func openReadReplica(ctx context.Context, log *zap.Logger, driverName string, impl dbutil.Implementation, applicationName string, config ReadReplicaConfig) (*readReplica, error) {
+	connstr, err := pgutil.CheckApplicationName(config.DatabaseURL, applicationName)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	rawdb, err := tagsql.Open(ctx, driverName, connstr)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	dbutil.Configure(ctx, rawdb, "metabase-replica", mon)
+
+	return &readReplica{
+		log:    log,
+		db:     postgresRebind{rawdb},
+		impl:   impl,
+		config: config,
+		nowFn:  time.Now,
+	}, nil
+}
+
+// Lag returns the replication lag of the replica. The lag is queried at most
+// once every LagCheckInterval.
+func (replica *readReplica) Lag(ctx context.Context) (_ time.Duration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	replica.mu.Lock()
+	defer replica.mu.Unlock()
+
+	now := replica.nowFn()
+	if !replica.checkedAt.IsZero() && now.Sub(replica.checkedAt) < replica.config.LagCheckInterval {
+		return replica.lag, replica.lagErr
+	}
+
+	replica.lag, replica.lagErr = replica.queryLag(ctx)
+	replica.checkedAt = now
+	if replica.lagErr == nil {
+		mon.DurationVal("metabase_replica_lag").Observe(replica.lag)
+	}
+	return replica.lag, replica.lagErr
+}
+
+func (replica *readReplica) queryLag(ctx context.Context) (lag time.Duration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch replica.impl {
+	case dbutil.Postgres:
+		// pg_last_xact_replay_timestamp is NULL when the server is not a
+		// standby, in which case there is no lag.
+		var seconds sql.NullFloat64
+		err = replica.db.QueryRowContext(ctx, `
+			SELECT EXTRACT(EPOCH FROM (now() - pg_last_xact_replay_timestamp()))
+		`).Scan(&seconds)
+		if err != nil {
+			return 0, Error.Wrap(err)
+		}
+		if !seconds.Valid || seconds.Float64 < 0 {
+			return 0, nil
+		}
+		return time.Duration(seconds.Float64 * float64(time.Second)), nil
+	default:
+		// cockroach replicas are kept consistent by the cluster itself.
+		return 0, nil
+	}
+}
+
+// Close closes the connection to the replica.
+func (replica *readReplica) Close() error {
+	return replica.db.Close()
+}
+
+// reader returns the database which should be used for a read tolerating
+// maxStaleness replication lag. It's the replica when it's configured and
+// its lag is within maxStaleness, otherwise it's the primary.
+func (db *DB) reader(ctx context.Context, maxStaleness time.Duration) tagsql.DB {
+	if db.replica == nil || maxStaleness <= 0 {
+		return db.db
+	}
+
+	lag, err := db.replica.Lag(ctx)
+	if err != nil {
+		db.log.Warn("unable to check read replica lag, reading from the primary", zap.Error(err))
+		mon.Meter("metabase_replica_fallback").Mark(1)
+		return db.db
+	}
+	if lag > maxStaleness {
+		mon.Meter("metabase_replica_fallback").Mark(1)
+		return db.db
+	}
+
+	mon.Meter("metabase_replica_read").Mark(1)
+	return db.replica.db
+}
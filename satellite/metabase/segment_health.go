@@ -0,0 +1,136 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+)
+
+// UpdateSegmentHealth contains arguments necessary for updating the health
+// of a segment.
+type UpdateSegmentHealth struct {
+	StreamID uuid.UUID
+	Position SegmentPosition
+
+	// Health is the health of the segment computed by the repair checker,
+	// lower is less healthy. Nil clears the health, e.g. when the segment
+	// became healthy.
+	Health *float64
+}
+
+// UpdateSegmentHealth updates the health of the segment. The health is cleared
+// when the segment pieces are updated by the repair.
+func (db *DB) UpdateSegmentHealth(ctx context.Context, opts UpdateSegmentHealth) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.StreamID.IsZero() {
+		return ErrInvalidRequest.New("StreamID missing")
+	}
+
+	var health sql.NullFloat64
+	if opts.Health != nil {
+		health = sql.NullFloat64{Float64: *opts.Health, Valid: true}
+	}
+
+	var position SegmentPosition
+	err = db.db.QueryRowContext(ctx, `
+		UPDATE segments SET
+			health = $3
+		WHERE
+			stream_id = $1 AND
+			position  = $2
+		RETURNING position
+	`, opts.StreamID, opts.Position, health).Scan(&position)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrSegmentNotFound.New("segment missing")
+		}
+		return Error.New("unable to update segment health: %w", err)
+	}
+
+	return nil
+}
+
+// ListUnhealthiestSegments contains arguments necessary for listing the
+// unhealthiest segments.
+type ListUnhealthiestSegments struct {
+	// Placement limits the listing to the segments with the placement, when
+	// not nil.
+	Placement *storj.PlacementConstraint
+	Limit     int
+
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
+}
+
+// UnhealthySegment is a segment with a known health.
+type UnhealthySegment struct {
+	StreamID uuid.UUID
+	Position SegmentPosition
+
+	Health     float64
+	Placement  storj.PlacementConstraint
+	CreatedAt  time.Time
+	RepairedAt *time.Time
+}
+
+// ListUnhealthiestSegments lists at most Limit not expired segments with a
+// known health, ordered from the unhealthiest one.
+func (db *DB) ListUnhealthiestSegments(ctx context.Context, opts ListUnhealthiestSegments) (segments []UnhealthySegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.Limit < 0 {
+		return nil, ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	}
+
+	ListLimit.Ensure(&opts.Limit)
+
+	args := []interface{}{opts.Limit}
+	placementCondition := ""
+	if opts.Placement != nil {
+		placementCondition = "AND COALESCE(placement, 0) = $2"
+		args = append(args, *opts.Placement)
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			stream_id, position,
+			health, placement,
+			created_at, repaired_at
+		FROM segments
+		`+db.asOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval)+`
+		WHERE
+			health IS NOT NULL AND
+			(expires_at IS NULL OR expires_at > now())
+			`+placementCondition+`
+		ORDER BY health ASC, stream_id ASC, position ASC
+		LIMIT $1
+	`, args...))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var segment UnhealthySegment
+			err := rows.Scan(
+				&segment.StreamID, &segment.Position,
+				&segment.Health, &segment.Placement,
+				&segment.CreatedAt, &segment.RepairedAt,
+			)
+			if err != nil {
+				return Error.New("failed to scan segments: %w", err)
+			}
+			segments = append(segments, segment)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to list unhealthiest segments: %w", err)
+	}
+
+	return segments, nil
+}
@@ -0,0 +1,241 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestUpdateSegmentHealth(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		health := 0.5
+
+		t.Run("StreamID missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.UpdateSegmentHealth{
+				Opts: metabase.UpdateSegmentHealth{
+					Health: &health,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "StreamID missing",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("segment missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.UpdateSegmentHealth{
+				Opts: metabase.UpdateSegmentHealth{
+					StreamID: obj.StreamID,
+					Health:   &health,
+				},
+				ErrClass: &metabase.ErrSegmentNotFound,
+				ErrText:  "segment missing",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("update and clear", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 1)
+			segment := segments[0]
+
+			metabasetest.UpdateSegmentHealth{
+				Opts: metabase.UpdateSegmentHealth{
+					StreamID: obj.StreamID,
+					Health:   &health,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.ListUnhealthiestSegments{
+				Result: []metabase.UnhealthySegment{{
+					StreamID:  segment.StreamID,
+					Position:  segment.Position,
+					Health:    health,
+					CreatedAt: segment.CreatedAt,
+				}},
+			}.Check(ctx, t, db)
+
+			metabasetest.UpdateSegmentHealth{
+				Opts: metabase.UpdateSegmentHealth{
+					StreamID: obj.StreamID,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.ListUnhealthiestSegments{}.Check(ctx, t, db)
+		})
+
+		t.Run("repair clears health", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 1)
+			segment := segments[0]
+
+			metabasetest.UpdateSegmentHealth{
+				Opts: metabase.UpdateSegmentHealth{
+					StreamID: obj.StreamID,
+					Health:   &health,
+				},
+			}.Check(ctx, t, db)
+
+			// updating the pieces without repair keeps the health.
+			newPieces := metabase.Pieces{{Number: 1, StorageNode: testrand.NodeID()}}
+			metabasetest.UpdateSegmentPieces{
+				Opts: metabase.UpdateSegmentPieces{
+					StreamID:      segment.StreamID,
+					Position:      segment.Position,
+					OldPieces:     segment.Pieces,
+					NewRedundancy: segment.Redundancy,
+					NewPieces:     newPieces,
+				},
+			}.Check(ctx, t, db)
+
+			unhealthy, err := db.ListUnhealthiestSegments(ctx, metabase.ListUnhealthiestSegments{})
+			require.NoError(t, err)
+			require.Len(t, unhealthy, 1)
+
+			metabasetest.UpdateSegmentPieces{
+				Opts: metabase.UpdateSegmentPieces{
+					StreamID:      segment.StreamID,
+					Position:      segment.Position,
+					OldPieces:     newPieces,
+					NewRedundancy: segment.Redundancy,
+					NewPieces:     segment.Pieces,
+					NewRepairedAt: time.Now(),
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.ListUnhealthiestSegments{}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestListUnhealthiestSegments(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid limit", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.ListUnhealthiestSegments{
+				Opts: metabase.ListUnhealthiestSegments{
+					Limit: -1,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Invalid limit: -1",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("ordered by health", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			createSegment := func(placement storj.PlacementConstraint, health *float64) metabase.Segment {
+				obj := metabasetest.RandObjectStream()
+				metabasetest.BeginObjectExactVersion{
+					Opts: metabase.BeginObjectExactVersion{
+						ObjectStream: obj,
+						Encryption:   metabasetest.DefaultEncryption,
+					},
+					Version: obj.Version,
+				}.Check(ctx, t, db)
+
+				metabasetest.CommitSegment{
+					Opts: metabase.CommitSegment{
+						ObjectStream: obj,
+						RootPieceID:  testrand.PieceID(),
+						Pieces:       metabase.Pieces{{Number: 0, StorageNode: testrand.NodeID()}},
+
+						EncryptedKey:      testrand.Bytes(32),
+						EncryptedKeyNonce: testrand.Bytes(32),
+
+						EncryptedSize: 1024,
+						PlainSize:     512,
+						Redundancy:    metabasetest.DefaultRedundancy,
+						Placement:     placement,
+					},
+				}.Check(ctx, t, db)
+
+				metabasetest.UpdateSegmentHealth{
+					Opts: metabase.UpdateSegmentHealth{
+						StreamID: obj.StreamID,
+						Health:   health,
+					},
+				}.Check(ctx, t, db)
+
+				segments, err := db.TestingAllSegments(ctx)
+				require.NoError(t, err)
+
+				for _, segment := range segments {
+					if segment.StreamID == obj.StreamID {
+						return segment
+					}
+				}
+				require.FailNow(t, "segment missing")
+				return metabase.Segment{}
+			}
+
+			unhealthy := func(segment metabase.Segment, health float64) metabase.UnhealthySegment {
+				return metabase.UnhealthySegment{
+					StreamID:  segment.StreamID,
+					Position:  segment.Position,
+					Health:    health,
+					Placement: segment.Placement,
+					CreatedAt: segment.CreatedAt,
+				}
+			}
+
+			low, middle, high := 0.1, 0.5, 0.9
+			lowSegment := unhealthy(createSegment(storj.EveryCountry, &low), low)
+			highSegment := unhealthy(createSegment(storj.EveryCountry, &high), high)
+			middleSegment := unhealthy(createSegment(storj.EU, &middle), middle)
+			// the segments without health aren't listed.
+			createSegment(storj.EveryCountry, nil)
+
+			metabasetest.ListUnhealthiestSegments{
+				Result: []metabase.UnhealthySegment{lowSegment, middleSegment, highSegment},
+			}.Check(ctx, t, db)
+
+			metabasetest.ListUnhealthiestSegments{
+				Opts: metabase.ListUnhealthiestSegments{
+					Limit: 2,
+				},
+				Result: []metabase.UnhealthySegment{lowSegment, middleSegment},
+			}.Check(ctx, t, db)
+
+			eu := storj.EU
+			metabasetest.ListUnhealthiestSegments{
+				Opts: metabase.ListUnhealthiestSegments{
+					Placement: &eu,
+				},
+				Result: []metabase.UnhealthySegment{middleSegment},
+			}.Check(ctx, t, db)
+
+			everyCountry := storj.EveryCountry
+			metabasetest.ListUnhealthiestSegments{
+				Opts: metabase.ListUnhealthiestSegments{
+					Placement: &everyCountry,
+					Limit:     1,
+				},
+				Result: []metabase.UnhealthySegment{lowSegment},
+			}.Check(ctx, t, db)
+		})
+	})
+}
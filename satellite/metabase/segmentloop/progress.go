@@ -0,0 +1,98 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentloop
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Progress describes the progress of the current iteration of the segments loop.
+type Progress struct {
+	// Running is false when there's no iteration in progress.
+	Running bool
+	// Started is when the current iteration started.
+	Started time.Time
+	// Processed is the number of segments processed so far.
+	Processed int64
+	// Estimated is the number of segments in the metabase when the iteration
+	// started, it's only an estimate of the segments it will process.
+	Estimated int64
+	// Observers is the number of observers still receiving segments.
+	Observers int
+}
+
+// Ratio returns the estimated ratio of the processed segments, between 0 and 1.
+func (progress Progress) Ratio() float64 {
+	if progress.Estimated <= 0 {
+		return 0
+	}
+	ratio := float64(progress.Processed) / float64(progress.Estimated)
+	if ratio > 1 {
+		return 1
+	}
+	return ratio
+}
+
+// progressTracker tracks the progress of the iterations of the loop.
+//
+// processed and observers are updated for every segment, hence they are
+// atomics rather than protected by the mutex.
+type progressTracker struct {
+	processed int64
+	observers int64
+
+	mu        sync.Mutex
+	running   bool
+	started   time.Time
+	estimated int64
+}
+
+// Start resets the progress for a new iteration.
+func (tracker *progressTracker) Start(started time.Time, estimated int64, observers int) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	tracker.running = true
+	tracker.started = started
+	tracker.estimated = estimated
+	atomic.StoreInt64(&tracker.processed, 0)
+	atomic.StoreInt64(&tracker.observers, int64(observers))
+}
+
+// SetProcessed updates the number of processed segments.
+func (tracker *progressTracker) SetProcessed(processed int64) {
+	atomic.StoreInt64(&tracker.processed, processed)
+}
+
+// SetObservers updates the number of observers still receiving segments.
+func (tracker *progressTracker) SetObservers(observers int) {
+	atomic.StoreInt64(&tracker.observers, int64(observers))
+}
+
+// Finish marks the current iteration as finished.
+func (tracker *progressTracker) Finish() {
+	progress := tracker.Get()
+	mon.DurationVal("segmentloop_iteration_duration").Observe(time.Since(progress.Started))
+	mon.FloatVal("segmentloop_iteration_processed_ratio").Observe(progress.Ratio())
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	tracker.running = false
+}
+
+// Get returns the progress of the current iteration.
+func (tracker *progressTracker) Get() Progress {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	return Progress{
+		Running:   tracker.running,
+		Started:   tracker.started,
+		Processed: atomic.LoadInt64(&tracker.processed),
+		Estimated: tracker.estimated,
+		Observers: int(atomic.LoadInt64(&tracker.observers)),
+	}
+}
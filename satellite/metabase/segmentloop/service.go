@@ -28,6 +28,9 @@ var (
 	Error = errs.Class("segments loop")
 	// ErrClosed is a loop closed error.
 	ErrClosed = Error.New("loop closed")
+	// ErrObserverTimeout is returned to the observers, which spent more than
+	// the configured observer timeout processing the segments of an iteration.
+	ErrObserverTimeout = Error.New("observer timed out")
 )
 
 // Segment contains information about segment metadata which will be received by observers.
@@ -84,6 +87,11 @@ type observerContext struct {
 	ctx  context.Context
 	done chan error
 
+	// timeout is how long the observer may spend processing the segments
+	// of an iteration, 0 means no limit.
+	timeout time.Duration
+	spent   time.Duration
+
 	remote *monkit.DurationDist
 	inline *monkit.DurationDist
 }
@@ -105,18 +113,32 @@ func newObserverContext(ctx context.Context, obs Observer) *observerContext {
 
 func (observer *observerContext) RemoteSegment(ctx context.Context, segment *Segment) error {
 	start := time.Now()
-	defer func() { observer.remote.Insert(time.Since(start)) }()
+	defer func() {
+		elapsed := time.Since(start)
+		observer.spent += elapsed
+		observer.remote.Insert(elapsed)
+	}()
 
 	return observer.observer.RemoteSegment(ctx, segment)
 }
 
 func (observer *observerContext) InlineSegment(ctx context.Context, segment *Segment) error {
 	start := time.Now()
-	defer func() { observer.inline.Insert(time.Since(start)) }()
+	defer func() {
+		elapsed := time.Since(start)
+		observer.spent += elapsed
+		observer.inline.Insert(elapsed)
+	}()
 
 	return observer.observer.InlineSegment(ctx, segment)
 }
 
+// TimedOut returns true when the observer spent more than its timeout
+// processing segments.
+func (observer *observerContext) TimedOut() bool {
+	return observer.timeout > 0 && observer.spent > observer.timeout
+}
+
 func (observer *observerContext) HandleError(err error) bool {
 	if err != nil {
 		observer.done <- err
@@ -147,6 +169,8 @@ type Config struct {
 	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
 
 	SuspiciousProcessedRatio float64 `help:"ratio where to consider processed count as supicious" default:"0.03"`
+
+	ObserverTimeout time.Duration `help:"how long an observer may spend processing the segments of an iteration before it's removed from it (default is 0 which is unlimited)" default:"0"`
 }
 
 // MetabaseDB contains iterators for the metabase data.
@@ -169,6 +193,8 @@ type Service struct {
 	metabaseDB MetabaseDB
 	join       chan *observerContext
 	done       chan struct{}
+
+	progress *progressTracker
 }
 
 // New creates a new segments loop service.
@@ -179,6 +205,7 @@ func New(log *zap.Logger, config Config, metabaseDB MetabaseDB) *Service {
 		config:     config,
 		join:       make(chan *observerContext),
 		done:       make(chan struct{}),
+		progress:   new(progressTracker),
 	}
 }
 
@@ -208,6 +235,7 @@ func (loop *Service) joinObserver(ctx context.Context, trigger bool, obs Observe
 	defer mon.Task()(&ctx)(&err)
 
 	obsctx := newObserverContext(ctx, obs)
+	obsctx.timeout = loop.config.ObserverTimeout
 	obsctx.immediate = sync2.IsManuallyTriggeredCycle(ctx)
 	obsctx.trigger = trigger || obsctx.immediate
 
@@ -343,6 +371,12 @@ func stopTimer(t *time.Timer) {
 	}
 }
 
+// Progress returns the progress of the current iteration.
+// Safe to be called concurrently.
+func (loop *Service) Progress() Progress {
+	return loop.progress.Get()
+}
+
 // Wait waits for run to be finished.
 // Safe to be called concurrently.
 func (loop *Service) Wait() {
@@ -367,6 +401,9 @@ func (loop *Service) iterateDatabase(ctx context.Context, observers []*observerC
 		return Error.Wrap(err)
 	}
 
+	loop.progress.Start(time.Now(), before.SegmentCount, len(observers))
+	defer loop.progress.Finish()
+
 	var processed processedStats
 	processed, observers, err = loop.iterateSegments(ctx, observers)
 	if errors.Is(err, errNoObservers) {
@@ -454,6 +491,7 @@ func (loop *Service) iterateSegments(ctx context.Context, observers []*observerC
 		err := observer.observer.LoopStarted(ctx, LoopInfo{Started: startingTime})
 		return !observer.HandleError(err)
 	})
+	loop.progress.SetObservers(len(observers))
 
 	if len(observers) == 0 {
 		return processed, observers, errNoObservers
@@ -486,11 +524,13 @@ func (loop *Service) iterateSegments(ctx context.Context, observers []*observerC
 				segment := Segment(entry)
 				return !observer.HandleError(handleSegment(ctx, observer, &segment))
 			})
+			loop.progress.SetObservers(len(observers))
 			if len(observers) == 0 {
 				return errNoObservers
 			}
 
 			processed.segments++
+			loop.progress.SetProcessed(processed.segments)
 			mon.IntVal("segmentsProcessed").Observe(processed.segments) //mon:locked
 		}
 		return nil
@@ -524,6 +564,11 @@ func handleSegment(ctx context.Context, observer *observerContext, segment *Segm
 		}
 	}
 
+	if observer.TimedOut() {
+		mon.Event("segmentloop_observer_timeout")
+		return ErrObserverTimeout
+	}
+
 	return observer.ctx.Err()
 }
 
@@ -226,6 +226,71 @@ func TestSegmentsLoopObserverCancel(t *testing.T) {
 	})
 }
 
+func TestSegmentsLoopObserverTimeout(t *testing.T) {
+	segmentSize := 8 * memory.KiB
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 4,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.SegmentLoop.CoalesceDuration = 1 * time.Second
+				config.Metainfo.SegmentLoop.ObserverTimeout = 50 * time.Millisecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ul := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		loop := satellite.Metabase.SegmentLoop
+
+		// upload 3 remote files with 1 segment
+		for i := 0; i < 3; i++ {
+			testData := testrand.Bytes(segmentSize)
+			path := "/some/remote/path/" + strconv.Itoa(i)
+			err := ul.Upload(ctx, satellite, "bucket", path, testData)
+			require.NoError(t, err)
+		}
+
+		fast := newTestObserver(nil)
+		// create observer that exceeds the timeout on the first segment
+		slow := newTestObserver(func(ctx context.Context) error {
+			time.Sleep(100 * time.Millisecond)
+			return nil
+		})
+
+		var group errgroup.Group
+		group.Go(func() error {
+			return loop.Join(ctx, fast)
+		})
+		group.Go(func() error {
+			err := loop.Join(ctx, slow)
+			if !errors.Is(err, segmentloop.ErrObserverTimeout) {
+				return errors.New("expected observer timeout")
+			}
+			return nil
+		})
+
+		err := group.Wait()
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 3, fast.remoteSegCount)
+		assert.EqualValues(t, 1, slow.remoteSegCount)
+
+		progress := loop.Progress()
+		assert.False(t, progress.Running)
+		assert.EqualValues(t, 3, progress.Processed)
+		assert.EqualValues(t, 1, progress.Observers)
+	})
+}
+
+func TestProgressRatio(t *testing.T) {
+	assert.Zero(t, segmentloop.Progress{Processed: 10}.Ratio())
+	assert.Equal(t, 0.5, segmentloop.Progress{Processed: 5, Estimated: 10}.Ratio())
+	// segments uploaded during the iteration may exceed the estimate.
+	assert.Equal(t, 1.0, segmentloop.Progress{Processed: 15, Estimated: 10}.Ratio())
+}
+
 // TestSegmentsLoopCancel does the following:
 // * upload 3 remote segments
 // * hook two observers up to segments loop
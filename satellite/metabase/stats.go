@@ -27,13 +27,15 @@ type TableStats struct {
 func (db *DB) GetTableStats(ctx context.Context, opts GetTableStats) (result TableStats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	reader := db.reader(ctx, db.config.ReadReplica.StatsMaxStaleness)
+
 	var group errs2.Group
 	group.Go(func() error {
-		row := db.db.QueryRowContext(ctx, `SELECT count(*) FROM objects `+db.impl.AsOfSystemInterval(opts.AsOfSystemInterval))
+		row := reader.QueryRowContext(ctx, `SELECT count(*) FROM objects `+db.impl.AsOfSystemInterval(opts.AsOfSystemInterval))
 		return Error.Wrap(row.Scan(&result.ObjectCount))
 	})
 	group.Go(func() error {
-		row := db.db.QueryRowContext(ctx, `SELECT count(*) FROM segments `+db.impl.AsOfSystemInterval(opts.AsOfSystemInterval))
+		row := reader.QueryRowContext(ctx, `SELECT count(*) FROM segments `+db.impl.AsOfSystemInterval(opts.AsOfSystemInterval))
 		return Error.Wrap(row.Scan(&result.SegmentCount))
 	})
 	err = errs.Combine(group.Wait()...)
@@ -22,12 +22,45 @@ type GetStreamPieceCountByNodeID struct {
 func (db *DB) GetStreamPieceCountByNodeID(ctx context.Context, opts GetStreamPieceCountByNodeID) (result map[storj.NodeID]int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	countByAlias, err := db.GetStreamPieceCountByAlias(ctx, GetStreamPieceCountByAlias{
+		StreamID: opts.StreamID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := make([]NodeAlias, 0, len(countByAlias))
+	for alias := range countByAlias {
+		aliases = append(aliases, alias)
+	}
+
+	nodeIDs, err := db.aliasCache.Nodes(ctx, aliases)
+	if err != nil {
+		return nil, Error.New("unable to convert aliases to pieces: %w", err)
+	}
+
+	result = make(map[storj.NodeID]int64, len(aliases))
+	for i, alias := range aliases {
+		result[nodeIDs[i]] = countByAlias[alias]
+	}
+
+	return result, nil
+}
+
+// GetStreamPieceCountByAlias contains arguments for GetStreamPieceCountByAlias.
+type GetStreamPieceCountByAlias struct {
+	StreamID uuid.UUID
+}
+
+// GetStreamPieceCountByAlias returns piece count by node alias.
+func (db *DB) GetStreamPieceCountByAlias(ctx context.Context, opts GetStreamPieceCountByAlias) (result map[NodeAlias]int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	if opts.StreamID.IsZero() {
 		return nil, ErrInvalidRequest.New("StreamID missing")
 	}
 
-	countByAlias := map[NodeAlias]int64{}
-	result = map[storj.NodeID]int64{}
+	result = map[NodeAlias]int64{}
 	err = withRows(db.db.QueryContext(ctx, `
 		SELECT remote_alias_pieces
 		FROM   segments
@@ -41,7 +74,7 @@ func (db *DB) GetStreamPieceCountByNodeID(ctx context.Context, opts GetStreamPie
 			}
 
 			for i := range aliasPieces {
-				countByAlias[aliasPieces[i].Alias]++
+				result[aliasPieces[i].Alias]++
 			}
 		}
 		return nil
@@ -50,15 +83,7 @@ func (db *DB) GetStreamPieceCountByNodeID(ctx context.Context, opts GetStreamPie
 		if errors.Is(err, sql.ErrNoRows) {
 			return result, nil
 		}
-		return result, Error.New("unable to fetch object segments: %w", err)
-	}
-
-	for alias, count := range countByAlias {
-		nodeID, err := db.aliasCache.Nodes(ctx, []NodeAlias{alias})
-		if err != nil {
-			return nil, Error.New("unable to convert aliases to pieces: %w", err)
-		}
-		result[nodeID[0]] = count
+		return nil, Error.New("unable to fetch object segments: %w", err)
 	}
 
 	return result, nil
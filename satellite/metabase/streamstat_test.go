@@ -6,6 +6,8 @@ package metabase_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
@@ -26,6 +28,12 @@ func TestGetStreamPieceCountByNodeID(t *testing.T) {
 				ErrText:  "StreamID missing",
 			}.Check(ctx, t, db)
 
+			metabasetest.GetStreamPieceCountByAlias{
+				Opts:     metabase.GetStreamPieceCountByAlias{},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "StreamID missing",
+			}.Check(ctx, t, db)
+
 			metabasetest.Verify{}.Check(ctx, t, db)
 		})
 
@@ -149,6 +157,24 @@ func TestGetStreamPieceCountByNodeID(t *testing.T) {
 					n03: 2,
 				},
 			}.Check(ctx, t, db)
+
+			aliases, err := db.ListNodeAliases(ctx)
+			require.NoError(t, err)
+			aliasOf := map[storj.NodeID]metabase.NodeAlias{}
+			for _, entry := range aliases {
+				aliasOf[entry.ID] = entry.Alias
+			}
+
+			metabasetest.GetStreamPieceCountByAlias{
+				Opts: metabase.GetStreamPieceCountByAlias{
+					StreamID: obj.StreamID,
+				},
+				Result: map[metabase.NodeAlias]int64{
+					aliasOf[n01]: 1,
+					aliasOf[n02]: 2,
+					aliasOf[n03]: 2,
+				},
+			}.Check(ctx, t, db)
 		})
 	})
 }
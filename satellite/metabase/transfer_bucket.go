@@ -0,0 +1,183 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+const transferBatchSizeLimit = intLimitRange(100)
+
+// TransferBucketObjects contains arguments for moving or copying the objects
+// of a bucket to the bucket with the same name in another project.
+//
+// The object keys and the encryption keys are kept as they are, so the objects
+// can be read only with the encryption passphrase of the source bucket.
+type TransferBucketObjects struct {
+	Bucket       BucketLocation
+	NewProjectID uuid.UUID
+
+	// Copy keeps the objects in the source bucket. The copies reference the
+	// pieces of the source objects, the same way as server-side copies do, so
+	// no piece data is rewritten.
+	Copy bool
+
+	BatchSize int
+}
+
+// Verify verifies transfer bucket objects request fields.
+func (opts *TransferBucketObjects) Verify() error {
+	if err := opts.Bucket.Verify(); err != nil {
+		return err
+	}
+
+	switch {
+	case opts.NewProjectID.IsZero():
+		return ErrInvalidRequest.New("NewProjectID missing")
+	case opts.NewProjectID == opts.Bucket.ProjectID:
+		return ErrInvalidRequest.New("NewProjectID is the project of the bucket")
+	case opts.BatchSize < 0:
+		return ErrInvalidRequest.New("BatchSize is negative")
+	}
+	return nil
+}
+
+// TransferBucketObjectsResult is the result of transferring the objects of a bucket.
+type TransferBucketObjectsResult struct {
+	Objects int64
+}
+
+// TransferBucketObjects moves or copies the objects of the bucket to the new
+// project. The segments are keyed by stream ID, so moving an object rewrites
+// only its object row.
+//
+// The transfer is done in batches. When an error occurs, the returned result
+// contains the objects transferred until then. A failed move can be resumed
+// by running it again.
+func (db *DB) TransferBucketObjects(ctx context.Context, opts TransferBucketObjects) (result TransferBucketObjectsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return TransferBucketObjectsResult{}, err
+	}
+
+	transferBatchSizeLimit.Ensure(&opts.BatchSize)
+
+	if opts.Copy {
+		result, err = db.copyBucketObjects(ctx, opts)
+	} else {
+		result, err = db.moveBucketObjects(ctx, opts)
+	}
+
+	mon.Meter("transfer_bucket_objects").Mark64(result.Objects)
+
+	return result, err
+}
+
+func (db *DB) moveBucketObjects(ctx context.Context, opts TransferBucketObjects) (result TransferBucketObjectsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		res, err := db.db.ExecContext(ctx, `
+			UPDATE objects SET project_id = $3
+			WHERE (project_id, bucket_name, object_key, version) IN (
+				SELECT project_id, bucket_name, object_key, version FROM objects
+				WHERE project_id = $1 AND bucket_name = $2
+				LIMIT $4
+			)
+		`, opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.NewProjectID, opts.BatchSize)
+		if err != nil {
+			return result, Error.New("unable to move objects: %w", err)
+		}
+
+		moved, err := res.RowsAffected()
+		if err != nil {
+			return result, Error.New("unable to move objects: %w", err)
+		}
+		if moved == 0 {
+			return result, nil
+		}
+
+		result.Objects += moved
+	}
+}
+
+func (db *DB) copyBucketObjects(ctx context.Context, opts TransferBucketObjects) (result TransferBucketObjectsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !db.config.ServerSideCopy || db.config.ServerSideCopyDisabled {
+		return result, ErrInvalidRequest.New("copying objects requires server-side copy")
+	}
+
+	err = db.IterateObjectsAllVersionsWithStatus(ctx, IterateObjectsWithStatus{
+		ProjectID:             opts.Bucket.ProjectID,
+		BucketName:            opts.Bucket.BucketName,
+		Recursive:             true,
+		BatchSize:             opts.BatchSize,
+		Status:                Committed,
+		IncludeCustomMetadata: true,
+		IncludeSystemMetadata: true,
+	}, func(ctx context.Context, it ObjectsIterator) error {
+		var entry ObjectEntry
+		for it.Next(ctx, &entry) {
+			if err := db.copyBucketObject(ctx, opts, entry); err != nil {
+				return err
+			}
+			result.Objects++
+		}
+		return nil
+	})
+	return result, err
+}
+
+// copyBucketObject copies the object to the new project, keeping its object
+// key and encryption keys.
+func (db *DB) copyBucketObject(ctx context.Context, opts TransferBucketObjects, entry ObjectEntry) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segmentKeys, err := db.listSegmentKeys(ctx, entry.StreamID)
+	if err != nil {
+		return err
+	}
+
+	var metadataKeyNonce storj.Nonce
+	if len(entry.EncryptedMetadataNonce) > 0 {
+		metadataKeyNonce, err = storj.NonceFromBytes(entry.EncryptedMetadataNonce)
+		if err != nil {
+			return Error.New("invalid metadata nonce: %w", err)
+		}
+	}
+
+	newStreamID, err := uuid.New()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = db.FinishCopyObject(ctx, FinishCopyObject{
+		ObjectStream: ObjectStream{
+			ProjectID:  opts.Bucket.ProjectID,
+			BucketName: opts.Bucket.BucketName,
+			ObjectKey:  entry.ObjectKey,
+			Version:    entry.Version,
+			StreamID:   entry.StreamID,
+		},
+		NewBucket:             opts.Bucket.BucketName,
+		NewEncryptedObjectKey: entry.ObjectKey,
+		NewStreamID:           newStreamID,
+		NewProjectID:          opts.NewProjectID,
+
+		NewEncryptedMetadataKeyNonce: metadataKeyNonce,
+		NewEncryptedMetadataKey:      entry.EncryptedMetadataEncryptedKey,
+
+		NewSegmentKeys: segmentKeys,
+	})
+	return err
+}
@@ -0,0 +1,168 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestTransferBucketObjects(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		bucket := metabase.BucketLocation{
+			ProjectID:  obj.ProjectID,
+			BucketName: obj.BucketName,
+		}
+
+		t.Run("invalid request", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			_, err := db.TransferBucketObjects(ctx, metabase.TransferBucketObjects{
+				Bucket: bucket,
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+			require.Contains(t, err.Error(), "NewProjectID missing")
+
+			_, err = db.TransferBucketObjects(ctx, metabase.TransferBucketObjects{
+				Bucket:       bucket,
+				NewProjectID: bucket.ProjectID,
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+			require.Contains(t, err.Error(), "NewProjectID is the project of the bucket")
+		})
+
+		t.Run("move", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			var objects []metabase.ObjectStream
+			for i := 0; i < 5; i++ {
+				stream := obj
+				stream.ObjectKey = metabasetest.RandObjectKey()
+				stream.StreamID = testrand.UUID()
+				metabasetest.CreateObject(ctx, t, db, stream, 2)
+				objects = append(objects, stream)
+			}
+
+			other := metabasetest.RandObjectStream()
+			metabasetest.CreateObject(ctx, t, db, other, 1)
+
+			newProjectID := testrand.UUID()
+			result, err := db.TransferBucketObjects(ctx, metabase.TransferBucketObjects{
+				Bucket:       bucket,
+				NewProjectID: newProjectID,
+				BatchSize:    2,
+			})
+			require.NoError(t, err)
+			require.EqualValues(t, len(objects), result.Objects)
+
+			entries, err := db.TestingAllCommittedObjects(ctx, bucket.ProjectID, bucket.BucketName)
+			require.NoError(t, err)
+			require.Empty(t, entries)
+
+			entries, err = db.TestingAllCommittedObjects(ctx, newProjectID, bucket.BucketName)
+			require.NoError(t, err)
+			require.Len(t, entries, len(objects))
+
+			for _, stream := range objects {
+				segments, err := db.TestingAllObjectSegments(ctx, metabase.ObjectLocation{
+					ProjectID:  newProjectID,
+					BucketName: stream.BucketName,
+					ObjectKey:  stream.ObjectKey,
+				})
+				require.NoError(t, err)
+				require.Len(t, segments, 2)
+			}
+
+			entries, err = db.TestingAllCommittedObjects(ctx, other.ProjectID, other.BucketName)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+
+			result, err = db.TransferBucketObjects(ctx, metabase.TransferBucketObjects{
+				Bucket:       bucket,
+				NewProjectID: newProjectID,
+			})
+			require.NoError(t, err)
+			require.Zero(t, result.Objects)
+		})
+
+		t.Run("copy", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			var objects []metabase.ObjectStream
+			for i := 0; i < 3; i++ {
+				stream := obj
+				stream.ObjectKey = metabasetest.RandObjectKey()
+				stream.StreamID = testrand.UUID()
+				metabasetest.CreateObject(ctx, t, db, stream, 2)
+				objects = append(objects, stream)
+			}
+
+			newProjectID := testrand.UUID()
+			result, err := db.TransferBucketObjects(ctx, metabase.TransferBucketObjects{
+				Bucket:       bucket,
+				NewProjectID: newProjectID,
+				Copy:         true,
+			})
+			require.NoError(t, err)
+			require.EqualValues(t, len(objects), result.Objects)
+
+			sources, err := db.TestingAllCommittedObjects(ctx, bucket.ProjectID, bucket.BucketName)
+			require.NoError(t, err)
+			require.Len(t, sources, len(objects))
+
+			copies, err := db.TestingAllCommittedObjects(ctx, newProjectID, bucket.BucketName)
+			require.NoError(t, err)
+			require.Len(t, copies, len(objects))
+
+			for _, stream := range objects {
+				location := metabase.ObjectLocation{
+					ProjectID:  newProjectID,
+					BucketName: stream.BucketName,
+					ObjectKey:  stream.ObjectKey,
+				}
+
+				object, err := db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+					ObjectLocation: location,
+				})
+				require.NoError(t, err)
+				require.NotEqual(t, stream.StreamID, object.StreamID)
+
+				segments, err := db.TestingAllObjectSegments(ctx, location)
+				require.NoError(t, err)
+				require.Len(t, segments, 2)
+				for _, segment := range segments {
+					require.NotEmpty(t, segment.Pieces)
+				}
+			}
+		})
+	})
+}
+
+func TestTransferBucketObjectsCopyNoServerSideCopy(t *testing.T) {
+	metabasetest.RunWithConfig(t, noServerSideCopyConfig, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+		_, err := db.TransferBucketObjects(ctx, metabase.TransferBucketObjects{
+			Bucket: metabase.BucketLocation{
+				ProjectID:  obj.ProjectID,
+				BucketName: obj.BucketName,
+			},
+			NewProjectID: testrand.UUID(),
+			Copy:         true,
+		})
+		require.True(t, metabase.ErrInvalidRequest.Has(err))
+
+		entries, err := db.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+	})
+}
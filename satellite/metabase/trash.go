@@ -0,0 +1,374 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// TrashObject contains arguments necessary for moving the committed object
+// to the trash.
+type TrashObject struct {
+	ObjectLocation
+	// RestoreDeadline is the time until which the object can be restored.
+	// The object is deleted by the trash purge afterwards.
+	RestoreDeadline time.Time
+}
+
+// Verify verifies trash object request fields.
+func (opts *TrashObject) Verify() error {
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	if opts.RestoreDeadline.IsZero() {
+		return ErrInvalidRequest.New("RestoreDeadline missing")
+	}
+	return nil
+}
+
+// TrashObject moves the committed versions of the object to the trash instead
+// of deleting them. The trashed objects keep their segments, they aren't
+// visible for getting or listing, and they can be restored with RestoreObject
+// until the restore deadline.
+//
+// The trashed objects are moved to negative versions, below the versions
+// already in the trash, so that the key can be uploaded again with the
+// default version.
+//
+// The result contains the trashed objects, but no segments, because none were
+// deleted.
+func (db *DB) TrashObject(ctx context.Context, opts TrashObject) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return DeleteObjectResult{}, err
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		WITH lowest AS (
+			SELECT LEAST(coalesce(min(version), 0), 0) AS version
+			FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3
+		)
+		UPDATE objects SET
+			status = `+trashedStatus+`,
+			restore_deadline = $4,
+			version = (SELECT version FROM lowest) - objects.version
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3 AND
+			status       = `+committedStatus+` AND
+			(expires_at IS NULL OR expires_at > now())
+		RETURNING
+			version, stream_id,
+			created_at, expires_at,
+			segment_count,
+			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+			total_plain_size, total_encrypted_size, fixed_segment_size,
+			encryption
+	`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.RestoreDeadline))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			object := Object{
+				Status:          Trashed,
+				RestoreDeadline: &opts.RestoreDeadline,
+			}
+			object.ProjectID = opts.ProjectID
+			object.BucketName = opts.BucketName
+			object.ObjectKey = opts.ObjectKey
+
+			err := rows.Scan(
+				&object.Version, &object.StreamID,
+				&object.CreatedAt, &object.ExpiresAt,
+				&object.SegmentCount,
+				&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
+				&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+				encryptionParameters{&object.Encryption},
+			)
+			if err != nil {
+				return Error.New("unable to scan trashed object: %w", err)
+			}
+			result.Objects = append(result.Objects, object)
+		}
+		return nil
+	})
+	if err != nil {
+		return DeleteObjectResult{}, Error.New("unable to trash object: %w", err)
+	}
+	if len(result.Objects) == 0 {
+		return DeleteObjectResult{}, storj.ErrObjectNotFound.Wrap(Error.New("no rows deleted"))
+	}
+
+	mon.Meter("object_trash").Mark(len(result.Objects))
+
+	return result, nil
+}
+
+// RestoreObject contains arguments necessary for restoring a trashed object.
+type RestoreObject struct {
+	ObjectLocation
+	Version Version
+}
+
+// Verify verifies restore object request fields.
+func (opts *RestoreObject) Verify() error {
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	if opts.Version >= 0 {
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	}
+	return nil
+}
+
+// RestoreObject restores the trashed version of the object, whose restore
+// deadline hasn't passed yet, as a committed object with the default version.
+// The object can't be restored, when the key already has a committed object or
+// an object with the default version.
+func (db *DB) RestoreObject(ctx context.Context, opts RestoreObject) (object Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return Object{}, err
+	}
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		var conflicts int
+		err := tx.QueryRowContext(ctx, `
+			SELECT count(*)
+			FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				((status = `+committedStatus+` AND (expires_at IS NULL OR expires_at > now())) OR
+					version = $4)
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, DefaultVersion).Scan(&conflicts)
+		if err != nil {
+			return Error.New("unable to query committed objects: %w", err)
+		}
+		if conflicts > 0 {
+			return ErrObjectAlreadyExists.New("")
+		}
+
+		err = tx.QueryRowContext(ctx, `
+			UPDATE objects SET
+				status = `+committedStatus+`,
+				restore_deadline = NULL,
+				version = $5
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version      = $4 AND
+				status       = `+trashedStatus+` AND
+				restore_deadline > now()
+			RETURNING
+				stream_id,
+				created_at, expires_at,
+				segment_count,
+				encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, DefaultVersion).
+			Scan(
+				&object.StreamID,
+				&object.CreatedAt, &object.ExpiresAt,
+				&object.SegmentCount,
+				&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
+				&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+				encryptionParameters{&object.Encryption},
+			)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storj.ErrObjectNotFound.Wrap(Error.New("trashed object missing"))
+			}
+			return Error.New("unable to restore object: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return Object{}, err
+	}
+
+	object.ProjectID = opts.ProjectID
+	object.BucketName = opts.BucketName
+	object.ObjectKey = opts.ObjectKey
+	object.Version = DefaultVersion
+	object.Status = Committed
+
+	mon.Meter("object_restore").Mark(1)
+
+	return object, nil
+}
+
+// ListTrashedObjects contains arguments necessary for listing the trashed
+// objects of a bucket.
+type ListTrashedObjects struct {
+	ProjectID  uuid.UUID
+	BucketName string
+	Cursor     ListTrashedObjectsCursor
+	Limit      int
+}
+
+// ListTrashedObjectsCursor is the exclusive position after which the listing
+// of the trashed objects continues.
+type ListTrashedObjectsCursor struct {
+	Key     ObjectKey
+	Version Version
+}
+
+// ListTrashedObjectsResult is the result of listing the trashed objects.
+type ListTrashedObjectsResult struct {
+	Objects []Object
+	More    bool
+}
+
+// Verify verifies list trashed objects request fields.
+func (opts *ListTrashedObjects) Verify() error {
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return ErrInvalidRequest.New("BucketName missing")
+	case opts.Limit < 0:
+		return ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	}
+	return nil
+}
+
+// ListTrashedObjects lists the trashed objects of the bucket, which can still
+// be restored, ordered by the key and the version.
+func (db *DB) ListTrashedObjects(ctx context.Context, opts ListTrashedObjects) (result ListTrashedObjectsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return ListTrashedObjectsResult{}, err
+	}
+
+	ListLimit.Ensure(&opts.Limit)
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			object_key, version, stream_id,
+			created_at, expires_at,
+			segment_count,
+			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+			total_plain_size, total_encrypted_size, fixed_segment_size,
+			encryption,
+			restore_deadline
+		FROM objects
+		WHERE
+			(project_id, bucket_name) = ($1, $2) AND
+			(object_key, version) > ($3, $4) AND
+			status = `+trashedStatus+` AND
+			restore_deadline > now()
+		ORDER BY project_id, bucket_name, object_key, version
+		LIMIT $5
+	`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.Cursor.Key), opts.Cursor.Version, opts.Limit+1))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			object := Object{Status: Trashed}
+			object.ProjectID = opts.ProjectID
+			object.BucketName = opts.BucketName
+
+			err := rows.Scan(
+				&object.ObjectKey, &object.Version, &object.StreamID,
+				&object.CreatedAt, &object.ExpiresAt,
+				&object.SegmentCount,
+				&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
+				&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+				encryptionParameters{&object.Encryption},
+				&object.RestoreDeadline,
+			)
+			if err != nil {
+				return Error.New("unable to scan trashed object: %w", err)
+			}
+			result.Objects = append(result.Objects, object)
+		}
+		return nil
+	})
+	if err != nil {
+		return ListTrashedObjectsResult{}, Error.New("unable to list trashed objects: %w", err)
+	}
+
+	if len(result.Objects) > opts.Limit {
+		result.More = true
+		result.Objects = result.Objects[:len(result.Objects)-1]
+	}
+
+	return result, nil
+}
+
+// DeleteTrashedObjects contains all the information necessary to delete the
+// trashed objects, which can't be restored anymore.
+type DeleteTrashedObjects struct {
+	DeadlineBefore time.Time
+	AsOfSystemTime time.Time
+	BatchSize      int
+}
+
+// DeleteTrashedObjects deletes the trashed objects, whose restore deadline
+// passed before DeadlineBefore, together with their segments. The pieces are
+// left for the garbage collection.
+func (db *DB) DeleteTrashedObjects(ctx context.Context, opts DeleteTrashedObjects) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.deleteObjectsAndSegmentsBatch(ctx, opts.BatchSize, func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error) {
+		query := `
+			SELECT
+				project_id, bucket_name, object_key, version, stream_id
+			FROM objects
+			` + db.impl.AsOfSystemTime(opts.AsOfSystemTime) + `
+			WHERE
+				(project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
+				AND status = ` + trashedStatus + `
+				AND restore_deadline < $5
+				ORDER BY project_id, bucket_name, object_key, version
+			LIMIT $6;`
+
+		objects := make([]ObjectStream, 0, batchsize)
+
+		err = withRows(db.db.QueryContext(ctx, query,
+			startAfter.ProjectID, []byte(startAfter.BucketName), []byte(startAfter.ObjectKey), startAfter.Version,
+			opts.DeadlineBefore,
+			batchsize),
+		)(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				err = rows.Scan(&last.ProjectID, &last.BucketName, &last.ObjectKey, &last.Version, &last.StreamID)
+				if err != nil {
+					return Error.New("unable to delete trashed objects: %w", err)
+				}
+				objects = append(objects, last)
+			}
+			return nil
+		})
+		if err != nil {
+			return ObjectStream{}, Error.New("unable to delete trashed objects: %w", err)
+		}
+
+		if db.config.ServerSideCopy {
+			_, err = db.deleteObjectsAndSegmentsWithCopies(ctx, objects)
+		} else {
+			_, err = db.deleteObjectsAndSegments(ctx, objects)
+		}
+		if err != nil {
+			return ObjectStream{}, err
+		}
+
+		mon.Meter("object_trash_purge").Mark(len(objects))
+
+		return last, nil
+	})
+}
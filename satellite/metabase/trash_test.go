@@ -0,0 +1,444 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestTrashObject(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		location := obj.Location()
+		deadline := time.Now().Add(time.Hour)
+
+		for _, test := range metabasetest.InvalidObjectLocations(location) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+				metabasetest.TrashObject{
+					Opts: metabase.TrashObject{
+						ObjectLocation:  test.ObjectLocation,
+						RestoreDeadline: deadline,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+
+				metabasetest.Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("missing deadline", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.TrashObject{
+				Opts: metabase.TrashObject{
+					ObjectLocation: location,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "RestoreDeadline missing",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("missing object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.TrashObject{
+				Opts: metabase.TrashObject{
+					ObjectLocation:  location,
+					RestoreDeadline: deadline,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+			}.Check(ctx, t, db)
+
+			// pending objects aren't trashed.
+			metabasetest.CreatePendingObject(ctx, t, db, obj, 1)
+
+			metabasetest.TrashObject{
+				Opts: metabase.TrashObject{
+					ObjectLocation:  location,
+					RestoreDeadline: deadline,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("trash and restore", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+			trashed := object
+			trashed.Version = -1
+			trashed.Status = metabase.Trashed
+			trashed.RestoreDeadline = &deadline
+
+			metabasetest.TrashObject{
+				Opts: metabase.TrashObject{
+					ObjectLocation:  location,
+					RestoreDeadline: deadline,
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{trashed},
+				},
+			}.Check(ctx, t, db)
+
+			// the trashed object isn't visible, but it keeps its segments.
+			metabasetest.GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					ObjectLocation: location,
+					Version:        obj.Version,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+			}.Check(ctx, t, db)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 2)
+
+			metabasetest.ListTrashedObjects{
+				Opts: metabase.ListTrashedObjects{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+				},
+				Result: metabase.ListTrashedObjectsResult{
+					Objects: []metabase.Object{trashed},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.RestoreObject{
+				Opts: metabase.RestoreObject{
+					ObjectLocation: location,
+					Version:        trashed.Version,
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+
+			metabasetest.GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					ObjectLocation: location,
+					Version:        obj.Version,
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+
+			// the restored object isn't in the trash anymore.
+			metabasetest.RestoreObject{
+				Opts: metabase.RestoreObject{
+					ObjectLocation: location,
+					Version:        trashed.Version,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+				Segments: metabasetest.SegmentsToRaw(segments),
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("restore conflicts with committed object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			trashed := metabasetest.CreateObject(ctx, t, db, obj, 0)
+			trashed.Version = -1
+			trashed.Status = metabase.Trashed
+			trashed.RestoreDeadline = &deadline
+
+			metabasetest.TrashObject{
+				Opts: metabase.TrashObject{
+					ObjectLocation:  location,
+					RestoreDeadline: deadline,
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{trashed},
+				},
+			}.Check(ctx, t, db)
+
+			newer := obj
+			newer.Version++
+			newer.StreamID[0]++
+			metabasetest.CreateObject(ctx, t, db, newer, 0)
+
+			metabasetest.RestoreObject{
+				Opts: metabase.RestoreObject{
+					ObjectLocation: location,
+					Version:        trashed.Version,
+				},
+				ErrClass: &metabase.ErrObjectAlreadyExists,
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("restore conflicts with pending object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			result, err := db.TrashObject(ctx, metabase.TrashObject{
+				ObjectLocation:  location,
+				RestoreDeadline: deadline,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Objects, 1)
+
+			pending := obj
+			pending.StreamID[0]++
+			metabasetest.CreatePendingObject(ctx, t, db, pending, 0)
+
+			metabasetest.RestoreObject{
+				Opts: metabase.RestoreObject{
+					ObjectLocation: location,
+					Version:        result.Objects[0].Version,
+				},
+				ErrClass: &metabase.ErrObjectAlreadyExists,
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("upload again after trash", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			first := metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			result, err := db.TrashObject(ctx, metabase.TrashObject{
+				ObjectLocation:  location,
+				RestoreDeadline: deadline,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Objects, 1)
+			require.Equal(t, metabase.Version(-1), result.Objects[0].Version)
+
+			// the key can be uploaded again with the default version.
+			again := obj
+			again.StreamID[0]++
+			second := metabasetest.CreateObject(ctx, t, db, again, 1)
+
+			result, err = db.TrashObject(ctx, metabase.TrashObject{
+				ObjectLocation:  location,
+				RestoreDeadline: deadline,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Objects, 1)
+			require.Equal(t, metabase.Version(-2), result.Objects[0].Version)
+
+			// both are in the trash and either can be restored.
+			trashed, err := db.ListTrashedObjects(ctx, metabase.ListTrashedObjects{
+				ProjectID:  obj.ProjectID,
+				BucketName: obj.BucketName,
+			})
+			require.NoError(t, err)
+			require.Len(t, trashed.Objects, 2)
+			require.Equal(t, second.StreamID, trashed.Objects[0].StreamID)
+			require.Equal(t, first.StreamID, trashed.Objects[1].StreamID)
+
+			metabasetest.RestoreObject{
+				Opts: metabase.RestoreObject{
+					ObjectLocation: location,
+					Version:        -1,
+				},
+				Result: first,
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("restore after deadline", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			past := time.Now().Add(-time.Hour)
+			result, err := db.TrashObject(ctx, metabase.TrashObject{
+				ObjectLocation:  location,
+				RestoreDeadline: past,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Objects, 1)
+
+			metabasetest.ListTrashedObjects{
+				Opts: metabase.ListTrashedObjects{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.RestoreObject{
+				Opts: metabase.RestoreObject{
+					ObjectLocation: location,
+					Version:        result.Objects[0].Version,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestTrashObjectKeptByDeleteAllVersions(t *testing.T) {
+	metabasetest.RunWithConfig(t, noServerSideCopyConfig, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		location := obj.Location()
+
+		metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+		_, err := db.TrashObject(ctx, metabase.TrashObject{
+			ObjectLocation:  location,
+			RestoreDeadline: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		// uploading the key again deletes the previous upload, but not the
+		// trashed object.
+		again := obj
+		again.StreamID[0]++
+		metabasetest.CreatePendingObject(ctx, t, db, again, 0)
+
+		result, err := db.DeleteObjectAnyStatusAllVersions(ctx, metabase.DeleteObjectAnyStatusAllVersions{
+			ObjectLocation: location,
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Objects, 1)
+		require.Equal(t, again.StreamID, result.Objects[0].StreamID)
+
+		trashed, err := db.ListTrashedObjects(ctx, metabase.ListTrashedObjects{
+			ProjectID:  obj.ProjectID,
+			BucketName: obj.BucketName,
+		})
+		require.NoError(t, err)
+		require.Len(t, trashed.Objects, 1)
+		require.Equal(t, obj.StreamID, trashed.Objects[0].StreamID)
+	})
+}
+
+func TestListTrashedObjects(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		deadline := time.Now().Add(time.Hour)
+
+		t.Run("invalid options", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.ListTrashedObjects{
+				Opts: metabase.ListTrashedObjects{
+					BucketName: obj.BucketName,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.ListTrashedObjects{
+				Opts: metabase.ListTrashedObjects{
+					ProjectID: obj.ProjectID,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.ListTrashedObjects{
+				Opts: metabase.ListTrashedObjects{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					Limit:      -1,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Invalid limit: -1",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("pages", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			var trashed []metabase.Object
+			for _, key := range []metabase.ObjectKey{"a", "b", "c"} {
+				stream := metabasetest.RandObjectStream()
+				stream.ProjectID, stream.BucketName = obj.ProjectID, obj.BucketName
+				stream.ObjectKey = key
+				metabasetest.CreateObject(ctx, t, db, stream, 0)
+
+				result, err := db.TrashObject(ctx, metabase.TrashObject{
+					ObjectLocation:  stream.Location(),
+					RestoreDeadline: deadline,
+				})
+				require.NoError(t, err)
+				trashed = append(trashed, result.Objects...)
+			}
+
+			metabasetest.ListTrashedObjects{
+				Opts: metabase.ListTrashedObjects{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					Limit:      2,
+				},
+				Result: metabase.ListTrashedObjectsResult{
+					Objects: trashed[:2],
+					More:    true,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.ListTrashedObjects{
+				Opts: metabase.ListTrashedObjects{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					Cursor: metabase.ListTrashedObjectsCursor{
+						Key:     trashed[1].ObjectKey,
+						Version: trashed[1].Version,
+					},
+					Limit: 2,
+				},
+				Result: metabase.ListTrashedObjectsResult{
+					Objects: trashed[2:],
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestDeleteTrashedObjects(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		now := time.Now()
+
+		restorable := metabasetest.RandObjectStream()
+		metabasetest.CreateObject(ctx, t, db, restorable, 1)
+		_, err := db.TrashObject(ctx, metabase.TrashObject{
+			ObjectLocation:  restorable.Location(),
+			RestoreDeadline: now.Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		purged := metabasetest.RandObjectStream()
+		metabasetest.CreateObject(ctx, t, db, purged, 1)
+		_, err = db.TrashObject(ctx, metabase.TrashObject{
+			ObjectLocation:  purged.Location(),
+			RestoreDeadline: now.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+
+		committed := metabasetest.RandObjectStream()
+		metabasetest.CreateObject(ctx, t, db, committed, 1)
+
+		metabasetest.DeleteTrashedObjects{
+			Opts: metabase.DeleteTrashedObjects{
+				DeadlineBefore: now,
+			},
+		}.Check(ctx, t, db)
+
+		objects, err := db.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		for _, object := range objects {
+			require.NotEqual(t, purged.StreamID, object.StreamID)
+		}
+
+		segments, err := db.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trashdeletion
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error defines the trashdeletion chore errors class.
+	Error = errs.Class("trash deletion chore")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for trashed objects cleanup.
+type Config struct {
+	Interval           time.Duration `help:"the time between each attempt to go through the db and delete the trashed objects, which can't be restored anymore" releaseDefault:"1h" devDefault:"10s"`
+	Enabled            bool          `help:"set if trashed objects cleanup is enabled or not" default:"true"`
+	ListLimit          int           `help:"how many objects to query in a batch" default:"100"`
+	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+}
+
+// Chore implements the trashed objects cleanup chore.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	metabase *metabase.DB
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the trashdeletion chore.
+func NewChore(log *zap.Logger, config Config, metabase *metabase.DB) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		metabase: metabase,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the trashdeletion loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.deleteTrashedObjects)
+}
+
+// Close stops the trashdeletion chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestingSetNow allows tests to have the server act as if the current time is whatever they want.
+func (chore *Chore) TestingSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+func (chore *Chore) deleteTrashedObjects(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	chore.log.Debug("deleting trashed objects")
+
+	return chore.metabase.DeleteTrashedObjects(ctx, metabase.DeleteTrashedObjects{
+		DeadlineBefore: chore.nowFn(),
+		AsOfSystemTime: time.Now().Add(chore.config.AsOfSystemInterval),
+		BatchSize:      chore.config.ListLimit,
+	})
+}
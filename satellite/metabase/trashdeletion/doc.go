@@ -0,0 +1,10 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package trashdeletion contains the functions needed to run trashed objects deletion chore.
+
+The trashdeletion chore will periodically query metabase for trashed objects,
+whose restore deadline passed, and delete them with their segments.
+*/
+package trashdeletion
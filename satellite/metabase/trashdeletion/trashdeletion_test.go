@@ -0,0 +1,93 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trashdeletion_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestTrashDeletion(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.TrashRetention = time.Hour
+				config.Metainfo.RS.Min = 2
+				config.Metainfo.RS.Repair = 2
+				config.Metainfo.RS.Success = 4
+				config.Metainfo.RS.Total = 4
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		trashChore := sat.Core.TrashDeletion.Chore
+
+		trashChore.Loop.Pause()
+
+		err := upl.Upload(ctx, sat, "testbucket", "restored", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+		err = upl.Upload(ctx, sat, "testbucket", "purged", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		require.NoError(t, upl.DeleteObject(ctx, sat, "testbucket", "restored"))
+		require.NoError(t, upl.DeleteObject(ctx, sat, "testbucket", "purged"))
+
+		// the deleted objects aren't visible anymore, but they keep their segments.
+		_, err = upl.Download(ctx, sat, "testbucket", "restored")
+		require.Error(t, err)
+
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+
+		projectID := upl.Projects[0].ID
+		trashed, err := sat.Metabase.DB.ListTrashedObjects(ctx, metabase.ListTrashedObjects{
+			ProjectID:  projectID,
+			BucketName: "testbucket",
+		})
+		require.NoError(t, err)
+		require.Len(t, trashed.Objects, 2)
+
+		restored := trashed.Objects[0]
+		_, err = sat.Metabase.DB.RestoreObject(ctx, metabase.RestoreObject{
+			ObjectLocation: restored.Location(),
+			Version:        restored.Version,
+		})
+		require.NoError(t, err)
+
+		// the chore doesn't delete the objects before the restore deadline.
+		trashChore.Loop.TriggerWait()
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		trashChore.TestingSetNow(func() time.Time {
+			return time.Now().Add(2 * time.Hour)
+		})
+		trashChore.Loop.TriggerWait()
+
+		objects, err = sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, metabase.Committed, objects[0].Status)
+		require.Equal(t, restored.StreamID, objects[0].StreamID)
+
+		segments, err = sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// execQueryer is implemented by tagsql.DB and tagsql.Tx.
+type execQueryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Tx is a metabase transaction, which allows to compose several operations
+// atomically. It's only valid inside the callback of DB.WithTx.
+type Tx struct {
+	db *DB
+	tx tagsql.Tx
+}
+
+// WithTx runs fn in a transaction. The transaction is committed when fn returns
+// nil and rolled back otherwise.
+//
+// fn may be called more than once, when the transaction needs to be retried,
+// so any of its side effects outside of the transaction must be idempotent.
+func (db *DB) WithTx(ctx context.Context, fn func(ctx context.Context, tx *Tx) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		return fn(ctx, &Tx{db: db, tx: tx})
+	})
+}
+
+// withTx runs fn in tx, or in a new transaction when tx is nil.
+func (db *DB) withTx(ctx context.Context, tx tagsql.Tx, fn func(ctx context.Context, tx tagsql.Tx) error) error {
+	if tx != nil {
+		return fn(ctx, tx)
+	}
+	return txutil.WithTx(ctx, db.db, nil, fn)
+}
+
+// GetObjectExactVersion returns object information for exact version.
+func (tx *Tx) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVersion) (_ Object, err error) {
+	return tx.db.getObjectExactVersion(ctx, opts, tx.tx)
+}
+
+// GetObjectLastCommitted returns object information for the latest committed version.
+func (tx *Tx) GetObjectLastCommitted(ctx context.Context, opts GetObjectLastCommitted) (_ Object, err error) {
+	return tx.db.getObjectLastCommitted(ctx, opts, tx.tx)
+}
+
+// CommitObject adds a pending object to the database.
+func (tx *Tx) CommitObject(ctx context.Context, opts CommitObject) (object Object, err error) {
+	return tx.db.commitObject(ctx, opts, tx.tx)
+}
+
+// UpdateObjectMetadata updates an object metadata.
+func (tx *Tx) UpdateObjectMetadata(ctx context.Context, opts UpdateObjectMetadata) (err error) {
+	return tx.db.updateObjectMetadata(ctx, opts, tx.tx)
+}
+
+// FinishCopyObject accepts new encryption keys for copied object and insert
+// the corresponding new object ObjectKey and segments EncryptedKey.
+func (tx *Tx) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (object Object, err error) {
+	return tx.db.finishCopyObject(ctx, opts, tx.tx)
+}
+
+// DeleteObjectExactVersion deletes an exact object version.
+func (tx *Tx) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExactVersion) (result DeleteObjectResult, err error) {
+	return tx.db.deleteObjectExactVersion(ctx, opts, tx.tx)
+}
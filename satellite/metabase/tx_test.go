@@ -0,0 +1,118 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestWithTx(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		now := time.Now()
+
+		encryptedMetadata := testrand.Bytes(1024)
+		encryptedMetadataNonce := testrand.Nonce()
+		encryptedMetadataKey := testrand.Bytes(265)
+
+		commitAndUpdate := func(ctx context.Context, tx *metabase.Tx) error {
+			_, err := tx.CommitObject(ctx, metabase.CommitObject{
+				ObjectStream: obj,
+				Encryption:   metabasetest.DefaultEncryption,
+			})
+			if err != nil {
+				return err
+			}
+
+			return tx.UpdateObjectMetadata(ctx, metabase.UpdateObjectMetadata{
+				ObjectStream:                  obj,
+				EncryptedMetadata:             encryptedMetadata,
+				EncryptedMetadataNonce:        encryptedMetadataNonce[:],
+				EncryptedMetadataEncryptedKey: encryptedMetadataKey,
+			})
+		}
+
+		t.Run("rollback", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: obj,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: obj.Version,
+			}.Check(ctx, t, db)
+
+			errFailed := errors.New("failed")
+			err := db.WithTx(ctx, func(ctx context.Context, tx *metabase.Tx) error {
+				if err := commitAndUpdate(ctx, tx); err != nil {
+					return err
+				}
+
+				// the changes are visible inside the transaction
+				object, err := tx.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+				})
+				if err != nil {
+					return err
+				}
+				require.Equal(t, encryptedMetadata, object.EncryptedMetadata)
+
+				return errFailed
+			})
+			require.ErrorIs(t, err, errFailed)
+
+			// neither the commit nor the metadata update are applied
+			metabasetest.GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("commit", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: obj,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: obj.Version,
+			}.Check(ctx, t, db)
+
+			err := db.WithTx(ctx, commitAndUpdate)
+			require.NoError(t, err)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					{
+						ObjectStream: obj,
+						CreatedAt:    now,
+						Status:       metabase.Committed,
+						Encryption:   metabasetest.DefaultEncryption,
+
+						EncryptedMetadata:             encryptedMetadata,
+						EncryptedMetadataNonce:        encryptedMetadataNonce[:],
+						EncryptedMetadataEncryptedKey: encryptedMetadataKey,
+					},
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -9,10 +9,12 @@ import (
 	"errors"
 	"time"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgxutil"
 	"storj.io/storj/storage"
 )
 
@@ -26,14 +28,11 @@ type UpdateSegmentPieces struct {
 	NewRedundancy storj.RedundancyScheme
 	NewPieces     Pieces
 
-	NewRepairedAt time.Time // sets new time of last segment repair (optional).
+	NewRepairedAt time.Time // sets new time of last segment repair and clears the health (optional).
 }
 
-// UpdateSegmentPieces updates pieces for specified segment. If provided old pieces
-// won't match current database state update will fail.
-func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces) (err error) {
-	defer mon.Task()(&ctx)(&err)
-
+// verify validates the arguments of a segment pieces update.
+func (opts *UpdateSegmentPieces) verify() error {
 	if opts.StreamID.IsZero() {
 		return ErrInvalidRequest.New("StreamID missing")
 	}
@@ -62,6 +61,44 @@ func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces)
 		return err
 	}
 
+	return nil
+}
+
+// updateSegmentPiecesSQL updates the pieces of a segment, when the current
+// pieces match the old pieces, and returns the pieces after the update.
+const updateSegmentPiecesSQL = `
+	UPDATE segments SET
+		remote_alias_pieces = CASE
+			WHEN remote_alias_pieces = $3 THEN $4
+			ELSE remote_alias_pieces
+		END,
+		redundancy = CASE
+			WHEN remote_alias_pieces = $3 THEN $5
+			ELSE redundancy
+		END,
+		repaired_at = CASE
+			WHEN remote_alias_pieces = $3 AND $7 = true THEN $6
+			ELSE repaired_at
+		END,
+		health = CASE
+			WHEN remote_alias_pieces = $3 AND $7 = true THEN NULL
+			ELSE health
+		END
+	WHERE
+		stream_id     = $1 AND
+		position      = $2
+	RETURNING remote_alias_pieces
+`
+
+// UpdateSegmentPieces updates pieces for specified segment. If provided old pieces
+// won't match current database state update will fail.
+func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.verify(); err != nil {
+		return err
+	}
+
 	updateRepairAt := !opts.NewRepairedAt.IsZero()
 
 	oldPieces, err := db.aliasCache.ConvertPiecesToAliases(ctx, opts.OldPieces)
@@ -75,25 +112,8 @@ func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces)
 	}
 
 	var resultPieces AliasPieces
-	err = db.db.QueryRowContext(ctx, `
-		UPDATE segments SET
-			remote_alias_pieces = CASE
-				WHEN remote_alias_pieces = $3 THEN $4
-				ELSE remote_alias_pieces
-			END,
-			redundancy = CASE
-				WHEN remote_alias_pieces = $3 THEN $5
-				ELSE redundancy
-			END,
-			repaired_at = CASE
-				WHEN remote_alias_pieces = $3 AND $7 = true THEN $6
-				ELSE repaired_at
-			END
-		WHERE
-			stream_id     = $1 AND
-			position      = $2
-		RETURNING remote_alias_pieces
-		`, opts.StreamID, opts.Position, oldPieces, newPieces, redundancyScheme{&opts.NewRedundancy}, opts.NewRepairedAt, updateRepairAt).
+	err = db.db.QueryRowContext(ctx, updateSegmentPiecesSQL,
+		opts.StreamID, opts.Position, oldPieces, newPieces, redundancyScheme{&opts.NewRedundancy}, opts.NewRepairedAt, updateRepairAt).
 		Scan(&resultPieces)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -110,3 +130,79 @@ func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces)
 
 	return nil
 }
+
+// UpdateSegmentPiecesBatch updates the pieces of multiple segments in a single
+// round trip to the database.
+//
+// Every segment is updated independently with the same compare-and-swap
+// semantics as UpdateSegmentPieces. The returned slice contains the failure of
+// every segment at the same index as in the request, or nil when the segment
+// was updated. The error is only returned when the batch couldn't be executed.
+func (db *DB) UpdateSegmentPiecesBatch(ctx context.Context, segments []UpdateSegmentPieces) (failures []error, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(segments) == 0 {
+		return nil, nil
+	}
+
+	type aliasPiecesUpdate struct {
+		oldPieces AliasPieces
+		newPieces AliasPieces
+	}
+	updates := make([]aliasPiecesUpdate, len(segments))
+
+	for i := range segments {
+		opts := &segments[i]
+		if err := opts.verify(); err != nil {
+			return nil, ErrInvalidRequest.New("segment %d: %v", i, errs.Unwrap(err))
+		}
+
+		updates[i].oldPieces, err = db.aliasCache.ConvertPiecesToAliases(ctx, opts.OldPieces)
+		if err != nil {
+			return nil, Error.New("unable to convert pieces to aliases: %w", err)
+		}
+
+		updates[i].newPieces, err = db.aliasCache.ConvertPiecesToAliases(ctx, opts.NewPieces)
+		if err != nil {
+			return nil, Error.New("unable to convert pieces to aliases: %w", err)
+		}
+	}
+
+	failures = make([]error, len(segments))
+	var updated int
+	err = pgxutil.Conn(ctx, db.db, func(conn *pgx.Conn) (err error) {
+		var batch pgx.Batch
+		for i := range segments {
+			opts := &segments[i]
+			batch.Queue(updateSegmentPiecesSQL,
+				opts.StreamID, opts.Position, updates[i].oldPieces, updates[i].newPieces,
+				redundancyScheme{&opts.NewRedundancy}, opts.NewRepairedAt, !opts.NewRepairedAt.IsZero())
+		}
+
+		results := conn.SendBatch(ctx, &batch)
+		defer func() { err = errs.Combine(err, results.Close()) }()
+
+		for i := range segments {
+			var resultPieces AliasPieces
+			err := results.QueryRow().Scan(&resultPieces)
+			switch {
+			case errors.Is(err, pgx.ErrNoRows):
+				failures[i] = ErrSegmentNotFound.New("segment missing")
+			case err != nil:
+				return err
+			case !EqualAliasPieces(updates[i].newPieces, resultPieces):
+				failures[i] = storage.ErrValueChanged.New("segment remote_alias_pieces field was changed")
+			default:
+				updated++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to update segment pieces: %w", err)
+	}
+
+	mon.Meter("segment_update").Mark(updated)
+
+	return failures, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
@@ -418,3 +419,110 @@ func TestUpdateSegmentPieces(t *testing.T) {
 		})
 	})
 }
+
+func TestUpdateSegmentPiecesBatch(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		newPieces := func() metabase.Pieces {
+			return metabase.Pieces{
+				{Number: 1, StorageNode: testrand.NodeID()},
+				{Number: 2, StorageNode: testrand.NodeID()},
+			}
+		}
+
+		t.Run("empty batch", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.UpdateSegmentPiecesBatch{}.Check(ctx, t, db)
+		})
+
+		t.Run("invalid segment", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.UpdateSegmentPiecesBatch{
+				Opts: []metabase.UpdateSegmentPieces{
+					{
+						StreamID:      obj.StreamID,
+						OldPieces:     newPieces(),
+						NewRedundancy: metabasetest.DefaultRedundancy,
+						NewPieces:     newPieces(),
+					},
+					{},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "segment 1: StreamID missing",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("update pieces", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 3)
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 3)
+
+			now := time.Now()
+			updated := newPieces()
+			repaired := newPieces()
+			changed := newPieces()
+
+			metabasetest.UpdateSegmentPiecesBatch{
+				Opts: []metabase.UpdateSegmentPieces{
+					{
+						StreamID:      object.StreamID,
+						Position:      segments[0].Position,
+						OldPieces:     segments[0].Pieces,
+						NewRedundancy: metabasetest.DefaultRedundancy,
+						NewPieces:     updated,
+					},
+					{
+						StreamID:      object.StreamID,
+						Position:      segments[1].Position,
+						OldPieces:     segments[1].Pieces,
+						NewRedundancy: metabasetest.DefaultRedundancy,
+						NewPieces:     repaired,
+						NewRepairedAt: now,
+					},
+					{
+						StreamID:      object.StreamID,
+						Position:      segments[2].Position,
+						OldPieces:     newPieces(),
+						NewRedundancy: metabasetest.DefaultRedundancy,
+						NewPieces:     changed,
+					},
+					{
+						StreamID:      testrand.UUID(),
+						Position:      segments[0].Position,
+						OldPieces:     segments[0].Pieces,
+						NewRedundancy: metabasetest.DefaultRedundancy,
+						NewPieces:     newPieces(),
+					},
+				},
+				Failures: []*errs.Class{
+					nil,
+					nil,
+					&storage.ErrValueChanged,
+					&metabase.ErrSegmentNotFound,
+				},
+			}.Check(ctx, t, db)
+
+			expectedSegments := make([]metabase.RawSegment, len(segments))
+			for i, segment := range segments {
+				expectedSegments[i] = metabase.RawSegment(segment)
+			}
+			expectedSegments[0].Pieces = updated
+			expectedSegments[1].Pieces = repaired
+			expectedSegments[1].RepairedAt = &now
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+				Segments: expectedSegments,
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -0,0 +1,226 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// GetObjectLastCommitted contains arguments necessary for fetching the latest
+// committed version of an object.
+type GetObjectLastCommitted struct {
+	ObjectLocation
+}
+
+// GetObjectLastCommitted returns object information for the latest committed version.
+// The object isn't found, when its latest version is a delete marker.
+func (db *DB) GetObjectLastCommitted(ctx context.Context, opts GetObjectLastCommitted) (_ Object, err error) {
+	return db.getObjectLastCommitted(ctx, opts, db.db)
+}
+
+// implementation of DB.GetObjectLastCommitted for re-use internally in metabase package.
+func (db *DB) getObjectLastCommitted(ctx context.Context, opts GetObjectLastCommitted, q execQueryer) (_ Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return Object{}, err
+	}
+
+	object := Object{}
+	err = q.QueryRowContext(ctx, `
+		SELECT
+			version, stream_id, status,
+			created_at, expires_at,
+			segment_count,
+			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+			total_plain_size, total_encrypted_size, fixed_segment_size,
+			encryption
+		FROM objects
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3 AND
+			status IN (`+committedStatus+`, `+deleteMarkerStatus+`) AND
+			(expires_at IS NULL OR expires_at > now())
+		ORDER BY version DESC
+		LIMIT 1`,
+		opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey).
+		Scan(
+			&object.Version, &object.StreamID, &object.Status,
+			&object.CreatedAt, &object.ExpiresAt,
+			&object.SegmentCount,
+			&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
+			&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+			encryptionParameters{&object.Encryption},
+		)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Object{}, storj.ErrObjectNotFound.Wrap(Error.Wrap(err))
+		}
+		return Object{}, Error.New("unable to query object status: %w", err)
+	}
+	if object.Status == DeleteMarker {
+		return Object{}, storj.ErrObjectNotFound.Wrap(Error.New("object is deleted"))
+	}
+
+	object.ProjectID = opts.ProjectID
+	object.BucketName = opts.BucketName
+	object.ObjectKey = opts.ObjectKey
+
+	return object, nil
+}
+
+// InsertDeleteMarker contains arguments necessary for deleting an object,
+// while keeping its previous versions.
+type InsertDeleteMarker struct {
+	ObjectLocation
+}
+
+// InsertDeleteMarker inserts a delete marker as the latest version of the
+// object, which hides the object from GetObjectLastCommitted and
+// IterateObjectsLatestVersion. The previous versions are kept and can still
+// be retrieved and deleted by their version.
+func (db *DB) InsertDeleteMarker(ctx context.Context, opts InsertDeleteMarker) (marker Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return Object{}, err
+	}
+
+	streamID, err := uuid.New()
+	if err != nil {
+		return Object{}, Error.New("unable to generate stream ID: %w", err)
+	}
+
+	marker = Object{
+		ObjectStream: ObjectStream{
+			ProjectID:  opts.ProjectID,
+			BucketName: opts.BucketName,
+			ObjectKey:  opts.ObjectKey,
+			StreamID:   streamID,
+		},
+		Status: DeleteMarker,
+	}
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		var status ObjectStatus
+		err := tx.QueryRowContext(ctx, `
+			SELECT status
+			FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				status IN (`+committedStatus+`, `+deleteMarkerStatus+`) AND
+				(expires_at IS NULL OR expires_at > now())
+			ORDER BY version DESC
+			LIMIT 1
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey).Scan(&status)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storj.ErrObjectNotFound.Wrap(Error.New("object missing"))
+			}
+			return Error.New("unable to query object status: %w", err)
+		}
+		if status == DeleteMarker {
+			return storj.ErrObjectNotFound.Wrap(Error.New("object is already deleted"))
+		}
+
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO objects (
+				project_id, bucket_name, object_key, version, stream_id,
+				status, zombie_deletion_deadline
+			) VALUES (
+				$1, $2, $3,
+					coalesce((
+						SELECT version + 1
+						FROM objects
+						WHERE project_id = $1 AND bucket_name = $2 AND object_key = $3
+						ORDER BY version DESC
+						LIMIT 1
+					), 1),
+				$4,
+				`+deleteMarkerStatus+`, NULL
+			)
+			RETURNING version, created_at
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, streamID).
+			Scan(&marker.Version, &marker.CreatedAt)
+		if err != nil {
+			return Error.New("unable to insert delete marker: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return Object{}, err
+	}
+
+	mon.Meter("object_delete_marker").Mark(1)
+
+	return marker, nil
+}
+
+// IterateObjectVersions contains arguments necessary for listing the versions
+// of the objects in a bucket.
+type IterateObjectVersions struct {
+	ProjectID  uuid.UUID
+	BucketName string
+	Recursive  bool
+	BatchSize  int
+	Prefix     ObjectKey
+	// Cursor is the exclusive position after which the listing continues.
+	// The versions of an object are listed from the newest, so the listing
+	// continues with the older versions of Cursor.Key.
+	Cursor                IterateCursor
+	IncludeCustomMetadata bool
+	IncludeSystemMetadata bool
+
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
+}
+
+// Verify verifies iterate object versions request fields.
+func (opts *IterateObjectVersions) Verify() error {
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return ErrInvalidRequest.New("BucketName missing")
+	case opts.BatchSize < 0:
+		return ErrInvalidRequest.New("BatchSize is negative")
+	}
+	return nil
+}
+
+// IterateObjectVersions iterates through the committed versions and the delete
+// markers of the objects, ordered by the key and then from the newest version.
+func (db *DB) IterateObjectVersions(ctx context.Context, opts IterateObjectVersions, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err = opts.Verify(); err != nil {
+		return err
+	}
+	return iterateObjectVersions(ctx, db, opts, fn)
+}
+
+// IterateObjectsLatestVersion iterates through the latest committed version of
+// the objects. The objects, whose latest version is a delete marker, are
+// skipped. opts.Status must be Committed and the version of opts.Cursor is
+// ignored, i.e. the listing continues after all versions of opts.Cursor.Key.
+func (db *DB) IterateObjectsLatestVersion(ctx context.Context, opts IterateObjectsWithStatus, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err = opts.Verify(); err != nil {
+		return err
+	}
+	if opts.Status != Committed {
+		return ErrInvalidRequest.New("Status %v is not supported", opts.Status)
+	}
+	return iterateObjectsLatestVersion(ctx, db, opts, fn)
+}
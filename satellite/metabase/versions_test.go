@@ -0,0 +1,352 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestGetObjectLastCommitted(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		location := obj.Location()
+
+		for _, test := range metabasetest.InvalidObjectLocations(location) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+				metabasetest.GetObjectLastCommitted{
+					Opts: metabase.GetObjectLastCommitted{
+						ObjectLocation: test.ObjectLocation,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+
+				metabasetest.Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("missing object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.GetObjectLastCommitted{
+				Opts: metabase.GetObjectLastCommitted{
+					ObjectLocation: location,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("latest version", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			v1 := obj
+			v1.Version = 1
+			object1 := metabasetest.CreateObject(ctx, t, db, v1, 0)
+
+			v2 := obj
+			v2.Version = 2
+			v2.StreamID[0]++
+			object2 := metabasetest.CreateObject(ctx, t, db, v2, 0)
+
+			v3 := obj
+			v3.Version = 3
+			v3.StreamID[0] += 2
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: v3,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: 3,
+			}.Check(ctx, t, db)
+
+			// the pending version isn't returned
+			metabasetest.GetObjectLastCommitted{
+				Opts: metabase.GetObjectLastCommitted{
+					ObjectLocation: location,
+				},
+				Result: object2,
+			}.Check(ctx, t, db)
+
+			metabasetest.GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					ObjectLocation: location,
+					Version:        1,
+				},
+				Result: object1,
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("delete marker", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			metabasetest.InsertDeleteMarker{
+				Opts: metabase.InsertDeleteMarker{
+					ObjectLocation: location,
+				},
+				Version: obj.Version + 1,
+			}.Check(ctx, t, db)
+
+			metabasetest.GetObjectLastCommitted{
+				Opts: metabase.GetObjectLastCommitted{
+					ObjectLocation: location,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: object is deleted",
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestInsertDeleteMarker(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		location := obj.Location()
+
+		for _, test := range metabasetest.InvalidObjectLocations(location) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+				metabasetest.InsertDeleteMarker{
+					Opts: metabase.InsertDeleteMarker{
+						ObjectLocation: test.ObjectLocation,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+
+				metabasetest.Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("missing object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.InsertDeleteMarker{
+				Opts: metabase.InsertDeleteMarker{
+					ObjectLocation: location,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: object missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("keeps previous versions", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			now := time.Now()
+			object := metabasetest.CreateObject(ctx, t, db, obj, 1)
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+
+			marker := metabasetest.InsertDeleteMarker{
+				Opts: metabase.InsertDeleteMarker{
+					ObjectLocation: location,
+				},
+				Version: obj.Version + 1,
+			}.Check(ctx, t, db)
+
+			// the object can't be deleted twice
+			metabasetest.InsertDeleteMarker{
+				Opts: metabase.InsertDeleteMarker{
+					ObjectLocation: location,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: object is already deleted",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+					{
+						ObjectStream: marker.ObjectStream,
+						CreatedAt:    now,
+						Status:       metabase.DeleteMarker,
+					},
+				},
+				Segments: metabasetest.SegmentsToRaw(segments),
+			}.Check(ctx, t, db)
+
+			// the previous version is still available by its version
+			metabasetest.GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					ObjectLocation: location,
+					Version:        obj.Version,
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestIterateObjectVersions(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid arguments", func(t *testing.T) {
+			metabasetest.IterateObjectVersions{
+				Opts: metabase.IterateObjectVersions{
+					BucketName: "mybucket",
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+			metabasetest.IterateObjectVersions{
+				Opts: metabase.IterateObjectVersions{
+					ProjectID: metabasetest.RandObjectStream().ProjectID,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("versions and delete markers", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			a, b, marker := createVersionedObjects(ctx, t, db)
+
+			metabasetest.IterateObjectVersions{
+				Opts: metabase.IterateObjectVersions{
+					ProjectID:  a[0].ProjectID,
+					BucketName: a[0].BucketName,
+					Recursive:  true,
+				},
+				Result: []metabase.ObjectEntry{
+					versionEntry(a[1], metabase.Committed),
+					versionEntry(a[0], metabase.Committed),
+					versionEntry(marker, metabase.DeleteMarker),
+					versionEntry(b[0], metabase.Committed),
+				},
+			}.Check(ctx, t, db)
+
+			// the listing continues with the older versions of the cursor key
+			metabasetest.IterateObjectVersions{
+				Opts: metabase.IterateObjectVersions{
+					ProjectID:  a[0].ProjectID,
+					BucketName: a[0].BucketName,
+					Recursive:  true,
+					BatchSize:  1,
+					Cursor: metabase.IterateCursor{
+						Key:     a[1].ObjectKey,
+						Version: a[1].Version,
+					},
+				},
+				Result: []metabase.ObjectEntry{
+					versionEntry(a[0], metabase.Committed),
+					versionEntry(marker, metabase.DeleteMarker),
+					versionEntry(b[0], metabase.Committed),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestIterateObjectsLatestVersion(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("Status is invalid", func(t *testing.T) {
+			metabasetest.IterateObjectsLatestVersion{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:  metabasetest.RandObjectStream().ProjectID,
+					BucketName: "mybucket",
+					Status:     metabase.Pending,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Status 1 is not supported",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("latest versions", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			a, _, _ := createVersionedObjects(ctx, t, db)
+
+			for _, batchSize := range []int{0, 1, 2} {
+				metabasetest.IterateObjectsLatestVersion{
+					Opts: metabase.IterateObjectsWithStatus{
+						ProjectID:  a[0].ProjectID,
+						BucketName: a[0].BucketName,
+						Recursive:  true,
+						BatchSize:  batchSize,
+						Status:     metabase.Committed,
+					},
+					Result: []metabase.ObjectEntry{
+						versionEntry(a[1], metabase.Committed),
+					},
+				}.Check(ctx, t, db)
+			}
+
+			// the listing continues after all versions of the cursor key
+			metabasetest.IterateObjectsLatestVersion{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:  a[0].ProjectID,
+					BucketName: a[0].BucketName,
+					Recursive:  true,
+					Status:     metabase.Committed,
+					Cursor: metabase.IterateCursor{
+						Key:     a[1].ObjectKey,
+						Version: a[1].Version,
+					},
+				},
+				Result: nil,
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+// createVersionedObjects creates two versions of the object a/x, and the
+// object b/y, which is hidden by a delete marker.
+func createVersionedObjects(ctx *testcontext.Context, t *testing.T, db *metabase.DB) (a, b []metabase.ObjectStream, marker metabase.ObjectStream) {
+	obj := metabasetest.RandObjectStream()
+
+	for version := metabase.Version(1); version <= 2; version++ {
+		v := metabasetest.RandObjectStream()
+		v.ProjectID, v.BucketName = obj.ProjectID, obj.BucketName
+		v.ObjectKey = "a/x"
+		v.Version = version
+		metabasetest.CreateObject(ctx, t, db, v, 0)
+		a = append(a, v)
+	}
+
+	v := metabasetest.RandObjectStream()
+	v.ProjectID, v.BucketName = obj.ProjectID, obj.BucketName
+	v.ObjectKey = "b/y"
+	v.Version = 1
+	metabasetest.CreateObject(ctx, t, db, v, 0)
+	b = append(b, v)
+
+	deleted := metabasetest.InsertDeleteMarker{
+		Opts: metabase.InsertDeleteMarker{
+			ObjectLocation: v.Location(),
+		},
+		Version: 2,
+	}.Check(ctx, t, db)
+
+	return a, b, deleted.ObjectStream
+}
+
+func versionEntry(obj metabase.ObjectStream, status metabase.ObjectStatus) metabase.ObjectEntry {
+	entry := metabase.ObjectEntry{
+		ObjectKey: obj.ObjectKey,
+		Version:   obj.Version,
+		StreamID:  obj.StreamID,
+		Status:    status,
+	}
+	if status != metabase.DeleteMarker {
+		entry.Encryption = metabasetest.DefaultEncryption
+	}
+	return entry
+}
@@ -27,6 +27,8 @@ type Config struct {
 	Enabled     bool          `help:"set if zombie object cleanup is enabled or not" default:"true"`
 	ListLimit   int           `help:"how many objects to query in a batch" default:"100"`
 	InactiveFor time.Duration `help:"after what time object will be deleted if there where no new upload activity" default:"24h"`
+
+	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
 }
 
 // Chore implements the zombie objects cleanup chore.
@@ -79,9 +81,16 @@ func (chore *Chore) deleteZombieObjects(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	chore.log.Debug("deleting zombie objects")
 
-	return chore.metabase.DeleteZombieObjects(ctx, metabase.DeleteZombieObjects{
+	deleted, err := chore.metabase.DeleteZombieObjects(ctx, metabase.DeleteZombieObjects{
 		DeadlineBefore:   chore.nowFn(),
 		InactiveDeadline: chore.nowFn().Add(-chore.config.InactiveFor),
+		AsOfSystemTime:   time.Now().Add(chore.config.AsOfSystemInterval),
 		BatchSize:        chore.config.ListLimit,
 	})
+	if deleted.Objects > 0 {
+		chore.log.Info("deleted zombie objects",
+			zap.Int64("objects", deleted.Objects),
+			zap.Int64("segments", deleted.Segments))
+	}
+	return err
 }
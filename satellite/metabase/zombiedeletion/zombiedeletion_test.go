@@ -179,3 +179,79 @@ func TestZombieDeletion_LastSegmentActive(t *testing.T) {
 		require.Zero(t, diff)
 	})
 }
+
+func TestZombieDeletion_Counts(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		upl := planet.Uplinks[0]
+		sat := planet.Satellites[0]
+
+		// upload regular object, will be NOT deleted
+		err := upl.Upload(ctx, sat, "testbucket", "committed_object", testrand.Bytes(1*memory.KiB))
+		require.NoError(t, err)
+
+		project, err := upl.OpenProject(ctx, sat)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		// pending object with a later zombie deadline, will be NOT deleted
+		_, err = project.BeginUpload(ctx, "testbucket", "pending_object", nil)
+		require.NoError(t, err)
+
+		now := time.Now()
+		laterDeadline := now.Add(48 * time.Hour)
+		_, err = sat.Metabase.DB.UnderlyingTagSQL().Exec(ctx,
+			"UPDATE objects SET zombie_deletion_deadline = $1 WHERE zombie_deletion_deadline IS NOT NULL",
+			laterDeadline)
+		require.NoError(t, err)
+
+		// pending object without segments, will be deleted
+		_, err = project.BeginUpload(ctx, "testbucket", "zombie_object_no_segments", nil)
+		require.NoError(t, err)
+
+		// pending object with two segments, will be deleted
+		info, err := project.BeginUpload(ctx, "testbucket", "zombie_object_multipart", nil)
+		require.NoError(t, err)
+		for partNumber := uint32(1); partNumber <= 2; partNumber++ {
+			partUpload, err := project.UploadPart(ctx, "testbucket", "zombie_object_multipart", info.UploadID, partNumber)
+			require.NoError(t, err)
+			_, err = partUpload.Write(testrand.Bytes(1 * memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, partUpload.Commit())
+		}
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 4)
+
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 3)
+
+		// the deadlines the chore uses 25 hours from now
+		result, err := sat.Metabase.DB.DeleteZombieObjects(ctx, metabase.DeleteZombieObjects{
+			DeadlineBefore:   now.Add(25 * time.Hour),
+			InactiveDeadline: now.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		require.Equal(t, metabase.DeleteZombieObjectsResult{Objects: 2, Segments: 2}, result)
+
+		objects, err = sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		statuses := map[metabase.ObjectStatus]int{}
+		for _, object := range objects {
+			statuses[object.Status]++
+			if object.Status == metabase.Pending {
+				require.WithinDuration(t, laterDeadline, *object.ZombieDeletionDeadline, time.Second)
+			}
+		}
+		require.Equal(t, map[metabase.ObjectStatus]int{metabase.Committed: 1, metabase.Pending: 1}, statuses)
+
+		segments, err = sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+	})
+}
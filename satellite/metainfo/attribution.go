@@ -31,7 +31,7 @@ func (endpoint *Endpoint) ensureAttribution(ctx context.Context, header *pb.Requ
 	defer mon.Task()(&ctx)(&err)
 
 	if header == nil {
-		return rpcstatus.Error(rpcstatus.InvalidArgument, "header is nil")
+		return newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "header is nil")
 	}
 	if keyInfo.PartnerID.IsZero() && len(header.UserAgent) == 0 && len(keyInfo.UserAgent) == 0 && len(projectUserAgent) == 0 {
 		return nil
@@ -116,7 +116,7 @@ func (endpoint *Endpoint) tryUpdateBucketAttribution(ctx context.Context, header
 	defer mon.Task()(&ctx)(&err)
 
 	if header == nil {
-		return rpcstatus.Error(rpcstatus.InvalidArgument, "header is nil")
+		return newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "header is nil")
 	}
 
 	// check if attribution is set for given bucket
@@ -17,6 +17,12 @@ import (
 func (endpoint *Endpoint) Batch(ctx context.Context, req *pb.BatchRequest) (resp *pb.BatchResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, batchPriority(req))
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	resp = &pb.BatchResponse{}
 
 	resp.Responses = make([]*pb.BatchResponseItem, 0, len(req.Requests))
@@ -366,3 +372,24 @@ func (endpoint *Endpoint) shouldCombine(segmentIndex int32, reqIndex int, reques
 	}
 	return false
 }
+
+// batchPriority returns the priority of the batch, which is the lowest
+// priority of its requests.
+func batchPriority(req *pb.BatchRequest) requestPriority {
+	priority := priorityHigh
+	for _, request := range req.Requests {
+		var requestPriority requestPriority
+		switch request.Request.(type) {
+		case *pb.BatchRequestItem_ObjectGet, *pb.BatchRequestItem_SegmentDownload:
+			requestPriority = priorityHigh
+		case *pb.BatchRequestItem_BucketList, *pb.BatchRequestItem_ObjectList, *pb.BatchRequestItem_SegmentList:
+			requestPriority = priorityLow
+		default:
+			requestPriority = priorityNormal
+		}
+		if requestPriority < priority {
+			priority = requestPriority
+		}
+	}
+	return priority
+}
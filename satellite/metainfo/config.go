@@ -12,6 +12,7 @@ import (
 	"github.com/vivint/infectious"
 
 	"storj.io/common/memory"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
 	"storj.io/uplink/private/eestream"
@@ -109,6 +110,10 @@ type RateLimiterConfig struct {
 	Rate            float64       `help:"request rate per project per second." releaseDefault:"100" devDefault:"100" testDefault:"1000"`
 	CacheCapacity   int           `help:"number of projects to cache." releaseDefault:"10000" devDefault:"10" testDefault:"100"`
 	CacheExpiration time.Duration `help:"how long to cache the projects limiter." releaseDefault:"10m" devDefault:"10s"`
+
+	ExpensiveRate    float64 `help:"request rate per project per second of the requests, which list or delete objects. 0 means that they share the rate of the other requests." default:"0"`
+	KeyRate          float64 `help:"request rate per API key per second. 0 means that the API keys aren't limited." default:"0"`
+	KeyExpensiveRate float64 `help:"request rate per API key per second of the requests, which list or delete objects. 0 means that they share the rate of the other requests of the API key." default:"0"`
 }
 
 // ProjectLimitConfig is a configuration struct for default project limits.
@@ -116,6 +121,11 @@ type ProjectLimitConfig struct {
 	MaxBuckets int `help:"max bucket count for a project." default:"100" testDefault:"10"`
 }
 
+// BucketQuotaConfig is a configuration struct for the object count and size quotas of buckets.
+type BucketQuotaConfig struct {
+	Enabled bool `help:"whether the object count and size quotas of buckets are enforced." default:"true"`
+}
+
 // Config is a configuration struct that is everything you need to start a metainfo.
 type Config struct {
 	DatabaseURL          string      `help:"the database connection string to use" default:"postgres://"`
@@ -133,9 +143,18 @@ type Config struct {
 	RS                          RSConfig             `releaseDefault:"29/35/80/110-256B" devDefault:"4/6/8/10-256B" help:"redundancy scheme configuration in the format k/m/o/n-sharesize"`
 	SegmentLoop                 segmentloop.Config   `help:"segment loop configuration"`
 	RateLimiter                 RateLimiterConfig    `help:"rate limiter configuration"`
+	Overload                    OverloadConfig       `help:"overload protection configuration"`
+	ListingCache                ListingCacheConfig   `help:"object listing cache configuration"`
 	ProjectLimits               ProjectLimitConfig   `help:"project limit configuration"`
+	BucketQuota                 BucketQuotaConfig    `help:"bucket quota configuration"`
 	PieceDeletion               piecedeletion.Config `help:"piece deletion configuration"`
 	// TODO remove this flag when server-side copy implementation will be finished
 	ServerSideCopy         bool `help:"enable code for server-side copy, deprecated. please leave this to true." default:"true"`
 	ServerSideCopyDisabled bool `help:"disable already enabled server-side copy. this is because once server side copy is enabled, delete code should stay changed, even if you want to disable server side copy" default:"false"`
+
+	ObjectVersioning bool `help:"keep the previous versions of objects, when they're overwritten or deleted. experimental." default:"false"`
+
+	TrashRetention time.Duration `help:"how long the deleted objects are kept in the trash, where they can be restored. objects are deleted immediately when zero. not used with object versioning." default:"0"`
+
+	ReadReplica metabase.ReadReplicaConfig `help:"metabase read replica configuration"`
 }
@@ -6,6 +6,7 @@ package metainfo
 import (
 	"context"
 	"crypto/sha256"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -24,9 +25,11 @@ import (
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/maintenance"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
 	"storj.io/storj/satellite/metainfo/pointerverification"
+	"storj.io/storj/satellite/metainfo/securitylog"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/revocation"
@@ -73,22 +76,32 @@ type Endpoint struct {
 	pointerVerification  *pointerverification.Service
 	projectUsage         *accounting.Service
 	projects             console.Projects
+	projectActivities    console.ProjectActivities
+	securityLog          *securitylog.Service
 	apiKeys              APIKeys
 	satellite            signing.Signer
-	limiterCache         *lrucache.ExpiringLRU
+	overload             *concurrencyLimiter
+	listingCache         *listingCache
 	encInlineSegmentSize int64 // max inline segment size + encryption overhead
 	revocations          revocation.DB
+	maintenance          *maintenance.Service
 	defaultRS            *pb.RedundancyScheme
 	config               Config
 	versionCollector     *versionCollector
+
+	// rateLimiterMu guards config.RateLimiter and limiterCache, which
+	// can be changed at runtime.
+	rateLimiterMu sync.RWMutex
+	limiterCache  *lrucache.ExpiringLRU
 }
 
 // NewEndpoint creates new metainfo endpoint instance.
 func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase.DB,
 	deletePieces *piecedeletion.Service, orders *orders.Service, cache *overlay.Service,
 	attributions attribution.DB, partners *rewards.PartnersService, peerIdentities overlay.PeerIdentities,
-	apiKeys APIKeys, projectUsage *accounting.Service, projects console.Projects,
-	satellite signing.Signer, revocations revocation.DB, config Config) (*Endpoint, error) {
+	apiKeys APIKeys, projectUsage *accounting.Service, projects console.Projects, projectActivities console.ProjectActivities,
+	securityLog *securitylog.Service, satellite signing.Signer, revocations revocation.DB, maintenance *maintenance.Service,
+	config Config) (*Endpoint, error) {
 	// TODO do something with too many params
 
 	encInlineSegmentSize, err := encryption.CalcEncryptedSize(config.MaxInlineSegmentSize.Int64(), storj.EncryptionParameters{
@@ -108,29 +121,45 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 		ErasureShareSize: config.RS.ErasureShareSize.Int32(),
 	}
 
+	var overload *concurrencyLimiter
+	if config.Overload.Enabled {
+		overload = newConcurrencyLimiter(config.Overload)
+	}
+
+	var listingCache *listingCache
+	if config.ListingCache.Enabled {
+		listingCache = newListingCache(config.ListingCache)
+	}
+
 	return &Endpoint{
-		log:                 log,
-		buckets:             buckets,
-		metabase:            metabaseDB,
-		deletePieces:        deletePieces,
-		orders:              orders,
-		overlay:             cache,
-		attributions:        attributions,
-		partners:            partners,
-		pointerVerification: pointerverification.NewService(peerIdentities),
-		apiKeys:             apiKeys,
-		projectUsage:        projectUsage,
-		projects:            projects,
-		satellite:           satellite,
-		limiterCache: lrucache.New(lrucache.Options{
-			Capacity:   config.RateLimiter.CacheCapacity,
-			Expiration: config.RateLimiter.CacheExpiration,
-		}),
+		log:                  log,
+		buckets:              buckets,
+		metabase:             metabaseDB,
+		deletePieces:         deletePieces,
+		orders:               orders,
+		overlay:              cache,
+		attributions:         attributions,
+		partners:             partners,
+		pointerVerification:  pointerverification.NewService(peerIdentities),
+		apiKeys:              apiKeys,
+		projectUsage:         projectUsage,
+		projects:             projects,
+		projectActivities:    projectActivities,
+		securityLog:          securityLog,
+		satellite:            satellite,
+		overload:             overload,
+		listingCache:         listingCache,
 		encInlineSegmentSize: encInlineSegmentSize,
 		revocations:          revocations,
+		maintenance:          maintenance,
 		defaultRS:            defaultRSScheme,
 		config:               config,
 		versionCollector:     newVersionCollector(log),
+
+		limiterCache: lrucache.New(lrucache.Options{
+			Capacity:   config.RateLimiter.CacheCapacity,
+			Expiration: config.RateLimiter.CacheExpiration,
+		}),
 	}, nil
 }
 
@@ -141,6 +170,12 @@ func (endpoint *Endpoint) Close() error { return nil }
 func (endpoint *Endpoint) ProjectInfo(ctx context.Context, req *pb.ProjectInfoRequest) (_ *pb.ProjectInfoResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -162,11 +197,17 @@ func (endpoint *Endpoint) ProjectInfo(ctx context.Context, req *pb.ProjectInfoRe
 func (endpoint *Endpoint) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (resp *pb.RevokeAPIKeyResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	macToRevoke, err := macaroon.ParseMacaroon(req.GetApiKey())
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "API key to revoke is not a macaroon")
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "API key to revoke is not a macaroon")
 	}
 	keyInfo, err := endpoint.validateRevoke(ctx, req.Header, macToRevoke)
 	if err != nil {
@@ -272,15 +313,17 @@ func (endpoint *Endpoint) convertMetabaseErr(err error) error {
 
 	switch {
 	case storj.ErrObjectNotFound.Has(err):
-		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		return newDetailedError(rpcstatus.NotFound, ErrorDetails{Code: ErrorCodeObjectNotFound}, err.Error())
 	case metabase.ErrSegmentNotFound.Has(err):
-		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		return newDetailedError(rpcstatus.NotFound, ErrorDetails{Code: ErrorCodeSegmentNotFound}, err.Error())
 	case metabase.ErrInvalidRequest.Has(err):
-		return rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	case metabase.ErrObjectAlreadyExists.Has(err):
-		return rpcstatus.Error(rpcstatus.AlreadyExists, err.Error())
+		return newDetailedError(rpcstatus.AlreadyExists, ErrorDetails{Code: ErrorCodeObjectAlreadyExists}, err.Error())
 	case metabase.ErrPendingObjectMissing.Has(err):
-		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		return newDetailedError(rpcstatus.NotFound, ErrorDetails{Code: ErrorCodeObjectNotFound}, err.Error())
+	case metabase.ErrObjectModified.Has(err):
+		return newDetailedError(rpcstatus.FailedPrecondition, ErrorDetails{Code: ErrorCodeObjectModified}, err.Error())
 	default:
 		endpoint.log.Error("internal", zap.Error(err))
 		return rpcstatus.Error(rpcstatus.Internal, err.Error())
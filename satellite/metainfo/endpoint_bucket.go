@@ -18,6 +18,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -25,6 +26,12 @@ import (
 func (endpoint *Endpoint) GetBucket(ctx context.Context, req *pb.BucketGetRequest) (resp *pb.BucketGetResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -39,7 +46,10 @@ func (endpoint *Endpoint) GetBucket(ctx context.Context, req *pb.BucketGetReques
 	bucket, err := endpoint.buckets.GetMinimalBucket(ctx, req.GetName(), keyInfo.ProjectID)
 	if err != nil {
 		if storj.ErrBucketNotFound.Has(err) {
-			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+			return nil, newDetailedError(rpcstatus.NotFound, ErrorDetails{
+				Code:   ErrorCodeBucketNotFound,
+				Bucket: string(req.GetName()),
+			}, err.Error())
 		}
 		endpoint.log.Error("internal", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -60,8 +70,18 @@ func (endpoint *Endpoint) GetBucket(ctx context.Context, req *pb.BucketGetReques
 func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreateRequest) (resp *pb.BucketCreateResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkWriteFreeze(ctx); err != nil {
+		return nil, err
+	}
+
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
 		Op:     macaroon.ActionWrite,
 		Bucket: req.Name,
@@ -71,9 +91,11 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 		return nil, err
 	}
 
+	endpoint.securityLog.Record(ctx, keyInfo, console.SecurityLogCreateBucket, req.Name)
+
 	err = endpoint.validateBucket(ctx, req.Name)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	// checks if bucket exists before updates it or makes a new entry
@@ -86,7 +108,10 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 		if err := endpoint.ensureAttribution(ctx, req.Header, keyInfo, req.GetName(), nil); err != nil {
 			return nil, err
 		}
-		return nil, rpcstatus.Error(rpcstatus.AlreadyExists, "bucket already exists")
+		return nil, newDetailedError(rpcstatus.AlreadyExists, ErrorDetails{
+			Code:   ErrorCodeBucketAlreadyExists,
+			Bucket: string(req.GetName()),
+		}, "bucket already exists")
 	}
 
 	project, err := endpoint.projects.Get(ctx, keyInfo.ProjectID)
@@ -103,12 +128,15 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 		return nil, err
 	}
 	if bucketCount >= *maxBuckets {
-		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, fmt.Sprintf("number of allocated buckets (%d) exceeded", endpoint.config.ProjectLimits.MaxBuckets))
+		return nil, newDetailedError(rpcstatus.ResourceExhausted, ErrorDetails{
+			Code:  ErrorCodeBucketsLimitExceeded,
+			Limit: int64(*maxBuckets),
+		}, fmt.Sprintf("number of allocated buckets (%d) exceeded", endpoint.config.ProjectLimits.MaxBuckets))
 	}
 
 	bucketReq, err := convertProtoToBucket(req, keyInfo.ProjectID)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	bucket, err := endpoint.buckets.CreateBucket(ctx, bucketReq)
@@ -117,6 +145,16 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to create bucket")
 	}
 
+	// The bucket is created by an uplink, so the actor of the activity isn't known.
+	_, err = endpoint.projectActivities.Insert(ctx, &console.ProjectActivity{
+		ProjectID: keyInfo.ProjectID,
+		Kind:      console.ProjectActivityBucketCreated,
+		Details:   bucket.Name,
+	})
+	if err != nil {
+		endpoint.log.Error("error while recording bucket creation", zap.String("bucketName", bucket.Name), zap.Error(err))
+	}
+
 	// Once we have created the bucket, we can try setting the attribution.
 	if err := endpoint.ensureAttribution(ctx, req.Header, keyInfo, req.GetName(), project.UserAgent); err != nil {
 		return nil, err
@@ -141,8 +179,18 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDeleteRequest) (resp *pb.BucketDeleteResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkWriteFreeze(ctx); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 
 	var canRead, canList bool
@@ -178,9 +226,11 @@ func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDelete
 		return nil, err
 	}
 
+	endpoint.securityLog.Record(ctx, keyInfo, console.SecurityLogDeleteBucket, req.Name)
+
 	err = endpoint.validateBucket(ctx, req.Name)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	var (
@@ -192,7 +242,10 @@ func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDelete
 		bucket, err = endpoint.buckets.GetMinimalBucket(ctx, req.Name, keyInfo.ProjectID)
 		if err != nil {
 			if storj.ErrBucketNotFound.Has(err) {
-				return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+				return nil, newDetailedError(rpcstatus.NotFound, ErrorDetails{
+					Code:   ErrorCodeBucketNotFound,
+					Bucket: string(req.Name),
+				}, err.Error())
 			}
 			return nil, err
 		}
@@ -292,6 +345,8 @@ func (endpoint *Endpoint) deleteBucketObjects(ctx context.Context, projectID uui
 			return nil
 		},
 	})
+	// objects might have been deleted even when the deletion failed.
+	endpoint.invalidateBucketListing(bucketLocation)
 
 	return deletedObjects, Error.Wrap(err)
 }
@@ -300,6 +355,12 @@ func (endpoint *Endpoint) deleteBucketObjects(ctx context.Context, projectID uui
 func (endpoint *Endpoint) ListBuckets(ctx context.Context, req *pb.BucketListRequest) (resp *pb.BucketListResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityLow)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	action := macaroon.Action{
@@ -313,6 +374,8 @@ func (endpoint *Endpoint) ListBuckets(ctx context.Context, req *pb.BucketListReq
 		return nil, err
 	}
 
+	endpoint.securityLog.Record(ctx, keyInfo, console.SecurityLogListBuckets, nil)
+
 	allowedBuckets, err := getAllowedBuckets(ctx, req.Header, action)
 	if err != nil {
 		return nil, err
@@ -356,7 +419,7 @@ func (endpoint *Endpoint) CountBuckets(ctx context.Context, projectID uuid.UUID)
 func getAllowedBuckets(ctx context.Context, header *pb.RequestHeader, action macaroon.Action) (_ macaroon.AllowedBuckets, err error) {
 	key, err := getAPIKey(ctx, header)
 	if err != nil {
-		return macaroon.AllowedBuckets{}, rpcstatus.Errorf(rpcstatus.InvalidArgument, "Invalid API credentials: %v", err)
+		return macaroon.AllowedBuckets{}, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, fmt.Sprintf("Invalid API credentials: %v", err))
 	}
 	allowedBuckets, err := key.GetAllowedBuckets(ctx, action)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"go.uber.org/zap"
@@ -20,6 +21,7 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
@@ -30,8 +32,18 @@ import (
 func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRequest) (resp *pb.ObjectBeginResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkWriteFreeze(ctx); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 
 	var canDelete bool
@@ -60,18 +72,23 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		return nil, err
 	}
 
+	endpoint.securityLog.Record(ctx, keyInfo, console.SecurityLogUploadObject, req.Bucket)
+
 	if !req.ExpiresAt.IsZero() && !req.ExpiresAt.After(time.Now()) {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "Invalid expiration time")
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "Invalid expiration time")
 	}
 
 	err = endpoint.validateBucket(ctx, req.Bucket)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	objectKeyLength := len(req.EncryptedPath)
 	if objectKeyLength > endpoint.config.MaxEncryptedObjectKeyLength {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, fmt.Sprintf("key length is too big, got %v, maximum allowed is %v", objectKeyLength, endpoint.config.MaxEncryptedObjectKeyLength))
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{
+			Code:  ErrorCodeObjectKeyTooLong,
+			Limit: int64(endpoint.config.MaxEncryptedObjectKeyLength),
+		}, fmt.Sprintf("key length is too big, got %v, maximum allowed is %v", objectKeyLength, endpoint.config.MaxEncryptedObjectKeyLength))
 	}
 
 	err = endpoint.checkUploadLimits(ctx, keyInfo.ProjectID)
@@ -83,13 +100,20 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 	placement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
 		if storj.ErrBucketNotFound.Has(err) {
-			return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
+			return nil, newBucketNotFoundError("bucket not found: %s", req.Bucket)
 		}
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	if canDelete {
+	if err := endpoint.checkBucketQuota(ctx, keyInfo.ProjectID, req.Bucket); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case endpoint.config.ObjectVersioning:
+		// the previous versions are kept, so nothing is overwritten.
+	case canDelete:
 		_, err = endpoint.DeleteObjectAnyStatus(ctx, metabase.ObjectLocation{
 			ProjectID:  keyInfo.ProjectID,
 			BucketName: string(req.Bucket),
@@ -98,7 +122,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		if err != nil && !storj.ErrObjectNotFound.Has(err) {
 			return nil, err
 		}
-	} else {
+	default:
 		_, err = endpoint.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
 			ObjectLocation: metabase.ObjectLocation{
 				ProjectID:  keyInfo.ProjectID,
@@ -141,7 +165,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		nonce = req.EncryptedMetadataNonce[:]
 	}
 
-	object, err := endpoint.metabase.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
+	opts := metabase.BeginObjectExactVersion{
 		ObjectStream: metabase.ObjectStream{
 			ProjectID:  keyInfo.ProjectID,
 			BucketName: string(req.Bucket),
@@ -155,10 +179,18 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		EncryptedMetadata:             req.EncryptedMetadata,
 		EncryptedMetadataEncryptedKey: req.EncryptedMetadataEncryptedKey,
 		EncryptedMetadataNonce:        nonce,
-	})
+	}
+
+	var object metabase.Object
+	if endpoint.config.ObjectVersioning {
+		object, err = endpoint.beginObjectNextVersion(ctx, opts)
+	} else {
+		object, err = endpoint.metabase.BeginObjectExactVersion(ctx, opts)
+	}
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
+	endpoint.invalidateListing(object.Location())
 
 	satStreamID, err := endpoint.packStreamID(ctx, &internalpb.StreamID{
 		Bucket:               req.Bucket,
@@ -188,15 +220,49 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 	}, nil
 }
 
+// beginObjectNextVersion starts the upload of the next version of the object,
+// which keeps its previous versions.
+func (endpoint *Endpoint) beginObjectNextVersion(ctx context.Context, opts metabase.BeginObjectExactVersion) (_ metabase.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	opts.Version = metabase.NextVersion
+	opts.Version, err = endpoint.metabase.BeginObjectNextVersion(ctx, metabase.BeginObjectNextVersion{
+		ObjectStream: opts.ObjectStream,
+		ExpiresAt:    opts.ExpiresAt,
+		Encryption:   opts.Encryption,
+
+		EncryptedMetadata:             opts.EncryptedMetadata,
+		EncryptedMetadataEncryptedKey: opts.EncryptedMetadataEncryptedKey,
+		EncryptedMetadataNonce:        opts.EncryptedMetadataNonce,
+	})
+	if err != nil {
+		return metabase.Object{}, err
+	}
+
+	return metabase.Object{
+		ObjectStream: opts.ObjectStream,
+		CreatedAt:    time.Now(),
+		ExpiresAt:    opts.ExpiresAt,
+		Status:       metabase.Pending,
+		Encryption:   opts.Encryption,
+	}, nil
+}
+
 // CommitObject commits an object when all its segments have already been committed.
 func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommitRequest) (resp *pb.ObjectCommitResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -211,7 +277,16 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 
 	metadataSize := memory.Size(len(req.EncryptedMetadata))
 	if metadataSize > endpoint.config.MaxMetadataSize {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, fmt.Sprintf("Metadata is too large, got %v, maximum allowed is %v", metadataSize, endpoint.config.MaxMetadataSize))
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{
+			Code:  ErrorCodeMetadataTooLarge,
+			Limit: endpoint.config.MaxMetadataSize.Int64(),
+		}, fmt.Sprintf("Metadata is too large, got %v, maximum allowed is %v", metadataSize, endpoint.config.MaxMetadataSize))
+	}
+
+	// the quota could be reached since the upload began, e.g. by a long
+	// multipart upload.
+	if err := endpoint.checkBucketQuota(ctx, keyInfo.ProjectID, streamID.Bucket); err != nil {
+		return nil, err
 	}
 
 	id, err := uuid.FromBytes(streamID.StreamId)
@@ -235,7 +310,7 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
 			StreamID:   id,
-			Version:    metabase.DefaultVersion,
+			Version:    metabase.Version(streamID.Version),
 		},
 		Encryption: encryption,
 	}
@@ -261,6 +336,7 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
+	endpoint.invalidateListing(request.Location())
 
 	return &pb.ObjectCommitResponse{}, nil
 }
@@ -269,6 +345,12 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 func (endpoint *Endpoint) GetObject(ctx context.Context, req *pb.ObjectGetRequest) (resp *pb.ObjectGetResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityHigh)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -281,19 +363,18 @@ func (endpoint *Endpoint) GetObject(ctx context.Context, req *pb.ObjectGetReques
 		return nil, err
 	}
 
+	endpoint.securityLog.Record(ctx, keyInfo, console.SecurityLogGetObject, req.Bucket)
+
 	err = endpoint.validateBucket(ctx, req.Bucket)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
-	mbObject, err := endpoint.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
-		ObjectLocation: metabase.ObjectLocation{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(req.Bucket),
-			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-		},
-		Version: metabase.DefaultVersion,
-	})
+	mbObject, err := endpoint.getObject(ctx, metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+	}, metabase.Version(req.Version))
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
@@ -343,6 +424,12 @@ func (endpoint *Endpoint) GetObject(ctx context.Context, req *pb.ObjectGetReques
 func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDownloadRequest) (resp *pb.ObjectDownloadResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityHigh)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	if ctx.Err() != nil {
 		return nil, rpcstatus.Error(rpcstatus.Canceled, "client has closed the connection")
 	}
@@ -359,9 +446,15 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		return nil, err
 	}
 
+	endpoint.securityLog.Record(ctx, keyInfo, console.SecurityLogDownloadObject, req.Bucket)
+
 	err = endpoint.validateBucket(ctx, req.Bucket)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
+	}
+
+	if err := endpoint.checkProjectFreeze(ctx, keyInfo.ProjectID, true); err != nil {
+		return nil, err
 	}
 
 	if exceeded, limit, err := endpoint.projectUsage.ExceedsBandwidthUsage(ctx, keyInfo.ProjectID); err != nil {
@@ -379,19 +472,19 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 			zap.Stringer("Limit", limit),
 			zap.Stringer("Project ID", keyInfo.ProjectID),
 		)
-		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
+		return nil, newDetailedError(rpcstatus.ResourceExhausted, ErrorDetails{
+			Code:  ErrorCodeBandwidthLimitExceeded,
+			Limit: limit.Int64(),
+		}, "Exceeded Usage Limit")
 	}
 
 	// get the object information
 
-	object, err := endpoint.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
-		ObjectLocation: metabase.ObjectLocation{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(req.Bucket),
-			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
-		},
-		Version: metabase.DefaultVersion,
-	})
+	object, err := endpoint.getObject(ctx, metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+	}, 0)
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
@@ -400,7 +493,7 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 
 	streamRange, err := calculateStreamRange(object, req.Range)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	segments, err := endpoint.metabase.ListStreamPositions(ctx, metabase.ListStreamPositions{
@@ -421,10 +514,20 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 			return nil, nil
 		}
 
-		segment, err := endpoint.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
-			StreamID: object.StreamID,
-			Position: segments.Segments[0].Position,
-		})
+		var segment metabase.Segment
+		if streamRange != nil && !object.IsMigrated() {
+			// the first segment of the range is found directly by its offset.
+			segment, err = endpoint.metabase.GetSegmentByOffset(ctx, metabase.GetSegmentByOffset{
+				StreamID:         object.StreamID,
+				FixedSegmentSize: object.FixedSegmentSize,
+				PlainOffset:      streamRange.PlainStart,
+			})
+		} else {
+			segment, err = endpoint.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+				StreamID: object.StreamID,
+				Position: segments.Segments[0].Position,
+			})
+		}
 		if err != nil {
 			return nil, endpoint.convertMetabaseErr(err)
 		}
@@ -455,11 +558,12 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		}
 
 		if segment.Inline() {
-			err := endpoint.orders.UpdateGetInlineOrder(ctx, object.Location().Bucket(), downloadSizes.plainSize)
+			err := endpoint.orders.UpdateGetInlineOrder(ctx, object.Location().Bucket(), keyInfo.Head, downloadSizes.plainSize)
 			if err != nil {
 				endpoint.log.Error("internal", zap.Error(err))
 				return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 			}
+			endpoint.updateSharedLinkUsage(ctx, req.Header, object.Location(), nil, downloadSizes.plainSize, 1)
 
 			// TODO we may think about fallback to encrypted size
 			// as plain size may be empty for old objects
@@ -488,7 +592,7 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 			}}, nil
 		}
 
-		limits, privateKey, err := endpoint.orders.CreateGetOrderLimits(ctx, object.Location().Bucket(), segment, downloadSizes.orderLimit)
+		limits, privateKey, err := endpoint.orders.CreateGetOrderLimits(ctx, object.Location().Bucket(), keyInfo.Head, segment, downloadSizes.orderLimit)
 		if err != nil {
 			if orders.ErrDownloadFailedNotEnoughPieces.Has(err) {
 				endpoint.log.Error("Unable to create order limits.",
@@ -500,6 +604,7 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 			endpoint.log.Error("internal", zap.Error(err))
 			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 		}
+		endpoint.updateSharedLinkUsage(ctx, req.Header, object.Location(), limits, 0, 1)
 
 		// TODO we may think about fallback to encrypted size
 		// as plain size may be empty for old objects
@@ -696,6 +801,12 @@ func calculateStreamRange(object metabase.Object, req *pb.Range) (*metabase.Stre
 func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListRequest) (resp *pb.ObjectListResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityLow)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -708,16 +819,18 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 		return nil, err
 	}
 
+	endpoint.securityLog.Record(ctx, keyInfo, console.SecurityLogListObjects, req.Bucket)
+
 	err = endpoint.validateBucket(ctx, req.Bucket)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	// TODO this needs to be optimized to avoid DB call on each request
 	placement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
 		if storj.ErrBucketNotFound.Has(err) {
-			return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
+			return nil, newBucketNotFoundError("bucket not found: %s", req.Bucket)
 		}
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -725,7 +838,7 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 
 	limit := int(req.Limit)
 	if limit < 0 {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "limit is negative")
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "limit is negative")
 	}
 	metabase.ListLimit.Ensure(&limit)
 
@@ -755,9 +868,35 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 		includeSystemMetadata = !req.ObjectIncludes.ExcludeSystemMetadata
 	}
 
+	listedPrefix := listingPrefix{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		Prefix:     prefix,
+	}
+	listing := listingRequest{
+		Cursor:                cursor,
+		Recursive:             req.Recursive,
+		Limit:                 limit,
+		Status:                status,
+		IncludeCustomMetadata: includeCustomMetadata,
+		IncludeSystemMetadata: includeSystemMetadata,
+	}
+	if endpoint.listingCache != nil {
+		if cached, ok := endpoint.listingCache.get(listedPrefix, listing); ok {
+			mon.Meter("req_list_object").Mark(1)
+			return cached, nil
+		}
+	}
+
+	// with versioning only the latest version of the committed objects is
+	// listed, otherwise every object has just the default version.
+	iterate := endpoint.metabase.IterateObjectsAllVersionsWithStatus
+	if endpoint.config.ObjectVersioning && status == metabase.Committed {
+		iterate = endpoint.metabase.IterateObjectsLatestVersion
+	}
+
 	resp = &pb.ObjectListResponse{}
-	// TODO: Replace with IterateObjectsLatestVersion when ready
-	err = endpoint.metabase.IterateObjectsAllVersionsWithStatus(ctx,
+	err = iterate(ctx,
 		metabase.IterateObjectsWithStatus{
 			ProjectID:  keyInfo.ProjectID,
 			BucketName: string(req.Bucket),
@@ -788,6 +927,10 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 		return nil, endpoint.convertMetabaseErr(err)
 	}
 
+	if endpoint.listingCache != nil {
+		endpoint.listingCache.put(listedPrefix, listing, resp)
+	}
+
 	endpoint.log.Info("Object List", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "list"), zap.String("type", "object"))
 	mon.Meter("req_list_object").Mark(1)
 
@@ -798,6 +941,12 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.ObjectListPendingStreamsRequest) (resp *pb.ObjectListPendingStreamsResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityLow)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -812,13 +961,13 @@ func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.
 
 	err = endpoint.validateBucket(ctx, req.Bucket)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	placement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
 		if storj.ErrBucketNotFound.Has(err) {
-			return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
+			return nil, newBucketNotFoundError("bucket not found: %s", req.Bucket)
 		}
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -828,7 +977,7 @@ func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.
 	if req.StreamIdCursor != nil {
 		streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamIdCursor)
 		if err != nil {
-			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+			return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 		}
 		cursor.StreamID, err = uuid.FromBytes(streamID.StreamId)
 		if err != nil {
@@ -839,7 +988,7 @@ func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.
 
 	limit := int(req.Limit)
 	if limit < 0 {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "limit is negative")
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "limit is negative")
 	}
 	metabase.ListLimit.Ensure(&limit)
 
@@ -882,8 +1031,18 @@ func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.
 func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectBeginDeleteRequest) (resp *pb.ObjectBeginDeleteResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkWriteFreeze(ctx); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 
 	var canRead, canList bool
@@ -922,16 +1081,18 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 		return nil, err
 	}
 
+	endpoint.securityLog.Record(ctx, keyInfo, console.SecurityLogDeleteObject, req.Bucket)
+
 	err = endpoint.validateBucket(ctx, req.Bucket)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	var deletedObjects []*pb.Object
 
 	if req.GetStatus() == int32(metabase.Pending) {
 		if req.StreamId == nil {
-			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "StreamID missing")
+			return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "StreamID missing")
 		}
 		var pbStreamID *internalpb.StreamID
 		pbStreamID, err = endpoint.unmarshalSatStreamID(ctx, *(req.StreamId))
@@ -944,11 +1105,23 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 						ProjectID:  keyInfo.ProjectID,
 						BucketName: string(req.Bucket),
 						ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-						Version:    metabase.Version(req.GetVersion()),
+						Version:    metabase.Version(pbStreamID.Version),
 						StreamID:   streamID,
 					})
 			}
 		}
+	} else if endpoint.config.ObjectVersioning {
+		deletedObjects, err = endpoint.deleteObjectVersion(ctx, metabase.ObjectLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+		}, metabase.Version(req.GetVersion()))
+	} else if endpoint.config.TrashRetention > 0 {
+		deletedObjects, err = endpoint.trashObject(ctx, metabase.ObjectLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+		}, now)
 	} else {
 		deletedObjects, err = endpoint.DeleteCommittedObject(ctx, keyInfo.ProjectID, string(req.Bucket), metabase.ObjectKey(req.EncryptedPath))
 	}
@@ -989,6 +1162,12 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 func (endpoint *Endpoint) GetObjectIPs(ctx context.Context, req *pb.ObjectGetIPsRequest) (resp *pb.ObjectGetIPsResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityHigh)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -1003,18 +1182,15 @@ func (endpoint *Endpoint) GetObjectIPs(ctx context.Context, req *pb.ObjectGetIPs
 
 	err = endpoint.validateBucket(ctx, req.Bucket)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	// TODO we may need custom metabase request to avoid two DB calls
-	object, err := endpoint.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
-		ObjectLocation: metabase.ObjectLocation{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(req.Bucket),
-			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-		},
-		Version: metabase.DefaultVersion,
-	})
+	object, err := endpoint.getObject(ctx, metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+	}, metabase.Version(req.Version))
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
@@ -1064,8 +1240,18 @@ func (endpoint *Endpoint) GetObjectIPs(ctx context.Context, req *pb.ObjectGetIPs
 func (endpoint *Endpoint) UpdateObjectMetadata(ctx context.Context, req *pb.ObjectUpdateMetadataRequest) (resp *pb.ObjectUpdateMetadataResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkWriteFreeze(ctx); err != nil {
+		return nil, err
+	}
+
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
 		Op:            macaroon.ActionWrite,
 		Bucket:        req.Bucket,
@@ -1078,12 +1264,12 @@ func (endpoint *Endpoint) UpdateObjectMetadata(ctx context.Context, req *pb.Obje
 
 	err = endpoint.validateBucket(ctx, req.Bucket)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	id, err := uuid.FromBytes(streamID.StreamId)
@@ -1102,7 +1288,7 @@ func (endpoint *Endpoint) UpdateObjectMetadata(ctx context.Context, req *pb.Obje
 			ProjectID:  keyInfo.ProjectID,
 			BucketName: string(req.Bucket),
 			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
-			Version:    metabase.Version(req.Version),
+			Version:    metabase.Version(streamID.Version),
 			StreamID:   id,
 		},
 		EncryptedMetadata:             req.EncryptedMetadata,
@@ -1112,10 +1298,75 @@ func (endpoint *Endpoint) UpdateObjectMetadata(ctx context.Context, req *pb.Obje
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
+	endpoint.invalidateListing(metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+	})
 
 	return &pb.ObjectUpdateMetadataResponse{}, nil
 }
 
+// checkUnversionedObject returns an error when the object has versions above
+// the default version. Moving and copying work only on the default version,
+// so with versioning they are rejected for the objects with multiple versions.
+func (endpoint *Endpoint) checkUnversionedObject(ctx context.Context, location metabase.ObjectLocation, operation string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !endpoint.config.ObjectVersioning {
+		return nil
+	}
+
+	// the versions of a key are listed from the newest, including the delete
+	// markers, and the cursor version is above any version, so the first
+	// entry is the latest version of the object.
+	latest := metabase.Version(0)
+	err = endpoint.metabase.IterateObjectVersions(ctx, metabase.IterateObjectVersions{
+		ProjectID:  location.ProjectID,
+		BucketName: location.BucketName,
+		Recursive:  true,
+		BatchSize:  1,
+		Cursor: metabase.IterateCursor{
+			Key:     location.ObjectKey,
+			Version: math.MaxInt32,
+		},
+	}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+		entry := metabase.ObjectEntry{}
+		if it.Next(ctx, &entry) && entry.ObjectKey == location.ObjectKey {
+			latest = entry.Version
+		}
+		return nil
+	})
+	if err != nil {
+		return endpoint.convertMetabaseErr(err)
+	}
+
+	if latest > metabase.DefaultVersion {
+		return newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, operation+" objects with multiple versions is not (yet) supported")
+	}
+	return nil
+}
+
+// getObject returns the given version of the object. When version is zero,
+// it returns the latest committed version if object versioning is enabled and
+// the default version otherwise.
+func (endpoint *Endpoint) getObject(ctx context.Context, location metabase.ObjectLocation, version metabase.Version) (_ metabase.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if version == 0 {
+		if endpoint.config.ObjectVersioning {
+			return endpoint.metabase.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+				ObjectLocation: location,
+			})
+		}
+		version = metabase.DefaultVersion
+	}
+	return endpoint.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+		ObjectLocation: location,
+		Version:        version,
+	})
+}
+
 func (endpoint *Endpoint) objectToProto(ctx context.Context, object metabase.Object, rs *pb.RedundancyScheme) (*pb.Object, error) {
 	expires := time.Time{}
 	if object.ExpiresAt != nil {
@@ -1317,6 +1568,7 @@ func (endpoint *Endpoint) DeleteCommittedObject(
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
+	endpoint.invalidateListing(req)
 
 	deletedObjects, err = endpoint.deleteObjectsPieces(ctx, result)
 	if err != nil {
@@ -1332,6 +1584,68 @@ func (endpoint *Endpoint) DeleteCommittedObject(
 	return deletedObjects, nil
 }
 
+// trashObject moves the committed object to the trash, where it can be restored
+// until the trash retention passes. The pieces are kept.
+func (endpoint *Endpoint) trashObject(ctx context.Context, location metabase.ObjectLocation, now time.Time) (deletedObjects []*pb.Object, err error) {
+	defer mon.Task()(&ctx, location.ProjectID.String(), location.BucketName, location.ObjectKey)(&err)
+
+	result, err := endpoint.metabase.TrashObject(ctx, metabase.TrashObject{
+		ObjectLocation:  location,
+		RestoreDeadline: now.Add(endpoint.config.TrashRetention),
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	endpoint.invalidateListing(location)
+
+	return endpoint.deleteObjectsPieces(ctx, result)
+}
+
+// deleteObjectVersion deletes the given version of the object. When version is
+// zero, the object is hidden behind a delete marker instead, which keeps its
+// previous versions, and the latest version is returned as the deleted object.
+func (endpoint *Endpoint) deleteObjectVersion(ctx context.Context, location metabase.ObjectLocation, version metabase.Version) (deletedObjects []*pb.Object, err error) {
+	defer mon.Task()(&ctx, location.ProjectID.String(), location.BucketName, location.ObjectKey)(&err)
+
+	if version != 0 {
+		result, err := endpoint.metabase.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+			ObjectLocation: location,
+			Version:        version,
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		endpoint.invalidateListing(location)
+
+		deletedObjects, err = endpoint.deleteObjectsPieces(ctx, result)
+		return deletedObjects, Error.Wrap(err)
+	}
+
+	// TODO we may need custom metabase request to avoid two DB calls
+	object, err := endpoint.metabase.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+		ObjectLocation: location,
+	})
+	if err == nil {
+		_, err = endpoint.metabase.InsertDeleteMarker(ctx, metabase.InsertDeleteMarker{
+			ObjectLocation: location,
+		})
+	}
+	if err != nil {
+		if storj.ErrObjectNotFound.Has(err) {
+			// deleting a non-existent object isn't an error
+			return nil, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+	endpoint.invalidateListing(location)
+
+	deletedObject, err := endpoint.objectToProto(ctx, object, endpoint.defaultRS)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return []*pb.Object{deletedObject}, nil
+}
+
 // DeleteObjectAnyStatus deletes all the pieces of the storage nodes that belongs
 // to the specified object.
 //
@@ -1355,6 +1669,7 @@ func (endpoint *Endpoint) DeleteObjectAnyStatus(ctx context.Context, location me
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
+	endpoint.invalidateListing(location)
 
 	deletedObjects, err = endpoint.deleteObjectsPieces(ctx, result)
 	if err != nil {
@@ -1383,6 +1698,7 @@ func (endpoint *Endpoint) DeletePendingObject(ctx context.Context, stream metaba
 	if err != nil {
 		return nil, err
 	}
+	endpoint.invalidateListing(stream.Location())
 
 	return endpoint.deleteObjectsPieces(ctx, result)
 }
@@ -1450,8 +1766,18 @@ func groupPiecesByNodeID(segments []metabase.DeletedSegmentInfo) map[storj.NodeI
 func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *pb.ObjectBeginMoveRequest) (resp *pb.ObjectBeginMoveResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkWriteFreeze(ctx); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
 		verifyPermission{
@@ -1486,7 +1812,7 @@ func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *pb.ObjectBeg
 	for _, bucket := range [][]byte{req.Bucket, req.NewBucket} {
 		err = endpoint.validateBucket(ctx, bucket)
 		if err != nil {
-			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+			return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 		}
 	}
 
@@ -1496,7 +1822,7 @@ func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *pb.ObjectBeg
 		oldBucketPlacement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 		if err != nil {
 			if storj.ErrBucketNotFound.Has(err) {
-				return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
+				return nil, newBucketNotFoundError("bucket not found: %s", req.Bucket)
 			}
 			endpoint.log.Error("unable to check bucket", zap.Error(err))
 			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -1504,16 +1830,25 @@ func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *pb.ObjectBeg
 		newBucketPlacement, err := endpoint.buckets.GetBucketPlacement(ctx, req.NewBucket, keyInfo.ProjectID)
 		if err != nil {
 			if storj.ErrBucketNotFound.Has(err) {
-				return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.NewBucket)
+				return nil, newBucketNotFoundError("bucket not found: %s", req.NewBucket)
 			}
 			endpoint.log.Error("unable to check bucket", zap.Error(err))
 			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 		}
 		if oldBucketPlacement != newBucketPlacement {
-			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "copying object to bucket with different placement policy is not (yet) supported")
+			return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "copying object to bucket with different placement policy is not (yet) supported")
 		}
 	}
 
+	err = endpoint.checkUnversionedObject(ctx, metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+	}, "moving")
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := endpoint.metabase.BeginMoveObject(ctx, metabase.BeginMoveObject{
 		ObjectLocation: metabase.ObjectLocation{
 			ProjectID:  keyInfo.ProjectID,
@@ -1610,11 +1945,17 @@ func convertBeginMoveObjectResults(result metabase.BeginMoveObjectResult) (*pb.O
 func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFinishMoveRequest) (resp *pb.ObjectFinishMoveResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -1629,7 +1970,7 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 
 	err = endpoint.validateBucket(ctx, req.NewBucket)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	exists, err := endpoint.buckets.HasBucket(ctx, req.NewBucket, keyInfo.ProjectID)
@@ -1637,12 +1978,21 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	} else if !exists {
-		return nil, rpcstatus.Errorf(rpcstatus.NotFound, "target bucket not found: %s", req.NewBucket)
+		return nil, newBucketNotFoundError("target bucket not found: %s", req.NewBucket)
 	}
 
 	streamUUID, err := uuid.FromBytes(streamID.StreamId)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
+	}
+
+	err = endpoint.checkUnversionedObject(ctx, metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.NewBucket),
+		ObjectKey:  metabase.ObjectKey(req.NewEncryptedObjectKey),
+	}, "moving")
+	if err != nil {
+		return nil, err
 	}
 
 	err = endpoint.metabase.FinishMoveObject(ctx, metabase.FinishMoveObject{
@@ -1662,6 +2012,16 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
+	endpoint.invalidateListing(metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(streamID.Bucket),
+		ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
+	})
+	endpoint.invalidateListing(metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.NewBucket),
+		ObjectKey:  metabase.ObjectKey(req.NewEncryptedObjectKey),
+	})
 
 	return &pb.ObjectFinishMoveResponse{}, nil
 }
@@ -1672,10 +2032,20 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeginCopyRequest) (resp *pb.ObjectBeginCopyResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	if !endpoint.config.ServerSideCopy || endpoint.config.ServerSideCopyDisabled {
 		return nil, rpcstatus.Error(rpcstatus.Unimplemented, "Unimplemented")
 	}
 
+	if err := endpoint.checkWriteFreeze(ctx); err != nil {
+		return nil, err
+	}
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	now := time.Now()
@@ -1712,7 +2082,7 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 	for _, bucket := range [][]byte{req.Bucket, req.NewBucket} {
 		err = endpoint.validateBucket(ctx, bucket)
 		if err != nil {
-			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+			return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 		}
 	}
 
@@ -1722,7 +2092,7 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 		oldBucketPlacement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 		if err != nil {
 			if storj.ErrBucketNotFound.Has(err) {
-				return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
+				return nil, newBucketNotFoundError("bucket not found: %s", req.Bucket)
 			}
 			endpoint.log.Error("unable to check bucket", zap.Error(err))
 			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -1730,16 +2100,25 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 		newBucketPlacement, err := endpoint.buckets.GetBucketPlacement(ctx, req.NewBucket, keyInfo.ProjectID)
 		if err != nil {
 			if storj.ErrBucketNotFound.Has(err) {
-				return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.NewBucket)
+				return nil, newBucketNotFoundError("bucket not found: %s", req.NewBucket)
 			}
 			endpoint.log.Error("unable to check bucket", zap.Error(err))
 			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 		}
 		if oldBucketPlacement != newBucketPlacement {
-			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "copying object to bucket with different placement policy is not (yet) supported")
+			return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "copying object to bucket with different placement policy is not (yet) supported")
 		}
 	}
 
+	err = endpoint.checkUnversionedObject(ctx, metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+	}, "copying")
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := endpoint.metabase.BeginCopyObject(ctx, metabase.BeginCopyObject{
 		ObjectLocation: metabase.ObjectLocation{
 			ProjectID:  keyInfo.ProjectID,
@@ -1839,6 +2218,12 @@ func convertBeginCopyObjectResults(result metabase.BeginCopyObjectResult) (*pb.O
 func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFinishCopyRequest) (resp *pb.ObjectFinishCopyResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	if !endpoint.config.ServerSideCopy || endpoint.config.ServerSideCopyDisabled {
 		return nil, rpcstatus.Error(rpcstatus.Unimplemented, "Unimplemented")
 	}
@@ -1847,7 +2232,7 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -1862,7 +2247,7 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 
 	err = endpoint.validateBucket(ctx, req.NewBucket)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	exists, err := endpoint.buckets.HasBucket(ctx, req.NewBucket, keyInfo.ProjectID)
@@ -1870,17 +2255,26 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	} else if !exists {
-		return nil, rpcstatus.Errorf(rpcstatus.NotFound, "target bucket not found: %s", req.NewBucket)
+		return nil, newBucketNotFoundError("target bucket not found: %s", req.NewBucket)
 	}
 
 	streamUUID, err := uuid.FromBytes(streamID.StreamId)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	newStreamID, err := uuid.New()
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
+	}
+
+	err = endpoint.checkUnversionedObject(ctx, metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.NewBucket),
+		ObjectKey:  metabase.ObjectKey(req.NewEncryptedObjectKey),
+	}, "copying")
+	if err != nil {
+		return nil, err
 	}
 
 	object, err := endpoint.metabase.FinishCopyObject(ctx, metabase.FinishCopyObject{
@@ -1906,6 +2300,7 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
+	endpoint.invalidateListing(object.Location())
 
 	// we can return nil redundancy because this request won't be used for downloading
 	protoObject, err := endpoint.objectToProto(ctx, object, nil)
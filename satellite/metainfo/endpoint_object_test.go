@@ -30,9 +30,11 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/uplink"
 	"storj.io/uplink/private/metaclient"
@@ -1458,6 +1460,311 @@ func TestEndpoint_CopyObject(t *testing.T) {
 	})
 }
 
+func TestEndpoint_ProjectFreeze(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				// the freezes are enforced without waiting for the cached project limits.
+				config.ProjectLimit.CacheExpiration = time.Nanosecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+
+		// the inline object doesn't need storage nodes.
+		expectedData := testrand.Bytes(1 * memory.KiB)
+		err := upl.Upload(ctx, sat, "testbucket", "testobject", expectedData)
+		require.NoError(t, err)
+
+		requireFrozen := func(t *testing.T, err error) {
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "Project Frozen")
+		}
+
+		err = sat.DB.ProjectAccounting().FreezeProject(ctx, accounting.ProjectFreeze{
+			ProjectID: projectID,
+			Reason:    accounting.FreezeReasonNonPayment,
+			InvoiceID: "in_unpaid",
+		})
+		require.NoError(t, err)
+
+		// the uploads and copies are rejected, the downloads aren't.
+		requireFrozen(t, upl.Upload(ctx, sat, "testbucket", "other", expectedData))
+		requireFrozen(t, upl.CopyObject(ctx, sat, "testbucket", "testobject", "testbucket", "copy"))
+
+		data, err := upl.Download(ctx, sat, "testbucket", "testobject")
+		require.NoError(t, err)
+		require.Equal(t, expectedData, data)
+
+		err = sat.DB.ProjectAccounting().FreezeProject(ctx, accounting.ProjectFreeze{
+			ProjectID: projectID,
+			Reason:    accounting.FreezeReasonSupport,
+			Downloads: true,
+		})
+		require.NoError(t, err)
+
+		_, err = upl.Download(ctx, sat, "testbucket", "testobject")
+		requireFrozen(t, err)
+
+		// the data is preserved while the project is frozen.
+		err = sat.DB.ProjectAccounting().UnfreezeProject(ctx, projectID)
+		require.NoError(t, err)
+
+		data, err = upl.Download(ctx, sat, "testbucket", "testobject")
+		require.NoError(t, err)
+		require.Equal(t, expectedData, data)
+
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "other", expectedData))
+	})
+}
+
+func TestEndpoint_ObjectVersioning(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.ObjectVersioning = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		// the inline objects don't need storage nodes.
+		first := testrand.Bytes(1 * memory.KiB)
+		second := testrand.Bytes(1 * memory.KiB)
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "testobject", first))
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "testobject", second))
+
+		// only the latest version is listed and downloaded.
+		objects, err := upl.ListObjects(ctx, sat, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		data, err := upl.Download(ctx, sat, "testbucket", "testobject")
+		require.NoError(t, err)
+		require.Equal(t, second, data)
+
+		project, err := upl.OpenProject(ctx, sat)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		// the metadata of the latest version can be updated.
+		err = project.UpdateObjectMetadata(ctx, "testbucket", "testobject", uplink.CustomMetadata{"key": "value"}, nil)
+		require.NoError(t, err)
+
+		object, err := project.StatObject(ctx, "testbucket", "testobject")
+		require.NoError(t, err)
+		require.Equal(t, uplink.CustomMetadata{"key": "value"}, object.Custom)
+
+		require.NoError(t, upl.DeleteObject(ctx, sat, "testbucket", "testobject"))
+
+		objects, err = upl.ListObjects(ctx, sat, "testbucket")
+		require.NoError(t, err)
+		require.Empty(t, objects)
+
+		_, err = upl.Download(ctx, sat, "testbucket", "testobject")
+		require.ErrorIs(t, err, uplink.ErrObjectNotFound)
+
+		// the previous versions are kept behind the delete marker.
+		var versions []metabase.ObjectEntry
+		err = sat.Metabase.DB.IterateObjectVersions(ctx, metabase.IterateObjectVersions{
+			ProjectID:  upl.Projects[0].ID,
+			BucketName: "testbucket",
+			Recursive:  true,
+		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+			var entry metabase.ObjectEntry
+			for it.Next(ctx, &entry) {
+				versions = append(versions, entry)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, versions, 3)
+		require.Equal(t, metabase.DeleteMarker, versions[0].Status)
+		require.Equal(t, metabase.Version(3), versions[0].Version)
+		require.Equal(t, metabase.Committed, versions[1].Status)
+		require.Equal(t, metabase.Committed, versions[2].Status)
+
+		// moving and copying work only on the objects with a single version,
+		// the delete marker is a version too.
+		err = project.MoveObject(ctx, "testbucket", "testobject", "testbucket", "moved", nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "moving objects with multiple versions is not (yet) supported")
+
+		_, err = project.CopyObject(ctx, "testbucket", "testobject", "testbucket", "copied", nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "copying objects with multiple versions is not (yet) supported")
+
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "single", first))
+
+		_, err = project.CopyObject(ctx, "testbucket", "single", "testbucket", "testobject", nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "copying objects with multiple versions is not (yet) supported")
+
+		_, err = project.CopyObject(ctx, "testbucket", "single", "testbucket", "copied", nil)
+		require.NoError(t, err)
+
+		require.NoError(t, project.MoveObject(ctx, "testbucket", "copied", "testbucket", "moved", nil))
+	})
+}
+
+func TestEndpoint_ListObjects_VersioningDisabled(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		// without versioning an upload replaces the object.
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "a/first", testrand.Bytes(1*memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "a/first", testrand.Bytes(1*memory.KiB)))
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "second", testrand.Bytes(1*memory.KiB)))
+
+		project, err := upl.OpenProject(ctx, sat)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		_, err = project.BeginUpload(ctx, "testbucket", "pending", nil)
+		require.NoError(t, err)
+
+		var keys []string
+		list := project.ListObjects(ctx, "testbucket", &uplink.ListObjectsOptions{Recursive: true})
+		for list.Next() {
+			keys = append(keys, list.Item().Key)
+		}
+		require.NoError(t, list.Err())
+		require.Equal(t, []string{"a/first", "second"}, keys)
+
+		keys = nil
+		list = project.ListObjects(ctx, "testbucket", nil)
+		for list.Next() {
+			keys = append(keys, list.Item().Key)
+		}
+		require.NoError(t, list.Err())
+		require.Equal(t, []string{"a/", "second"}, keys)
+
+		keys = nil
+		uploads := project.ListUploads(ctx, "testbucket", nil)
+		for uploads.Next() {
+			keys = append(keys, uploads.Item().Key)
+		}
+		require.NoError(t, uploads.Err())
+		require.Equal(t, []string{"pending"}, keys)
+
+		objects, err := sat.Metabase.DB.TestingAllCommittedObjects(ctx, upl.Projects[0].ID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		for _, object := range objects {
+			require.Equal(t, metabase.DefaultVersion, object.Version)
+		}
+	})
+}
+
+func TestEndpoint_GetObject_VersioningDisabled(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		expectedData := testrand.Bytes(1 * memory.KiB)
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "testobject", expectedData))
+
+		objects, err := sat.Metabase.DB.TestingAllCommittedObjects(ctx, upl.Projects[0].ID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		// a newer committed version must not be returned, without versioning
+		// only the default version is read.
+		metabasetest.CreateObject(ctx, t, sat.Metabase.DB, metabase.ObjectStream{
+			ProjectID:  upl.Projects[0].ID,
+			BucketName: "testbucket",
+			ObjectKey:  objects[0].ObjectKey,
+			Version:    metabase.DefaultVersion + 1,
+			StreamID:   testrand.UUID(),
+		}, 0)
+
+		data, err := upl.Download(ctx, sat, "testbucket", "testobject")
+		require.NoError(t, err)
+		require.Equal(t, expectedData, data)
+
+		project, err := upl.OpenProject(ctx, sat)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		object, err := project.StatObject(ctx, "testbucket", "testobject")
+		require.NoError(t, err)
+		require.EqualValues(t, len(expectedData), object.System.ContentLength)
+	})
+}
+
+func TestEndpoint_TrashRetention(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.TrashRetention = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+
+		listTrash := func() []metabase.Object {
+			result, err := sat.Metabase.DB.ListTrashedObjects(ctx, metabase.ListTrashedObjects{
+				ProjectID:  upl.Projects[0].ID,
+				BucketName: "testbucket",
+			})
+			require.NoError(t, err)
+			return result.Objects
+		}
+
+		// the inline objects don't need storage nodes.
+		first := testrand.Bytes(1 * memory.KiB)
+		second := testrand.Bytes(1 * memory.KiB)
+
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "testobject", first))
+		require.NoError(t, upl.DeleteObject(ctx, sat, "testbucket", "testobject"))
+		require.Len(t, listTrash(), 1)
+
+		// the deleted key can be uploaded again.
+		require.NoError(t, upl.Upload(ctx, sat, "testbucket", "testobject", second))
+
+		data, err := upl.Download(ctx, sat, "testbucket", "testobject")
+		require.NoError(t, err)
+		require.Equal(t, second, data)
+
+		trashed := listTrash()
+		require.Len(t, trashed, 1)
+
+		_, err = sat.Metabase.DB.RestoreObject(ctx, metabase.RestoreObject{
+			ObjectLocation: trashed[0].Location(),
+			Version:        trashed[0].Version,
+		})
+		require.True(t, metabase.ErrObjectAlreadyExists.Has(err))
+
+		require.NoError(t, upl.DeleteObject(ctx, sat, "testbucket", "testobject"))
+
+		trashed = listTrash()
+		require.Len(t, trashed, 2)
+
+		// the listing is ordered by version, the first upload is the last.
+		_, err = sat.Metabase.DB.RestoreObject(ctx, metabase.RestoreObject{
+			ObjectLocation: trashed[1].Location(),
+			Version:        trashed[1].Version,
+		})
+		require.NoError(t, err)
+
+		data, err = upl.Download(ctx, sat, "testbucket", "testobject")
+		require.NoError(t, err)
+		require.Equal(t, first, data)
+	})
+}
+
 func TestEndpoint_ParallelDeletes(t *testing.T) {
 	t.Skip("to be fixed - creating deadlocks")
 	testplanet.Run(t, testplanet.Config{
@@ -32,11 +32,21 @@ func calculateSpaceUsed(segmentSize int64, numberOfPieces int, rs storj.Redundan
 func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBeginRequest) (resp *pb.SegmentBeginResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkWriteFreeze(ctx); err != nil {
+		return nil, err
+	}
+
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -52,7 +62,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 	// no need to validate streamID fields because it was validated during BeginObject
 
 	if req.Position.Index < 0 {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "segment index must be greater then 0")
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "segment index must be greater then 0")
 	}
 
 	if err := endpoint.checkUploadLimits(ctx, keyInfo.ProjectID); err != nil {
@@ -61,7 +71,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 
 	redundancy, err := eestream.NewRedundancyStrategyFromProto(endpoint.defaultRS)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	maxPieceSize := eestream.CalcPieceSize(req.MaxOrderLimit, redundancy)
@@ -77,7 +87,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 	}
 
 	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
-	rootPieceID, addressedLimits, piecePrivateKey, err := endpoint.orders.CreatePutOrderLimits(ctx, bucket, nodes, streamID.ExpirationDate, maxPieceSize)
+	rootPieceID, addressedLimits, piecePrivateKey, err := endpoint.orders.CreatePutOrderLimits(ctx, bucket, keyInfo.Head, nodes, streamID.ExpirationDate, maxPieceSize)
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -102,7 +112,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
 			StreamID:   id,
-			Version:    1,
+			Version:    metabase.Version(streamID.Version),
 		},
 		Position: metabase.SegmentPosition{
 			Part:  uint32(req.Position.PartNumber),
@@ -139,11 +149,17 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentCommitRequest) (resp *pb.SegmentCommitResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	segmentID, err := endpoint.unmarshalSatSegmentID(ctx, req.SegmentId)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	streamID := segmentID.StreamId
@@ -165,10 +181,10 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 			zap.Int32("redundancy optimal threshold", endpoint.defaultRS.GetSuccessThreshold()),
 			zap.Stringer("Segment ID", req.SegmentId),
 		)
-		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument,
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, fmt.Sprintf(
 			"the number of results of uploaded pieces (%d) is below the optimal threshold (%d)",
 			numResults, endpoint.defaultRS.GetSuccessThreshold(),
-		)
+		))
 	}
 
 	rs := storj.RedundancyScheme{
@@ -183,7 +199,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 	err = endpoint.pointerVerification.VerifySizes(ctx, rs, req.SizeEncryptedData, req.UploadResult)
 	if err != nil {
 		endpoint.log.Debug("piece sizes are invalid", zap.Error(err))
-		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "piece sizes are invalid: %v", err)
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, fmt.Sprintf("piece sizes are invalid: %v", err))
 	}
 
 	// extract the original order limits
@@ -196,7 +212,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 	validPieces, invalidPieces, err := endpoint.pointerVerification.SelectValidPieces(ctx, req.UploadResult, originalLimits)
 	if err != nil {
 		endpoint.log.Debug("pointer verification failed", zap.Error(err))
-		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "pointer verification failed: %s", err)
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, fmt.Sprintf("pointer verification failed: %s", err))
 	}
 
 	if len(validPieces) < int(rs.OptimalShares) {
@@ -220,7 +236,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 				)
 			}
 		}
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, errMsg)
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, errMsg)
 	}
 
 	pieces := metabase.Pieces{}
@@ -248,7 +264,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
 			StreamID:   id,
-			Version:    1,
+			Version:    metabase.Version(streamID.Version),
 		},
 		ExpiresAt:         expiresAt,
 		EncryptedKey:      req.EncryptedKey,
@@ -271,7 +287,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 
 	err = endpoint.validateRemoteSegment(ctx, mbCommitSegment, originalLimits)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	if err := endpoint.checkUploadLimits(ctx, keyInfo.ProjectID); err != nil {
@@ -292,7 +308,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 			zap.Int16("redundancy minimum requested", rs.RequiredShares),
 			zap.Int16("redundancy total", rs.TotalShares),
 		)
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "mismatched segment size and piece usage")
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "mismatched segment size and piece usage")
 	}
 
 	err = endpoint.metabase.CommitSegment(ctx, mbCommitSegment)
@@ -317,11 +333,21 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.SegmentMakeInlineRequest) (resp *pb.SegmentMakeInlineResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkWriteFreeze(ctx); err != nil {
+		return nil, err
+	}
+
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -335,12 +361,12 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 	}
 
 	if req.Position.Index < 0 {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "segment index must be greater then 0")
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "segment index must be greater then 0")
 	}
 
 	inlineUsed := int64(len(req.EncryptedInlineData))
 	if inlineUsed > endpoint.encInlineSegmentSize {
-		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "inline segment size cannot be larger than %s", endpoint.config.MaxInlineSegmentSize)
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, fmt.Sprintf("inline segment size cannot be larger than %s", endpoint.config.MaxInlineSegmentSize))
 	}
 
 	id, err := uuid.FromBytes(streamID.StreamId)
@@ -364,7 +390,7 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
 			StreamID:   id,
-			Version:    1,
+			Version:    metabase.Version(streamID.Version),
 		},
 		ExpiresAt:         expiresAt,
 		EncryptedKey:      req.EncryptedKey,
@@ -385,7 +411,7 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 	}
 
 	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
-	err = endpoint.orders.UpdatePutInlineOrder(ctx, bucket, inlineUsed)
+	err = endpoint.orders.UpdatePutInlineOrder(ctx, bucket, keyInfo.Head, inlineUsed)
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -407,11 +433,17 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 func (endpoint *Endpoint) ListSegments(ctx context.Context, req *pb.SegmentListRequest) (resp *pb.SegmentListResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityLow)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	_, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -488,6 +520,12 @@ func convertStreamListResults(result metabase.ListStreamPositionsResult) (*pb.Se
 func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDownloadRequest) (resp *pb.SegmentDownloadResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityHigh)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	if ctx.Err() != nil {
 		return nil, rpcstatus.Error(rpcstatus.Canceled, "client has closed the connection")
 	}
@@ -496,7 +534,7 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, err.Error())
 	}
 
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
@@ -510,6 +548,15 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 	}
 
 	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
+	objectLocation := metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(streamID.Bucket),
+		ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
+	}
+
+	if err := endpoint.checkProjectFreeze(ctx, keyInfo.ProjectID, true); err != nil {
+		return nil, err
+	}
 
 	if exceeded, limit, err := endpoint.projectUsage.ExceedsBandwidthUsage(ctx, keyInfo.ProjectID); err != nil {
 		if errs2.IsCanceled(err) {
@@ -526,7 +573,10 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 			zap.Stringer("Limit", limit),
 			zap.Stringer("Project ID", keyInfo.ProjectID),
 		)
-		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
+		return nil, newDetailedError(rpcstatus.ResourceExhausted, ErrorDetails{
+			Code:  ErrorCodeBandwidthLimitExceeded,
+			Limit: limit.Int64(),
+		}, "Exceeded Usage Limit")
 	}
 
 	id, err := uuid.FromBytes(streamID.StreamId)
@@ -542,11 +592,7 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		}
 
 		segment, err = endpoint.metabase.GetLatestObjectLastSegment(ctx, metabase.GetLatestObjectLastSegment{
-			ObjectLocation: metabase.ObjectLocation{
-				ProjectID:  keyInfo.ProjectID,
-				BucketName: string(streamID.Bucket),
-				ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
-			},
+			ObjectLocation: objectLocation,
 		})
 	} else {
 		segment, err = endpoint.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
@@ -584,11 +630,12 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 	}
 
 	if segment.Inline() {
-		err := endpoint.orders.UpdateGetInlineOrder(ctx, bucket, int64(len(segment.InlineData)))
+		err := endpoint.orders.UpdateGetInlineOrder(ctx, bucket, keyInfo.Head, int64(len(segment.InlineData)))
 		if err != nil {
 			endpoint.log.Error("internal", zap.Error(err))
 			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 		}
+		endpoint.updateSharedLinkUsage(ctx, req.Header, objectLocation, nil, int64(len(segment.InlineData)), 0)
 
 		endpoint.versionCollector.collectTransferStats(req.Header.UserAgent, download, len(segment.InlineData))
 
@@ -611,7 +658,7 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 	}
 
 	// Remote segment
-	limits, privateKey, err := endpoint.orders.CreateGetOrderLimits(ctx, bucket, segment, 0)
+	limits, privateKey, err := endpoint.orders.CreateGetOrderLimits(ctx, bucket, keyInfo.Head, segment, 0)
 	if err != nil {
 		if orders.ErrDownloadFailedNotEnoughPieces.Has(err) {
 			endpoint.log.Error("Unable to create order limits.",
@@ -623,6 +670,7 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		endpoint.log.Error("internal", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
+	endpoint.updateSharedLinkUsage(ctx, req.Header, objectLocation, limits, 0, 0)
 
 	endpoint.versionCollector.collectTransferStats(req.Header.UserAgent, download, int(segment.EncryptedSize))
 
@@ -663,5 +711,11 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 func (endpoint *Endpoint) DeletePart(ctx context.Context, req *pb.PartDeleteRequest) (resp *pb.PartDeleteResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	ctx, release, err := endpoint.admit(ctx, priorityNormal)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	return &pb.PartDeleteResponse{}, nil
 }
@@ -0,0 +1,127 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+
+	"storj.io/common/rpc/rpcstatus"
+)
+
+// ErrorCode is a stable, machine readable code of a metainfo API failure.
+//
+// The codes are part of the API, they must not be changed or reused.
+type ErrorCode string
+
+const (
+	// ErrorCodeBandwidthLimitExceeded is used when the monthly bandwidth limit of the project is exceeded.
+	ErrorCodeBandwidthLimitExceeded = ErrorCode("bandwidth_limit_exceeded")
+	// ErrorCodeStorageLimitExceeded is used when the storage limit of the project is exceeded.
+	ErrorCodeStorageLimitExceeded = ErrorCode("storage_limit_exceeded")
+	// ErrorCodeSegmentsLimitExceeded is used when the segments limit of the project is exceeded.
+	ErrorCodeSegmentsLimitExceeded = ErrorCode("segments_limit_exceeded")
+	// ErrorCodeUploadLimitExceeded is used when the storage or segments limit of the project
+	// would be exceeded by an upload.
+	ErrorCodeUploadLimitExceeded = ErrorCode("upload_limit_exceeded")
+	// ErrorCodeBucketsLimitExceeded is used when the maximum number of buckets of the project is reached.
+	ErrorCodeBucketsLimitExceeded = ErrorCode("buckets_limit_exceeded")
+	// ErrorCodeBucketObjectsQuotaExceeded is used when the object count quota of the bucket is reached.
+	ErrorCodeBucketObjectsQuotaExceeded = ErrorCode("bucket_objects_quota_exceeded")
+	// ErrorCodeBucketBytesQuotaExceeded is used when the size quota of the bucket is reached.
+	ErrorCodeBucketBytesQuotaExceeded = ErrorCode("bucket_bytes_quota_exceeded")
+	// ErrorCodeRateLimited is used when the request rate limit of the project is exceeded.
+	ErrorCodeRateLimited = ErrorCode("rate_limited")
+	// ErrorCodeWritesFrozen is used when writes are rejected during a maintenance window.
+	ErrorCodeWritesFrozen = ErrorCode("writes_frozen")
+	// ErrorCodeProjectFrozen is used when the project is frozen, e.g. because
+	// an invoice of its owner wasn't paid.
+	ErrorCodeProjectFrozen = ErrorCode("project_frozen")
+	// ErrorCodeProjectDeleted is used when the project is deleted and can
+	// only be restored by its owner or an admin.
+	ErrorCodeProjectDeleted = ErrorCode("project_deleted")
+	// ErrorCodeOverloaded is used when the request is rejected because the satellite
+	// is overloaded. The request can be retried later.
+	ErrorCodeOverloaded = ErrorCode("overloaded")
+
+	// ErrorCodeBucketNotFound is used when the bucket of the request doesn't exist.
+	ErrorCodeBucketNotFound = ErrorCode("bucket_not_found")
+	// ErrorCodeObjectNotFound is used when the object of the request doesn't exist.
+	ErrorCodeObjectNotFound = ErrorCode("object_not_found")
+	// ErrorCodeSegmentNotFound is used when the segment of the request doesn't exist.
+	ErrorCodeSegmentNotFound = ErrorCode("segment_not_found")
+
+	// ErrorCodeBucketAlreadyExists is used when creating a bucket which already exists.
+	ErrorCodeBucketAlreadyExists = ErrorCode("bucket_already_exists")
+	// ErrorCodeObjectAlreadyExists is used when committing an object which already exists.
+	ErrorCodeObjectAlreadyExists = ErrorCode("object_already_exists")
+	// ErrorCodeObjectModified is used when the object was replaced by another upload
+	// since the client read it.
+	ErrorCodeObjectModified = ErrorCode("object_modified")
+
+	// ErrorCodeInvalidArgument is used when the request failed validation.
+	ErrorCodeInvalidArgument = ErrorCode("invalid_argument")
+	// ErrorCodeObjectKeyTooLong is used when the object key is longer than the maximum allowed.
+	ErrorCodeObjectKeyTooLong = ErrorCode("object_key_too_long")
+	// ErrorCodeMetadataTooLarge is used when the object metadata is larger than the maximum allowed.
+	ErrorCodeMetadataTooLarge = ErrorCode("metadata_too_large")
+)
+
+// ErrorDetails are the machine readable details of a metainfo API failure.
+type ErrorDetails struct {
+	Code ErrorCode
+	// Bucket is the bucket of the failed request, when the failure is about a bucket.
+	Bucket string
+	// Limit is the exceeded limit, when the failure is about an exceeded limit
+	// and the limit is known.
+	Limit int64
+	// RetryAfter is how long to wait before the request can succeed, when the
+	// failure is about an exceeded rate limit.
+	RetryAfter time.Duration
+}
+
+// detailedError is an rpc status error, which carries the details of the failure.
+type detailedError struct {
+	error
+	details ErrorDetails
+}
+
+// Unwrap returns the rpc status error.
+func (err *detailedError) Unwrap() error { return err.error }
+
+// newDetailedError returns an rpc status error with the provided status code
+// and message, which carries details.
+//
+// The message of the error is kept as is, because older clients use it to
+// recognize the failure.
+func newDetailedError(status rpcstatus.StatusCode, details ErrorDetails, message string) error {
+	mon.Counter("metainfo_error", monkit.NewSeriesTag("code", string(details.Code))).Inc(1)
+
+	return &detailedError{
+		error:   rpcstatus.Error(status, message),
+		details: details,
+	}
+}
+
+// GetErrorDetails returns the details of an error returned by the metainfo
+// API, when it has any.
+func GetErrorDetails(err error) (ErrorDetails, bool) {
+	var detailed *detailedError
+	if !errors.As(err, &detailed) {
+		return ErrorDetails{}, false
+	}
+	return detailed.details, true
+}
+
+// newBucketNotFoundError returns the error of a request to a bucket, which
+// doesn't exist.
+func newBucketNotFoundError(format string, bucket []byte) error {
+	return newDetailedError(rpcstatus.NotFound, ErrorDetails{
+		Code:   ErrorCodeBucketNotFound,
+		Bucket: string(bucket),
+	}, fmt.Sprintf(format, bucket))
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/errs2"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/buckets"
+)
+
+func TestDetailedError(t *testing.T) {
+	err := newBucketNotFoundError("bucket not found: %s", []byte("testbucket"))
+
+	// the status code and the message must be kept for older clients.
+	require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+	require.Equal(t, "bucket not found: testbucket", err.Error())
+
+	details, ok := GetErrorDetails(errs.Wrap(err))
+	require.True(t, ok)
+	require.Equal(t, ErrorDetails{
+		Code:   ErrorCodeBucketNotFound,
+		Bucket: "testbucket",
+	}, details)
+
+	_, ok = GetErrorDetails(rpcstatus.Error(rpcstatus.NotFound, "bucket not found: testbucket"))
+	require.False(t, ok)
+}
+
+func TestBucketQuotaError(t *testing.T) {
+	maxObjects, maxBytes := int64(10), int64(1000)
+	quota := buckets.Quota{MaxObjects: &maxObjects, MaxBytes: &maxBytes}
+
+	require.NoError(t, bucketQuotaError([]byte("testbucket"), buckets.Quota{}, accounting.BucketTally{ObjectCount: 100, TotalBytes: 10000}))
+	require.NoError(t, bucketQuotaError([]byte("testbucket"), quota, accounting.BucketTally{ObjectCount: 9, TotalBytes: 999}))
+
+	err := bucketQuotaError([]byte("testbucket"), quota, accounting.BucketTally{ObjectCount: 10, TotalBytes: 999})
+	require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+	details, ok := GetErrorDetails(err)
+	require.True(t, ok)
+	require.Equal(t, ErrorDetails{
+		Code:   ErrorCodeBucketObjectsQuotaExceeded,
+		Bucket: "testbucket",
+		Limit:  maxObjects,
+	}, details)
+
+	err = bucketQuotaError([]byte("testbucket"), quota, accounting.BucketTally{ObjectCount: 9, TotalBytes: 1000})
+	require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+	details, ok = GetErrorDetails(err)
+	require.True(t, ok)
+	require.Equal(t, ErrorDetails{
+		Code:   ErrorCodeBucketBytesQuotaExceeded,
+		Bucket: "testbucket",
+		Limit:  maxBytes,
+	}, details)
+}
@@ -11,8 +11,10 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo/piecedeletion"
 )
 
 var (
@@ -23,29 +25,43 @@ var (
 
 // Config contains configurable values for expired segment cleanup.
 type Config struct {
-	Interval  time.Duration `help:"the time between each attempt to go through the db and clean up expired segments" releaseDefault:"24h" devDefault:"10s" testDefault:"$TESTINTERVAL"`
-	Enabled   bool          `help:"set if expired segment cleanup is enabled or not" releaseDefault:"true" devDefault:"true"`
-	ListLimit int           `help:"how many expired objects to query in a batch" default:"100"`
+	Interval     time.Duration `help:"the time between each attempt to go through the db and clean up expired segments" releaseDefault:"24h" devDefault:"10s" testDefault:"$TESTINTERVAL"`
+	Enabled      bool          `help:"set if expired segment cleanup is enabled or not" releaseDefault:"true" devDefault:"true"`
+	ListLimit    int           `help:"how many expired objects to query in a batch" default:"100"`
+	MaxRuntime   time.Duration `help:"maximum time spent deleting expired objects in a single cycle, zero means no limit" default:"0s"`
+	DeletePieces bool          `help:"send delete requests to the storage nodes for the pieces of the expired segments, instead of leaving them to garbage collection" default:"false"`
+
+	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
 }
 
+// deletePiecesSuccessThreshold is the fraction of the pieces that must be
+// deleted for the request to be considered successful. Pieces that weren't
+// deleted are collected by garbage collection.
+const deletePiecesSuccessThreshold = 0.75
+
 // Chore implements the expired segment cleanup chore.
 //
 // architecture: Chore
 type Chore struct {
-	log      *zap.Logger
-	config   Config
-	metabase *metabase.DB
+	log          *zap.Logger
+	config       Config
+	metabase     *metabase.DB
+	pieceDeleter *piecedeletion.Service
 
 	nowFn func() time.Time
 	Loop  *sync2.Cycle
 }
 
 // NewChore creates a new instance of the expireddeletion chore.
-func NewChore(log *zap.Logger, config Config, metabase *metabase.DB) *Chore {
+//
+// pieceDeleter is used to delete the pieces of the expired segments from the
+// storage nodes. When nil, the pieces are left to garbage collection.
+func NewChore(log *zap.Logger, config Config, metabase *metabase.DB, pieceDeleter *piecedeletion.Service) *Chore {
 	return &Chore{
-		log:      log,
-		config:   config,
-		metabase: metabase,
+		log:          log,
+		config:       config,
+		metabase:     metabase,
+		pieceDeleter: pieceDeleter,
 
 		nowFn: time.Now,
 		Loop:  sync2.NewCycle(config.Interval),
@@ -78,15 +94,62 @@ func (chore *Chore) deleteExpiredObjects(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	chore.log.Debug("deleting expired objects")
 
+	if chore.config.MaxRuntime > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, chore.config.MaxRuntime)
+		defer cancel()
+	}
+
+	opts := metabase.DeleteExpiredObjects{
+		ExpiredBefore:  chore.nowFn(),
+		AsOfSystemTime: time.Now().Add(chore.config.AsOfSystemInterval),
+		BatchSize:      chore.config.ListLimit,
+	}
+	if chore.pieceDeleter != nil {
+		opts.DeletePieces = chore.deletePieces
+	}
+
 	// TODO log error instead of crashing core until we will be sure
 	// that queries for deleting expired objects are stable
-	err = chore.metabase.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
-		ExpiredBefore: chore.nowFn(),
-		BatchSize:     chore.config.ListLimit,
-	})
-	if err != nil {
+	err = chore.metabase.DeleteExpiredObjects(ctx, opts)
+	switch {
+	case err == nil:
+	case errs.Is(ctx.Err(), context.DeadlineExceeded):
+		// the remaining objects will be deleted in the next cycle.
+		chore.log.Info("deleting expired objects reached the maximum runtime", zap.Duration("Max Runtime", chore.config.MaxRuntime))
+	default:
 		chore.log.Error("deleting expired objects failed", zap.Error(err))
 	}
 
 	return nil
 }
+
+// deletePieces sends delete requests for the pieces of the deleted segments
+// to the storage nodes. Failures are only logged, the pieces which weren't
+// deleted are collected by garbage collection.
+func (chore *Chore) deletePieces(ctx context.Context, segments []metabase.DeletedSegmentInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodesPieces := map[storj.NodeID][]storj.PieceID{}
+	for _, segment := range segments {
+		deriver := segment.RootPieceID.Deriver()
+		for _, piece := range segment.Pieces {
+			pieceID := deriver.Derive(piece.StorageNode, int32(piece.Number))
+			nodesPieces[piece.StorageNode] = append(nodesPieces[piece.StorageNode], pieceID)
+		}
+	}
+
+	requests := make([]piecedeletion.Request, 0, len(nodesPieces))
+	for node, pieces := range nodesPieces {
+		requests = append(requests, piecedeletion.Request{
+			Node:   storj.NodeURL{ID: node},
+			Pieces: pieces,
+		})
+	}
+
+	err = chore.pieceDeleter.Delete(ctx, requests, deletePiecesSuccessThreshold)
+	if err != nil {
+		chore.log.Error("failed to delete pieces of expired objects", zap.Error(err))
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/storagenode/pieces"
 )
 
 func TestExpiredDeletion(t *testing.T) {
@@ -65,6 +66,63 @@ func TestExpiredDeletion(t *testing.T) {
 	})
 }
 
+func TestExpiredDeletionDeletesPieces(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				testplanet.ReconfigureRS(2, 3, 4, 4),
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					config.ExpiredDeletion.DeletePieces = true
+				},
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		expiredChore := satellite.Core.ExpiredDeletion.Chore
+
+		expiredChore.Loop.Pause()
+
+		countPieces := func() (count int) {
+			for _, node := range planet.StorageNodes {
+				err := node.Storage2.Store.WalkSatellitePieces(ctx, satellite.ID(),
+					func(pieces.StoredPieceAccess) error {
+						count++
+						return nil
+					},
+				)
+				require.NoError(t, err)
+			}
+			return count
+		}
+
+		err := upl.Upload(ctx, satellite, "testbucket", "remote_no_expire", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+		noExpirePieces := countPieces()
+		require.NotZero(t, noExpirePieces)
+
+		err = upl.UploadWithExpiration(ctx, satellite, "testbucket", "remote_expire", testrand.Bytes(8*memory.KiB), time.Now().Add(1*time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, planet.WaitForStorageNodeEndpoints(ctx))
+		require.Greater(t, countPieces(), noExpirePieces)
+
+		expiredChore.SetNow(func() time.Time {
+			return time.Now().Add(2 * time.Hour)
+		})
+		expiredChore.Loop.TriggerWait()
+		planet.WaitForStorageNodeDeleters(ctx)
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		// the pieces of the expired object are deleted without waiting for
+		// the storage nodes to collect them.
+		require.Equal(t, noExpirePieces, countPieces())
+	})
+}
+
 func TestExpiresAtForSegmentsAfterCopy(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
@@ -0,0 +1,197 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"sync"
+	"time"
+
+	"storj.io/common/pb"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ListingCacheConfig is a configuration struct for the cache of object
+// listings of frequently listed prefixes.
+type ListingCacheConfig struct {
+	Enabled      bool          `help:"whether object listings of frequently listed prefixes are cached" default:"false"`
+	TTL          time.Duration `help:"how long an object listing is cached" default:"5s"`
+	HotThreshold int           `help:"number of listings of a prefix within the ttl from which the listings of the prefix are cached" default:"3"`
+	Capacity     int           `help:"maximum number of cached prefixes" default:"10000"`
+}
+
+// listingPrefix is a listed prefix of a bucket.
+type listingPrefix struct {
+	ProjectID  uuid.UUID
+	BucketName string
+	Prefix     metabase.ObjectKey
+}
+
+// listingRequest contains the parameters of a listing, which aren't part of
+// the listed prefix.
+type listingRequest struct {
+	Cursor                string
+	Recursive             bool
+	Limit                 int
+	Status                metabase.ObjectStatus
+	IncludeCustomMetadata bool
+	IncludeSystemMetadata bool
+}
+
+// cachedPrefix contains the cached listings of a prefix.
+type cachedPrefix struct {
+	// windowStart and listings are used to count the listings of the prefix
+	// within the ttl to decide whether the prefix is hot.
+	windowStart time.Time
+	listings    int
+
+	responses map[listingRequest]cachedListing
+}
+
+// cachedListing is a cached listing response.
+type cachedListing struct {
+	response  *pb.ObjectListResponse
+	expiresAt time.Time
+}
+
+// listingCache caches the object listings of hot prefixes for a short time.
+// The listings of a prefix are invalidated when an object with the prefix
+// is written through this endpoint, writes through other satellite API
+// instances are visible once the cached listing expires.
+type listingCache struct {
+	config ListingCacheConfig
+	nowFn  func() time.Time
+
+	mu       sync.Mutex
+	prefixes map[listingPrefix]*cachedPrefix
+}
+
+func newListingCache(config ListingCacheConfig) *listingCache {
+	return &listingCache{
+		config:   config,
+		nowFn:    time.Now,
+		prefixes: map[listingPrefix]*cachedPrefix{},
+	}
+}
+
+// get returns the cached listing for the prefix and the request and records
+// the listing of the prefix. The returned response must not be modified.
+func (cache *listingCache) get(prefix listingPrefix, req listingRequest) (_ *pb.ObjectListResponse, ok bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	now := cache.nowFn()
+
+	cached, exists := cache.prefixes[prefix]
+	if !exists {
+		if len(cache.prefixes) >= cache.config.Capacity {
+			cache.evictExpired(now)
+			if len(cache.prefixes) >= cache.config.Capacity {
+				return nil, false
+			}
+		}
+		cached = &cachedPrefix{windowStart: now}
+		cache.prefixes[prefix] = cached
+	}
+
+	if now.Sub(cached.windowStart) > cache.config.TTL {
+		cached.windowStart = now
+		cached.listings = 0
+	}
+	cached.listings++
+
+	listing, ok := cached.responses[req]
+	if !ok {
+		return nil, false
+	}
+	if !now.Before(listing.expiresAt) {
+		delete(cached.responses, req)
+		return nil, false
+	}
+
+	mon.Meter("metainfo_listing_cache_hit").Mark(1)
+	return listing.response, true
+}
+
+// put caches the listing for the prefix and the request, when the prefix is hot.
+func (cache *listingCache) put(prefix listingPrefix, req listingRequest, response *pb.ObjectListResponse) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cached, exists := cache.prefixes[prefix]
+	if !exists || cached.listings < cache.config.HotThreshold {
+		return
+	}
+
+	if cached.responses == nil {
+		cached.responses = map[listingRequest]cachedListing{}
+	}
+	cached.responses[req] = cachedListing{
+		response:  response,
+		expiresAt: cache.nowFn().Add(cache.config.TTL),
+	}
+}
+
+// invalidate removes the cached listings of all prefixes of the object key.
+func (cache *listingCache) invalidate(location metabase.ObjectLocation) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	prefix := listingPrefix{
+		ProjectID:  location.ProjectID,
+		BucketName: location.BucketName,
+	}
+	delete(cache.prefixes, prefix)
+
+	key := location.ObjectKey
+	for i := 0; i < len(key); i++ {
+		if key[i] == metabase.Delimiter {
+			prefix.Prefix = key[:i+1]
+			delete(cache.prefixes, prefix)
+		}
+	}
+}
+
+// invalidateBucket removes the cached listings of all prefixes of the bucket.
+func (cache *listingCache) invalidateBucket(bucket metabase.BucketLocation) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	for prefix := range cache.prefixes {
+		if prefix.ProjectID == bucket.ProjectID && prefix.BucketName == bucket.BucketName {
+			delete(cache.prefixes, prefix)
+		}
+	}
+}
+
+// evictExpired removes the prefixes, which don't have any unexpired listings
+// and which weren't listed recently.
+func (cache *listingCache) evictExpired(now time.Time) {
+	for prefix, cached := range cache.prefixes {
+		for req, listing := range cached.responses {
+			if !now.Before(listing.expiresAt) {
+				delete(cached.responses, req)
+			}
+		}
+		if len(cached.responses) == 0 && now.Sub(cached.windowStart) > cache.config.TTL {
+			delete(cache.prefixes, prefix)
+		}
+	}
+}
+
+// invalidateListing removes the cached listings, which contain the object.
+func (endpoint *Endpoint) invalidateListing(location metabase.ObjectLocation) {
+	if endpoint.listingCache == nil {
+		return
+	}
+	endpoint.listingCache.invalidate(location)
+}
+
+// invalidateBucketListing removes the cached listings of the bucket.
+func (endpoint *Endpoint) invalidateBucketListing(bucket metabase.BucketLocation) {
+	if endpoint.listingCache == nil {
+		return
+	}
+	endpoint.listingCache.invalidateBucket(bucket)
+}
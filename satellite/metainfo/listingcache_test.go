@@ -0,0 +1,137 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestListingCache(t *testing.T) {
+	config := ListingCacheConfig{
+		Enabled:      true,
+		TTL:          5 * time.Second,
+		HotThreshold: 3,
+		Capacity:     2,
+	}
+
+	projectID := testrand.UUID()
+	prefix := listingPrefix{ProjectID: projectID, BucketName: "bucket", Prefix: "a/b/"}
+	req := listingRequest{Limit: 100, Status: metabase.Committed}
+	response := &pb.ObjectListResponse{More: true}
+
+	newCache := func() (*listingCache, *time.Time) {
+		now := time.Now()
+		cache := newListingCache(config)
+		cache.nowFn = func() time.Time { return now }
+		return cache, &now
+	}
+
+	// list lists the prefix until the listing is cached.
+	list := func(cache *listingCache, prefix listingPrefix) {
+		for i := 0; i < config.HotThreshold; i++ {
+			_, ok := cache.get(prefix, req)
+			require.False(t, ok)
+			cache.put(prefix, req, response)
+		}
+	}
+
+	t.Run("hot threshold", func(t *testing.T) {
+		cache, _ := newCache()
+
+		for i := 0; i < config.HotThreshold-1; i++ {
+			_, ok := cache.get(prefix, req)
+			require.False(t, ok)
+			cache.put(prefix, req, response)
+		}
+
+		_, ok := cache.get(prefix, req)
+		require.False(t, ok)
+		cache.put(prefix, req, response)
+
+		cached, ok := cache.get(prefix, req)
+		require.True(t, ok)
+		require.Equal(t, response, cached)
+
+		_, ok = cache.get(prefix, listingRequest{Limit: 1, Status: metabase.Committed})
+		require.False(t, ok)
+	})
+
+	t.Run("ttl", func(t *testing.T) {
+		cache, now := newCache()
+		list(cache, prefix)
+
+		*now = now.Add(config.TTL - time.Millisecond)
+		_, ok := cache.get(prefix, req)
+		require.True(t, ok)
+
+		*now = now.Add(time.Millisecond)
+		_, ok = cache.get(prefix, req)
+		require.False(t, ok)
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		cache, _ := newCache()
+
+		root := listingPrefix{ProjectID: projectID, BucketName: "bucket"}
+		parent := listingPrefix{ProjectID: projectID, BucketName: "bucket", Prefix: "a/"}
+		sibling := listingPrefix{ProjectID: projectID, BucketName: "bucket", Prefix: "a/c/"}
+		other := listingPrefix{ProjectID: projectID, BucketName: "other", Prefix: "a/b/"}
+
+		cache.config.Capacity = 10
+		for _, p := range []listingPrefix{root, parent, prefix, sibling, other} {
+			list(cache, p)
+		}
+
+		cache.invalidate(metabase.ObjectLocation{
+			ProjectID:  projectID,
+			BucketName: "bucket",
+			ObjectKey:  "a/b/object",
+		})
+
+		for _, p := range []listingPrefix{root, parent, prefix} {
+			_, ok := cache.get(p, req)
+			require.False(t, ok, p.Prefix)
+		}
+		for _, p := range []listingPrefix{sibling, other} {
+			_, ok := cache.get(p, req)
+			require.True(t, ok, p.Prefix)
+		}
+
+		cache.invalidateBucket(metabase.BucketLocation{ProjectID: projectID, BucketName: "bucket"})
+		_, ok := cache.get(sibling, req)
+		require.False(t, ok)
+		_, ok = cache.get(other, req)
+		require.True(t, ok)
+	})
+
+	t.Run("capacity", func(t *testing.T) {
+		cache, now := newCache()
+
+		first := listingPrefix{ProjectID: projectID, BucketName: "bucket", Prefix: "first/"}
+		second := listingPrefix{ProjectID: projectID, BucketName: "bucket", Prefix: "second/"}
+		list(cache, first)
+		list(cache, second)
+
+		// the cache is full, so the prefix isn't tracked.
+		for i := 0; i < config.HotThreshold+1; i++ {
+			_, ok := cache.get(prefix, req)
+			require.False(t, ok)
+			cache.put(prefix, req, response)
+		}
+
+		// expired prefixes are evicted to make space.
+		*now = now.Add(2 * config.TTL)
+		list(cache, prefix)
+		_, ok := cache.get(prefix, req)
+		require.True(t, ok)
+		require.Len(t, cache.prefixes, 1)
+	})
+}
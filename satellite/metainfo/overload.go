@@ -0,0 +1,198 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+
+	"storj.io/common/rpc/rpcstatus"
+)
+
+// OverloadConfig is a configuration struct for the adaptive concurrency limit
+// of the endpoint, which sheds load when the latency of the requests grows.
+type OverloadConfig struct {
+	Enabled             bool          `help:"whether requests are rejected when the satellite is overloaded" default:"false"`
+	InitialLimit        int           `help:"initial number of concurrent requests" default:"1000"`
+	MinLimit            int           `help:"minimum number of concurrent requests" default:"100"`
+	MaxLimit            int           `help:"maximum number of concurrent requests" default:"10000"`
+	Tolerance           float64       `help:"how many times the long term latency the recent latency may be before the limit is lowered" default:"2"`
+	SampleWindow        time.Duration `help:"how often the limit is adjusted from the latency of the finished requests" default:"1s"`
+	NormalPriorityShare float64       `help:"share of the limit which can be used by uploads, deletes and other requests which aren't downloads" default:"0.9"`
+	LowPriorityShare    float64       `help:"share of the limit which can be used by listing requests" default:"0.6"`
+}
+
+// requestPriority is how important it is to admit a request when the
+// satellite is overloaded.
+type requestPriority int
+
+const (
+	// priorityLow is used for listing requests.
+	priorityLow requestPriority = iota
+	// priorityNormal is used for requests, which don't have another priority.
+	priorityNormal
+	// priorityHigh is used for downloads.
+	priorityHigh
+)
+
+// String implements fmt.Stringer.
+func (priority requestPriority) String() string {
+	switch priority {
+	case priorityLow:
+		return "low"
+	case priorityNormal:
+		return "normal"
+	case priorityHigh:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	// latencySmoothing is how much a sample window affects the long term latency.
+	latencySmoothing = 0.05
+	// limitSmoothing is how much a newly computed limit replaces the previous one.
+	limitSmoothing = 0.2
+)
+
+// concurrencyLimiter limits the number of concurrent requests. The limit is
+// adjusted by comparing the recent latency with the long term latency, the
+// limit decreases as soon as the recent latency grows and increases slowly
+// while the latency is stable, similarly to a gradient concurrency limit.
+type concurrencyLimiter struct {
+	config OverloadConfig
+	nowFn  func() time.Time
+
+	mu       sync.Mutex
+	limit    float64
+	inflight int
+
+	// longLatency is the exponentially smoothed average latency.
+	longLatency float64
+
+	windowStart time.Time
+	windowSum   time.Duration
+	windowCount int
+}
+
+func newConcurrencyLimiter(config OverloadConfig) *concurrencyLimiter {
+	limiter := &concurrencyLimiter{
+		config: config,
+		nowFn:  time.Now,
+		limit:  float64(config.InitialLimit),
+	}
+	limiter.windowStart = limiter.nowFn()
+	return limiter
+}
+
+// acquire admits a request of the provided priority when the number of
+// concurrent requests is below the share of the limit of the priority. The
+// returned function must be called when the request finishes.
+func (limiter *concurrencyLimiter) acquire(priority requestPriority) (release func(), ok bool) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	if float64(limiter.inflight) >= limiter.limit*limiter.share(priority) {
+		return nil, false
+	}
+	limiter.inflight++
+
+	start := limiter.nowFn()
+	var once sync.Once
+	return func() {
+		once.Do(func() { limiter.release(start) })
+	}, true
+}
+
+// share returns which share of the limit can be used by requests with the priority.
+func (limiter *concurrencyLimiter) share(priority requestPriority) float64 {
+	switch priority {
+	case priorityLow:
+		return limiter.config.LowPriorityShare
+	case priorityNormal:
+		return limiter.config.NormalPriorityShare
+	default:
+		return 1
+	}
+}
+
+// release records the latency of a finished request and adjusts the limit
+// when the sample window has passed.
+func (limiter *concurrencyLimiter) release(start time.Time) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	now := limiter.nowFn()
+	limiter.inflight--
+	limiter.windowSum += now.Sub(start)
+	limiter.windowCount++
+
+	if now.Sub(limiter.windowStart) < limiter.config.SampleWindow {
+		return
+	}
+
+	limiter.adjust(limiter.windowSum.Seconds() / float64(limiter.windowCount))
+
+	limiter.windowStart = now
+	limiter.windowSum = 0
+	limiter.windowCount = 0
+}
+
+// adjust updates the limit with the average latency of the last sample window.
+func (limiter *concurrencyLimiter) adjust(shortLatency float64) {
+	if shortLatency <= 0 {
+		return
+	}
+
+	if limiter.longLatency == 0 {
+		limiter.longLatency = shortLatency
+	} else {
+		limiter.longLatency = limiter.longLatency*(1-latencySmoothing) + shortLatency*latencySmoothing
+	}
+	// let the long term latency recover quickly, when it grew during an incident.
+	if limiter.longLatency/shortLatency > 2 {
+		limiter.longLatency *= 0.95
+	}
+
+	gradient := math.Max(0.5, math.Min(1, limiter.config.Tolerance*limiter.longLatency/shortLatency))
+
+	// allow the limit to grow while the latency is stable.
+	queueSize := math.Sqrt(limiter.limit)
+	newLimit := limiter.limit*gradient + queueSize
+	newLimit = limiter.limit*(1-limitSmoothing) + newLimit*limitSmoothing
+	newLimit = math.Max(float64(limiter.config.MinLimit), math.Min(float64(limiter.config.MaxLimit), newLimit))
+
+	limiter.limit = newLimit
+
+	mon.FloatVal("metainfo_concurrency_limit").Observe(newLimit)
+	mon.FloatVal("metainfo_concurrency_latency_short_seconds").Observe(shortLatency)
+	mon.FloatVal("metainfo_concurrency_latency_long_seconds").Observe(limiter.longLatency)
+}
+
+// admittedKey is the context key, which marks requests that were already
+// admitted, so that the requests of a batch aren't admitted again.
+type admittedKey struct{}
+
+// admit admits the request with the priority or returns a retryable error when
+// the satellite is overloaded. The returned function must be called when the
+// request finishes.
+func (endpoint *Endpoint) admit(ctx context.Context, priority requestPriority) (_ context.Context, release func(), err error) {
+	if endpoint.overload == nil || ctx.Value(admittedKey{}) != nil {
+		return ctx, func() {}, nil
+	}
+
+	release, ok := endpoint.overload.acquire(priority)
+	if !ok {
+		mon.Event("metainfo_overloaded", monkit.NewSeriesTag("priority", priority.String()))
+		return ctx, nil, newDetailedError(rpcstatus.Unavailable, ErrorDetails{Code: ErrorCodeOverloaded},
+			"satellite is overloaded, retry later")
+	}
+
+	return context.WithValue(ctx, admittedKey{}, struct{}{}), release, nil
+}
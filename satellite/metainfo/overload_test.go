@@ -0,0 +1,101 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+)
+
+func TestConcurrencyLimiter(t *testing.T) {
+	config := OverloadConfig{
+		Enabled:             true,
+		InitialLimit:        10,
+		MinLimit:            5,
+		MaxLimit:            100,
+		Tolerance:           2,
+		SampleWindow:        time.Second,
+		NormalPriorityShare: 0.8,
+		LowPriorityShare:    0.5,
+	}
+
+	t.Run("priorities", func(t *testing.T) {
+		limiter := newConcurrencyLimiter(config)
+
+		var releases []func()
+		acquire := func(priority requestPriority) bool {
+			release, ok := limiter.acquire(priority)
+			if ok {
+				releases = append(releases, release)
+			}
+			return ok
+		}
+
+		for i := 0; i < 5; i++ {
+			require.True(t, acquire(priorityLow))
+		}
+		require.False(t, acquire(priorityLow))
+
+		for i := 0; i < 3; i++ {
+			require.True(t, acquire(priorityNormal))
+		}
+		require.False(t, acquire(priorityNormal))
+
+		for i := 0; i < 2; i++ {
+			require.True(t, acquire(priorityHigh))
+		}
+		require.False(t, acquire(priorityHigh))
+
+		// releasing twice must not free two slots.
+		releases[0]()
+		releases[0]()
+		require.True(t, acquire(priorityHigh))
+		require.False(t, acquire(priorityHigh))
+	})
+
+	t.Run("adjust", func(t *testing.T) {
+		now := time.Now()
+		limiter := newConcurrencyLimiter(config)
+		limiter.nowFn = func() time.Time { return now }
+
+		run := func(latency time.Duration) {
+			release, ok := limiter.acquire(priorityHigh)
+			require.True(t, ok)
+			now = now.Add(latency)
+			release()
+			now = now.Add(config.SampleWindow)
+		}
+
+		// the limit grows while the latency is stable.
+		for i := 0; i < 20; i++ {
+			run(10 * time.Millisecond)
+		}
+		stable := limiter.limit
+		require.Greater(t, stable, float64(config.InitialLimit))
+
+		// the limit shrinks when the latency grows.
+		for i := 0; i < 5; i++ {
+			run(time.Second)
+		}
+		require.Less(t, limiter.limit, stable)
+		require.GreaterOrEqual(t, limiter.limit, float64(config.MinLimit))
+	})
+}
+
+func TestBatchPriority(t *testing.T) {
+	batch := func(items ...*pb.BatchRequestItem) *pb.BatchRequest {
+		return &pb.BatchRequest{Requests: items}
+	}
+	download := &pb.BatchRequestItem{Request: &pb.BatchRequestItem_SegmentDownload{}}
+	upload := &pb.BatchRequestItem{Request: &pb.BatchRequestItem_SegmentBegin{}}
+	list := &pb.BatchRequestItem{Request: &pb.BatchRequestItem_ObjectList{}}
+
+	require.Equal(t, priorityHigh, batchPriority(batch(download)))
+	require.Equal(t, priorityNormal, batchPriority(batch(download, upload)))
+	require.Equal(t, priorityLow, batchPriority(batch(upload, list)))
+}
@@ -0,0 +1,238 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/lrucache"
+	"storj.io/common/macaroon"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/tuning"
+)
+
+// rateLimitClass groups the requests, which are limited by the same token
+// bucket.
+type rateLimitClass int
+
+const (
+	// rateLimitDefault is the class of the cheap requests.
+	rateLimitDefault rateLimitClass = iota
+	// rateLimitExpensive is the class of the requests, which list or delete
+	// objects, because they may touch many objects at once.
+	rateLimitExpensive
+)
+
+// String returns the name of the class.
+func (class rateLimitClass) String() string {
+	switch class {
+	case rateLimitDefault:
+		return "default"
+	case rateLimitExpensive:
+		return "expensive"
+	default:
+		return "unknown"
+	}
+}
+
+// rateLimitClassOf returns the class of a request, which is authorized with
+// an action of the op.
+func rateLimitClassOf(op macaroon.ActionType) rateLimitClass {
+	switch op {
+	case macaroon.ActionList, macaroon.ActionDelete:
+		return rateLimitExpensive
+	default:
+		return rateLimitDefault
+	}
+}
+
+// scopedLimiter is a token bucket of a project or an API key.
+type scopedLimiter struct {
+	scope   string
+	id      uuid.UUID
+	limiter *rate.Limiter
+}
+
+// checkRate consumes a token from every token bucket, which limits the
+// request of the class made with the API key. The expensive requests have
+// independent token buckets from the other requests, when their rate is
+// configured.
+func (endpoint *Endpoint) checkRate(ctx context.Context, keyInfo *console.APIKeyInfo, class rateLimitClass) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	config, cache := endpoint.rateLimiter()
+	if !config.Enabled {
+		return nil
+	}
+
+	limiters, err := endpoint.getLimiters(ctx, config, cache, keyInfo, class)
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+
+	now := time.Now()
+	reservations := make([]*rate.Reservation, 0, len(limiters))
+	for _, scoped := range limiters {
+		reservation := scoped.limiter.ReserveN(now, 1)
+		reservations = append(reservations, reservation)
+
+		delay := reservation.DelayFrom(now)
+		if reservation.OK() && delay == 0 {
+			continue
+		}
+
+		// give back the tokens, because the request isn't handled.
+		for _, reservation := range reservations {
+			reservation.CancelAt(now)
+		}
+
+		endpoint.log.Warn("too many requests for "+scoped.scope,
+			zap.Stringer(scoped.scope+"ID", scoped.id),
+			zap.Stringer("class", class),
+			zap.Float64("rate limit", float64(scoped.limiter.Limit())),
+			zap.Float64("burst limit", float64(scoped.limiter.Burst())))
+
+		mon.Event("metainfo_rate_limit_exceeded") //mon:locked
+
+		details := ErrorDetails{
+			Code:  ErrorCodeRateLimited,
+			Limit: int64(math.Ceil(float64(scoped.limiter.Limit()))),
+		}
+		if reservation.OK() {
+			details.RetryAfter = delay
+		}
+		return newDetailedError(rpcstatus.ResourceExhausted, details, "Too Many Requests")
+	}
+
+	return nil
+}
+
+// getLimiters returns the token buckets, which limit the request of the class
+// made with the API key.
+func (endpoint *Endpoint) getLimiters(ctx context.Context, config RateLimiterConfig, cache *lrucache.ExpiringLRU, keyInfo *console.APIKeyInfo, class rateLimitClass) (_ []scopedLimiter, err error) {
+	var limiters []scopedLimiter
+
+	if class == rateLimitExpensive && config.ExpensiveRate > 0 {
+		limiter, err := cache.Get("expensive:"+keyInfo.ProjectID.String(), func() (interface{}, error) {
+			return newRateLimiter(config.ExpensiveRate), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		limiters = append(limiters, scopedLimiter{scope: "project", id: keyInfo.ProjectID, limiter: limiter.(*rate.Limiter)})
+	} else {
+		limiter, err := cache.Get(keyInfo.ProjectID.String(), func() (interface{}, error) {
+			rateLimit := rate.Limit(config.Rate)
+			burstLimit := int(config.Rate)
+
+			project, err := endpoint.projects.Get(ctx, keyInfo.ProjectID)
+			if err != nil {
+				return false, err
+			}
+			if project.RateLimit != nil {
+				rateLimit = rate.Limit(*project.RateLimit)
+				burstLimit = *project.RateLimit
+			}
+			// use the explicitly set burst value if it's defined
+			if project.BurstLimit != nil {
+				burstLimit = *project.BurstLimit
+			}
+
+			return rate.NewLimiter(rateLimit, burstLimit), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		limiters = append(limiters, scopedLimiter{scope: "project", id: keyInfo.ProjectID, limiter: limiter.(*rate.Limiter)})
+	}
+
+	keyRate, cacheKey := config.KeyRate, "key:"+keyInfo.ID.String()
+	if class == rateLimitExpensive && config.KeyExpensiveRate > 0 {
+		keyRate, cacheKey = config.KeyExpensiveRate, "key-expensive:"+keyInfo.ID.String()
+	}
+	if keyRate > 0 {
+		limiter, err := cache.Get(cacheKey, func() (interface{}, error) {
+			return newRateLimiter(keyRate), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		limiters = append(limiters, scopedLimiter{scope: "key", id: keyInfo.ID, limiter: limiter.(*rate.Limiter)})
+	}
+
+	return limiters, nil
+}
+
+// rateLimiter returns the rate limiter configuration and the cache of the
+// token buckets in use.
+func (endpoint *Endpoint) rateLimiter() (RateLimiterConfig, *lrucache.ExpiringLRU) {
+	endpoint.rateLimiterMu.RLock()
+	defer endpoint.rateLimiterMu.RUnlock()
+
+	return endpoint.config.RateLimiter, endpoint.limiterCache
+}
+
+// RateLimiterConfig returns the rate limiter configuration in use.
+func (endpoint *Endpoint) RateLimiterConfig() RateLimiterConfig {
+	config, _ := endpoint.rateLimiter()
+	return config
+}
+
+// SetRateLimiterConfig changes the rate limiter configuration. The cached
+// token buckets are dropped, so the changed rates apply immediately.
+func (endpoint *Endpoint) SetRateLimiterConfig(config RateLimiterConfig) {
+	endpoint.rateLimiterMu.Lock()
+	defer endpoint.rateLimiterMu.Unlock()
+
+	endpoint.config.RateLimiter = config
+	endpoint.limiterCache = lrucache.New(lrucache.Options{
+		Capacity:   config.CacheCapacity,
+		Expiration: config.CacheExpiration,
+	})
+}
+
+// TuningSettings returns the rate limiter settings, which can be changed at
+// runtime.
+func (endpoint *Endpoint) TuningSettings() []tuning.Setting {
+	update := func(fn func(config *RateLimiterConfig)) {
+		config := endpoint.RateLimiterConfig()
+		fn(&config)
+		endpoint.SetRateLimiterConfig(config)
+	}
+
+	return []tuning.Setting{
+		tuning.Bool("metainfo.rate-limiter.enabled", "whether rate limiting is enabled",
+			func() bool { return endpoint.RateLimiterConfig().Enabled },
+			func(v bool) { update(func(config *RateLimiterConfig) { config.Enabled = v }) }),
+		tuning.Float("metainfo.rate-limiter.rate", "request rate per project per second",
+			func() float64 { return endpoint.RateLimiterConfig().Rate },
+			func(v float64) { update(func(config *RateLimiterConfig) { config.Rate = v }) }),
+		tuning.Float("metainfo.rate-limiter.expensive-rate", "request rate per project per second of the requests, which list or delete objects",
+			func() float64 { return endpoint.RateLimiterConfig().ExpensiveRate },
+			func(v float64) { update(func(config *RateLimiterConfig) { config.ExpensiveRate = v }) }),
+		tuning.Float("metainfo.rate-limiter.key-rate", "request rate per API key per second",
+			func() float64 { return endpoint.RateLimiterConfig().KeyRate },
+			func(v float64) { update(func(config *RateLimiterConfig) { config.KeyRate = v }) }),
+		tuning.Float("metainfo.rate-limiter.key-expensive-rate", "request rate per API key per second of the requests, which list or delete objects",
+			func() float64 { return endpoint.RateLimiterConfig().KeyExpensiveRate },
+			func(v float64) { update(func(config *RateLimiterConfig) { config.KeyExpensiveRate = v }) }),
+	}
+}
+
+// newRateLimiter returns a token bucket with the rate per second, which
+// allows bursts of one second worth of requests.
+func newRateLimiter(perSecond float64) *rate.Limiter {
+	burst := int(perSecond)
+	if burst < 1 {
+		burst = 1
+	}
+	return rate.NewLimiter(rate.Limit(perSecond), burst)
+}
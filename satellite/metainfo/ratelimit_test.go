@@ -0,0 +1,142 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/errs2"
+	"storj.io/common/lrucache"
+	"storj.io/common/macaroon"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/console"
+)
+
+func TestRateLimitClassOf(t *testing.T) {
+	require.Equal(t, rateLimitExpensive, rateLimitClassOf(macaroon.ActionList))
+	require.Equal(t, rateLimitExpensive, rateLimitClassOf(macaroon.ActionDelete))
+	require.Equal(t, rateLimitDefault, rateLimitClassOf(macaroon.ActionRead))
+	require.Equal(t, rateLimitDefault, rateLimitClassOf(macaroon.ActionWrite))
+	require.Equal(t, rateLimitDefault, rateLimitClassOf(macaroon.ActionProjectInfo))
+}
+
+func TestCheckRate(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	newEndpoint := func(config RateLimiterConfig, keyInfo *console.APIKeyInfo) *Endpoint {
+		config.Enabled = true
+		endpoint := &Endpoint{
+			log:    zaptest.NewLogger(t),
+			config: Config{RateLimiter: config},
+			limiterCache: lrucache.New(lrucache.Options{
+				Capacity:   10,
+				Expiration: time.Hour,
+			}),
+		}
+		// the project limiter is cached, so that the project isn't looked up.
+		_, err := endpoint.limiterCache.Get(keyInfo.ProjectID.String(), func() (interface{}, error) {
+			return rate.NewLimiter(rate.Limit(config.Rate), int(config.Rate)), nil
+		})
+		require.NoError(t, err)
+		return endpoint
+	}
+
+	requireRateLimited := func(t *testing.T, err error, limit int64) {
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+		details, ok := GetErrorDetails(err)
+		require.True(t, ok)
+		require.Equal(t, ErrorCodeRateLimited, details.Code)
+		require.Equal(t, limit, details.Limit)
+		require.Greater(t, details.RetryAfter, time.Duration(0))
+	}
+
+	t.Run("shared project bucket", func(t *testing.T) {
+		keyInfo := &console.APIKeyInfo{ID: testrand.UUID(), ProjectID: testrand.UUID()}
+		endpoint := newEndpoint(RateLimiterConfig{Rate: 2}, keyInfo)
+
+		require.NoError(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault))
+		require.NoError(t, endpoint.checkRate(ctx, keyInfo, rateLimitExpensive))
+		requireRateLimited(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault), 2)
+	})
+
+	t.Run("independent expensive bucket", func(t *testing.T) {
+		keyInfo := &console.APIKeyInfo{ID: testrand.UUID(), ProjectID: testrand.UUID()}
+		endpoint := newEndpoint(RateLimiterConfig{Rate: 2, ExpensiveRate: 1}, keyInfo)
+
+		require.NoError(t, endpoint.checkRate(ctx, keyInfo, rateLimitExpensive))
+		requireRateLimited(t, endpoint.checkRate(ctx, keyInfo, rateLimitExpensive), 1)
+
+		// the cheap requests aren't affected by the expensive ones.
+		require.NoError(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault))
+		require.NoError(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault))
+		requireRateLimited(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault), 2)
+	})
+
+	t.Run("key buckets", func(t *testing.T) {
+		projectID := testrand.UUID()
+		keyInfo := &console.APIKeyInfo{ID: testrand.UUID(), ProjectID: projectID}
+		otherKeyInfo := &console.APIKeyInfo{ID: testrand.UUID(), ProjectID: projectID}
+		endpoint := newEndpoint(RateLimiterConfig{Rate: 10, KeyRate: 2, KeyExpensiveRate: 1}, keyInfo)
+
+		require.NoError(t, endpoint.checkRate(ctx, keyInfo, rateLimitExpensive))
+		requireRateLimited(t, endpoint.checkRate(ctx, keyInfo, rateLimitExpensive), 1)
+
+		require.NoError(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault))
+		require.NoError(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault))
+		requireRateLimited(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault), 2)
+
+		// the other keys of the project have their own buckets.
+		require.NoError(t, endpoint.checkRate(ctx, otherKeyInfo, rateLimitDefault))
+		require.NoError(t, endpoint.checkRate(ctx, otherKeyInfo, rateLimitExpensive))
+	})
+
+	t.Run("rejected requests don't consume tokens", func(t *testing.T) {
+		keyInfo := &console.APIKeyInfo{ID: testrand.UUID(), ProjectID: testrand.UUID()}
+		endpoint := newEndpoint(RateLimiterConfig{Rate: 2, KeyRate: 1}, keyInfo)
+
+		// the key bucket rejects the second request, so the token taken from
+		// the project bucket is given back.
+		require.NoError(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault))
+		requireRateLimited(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault), 1)
+
+		limiter, err := endpoint.limiterCache.Get(keyInfo.ProjectID.String(), nil)
+		require.NoError(t, err)
+		require.True(t, limiter.(*rate.Limiter).Allow())
+	})
+
+	t.Run("changed config", func(t *testing.T) {
+		keyInfo := &console.APIKeyInfo{ID: testrand.UUID(), ProjectID: testrand.UUID()}
+		endpoint := newEndpoint(RateLimiterConfig{Rate: 10, KeyRate: 1}, keyInfo)
+
+		require.NoError(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault))
+		requireRateLimited(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault), 1)
+
+		// the token buckets are recreated with the changed rates.
+		config := endpoint.RateLimiterConfig()
+		config.KeyRate = 2
+		config.CacheCapacity = 10
+		config.CacheExpiration = time.Hour
+		endpoint.SetRateLimiterConfig(config)
+
+		_, err := endpoint.limiterCache.Get(keyInfo.ProjectID.String(), func() (interface{}, error) {
+			return rate.NewLimiter(rate.Limit(config.Rate), int(config.Rate)), nil
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault))
+		require.NoError(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault))
+		requireRateLimited(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault), 2)
+
+		config.Enabled = false
+		endpoint.SetRateLimiterConfig(config)
+		require.NoError(t, endpoint.checkRate(ctx, keyInfo, rateLimitDefault))
+	})
+}
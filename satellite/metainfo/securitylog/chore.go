@@ -0,0 +1,77 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package securitylog
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/console"
+)
+
+// Chore deletes the security log events older than the retention.
+//
+// architecture: Chore
+type Chore struct {
+	log    *zap.Logger
+	db     console.SecurityLogs
+	config Config
+	nowFn  func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new security log retention chore.
+func NewChore(log *zap.Logger, db console.SecurityLogs, config Config) *Chore {
+	return &Chore{
+		log:    log,
+		db:     db,
+		config: config,
+		nowFn:  time.Now,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.DeleteExpired(ctx); err != nil {
+			chore.log.Error("error while deleting expired security log events", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// DeleteExpired deletes the security log events older than the retention.
+func (chore *Chore) DeleteExpired(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := chore.db.DeleteBefore(ctx, chore.nowFn().Add(-chore.config.Retention))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	mon.IntVal("security_log_events_deleted").Observe(deleted)
+
+	if deleted > 0 {
+		chore.log.Debug("deleted expired security log events", zap.Int64("count", deleted))
+	}
+	return nil
+}
+
+// SetNow allows tests to have the chore act as if the current time is whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package securitylog records the metainfo operations of the projects which
+// opted in to the security logging, so they can be reviewed from the console.
+package securitylog
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcpeer"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// Error defines the security log errors class.
+	Error = errs.Class("security log")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the security log.
+type Config struct {
+	Projects  string        `help:"comma separated list of the ids of the projects whose metainfo operations are logged" default:""`
+	Retention time.Duration `help:"how long the security log events are kept" default:"2160h"`
+	Interval  time.Duration `help:"how often to delete the security log events older than the retention" releaseDefault:"24h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+}
+
+// Service records the metainfo operations of the configured projects.
+//
+// architecture: Service
+type Service struct {
+	log      *zap.Logger
+	db       console.SecurityLogs
+	projects map[uuid.UUID]struct{}
+	nowFn    func() time.Time
+}
+
+// NewService creates a new security log service.
+func NewService(log *zap.Logger, db console.SecurityLogs, config Config) (*Service, error) {
+	projects := make(map[uuid.UUID]struct{})
+	for _, s := range strings.Split(config.Projects, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		projectID, err := uuid.FromString(s)
+		if err != nil {
+			return nil, Error.New("invalid project id %q: %v", s, err)
+		}
+		projects[projectID] = struct{}{}
+	}
+
+	return &Service{
+		log:      log,
+		db:       db,
+		projects: projects,
+		nowFn:    time.Now,
+	}, nil
+}
+
+// Enabled returns whether the operations of the project are logged.
+func (service *Service) Enabled(projectID uuid.UUID) bool {
+	_, ok := service.projects[projectID]
+	return ok
+}
+
+// Record records the operation made with the api key when the security log is
+// enabled for its project. The operation has already been authorized, hence
+// failing to record it is only logged.
+func (service *Service) Record(ctx context.Context, keyInfo *console.APIKeyInfo, operation console.SecurityLogOperation, bucketName []byte) {
+	if service == nil || keyInfo == nil || !service.Enabled(keyInfo.ProjectID) {
+		return
+	}
+
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var remoteAddress string
+	if peer, err := rpcpeer.FromContext(ctx); err == nil && peer.Addr != nil {
+		remoteAddress = peer.Addr.String()
+	}
+
+	err = service.db.Insert(ctx, &console.SecurityLogEvent{
+		ProjectID:     keyInfo.ProjectID,
+		APIKeyID:      keyInfo.ID,
+		APIKeyName:    keyInfo.Name,
+		RemoteAddress: remoteAddress,
+		Operation:     operation,
+		BucketName:    string(bucketName),
+		CreatedAt:     service.nowFn(),
+	})
+	if err != nil {
+		service.log.Error("error while recording security log event",
+			zap.Stringer("projectID", keyInfo.ProjectID),
+			zap.String("operation", string(operation)),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package securitylog_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metainfo/securitylog"
+)
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	logged, other := testrand.UUID(), testrand.UUID()
+
+	_, err := securitylog.NewService(zaptest.NewLogger(t), &securityLogsDB{}, securitylog.Config{
+		Projects: "not-a-uuid",
+	})
+	require.Error(t, err)
+
+	db := &securityLogsDB{}
+	service, err := securitylog.NewService(zaptest.NewLogger(t), db, securitylog.Config{
+		Projects: " " + logged.String() + ",",
+	})
+	require.NoError(t, err)
+
+	require.True(t, service.Enabled(logged))
+	require.False(t, service.Enabled(other))
+
+	key := &console.APIKeyInfo{ID: testrand.UUID(), ProjectID: logged, Name: "key"}
+	service.Record(ctx, key, console.SecurityLogCreateBucket, []byte("bucket"))
+	service.Record(ctx, &console.APIKeyInfo{ID: testrand.UUID(), ProjectID: other}, console.SecurityLogCreateBucket, []byte("bucket"))
+	service.Record(ctx, nil, console.SecurityLogListBuckets, nil)
+
+	require.Len(t, db.events, 1)
+	event := db.events[0]
+	require.Equal(t, logged, event.ProjectID)
+	require.Equal(t, key.ID, event.APIKeyID)
+	require.Equal(t, "key", event.APIKeyName)
+	require.Equal(t, console.SecurityLogCreateBucket, event.Operation)
+	require.Equal(t, "bucket", event.BucketName)
+	require.False(t, event.CreatedAt.IsZero())
+
+	// a disabled security log doesn't record anything.
+	var disabled *securitylog.Service
+	disabled.Record(ctx, key, console.SecurityLogCreateBucket, []byte("bucket"))
+}
+
+func TestChore(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	now := time.Now()
+	db := &securityLogsDB{
+		events: []console.SecurityLogEvent{
+			{ProjectID: testrand.UUID(), CreatedAt: now.Add(-48 * time.Hour)},
+			{ProjectID: testrand.UUID(), CreatedAt: now.Add(-time.Hour)},
+		},
+	}
+
+	chore := securitylog.NewChore(zaptest.NewLogger(t), db, securitylog.Config{
+		Retention: 24 * time.Hour,
+		Interval:  time.Hour,
+	})
+	defer ctx.Check(chore.Close)
+	chore.SetNow(func() time.Time { return now })
+
+	require.NoError(t, chore.DeleteExpired(ctx))
+	require.Len(t, db.events, 1)
+	require.Equal(t, now.Add(-time.Hour), db.events[0].CreatedAt)
+}
+
+// securityLogsDB is an in memory console.SecurityLogs.
+type securityLogsDB struct {
+	events []console.SecurityLogEvent
+}
+
+func (db *securityLogsDB) Insert(ctx context.Context, event *console.SecurityLogEvent) error {
+	db.events = append(db.events, *event)
+	return nil
+}
+
+func (db *securityLogsDB) GetPagedByProjectID(ctx context.Context, projectID uuid.UUID, cursor console.SecurityLogCursor) (*console.SecurityLogPage, error) {
+	page := &console.SecurityLogPage{}
+	for _, event := range db.events {
+		if event.ProjectID == projectID {
+			page.Events = append(page.Events, event)
+		}
+	}
+	return page, nil
+}
+
+func (db *securityLogsDB) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	var kept []console.SecurityLogEvent
+	for _, event := range db.events {
+		if !event.CreatedAt.Before(before) {
+			kept = append(kept, event)
+		}
+	}
+	deleted := int64(len(db.events) - len(kept))
+	db.events = kept
+	return deleted, nil
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/useragent"
+	"storj.io/storj/satellite/metabase"
+)
+
+// linksharingProduct is the user agent product of the linksharing service,
+// which downloads objects on behalf of shared links.
+const linksharingProduct = "linksharing"
+
+// isSharedLinkRequest returns whether the request was made by the linksharing
+// service.
+func isSharedLinkRequest(header *pb.RequestHeader) bool {
+	userAgent := header.GetUserAgent()
+	if len(userAgent) == 0 {
+		return false
+	}
+
+	entries, err := useragent.ParseEntries(userAgent)
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		if strings.EqualFold(entry.Product, linksharingProduct) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateSharedLinkUsage attributes the egress of a download to the downloaded
+// object, when the download was made through a shared link. requests is the
+// number of object downloads, which are started by the request.
+//
+// Failing to update the usage doesn't fail the download, since the usage is
+// only informational and the egress is billed through the bucket rollups.
+func (endpoint *Endpoint) updateSharedLinkUsage(ctx context.Context, header *pb.RequestHeader, object metabase.ObjectLocation, limits []*pb.AddressedOrderLimit, inline, requests int64) {
+	if !isSharedLinkRequest(header) {
+		return
+	}
+
+	var allocated int64
+	for _, limit := range limits {
+		if limit != nil && limit.Limit != nil {
+			allocated += limit.Limit.Limit
+		}
+	}
+
+	err := endpoint.orders.UpdateSharedLinkBandwidth(ctx, object, allocated, inline, requests)
+	if err != nil {
+		endpoint.log.Warn("unable to update shared link usage",
+			zap.Stringer("Project ID", object.ProjectID),
+			zap.Error(err),
+		)
+	}
+	mon.Meter("req_get_shared_link").Mark64(requests)
+}
@@ -7,13 +7,13 @@ import (
 	"bytes"
 	"context"
 	"crypto/subtle"
+	"fmt"
 	"regexp"
 	"strconv"
 	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
-	"golang.org/x/time/rate"
 
 	"storj.io/common/encryption"
 	"storj.io/common/errs2"
@@ -23,6 +23,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/metabase"
@@ -50,7 +51,7 @@ func getAPIKey(ctx context.Context, header *pb.RequestHeader) (key *macaroon.API
 func (endpoint *Endpoint) validateAuth(ctx context.Context, header *pb.RequestHeader, action macaroon.Action) (_ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	key, keyInfo, err := endpoint.validateBasic(ctx, header)
+	key, keyInfo, err := endpoint.validateBasic(ctx, header, rateLimitClassOf(action.Op))
 	if err != nil {
 		return nil, err
 	}
@@ -79,11 +80,14 @@ func (endpoint *Endpoint) validateAuthN(ctx context.Context, header *pb.RequestH
 	defer mon.Task()(&ctx)(&err)
 
 	allOptional := true
+	class := rateLimitDefault
 
 	for _, p := range permissions {
 		if !p.optional {
 			allOptional = false
-			break
+			if rateLimitClassOf(p.action.Op) == rateLimitExpensive {
+				class = rateLimitExpensive
+			}
 		}
 	}
 
@@ -91,7 +95,7 @@ func (endpoint *Endpoint) validateAuthN(ctx context.Context, header *pb.RequestH
 		return nil, rpcstatus.Error(rpcstatus.Internal, "All permissions are optional")
 	}
 
-	key, keyInfo, err := endpoint.validateBasic(ctx, header)
+	key, keyInfo, err := endpoint.validateBasic(ctx, header, class)
 	if err != nil {
 		return nil, err
 	}
@@ -110,13 +114,13 @@ func (endpoint *Endpoint) validateAuthN(ctx context.Context, header *pb.RequestH
 	return keyInfo, nil
 }
 
-func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestHeader) (_ *macaroon.APIKey, _ *console.APIKeyInfo, err error) {
+func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestHeader, class rateLimitClass) (_ *macaroon.APIKey, _ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	key, err := getAPIKey(ctx, header)
 	if err != nil {
 		endpoint.log.Debug("invalid request", zap.Error(err))
-		return nil, nil, rpcstatus.Error(rpcstatus.InvalidArgument, "Invalid API credentials")
+		return nil, nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "Invalid API credentials")
 	}
 
 	keyInfo, err := endpoint.apiKeys.GetByHead(ctx, key.Head())
@@ -125,7 +129,7 @@ func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestH
 		return nil, nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
 	}
 
-	if err = endpoint.checkRate(ctx, keyInfo.ProjectID); err != nil {
+	if err = endpoint.checkRate(ctx, keyInfo, class); err != nil {
 		endpoint.log.Debug("rate check failed", zap.Error(err))
 		return nil, nil, err
 	}
@@ -135,14 +139,14 @@ func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestH
 
 func (endpoint *Endpoint) validateRevoke(ctx context.Context, header *pb.RequestHeader, macToRevoke *macaroon.Macaroon) (_ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
-	key, keyInfo, err := endpoint.validateBasic(ctx, header)
+	key, keyInfo, err := endpoint.validateBasic(ctx, header, rateLimitDefault)
 	if err != nil {
 		return nil, err
 	}
 
 	// The macaroon to revoke must be valid with the same secret as the key.
 	if !macToRevoke.Validate(keyInfo.Secret) {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "Macaroon to revoke invalid")
+		return nil, newDetailedError(rpcstatus.InvalidArgument, ErrorDetails{Code: ErrorCodeInvalidArgument}, "Macaroon to revoke invalid")
 	}
 
 	keyTail := key.Tail()
@@ -159,48 +163,6 @@ func (endpoint *Endpoint) validateRevoke(ctx context.Context, header *pb.Request
 	return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized attempt to revoke macaroon")
 }
 
-func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (err error) {
-	defer mon.Task()(&ctx)(&err)
-	if !endpoint.config.RateLimiter.Enabled {
-		return nil
-	}
-	limiter, err := endpoint.limiterCache.Get(projectID.String(), func() (interface{}, error) {
-		rateLimit := rate.Limit(endpoint.config.RateLimiter.Rate)
-		burstLimit := int(endpoint.config.RateLimiter.Rate)
-
-		project, err := endpoint.projects.Get(ctx, projectID)
-		if err != nil {
-			return false, err
-		}
-		if project.RateLimit != nil {
-			rateLimit = rate.Limit(*project.RateLimit)
-			burstLimit = *project.RateLimit
-		}
-		// use the explicitly set burst value if it's defined
-		if project.BurstLimit != nil {
-			burstLimit = *project.BurstLimit
-		}
-
-		return rate.NewLimiter(rateLimit, burstLimit), nil
-	})
-	if err != nil {
-		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
-	}
-
-	if !limiter.(*rate.Limiter).Allow() {
-		endpoint.log.Warn("too many requests for project",
-			zap.Stringer("projectID", projectID),
-			zap.Float64("rate limit", float64(limiter.(*rate.Limiter).Limit())),
-			zap.Float64("burst limit", float64(limiter.(*rate.Limiter).Burst())))
-
-		mon.Event("metainfo_rate_limit_exceeded") //mon:locked
-
-		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Too Many Requests")
-	}
-
-	return nil
-}
-
 func (endpoint *Endpoint) validateBucket(ctx context.Context, bucket []byte) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -332,6 +294,66 @@ func (endpoint *Endpoint) validateRemoteSegment(ctx context.Context, commitReque
 	return nil
 }
 
+// checkProjectFreeze returns an error when the project is deleted, or frozen
+// for the uploads, or for the downloads when download is true.
+func (endpoint *Endpoint) checkProjectFreeze(ctx context.Context, projectID uuid.UUID, download bool) error {
+	if err := endpoint.checkProjectDeleted(ctx, projectID); err != nil {
+		return err
+	}
+
+	freeze, err := endpoint.projectUsage.GetProjectFreeze(ctx, projectID)
+	if err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		endpoint.log.Error(
+			"Retrieving project freeze failed; freeze won't be enforced",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+		return nil
+	}
+	if freeze == nil || (download && !freeze.Downloads) {
+		return nil
+	}
+
+	endpoint.log.Warn("Project frozen",
+		zap.String("Reason", string(freeze.Reason)),
+		zap.Bool("Download", download),
+		zap.Stringer("Project ID", projectID),
+	)
+	return newDetailedError(rpcstatus.PermissionDenied, ErrorDetails{
+		Code: ErrorCodeProjectFrozen,
+	}, "Project Frozen")
+}
+
+// checkProjectDeleted returns an error when the project is deleted and waits
+// for its data to be purged or for it to be restored.
+func (endpoint *Endpoint) checkProjectDeleted(ctx context.Context, projectID uuid.UUID) error {
+	deleted, err := endpoint.projectUsage.IsProjectDeleted(ctx, projectID)
+	if err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		endpoint.log.Error(
+			"Retrieving project deletion failed; deletion won't be enforced",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+		return nil
+	}
+	if !deleted {
+		return nil
+	}
+
+	endpoint.log.Warn("Project deleted", zap.Stringer("Project ID", projectID))
+	return newDetailedError(rpcstatus.PermissionDenied, ErrorDetails{
+		Code: ErrorCodeProjectDeleted,
+	}, "Project Deleted")
+}
+
 func (endpoint *Endpoint) checkUploadLimits(ctx context.Context, projectID uuid.UUID) error {
 	return endpoint.checkUploadLimitsForNewObject(ctx, projectID, 1, 1)
 }
@@ -339,6 +361,10 @@ func (endpoint *Endpoint) checkUploadLimits(ctx context.Context, projectID uuid.
 func (endpoint *Endpoint) checkUploadLimitsForNewObject(
 	ctx context.Context, projectID uuid.UUID, newObjectSize int64, newObjectSegmentCount int64,
 ) error {
+	if err := endpoint.checkProjectFreeze(ctx, projectID, false); err != nil {
+		return err
+	}
+
 	if limit, err := endpoint.projectUsage.ExceedsUploadLimits(ctx, projectID, newObjectSize, newObjectSegmentCount); err != nil {
 		if errs2.IsCanceled(err) {
 			return rpcstatus.Wrap(rpcstatus.Canceled, err)
@@ -355,7 +381,10 @@ func (endpoint *Endpoint) checkUploadLimitsForNewObject(
 				zap.String("Limit", strconv.Itoa(int(limit.SegmentsLimit))),
 				zap.Stringer("Project ID", projectID),
 			)
-			return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Segments Limit")
+			return newDetailedError(rpcstatus.ResourceExhausted, ErrorDetails{
+				Code:  ErrorCodeSegmentsLimitExceeded,
+				Limit: limit.SegmentsLimit,
+			}, "Exceeded Segments Limit")
 		}
 
 		if limit.ExceedsStorage {
@@ -363,8 +392,90 @@ func (endpoint *Endpoint) checkUploadLimitsForNewObject(
 				zap.String("Limit", strconv.Itoa(limit.StorageLimit.Int())),
 				zap.Stringer("Project ID", projectID),
 			)
-			return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Storage Limit")
+			return newDetailedError(rpcstatus.ResourceExhausted, ErrorDetails{
+				Code:  ErrorCodeStorageLimitExceeded,
+				Limit: limit.StorageLimit.Int64(),
+			}, "Exceeded Storage Limit")
+		}
+	}
+
+	return nil
+}
+
+// checkBucketQuota returns an error when the bucket reached its object count
+// or size quota. The usage of the bucket comes from its latest tally, hence
+// the quota is enforced with the delay of the tally interval.
+func (endpoint *Endpoint) checkBucketQuota(ctx context.Context, projectID uuid.UUID, bucketName []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !endpoint.config.BucketQuota.Enabled {
+		return nil
+	}
+
+	quota, err := endpoint.buckets.GetBucketQuota(ctx, bucketName, projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return newBucketNotFoundError("bucket not found: %s", bucketName)
+		}
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		endpoint.log.Error(
+			"Retrieving bucket quota failed; quota won't be enforced",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+		return nil
+	}
+	if quota.IsZero() {
+		return nil
+	}
+
+	tally, err := endpoint.projectUsage.GetLatestBucketTally(ctx, metabase.BucketLocation{
+		ProjectID:  projectID,
+		BucketName: string(bucketName),
+	})
+	if err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
 		}
+
+		endpoint.log.Error(
+			"Retrieving bucket usage failed; quota won't be enforced",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	err = bucketQuotaError(bucketName, quota, tally)
+	if err != nil {
+		endpoint.log.Warn("Bucket quota exceeded",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+	}
+	return err
+}
+
+// bucketQuotaError returns the error of the quota, which the tally of the
+// bucket reached, or nil.
+func bucketQuotaError(bucketName []byte, quota buckets.Quota, tally accounting.BucketTally) error {
+	if quota.MaxObjects != nil && tally.ObjectCount >= *quota.MaxObjects {
+		return newDetailedError(rpcstatus.ResourceExhausted, ErrorDetails{
+			Code:   ErrorCodeBucketObjectsQuotaExceeded,
+			Bucket: string(bucketName),
+			Limit:  *quota.MaxObjects,
+		}, "Exceeded Bucket Objects Quota")
+	}
+
+	if quota.MaxBytes != nil && tally.TotalBytes >= *quota.MaxBytes {
+		return newDetailedError(rpcstatus.ResourceExhausted, ErrorDetails{
+			Code:   ErrorCodeBucketBytesQuotaExceeded,
+			Bucket: string(bucketName),
+			Limit:  *quota.MaxBytes,
+		}, "Exceeded Bucket Size Quota")
 	}
 
 	return nil
@@ -413,7 +524,7 @@ func (endpoint *Endpoint) addStorageUsageUpToLimit(ctx context.Context, projectI
 				zap.Stringer("Project ID", projectID),
 				zap.Error(err),
 			)
-			return rpcstatus.Error(rpcstatus.ResourceExhausted, err.Error())
+			return newDetailedError(rpcstatus.ResourceExhausted, ErrorDetails{Code: ErrorCodeUploadLimitExceeded}, err.Error())
 		}
 
 		if errs2.IsCanceled(err) {
@@ -429,3 +540,15 @@ func (endpoint *Endpoint) addStorageUsageUpToLimit(ctx context.Context, projectI
 
 	return nil
 }
+
+// checkWriteFreeze returns an error when a maintenance window currently
+// freezes writes.
+func (endpoint *Endpoint) checkWriteFreeze(ctx context.Context) error {
+	window, frozen := endpoint.maintenance.WriteFreeze(ctx)
+	if !frozen {
+		return nil
+	}
+	return newDetailedError(rpcstatus.Unavailable, ErrorDetails{Code: ErrorCodeWritesFrozen},
+		fmt.Sprintf("writes are disabled during satellite maintenance until %s: %s",
+			window.End.UTC().Format(time.RFC3339), window.Message))
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeevents
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+)
+
+var (
+	// Error is the standard error class for node events.
+	Error = errs.Class("node events")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the node events chore.
+type Config struct {
+	Enabled   bool          `help:"whether node operators are emailed about node events" releaseDefault:"false" devDefault:"true"`
+	Interval  time.Duration `help:"how often the node events are emailed" default:"1m"`
+	BatchSize int           `help:"the maximum number of node events emailed in a single iteration" default:"100"`
+}
+
+// Chore emails node operators about the events of their nodes.
+//
+// architecture: Chore
+type Chore struct {
+	log  *zap.Logger
+	Loop *sync2.Cycle
+
+	db               DB
+	mailService      *mailservice.Service
+	config           Config
+	satelliteAddress string
+}
+
+// NewChore instantiates Chore.
+func NewChore(log *zap.Logger, db DB, mailService *mailservice.Service, config Config, satelliteAddress string) *Chore {
+	return &Chore{
+		log:              log,
+		Loop:             sync2.NewCycle(config.Interval),
+		db:               db,
+		mailService:      mailService,
+		config:           config,
+		satelliteAddress: satelliteAddress,
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.SendEmails(ctx); err != nil {
+			chore.log.Error("error sending node event emails", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// SendEmails emails the node operators about the node events, which haven't
+// been emailed yet.
+func (chore *Chore) SendEmails(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeEvents, err := chore.db.GetUnsent(ctx, chore.config.BatchSize)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var sent []uuid.UUID
+	for _, nodeEvent := range nodeEvents {
+		err := chore.mailService.SendRendered(ctx,
+			[]post.Address{{Address: nodeEvent.Email}},
+			&NodeEventEmail{
+				Event:            nodeEvent.Event,
+				NodeID:           nodeEvent.NodeID,
+				SatelliteAddress: chore.satelliteAddress,
+			},
+		)
+		if err != nil {
+			chore.log.Error("error sending node event email",
+				zap.Stringer("Node ID", nodeEvent.NodeID),
+				zap.Stringer("Event", nodeEvent.Event),
+				zap.Error(err))
+			continue
+		}
+		sent = append(sent, nodeEvent.ID)
+	}
+	mon.IntVal("node_event_emails_sent").Observe(int64(len(sent)))
+
+	if len(sent) == 0 {
+		return nil
+	}
+	return Error.Wrap(chore.db.UpdateEmailSent(ctx, sent, time.Now()))
+}
+
+// Close closes chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeevents
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// DB implements the database for node events.
+//
+// architecture: Database
+type DB interface {
+	// Insert inserts a node event.
+	Insert(ctx context.Context, email string, nodeID storj.NodeID, event Type) (nodeEvent NodeEvent, err error)
+	// GetUnsent returns the oldest node events with an email address, which
+	// haven't been emailed yet.
+	GetUnsent(ctx context.Context, limit int) (nodeEvents []NodeEvent, err error)
+	// UpdateEmailSent marks the node events as emailed.
+	UpdateEmailSent(ctx context.Context, ids []uuid.UUID, timestamp time.Time) (err error)
+}
+
+// NodeEvent is a change of the status of a node, which the node operator is
+// notified about.
+type NodeEvent struct {
+	ID        uuid.UUID
+	Email     string
+	NodeID    storj.NodeID
+	Event     Type
+	CreatedAt time.Time
+	EmailSent *time.Time
+}
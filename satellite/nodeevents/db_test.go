@@ -0,0 +1,57 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeevents_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/nodeevents"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestNodeEvents(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		nodeEventsDB := db.NodeEvents()
+		nodeID := testrand.NodeID()
+
+		warning, err := nodeEventsDB.Insert(ctx, "test@storj.test", nodeID, nodeevents.AuditWarning)
+		require.NoError(t, err)
+		require.Equal(t, nodeID, warning.NodeID)
+		require.Equal(t, nodeevents.AuditWarning, warning.Event)
+		require.False(t, warning.CreatedAt.IsZero())
+
+		// node events without an email address are not emailed.
+		_, err = nodeEventsDB.Insert(ctx, "", nodeID, nodeevents.AuditWarningReminder)
+		require.NoError(t, err)
+
+		lifted, err := nodeEventsDB.Insert(ctx, "test@storj.test", nodeID, nodeevents.AuditWarningLifted)
+		require.NoError(t, err)
+
+		unsent, err := nodeEventsDB.GetUnsent(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, unsent, 2)
+		require.Equal(t, warning.ID, unsent[0].ID)
+		require.Equal(t, "test@storj.test", unsent[0].Email)
+		require.Nil(t, unsent[0].EmailSent)
+		require.Equal(t, lifted.ID, unsent[1].ID)
+
+		unsent, err = nodeEventsDB.GetUnsent(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, unsent, 1)
+
+		require.NoError(t, nodeEventsDB.UpdateEmailSent(ctx, []uuid.UUID{warning.ID}, time.Now()))
+
+		unsent, err = nodeEventsDB.GetUnsent(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, unsent, 1)
+		require.Equal(t, lifted.ID, unsent[0].ID)
+	})
+}
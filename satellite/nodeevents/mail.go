@@ -0,0 +1,51 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeevents
+
+import (
+	"storj.io/common/storj"
+)
+
+// NodeEventEmail is the mailservice template of the emails about node events.
+type NodeEventEmail struct {
+	Event            Type
+	NodeID           storj.NodeID
+	SatelliteAddress string
+}
+
+// Template returns email template name.
+func (*NodeEventEmail) Template() string { return "NodeEvent" }
+
+// Subject gets email subject.
+func (email *NodeEventEmail) Subject() string {
+	switch email.Event {
+	case AuditWarning:
+		return "Your node is at risk of disqualification"
+	case AuditWarningReminder:
+		return "Reminder: your node is at risk of disqualification"
+	case AuditWarningFinal:
+		return "Final warning: your node is about to be disqualified"
+	case AuditWarningLifted:
+		return "Your node is no longer at risk of disqualification"
+	case Disqualified:
+		return "Your node has been disqualified"
+	default:
+		return "Your node status has changed"
+	}
+}
+
+// Message gets the text of the email.
+func (email *NodeEventEmail) Message() string {
+	switch email.Event {
+	case AuditWarning, AuditWarningReminder, AuditWarningFinal:
+		return "Your node has failed too many audits and its audit score is below the disqualification threshold. " +
+			"Please check that the node can read all the stored pieces: the node will be disqualified, if its audit score doesn't recover in time."
+	case AuditWarningLifted:
+		return "The audit score of your node has recovered and it's no longer at risk of disqualification."
+	case Disqualified:
+		return "Your node has been disqualified and it won't receive new data from the satellite anymore."
+	default:
+		return "The status of your node has changed."
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeevents
+
+import "fmt"
+
+// Type is the type of a node event.
+type Type int
+
+const (
+	// AuditWarning is used when the audit reputation of a node falls below the
+	// disqualification cut-off and the node is warned.
+	AuditWarning Type = 1
+	// AuditWarningReminder is used when half of the audit warning grace period
+	// has passed and the audit reputation hasn't recovered.
+	AuditWarningReminder Type = 2
+	// AuditWarningFinal is used when three quarters of the audit warning grace
+	// period have passed and the audit reputation hasn't recovered.
+	AuditWarningFinal Type = 3
+	// AuditWarningLifted is used when the audit reputation of a warned node
+	// recovers.
+	AuditWarningLifted Type = 4
+	// Disqualified is used when a node is disqualified.
+	Disqualified Type = 5
+)
+
+// String returns the name of the node event type.
+func (t Type) String() string {
+	switch t {
+	case AuditWarning:
+		return "audit warning"
+	case AuditWarningReminder:
+		return "audit warning reminder"
+	case AuditWarningFinal:
+		return "final audit warning"
+	case AuditWarningLifted:
+		return "audit warning lifted"
+	case Disqualified:
+		return "disqualified"
+	default:
+		return fmt.Sprintf("<unknown node event type %d>", int(t))
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodeslo generates the monthly service level reports of the storage
+// nodes, which communities and payout audits can consume.
+package nodeslo
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/reputation"
+)
+
+var (
+	// Error is the error class for node SLO reports.
+	Error = errs.Class("node slo")
+
+	mon = monkit.Package()
+)
+
+// DB is the databases needed to generate the reports.
+type DB interface {
+	// Reputation returns database for storage node reputation.
+	Reputation() reputation.DB
+	// StoragenodeAccounting returns database for storing information about storagenode use.
+	StoragenodeAccounting() accounting.StoragenodeAccounting
+}
+
+// Report is the service level report of the storage nodes for a period.
+type Report struct {
+	Period string    `json:"period"`
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+
+	Nodes []NodeReport `json:"nodes"`
+}
+
+// NodeReport is the service level report of a storage node.
+type NodeReport struct {
+	NodeID       storj.NodeID `json:"nodeId"`
+	Wallet       string       `json:"wallet"`
+	CreatedAt    time.Time    `json:"createdAt"`
+	Disqualified *time.Time   `json:"disqualified"`
+
+	// OnlineCount is the number of the contacts in the period during which
+	// the node was online, out of TotalCount contacts.
+	OnlineCount int64 `json:"onlineCount"`
+	TotalCount  int64 `json:"totalCount"`
+	// Availability is the ratio of the contacts in the period during which
+	// the node was online, 0 when the node wasn't contacted.
+	Availability float64 `json:"availability"`
+
+	// AuditSuccessCount is the number of successful audits out of
+	// TotalAuditCount audits, over the lifetime of the node.
+	AuditSuccessCount int64 `json:"auditSuccessCount"`
+	TotalAuditCount   int64 `json:"totalAuditCount"`
+	// AuditSuccessRatio is the ratio of successful audits, 0 when the node
+	// wasn't audited.
+	AuditSuccessRatio float64 `json:"auditSuccessRatio"`
+
+	// RepairEgress is the number of bytes the node sent for repairs in the
+	// period, RepairIngress the number of bytes it received.
+	RepairEgress  int64 `json:"repairEgress"`
+	RepairIngress int64 `json:"repairIngress"`
+}
+
+// Service generates the node SLO reports.
+//
+// architecture: Service
+type Service struct {
+	db DB
+}
+
+// NewService creates a new node SLO report service.
+func NewService(db DB) *Service {
+	return &Service{db: db}
+}
+
+// Generate generates the report of the nodes which stored data or transferred
+// bandwidth during period.
+//
+// The availability is calculated from the audit history windows of the nodes,
+// which are kept only for the reputation tracking period, so the report of a
+// month should be generated shortly after the month ends.
+func (service *Service) Generate(ctx context.Context, period compensation.Period) (_ *Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	start, end := period.StartDate(), period.EndDateExclusive()
+
+	rows, err := service.db.StoragenodeAccounting().QueryPaymentInfo(ctx, start, end)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	report := &Report{
+		Period: period.String(),
+		Start:  start,
+		End:    end,
+		Nodes:  make([]NodeReport, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		node := NodeReport{
+			NodeID:        row.NodeID,
+			Wallet:        row.Wallet,
+			CreatedAt:     row.NodeCreationDate,
+			Disqualified:  row.Disqualified,
+			RepairEgress:  row.GetRepairTotal,
+			RepairIngress: row.PutRepairTotal,
+		}
+
+		info, err := service.db.Reputation().Get(ctx, row.NodeID)
+		if err != nil && !reputation.ErrNodeNotFound.Has(err) {
+			return nil, Error.Wrap(err)
+		}
+		if info != nil {
+			node.AuditSuccessCount = info.AuditSuccessCount
+			node.TotalAuditCount = info.TotalAuditCount
+			if info.TotalAuditCount > 0 {
+				node.AuditSuccessRatio = float64(info.AuditSuccessCount) / float64(info.TotalAuditCount)
+			}
+
+			if info.AuditHistory != nil {
+				for _, window := range info.AuditHistory.Windows {
+					if window.WindowStart.Before(start) || !window.WindowStart.Before(end) {
+						continue
+					}
+					node.OnlineCount += int64(window.OnlineCount)
+					node.TotalCount += int64(window.TotalCount)
+				}
+			}
+			if node.TotalCount > 0 {
+				node.Availability = float64(node.OnlineCount) / float64(node.TotalCount)
+			}
+		}
+
+		report.Nodes = append(report.Nodes, node)
+	}
+
+	return report, nil
+}
+
+// WriteCSV writes the report as CSV, with a row per node.
+func WriteCSV(output io.Writer, report *Report) error {
+	w := csv.NewWriter(output)
+	headers := []string{
+		"period",
+		"nodeID",
+		"walletAddress",
+		"nodeCreationDate",
+		"disqualified",
+		"onlineCount",
+		"totalCount",
+		"availability",
+		"auditSuccessCount",
+		"totalAuditCount",
+		"auditSuccessRatio",
+		"bytes:BWRepair-GET",
+		"bytes:BWRepair-PUT",
+	}
+	if err := w.Write(headers); err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, node := range report.Nodes {
+		disqualified := ""
+		if node.Disqualified != nil {
+			disqualified = node.Disqualified.Format("2006-01-02")
+		}
+		record := []string{
+			report.Period,
+			node.NodeID.String(),
+			node.Wallet,
+			node.CreatedAt.Format("2006-01-02"),
+			disqualified,
+			strconv.FormatInt(node.OnlineCount, 10),
+			strconv.FormatInt(node.TotalCount, 10),
+			strconv.FormatFloat(node.Availability, 'f', 5, 64),
+			strconv.FormatInt(node.AuditSuccessCount, 10),
+			strconv.FormatInt(node.TotalAuditCount, 10),
+			strconv.FormatFloat(node.AuditSuccessRatio, 'f', 5, 64),
+			strconv.FormatInt(node.RepairEgress, 10),
+			strconv.FormatInt(node.RepairIngress, 10),
+		}
+		if err := w.Write(record); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	w.Flush()
+	return Error.Wrap(w.Error())
+}
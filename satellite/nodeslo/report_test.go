@@ -0,0 +1,106 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeslo_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/nodeslo"
+	"storj.io/storj/satellite/reputation"
+)
+
+func TestGenerate(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		active, idle := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+
+		period := compensation.Period{Year: 2022, Month: time.March}
+		start := period.StartDate()
+
+		// only the active node has rollups in the period, the idle node has
+		// them in the previous one.
+		err := satellite.DB.StoragenodeAccounting().SaveRollup(ctx, start.AddDate(0, 1, 0), accounting.RollupStats{
+			start.Add(24 * time.Hour): {
+				active: {
+					NodeID:         active,
+					StartTime:      start.Add(24 * time.Hour),
+					GetRepairTotal: 1000,
+					PutRepairTotal: 2000,
+				},
+			},
+			start.AddDate(0, -1, 0): {
+				idle: {
+					NodeID:         idle,
+					StartTime:      start.AddDate(0, -1, 0),
+					GetRepairTotal: 3000,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		// the window before the period is not included in the availability.
+		_, err = satellite.DB.Reputation().ApplyUpdates(ctx, active, reputation.Mutations{
+			PositiveResults: 9,
+			FailureResults:  1,
+			OnlineHistory: &pb.AuditHistory{
+				Windows: []*pb.AuditWindow{
+					{WindowStart: start.Add(-12 * time.Hour), OnlineCount: 0, TotalCount: 5},
+					{WindowStart: start, OnlineCount: 3, TotalCount: 4},
+					{WindowStart: start.Add(12 * time.Hour), OnlineCount: 4, TotalCount: 4},
+				},
+			},
+		}, satellite.Config.Reputation, start.Add(24*time.Hour))
+		require.NoError(t, err)
+
+		report, err := nodeslo.NewService(satellite.DB).Generate(ctx, period)
+		require.NoError(t, err)
+		require.Equal(t, "2022-03", report.Period)
+		require.Equal(t, start, report.Start)
+		require.Equal(t, period.EndDateExclusive(), report.End)
+
+		require.Len(t, report.Nodes, 1)
+		node := report.Nodes[0]
+		require.Equal(t, active, node.NodeID)
+		require.EqualValues(t, 7, node.OnlineCount)
+		require.EqualValues(t, 8, node.TotalCount)
+		require.InDelta(t, 0.875, node.Availability, 1e-8)
+		require.EqualValues(t, 9, node.AuditSuccessCount)
+		require.EqualValues(t, 10, node.TotalAuditCount)
+		require.InDelta(t, 0.9, node.AuditSuccessRatio, 1e-8)
+		require.EqualValues(t, 1000, node.RepairEgress)
+		require.EqualValues(t, 2000, node.RepairIngress)
+
+		var output bytes.Buffer
+		require.NoError(t, nodeslo.WriteCSV(&output, report))
+
+		records, err := csv.NewReader(&output).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		require.Equal(t, []string{"2022-03", active.String()}, records[1][:2])
+		require.Equal(t, []string{"7", "8", "0.87500", "9", "10", "0.90000", "1000", "2000"}, records[1][5:])
+
+		// a node without reputation is reported as never contacted nor audited.
+		report, err = nodeslo.NewService(satellite.DB).Generate(ctx, compensation.Period{Year: 2022, Month: time.February})
+		require.NoError(t, err)
+		require.Len(t, report.Nodes, 1)
+		require.Equal(t, nodeslo.NodeReport{
+			NodeID:       idle,
+			Wallet:       report.Nodes[0].Wallet,
+			CreatedAt:    report.Nodes[0].CreatedAt,
+			RepairEgress: 3000,
+		}, report.Nodes[0])
+	})
+}
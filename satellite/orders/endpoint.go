@@ -38,6 +38,10 @@ type DB interface {
 	UpdateBucketBandwidthInline(ctx context.Context, projectID uuid.UUID, bucketName []byte, action pb.PieceAction, amount int64, intervalStart time.Time) error
 	// UpdateBandwidthBatch updates bucket and project bandwidth rollups in the database
 	UpdateBandwidthBatch(ctx context.Context, rollups []BucketBandwidthRollup) error
+	// UpdateAPIKeyBandwidth updates the bandwidth rollups of api keys
+	UpdateAPIKeyBandwidth(ctx context.Context, rollups []APIKeyBandwidthRollup) error
+	// UpdateSharedLinkBandwidth updates the bandwidth rollups of shared links
+	UpdateSharedLinkBandwidth(ctx context.Context, rollups []SharedLinkBandwidthRollup) error
 
 	// UpdateStoragenodeBandwidthSettle updates 'settled' bandwidth for given storage node
 	UpdateStoragenodeBandwidthSettle(ctx context.Context, storageNode storj.NodeID, action pb.PieceAction, amount int64, intervalStart time.Time) error
@@ -94,6 +98,31 @@ type BucketBandwidthRollup struct {
 	Dead          int64
 }
 
+// APIKeyBandwidthRollup contains the bandwidth and the requests, which are
+// attributed to an api key.
+type APIKeyBandwidthRollup struct {
+	ProjectID     uuid.UUID
+	APIKeyHead    []byte
+	Action        pb.PieceAction
+	IntervalStart time.Time
+	Inline        int64
+	Allocated     int64
+	Settled       int64
+	Requests      int64
+}
+
+// SharedLinkBandwidthRollup contains the egress and the requests of the
+// downloads of an object through a shared link.
+type SharedLinkBandwidthRollup struct {
+	ProjectID     uuid.UUID
+	BucketName    string
+	ObjectKey     metabase.ObjectKey
+	IntervalStart time.Time
+	Inline        int64
+	Allocated     int64
+	Requests      int64
+}
+
 // SortBucketBandwidthRollups sorts the rollups.
 func SortBucketBandwidthRollups(rollups []BucketBandwidthRollup) {
 	sort.SliceStable(rollups, func(i, j int) bool {
@@ -184,6 +213,12 @@ type bucketIDAction struct {
 	action     pb.PieceAction
 }
 
+type apiKeyAction struct {
+	projectID  uuid.UUID
+	apiKeyHead string
+	action     pb.PieceAction
+}
+
 // SettlementWithWindow processes all orders that were created in a 1 hour window.
 // Only one window is processed at a time.
 // Batches are atomic, all orders are settled successfully or they all fail.
@@ -242,6 +277,7 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 
 	storagenodeSettled := map[int32]int64{}
 	bucketSettled := map[bucketIDAction]bandwidthAmount{}
+	apiKeySettled := map[apiKeyAction]int64{}
 	seenSerials := map[storj.SerialNumber]struct{}{}
 
 	var window int64
@@ -341,6 +377,14 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 			Allocated: bucketSettled[currentBucketIDAction].Allocated + orderLimit.Limit,
 			Dead:      bucketSettled[currentBucketIDAction].Dead + orderLimit.Limit - order.Amount,
 		}
+
+		if len(metadata.ApiKeyHead) > 0 {
+			apiKeySettled[apiKeyAction{
+				projectID:  bucketInfo.ProjectID,
+				apiKeyHead: string(metadata.ApiKeyHead),
+				action:     orderLimit.Action,
+			}] += order.Amount
+		}
 	}
 
 	if len(storagenodeSettled) == 0 {
@@ -373,6 +417,23 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 				log.Info("err updating bucket bandwidth settle", zap.Error(err))
 			}
 		}
+
+		if len(apiKeySettled) > 0 {
+			rollups := make([]APIKeyBandwidthRollup, 0, len(apiKeySettled))
+			for apiKeyAction, settled := range apiKeySettled {
+				rollups = append(rollups, APIKeyBandwidthRollup{
+					ProjectID:     apiKeyAction.projectID,
+					APIKeyHead:    []byte(apiKeyAction.apiKeyHead),
+					Action:        apiKeyAction.action,
+					IntervalStart: time.Unix(0, window),
+					Settled:       settled,
+				})
+			}
+			err = endpoint.DB.UpdateAPIKeyBandwidth(ctx, rollups)
+			if err != nil {
+				log.Info("err updating api key bandwidth settle", zap.Error(err))
+			}
+		}
 	} else {
 		mon.Event("orders_already_processed")
 	}
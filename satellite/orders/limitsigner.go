@@ -0,0 +1,174 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+)
+
+// ErrLimitSigner is the error class for order limit signing backends.
+var ErrLimitSigner = errs.Class("order limit signer")
+
+// SignerConfig configures how the order limits are signed.
+type SignerConfig struct {
+	Backend       string        `help:"how order limits are signed: 'local' signs with the satellite identity, 'remote' sends them to a remote signer" default:"local"`
+	RemoteAddress string        `help:"address of the remote signer, e.g. https://signer.example.test" default:""`
+	RemoteToken   string        `help:"bearer token to authenticate with the remote signer" default:""`
+	RemoteTimeout time.Duration `help:"timeout of a request to the remote signer" default:"5s"`
+}
+
+// LimitSigner signs batches of order limits.
+//
+// Implementations may keep the private key of the satellite out of the
+// process, e.g. by sending the order limits to a remote signer in front of an
+// HSM, hence a batch is signed with a single request.
+type LimitSigner interface {
+	// SignOrderLimits sets the satellite signature of the order limits.
+	SignOrderLimits(ctx context.Context, limits []*pb.OrderLimit) error
+}
+
+// NewLimitSigner creates the order limit signer configured by config. The
+// local signer uses satellite.
+func NewLimitSigner(satellite signing.Signer, config SignerConfig) (LimitSigner, error) {
+	switch config.Backend {
+	case "", "local":
+		return NewLocalLimitSigner(satellite), nil
+	case "remote":
+		return NewRemoteLimitSigner(satellite.ID(), config)
+	default:
+		return nil, ErrLimitSigner.New("unknown backend %q", config.Backend)
+	}
+}
+
+// LocalLimitSigner signs order limits with a signer in the process.
+type LocalLimitSigner struct {
+	signer signing.Signer
+}
+
+// NewLocalLimitSigner creates an order limit signer which signs with signer.
+func NewLocalLimitSigner(signer signing.Signer) *LocalLimitSigner {
+	return &LocalLimitSigner{signer: signer}
+}
+
+// SignOrderLimits sets the satellite signature of the order limits.
+func (local *LocalLimitSigner) SignOrderLimits(ctx context.Context, limits []*pb.OrderLimit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, limit := range limits {
+		encoded, err := signing.EncodeOrderLimit(ctx, limit)
+		if err != nil {
+			return ErrLimitSigner.Wrap(err)
+		}
+		limit.SatelliteSignature, err = local.signer.HashAndSign(ctx, encoded)
+		if err != nil {
+			return ErrLimitSigner.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// RemoteLimitSigner signs order limits with a remote signer.
+//
+// The encoded order limits of a batch are sent in a single request:
+//
+//	POST /v1/sign {"satellite_id": "...", "messages": ["<base64>", ...]}
+//
+// and the remote signer responds with a signature for each of them, in the
+// same order:
+//
+//	{"signatures": ["<base64>", ...]}
+type RemoteLimitSigner struct {
+	satelliteID storj.NodeID
+	address     string
+	token       string
+	client      *http.Client
+}
+
+// NewRemoteLimitSigner creates an order limit signer which signs with the
+// remote signer configured by config.
+func NewRemoteLimitSigner(satelliteID storj.NodeID, config SignerConfig) (*RemoteLimitSigner, error) {
+	if config.RemoteAddress == "" {
+		return nil, ErrLimitSigner.New("remote signer address is missing")
+	}
+	return &RemoteLimitSigner{
+		satelliteID: satelliteID,
+		address:     strings.TrimSuffix(config.RemoteAddress, "/"),
+		token:       config.RemoteToken,
+		client:      &http.Client{Timeout: config.RemoteTimeout},
+	}, nil
+}
+
+type remoteSignRequest struct {
+	SatelliteID storj.NodeID `json:"satellite_id"`
+	Messages    [][]byte     `json:"messages"`
+}
+
+type remoteSignResponse struct {
+	Signatures [][]byte `json:"signatures"`
+}
+
+// SignOrderLimits sets the satellite signature of the order limits.
+func (remote *RemoteLimitSigner) SignOrderLimits(ctx context.Context, limits []*pb.OrderLimit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	request := remoteSignRequest{
+		SatelliteID: remote.satelliteID,
+		Messages:    make([][]byte, 0, len(limits)),
+	}
+	for _, limit := range limits {
+		encoded, err := signing.EncodeOrderLimit(ctx, limit)
+		if err != nil {
+			return ErrLimitSigner.Wrap(err)
+		}
+		request.Messages = append(request.Messages, encoded)
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return ErrLimitSigner.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, remote.address+"/v1/sign", bytes.NewReader(body))
+	if err != nil {
+		return ErrLimitSigner.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if remote.token != "" {
+		req.Header.Set("Authorization", "Bearer "+remote.token)
+	}
+
+	resp, err := remote.client.Do(req)
+	if err != nil {
+		return ErrLimitSigner.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrLimitSigner.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return ErrLimitSigner.New("remote signer responded with status %d", resp.StatusCode)
+	}
+
+	var response remoteSignResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return ErrLimitSigner.Wrap(err)
+	}
+	if len(response.Signatures) != len(limits) {
+		return ErrLimitSigner.New("remote signer returned %d signatures for %d order limits", len(response.Signatures), len(limits))
+	}
+
+	for i, limit := range limits {
+		limit.SatelliteSignature = response.Signatures[i]
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/orders"
+)
+
+func TestLimitSigner(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	identity := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+	satellite := signing.SignerFromFullIdentity(identity)
+
+	newLimits := func() []*pb.OrderLimit {
+		limits := make([]*pb.OrderLimit, 3)
+		for i := range limits {
+			limits[i] = &pb.OrderLimit{
+				SerialNumber:    testrand.SerialNumber(),
+				SatelliteId:     satellite.ID(),
+				StorageNodeId:   testrand.NodeID(),
+				PieceId:         testrand.PieceID(),
+				Limit:           1024,
+				Action:          pb.PieceAction_GET,
+				OrderCreation:   time.Now(),
+				OrderExpiration: time.Now().Add(time.Hour),
+			}
+		}
+		return limits
+	}
+
+	verify := func(t *testing.T, limits []*pb.OrderLimit) {
+		for _, limit := range limits {
+			require.NoError(t, signing.VerifyOrderLimitSignature(ctx, satellite, limit))
+		}
+	}
+
+	t.Run("local", func(t *testing.T) {
+		signer, err := orders.NewLimitSigner(satellite, orders.SignerConfig{Backend: "local"})
+		require.NoError(t, err)
+
+		limits := newLimits()
+		require.NoError(t, signer.SignOrderLimits(ctx, limits))
+		verify(t, limits)
+	})
+
+	remoteSigner := func(token string, extra int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/sign" || r.Header.Get("Authorization") != "Bearer "+token {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+
+			var request struct {
+				SatelliteID storj.NodeID `json:"satellite_id"`
+				Messages    [][]byte     `json:"messages"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.SatelliteID != satellite.ID() {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			var response struct {
+				Signatures [][]byte `json:"signatures"`
+			}
+			for _, message := range request.Messages {
+				signature, err := satellite.HashAndSign(r.Context(), message)
+				if err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				response.Signatures = append(response.Signatures, signature)
+			}
+			for i := 0; i < extra; i++ {
+				response.Signatures = append(response.Signatures, response.Signatures[0])
+			}
+
+			_ = json.NewEncoder(w).Encode(response)
+		}))
+	}
+
+	t.Run("remote", func(t *testing.T) {
+		server := remoteSigner("secret", 0)
+		defer server.Close()
+
+		signer, err := orders.NewLimitSigner(satellite, orders.SignerConfig{
+			Backend:       "remote",
+			RemoteAddress: server.URL,
+			RemoteToken:   "secret",
+			RemoteTimeout: time.Minute,
+		})
+		require.NoError(t, err)
+
+		limits := newLimits()
+		require.NoError(t, signer.SignOrderLimits(ctx, limits))
+		verify(t, limits)
+	})
+
+	t.Run("remote unauthorized", func(t *testing.T) {
+		server := remoteSigner("secret", 0)
+		defer server.Close()
+
+		signer, err := orders.NewLimitSigner(satellite, orders.SignerConfig{
+			Backend:       "remote",
+			RemoteAddress: server.URL,
+			RemoteToken:   "wrong",
+			RemoteTimeout: time.Minute,
+		})
+		require.NoError(t, err)
+
+		err = signer.SignOrderLimits(ctx, newLimits())
+		require.Error(t, err)
+		require.True(t, orders.ErrLimitSigner.Has(err))
+	})
+
+	t.Run("remote signature count mismatch", func(t *testing.T) {
+		server := remoteSigner("secret", 1)
+		defer server.Close()
+
+		signer, err := orders.NewLimitSigner(satellite, orders.SignerConfig{
+			Backend:       "remote",
+			RemoteAddress: server.URL,
+			RemoteToken:   "secret",
+			RemoteTimeout: time.Minute,
+		})
+		require.NoError(t, err)
+
+		limits := newLimits()
+		err = signer.SignOrderLimits(ctx, limits)
+		require.Error(t, err)
+		require.True(t, orders.ErrLimitSigner.Has(err))
+		for _, limit := range limits {
+			require.Nil(t, limit.SatelliteSignature)
+		}
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := orders.NewLimitSigner(satellite, orders.SignerConfig{Backend: "unknown"})
+		require.Error(t, err)
+
+		_, err = orders.NewLimitSigner(satellite, orders.SignerConfig{Backend: "remote"})
+		require.Error(t, err)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/sync2"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
 )
 
 // CacheData stores the amount of inline and allocated data
@@ -35,6 +36,43 @@ type CacheKey struct {
 // RollupData contains the pending rollups waiting to be flushed to the db.
 type RollupData map[CacheKey]CacheData
 
+// APIKeyCacheKey is the key information of the cached api key bandwidth rollups.
+type APIKeyCacheKey struct {
+	ProjectID     uuid.UUID
+	APIKeyHead    string
+	Action        pb.PieceAction
+	IntervalStart int64
+}
+
+// APIKeyCacheData stores the amounts of an api key bandwidth rollup.
+type APIKeyCacheData struct {
+	Inline    int64
+	Allocated int64
+	Settled   int64
+	Requests  int64
+}
+
+// APIKeyRollupData contains the pending api key rollups waiting to be flushed to the db.
+type APIKeyRollupData map[APIKeyCacheKey]APIKeyCacheData
+
+// SharedLinkCacheKey is the key information of the cached shared link bandwidth rollups.
+type SharedLinkCacheKey struct {
+	ProjectID     uuid.UUID
+	BucketName    string
+	ObjectKey     metabase.ObjectKey
+	IntervalStart int64
+}
+
+// SharedLinkCacheData stores the amounts of a shared link bandwidth rollup.
+type SharedLinkCacheData struct {
+	Inline    int64
+	Allocated int64
+	Requests  int64
+}
+
+// SharedLinkRollupData contains the pending shared link rollups waiting to be flushed to the db.
+type SharedLinkRollupData map[SharedLinkCacheKey]SharedLinkCacheData
+
 // RollupsWriteCache stores information needed to update bucket bandwidth rollups.
 type RollupsWriteCache struct {
 	DB
@@ -42,10 +80,12 @@ type RollupsWriteCache struct {
 	wg        sync.WaitGroup
 	log       *zap.Logger
 
-	mu             sync.Mutex
-	pendingRollups RollupData
-	stopped        bool
-	flushing       bool
+	mu                       sync.Mutex
+	pendingRollups           RollupData
+	pendingAPIKeyRollups     APIKeyRollupData
+	pendingSharedLinkRollups SharedLinkRollupData
+	stopped                  bool
+	flushing                 bool
 
 	nextFlushCompletion *sync2.Fence
 }
@@ -53,11 +93,13 @@ type RollupsWriteCache struct {
 // NewRollupsWriteCache creates an RollupsWriteCache.
 func NewRollupsWriteCache(log *zap.Logger, db DB, batchSize int) *RollupsWriteCache {
 	return &RollupsWriteCache{
-		DB:                  db,
-		batchSize:           batchSize,
-		log:                 log,
-		pendingRollups:      make(RollupData),
-		nextFlushCompletion: new(sync2.Fence),
+		DB:                       db,
+		batchSize:                batchSize,
+		log:                      log,
+		pendingRollups:           make(RollupData),
+		pendingAPIKeyRollups:     make(APIKeyRollupData),
+		pendingSharedLinkRollups: make(SharedLinkRollupData),
+		nextFlushCompletion:      new(sync2.Fence),
 	}
 }
 
@@ -76,13 +118,123 @@ func (cache *RollupsWriteCache) UpdateBucketBandwidthSettle(ctx context.Context,
 	return cache.updateCacheValue(ctx, projectID, bucketName, action, 0, 0, settledAmount, deadAmount, intervalStart.UTC())
 }
 
+// UpdateAPIKeyBandwidth updates the rollups cache adding the data of api key bandwidth rollups.
+func (cache *RollupsWriteCache) UpdateAPIKeyBandwidth(ctx context.Context, rollups []APIKeyBandwidthRollup) error {
+	defer mon.Task()(&ctx)(nil)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.stopped {
+		return Error.New("RollupsWriteCache is stopped")
+	}
+
+	for _, rollup := range rollups {
+		intervalStart := rollup.IntervalStart.UTC()
+		key := APIKeyCacheKey{
+			ProjectID:     rollup.ProjectID,
+			APIKeyHead:    string(rollup.APIKeyHead),
+			Action:        rollup.Action,
+			IntervalStart: time.Date(intervalStart.Year(), intervalStart.Month(), intervalStart.Day(), intervalStart.Hour(), 0, 0, 0, intervalStart.Location()).Unix(),
+		}
+
+		data, ok := cache.pendingAPIKeyRollups[key]
+		if !ok && len(cache.pendingAPIKeyRollups) >= cache.batchSize {
+			mon.Event("rollups_write_cache_api_key_update_lost")
+			continue
+		}
+
+		data.Inline += rollup.Inline
+		data.Allocated += rollup.Allocated
+		data.Settled += rollup.Settled
+		data.Requests += rollup.Requests
+		cache.pendingAPIKeyRollups[key] = data
+	}
+
+	if len(cache.pendingAPIKeyRollups) >= cache.batchSize {
+		cache.flushInBackground(ctx)
+	}
+
+	return nil
+}
+
+// UpdateSharedLinkBandwidth updates the rollups cache adding the data of shared link bandwidth rollups.
+func (cache *RollupsWriteCache) UpdateSharedLinkBandwidth(ctx context.Context, rollups []SharedLinkBandwidthRollup) error {
+	defer mon.Task()(&ctx)(nil)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.stopped {
+		return Error.New("RollupsWriteCache is stopped")
+	}
+
+	for _, rollup := range rollups {
+		intervalStart := rollup.IntervalStart.UTC()
+		key := SharedLinkCacheKey{
+			ProjectID:     rollup.ProjectID,
+			BucketName:    rollup.BucketName,
+			ObjectKey:     rollup.ObjectKey,
+			IntervalStart: time.Date(intervalStart.Year(), intervalStart.Month(), intervalStart.Day(), intervalStart.Hour(), 0, 0, 0, intervalStart.Location()).Unix(),
+		}
+
+		data, ok := cache.pendingSharedLinkRollups[key]
+		if !ok && len(cache.pendingSharedLinkRollups) >= cache.batchSize {
+			mon.Event("rollups_write_cache_shared_link_update_lost")
+			continue
+		}
+
+		data.Inline += rollup.Inline
+		data.Allocated += rollup.Allocated
+		data.Requests += rollup.Requests
+		cache.pendingSharedLinkRollups[key] = data
+	}
+
+	if len(cache.pendingSharedLinkRollups) >= cache.batchSize {
+		cache.flushInBackground(ctx)
+	}
+
+	return nil
+}
+
+// pendingData contains all the pending rollups of the cache.
+type pendingData struct {
+	rollups           RollupData
+	apiKeyRollups     APIKeyRollupData
+	sharedLinkRollups SharedLinkRollupData
+}
+
 // resetCache should only be called after you have acquired the cache lock. It
-// will reset the various cache values and return the pendingRollups.
-func (cache *RollupsWriteCache) resetCache() RollupData {
-	pendingRollups := cache.pendingRollups
+// will reset the various cache values and return the pending rollups.
+func (cache *RollupsWriteCache) resetCache() pendingData {
+	pending := pendingData{
+		rollups:           cache.pendingRollups,
+		apiKeyRollups:     cache.pendingAPIKeyRollups,
+		sharedLinkRollups: cache.pendingSharedLinkRollups,
+	}
+
 	cache.pendingRollups = make(RollupData)
+	cache.pendingAPIKeyRollups = make(APIKeyRollupData)
+	cache.pendingSharedLinkRollups = make(SharedLinkRollupData)
+
+	return pending
+}
+
+// flushInBackground should only be called after you have acquired the cache
+// lock. It starts flushing the cache, unless it's already being flushed.
+func (cache *RollupsWriteCache) flushInBackground(ctx context.Context) {
+	if cache.flushing {
+		return
+	}
+
+	cache.flushing = true
+	pending := cache.resetCache()
 
-	return pendingRollups
+	cache.wg.Add(1)
+	go func() {
+		defer cache.wg.Done()
+		cache.flush(ctx, pending)
+	}()
 }
 
 // Flush resets cache then flushes the everything in the rollups write cache to the database.
@@ -106,11 +258,11 @@ func (cache *RollupsWriteCache) Flush(ctx context.Context) {
 	}
 
 	cache.flushing = true
-	pendingRollups := cache.resetCache()
+	pending := cache.resetCache()
 
 	cache.mu.Unlock()
 
-	cache.flush(ctx, pendingRollups)
+	cache.flush(ctx, pending)
 }
 
 // CloseAndFlush flushes anything in the cache and marks the cache as stopped.
@@ -126,12 +278,12 @@ func (cache *RollupsWriteCache) CloseAndFlush(ctx context.Context) error {
 }
 
 // flush flushes the everything in the rollups write cache to the database.
-func (cache *RollupsWriteCache) flush(ctx context.Context, pendingRollups RollupData) {
+func (cache *RollupsWriteCache) flush(ctx context.Context, pending pendingData) {
 	defer mon.Task()(&ctx)(nil)
 
-	if len(pendingRollups) > 0 {
-		rollups := make([]BucketBandwidthRollup, 0, len(pendingRollups))
-		for cacheKey, cacheData := range pendingRollups {
+	if len(pending.rollups) > 0 {
+		rollups := make([]BucketBandwidthRollup, 0, len(pending.rollups))
+		for cacheKey, cacheData := range pending.rollups {
 			rollups = append(rollups, BucketBandwidthRollup{
 				ProjectID:     cacheKey.ProjectID,
 				BucketName:    cacheKey.BucketName,
@@ -151,6 +303,49 @@ func (cache *RollupsWriteCache) flush(ctx context.Context, pendingRollups Rollup
 		}
 	}
 
+	if len(pending.apiKeyRollups) > 0 {
+		rollups := make([]APIKeyBandwidthRollup, 0, len(pending.apiKeyRollups))
+		for cacheKey, cacheData := range pending.apiKeyRollups {
+			rollups = append(rollups, APIKeyBandwidthRollup{
+				ProjectID:     cacheKey.ProjectID,
+				APIKeyHead:    []byte(cacheKey.APIKeyHead),
+				IntervalStart: time.Unix(cacheKey.IntervalStart, 0),
+				Action:        cacheKey.Action,
+				Inline:        cacheData.Inline,
+				Allocated:     cacheData.Allocated,
+				Settled:       cacheData.Settled,
+				Requests:      cacheData.Requests,
+			})
+		}
+
+		err := cache.DB.UpdateAPIKeyBandwidth(ctx, rollups)
+		if err != nil {
+			mon.Event("rollups_write_cache_api_key_flush_lost")
+			cache.log.Error("API key bandwidth rollup batch flush failed.", zap.Error(err))
+		}
+	}
+
+	if len(pending.sharedLinkRollups) > 0 {
+		rollups := make([]SharedLinkBandwidthRollup, 0, len(pending.sharedLinkRollups))
+		for cacheKey, cacheData := range pending.sharedLinkRollups {
+			rollups = append(rollups, SharedLinkBandwidthRollup{
+				ProjectID:     cacheKey.ProjectID,
+				BucketName:    cacheKey.BucketName,
+				ObjectKey:     cacheKey.ObjectKey,
+				IntervalStart: time.Unix(cacheKey.IntervalStart, 0),
+				Inline:        cacheData.Inline,
+				Allocated:     cacheData.Allocated,
+				Requests:      cacheData.Requests,
+			})
+		}
+
+		err := cache.DB.UpdateSharedLinkBandwidth(ctx, rollups)
+		if err != nil {
+			mon.Event("rollups_write_cache_shared_link_flush_lost")
+			cache.log.Error("Shared link bandwidth rollup batch flush failed.", zap.Error(err))
+		}
+	}
+
 	cache.mu.Lock()
 	defer cache.mu.Unlock()
 
@@ -195,16 +390,7 @@ func (cache *RollupsWriteCache) updateCacheValue(ctx context.Context, projectID
 		return nil
 	}
 
-	if !cache.flushing {
-		cache.flushing = true
-		pendingRollups := cache.resetCache()
-
-		cache.wg.Add(1)
-		go func() {
-			defer cache.wg.Done()
-			cache.flush(ctx, pendingRollups)
-		}()
-	}
+	cache.flushInBackground(ctx)
 
 	return nil
 }
@@ -38,6 +38,7 @@ type Config struct {
 	FlushInterval       time.Duration  `help:"how often to flush the rollups write cache to the database" devDefault:"30s" releaseDefault:"1m" testDefault:"$TESTINTERVAL"`
 	NodeStatusLogging   bool           `hidden:"true" help:"deprecated, log the offline/disqualification status of nodes" default:"false" testDefault:"true"`
 	OrdersSemaphoreSize int            `help:"how many concurrent orders to process at once. zero is unlimited" default:"2"`
+	Signer              SignerConfig
 }
 
 // BucketsDB returns information about buckets.
@@ -52,6 +53,7 @@ type BucketsDB interface {
 type Service struct {
 	log       *zap.Logger
 	satellite signing.Signer
+	limits    LimitSigner
 	overlay   *overlay.Service
 	orders    DB
 	buckets   BucketsDB
@@ -74,9 +76,15 @@ func NewService(
 		return nil, Error.New("encryption keys must be specified to include encrypted metadata")
 	}
 
+	limits, err := NewLimitSigner(satellite, config.Signer)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
 	return &Service{
 		log:       log,
 		satellite: satellite,
+		limits:    limits,
 		overlay:   overlay,
 		orders:    orders,
 		buckets:   buckets,
@@ -124,8 +132,51 @@ func (service *Service) updateBandwidth(ctx context.Context, bucket metabase.Buc
 	return nil
 }
 
+// updateAPIKeyBandwidth attributes the bandwidth of a request to the api key
+// which made it.
+func (service *Service) updateAPIKeyBandwidth(ctx context.Context, bucket metabase.BucketLocation, apiKeyHead []byte, action pb.PieceAction, allocated, inline int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(apiKeyHead) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	intervalStart := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, now.Location())
+
+	return service.orders.UpdateAPIKeyBandwidth(ctx, []APIKeyBandwidthRollup{{
+		ProjectID:     bucket.ProjectID,
+		APIKeyHead:    apiKeyHead,
+		Action:        action,
+		IntervalStart: intervalStart,
+		Allocated:     allocated,
+		Inline:        inline,
+		Requests:      1,
+	}})
+}
+
+// UpdateSharedLinkBandwidth attributes the egress of a download through a
+// shared link to the shared object. requests is the number of downloads,
+// which are started by the request.
+func (service *Service) UpdateSharedLinkBandwidth(ctx context.Context, object metabase.ObjectLocation, allocated, inline, requests int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now().UTC()
+	intervalStart := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, now.Location())
+
+	return Error.Wrap(service.orders.UpdateSharedLinkBandwidth(ctx, []SharedLinkBandwidthRollup{{
+		ProjectID:     object.ProjectID,
+		BucketName:    object.BucketName,
+		ObjectKey:     object.ObjectKey,
+		IntervalStart: intervalStart,
+		Allocated:     allocated,
+		Inline:        inline,
+		Requests:      requests,
+	}}))
+}
+
 // CreateGetOrderLimits creates the order limits for downloading the pieces of a segment.
-func (service *Service) CreateGetOrderLimits(ctx context.Context, bucket metabase.BucketLocation, segment metabase.Segment, overrideLimit int64) (_ []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, err error) {
+// The bandwidth is attributed to the api key with the head apiKeyHead, when it's not empty.
+func (service *Service) CreateGetOrderLimits(ctx context.Context, bucket metabase.BucketLocation, apiKeyHead []byte, segment metabase.Segment, overrideLimit int64) (_ []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
@@ -152,6 +203,7 @@ func (service *Service) CreateGetOrderLimits(ctx context.Context, bucket metabas
 	if err != nil {
 		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
+	signer.APIKeyHead = apiKeyHead
 
 	neededLimits := segment.Redundancy.DownloadNodes()
 
@@ -168,7 +220,7 @@ func (service *Service) CreateGetOrderLimits(ctx context.Context, bucket metabas
 			address = node.LastIPPort
 		}
 
-		_, err := signer.Sign(ctx, storj.NodeURL{
+		_, err := signer.Add(ctx, storj.NodeURL{
 			ID:      piece.StorageNode,
 			Address: address,
 		}, int32(piece.Number))
@@ -185,9 +237,16 @@ func (service *Service) CreateGetOrderLimits(ctx context.Context, bucket metabas
 		return nil, storj.PiecePrivateKey{}, ErrDownloadFailedNotEnoughPieces.New("not enough orderlimits: got %d, required %d", len(signer.AddressedLimits), redundancy.RequiredCount())
 	}
 
+	if err := signer.SignAll(ctx); err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
 	if err := service.updateBandwidth(ctx, bucket, signer.AddressedLimits...); err != nil {
 		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
+	if err := service.updateAPIKeyBandwidth(ctx, bucket, apiKeyHead, pb.PieceAction_GET, orderLimit*int64(len(signer.AddressedLimits)), 0); err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
 
 	signer.AddressedLimits, err = sortLimits(signer.AddressedLimits, segment)
 	if err != nil {
@@ -236,28 +295,37 @@ func getLimitByStorageNodeID(limits []*pb.AddressedOrderLimit, storageNodeID sto
 }
 
 // CreatePutOrderLimits creates the order limits for uploading pieces to nodes.
-func (service *Service) CreatePutOrderLimits(ctx context.Context, bucket metabase.BucketLocation, nodes []*overlay.SelectedNode, pieceExpiration time.Time, maxPieceSize int64) (_ storj.PieceID, _ []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, err error) {
+// The bandwidth is attributed to the api key with the head apiKeyHead, when it's not empty.
+func (service *Service) CreatePutOrderLimits(ctx context.Context, bucket metabase.BucketLocation, apiKeyHead []byte, nodes []*overlay.SelectedNode, pieceExpiration time.Time, maxPieceSize int64) (_ storj.PieceID, _ []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	signer, err := NewSignerPut(service, pieceExpiration, time.Now(), maxPieceSize, bucket)
 	if err != nil {
 		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
+	signer.APIKeyHead = apiKeyHead
 
 	for pieceNum, node := range nodes {
 		address := node.Address.Address
 		if node.LastIPPort != "" {
 			address = node.LastIPPort
 		}
-		_, err := signer.Sign(ctx, storj.NodeURL{ID: node.ID, Address: address}, int32(pieceNum))
+		_, err := signer.Add(ctx, storj.NodeURL{ID: node.ID, Address: address}, int32(pieceNum))
 		if err != nil {
 			return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 		}
 	}
 
+	if err := signer.SignAll(ctx); err != nil {
+		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
 	if err := service.updateBandwidth(ctx, bucket, signer.AddressedLimits...); err != nil {
 		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
+	if err := service.updateAPIKeyBandwidth(ctx, bucket, apiKeyHead, pb.PieceAction_PUT, maxPieceSize*int64(len(signer.AddressedLimits)), 0); err != nil {
+		return storj.PieceID{}, nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
 
 	return signer.RootPieceID, signer.AddressedLimits, signer.PrivateKey, nil
 }
@@ -300,7 +368,7 @@ func (service *Service) CreateAuditOrderLimits(ctx context.Context, segment meta
 		address := node.Address.Address
 		cachedNodesInfo[piece.StorageNode] = *node
 
-		limit, err := signer.Sign(ctx, storj.NodeURL{
+		limit, err := signer.Add(ctx, storj.NodeURL{
 			ID:      piece.StorageNode,
 			Address: address,
 		}, int32(piece.Number))
@@ -317,6 +385,10 @@ func (service *Service) CreateAuditOrderLimits(ctx context.Context, segment meta
 		return nil, storj.PiecePrivateKey{}, nil, errs.Combine(err, nodeErrors.Err())
 	}
 
+	if err := signer.SignAll(ctx); err != nil {
+		return nil, storj.PiecePrivateKey{}, nil, Error.Wrap(err)
+	}
+
 	return limits, signer.PrivateKey, cachedNodesInfo, nil
 }
 
@@ -409,7 +481,7 @@ func (service *Service) CreateGetRepairOrderLimits(ctx context.Context, bucket m
 
 		cachedNodesInfo[piece.StorageNode] = *node
 
-		limit, err := signer.Sign(ctx, storj.NodeURL{
+		limit, err := signer.Add(ctx, storj.NodeURL{
 			ID:      piece.StorageNode,
 			Address: node.Address.Address,
 		}, int32(piece.Number))
@@ -426,6 +498,10 @@ func (service *Service) CreateGetRepairOrderLimits(ctx context.Context, bucket m
 		return nil, storj.PiecePrivateKey{}, nil, errs.Combine(err, nodeErrors.Err())
 	}
 
+	if err := signer.SignAll(ctx); err != nil {
+		return nil, storj.PiecePrivateKey{}, nil, Error.Wrap(err)
+	}
+
 	if err := service.updateBandwidth(ctx, bucket, limits...); err != nil {
 		return nil, storj.PiecePrivateKey{}, nil, Error.Wrap(err)
 	}
@@ -482,7 +558,7 @@ func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, bucket m
 			return nil, storj.PiecePrivateKey{}, Error.New("piece num greater than total pieces: %d >= %d", pieceNum, totalPieces)
 		}
 
-		limit, err := signer.Sign(ctx, storj.NodeURL{
+		limit, err := signer.Add(ctx, storj.NodeURL{
 			ID:      node.ID,
 			Address: node.Address.Address,
 		}, pieceNum)
@@ -499,6 +575,10 @@ func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, bucket m
 		}
 	}
 
+	if err := signer.SignAll(ctx); err != nil {
+		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
+	}
+
 	if err := service.updateBandwidth(ctx, bucket, limits...); err != nil {
 		return nil, storj.PiecePrivateKey{}, Error.Wrap(err)
 	}
@@ -545,21 +625,29 @@ func (service *Service) CreateGracefulExitPutOrderLimit(ctx context.Context, buc
 }
 
 // UpdateGetInlineOrder updates amount of inline GET bandwidth for given bucket.
-func (service *Service) UpdateGetInlineOrder(ctx context.Context, bucket metabase.BucketLocation, amount int64) (err error) {
+// The bandwidth is attributed to the api key with the head apiKeyHead, when it's not empty.
+func (service *Service) UpdateGetInlineOrder(ctx context.Context, bucket metabase.BucketLocation, apiKeyHead []byte, amount int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	now := time.Now().UTC()
 	intervalStart := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, now.Location())
 
-	return service.orders.UpdateBucketBandwidthInline(ctx, bucket.ProjectID, []byte(bucket.BucketName), pb.PieceAction_GET, amount, intervalStart)
+	if err := service.orders.UpdateBucketBandwidthInline(ctx, bucket.ProjectID, []byte(bucket.BucketName), pb.PieceAction_GET, amount, intervalStart); err != nil {
+		return err
+	}
+	return service.updateAPIKeyBandwidth(ctx, bucket, apiKeyHead, pb.PieceAction_GET, 0, amount)
 }
 
 // UpdatePutInlineOrder updates amount of inline PUT bandwidth for given bucket.
-func (service *Service) UpdatePutInlineOrder(ctx context.Context, bucket metabase.BucketLocation, amount int64) (err error) {
+// The bandwidth is attributed to the api key with the head apiKeyHead, when it's not empty.
+func (service *Service) UpdatePutInlineOrder(ctx context.Context, bucket metabase.BucketLocation, apiKeyHead []byte, amount int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	now := time.Now().UTC()
 	intervalStart := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, now.Location())
 
-	return service.orders.UpdateBucketBandwidthInline(ctx, bucket.ProjectID, []byte(bucket.BucketName), pb.PieceAction_PUT, amount, intervalStart)
+	if err := service.orders.UpdateBucketBandwidthInline(ctx, bucket.ProjectID, []byte(bucket.BucketName), pb.PieceAction_PUT, amount, intervalStart); err != nil {
+		return err
+	}
+	return service.updateAPIKeyBandwidth(ctx, bucket, apiKeyHead, pb.PieceAction_PUT, 0, amount)
 }
 
 // DecryptOrderMetadata decrypts the order metadata.
@@ -12,7 +12,6 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/pb"
-	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
@@ -43,10 +42,17 @@ type Signer struct {
 	Action pb.PieceAction
 	Limit  int64
 
+	// APIKeyHead is the head of the api key which requested the order limits,
+	// it's empty for order limits created by the satellite.
+	APIKeyHead []byte
+
 	EncryptedMetadataKeyID []byte
 	EncryptedMetadata      []byte
 
 	AddressedLimits []*pb.AddressedOrderLimit
+
+	// unsigned are the added order limits which aren't signed yet.
+	unsigned []*pb.OrderLimit
 }
 
 // createSerial creates a timestamped serial number.
@@ -139,10 +145,27 @@ func NewSignerGracefulExit(service *Service, rootPieceID storj.PieceID, orderCre
 	return NewSigner(service, rootPieceID, time.Time{}, orderCreation, int64(shareSize), pb.PieceAction_PUT_REPAIR, bucket)
 }
 
-// Sign signs an order limit for the specified node.
+// Sign signs an order limit for the specified node, together with the added
+// order limits which aren't signed yet.
 func (signer *Signer) Sign(ctx context.Context, node storj.NodeURL, pieceNum int32) (_ *pb.AddressedOrderLimit, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	addressedLimit, err := signer.Add(ctx, node, pieceNum)
+	if err != nil {
+		return nil, err
+	}
+	if err := signer.SignAll(ctx); err != nil {
+		return nil, err
+	}
+	return addressedLimit, nil
+}
+
+// Add adds an unsigned order limit for the specified node. The order limit is
+// signed in place by the next call to SignAll, so the added order limits of a
+// request are signed with a single batch.
+func (signer *Signer) Add(ctx context.Context, node storj.NodeURL, pieceNum int32) (_ *pb.AddressedOrderLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	if len(signer.EncryptedMetadata) == 0 {
 		encryptionKey := signer.Service.encryptionKeys.Default
 		if encryptionKey.IsZero() {
@@ -153,6 +176,7 @@ func (signer *Signer) Sign(ctx context.Context, node storj.NodeURL, pieceNum int
 			signer.Serial,
 			&internalpb.OrderLimitMetadata{
 				CompactProjectBucketPrefix: signer.Bucket.CompactPrefix(),
+				ApiKeyHead:                 signer.APIKeyHead,
 			},
 		)
 		if err != nil {
@@ -180,19 +204,35 @@ func (signer *Signer) Sign(ctx context.Context, node storj.NodeURL, pieceNum int
 		EncryptedMetadata:      signer.EncryptedMetadata,
 	}
 
-	signedLimit, err := signing.SignOrderLimit(ctx, signer.Service.satellite, limit)
-	if err != nil {
-		return nil, ErrSigner.Wrap(err)
-	}
-
 	addressedLimit := &pb.AddressedOrderLimit{
-		Limit: signedLimit,
+		Limit: limit,
 		StorageNodeAddress: &pb.NodeAddress{
 			Address: node.Address,
 		},
 	}
 
 	signer.AddressedLimits = append(signer.AddressedLimits, addressedLimit)
+	signer.unsigned = append(signer.unsigned, limit)
 
 	return addressedLimit, nil
 }
+
+// SignAll signs the added order limits which aren't signed yet.
+func (signer *Signer) SignAll(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(signer.unsigned) == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	err = signer.Service.limits.SignOrderLimits(ctx, signer.unsigned)
+	mon.DurationVal("order_limit_signing_latency").Observe(time.Since(start))
+	mon.IntVal("order_limit_signing_batch_size").Observe(int64(len(signer.unsigned)))
+	if err != nil {
+		return ErrSigner.Wrap(err)
+	}
+
+	signer.unsigned = nil
+	return nil
+}
@@ -739,6 +739,12 @@ func TestAddrtoNetwork_Conversion(t *testing.T) {
 	require.Equal(t, "fc00::", network)
 	require.Equal(t, ipv6, net.JoinHostPort(resolvedIP.String(), port))
 	require.NoError(t, err)
+
+	// the only address is returned even when it isn't usable.
+	resolvedIP, port, network, err = overlay.ResolvePreferredIPAndNetwork(ctx, ipv6, func(net.IP) bool { return false })
+	require.Equal(t, "fc00::", network)
+	require.Equal(t, ipv6, net.JoinHostPort(resolvedIP.String(), port))
+	require.NoError(t, err)
 }
 
 func TestCacheSelectionVsDBSelection(t *testing.T) {
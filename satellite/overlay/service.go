@@ -67,6 +67,8 @@ type DB interface {
 	KnownReliable(ctx context.Context, onlineWindow time.Duration, nodeIDs storj.NodeIDList) ([]*pb.Node, error)
 	// Reliable returns all nodes that are reliable
 	Reliable(context.Context, *NodeCriteria) (storj.NodeIDList, error)
+	// ReliableNodes returns all nodes that are reliable with their country code.
+	ReliableNodes(context.Context, *NodeCriteria) ([]*SelectedNode, error)
 	// UpdateReputation updates the DB columns for all reputation fields in ReputationStatus.
 	UpdateReputation(ctx context.Context, id storj.NodeID, request ReputationUpdate) error
 	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
@@ -527,6 +529,18 @@ func (service *Service) Reliable(ctx context.Context) (nodes storj.NodeIDList, e
 	return service.db.Reliable(ctx, criteria)
 }
 
+// ReliableNodes returns the nodes that are reliable, independent of new,
+// with their country code.
+func (service *Service) ReliableNodes(ctx context.Context) (nodes []*SelectedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	criteria := &NodeCriteria{
+		OnlineWindow:      service.config.Node.OnlineWindow,
+		ExcludedCountries: service.config.RepairExcludedCountryCodes,
+	}
+	return service.db.ReliableNodes(ctx, criteria)
+}
+
 // UpdateReputation updates the DB columns for any of the reputation fields.
 func (service *Service) UpdateReputation(ctx context.Context, id storj.NodeID, request ReputationUpdate) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -672,30 +686,64 @@ func (service *Service) DisqualifyNode(ctx context.Context, nodeID storj.NodeID,
 
 // ResolveIPAndNetwork resolves the target address and determines its IP and /24 subnet IPv4 or /64 subnet IPv6.
 func ResolveIPAndNetwork(ctx context.Context, target string) (ip net.IP, port, network string, err error) {
+	return ResolvePreferredIPAndNetwork(ctx, target, func(net.IP) bool { return true })
+}
+
+// ResolvePreferredIPAndNetwork resolves the target address and determines its IP and /24 subnet IPv4 or /64 subnet IPv6.
+//
+// When the host of a dual-stack node resolves to multiple addresses, the first usable IPv4 address is
+// preferred, then the first usable IPv6 address. When none of the addresses is usable, the first one is returned.
+func ResolvePreferredIPAndNetwork(ctx context.Context, target string, usable func(ip net.IP) bool) (ip net.IP, port, network string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	host, port, err := net.SplitHostPort(target)
 	if err != nil {
 		return nil, "", "", err
 	}
-	ipAddr, err := net.ResolveIPAddr("ip", host)
+	ipAddrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
 	if err != nil {
 		return nil, "", "", err
 	}
+	if len(ipAddrs) == 0 {
+		return nil, "", "", errors.New("no IP address found for host " + host)
+	}
+
+	ip = preferredIP(ipAddrs, usable)
 
 	// If addr can be converted to 4byte notation, it is an IPv4 address, else its an IPv6 address
-	if ipv4 := ipAddr.IP.To4(); ipv4 != nil {
+	if ipv4 := ip.To4(); ipv4 != nil {
 		// Filter all IPv4 Addresses into /24 Subnet's
 		mask := net.CIDRMask(24, 32)
-		return ipAddr.IP, port, ipv4.Mask(mask).String(), nil
+		return ip, port, ipv4.Mask(mask).String(), nil
 	}
-	if ipv6 := ipAddr.IP.To16(); ipv6 != nil {
+	if ipv6 := ip.To16(); ipv6 != nil {
 		// Filter all IPv6 Addresses into /64 Subnet's
 		mask := net.CIDRMask(64, 128)
-		return ipAddr.IP, port, ipv6.Mask(mask).String(), nil
+		return ip, port, ipv6.Mask(mask).String(), nil
 	}
 
-	return nil, "", "", errors.New("unable to get network for address " + ipAddr.String())
+	return nil, "", "", errors.New("unable to get network for address " + ip.String())
+}
+
+// preferredIP returns the first usable IPv4 address, otherwise the first usable IPv6 address,
+// otherwise the first address.
+func preferredIP(ipAddrs []net.IPAddr, usable func(ip net.IP) bool) net.IP {
+	var ipv6 net.IP
+	for _, ipAddr := range ipAddrs {
+		if !usable(ipAddr.IP) {
+			continue
+		}
+		if ipAddr.IP.To4() != nil {
+			return ipAddr.IP
+		}
+		if ipv6 == nil {
+			ipv6 = ipAddr.IP
+		}
+	}
+	if ipv6 != nil {
+		return ipv6
+	}
+	return ipAddrs[0].IP
 }
 
 // TestVetNode directly sets a node's vetted_at timestamp to make testing easier.
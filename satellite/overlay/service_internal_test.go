@@ -0,0 +1,47 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreferredIP(t *testing.T) {
+	ipv4 := net.ParseIP("1.2.3.4")
+	ipv4Private := net.ParseIP("10.0.0.1")
+	ipv6 := net.ParseIP("2001:db8::1")
+	ipv6Private := net.ParseIP("fd00::1")
+
+	public := func(ip net.IP) bool { return !ip.IsPrivate() }
+	all := func(net.IP) bool { return true }
+	none := func(net.IP) bool { return false }
+
+	for _, tt := range []struct {
+		name     string
+		addrs    []net.IP
+		usable   func(net.IP) bool
+		expected net.IP
+	}{
+		{name: "ipv4 only", addrs: []net.IP{ipv4}, usable: all, expected: ipv4},
+		{name: "ipv6 only", addrs: []net.IP{ipv6}, usable: all, expected: ipv6},
+		{name: "dual-stack ipv4 first", addrs: []net.IP{ipv4, ipv6}, usable: all, expected: ipv4},
+		{name: "dual-stack ipv6 first", addrs: []net.IP{ipv6, ipv4}, usable: all, expected: ipv4},
+		{name: "first usable ipv4", addrs: []net.IP{ipv4Private, ipv6, ipv4}, usable: public, expected: ipv4},
+		{name: "unusable ipv4 falls back to ipv6", addrs: []net.IP{ipv4Private, ipv6Private, ipv6}, usable: public, expected: ipv6},
+		{name: "first of several ipv6", addrs: []net.IP{ipv6, ipv6Private}, usable: all, expected: ipv6},
+		{name: "nothing usable returns first", addrs: []net.IP{ipv6Private, ipv4Private}, usable: none, expected: ipv6Private},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ipAddrs := make([]net.IPAddr, 0, len(tt.addrs))
+			for _, ip := range tt.addrs {
+				ipAddrs = append(ipAddrs, net.IPAddr{IP: ip})
+			}
+			require.Equal(t, tt.expected, preferredIP(ipAddrs, tt.usable))
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
 )
 
 // ErrAccountNotSetup is an error type which indicates that payment account is not created.
@@ -29,6 +30,9 @@ type Accounts interface {
 	// ProjectCharges returns how much money current user will be charged for each project.
 	ProjectCharges(ctx context.Context, userID uuid.UUID, since, before time.Time) ([]ProjectCharge, error)
 
+	// ProjectUsagePrice returns how much money the given project usage costs.
+	ProjectUsagePrice(usage accounting.ProjectUsage) ProjectCharge
+
 	// CheckProjectInvoicingStatus returns error if for the given project there are outstanding project records and/or usage
 	// which have not been applied/invoiced yet (meaning sent over to stripe).
 	CheckProjectInvoicingStatus(ctx context.Context, projectID uuid.UUID) error
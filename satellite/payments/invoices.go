@@ -7,9 +7,15 @@ import (
 	"context"
 	"time"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/common/uuid"
 )
 
+// ErrInvalidWebhookEvent is used when a webhook event of the payment provider
+// can't be verified or parsed.
+var ErrInvalidWebhookEvent = errs.Class("invalid webhook event")
+
 // Invoices exposes all needed functionality to manage account invoices.
 //
 // architecture: Service
@@ -20,6 +26,9 @@ type Invoices interface {
 	ListWithDiscounts(ctx context.Context, userID uuid.UUID) ([]Invoice, []CouponUsage, error)
 	// CheckPendingItems returns if pending invoice items for a given payment account exist.
 	CheckPendingItems(ctx context.Context, userID uuid.UUID) (existingItems bool, err error)
+	// HandleWebhookEvent handles a signed webhook event of the payment provider,
+	// e.g. freezes the projects of the customer, whose invoice payment failed.
+	HandleWebhookEvent(ctx context.Context, payload []byte, signature string) error
 }
 
 // Invoice holds all public information about invoice.
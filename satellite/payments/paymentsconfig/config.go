@@ -4,6 +4,7 @@
 package paymentsconfig
 
 import (
+	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 )
@@ -21,6 +22,10 @@ type Config struct {
 	NodeRepairBandwidthPrice int64  `help:"price node receive for storing TB of repair in cents" default:"1000"`
 	NodeAuditBandwidthPrice  int64  `help:"price node receive for storing TB of audit in cents" default:"1000"`
 	NodeDiskSpacePrice       int64  `help:"price node receive for storing disk space in cents/TB" default:"150"`
+
+	PlacementPrices payments.PlacementPrices `help:"prices user should pay for the usage of buckets in specific placements, e.g. '1:storage=6,egress=9,segment=0.0000088'. other placements use the default prices" default:""`
+	PriceTiers      payments.PriceTiers      `help:"prices user should pay for the monthly usage of a project above thresholds, e.g. 'storage:10=3.5,100=3;egress:10=6'. thresholds are in TB-month for storage, TB for egress and segment-month for segments. usage below the first threshold uses the default prices" default:""`
+	VolumeDiscounts payments.VolumeDiscounts `help:"contractual discounts of customers in percents of their usage charges, e.g. '<user-id>:10,<user-id>:5'" default:""`
 }
 
 // PricingValues holds pricing model for satellite.
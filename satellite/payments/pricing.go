@@ -0,0 +1,286 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// ProjectUsagePrice holds the prices of the usage of a project, in dollars.
+type ProjectUsagePrice struct {
+	// StorageTB is the price of storing a TB for a month.
+	StorageTB decimal.Decimal
+	// EgressTB is the price of a TB of egress.
+	EgressTB decimal.Decimal
+	// Segment is the price of storing a segment for a month.
+	Segment decimal.Decimal
+}
+
+// PlacementPrices holds the prices of the usage of the buckets in specific
+// placements. Usage in other placements is charged with the default prices.
+//
+// It's configured as "placement:storage=price,egress=price,segment=price"
+// entries separated by semicolons, e.g.
+// "1:storage=6,egress=9,segment=0.0000088;2:storage=5,egress=8,segment=0.0000088".
+type PlacementPrices map[storj.PlacementConstraint]ProjectUsagePrice
+
+// Type implements pflag.Value.
+func (PlacementPrices) Type() string { return "payments.PlacementPrices" }
+
+// String implements pflag.Value.
+func (prices PlacementPrices) String() string {
+	placements := make([]storj.PlacementConstraint, 0, len(prices))
+	for placement := range prices {
+		placements = append(placements, placement)
+	}
+	sort.Slice(placements, func(i, k int) bool { return placements[i] < placements[k] })
+
+	entries := make([]string, 0, len(placements))
+	for _, placement := range placements {
+		price := prices[placement]
+		entries = append(entries, strconv.Itoa(int(placement))+
+			":storage="+price.StorageTB.String()+
+			",egress="+price.EgressTB.String()+
+			",segment="+price.Segment.String())
+	}
+	return strings.Join(entries, ";")
+}
+
+// Set implements pflag.Value.
+func (prices *PlacementPrices) Set(s string) error {
+	parsed := make(PlacementPrices)
+
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		colon := strings.Index(entry, ":")
+		if colon < 0 {
+			return errs.New("invalid placement prices %q: missing placement", entry)
+		}
+		placement, err := strconv.ParseUint(strings.TrimSpace(entry[:colon]), 10, 16)
+		if err != nil {
+			return errs.New("invalid placement in %q: %v", entry, err)
+		}
+		if _, ok := parsed[storj.PlacementConstraint(placement)]; ok {
+			return errs.New("duplicate prices for placement %d", placement)
+		}
+
+		var price ProjectUsagePrice
+		set := map[string]bool{}
+		for _, pair := range strings.Split(entry[colon+1:], ",") {
+			eq := strings.Index(pair, "=")
+			if eq < 0 {
+				return errs.New("invalid price %q for placement %d", pair, placement)
+			}
+			key := strings.TrimSpace(pair[:eq])
+			value, err := decimal.NewFromString(strings.TrimSpace(pair[eq+1:]))
+			if err != nil {
+				return errs.New("invalid %s price for placement %d: %v", key, placement, err)
+			}
+			if value.IsNegative() {
+				return errs.New("negative %s price for placement %d", key, placement)
+			}
+
+			switch key {
+			case "storage":
+				price.StorageTB = value
+			case "egress":
+				price.EgressTB = value
+			case "segment":
+				price.Segment = value
+			default:
+				return errs.New("unknown price %q for placement %d", key, placement)
+			}
+			set[key] = true
+		}
+		for _, key := range []string{"storage", "egress", "segment"} {
+			if !set[key] {
+				return errs.New("missing %s price for placement %d", key, placement)
+			}
+		}
+
+		parsed[storj.PlacementConstraint(placement)] = price
+	}
+
+	*prices = parsed
+	return nil
+}
+
+// PriceTier is the price of the usage above a threshold.
+type PriceTier struct {
+	// Above is the threshold in TB-month for storage, in TB for egress and
+	// in segment-month for segments.
+	Above decimal.Decimal
+	// Price is in dollars per the same unit as the threshold.
+	Price decimal.Decimal
+}
+
+// PriceTiers holds the tiered prices of the monthly usage of a project. The
+// usage above the threshold of a tier, up to the threshold of the next tier,
+// is charged with the price of the tier. The usage below the first threshold
+// is charged with the default prices.
+//
+// It's configured as "kind:threshold=price" entries separated by semicolons,
+// where the tiers of a kind are separated by commas, e.g.
+// "storage:10=3.5,100=3;egress:10=6".
+type PriceTiers struct {
+	Storage []PriceTier
+	Egress  []PriceTier
+	Segment []PriceTier
+}
+
+// Type implements pflag.Value.
+func (PriceTiers) Type() string { return "payments.PriceTiers" }
+
+// String implements pflag.Value.
+func (tiers PriceTiers) String() string {
+	var entries []string
+	for _, kind := range []struct {
+		name  string
+		tiers []PriceTier
+	}{
+		{"storage", tiers.Storage},
+		{"egress", tiers.Egress},
+		{"segment", tiers.Segment},
+	} {
+		if len(kind.tiers) == 0 {
+			continue
+		}
+		pairs := make([]string, 0, len(kind.tiers))
+		for _, tier := range kind.tiers {
+			pairs = append(pairs, tier.Above.String()+"="+tier.Price.String())
+		}
+		entries = append(entries, kind.name+":"+strings.Join(pairs, ","))
+	}
+	return strings.Join(entries, ";")
+}
+
+// Set implements pflag.Value.
+func (tiers *PriceTiers) Set(s string) error {
+	var parsed PriceTiers
+
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		colon := strings.Index(entry, ":")
+		if colon < 0 {
+			return errs.New("invalid price tiers %q: missing kind", entry)
+		}
+		kind := strings.TrimSpace(entry[:colon])
+
+		var kindTiers *[]PriceTier
+		switch kind {
+		case "storage":
+			kindTiers = &parsed.Storage
+		case "egress":
+			kindTiers = &parsed.Egress
+		case "segment":
+			kindTiers = &parsed.Segment
+		default:
+			return errs.New("unknown price tiers kind %q", kind)
+		}
+		if len(*kindTiers) > 0 {
+			return errs.New("duplicate price tiers for %s", kind)
+		}
+
+		for _, pair := range strings.Split(entry[colon+1:], ",") {
+			eq := strings.Index(pair, "=")
+			if eq < 0 {
+				return errs.New("invalid %s price tier %q", kind, pair)
+			}
+			above, err := decimal.NewFromString(strings.TrimSpace(pair[:eq]))
+			if err != nil {
+				return errs.New("invalid %s price tier threshold: %v", kind, err)
+			}
+			price, err := decimal.NewFromString(strings.TrimSpace(pair[eq+1:]))
+			if err != nil {
+				return errs.New("invalid %s price tier price: %v", kind, err)
+			}
+			if !above.IsPositive() {
+				return errs.New("%s price tier threshold must be positive", kind)
+			}
+			if price.IsNegative() {
+				return errs.New("negative %s price tier price", kind)
+			}
+			if n := len(*kindTiers); n > 0 && !above.GreaterThan((*kindTiers)[n-1].Above) {
+				return errs.New("%s price tier thresholds must be increasing", kind)
+			}
+
+			*kindTiers = append(*kindTiers, PriceTier{Above: above, Price: price})
+		}
+	}
+
+	*tiers = parsed
+	return nil
+}
+
+// VolumeDiscounts holds the contractual discounts of customers, in percents of
+// their usage charges, by the ID of the user.
+//
+// It's configured as "user-id:percent" entries separated by commas, e.g.
+// "ab9e6a1a-6f4e-4bb4-8cb5-1e2c3dc8c9a6:10".
+type VolumeDiscounts map[uuid.UUID]decimal.Decimal
+
+// Type implements pflag.Value.
+func (VolumeDiscounts) Type() string { return "payments.VolumeDiscounts" }
+
+// String implements pflag.Value.
+func (discounts VolumeDiscounts) String() string {
+	entries := make([]string, 0, len(discounts))
+	for userID, percent := range discounts {
+		entries = append(entries, userID.String()+":"+percent.String())
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+// Set implements pflag.Value.
+func (discounts *VolumeDiscounts) Set(s string) error {
+	parsed := make(VolumeDiscounts)
+
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		colon := strings.Index(entry, ":")
+		if colon < 0 {
+			return errs.New("invalid volume discount %q: missing percent", entry)
+		}
+		userID, err := uuid.FromString(strings.TrimSpace(entry[:colon]))
+		if err != nil {
+			return errs.New("invalid user ID in %q: %v", entry, err)
+		}
+		if _, ok := parsed[userID]; ok {
+			return errs.New("duplicate volume discount for user %s", userID)
+		}
+		percent, err := decimal.NewFromString(strings.TrimSpace(entry[colon+1:]))
+		if err != nil {
+			return errs.New("invalid volume discount for user %s: %v", userID, err)
+		}
+		if percent.IsNegative() || percent.GreaterThan(decimal.NewFromInt(100)) {
+			return errs.New("volume discount for user %s must be between 0 and 100 percent", userID)
+		}
+
+		parsed[userID] = percent
+	}
+
+	*discounts = parsed
+	return nil
+}
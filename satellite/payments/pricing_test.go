@@ -0,0 +1,107 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/payments"
+)
+
+func TestPlacementPrices(t *testing.T) {
+	var prices payments.PlacementPrices
+	require.NoError(t, prices.Set(""))
+	require.Empty(t, prices)
+	require.Equal(t, "", prices.String())
+
+	require.NoError(t, prices.Set("2:storage=5,egress=8,segment=0.0000088; 1:storage=6,egress=9,segment=0.0000176"))
+	require.Len(t, prices, 2)
+	require.True(t, decimal.NewFromInt(6).Equal(prices[1].StorageTB))
+	require.True(t, decimal.NewFromInt(9).Equal(prices[1].EgressTB))
+	require.True(t, decimal.RequireFromString("0.0000176").Equal(prices[1].Segment))
+	require.Equal(t, "1:storage=6,egress=9,segment=0.0000176;2:storage=5,egress=8,segment=0.0000088", prices.String())
+
+	var parsed payments.PlacementPrices
+	require.NoError(t, parsed.Set(prices.String()))
+	require.Equal(t, prices.String(), parsed.String())
+
+	for _, invalid := range []string{
+		"storage=6,egress=9,segment=0.0000088",
+		"x:storage=6,egress=9,segment=0.0000088",
+		"1:storage=6,egress=9",
+		"1:storage=6,egress=9,segment=0.0000088,repair=1",
+		"1:storage=-6,egress=9,segment=0.0000088",
+		"1:storage=six,egress=9,segment=0.0000088",
+		"1:storage=6,egress=9,segment=0.0000088;1:storage=6,egress=9,segment=0.0000088",
+	} {
+		var prices payments.PlacementPrices
+		require.Error(t, prices.Set(invalid), invalid)
+	}
+}
+
+func TestPriceTiers(t *testing.T) {
+	var tiers payments.PriceTiers
+	require.NoError(t, tiers.Set(""))
+	require.Empty(t, tiers.Storage)
+	require.Equal(t, "", tiers.String())
+
+	require.NoError(t, tiers.Set("segment:1000000=0.000006; storage:10=3.5,100=3"))
+	require.Len(t, tiers.Storage, 2)
+	require.Empty(t, tiers.Egress)
+	require.Len(t, tiers.Segment, 1)
+	require.True(t, decimal.NewFromInt(10).Equal(tiers.Storage[0].Above))
+	require.True(t, decimal.RequireFromString("3.5").Equal(tiers.Storage[0].Price))
+	require.True(t, decimal.NewFromInt(100).Equal(tiers.Storage[1].Above))
+	require.Equal(t, "storage:10=3.5,100=3;segment:1000000=0.000006", tiers.String())
+
+	var parsed payments.PriceTiers
+	require.NoError(t, parsed.Set(tiers.String()))
+	require.Equal(t, tiers.String(), parsed.String())
+
+	for _, invalid := range []string{
+		"10=3.5",
+		"repair:10=3.5",
+		"storage:10",
+		"storage:0=3.5",
+		"storage:10=-3.5",
+		"storage:ten=3.5",
+		"storage:100=3,10=3.5",
+		"storage:10=3.5;storage:100=3",
+	} {
+		var tiers payments.PriceTiers
+		require.Error(t, tiers.Set(invalid), invalid)
+	}
+}
+
+func TestVolumeDiscounts(t *testing.T) {
+	var discounts payments.VolumeDiscounts
+	require.NoError(t, discounts.Set(""))
+	require.Empty(t, discounts)
+	require.Equal(t, "", discounts.String())
+
+	userID := testrand.UUID()
+	require.NoError(t, discounts.Set(userID.String()+":12.5"))
+	require.Len(t, discounts, 1)
+	require.True(t, decimal.RequireFromString("12.5").Equal(discounts[userID]))
+
+	var parsed payments.VolumeDiscounts
+	require.NoError(t, parsed.Set(discounts.String()))
+	require.Equal(t, discounts.String(), parsed.String())
+
+	for _, invalid := range []string{
+		userID.String(),
+		"user:10",
+		userID.String() + ":ten",
+		userID.String() + ":-10",
+		userID.String() + ":110",
+		userID.String() + ":10," + userID.String() + ":5",
+	} {
+		var discounts payments.VolumeDiscounts
+		require.Error(t, discounts.Set(invalid), invalid)
+	}
+}
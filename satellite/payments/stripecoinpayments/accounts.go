@@ -11,6 +11,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/payments"
 )
 
@@ -132,21 +133,40 @@ func (accounts *accounts) ProjectCharges(ctx context.Context, userID uuid.UUID,
 			return charges, Error.Wrap(err)
 		}
 
-		projectPrice := accounts.service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.SegmentCount)
+		charge := accounts.ProjectUsagePrice(*usage)
+		charge.ProjectID = project.ID
 
-		charges = append(charges, payments.ProjectCharge{
-			ProjectUsage: *usage,
+		if len(accounts.service.PlacementPrices) > 0 {
+			placementUsages, err := accounts.service.usageDB.GetProjectTotalByPlacement(ctx, project.ID, since, before)
+			if err != nil {
+				return charges, Error.Wrap(err)
+			}
 
-			ProjectID:    project.ID,
-			Egress:       projectPrice.Egress.IntPart(),
-			SegmentCount: projectPrice.Segments.IntPart(),
-			StorageGbHrs: projectPrice.Storage.IntPart(),
-		})
+			projectPrice := accounts.service.calculateProjectUsagePriceByPlacement(*usage, placementUsages)
+			charge.Egress = projectPrice.Egress.IntPart()
+			charge.SegmentCount = projectPrice.Segments.IntPart()
+			charge.StorageGbHrs = projectPrice.Storage.IntPart()
+		}
+
+		charges = append(charges, charge)
 	}
 
 	return charges, nil
 }
 
+// ProjectUsagePrice returns how much money the given project usage costs.
+func (accounts *accounts) ProjectUsagePrice(usage accounting.ProjectUsage) payments.ProjectCharge {
+	projectPrice := accounts.service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.SegmentCount)
+
+	return payments.ProjectCharge{
+		ProjectUsage: usage,
+
+		Egress:       projectPrice.Egress.IntPart(),
+		SegmentCount: projectPrice.Segments.IntPart(),
+		StorageGbHrs: projectPrice.Storage.IntPart(),
+	}
+}
+
 // CheckProjectInvoicingStatus returns error if for the given project there are outstanding project records and/or usage
 // which have not been applied/invoiced yet (meaning sent over to stripe).
 func (accounts *accounts) CheckProjectInvoicingStatus(ctx context.Context, projectID uuid.UUID) (err error) {
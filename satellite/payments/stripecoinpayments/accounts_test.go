@@ -71,6 +71,9 @@ func TestSignupCouponCodes(t *testing.T) {
 			pc.StorageTBPrice,
 			pc.EgressTBPrice,
 			pc.SegmentPrice,
+			pc.PlacementPrices,
+			pc.PriceTiers,
+			pc.VolumeDiscounts,
 			pc.BonusRate)
 		require.NoError(t, err)
 
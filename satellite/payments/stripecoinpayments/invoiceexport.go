@@ -0,0 +1,273 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stripecoinpayments
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/stripe/stripe-go/v72"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+// ErrInvoiceExport defines invoice export error.
+var ErrInvoiceExport = errs.Class("invoice export")
+
+// invoiceBillingPeriodMetadata is the metadata key of the invoices, which
+// contains the billing period of the invoice.
+const invoiceBillingPeriodMetadata = "billingPeriod"
+
+// InvoiceExportConfig defines the configuration of the export of the
+// finalized invoices to external systems.
+type InvoiceExportConfig struct {
+	WebhookURL    string        `help:"url to which the finalized invoices of a billing period are posted, disabled when empty" default:""`
+	WebhookSecret string        `help:"secret used to sign the invoice export webhook requests" default:""`
+	Timeout       time.Duration `help:"timeout of an invoice export webhook request" default:"1m"`
+}
+
+// InvoiceExport contains the finalized invoices of a billing period.
+type InvoiceExport struct {
+	PeriodStart time.Time         `json:"periodStart"`
+	PeriodEnd   time.Time         `json:"periodEnd"`
+	Invoices    []ExportedInvoice `json:"invoices"`
+}
+
+// ExportedInvoice is a finalized invoice of a customer.
+//
+// All the amounts are in cents.
+type ExportedInvoice struct {
+	ID          string    `json:"id"`
+	Number      string    `json:"number"`
+	CustomerID  string    `json:"customerId"`
+	UserID      uuid.UUID `json:"userId"`
+	Status      string    `json:"status"`
+	Currency    string    `json:"currency"`
+	Created     time.Time `json:"created"`
+	FinalizedAt time.Time `json:"finalizedAt"`
+
+	Subtotal   int64 `json:"subtotal"`
+	Total      int64 `json:"total"`
+	AmountDue  int64 `json:"amountDue"`
+	AmountPaid int64 `json:"amountPaid"`
+
+	LineItems []ExportedLineItem `json:"lineItems"`
+}
+
+// ExportedLineItem is a line item of a finalized invoice.
+//
+// All the amounts are in cents.
+type ExportedLineItem struct {
+	ID          string            `json:"id"`
+	Description string            `json:"description"`
+	ProjectID   string            `json:"projectId,omitempty"`
+	Quantity    int64             `json:"quantity"`
+	UnitAmount  float64           `json:"unitAmount"`
+	Amount      int64             `json:"amount"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// invoiceDescription returns the description of the invoice of the billing period.
+func invoiceDescription(period time.Time) string {
+	return fmt.Sprintf("Storj DCS Cloud Storage for %s %d", period.Month(), period.Year())
+}
+
+// ExportInvoices returns the finalized invoices of the billing period with
+// their line items.
+func (service *Service) ExportInvoices(ctx context.Context, period time.Time) (_ *InvoiceExport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	utc := period.UTC()
+	start := time.Date(utc.Year(), utc.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(utc.Year(), utc.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+
+	userIDs, err := service.customerUserIDs(ctx)
+	if err != nil {
+		return nil, ErrInvoiceExport.Wrap(err)
+	}
+
+	export := &InvoiceExport{
+		PeriodStart: start,
+		PeriodEnd:   end,
+	}
+
+	params := &stripe.InvoiceListParams{
+		CreatedRange: &stripe.RangeQueryParams{GreaterThanOrEqual: start.Unix()},
+	}
+
+	invoicesIterator := service.stripeClient.Invoices().List(params)
+	for invoicesIterator.Next() {
+		stripeInvoice := invoicesIterator.Invoice()
+		if !invoiceOfPeriod(stripeInvoice, start) || stripeInvoice.Status == stripe.InvoiceStatusDraft {
+			continue
+		}
+
+		var customerID string
+		if stripeInvoice.Customer != nil {
+			customerID = stripeInvoice.Customer.ID
+		}
+
+		var items []*stripe.InvoiceItem
+		itemsIterator := service.stripeClient.InvoiceItems().List(&stripe.InvoiceItemListParams{
+			Invoice: stripe.String(stripeInvoice.ID),
+		})
+		for itemsIterator.Next() {
+			items = append(items, itemsIterator.InvoiceItem())
+		}
+		if err = itemsIterator.Err(); err != nil {
+			return nil, ErrInvoiceExport.Wrap(err)
+		}
+
+		export.Invoices = append(export.Invoices, exportInvoice(stripeInvoice, userIDs[customerID], items))
+	}
+	if err = invoicesIterator.Err(); err != nil {
+		return nil, ErrInvoiceExport.Wrap(err)
+	}
+
+	service.log.Info("Exported invoices.", zap.Time("Period", start), zap.Int("Invoices", len(export.Invoices)))
+	return export, nil
+}
+
+// customerUserIDs returns the user IDs of the customers by their stripe customer ID.
+func (service *Service) customerUserIDs(ctx context.Context) (_ map[string]uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	userIDs := map[string]uuid.UUID{}
+	now := service.nowFn()
+
+	var offset int64
+	for {
+		customersPage, err := service.db.Customers().List(ctx, offset, service.listingLimit, now)
+		if err != nil {
+			return nil, err
+		}
+		for _, customer := range customersPage.Customers {
+			userIDs[customer.ID] = customer.UserID
+		}
+		if !customersPage.Next {
+			return userIDs, nil
+		}
+		offset = customersPage.NextOffset
+	}
+}
+
+// invoiceOfPeriod returns whether the invoice was created for the billing
+// period starting at start. Invoices created before the billing period was
+// added to their metadata are matched by their description.
+func invoiceOfPeriod(invoice *stripe.Invoice, start time.Time) bool {
+	if period, ok := invoice.Metadata[invoiceBillingPeriodMetadata]; ok {
+		return period == start.Format("2006-01")
+	}
+	return invoice.Description == invoiceDescription(start)
+}
+
+// exportInvoice converts the stripe invoice and its items to an exported invoice.
+func exportInvoice(invoice *stripe.Invoice, userID uuid.UUID, items []*stripe.InvoiceItem) ExportedInvoice {
+	exported := ExportedInvoice{
+		ID:         invoice.ID,
+		Number:     invoice.Number,
+		UserID:     userID,
+		Status:     string(invoice.Status),
+		Currency:   string(invoice.Currency),
+		Created:    time.Unix(invoice.Created, 0).UTC(),
+		Subtotal:   invoice.Subtotal,
+		Total:      invoice.Total,
+		AmountDue:  invoice.AmountDue,
+		AmountPaid: invoice.AmountPaid,
+	}
+	if invoice.Customer != nil {
+		exported.CustomerID = invoice.Customer.ID
+	}
+	if invoice.StatusTransitions.FinalizedAt != 0 {
+		exported.FinalizedAt = time.Unix(invoice.StatusTransitions.FinalizedAt, 0).UTC()
+	}
+
+	for _, item := range items {
+		exported.LineItems = append(exported.LineItems, ExportedLineItem{
+			ID:          item.ID,
+			Description: item.Description,
+			ProjectID:   item.Metadata["projectID"],
+			Quantity:    item.Quantity,
+			UnitAmount:  item.UnitAmountDecimal,
+			Amount:      item.Amount,
+			Metadata:    item.Metadata,
+		})
+	}
+
+	return exported
+}
+
+// InvoiceWebhook posts invoice exports to an external system.
+type InvoiceWebhook struct {
+	config InvoiceExportConfig
+	client *http.Client
+	nowFn  func() time.Time
+}
+
+// NewInvoiceWebhook creates a new invoice export webhook.
+func NewInvoiceWebhook(config InvoiceExportConfig) *InvoiceWebhook {
+	return &InvoiceWebhook{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+		nowFn:  time.Now,
+	}
+}
+
+// Send posts the invoice export as JSON to the webhook URL. The request is
+// signed with the webhook secret in the Storj-Signature header, in the form
+// of "t=<unix timestamp>,v1=<hex hmac-sha256 of timestamp.body>".
+func (webhook *InvoiceWebhook) Send(ctx context.Context, export *InvoiceExport) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if webhook.config.WebhookURL == "" {
+		return ErrInvoiceExport.New("webhook url is not configured")
+	}
+
+	body, err := json.Marshal(export)
+	if err != nil {
+		return ErrInvoiceExport.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.config.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return ErrInvoiceExport.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if webhook.config.WebhookSecret != "" {
+		req.Header.Set("Storj-Signature", SignInvoiceExport(webhook.config.WebhookSecret, webhook.nowFn(), body))
+	}
+
+	resp, err := webhook.client.Do(req)
+	if err != nil {
+		return ErrInvoiceExport.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrInvoiceExport.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return ErrInvoiceExport.New("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SignInvoiceExport returns the Storj-Signature header value of the invoice
+// export body sent at the timestamp.
+func SignInvoiceExport(secret string, timestamp time.Time, body []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(unix + "."))
+	_, _ = mac.Write(body)
+
+	return "t=" + unix + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
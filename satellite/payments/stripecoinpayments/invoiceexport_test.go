@@ -0,0 +1,98 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stripecoinpayments_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/payments/stripecoinpayments"
+)
+
+func TestInvoiceWebhook_Send(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	const secret = "webhook-secret"
+
+	export := &stripecoinpayments.InvoiceExport{
+		PeriodStart: time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC),
+		Invoices: []stripecoinpayments.ExportedInvoice{{
+			ID:         "in_1",
+			CustomerID: "cus_1",
+			UserID:     testrand.UUID(),
+			Status:     "open",
+			Total:      1000,
+			LineItems: []stripecoinpayments.ExportedLineItem{{
+				ID:          "ii_1",
+				Description: "Project test - Egress Bandwidth (MB)",
+				Quantity:    100,
+				UnitAmount:  10,
+				Amount:      1000,
+			}},
+		}},
+	}
+
+	var received stripecoinpayments.InvoiceExport
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		// the signature is "t=<timestamp>,v1=<mac>", verify it by signing
+		// the body again with the received timestamp.
+		signature := r.Header.Get("Storj-Signature")
+		timestamp := strings.TrimPrefix(strings.Split(signature, ",")[0], "t=")
+		unix, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil || signature != stripecoinpayments.SignInvoiceExport(secret, time.Unix(unix, 0), body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if err := json.Unmarshal(body, &received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}))
+	defer server.Close()
+
+	t.Run("signed", func(t *testing.T) {
+		webhook := stripecoinpayments.NewInvoiceWebhook(stripecoinpayments.InvoiceExportConfig{
+			WebhookURL:    server.URL,
+			WebhookSecret: secret,
+			Timeout:       time.Minute,
+		})
+		require.NoError(t, webhook.Send(ctx, export))
+		require.Equal(t, export.Invoices, received.Invoices)
+		require.True(t, export.PeriodStart.Equal(received.PeriodStart))
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		webhook := stripecoinpayments.NewInvoiceWebhook(stripecoinpayments.InvoiceExportConfig{
+			WebhookURL:    server.URL,
+			WebhookSecret: "other-secret",
+			Timeout:       time.Minute,
+		})
+		err := webhook.Send(ctx, export)
+		require.Error(t, err)
+		require.True(t, stripecoinpayments.ErrInvoiceExport.Has(err))
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		webhook := stripecoinpayments.NewInvoiceWebhook(stripecoinpayments.InvoiceExportConfig{})
+		require.Error(t, webhook.Send(ctx, export))
+	})
+}
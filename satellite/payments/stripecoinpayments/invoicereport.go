@@ -0,0 +1,200 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stripecoinpayments
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stripe/stripe-go/v72"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+// InvoiceReport is the report of the invoices, which would be created for a
+// billing period.
+type InvoiceReport struct {
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Customers   []CustomerInvoiceReport
+}
+
+// CustomerInvoiceReport is the report of the invoice of a customer.
+//
+// All the amounts are in cents.
+type CustomerInvoiceReport struct {
+	CustomerID string
+	UserID     uuid.UUID
+	Projects   []ProjectInvoiceReport
+
+	// Subtotal is the sum of the project totals.
+	Subtotal int64
+	// CouponID is the coupon applied to the invoice, if any.
+	CouponID string
+	// Discount is the amount discounted by the coupon.
+	Discount int64
+	// Credit is the amount paid with the balance of the customer.
+	Credit int64
+	// Total is the amount due.
+	Total int64
+}
+
+// ProjectInvoiceReport is the usage and the price of a project on an invoice.
+//
+// All the amounts are in cents.
+type ProjectInvoiceReport struct {
+	ProjectID   uuid.UUID
+	ProjectName string
+
+	// StorageMBMonth, EgressMB and SegmentMonth are the invoiced quantities.
+	StorageMBMonth int64
+	EgressMB       int64
+	SegmentMonth   int64
+
+	Storage  int64
+	Egress   int64
+	Segments int64
+	Total    int64
+}
+
+// GenerateInvoiceReport calculates the invoices, which would be created for
+// the period, without creating any project record, invoice item or invoice.
+// Stripe is only used to read the coupons and the balances of the customers.
+func (service *Service) GenerateInvoiceReport(ctx context.Context, period time.Time) (_ *InvoiceReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := service.nowFn().UTC()
+	utc := period.UTC()
+
+	start := time.Date(utc.Year(), utc.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(utc.Year(), utc.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+
+	if end.After(now) {
+		return nil, Error.New("allowed for past periods only")
+	}
+
+	report := &InvoiceReport{
+		PeriodStart: start,
+		PeriodEnd:   end,
+	}
+
+	var offset int64
+	for {
+		if err = ctx.Err(); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		customersPage, err := service.db.Customers().List(ctx, offset, service.listingLimit, end)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for _, customer := range customersPage.Customers {
+			customerReport, err := service.customerInvoiceReport(ctx, customer, start, end, now)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			if len(customerReport.Projects) == 0 {
+				continue
+			}
+			report.Customers = append(report.Customers, customerReport)
+		}
+
+		if !customersPage.Next {
+			break
+		}
+		offset = customersPage.NextOffset
+	}
+
+	service.log.Info("Generated invoice report.", zap.Time("Period", start), zap.Int("Invoices", len(report.Customers)))
+	return report, nil
+}
+
+// customerInvoiceReport calculates the invoice of the customer.
+func (service *Service) customerInvoiceReport(ctx context.Context, customer Customer, start, end, now time.Time) (_ CustomerInvoiceReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report := CustomerInvoiceReport{
+		CustomerID: customer.ID,
+		UserID:     customer.UserID,
+	}
+
+	projects, err := service.projectsDB.GetOwn(ctx, customer.UserID)
+	if err != nil {
+		return CustomerInvoiceReport{}, err
+	}
+
+	for _, project := range projects {
+		usage, err := service.usageDB.GetProjectTotal(ctx, project.ID, start, end)
+		if err != nil {
+			return CustomerInvoiceReport{}, err
+		}
+
+		price := service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.SegmentCount)
+		projectReport := ProjectInvoiceReport{
+			ProjectID:   project.ID,
+			ProjectName: project.Name,
+
+			StorageMBMonth: storageMBMonthDecimal(usage.Storage).IntPart(),
+			EgressMB:       egressMBDecimal(usage.Egress).IntPart(),
+			SegmentMonth:   segmentMonthDecimal(usage.SegmentCount).IntPart(),
+
+			Storage:  price.Storage.IntPart(),
+			Egress:   price.Egress.IntPart(),
+			Segments: price.Segments.IntPart(),
+			Total:    price.TotalInt64(),
+		}
+
+		report.Projects = append(report.Projects, projectReport)
+		report.Subtotal += projectReport.Total
+	}
+
+	if len(report.Projects) == 0 {
+		return report, nil
+	}
+
+	params := &stripe.CustomerParams{}
+	params.AddExpand("discount")
+
+	stripeCustomer, err := service.stripeClient.Customers().Get(customer.ID, params)
+	if err != nil {
+		return CustomerInvoiceReport{}, err
+	}
+
+	report.Total = report.Subtotal
+	if discount := stripeCustomer.Discount; discount != nil && discount.Coupon != nil && (discount.End == 0 || time.Unix(discount.End, 0).After(now)) {
+		report.CouponID = discount.Coupon.ID
+		report.Discount = couponDiscount(discount.Coupon, report.Total)
+		report.Total -= report.Discount
+	}
+
+	// a negative balance is a credit of the customer.
+	if stripeCustomer.Balance < 0 {
+		report.Credit = min64(-stripeCustomer.Balance, report.Total)
+		report.Total -= report.Credit
+	}
+
+	return report, nil
+}
+
+// couponDiscount returns the amount discounted by the coupon from the amount.
+func couponDiscount(coupon *stripe.Coupon, amount int64) int64 {
+	var discount int64
+	switch {
+	case coupon.AmountOff > 0:
+		discount = coupon.AmountOff
+	case coupon.PercentOff > 0:
+		discount = decimal.NewFromInt(amount).Mul(decimal.NewFromFloat(coupon.PercentOff)).Shift(-2).Round(0).IntPart()
+	}
+	return min64(discount, amount)
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
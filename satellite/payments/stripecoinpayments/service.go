@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -18,6 +20,8 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/payments"
@@ -40,6 +44,8 @@ type Config struct {
 	StripeSecretKey              string        `help:"stripe API secret key" default:""`
 	StripePublicKey              string        `help:"stripe API public key" default:""`
 	StripeFreeTierCouponID       string        `help:"stripe free tier coupon ID" default:""`
+	StripeWebhookSecret          string        `help:"secret used to verify the signatures of the stripe webhook events, the webhook is disabled when empty" default:""`
+	FreezeDownloadsOnNonPayment  bool          `help:"whether the downloads of the projects are rejected too, when an invoice of their owner isn't paid" default:"false"`
 	CoinpaymentsPublicKey        string        `help:"coinpayments API public key" default:""`
 	CoinpaymentsPrivateKey       string        `help:"coinpayments API private key key" default:""`
 	TransactionUpdateInterval    time.Duration `help:"amount of time we wait before running next transaction update loop" default:"2m" testDefault:"$TESTINTERVAL"`
@@ -47,6 +53,24 @@ type Config struct {
 	ConversionRatesCycleInterval time.Duration `help:"amount of time we wait before running next conversion rates update loop" default:"10m" testDefault:"$TESTINTERVAL"`
 	AutoAdvance                  bool          `help:"toogle autoadvance feature for invoice creation" default:"false"`
 	ListingLimit                 int           `help:"sets the maximum amount of items before we start paging on requests" default:"100" hidden:"true"`
+	InvoiceExport                InvoiceExportConfig
+}
+
+// PlacementPrice holds the prices of the usage in a placement.
+type PlacementPrice struct {
+	StorageMBMonthPriceCents decimal.Decimal
+	EgressMBPriceCents       decimal.Decimal
+	SegmentMonthPriceCents   decimal.Decimal
+}
+
+// TierPrice holds the price of the usage above a threshold.
+type TierPrice struct {
+	// Above is the threshold in MB-month for storage, in MB for egress and
+	// in segment-month for segments.
+	Above      decimal.Decimal
+	PriceCents decimal.Decimal
+	// Description describes the threshold on the invoice items.
+	Description string
 }
 
 // Service is an implementation for payment service via Stripe and Coinpayments.
@@ -63,6 +87,16 @@ type Service struct {
 	StorageMBMonthPriceCents decimal.Decimal
 	EgressMBPriceCents       decimal.Decimal
 	SegmentMonthPriceCents   decimal.Decimal
+	// PlacementPrices overrides the prices of the usage in specific placements.
+	PlacementPrices map[storj.PlacementConstraint]PlacementPrice
+	// StorageTiers, EgressTiers and SegmentTiers override the default prices
+	// of the monthly usage of a project above their thresholds.
+	StorageTiers []TierPrice
+	EgressTiers  []TierPrice
+	SegmentTiers []TierPrice
+	// VolumeDiscounts are the discounts of the customers in percents of their
+	// usage charges, by user ID.
+	VolumeDiscounts map[uuid.UUID]decimal.Decimal
 	// BonusRate amount of percents
 	BonusRate int64
 	// Coupon Values
@@ -71,6 +105,12 @@ type Service struct {
 	// Stripe Extended Features
 	AutoAdvance bool
 
+	// StripeWebhookSecret verifies the signatures of the stripe webhook events.
+	StripeWebhookSecret string
+	// FreezeDownloadsOnNonPayment rejects the downloads of the projects frozen
+	// for non-payment.
+	FreezeDownloadsOnNonPayment bool
+
 	mu       sync.Mutex
 	rates    coinpayments.CurrencyRateInfos
 	ratesErr error
@@ -80,7 +120,7 @@ type Service struct {
 }
 
 // NewService creates a Service instance.
-func NewService(log *zap.Logger, stripeClient StripeClient, config Config, db DB, projectsDB console.Projects, usageDB accounting.ProjectAccounting, storageTBPrice, egressTBPrice, segmentPrice string, bonusRate int64) (*Service, error) {
+func NewService(log *zap.Logger, stripeClient StripeClient, config Config, db DB, projectsDB console.Projects, usageDB accounting.ProjectAccounting, storageTBPrice, egressTBPrice, segmentPrice string, placementPrices payments.PlacementPrices, priceTiers payments.PriceTiers, volumeDiscounts payments.VolumeDiscounts, bonusRate int64) (*Service, error) {
 
 	coinPaymentsClient := coinpayments.NewClient(
 		coinpayments.Credentials{
@@ -107,21 +147,62 @@ func NewService(log *zap.Logger, stripeClient StripeClient, config Config, db DB
 	egressMBPriceCents := egressTBDollars.Shift(-6).Shift(2)
 	segmentMonthPriceCents := segmentMonthDollars.Shift(2)
 
+	placementPriceCents := make(map[storj.PlacementConstraint]PlacementPrice, len(placementPrices))
+	for placement, price := range placementPrices {
+		placementPriceCents[placement] = PlacementPrice{
+			StorageMBMonthPriceCents: price.StorageTB.Shift(-6).Shift(2),
+			EgressMBPriceCents:       price.EgressTB.Shift(-6).Shift(2),
+			SegmentMonthPriceCents:   price.Segment.Shift(2),
+		}
+	}
+
+	storageTiers := make([]TierPrice, 0, len(priceTiers.Storage))
+	for _, tier := range priceTiers.Storage {
+		storageTiers = append(storageTiers, TierPrice{
+			Above:       tier.Above.Shift(6),
+			PriceCents:  tier.Price.Shift(-6).Shift(2),
+			Description: fmt.Sprintf("above %s TB-Month", tier.Above),
+		})
+	}
+	egressTiers := make([]TierPrice, 0, len(priceTiers.Egress))
+	for _, tier := range priceTiers.Egress {
+		egressTiers = append(egressTiers, TierPrice{
+			Above:       tier.Above.Shift(6),
+			PriceCents:  tier.Price.Shift(-6).Shift(2),
+			Description: fmt.Sprintf("above %s TB", tier.Above),
+		})
+	}
+	segmentTiers := make([]TierPrice, 0, len(priceTiers.Segment))
+	for _, tier := range priceTiers.Segment {
+		segmentTiers = append(segmentTiers, TierPrice{
+			Above:       tier.Above,
+			PriceCents:  tier.Price.Shift(2),
+			Description: fmt.Sprintf("above %s Segment-Month", tier.Above),
+		})
+	}
+
 	return &Service{
-		log:                      log,
-		db:                       db,
-		projectsDB:               projectsDB,
-		usageDB:                  usageDB,
-		stripeClient:             stripeClient,
-		coinPayments:             coinPaymentsClient,
-		StorageMBMonthPriceCents: storageMBMonthPriceCents,
-		EgressMBPriceCents:       egressMBPriceCents,
-		SegmentMonthPriceCents:   segmentMonthPriceCents,
-		BonusRate:                bonusRate,
-		StripeFreeTierCouponID:   config.StripeFreeTierCouponID,
-		AutoAdvance:              config.AutoAdvance,
-		listingLimit:             config.ListingLimit,
-		nowFn:                    time.Now,
+		log:                         log,
+		db:                          db,
+		projectsDB:                  projectsDB,
+		usageDB:                     usageDB,
+		stripeClient:                stripeClient,
+		coinPayments:                coinPaymentsClient,
+		StorageMBMonthPriceCents:    storageMBMonthPriceCents,
+		EgressMBPriceCents:          egressMBPriceCents,
+		SegmentMonthPriceCents:      segmentMonthPriceCents,
+		PlacementPrices:             placementPriceCents,
+		StorageTiers:                storageTiers,
+		EgressTiers:                 egressTiers,
+		SegmentTiers:                segmentTiers,
+		VolumeDiscounts:             volumeDiscounts,
+		BonusRate:                   bonusRate,
+		StripeFreeTierCouponID:      config.StripeFreeTierCouponID,
+		AutoAdvance:                 config.AutoAdvance,
+		StripeWebhookSecret:         config.StripeWebhookSecret,
+		FreezeDownloadsOnNonPayment: config.FreezeDownloadsOnNonPayment,
+		listingLimit:                config.ListingLimit,
+		nowFn:                       time.Now,
 	}, nil
 }
 
@@ -559,7 +640,7 @@ func (service *Service) applyProjectRecords(ctx context.Context, records []Proje
 			return errs.Wrap(err)
 		}
 
-		if err = service.createInvoiceItems(ctx, cusID, proj.Name, record); err != nil {
+		if err = service.createInvoiceItems(ctx, cusID, proj.OwnerID, proj.Name, record); err != nil {
 			return errs.Wrap(err)
 		}
 	}
@@ -568,14 +649,31 @@ func (service *Service) applyProjectRecords(ctx context.Context, records []Proje
 }
 
 // createInvoiceItems consumes invoice project record and creates invoice line items for stripe customer.
-func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName string, record ProjectRecord) (err error) {
+func (service *Service) createInvoiceItems(ctx context.Context, cusID string, ownerID uuid.UUID, projName string, record ProjectRecord) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if err = service.db.ProjectRecords().Consume(ctx, record.ID); err != nil {
 		return err
 	}
 
-	items := service.InvoiceItemsFromProjectRecord(projName, record)
+	exemptions, err := service.usageDB.GetProjectLimitExemptions(ctx, record.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	var placementUsages map[storj.PlacementConstraint]accounting.ProjectUsage
+	if len(service.PlacementPrices) > 0 {
+		placementUsages, err = service.usageDB.GetProjectTotalByPlacement(ctx, record.ProjectID, record.PeriodStart, record.PeriodEnd)
+		if err != nil {
+			return err
+		}
+	}
+
+	items := service.InvoiceItemsFromProjectRecordByPlacement(projName, record, placementUsages)
+	addLimitExemptions(items, record, exemptions)
+	if discount := service.VolumeDiscountInvoiceItem(projName, ownerID, items); discount != nil {
+		items = append(items, discount)
+	}
 	for _, item := range items {
 		item.Currency = stripe.String(string(stripe.CurrencyUSD))
 		item.Customer = stripe.String(cusID)
@@ -592,31 +690,242 @@ func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName
 
 // InvoiceItemsFromProjectRecord calculates Stripe invoice item from project record.
 func (service *Service) InvoiceItemsFromProjectRecord(projName string, record ProjectRecord) (result []*stripe.InvoiceItemParams) {
-	projectItem := &stripe.InvoiceItemParams{}
-	projectItem.Description = stripe.String(fmt.Sprintf("Project %s - Segment Storage (MB-Month)", projName))
-	projectItem.Quantity = stripe.Int64(storageMBMonthDecimal(record.Storage).IntPart())
-	storagePrice, _ := service.StorageMBMonthPriceCents.Float64()
-	projectItem.UnitAmountDecimal = stripe.Float64(storagePrice)
-	result = append(result, projectItem)
-
-	projectItem = &stripe.InvoiceItemParams{}
-	projectItem.Description = stripe.String(fmt.Sprintf("Project %s - Egress Bandwidth (MB)", projName))
-	projectItem.Quantity = stripe.Int64(egressMBDecimal(record.Egress).IntPart())
-	egressPrice, _ := service.EgressMBPriceCents.Float64()
-	projectItem.UnitAmountDecimal = stripe.Float64(egressPrice)
-	result = append(result, projectItem)
-
-	projectItem = &stripe.InvoiceItemParams{}
-	projectItem.Description = stripe.String(fmt.Sprintf("Project %s - Segment Fee (Segment-Month)", projName))
-	projectItem.Quantity = stripe.Int64(segmentMonthDecimal(record.Segments).IntPart())
-	segmentPrice, _ := service.SegmentMonthPriceCents.Float64()
-	projectItem.UnitAmountDecimal = stripe.Float64(segmentPrice)
-	result = append(result, projectItem)
+	return service.InvoiceItemsFromProjectRecordByPlacement(projName, record, nil)
+}
+
+// InvoiceItemsFromProjectRecordByPlacement calculates Stripe invoice item from project record.
+// The usage of the placements with their own prices is itemized separately and
+// is subtracted from the usage charged with the default prices. The usage above
+// the thresholds of the price tiers is itemized separately as well.
+func (service *Service) InvoiceItemsFromProjectRecordByPlacement(projName string, record ProjectRecord, placementUsages map[storj.PlacementConstraint]accounting.ProjectUsage) (result []*stripe.InvoiceItemParams) {
+	remaining, priced := service.splitUsageByPlacement(accounting.ProjectUsage{
+		Storage:      record.Storage,
+		Egress:       record.Egress,
+		SegmentCount: record.Segments,
+	}, placementUsages)
+
+	result = service.tieredUsageInvoiceItems(fmt.Sprintf("Project %s", projName), remaining)
+
+	for _, placement := range priced {
+		description := fmt.Sprintf("Project %s (placement %d)", projName, placement.placement)
+		items := usageInvoiceItems(description, placement.usage,
+			placement.price.StorageMBMonthPriceCents, placement.price.EgressMBPriceCents, placement.price.SegmentMonthPriceCents)
+		for _, item := range items {
+			item.AddMetadata("placement", strconv.Itoa(int(placement.placement)))
+		}
+		result = append(result, items...)
+	}
 	service.log.Info("invoice items", zap.Any("result", result))
 
 	return result
 }
 
+// placementUsage is the usage in a placement which is charged with the
+// prices of the placement.
+type placementUsage struct {
+	placement storj.PlacementConstraint
+	usage     accounting.ProjectUsage
+	price     PlacementPrice
+}
+
+// splitUsageByPlacement splits the usage of a project into the usage in the
+// placements with their own prices, ordered by placement, and the remaining
+// usage which is charged with the default prices.
+func (service *Service) splitUsageByPlacement(usage accounting.ProjectUsage, placementUsages map[storj.PlacementConstraint]accounting.ProjectUsage) (remaining accounting.ProjectUsage, priced []placementUsage) {
+	remaining = usage
+
+	for placement, usage := range placementUsages {
+		price, ok := service.PlacementPrices[placement]
+		if !ok {
+			continue
+		}
+		priced = append(priced, placementUsage{
+			placement: placement,
+			usage:     usage,
+			price:     price,
+		})
+	}
+	sort.Slice(priced, func(i, k int) bool { return priced[i].placement < priced[k].placement })
+
+	// the usage of the placements can't exceed the usage of the project, which
+	// may happen when the usage was recorded in between the queries.
+	kept := priced[:0]
+	for _, placement := range priced {
+		placement.usage.Storage = math.Min(placement.usage.Storage, remaining.Storage)
+		if placement.usage.Egress > remaining.Egress {
+			placement.usage.Egress = remaining.Egress
+		}
+		placement.usage.SegmentCount = math.Min(placement.usage.SegmentCount, remaining.SegmentCount)
+		if placement.usage.Storage <= 0 && placement.usage.Egress <= 0 && placement.usage.SegmentCount <= 0 {
+			continue
+		}
+
+		remaining.Storage -= placement.usage.Storage
+		remaining.Egress -= placement.usage.Egress
+		remaining.SegmentCount -= placement.usage.SegmentCount
+		kept = append(kept, placement)
+	}
+
+	return remaining, kept
+}
+
+// Descriptions of the usage invoice items.
+const (
+	storageItemDescription = "Segment Storage (MB-Month)"
+	egressItemDescription  = "Egress Bandwidth (MB)"
+	segmentItemDescription = "Segment Fee (Segment-Month)"
+)
+
+// usageInvoiceItems creates the storage, egress and segment invoice items of
+// the usage, in this order.
+func usageInvoiceItems(description string, usage accounting.ProjectUsage, storagePriceCents, egressPriceCents, segmentPriceCents decimal.Decimal) (result []*stripe.InvoiceItemParams) {
+	return []*stripe.InvoiceItemParams{
+		newUsageInvoiceItem(fmt.Sprintf("%s - %s", description, storageItemDescription), storageMBMonthDecimal(usage.Storage), storagePriceCents),
+		newUsageInvoiceItem(fmt.Sprintf("%s - %s", description, egressItemDescription), egressMBDecimal(usage.Egress), egressPriceCents),
+		newUsageInvoiceItem(fmt.Sprintf("%s - %s", description, segmentItemDescription), segmentMonthDecimal(usage.SegmentCount), segmentPriceCents),
+	}
+}
+
+// tieredUsageInvoiceItems creates the invoice items of the usage charged with
+// the default prices. The storage, egress and segment items of the usage below
+// the thresholds of the tiers come first, in this order, followed by the items
+// of the usage within the tiers.
+func (service *Service) tieredUsageInvoiceItems(description string, usage accounting.ProjectUsage) (result []*stripe.InvoiceItemParams) {
+	var tierItems []*stripe.InvoiceItemParams
+	for _, kind := range []struct {
+		description string
+		quantity    decimal.Decimal
+		priceCents  decimal.Decimal
+		tiers       []TierPrice
+	}{
+		{storageItemDescription, storageMBMonthDecimal(usage.Storage), service.StorageMBMonthPriceCents, service.StorageTiers},
+		{egressItemDescription, egressMBDecimal(usage.Egress), service.EgressMBPriceCents, service.EgressTiers},
+		{segmentItemDescription, segmentMonthDecimal(usage.SegmentCount), service.SegmentMonthPriceCents, service.SegmentTiers},
+	} {
+		for _, part := range splitByTiers(kind.quantity, kind.priceCents, kind.tiers) {
+			if part.tier == nil {
+				result = append(result, newUsageInvoiceItem(fmt.Sprintf("%s - %s", description, kind.description), part.quantity, part.priceCents))
+				continue
+			}
+
+			item := newUsageInvoiceItem(fmt.Sprintf("%s - %s %s", description, kind.description, part.tier.Description), part.quantity, part.priceCents)
+			item.AddMetadata("priceTier", part.tier.Description)
+			tierItems = append(tierItems, item)
+		}
+	}
+	return append(result, tierItems...)
+}
+
+// newUsageInvoiceItem creates an invoice item of quantity units of the usage.
+func newUsageInvoiceItem(description string, quantity, priceCents decimal.Decimal) *stripe.InvoiceItemParams {
+	item := &stripe.InvoiceItemParams{}
+	item.Description = stripe.String(description)
+	item.Quantity = stripe.Int64(quantity.IntPart())
+	price, _ := priceCents.Float64()
+	item.UnitAmountDecimal = stripe.Float64(price)
+	return item
+}
+
+// tieredQuantity is the part of the usage which is charged with a price.
+type tieredQuantity struct {
+	quantity   decimal.Decimal
+	priceCents decimal.Decimal
+	// tier is nil for the part charged with the default price.
+	tier *TierPrice
+}
+
+// splitByTiers splits the quantity of the usage into the part below the
+// threshold of the first tier, which is charged with the default price, and
+// the parts within the tiers.
+func splitByTiers(quantity, defaultPriceCents decimal.Decimal, tiers []TierPrice) []tieredQuantity {
+	result := []tieredQuantity{{quantity: quantity, priceCents: defaultPriceCents}}
+	for i := range tiers {
+		tier := &tiers[i]
+		if !quantity.GreaterThan(tier.Above) {
+			break
+		}
+
+		// the quantity above the threshold is moved from the previous part.
+		above := quantity.Sub(tier.Above)
+		previous := &result[len(result)-1]
+		previous.quantity = previous.quantity.Sub(above)
+
+		result = append(result, tieredQuantity{
+			quantity:   above,
+			priceCents: tier.PriceCents,
+			tier:       tier,
+		})
+	}
+	return result
+}
+
+// tieredPrice returns the price of the quantity of the usage.
+func tieredPrice(quantity, defaultPriceCents decimal.Decimal, tiers []TierPrice) decimal.Decimal {
+	total := decimal.Zero
+	for _, part := range splitByTiers(quantity, defaultPriceCents, tiers) {
+		total = total.Add(part.priceCents.Mul(part.quantity))
+	}
+	return total.Round(0)
+}
+
+// VolumeDiscountInvoiceItem creates the invoice item deducting the volume
+// discount of the project owner from the charges of the invoice items. It
+// returns nil when the owner has no volume discount.
+func (service *Service) VolumeDiscountInvoiceItem(projName string, ownerID uuid.UUID, items []*stripe.InvoiceItemParams) *stripe.InvoiceItemParams {
+	percent, ok := service.VolumeDiscounts[ownerID]
+	if !ok || !percent.IsPositive() {
+		return nil
+	}
+
+	subtotal := decimal.Zero
+	for _, item := range items {
+		if item.Quantity == nil || item.UnitAmountDecimal == nil {
+			continue
+		}
+		amount := decimal.NewFromFloat(*item.UnitAmountDecimal).Mul(decimal.NewFromInt(*item.Quantity)).Round(0)
+		subtotal = subtotal.Add(amount)
+	}
+
+	discount := subtotal.Mul(percent).Shift(-2).Round(0)
+	if !discount.IsPositive() {
+		return nil
+	}
+
+	item := &stripe.InvoiceItemParams{}
+	item.Description = stripe.String(fmt.Sprintf("Project %s - Volume Discount (%s%%)", projName, percent))
+	item.Amount = stripe.Int64(-discount.IntPart())
+	item.AddMetadata("volumeDiscountPercent", percent.String())
+	return item
+}
+
+// addLimitExemptions notes on the invoice items the limit exemptions of the
+// project which were in effect during the billing period of the record. The
+// items are expected in the order returned by InvoiceItemsFromProjectRecord.
+func addLimitExemptions(items []*stripe.InvoiceItemParams, record ProjectRecord, exemptions []accounting.LimitExemption) {
+	itemKinds := []accounting.LimitKind{
+		accounting.LimitKindStorage,
+		accounting.LimitKindBandwidth,
+		accounting.LimitKindSegments,
+	}
+
+	for _, exemption := range exemptions {
+		if !exemption.CreatedAt.Before(record.PeriodEnd) || !exemption.ExpiresAt.After(record.PeriodStart) {
+			continue
+		}
+
+		for i, kind := range itemKinds {
+			if i >= len(items) || kind != exemption.Kind {
+				continue
+			}
+			item := items[i]
+			item.Description = stripe.String(fmt.Sprintf("%s - limit exemption (%s) until %s",
+				*item.Description, exemption.Reason, exemption.ExpiresAt.Format("2006-01-02")))
+			item.AddMetadata("limitExemptionReason", string(exemption.Reason))
+			item.AddMetadata("limitExemptionExpiresAt", exemption.ExpiresAt.Format(time.RFC3339))
+		}
+	}
+}
+
 // ApplyFreeTierCoupons iterates through all customers in Stripe. For each customer,
 // if that customer does not currently have a Stripe coupon, the free tier Stripe coupon
 // is applied.
@@ -734,15 +1043,14 @@ func (service *Service) CreateInvoices(ctx context.Context, period time.Time) (e
 func (service *Service) createInvoice(ctx context.Context, cusID string, period time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	description := fmt.Sprintf("Storj DCS Cloud Storage for %s %d", period.Month(), period.Year())
+	params := &stripe.InvoiceParams{
+		Customer:    stripe.String(cusID),
+		AutoAdvance: stripe.Bool(service.AutoAdvance),
+		Description: stripe.String(invoiceDescription(period)),
+	}
+	params.AddMetadata(invoiceBillingPeriodMetadata, period.Format("2006-01"))
 
-	_, err = service.stripeClient.Invoices().New(
-		&stripe.InvoiceParams{
-			Customer:    stripe.String(cusID),
-			AutoAdvance: stripe.Bool(service.AutoAdvance),
-			Description: stripe.String(description),
-		},
-	)
+	_, err = service.stripeClient.Invoices().New(params)
 
 	if err != nil {
 		var stripErr *stripe.Error
@@ -803,13 +1111,28 @@ func (price projectUsagePrice) TotalInt64() int64 {
 	return price.Storage.Add(price.Egress).Add(price.Segments).IntPart()
 }
 
-// calculateProjectUsagePrice calculate project usage price.
+// calculateProjectUsagePrice calculate project usage price, charging the usage
+// above the thresholds of the price tiers with their prices.
 func (service *Service) calculateProjectUsagePrice(egress int64, storage, segments float64) projectUsagePrice {
 	return projectUsagePrice{
-		Storage:  service.StorageMBMonthPriceCents.Mul(storageMBMonthDecimal(storage)).Round(0),
-		Egress:   service.EgressMBPriceCents.Mul(egressMBDecimal(egress)).Round(0),
-		Segments: service.SegmentMonthPriceCents.Mul(segmentMonthDecimal(segments)).Round(0),
+		Storage:  tieredPrice(storageMBMonthDecimal(storage), service.StorageMBMonthPriceCents, service.StorageTiers),
+		Egress:   tieredPrice(egressMBDecimal(egress), service.EgressMBPriceCents, service.EgressTiers),
+		Segments: tieredPrice(segmentMonthDecimal(segments), service.SegmentMonthPriceCents, service.SegmentTiers),
+	}
+}
+
+// calculateProjectUsagePriceByPlacement calculates project usage price, charging
+// the usage in the placements with their own prices.
+func (service *Service) calculateProjectUsagePriceByPlacement(usage accounting.ProjectUsage, placementUsages map[storj.PlacementConstraint]accounting.ProjectUsage) projectUsagePrice {
+	remaining, priced := service.splitUsageByPlacement(usage, placementUsages)
+
+	price := service.calculateProjectUsagePrice(remaining.Egress, remaining.Storage, remaining.SegmentCount)
+	for _, placement := range priced {
+		price.Storage = price.Storage.Add(placement.price.StorageMBMonthPriceCents.Mul(storageMBMonthDecimal(placement.usage.Storage)).Round(0))
+		price.Egress = price.Egress.Add(placement.price.EgressMBPriceCents.Mul(egressMBDecimal(placement.usage.Egress)).Round(0))
+		price.Segments = price.Segments.Add(placement.price.SegmentMonthPriceCents.Mul(segmentMonthDecimal(placement.usage.SegmentCount)).Round(0))
 	}
+	return price
 }
 
 // SetNow allows tests to have the Service act as if the current time is whatever
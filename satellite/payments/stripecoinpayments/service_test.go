@@ -13,7 +13,9 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/pb"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
@@ -294,3 +296,159 @@ func TestService_InvoiceItemsFromProjectRecord(t *testing.T) {
 		}
 	})
 }
+
+func TestService_InvoiceItemsFromProjectRecordByPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				require.NoError(t, config.Payments.PlacementPrices.Set("1:storage=8,egress=9,segment=0.0000176"))
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		record := stripecoinpayments.ProjectRecord{
+			Storage:  20000000000,
+			Egress:   200 * memory.GB.Int64(),
+			Segments: 800000,
+		}
+		placementUsages := map[storj.PlacementConstraint]accounting.ProjectUsage{
+			1: {
+				Storage:      10000000000,
+				Egress:       50 * memory.GB.Int64(),
+				SegmentCount: 400000,
+			},
+			// placement without its own prices is charged with the default prices.
+			2: {
+				Storage: 10000000000,
+			},
+		}
+
+		items := satellite.API.Payments.StripeService.InvoiceItemsFromProjectRecordByPlacement("project name", record, placementUsages)
+		require.Len(t, items, 6)
+
+		// the usage outside of placement 1 is charged with the default prices.
+		require.Equal(t, "Project project name - Segment Storage (MB-Month)", *items[0].Description)
+		require.Equal(t, int64(14), *items[0].Quantity)
+		require.Equal(t, 0.001, *items[0].UnitAmountDecimal)
+		require.Equal(t, int64(150000), *items[1].Quantity)
+		require.Equal(t, 0.0045, *items[1].UnitAmountDecimal)
+		require.Equal(t, int64(556), *items[2].Quantity)
+		require.Equal(t, 0.00022, *items[2].UnitAmountDecimal)
+
+		require.Equal(t, "Project project name (placement 1) - Segment Storage (MB-Month)", *items[3].Description)
+		require.Equal(t, int64(14), *items[3].Quantity)
+		require.Equal(t, 0.0008, *items[3].UnitAmountDecimal)
+		require.Equal(t, int64(50000), *items[4].Quantity)
+		require.Equal(t, 0.0009, *items[4].UnitAmountDecimal)
+		require.Equal(t, int64(556), *items[5].Quantity)
+		require.Equal(t, 0.00176, *items[5].UnitAmountDecimal)
+		for _, item := range items[3:] {
+			require.Equal(t, "1", item.Metadata["placement"])
+		}
+	})
+}
+
+func TestService_InvoiceItemsFromProjectRecordTiers(t *testing.T) {
+	ownerID := testrand.UUID()
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				require.NoError(t, config.Payments.PriceTiers.Set("egress:0.1=30,0.2=15"))
+				require.NoError(t, config.Payments.VolumeDiscounts.Set(ownerID.String()+":10"))
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		service := planet.Satellites[0].API.Payments.StripeService
+
+		record := stripecoinpayments.ProjectRecord{
+			Egress: 250 * memory.GB.Int64(),
+		}
+
+		items := service.InvoiceItemsFromProjectRecord("project name", record)
+		require.Len(t, items, 5)
+
+		// the egress below the first threshold is charged with the default price.
+		require.Equal(t, "Project project name - Egress Bandwidth (MB)", *items[1].Description)
+		require.Equal(t, int64(100000), *items[1].Quantity)
+		require.Equal(t, 0.0045, *items[1].UnitAmountDecimal)
+
+		require.Equal(t, "Project project name - Egress Bandwidth (MB) above 0.1 TB", *items[3].Description)
+		require.Equal(t, int64(100000), *items[3].Quantity)
+		require.Equal(t, 0.003, *items[3].UnitAmountDecimal)
+		require.Equal(t, "above 0.1 TB", items[3].Metadata["priceTier"])
+
+		require.Equal(t, "Project project name - Egress Bandwidth (MB) above 0.2 TB", *items[4].Description)
+		require.Equal(t, int64(50000), *items[4].Quantity)
+		require.Equal(t, 0.0015, *items[4].UnitAmountDecimal)
+
+		// 10% of 450 + 300 + 75 cents.
+		discount := service.VolumeDiscountInvoiceItem("project name", ownerID, items)
+		require.NotNil(t, discount)
+		require.Equal(t, "Project project name - Volume Discount (10%)", *discount.Description)
+		require.Equal(t, int64(-83), *discount.Amount)
+
+		require.Nil(t, service.VolumeDiscountInvoiceItem("project name", testrand.UUID(), items))
+	})
+}
+
+func TestService_GenerateInvoiceReport(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.API.Payments.StripeService
+
+		// pick a specific date so that it doesn't fail if it's the last day of the month
+		// keep month + 1 because user needs to be created before calculation
+		period := time.Date(time.Now().Year(), time.Now().Month()+1, 20, 0, 0, 0, 0, time.UTC)
+		service.SetNow(func() time.Time {
+			return time.Date(period.Year(), period.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		})
+		start := time.Date(period.Year(), period.Month(), 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(period.Year(), period.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+
+		user, err := satellite.AddUser(ctx, console.CreateUser{
+			FullName: "testuser",
+			Email:    "user@test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := satellite.AddProject(ctx, user.ID, "testproject")
+		require.NoError(t, err)
+
+		egress := 100 * memory.GB.Int64()
+		err = satellite.DB.Orders().UpdateBucketBandwidthSettle(ctx, project.ID, []byte("testbucket"),
+			pb.PieceAction_GET, egress, 0, period)
+		require.NoError(t, err)
+
+		report, err := service.GenerateInvoiceReport(ctx, period)
+		require.NoError(t, err)
+		require.Equal(t, start, report.PeriodStart)
+		require.Equal(t, end, report.PeriodEnd)
+		require.Len(t, report.Customers, 1)
+
+		customer := report.Customers[0]
+		require.Equal(t, user.ID, customer.UserID)
+		require.Len(t, customer.Projects, 1)
+
+		projectReport := customer.Projects[0]
+		require.Equal(t, project.ID, projectReport.ProjectID)
+		require.Equal(t, int64(100000), projectReport.EgressMB)
+		require.Equal(t, projectReport.Storage+projectReport.Egress+projectReport.Segments, projectReport.Total)
+		require.Equal(t, projectReport.Total, customer.Subtotal)
+		require.Equal(t, customer.Subtotal-customer.Discount-customer.Credit, customer.Total)
+
+		// the report doesn't create project records.
+		projectRecord, err := satellite.DB.StripeCoinPayments().ProjectRecords().Get(ctx, project.ID, start, end)
+		require.NoError(t, err)
+		require.Nil(t, projectRecord)
+
+		// the period must be over.
+		_, err = service.GenerateInvoiceReport(ctx, end)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stripecoinpayments
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/webhook"
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/payments"
+)
+
+const (
+	// eventInvoicePaymentFailed is sent when a payment attempt of an invoice fails.
+	eventInvoicePaymentFailed = "invoice.payment_failed"
+	// eventInvoicePaid is sent when an invoice is paid, also out of band.
+	eventInvoicePaid = "invoice.paid"
+)
+
+// HandleWebhookEvent handles a signed stripe webhook event. The projects of
+// the customer, whose invoice payment failed, are frozen, and they're
+// unfrozen when the invoice is paid. Other events are ignored.
+func (invoices *invoices) HandleWebhookEvent(ctx context.Context, payload []byte, signature string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service := invoices.service
+	if service.StripeWebhookSecret == "" {
+		return payments.ErrInvalidWebhookEvent.New("webhook is disabled")
+	}
+
+	event, err := webhook.ConstructEvent(payload, signature, service.StripeWebhookSecret)
+	if err != nil {
+		return payments.ErrInvalidWebhookEvent.Wrap(err)
+	}
+
+	switch event.Type {
+	case eventInvoicePaymentFailed, eventInvoicePaid:
+	default:
+		return nil
+	}
+
+	var invoice stripe.Invoice
+	if err := json.Unmarshal(event.Data.Raw, &invoice); err != nil {
+		return payments.ErrInvalidWebhookEvent.Wrap(err)
+	}
+	if invoice.ID == "" || invoice.Customer == nil || invoice.Customer.ID == "" {
+		return payments.ErrInvalidWebhookEvent.New("missing invoice or customer ID")
+	}
+
+	if event.Type == eventInvoicePaid {
+		return invoices.unfreezeProjects(ctx, invoice.ID)
+	}
+	return invoices.freezeProjects(ctx, invoice.ID, invoice.Customer.ID)
+}
+
+// freezeProjects freezes the projects of the customer, which aren't frozen
+// yet, because of the unpaid invoice.
+func (invoices *invoices) freezeProjects(ctx context.Context, invoiceID, customerID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service := invoices.service
+
+	userID, err := service.db.Customers().GetUserID(ctx, customerID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	projects, err := service.projectsDB.GetOwn(ctx, userID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, project := range projects {
+		// an existing freeze, e.g. by support, isn't replaced.
+		freeze, err := service.usageDB.GetProjectFreeze(ctx, project.ID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if freeze != nil {
+			continue
+		}
+
+		err = service.usageDB.FreezeProject(ctx, accounting.ProjectFreeze{
+			ProjectID: project.ID,
+			Reason:    accounting.FreezeReasonNonPayment,
+			Downloads: service.FreezeDownloadsOnNonPayment,
+			InvoiceID: invoiceID,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		service.log.Info("project frozen for non-payment",
+			zap.Stringer("Project ID", project.ID),
+			zap.String("Invoice ID", invoiceID))
+		mon.Meter("projects_frozen_for_non_payment").Mark(1)
+	}
+
+	return nil
+}
+
+// unfreezeProjects unfreezes the projects frozen because of the paid invoice.
+func (invoices *invoices) unfreezeProjects(ctx context.Context, invoiceID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectIDs, err := invoices.service.usageDB.UnfreezeProjectsByInvoice(ctx, invoiceID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, projectID := range projectIDs {
+		invoices.service.log.Info("project unfrozen after payment",
+			zap.Stringer("Project ID", projectID),
+			zap.String("Invoice ID", invoiceID))
+	}
+	mon.Meter("projects_unfrozen_after_payment").Mark(len(projectIDs))
+
+	return nil
+}
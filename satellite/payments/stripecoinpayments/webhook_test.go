@@ -0,0 +1,78 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stripecoinpayments_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/stripecoinpayments"
+)
+
+func TestInvoices_HandleWebhookEvent(t *testing.T) {
+	const secret = "whsec_test"
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Payments.StripeCoinPayments.StripeWebhookSecret = secret
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		invoices := sat.API.Payments.Accounts.Invoices()
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		customerID, err := sat.DB.StripeCoinPayments().Customers().GetCustomerID(ctx, planet.Uplinks[0].Projects[0].Owner.ID)
+		require.NoError(t, err)
+
+		event := func(eventType, invoiceID string) []byte {
+			return []byte(fmt.Sprintf(`{"id":"evt_1","object":"event","type":%q,"data":{"object":{"id":%q,"object":"invoice","customer":%q}}}`,
+				eventType, invoiceID, customerID))
+		}
+		// the stripe signatures have the same format as the invoice export
+		// signatures.
+		sign := func(payload []byte) string {
+			return stripecoinpayments.SignInvoiceExport(secret, time.Now(), payload)
+		}
+
+		payload := event("invoice.payment_failed", "in_unpaid")
+		err = invoices.HandleWebhookEvent(ctx, payload, "t=1,v1=invalid")
+		require.True(t, payments.ErrInvalidWebhookEvent.Has(err))
+
+		freeze, err := sat.DB.ProjectAccounting().GetProjectFreeze(ctx, projectID)
+		require.NoError(t, err)
+		require.Nil(t, freeze)
+
+		require.NoError(t, invoices.HandleWebhookEvent(ctx, payload, sign(payload)))
+
+		freeze, err = sat.DB.ProjectAccounting().GetProjectFreeze(ctx, projectID)
+		require.NoError(t, err)
+		require.NotNil(t, freeze)
+		require.Equal(t, accounting.FreezeReasonNonPayment, freeze.Reason)
+		require.Equal(t, "in_unpaid", freeze.InvoiceID)
+		require.False(t, freeze.Downloads)
+
+		// the other events are ignored.
+		payload = event("invoice.created", "in_unpaid")
+		require.NoError(t, invoices.HandleWebhookEvent(ctx, payload, sign(payload)))
+
+		payload = event("invoice.paid", "in_unpaid")
+		require.NoError(t, invoices.HandleWebhookEvent(ctx, payload, sign(payload)))
+
+		freeze, err = sat.DB.ProjectAccounting().GetProjectFreeze(ctx, projectID)
+		require.NoError(t, err)
+		require.Nil(t, freeze)
+	})
+}
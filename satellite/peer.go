@@ -35,17 +35,24 @@ import (
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/console/emailreminders"
+	"storj.io/storj/satellite/console/projectpurge"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/console/usageanomaly"
 	"storj.io/storj/satellite/contact"
 	"storj.io/storj/satellite/gc"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
+	"storj.io/storj/satellite/maintenance"
+	"storj.io/storj/satellite/metabase/bucketlifecycle"
+	"storj.io/storj/satellite/metabase/trashdeletion"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
+	"storj.io/storj/satellite/metainfo/securitylog"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/nodeapiversion"
+	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
@@ -56,10 +63,14 @@ import (
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/repair/queuemonitor"
 	"storj.io/storj/satellite/repair/repairer"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/revocation"
+	"storj.io/storj/satellite/scheduler"
+	"storj.io/storj/satellite/segmentstats"
 	"storj.io/storj/satellite/snopayouts"
+	"storj.io/storj/satellite/tuning"
 )
 
 var mon = monkit.Package()
@@ -96,6 +107,8 @@ type DB interface {
 	ProjectAccounting() accounting.ProjectAccounting
 	// RepairQueue returns queue for segments that need repairing
 	RepairQueue() queue.RepairQueue
+	// RemediationQueue returns queue for segments that violate their declared redundancy
+	RemediationQueue() queue.RemediationQueue
 	// Console returns database for satellite console
 	Console() console.DB
 	// OIDC returns the database for OIDC resources.
@@ -123,6 +136,10 @@ type DB interface {
 	Revocation() revocation.DB
 	// NodeAPIVersion tracks nodes observed api usage
 	NodeAPIVersion() nodeapiversion.DB
+	// NodeEvents stores the events of nodes, which node operators are notified about.
+	NodeEvents() nodeevents.DB
+	// SegmentStatistics stores the segment statistics reports.
+	SegmentStatistics() segmentstats.DB
 	// StorjscanPayments stores payments retrieved from storjscan.
 	StorjscanPayments() storjscan.PaymentsDB
 }
@@ -133,25 +150,31 @@ type Config struct {
 	Server   server.Config
 	Debug    debug.Config
 
-	Admin admin.Config
+	Admin  admin.Config
+	Tuning tuning.Config
 
 	Contact    contact.Config
 	Overlay    overlay.Config
 	StrayNodes straynodes.Config
 
-	Metainfo metainfo.Config
-	Orders   orders.Config
+	Metainfo    metainfo.Config
+	Orders      orders.Config
+	Maintenance maintenance.Config
 
 	Reputation reputation.Config
 
-	Checker  checker.Config
-	Repairer repairer.Config
-	Audit    audit.Config
+	Checker            checker.Config
+	RepairQueueMonitor queuemonitor.Config
+	Repairer           repairer.Config
+	Audit              audit.Config
 
 	GarbageCollection gc.Config
 
 	ExpiredDeletion expireddeletion.Config
 	ZombieDeletion  zombiedeletion.Config
+	BucketLifecycle bucketlifecycle.Config
+	TrashDeletion   trashdeletion.Config
+	SecurityLog     securitylog.Config
 
 	Tally            tally.Config
 	Rollup           rollup.Config
@@ -167,14 +190,21 @@ type Config struct {
 	Console        consoleweb.Config
 	ConsoleAuth    consoleauth.Config
 	EmailReminders emailreminders.Config
+	NodeEvents     nodeevents.Config
+	UsageAnomaly   usageanomaly.Config
+	ProjectPurge   projectpurge.Config
 
 	Version version_checker.Config
 
 	GracefulExit gracefulexit.Config
 
-	Metrics metrics.Config
+	Metrics      metrics.Config
+	SegmentStats segmentstats.Config
 
 	Compensation compensation.Config
+	SNOPayouts   snopayouts.Config
+
+	Scheduler scheduler.Config
 
 	ProjectLimit accounting.ProjectLimitConfig
 
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -23,6 +24,8 @@ import (
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/repair"
 	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/scheduler"
+	"storj.io/storj/satellite/tuning"
 )
 
 // Error is a standard error class for this package.
@@ -37,31 +40,40 @@ var (
 type Checker struct {
 	logger               *zap.Logger
 	repairQueue          queue.RepairQueue
+	remediationQueue     queue.RemediationQueue
 	metabase             *metabase.DB
 	segmentLoop          *segmentloop.Service
 	nodestate            *ReliabilityCache
 	statsCollector       *statsCollector
-	repairOverrides      RepairOverridesMap
 	nodeFailureRate      float64
 	repairQueueBatchSize int
+	scheduler            *scheduler.Scheduler
 	Loop                 *sync2.Cycle
+
+	mu                    sync.Mutex
+	repairOverrides       RepairOverridesMap
+	repairOverridesConfig RepairOverrides
 }
 
 // NewChecker creates a new instance of checker.
-func NewChecker(logger *zap.Logger, repairQueue queue.RepairQueue, metabase *metabase.DB, segmentLoop *segmentloop.Service, overlay *overlay.Service, config Config) *Checker {
+func NewChecker(logger *zap.Logger, repairQueue queue.RepairQueue, remediationQueue queue.RemediationQueue, metabase *metabase.DB, segmentLoop *segmentloop.Service, overlay *overlay.Service, scheduler *scheduler.Scheduler, config Config) *Checker {
 	return &Checker{
 		logger: logger,
 
 		repairQueue:          repairQueue,
+		remediationQueue:     remediationQueue,
 		metabase:             metabase,
 		segmentLoop:          segmentLoop,
 		nodestate:            NewReliabilityCache(overlay, config.ReliabilityCacheStaleness),
 		statsCollector:       newStatsCollector(),
-		repairOverrides:      config.RepairOverrides.GetMap(),
 		nodeFailureRate:      config.NodeFailureRate,
 		repairQueueBatchSize: config.RepairQueueInsertBatchSize,
+		scheduler:            scheduler,
 
 		Loop: sync2.NewCycle(config.Interval),
+
+		repairOverrides:       config.RepairOverrides.GetMap(),
+		repairOverridesConfig: config.RepairOverrides,
 	}
 }
 
@@ -69,7 +81,15 @@ func NewChecker(logger *zap.Logger, repairQueue queue.RepairQueue, metabase *met
 func (checker *Checker) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return checker.Loop.Run(ctx, checker.IdentifyInjuredSegments)
+	return checker.Loop.Run(ctx, func(ctx context.Context) error {
+		release, err := checker.scheduler.Acquire(ctx, "checker")
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		return checker.IdentifyInjuredSegments(ctx)
+	})
 }
 
 // getNodesEstimate updates the estimate of the total number of nodes. It is guaranteed
@@ -103,6 +123,50 @@ func (checker *Checker) RefreshReliabilityCache(ctx context.Context) error {
 	return checker.nodestate.Refresh(ctx)
 }
 
+// RepairOverrides returns the repair threshold overrides in use.
+func (checker *Checker) RepairOverrides() RepairOverrides {
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	return RepairOverrides{List: append([]RepairOverride(nil), checker.repairOverridesConfig.List...)}
+}
+
+// SetRepairOverrides changes the repair threshold overrides. The overrides
+// are used from the next checker run.
+func (checker *Checker) SetRepairOverrides(overrides RepairOverrides) {
+	overrides = RepairOverrides{List: append([]RepairOverride(nil), overrides.List...)}
+
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	checker.repairOverrides = overrides.GetMap()
+	checker.repairOverridesConfig = overrides
+}
+
+// TuningSettings returns the checker settings, which can be changed at
+// runtime.
+func (checker *Checker) TuningSettings(interval time.Duration) []tuning.Setting {
+	return []tuning.Setting{
+		tuning.Cycle("checker.interval", checker.Loop, interval),
+		{
+			Name:        "checker.repair-overrides",
+			Description: "comma-separated override values for repair threshold in the format k/o/n-override (min/optimal/total-override)",
+			Get: func() string {
+				overrides := checker.RepairOverrides()
+				return overrides.String()
+			},
+			Set: func(value string) error {
+				var overrides RepairOverrides
+				if err := overrides.Set(value); err != nil {
+					return err
+				}
+				checker.SetRepairOverrides(overrides)
+				return nil
+			},
+		},
+	}
+}
+
 // Close halts the Checker loop.
 func (checker *Checker) Close() error {
 	checker.Loop.Close()
@@ -115,12 +179,17 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 
 	startTime := time.Now()
 
+	checker.mu.Lock()
+	repairOverrides := checker.repairOverrides
+	checker.mu.Unlock()
+
 	observer := &checkerObserver{
 		repairQueue:      checker.createInsertBuffer(),
+		remediationQueue: checker.remediationQueue,
 		nodestate:        checker.nodestate,
 		statsCollector:   checker.statsCollector,
-		monStats:         aggregateStats{},
-		repairOverrides:  checker.repairOverrides,
+		monStats:         aggregateStats{remoteSegmentsViolating: map[queue.Violation]int64{}},
+		repairOverrides:  repairOverrides,
 		nodeFailureRate:  checker.nodeFailureRate,
 		getNodesEstimate: checker.getNodesEstimate,
 		log:              checker.logger,
@@ -144,6 +213,12 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 		return Error.Wrap(err)
 	}
 
+	// remove all segments which were not seen as violating by this checker iteration
+	remediatedDeleted, err := checker.remediationQueue.Clean(ctx, startTime)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
 	checker.statsCollector.collectAggregates()
 
 	mon.IntVal("remote_files_checked").Observe(observer.monStats.objectsChecked)                               //mon:locked
@@ -159,6 +234,12 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 	mon.IntVal("remote_segments_over_threshold_4").Observe(observer.monStats.remoteSegmentsOverThreshold[3])   //mon:locked
 	mon.IntVal("remote_segments_over_threshold_5").Observe(observer.monStats.remoteSegmentsOverThreshold[4])   //mon:locked
 	mon.IntVal("healthy_segments_removed_from_queue").Observe(healthyDeleted)                                  //mon:locked
+	mon.IntVal("remediated_segments_removed_from_queue").Observe(remediatedDeleted)
+
+	for _, violation := range queue.Violations {
+		mon.IntVal("remote_segments_violating_redundancy",
+			monkit.NewSeriesTag("violation", string(violation))).Observe(observer.monStats.remoteSegmentsViolating[violation])
+	}
 
 	allUnhealthy := observer.monStats.remoteSegmentsNeedingRepair + observer.monStats.remoteSegmentsFailedToCheck
 	allChecked := observer.monStats.remoteSegmentsChecked
@@ -177,6 +258,7 @@ var _ segmentloop.Observer = (*checkerObserver)(nil)
 // architecture: Observer
 type checkerObserver struct {
 	repairQueue      *queue.InsertBuffer
+	remediationQueue queue.RemediationQueue
 	nodestate        *ReliabilityCache
 	statsCollector   *statsCollector
 	monStats         aggregateStats // TODO(cam): once we verify statsCollector reports data correctly, remove this
@@ -283,6 +365,15 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 
 	required, repairThreshold, successThreshold, _ := obs.loadRedundancy(segment.Redundancy)
 
+	violations := redundancyViolations(segment)
+	outOfPlacement, err := obs.nodestate.OutOfPlacementPieces(ctx, segment.CreatedAt, segment.Pieces, segment.Placement)
+	if err != nil {
+		obs.log.Error("error getting out of placement pieces", zap.Error(err))
+	} else if len(outOfPlacement) > 0 {
+		violations[queue.ViolationOutOfPlacement] = len(outOfPlacement)
+	}
+	obs.remediate(ctx, segment, violations)
+
 	segmentHealth := repair.SegmentHealth(numHealthy, required, totalNumNodes, obs.nodeFailureRate)
 	mon.FloatVal("checker_segment_health").Observe(segmentHealth) //mon:locked
 	stats.segmentHealth.Observe(segmentHealth)
@@ -300,6 +391,7 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 			Position:      segment.Position,
 			UpdatedAt:     time.Now().UTC(),
 			SegmentHealth: segmentHealth,
+			Placement:     segment.Placement,
 		}, func() {
 			// Counters are increased after the queue has determined
 			// that the segment wasn't already queued for repair.
@@ -18,6 +18,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair/queue"
 )
 
 func TestIdentifyInjuredSegments(t *testing.T) {
@@ -84,6 +85,69 @@ func TestIdentifyInjuredSegments(t *testing.T) {
 	})
 }
 
+func TestIdentifyViolatingSegments(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		checker := planet.Satellites[0].Repair.Checker
+		remediationQueue := planet.Satellites[0].DB.RemediationQueue()
+
+		checker.Loop.Pause()
+		planet.Satellites[0].Repair.Repairer.Loop.Pause()
+
+		rs := storj.RedundancyScheme{
+			RequiredShares: 1,
+			RepairShares:   2,
+			OptimalShares:  3,
+			TotalShares:    4,
+			ShareSize:      256,
+		}
+
+		projectID := planet.Uplinks[0].Projects[0].ID
+		err := planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], "test-bucket")
+		require.NoError(t, err)
+
+		location := metabase.SegmentLocation{
+			ProjectID:  projectID,
+			BucketName: "test-bucket",
+			ObjectKey:  "healthy",
+		}
+		insertSegment(ctx, t, planet, rs, location, createPieces(planet, rs), nil)
+
+		// two pieces on the same node
+		pieces := createPieces(planet, rs)
+		pieces[1].StorageNode = pieces[0].StorageNode
+		location.ObjectKey = "duplicate-nodes"
+		streamID := insertSegment(ctx, t, planet, rs, location, pieces, nil)
+
+		checker.Loop.TriggerWait()
+
+		segments, err := remediationQueue.SelectN(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Equal(t, streamID, segments[0].StreamID)
+		require.Equal(t, queue.ViolationDuplicateNodes, segments[0].Violation)
+		require.Equal(t, 1, segments[0].ViolatingPieces)
+
+		counts, err := remediationQueue.Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, map[queue.Violation]int{queue.ViolationDuplicateNodes: 1}, counts)
+
+		// segments which don't violate the redundancy anymore are removed
+		_, err = planet.Satellites[0].Metabase.DB.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+			ObjectLocation: location.Object(),
+			Version:        1,
+		})
+		require.NoError(t, err)
+
+		checker.Loop.TriggerWait()
+
+		counts, err = remediationQueue.Count(ctx)
+		require.NoError(t, err)
+		require.Empty(t, counts)
+	})
+}
+
 func TestIdentifyIrreparableSegments(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 3, UplinkCount: 1,
@@ -9,6 +9,7 @@ import (
 	"github.com/spacemonkeygo/monkit/v3"
 
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/repair/queue"
 )
 
 // statsCollector holds a *stats for each redundancy scheme
@@ -88,6 +89,10 @@ type aggregateStats struct {
 
 	// remoteSegmentsOverThreshold[0]=# of healthy=rt+1, remoteSegmentsOverThreshold[1]=# of healthy=rt+2, etc...
 	remoteSegmentsOverThreshold [5]int64
+
+	// remoteSegmentsViolating counts the segments violating their declared
+	// redundancy or placement by violation class.
+	remoteSegmentsViolating map[queue.Violation]int64
 }
 
 func newStats(rs string) *stats {
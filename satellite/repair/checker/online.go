@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"storj.io/common/storj"
+	"storj.io/common/storj/location"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/overlay"
 )
@@ -27,7 +28,7 @@ type ReliabilityCache struct {
 
 // reliabilityState.
 type reliabilityState struct {
-	reliable map[storj.NodeID]struct{}
+	reliable map[storj.NodeID]location.CountryCode
 	created  time.Time
 }
 
@@ -79,6 +80,28 @@ func (cache *ReliabilityCache) MissingPieces(ctx context.Context, created time.T
 	return unreliable, nil
 }
 
+// OutOfPlacementPieces returns the pieces stored on reliable nodes which don't
+// satisfy the placement constraint.
+func (cache *ReliabilityCache) OutOfPlacementPieces(ctx context.Context, created time.Time, pieces metabase.Pieces, placement storj.PlacementConstraint) (_ []metabase.Piece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if placement == storj.EveryCountry {
+		return nil, nil
+	}
+
+	state, err := cache.loadFast(ctx, created)
+	if err != nil {
+		return nil, err
+	}
+	var outOfPlacement []metabase.Piece
+	for _, p := range pieces {
+		if countryCode, ok := state.reliable[p.StorageNode]; ok && !placement.AllowedCountry(countryCode) {
+			outOfPlacement = append(outOfPlacement, p)
+		}
+	}
+	return outOfPlacement, nil
+}
+
 func (cache *ReliabilityCache) loadFast(ctx context.Context, validUpTo time.Time) (_ *reliabilityState, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -120,17 +143,17 @@ func (cache *ReliabilityCache) Refresh(ctx context.Context) (err error) {
 func (cache *ReliabilityCache) refreshLocked(ctx context.Context) (_ *reliabilityState, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	nodes, err := cache.overlay.Reliable(ctx)
+	nodes, err := cache.overlay.ReliableNodes(ctx)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
 	state := &reliabilityState{
 		created:  time.Now(),
-		reliable: make(map[storj.NodeID]struct{}, len(nodes)),
+		reliable: make(map[storj.NodeID]location.CountryCode, len(nodes)),
 	}
-	for _, id := range nodes {
-		state.reliable[id] = struct{}{}
+	for _, node := range nodes {
+		state.reliable[node.ID] = node.CountryCode
 	}
 
 	cache.state.Store(state)
@@ -12,7 +12,6 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
-	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/satellite/metabase"
@@ -53,11 +52,11 @@ func TestReliabilityCache_Concurrent(t *testing.T) {
 
 type fakeOverlayDB struct{ overlay.DB }
 
-func (fakeOverlayDB) Reliable(context.Context, *overlay.NodeCriteria) (storj.NodeIDList, error) {
-	return storj.NodeIDList{
-		testrand.NodeID(),
-		testrand.NodeID(),
-		testrand.NodeID(),
-		testrand.NodeID(),
+func (fakeOverlayDB) ReliableNodes(context.Context, *overlay.NodeCriteria) ([]*overlay.SelectedNode, error) {
+	return []*overlay.SelectedNode{
+		{ID: testrand.NodeID()},
+		{ID: testrand.NodeID()},
+		{ID: testrand.NodeID()},
+		{ID: testrand.NodeID()},
 	}, nil
 }
@@ -0,0 +1,61 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"context"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// remediate adds the segment to the remediation queue for each of its
+// violations.
+func (obs *checkerObserver) remediate(ctx context.Context, segment *segmentloop.Segment, violations map[queue.Violation]int) {
+	for violation, violatingPieces := range violations {
+		obs.monStats.remoteSegmentsViolating[violation]++
+		mon.Counter("checker_segments_violating_redundancy", monkit.NewSeriesTag("violation", string(violation))).Inc(1)
+
+		err := obs.remediationQueue.Insert(ctx, &queue.ViolatingSegment{
+			StreamID:        segment.StreamID,
+			Position:        segment.Position,
+			Placement:       segment.Placement,
+			Violation:       violation,
+			ViolatingPieces: violatingPieces,
+		})
+		if err != nil {
+			obs.log.Error("error adding violating segment to remediation queue", zap.Error(err))
+		}
+	}
+}
+
+// redundancyViolations returns the violations of the declared redundancy of
+// the segment with the number of pieces causing each of them.
+func redundancyViolations(segment *segmentloop.Segment) map[queue.Violation]int {
+	violations := map[queue.Violation]int{}
+
+	if total := int(segment.Redundancy.TotalShares); total > 0 && len(segment.Pieces) > total {
+		violations[queue.ViolationTooManyPieces] = len(segment.Pieces) - total
+	}
+
+	pieceNumbers := make(map[uint16]struct{}, len(segment.Pieces))
+	nodes := make(map[storj.NodeID]struct{}, len(segment.Pieces))
+	for _, piece := range segment.Pieces {
+		if _, ok := pieceNumbers[piece.Number]; ok {
+			violations[queue.ViolationDuplicatePieceNumbers]++
+		}
+		pieceNumbers[piece.Number] = struct{}{}
+
+		if _, ok := nodes[piece.StorageNode]; ok {
+			violations[queue.ViolationDuplicateNodes]++
+		}
+		nodes[piece.StorageNode] = struct{}{}
+	}
+
+	return violations
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+func TestRedundancyViolations(t *testing.T) {
+	rs := storj.RedundancyScheme{RequiredShares: 1, RepairShares: 2, OptimalShares: 3, TotalShares: 3}
+	nodes := []storj.NodeID{testrand.NodeID(), testrand.NodeID(), testrand.NodeID(), testrand.NodeID()}
+
+	for _, tt := range []struct {
+		name       string
+		pieces     metabase.Pieces
+		violations map[queue.Violation]int
+	}{
+		{
+			name:       "healthy",
+			pieces:     metabase.Pieces{{Number: 0, StorageNode: nodes[0]}, {Number: 1, StorageNode: nodes[1]}, {Number: 2, StorageNode: nodes[2]}},
+			violations: map[queue.Violation]int{},
+		},
+		{
+			name: "too many pieces",
+			pieces: metabase.Pieces{
+				{Number: 0, StorageNode: nodes[0]}, {Number: 1, StorageNode: nodes[1]},
+				{Number: 2, StorageNode: nodes[2]}, {Number: 3, StorageNode: nodes[3]},
+			},
+			violations: map[queue.Violation]int{queue.ViolationTooManyPieces: 1},
+		},
+		{
+			name:       "duplicate piece numbers",
+			pieces:     metabase.Pieces{{Number: 0, StorageNode: nodes[0]}, {Number: 0, StorageNode: nodes[1]}},
+			violations: map[queue.Violation]int{queue.ViolationDuplicatePieceNumbers: 1},
+		},
+		{
+			name:       "duplicate nodes",
+			pieces:     metabase.Pieces{{Number: 0, StorageNode: nodes[0]}, {Number: 1, StorageNode: nodes[0]}, {Number: 2, StorageNode: nodes[0]}},
+			violations: map[queue.Violation]int{queue.ViolationDuplicateNodes: 2},
+		},
+	} {
+		violations := redundancyViolations(&segmentloop.Segment{Redundancy: rs, Pieces: tt.pieces})
+		require.Equal(t, tt.violations, violations, tt.name)
+	}
+}
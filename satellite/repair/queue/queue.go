@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
@@ -16,6 +17,9 @@ import (
 type InjuredSegment struct {
 	StreamID uuid.UUID
 	Position metabase.SegmentPosition
+	// Placement is the placement constraint of the segment, which the
+	// replacement nodes have to satisfy.
+	Placement storj.PlacementConstraint
 
 	SegmentHealth float64
 	AttemptedAt   *time.Time
@@ -23,6 +27,15 @@ type InjuredSegment struct {
 	InsertedAt    time.Time
 }
 
+// Stat contains the statistics of the repair queue.
+type Stat struct {
+	Count int
+	// OldestInsertedAt is the time when the segment, which has been waiting
+	// in the queue for the longest time, was inserted. It's nil when the
+	// queue is empty.
+	OldestInsertedAt *time.Time
+}
+
 // RepairQueue implements queueing for segments that need repairing.
 // Implementation can be found at satellite/satellitedb/repairqueue.go.
 //
@@ -42,6 +55,8 @@ type RepairQueue interface {
 	SelectN(ctx context.Context, limit int) ([]InjuredSegment, error)
 	// Count counts the number of segments in the repair queue.
 	Count(ctx context.Context) (count int, err error)
+	// Stat returns the statistics of the repair queue.
+	Stat(ctx context.Context) (Stat, error)
 
 	// TestingSetAttemptedTime sets attempted time for a segment.
 	TestingSetAttemptedTime(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, t time.Time) (rowsAffected int64, err error)
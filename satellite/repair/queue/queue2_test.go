@@ -282,3 +282,27 @@ func TestCount(t *testing.T) {
 	})
 
 }
+
+func TestStat(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		repairQueue := db.RepairQueue()
+
+		stat, err := repairQueue.Stat(ctx)
+		require.NoError(t, err)
+		require.Equal(t, queue.Stat{}, stat)
+
+		before := time.Now()
+		for i := 0; i < 3; i++ {
+			_, err := repairQueue.Insert(ctx, &queue.InjuredSegment{
+				StreamID: testrand.UUID(),
+			})
+			require.NoError(t, err)
+		}
+
+		stat, err = repairQueue.Stat(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 3, stat.Count)
+		require.NotNil(t, stat.OldestInsertedAt)
+		require.WithinDuration(t, before, *stat.OldestInsertedAt, 5*time.Second)
+	})
+}
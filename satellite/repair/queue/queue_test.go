@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/errs2"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
@@ -42,6 +43,49 @@ func TestInsertSelect(t *testing.T) {
 	})
 }
 
+func TestInsertPlacement(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		q := db.RepairQueue()
+
+		seg := createInjuredSegment()
+		seg.Placement = storj.EU
+
+		_, err := q.Insert(ctx, seg)
+		require.NoError(t, err)
+
+		batchSeg := createInjuredSegment()
+		batchSeg.Placement = storj.US
+		_, err = q.InsertBatch(ctx, []*queue.InjuredSegment{batchSeg})
+		require.NoError(t, err)
+
+		segments, err := q.SelectN(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+
+		placements := map[uuid.UUID]storj.PlacementConstraint{}
+		for _, s := range segments {
+			placements[s.StreamID] = s.Placement
+		}
+		require.Equal(t, storj.EU, placements[seg.StreamID])
+		require.Equal(t, storj.US, placements[batchSeg.StreamID])
+
+		// updating the segment updates the placement as well.
+		seg.Placement = storj.DE
+		alreadyInserted, err := q.Insert(ctx, seg)
+		require.NoError(t, err)
+		require.True(t, alreadyInserted)
+
+		for {
+			s, err := q.Select(ctx)
+			require.NoError(t, err)
+			if s.StreamID == seg.StreamID {
+				require.Equal(t, storj.DE, s.Placement)
+				break
+			}
+		}
+	})
+}
+
 func TestInsertDuplicate(t *testing.T) {
 	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
 		q := db.RepairQueue()
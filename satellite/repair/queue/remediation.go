@@ -0,0 +1,74 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package queue
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Violation is a class of violations of the declared redundancy or the
+// placement of a segment.
+type Violation string
+
+const (
+	// ViolationTooManyPieces means the segment has more pieces than the total
+	// shares of its redundancy scheme.
+	ViolationTooManyPieces Violation = "too_many_pieces"
+	// ViolationDuplicatePieceNumbers means the segment has more than one piece
+	// with the same piece number.
+	ViolationDuplicatePieceNumbers Violation = "duplicate_piece_numbers"
+	// ViolationDuplicateNodes means more than one piece of the segment is
+	// stored on the same node.
+	ViolationDuplicateNodes Violation = "duplicate_nodes"
+	// ViolationOutOfPlacement means pieces of the segment are stored on nodes
+	// which don't satisfy its placement constraint.
+	ViolationOutOfPlacement Violation = "out_of_placement"
+)
+
+// Violations contains all the classes of violations.
+var Violations = []Violation{
+	ViolationTooManyPieces,
+	ViolationDuplicatePieceNumbers,
+	ViolationDuplicateNodes,
+	ViolationOutOfPlacement,
+}
+
+// ViolatingSegment contains information about a segment which violates its
+// declared redundancy or placement and has to be remediated.
+type ViolatingSegment struct {
+	StreamID  uuid.UUID
+	Position  metabase.SegmentPosition
+	Placement storj.PlacementConstraint
+	Violation Violation
+	// ViolatingPieces is the number of pieces which cause the violation.
+	ViolatingPieces int
+
+	UpdatedAt  time.Time
+	InsertedAt time.Time
+}
+
+// RemediationQueue implements queueing for segments which violate their
+// declared redundancy or placement. These segments aren't missing pieces, so
+// they are remediated separately from the repair.
+// Implementation can be found at satellite/satellitedb/remediationqueue.go.
+//
+// architecture: Database
+type RemediationQueue interface {
+	// Insert adds a violating segment or updates it when it's already queued
+	// for the same violation.
+	Insert(ctx context.Context, s *ViolatingSegment) error
+	// SelectN lists limit amount of violating segments.
+	SelectN(ctx context.Context, limit int) ([]ViolatingSegment, error)
+	// Delete removes a violating segment.
+	Delete(ctx context.Context, s *ViolatingSegment) error
+	// Clean removes all segments last updated before a certain time.
+	Clean(ctx context.Context, before time.Time) (deleted int64, err error)
+	// Count counts the number of segments in the remediation queue by violation.
+	Count(ctx context.Context) (map[Violation]int, error)
+}
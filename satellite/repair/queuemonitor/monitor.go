@@ -0,0 +1,226 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package queuemonitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+var (
+	// Error is the error class for the repair queue monitor.
+	Error = errs.Class("repair queue monitor")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the repair queue monitor.
+type Config struct {
+	Interval       time.Duration `help:"how often to check the age and the growth of the repair queue" releaseDefault:"5m" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	MaxOldestAge   time.Duration `help:"alert when the oldest segment has been waiting in the repair queue for longer than this. 0 disables the alert" default:"72h"`
+	MaxGrowthRate  float64       `help:"alert when the repair queue grows by more than this many segments per hour. 0 disables the alert" default:"0"`
+	GrowthWindow   time.Duration `help:"the period over which the growth rate of the repair queue is measured" default:"1h"`
+	WebhookURL     string        `help:"url to post the repair queue alerts to. the alerts are only logged when it's empty" default:""`
+	WebhookTimeout time.Duration `help:"timeout of the repair queue alert webhook requests" default:"10s"`
+}
+
+// AlertKind is the kind of the repair queue alert.
+type AlertKind string
+
+const (
+	// AlertOldestAge is raised when the oldest segment in the repair queue
+	// waits for longer than the configured maximum.
+	AlertOldestAge = AlertKind("oldest_age")
+	// AlertGrowthRate is raised when the repair queue grows faster than the
+	// configured maximum.
+	AlertGrowthRate = AlertKind("growth_rate")
+)
+
+// Alert is the notification about the repair queue crossing a threshold,
+// or getting back under it.
+type Alert struct {
+	Kind AlertKind `json:"kind"`
+	// Resolved is true when the value got back under the threshold.
+	Resolved bool `json:"resolved"`
+	// Value and Threshold are in seconds for AlertOldestAge and in segments
+	// per hour for AlertGrowthRate.
+	Value     float64   `json:"value"`
+	Threshold float64   `json:"threshold"`
+	QueueSize int       `json:"queueSize"`
+	Time      time.Time `json:"time"`
+}
+
+// Notifier notifies the operators about the repair queue alerts.
+type Notifier interface {
+	Notify(ctx context.Context, alert Alert) error
+}
+
+// sample is the size of the repair queue at a point in time.
+type sample struct {
+	at    time.Time
+	count int
+}
+
+// Monitor tracks the age of the oldest segment and the growth rate of the
+// repair queue, and alerts the operators when they cross the thresholds.
+//
+// architecture: Chore
+type Monitor struct {
+	log      *zap.Logger
+	queue    queue.RepairQueue
+	notifier Notifier
+	config   Config
+	nowFn    func() time.Time
+
+	samples []sample
+	firing  map[AlertKind]bool
+
+	Loop *sync2.Cycle
+}
+
+// NewMonitor creates a new repair queue monitor. The alerts are only logged
+// when notifier is nil.
+func NewMonitor(log *zap.Logger, queue queue.RepairQueue, notifier Notifier, config Config) *Monitor {
+	return &Monitor{
+		log:      log,
+		queue:    queue,
+		notifier: notifier,
+		config:   config,
+		nowFn:    time.Now,
+
+		firing: make(map[AlertKind]bool),
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the monitor.
+func (monitor *Monitor) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return monitor.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := monitor.Check(ctx); err != nil {
+			monitor.log.Error("error while checking the repair queue", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Check measures the repair queue, and raises or resolves the alerts.
+func (monitor *Monitor) Check(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stat, err := monitor.queue.Stat(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	now := monitor.nowFn()
+
+	var oldestAge time.Duration
+	if stat.OldestInsertedAt != nil {
+		oldestAge = now.Sub(*stat.OldestInsertedAt)
+	}
+	growthRate, hasGrowthRate := monitor.addSample(sample{at: now, count: stat.Count})
+
+	mon.IntVal("repair_queue_size").Observe(int64(stat.Count))
+	mon.IntVal("repair_queue_oldest_age_seconds").Observe(int64(oldestAge.Seconds()))
+	if hasGrowthRate {
+		mon.FloatVal("repair_queue_growth_per_hour").Observe(growthRate)
+	}
+
+	var group errs.Group
+	if monitor.config.MaxOldestAge > 0 {
+		group.Add(monitor.evaluate(ctx, Alert{
+			Kind:      AlertOldestAge,
+			Value:     oldestAge.Seconds(),
+			Threshold: monitor.config.MaxOldestAge.Seconds(),
+			QueueSize: stat.Count,
+			Time:      now,
+		}))
+	}
+	if monitor.config.MaxGrowthRate > 0 && hasGrowthRate {
+		group.Add(monitor.evaluate(ctx, Alert{
+			Kind:      AlertGrowthRate,
+			Value:     growthRate,
+			Threshold: monitor.config.MaxGrowthRate,
+			QueueSize: stat.Count,
+			Time:      now,
+		}))
+	}
+	return Error.Wrap(group.Err())
+}
+
+// addSample records the size of the queue and returns its growth rate in
+// segments per hour over the growth window. The rate isn't known until the
+// samples cover at least half of the window.
+func (monitor *Monitor) addSample(current sample) (rate float64, ok bool) {
+	windowStart := current.at.Add(-monitor.config.GrowthWindow)
+
+	samples := monitor.samples[:0]
+	for _, s := range monitor.samples {
+		if !s.at.Before(windowStart) {
+			samples = append(samples, s)
+		}
+	}
+	monitor.samples = append(samples, current)
+
+	oldest := monitor.samples[0]
+	elapsed := current.at.Sub(oldest.at)
+	if elapsed <= 0 || elapsed < monitor.config.GrowthWindow/2 {
+		return 0, false
+	}
+	return float64(current.count-oldest.count) / elapsed.Hours(), true
+}
+
+// evaluate notifies about the alert when its value crosses the threshold in
+// either direction. The alerts which keep firing aren't repeated, unless the
+// notification failed.
+func (monitor *Monitor) evaluate(ctx context.Context, alert Alert) (err error) {
+	exceeded := alert.Value > alert.Threshold
+	if exceeded == monitor.firing[alert.Kind] {
+		return nil
+	}
+	alert.Resolved = !exceeded
+	defer func() {
+		if err == nil {
+			monitor.firing[alert.Kind] = exceeded
+		}
+	}()
+
+	fields := []zap.Field{
+		zap.String("Kind", string(alert.Kind)),
+		zap.Float64("Value", alert.Value),
+		zap.Float64("Threshold", alert.Threshold),
+		zap.Int("Queue Size", alert.QueueSize),
+	}
+	if alert.Resolved {
+		monitor.log.Info("repair queue alert resolved", fields...)
+	} else {
+		monitor.log.Warn("repair queue alert", fields...)
+		mon.Counter("repair_queue_alerts", monkit.NewSeriesTag("kind", string(alert.Kind))).Inc(1)
+	}
+
+	if monitor.notifier == nil {
+		return nil
+	}
+	return monitor.notifier.Notify(ctx, alert)
+}
+
+// SetNow allows tests to have the monitor act as if the current time is whatever they want.
+func (monitor *Monitor) SetNow(nowFn func() time.Time) {
+	monitor.nowFn = nowFn
+}
+
+// Close stops the monitor.
+func (monitor *Monitor) Close() error {
+	monitor.Loop.Close()
+	return nil
+}
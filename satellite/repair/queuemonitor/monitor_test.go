@@ -0,0 +1,151 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package queuemonitor_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/repair/queuemonitor"
+)
+
+type fakeQueue struct {
+	queue.RepairQueue
+	stat queue.Stat
+}
+
+func (q *fakeQueue) Stat(ctx context.Context) (queue.Stat, error) {
+	return q.stat, nil
+}
+
+type fakeNotifier struct {
+	alerts []queuemonitor.Alert
+	err    error
+}
+
+func (n *fakeNotifier) Notify(ctx context.Context, alert queuemonitor.Alert) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+func TestMonitorOldestAge(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	now := time.Now()
+	repairQueue := &fakeQueue{}
+	notifier := &fakeNotifier{}
+	monitor := queuemonitor.NewMonitor(zaptest.NewLogger(t), repairQueue, notifier, queuemonitor.Config{
+		Interval:     time.Hour,
+		MaxOldestAge: 24 * time.Hour,
+		GrowthWindow: time.Hour,
+	})
+	monitor.SetNow(func() time.Time { return now })
+
+	// empty queue.
+	require.NoError(t, monitor.Check(ctx))
+	require.Empty(t, notifier.alerts)
+
+	oldest := now.Add(-25 * time.Hour)
+	repairQueue.stat = queue.Stat{Count: 10, OldestInsertedAt: &oldest}
+
+	// the notification failed, hence it's retried on the next check.
+	notifier.err = errs.New("unavailable")
+	require.Error(t, monitor.Check(ctx))
+	notifier.err = nil
+
+	require.NoError(t, monitor.Check(ctx))
+	require.Len(t, notifier.alerts, 1)
+	require.Equal(t, queuemonitor.AlertOldestAge, notifier.alerts[0].Kind)
+	require.False(t, notifier.alerts[0].Resolved)
+	require.Equal(t, (25 * time.Hour).Seconds(), notifier.alerts[0].Value)
+	require.Equal(t, 10, notifier.alerts[0].QueueSize)
+
+	// the alert which keeps firing isn't repeated.
+	require.NoError(t, monitor.Check(ctx))
+	require.Len(t, notifier.alerts, 1)
+
+	oldest = now.Add(-time.Hour)
+	require.NoError(t, monitor.Check(ctx))
+	require.Len(t, notifier.alerts, 2)
+	require.Equal(t, queuemonitor.AlertOldestAge, notifier.alerts[1].Kind)
+	require.True(t, notifier.alerts[1].Resolved)
+}
+
+func TestMonitorGrowthRate(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	now := time.Now()
+	repairQueue := &fakeQueue{}
+	notifier := &fakeNotifier{}
+	monitor := queuemonitor.NewMonitor(zaptest.NewLogger(t), repairQueue, notifier, queuemonitor.Config{
+		Interval:      time.Hour,
+		MaxGrowthRate: 100,
+		GrowthWindow:  time.Hour,
+	})
+	monitor.SetNow(func() time.Time { return now })
+
+	check := func(count int) {
+		repairQueue.stat = queue.Stat{Count: count}
+		require.NoError(t, monitor.Check(ctx))
+		now = now.Add(15 * time.Minute)
+	}
+
+	// the growth rate isn't known until half of the window is covered.
+	check(0)
+	check(1000)
+	require.Empty(t, notifier.alerts)
+
+	check(1000)
+	require.Len(t, notifier.alerts, 1)
+	require.Equal(t, queuemonitor.AlertGrowthRate, notifier.alerts[0].Kind)
+	require.False(t, notifier.alerts[0].Resolved)
+	require.Equal(t, float64(2000), notifier.alerts[0].Value)
+
+	// the samples older than the window are dropped.
+	check(1000)
+	check(1000)
+	check(1000)
+	require.Len(t, notifier.alerts, 2)
+	require.True(t, notifier.alerts[1].Resolved)
+}
+
+func TestWebhook(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var received queuemonitor.Alert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if json.NewDecoder(r.Body).Decode(&received) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	webhook := queuemonitor.NewWebhook(queuemonitor.Config{
+		WebhookURL:     server.URL,
+		WebhookTimeout: time.Second,
+	})
+
+	alert := queuemonitor.Alert{
+		Kind:      queuemonitor.AlertOldestAge,
+		Value:     100,
+		Threshold: 10,
+		QueueSize: 5,
+		Time:      time.Now().UTC().Truncate(time.Second),
+	}
+	require.NoError(t, webhook.Notify(ctx, alert))
+	require.Equal(t, alert, received)
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package queuemonitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/zeebo/errs"
+)
+
+// Webhook posts the repair queue alerts as JSON to a url, e.g. of an
+// incident management system.
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook creates a new repair queue alert webhook.
+func NewWebhook(config Config) *Webhook {
+	return &Webhook{
+		url:    config.WebhookURL,
+		client: &http.Client{Timeout: config.WebhookTimeout},
+	}
+}
+
+// Notify posts the alert to the webhook url.
+func (webhook *Webhook) Notify(ctx context.Context, alert Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.url, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := webhook.client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -3167,3 +3167,74 @@ func TestSegmentInExcludedCountriesRepairIrreparable(t *testing.T) {
 		}
 	})
 }
+
+// TestRepairDryRun ensures that the repairer doesn't repair segments nor
+// removes them from the queue in dry run mode, but reports what it would do.
+func TestRepairDryRun(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 16,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					config.Repairer.DryRun = true
+				},
+				testplanet.ReconfigureRS(3, 4, 9, 9),
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplinkPeer := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		satellite.Audit.Worker.Loop.Pause()
+
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		err := uplinkPeer.Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		segment, _ := getRemoteSegment(ctx, t, satellite, uplinkPeer.Projects[0].ID, "testbucket")
+
+		// kill nodes to fall below the repair threshold
+		nodesToKill := make(map[storj.NodeID]bool)
+		for _, piece := range segment.Pieces[:5] {
+			nodesToKill[piece.StorageNode] = true
+		}
+		for _, node := range planet.StorageNodes {
+			if nodesToKill[node.ID()] {
+				require.NoError(t, planet.StopNodeAndUpdate(ctx, node))
+			}
+		}
+
+		satellite.Repair.Checker.Loop.Restart()
+		satellite.Repair.Checker.Loop.TriggerWait()
+		satellite.Repair.Checker.Loop.Pause()
+
+		count, err := satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		satellite.Repair.Repairer.Loop.Restart()
+		satellite.Repair.Repairer.Loop.TriggerWait()
+		satellite.Repair.Repairer.Loop.Pause()
+		satellite.Repair.Repairer.WaitForPendingRepairs()
+
+		summary := satellite.Repair.Repairer.DryRunSummary()
+		require.EqualValues(t, 1, summary.Segments)
+		require.EqualValues(t, 1, summary.Actions[repairer.PlanRepair])
+		require.NotZero(t, summary.DownloadBytes)
+		require.NotZero(t, summary.UploadBytes)
+		for nodeID := range summary.NewNodePieces {
+			require.False(t, nodesToKill[nodeID])
+		}
+
+		// the segment is neither repaired nor removed from the queue
+		repairedSegment, _ := getRemoteSegment(ctx, t, satellite, uplinkPeer.Projects[0].ID, "testbucket")
+		require.Equal(t, segment.Pieces, repairedSegment.Pieces)
+
+		count, err = satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	})
+}
@@ -0,0 +1,268 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"math"
+	"sync"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/uplink/private/eestream"
+)
+
+// PlanAction is what the repairer would do with a queued segment.
+type PlanAction string
+
+const (
+	// PlanRepair means that the segment would be repaired.
+	PlanRepair = PlanAction("repair")
+	// PlanUnnecessary means that the segment is above the repair threshold.
+	PlanUnnecessary = PlanAction("unnecessary")
+	// PlanIrreparable means that the segment doesn't have enough healthy pieces.
+	PlanIrreparable = PlanAction("irreparable")
+	// PlanNotEnoughNodes means that there aren't enough nodes to upload the repaired pieces.
+	PlanNotEnoughNodes = PlanAction("not_enough_nodes")
+	// PlanDeleted means that the segment doesn't exist anymore.
+	PlanDeleted = PlanAction("deleted")
+	// PlanExpired means that the segment has expired.
+	PlanExpired = PlanAction("expired")
+	// PlanInvalid means that the segment can't be repaired, e.g. because it's inline.
+	PlanInvalid = PlanAction("invalid")
+)
+
+// RepairPlan describes what the repairer would do with a queued segment,
+// without transferring any piece.
+type RepairPlan struct {
+	StreamID uuid.UUID  `json:"streamId"`
+	Position uint64     `json:"position"`
+	Action   PlanAction `json:"action"`
+
+	Healthy             int   `json:"healthy"`
+	Missing             int   `json:"missing"`
+	InExcludedCountries int   `json:"inExcludedCountries"`
+	RepairThreshold     int32 `json:"repairThreshold"`
+	OptimalThreshold    int   `json:"optimalThreshold"`
+
+	// DownloadPieces is the number of pieces needed to reconstruct the segment.
+	DownloadPieces int   `json:"downloadPieces"`
+	DownloadBytes  int64 `json:"downloadBytes"`
+	// UploadPieces is the maximum number of repaired pieces that would be uploaded.
+	UploadPieces int   `json:"uploadPieces"`
+	UploadBytes  int64 `json:"uploadBytes"`
+	// MinSuccessfulUploads is the number of uploads needed for the repair to succeed.
+	MinSuccessfulUploads int `json:"minSuccessfulUploads"`
+
+	NewNodes []storj.NodeID `json:"newNodes,omitempty"`
+}
+
+// Plan evaluates a queued segment like Repair does and returns what would be
+// downloaded, uploaded and to which nodes, without creating order limits or
+// contacting any storage node.
+func (repairer *SegmentRepairer) Plan(ctx context.Context, queueSegment *queue.InjuredSegment) (plan RepairPlan, err error) {
+	defer mon.Task()(&ctx, queueSegment.StreamID.String(), queueSegment.Position.Encode())(&err)
+
+	plan = RepairPlan{
+		StreamID: queueSegment.StreamID,
+		Position: queueSegment.Position.Encode(),
+	}
+
+	segment, err := repairer.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: queueSegment.StreamID,
+		Position: queueSegment.Position,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			plan.Action = PlanDeleted
+			return plan, nil
+		}
+		return plan, metainfoGetError.Wrap(err)
+	}
+
+	if segment.Inline() {
+		plan.Action = PlanInvalid
+		return plan, nil
+	}
+
+	if segment.Expired(repairer.nowFn()) {
+		plan.Action = PlanExpired
+		return plan, nil
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+	if err != nil {
+		plan.Action = PlanInvalid
+		return plan, nil
+	}
+	plan.OptimalThreshold = redundancy.OptimalThreshold()
+
+	missingPieces, err := repairer.overlay.GetMissingPieces(ctx, segment.Pieces)
+	if err != nil {
+		return plan, overlayQueryError.New("error identifying missing pieces: %w", err)
+	}
+	plan.Missing = len(missingPieces)
+	plan.Healthy = len(segment.Pieces) - len(missingPieces)
+
+	if plan.Healthy < int(segment.Redundancy.RequiredShares) {
+		plan.Action = PlanIrreparable
+		return plan, nil
+	}
+
+	piecesInExcludedCountries, err := repairer.overlay.GetReliablePiecesInExcludedCountries(ctx, segment.Pieces)
+	if err != nil {
+		return plan, overlayQueryError.New("error identifying pieces in excluded countries: %w", err)
+	}
+	plan.InExcludedCountries = len(piecesInExcludedCountries)
+
+	plan.RepairThreshold = int32(segment.Redundancy.RepairShares)
+	overrideValue := repairer.repairOverrides.GetOverrideValuePB(&pb.RedundancyScheme{
+		MinReq:           int32(segment.Redundancy.RequiredShares),
+		RepairThreshold:  int32(segment.Redundancy.RepairShares),
+		SuccessThreshold: int32(segment.Redundancy.OptimalShares),
+		Total:            int32(segment.Redundancy.TotalShares),
+	})
+	if overrideValue != 0 {
+		plan.RepairThreshold = overrideValue
+	}
+
+	if plan.Healthy-plan.InExcludedCountries > int(plan.RepairThreshold) {
+		plan.Action = PlanUnnecessary
+		return plan, nil
+	}
+
+	totalNeeded := math.Ceil(float64(redundancy.OptimalThreshold()) * repairer.multiplierOptimalThreshold)
+	plan.UploadPieces = int(totalNeeded) - plan.Healthy + plan.InExcludedCountries
+	plan.MinSuccessfulUploads = redundancy.OptimalThreshold() - plan.Healthy + plan.InExcludedCountries
+
+	pieceSize := eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy)
+	plan.DownloadPieces = redundancy.RequiredCount()
+	plan.DownloadBytes = int64(plan.DownloadPieces) * pieceSize
+	plan.UploadBytes = int64(plan.UploadPieces) * pieceSize
+
+	excludeNodeIDs := make(storj.NodeIDList, 0, len(segment.Pieces))
+	for _, piece := range segment.Pieces {
+		excludeNodeIDs = append(excludeNodeIDs, piece.StorageNode)
+	}
+
+	newNodes, err := repairer.overlay.FindStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{
+		RequestedCount: plan.UploadPieces,
+		ExcludedIDs:    excludeNodeIDs,
+		Placement:      segment.Placement,
+	})
+	if err != nil && !overlay.ErrNotEnoughNodes.Has(err) {
+		return plan, overlayQueryError.Wrap(err)
+	}
+	newNodes = filterNodesByPlacement(newNodes, segment.Placement)
+	for _, node := range newNodes {
+		plan.NewNodes = append(plan.NewNodes, node.ID)
+	}
+
+	if err != nil || len(newNodes) < plan.MinSuccessfulUploads {
+		plan.Action = PlanNotEnoughNodes
+		return plan, nil
+	}
+
+	plan.Action = PlanRepair
+	return plan, nil
+}
+
+// PlanSummary aggregates repair plans.
+type PlanSummary struct {
+	Segments      int64                `json:"segments"`
+	Actions       map[PlanAction]int64 `json:"actions"`
+	DownloadBytes int64                `json:"downloadBytes"`
+	UploadBytes   int64                `json:"uploadBytes"`
+	// NewNodePieces is the number of repaired pieces which would be uploaded
+	// to each node.
+	NewNodePieces map[storj.NodeID]int64 `json:"newNodePieces"`
+}
+
+// Add adds the plan to the summary.
+func (summary *PlanSummary) Add(plan RepairPlan) {
+	if summary.Actions == nil {
+		summary.Actions = make(map[PlanAction]int64)
+	}
+	if summary.NewNodePieces == nil {
+		summary.NewNodePieces = make(map[storj.NodeID]int64)
+	}
+
+	summary.Segments++
+	summary.Actions[plan.Action]++
+	if plan.Action != PlanRepair {
+		return
+	}
+	summary.DownloadBytes += plan.DownloadBytes
+	summary.UploadBytes += plan.UploadBytes
+	for _, nodeID := range plan.NewNodes {
+		summary.NewNodePieces[nodeID]++
+	}
+}
+
+// planSummarizer aggregates the plans made by concurrent workers.
+type planSummarizer struct {
+	mu      sync.Mutex
+	summary PlanSummary
+}
+
+func (summarizer *planSummarizer) add(plan RepairPlan) {
+	summarizer.mu.Lock()
+	defer summarizer.mu.Unlock()
+	summarizer.summary.Add(plan)
+}
+
+func (summarizer *planSummarizer) get() PlanSummary {
+	summarizer.mu.Lock()
+	defer summarizer.mu.Unlock()
+
+	summary := summarizer.summary
+	summary.Actions = make(map[PlanAction]int64, len(summarizer.summary.Actions))
+	for action, count := range summarizer.summary.Actions {
+		summary.Actions[action] = count
+	}
+	summary.NewNodePieces = make(map[storj.NodeID]int64, len(summarizer.summary.NewNodePieces))
+	for nodeID, count := range summarizer.summary.NewNodePieces {
+		summary.NewNodePieces[nodeID] = count
+	}
+	return summary
+}
+
+// dryRun plans the repair of the segment, without executing it, and logs the plan.
+func (service *Service) dryRun(ctx context.Context, seg *queue.InjuredSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	plan, err := service.repairer.Plan(ctx, seg)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	service.plans.add(plan)
+
+	mon.Meter("repair_dry_run", monkit.NewSeriesTag("action", string(plan.Action))).Mark(1)
+	mon.IntVal("repair_dry_run_download_bytes").Observe(plan.DownloadBytes)
+	mon.IntVal("repair_dry_run_upload_bytes").Observe(plan.UploadBytes)
+
+	service.log.Info("repair dry run",
+		zap.Stringer("Stream ID", plan.StreamID),
+		zap.Uint64("Position", plan.Position),
+		zap.String("Action", string(plan.Action)),
+		zap.Int("Healthy", plan.Healthy),
+		zap.Int32("Repair Threshold", plan.RepairThreshold),
+		zap.Int64("Download Bytes", plan.DownloadBytes),
+		zap.Int64("Upload Bytes", plan.UploadBytes),
+		zap.Int("New Nodes", len(plan.NewNodes)),
+	)
+	return nil
+}
+
+// DryRunSummary returns the summary of the repairs planned while running in dry run mode.
+func (service *Service) DryRunSummary() PlanSummary {
+	return service.plans.get()
+}
@@ -34,6 +34,7 @@ type Config struct {
 	MaxBufferMem                  memory.Size   `help:"maximum buffer memory (in bytes) to be allocated for read buffers" default:"4.0 MiB"`
 	MaxExcessRateOptimalThreshold float64       `help:"ratio applied to the optimal threshold to calculate the excess of the maximum number of repaired pieces to upload" default:"0.05"`
 	InMemoryRepair                bool          `help:"whether to download pieces for repair in memory (true) or download to disk (false)" default:"false"`
+	DryRun                        bool          `help:"whether to only report what would be done to repair the queued segments, without transferring pieces or removing them from the queue" default:"false"`
 }
 
 // Service contains the information needed to run the repair service.
@@ -46,6 +47,7 @@ type Service struct {
 	JobLimiter *semaphore.Weighted
 	Loop       *sync2.Cycle
 	repairer   *SegmentRepairer
+	plans      planSummarizer
 
 	nowFn func() time.Time
 }
@@ -152,6 +154,10 @@ func (service *Service) process(ctx context.Context) (err error) {
 func (service *Service) worker(ctx context.Context, seg *queue.InjuredSegment) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if service.config.DryRun {
+		return service.dryRun(ctx, seg)
+	}
+
 	workerStartTime := service.nowFn().UTC()
 
 	service.log.Debug("Limiter running repair on segment")
@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"strconv"
 	"time"
 
+	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
@@ -283,12 +285,26 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: requestCount,
 		ExcludedIDs:    excludeNodeIDs,
+		Placement:      segment.Placement,
 	}
 	newNodes, err := repairer.overlay.FindStorageNodesForUpload(ctx, request)
 	if err != nil {
+		if overlay.ErrNotEnoughNodes.Has(err) && segment.Placement != storj.EveryCountry {
+			repairer.alertInsufficientPlacementNodes(segment, requestCount, -1)
+		}
 		return false, overlayQueryError.Wrap(err)
 	}
 
+	// the node selection may not be able to apply the placement constraint
+	// (e.g. when the node selection cache is disabled), so double check it
+	// here to never place repaired pieces outside of the segment placement.
+	newNodes = filterNodesByPlacement(newNodes, segment.Placement)
+	if len(newNodes) < minSuccessfulNeeded {
+		repairer.alertInsufficientPlacementNodes(segment, requestCount, len(newNodes))
+		return false, overlayQueryError.New("not enough nodes satisfying placement %d: requested %d, found %d",
+			segment.Placement, requestCount, len(newNodes))
+	}
+
 	// Create the order limits for the PUT_REPAIR action
 	putLimits, putPrivateKey, err := repairer.orders.CreatePutRepairOrderLimits(ctx, metabase.BucketLocation{}, segment, getOrderLimits, newNodes, repairer.multiplierOptimalThreshold, numHealthyInExcludedCountries)
 	if err != nil {
@@ -618,6 +634,39 @@ func (repairer *SegmentRepairer) AdminFetchPieces(ctx context.Context, seg *meta
 	return pieceInfos, nil
 }
 
+// alertInsufficientPlacementNodes reports that there are not enough nodes
+// satisfying the placement constraint of the segment to repair it. available
+// is negative when the node selection didn't report how many nodes it found.
+func (repairer *SegmentRepairer) alertInsufficientPlacementNodes(segment metabase.Segment, requested, available int) {
+	mon.Meter("repair_placement_nodes_insufficient",
+		monkit.NewSeriesTag("placement", strconv.Itoa(int(segment.Placement)))).Mark(1)
+
+	fields := []zap.Field{
+		zap.Stringer("Stream ID", segment.StreamID),
+		zap.Uint64("Position", segment.Position.Encode()),
+		zap.Uint16("Placement", uint16(segment.Placement)),
+		zap.Int("Requested", requested),
+	}
+	if available >= 0 {
+		fields = append(fields, zap.Int("Available", available))
+	}
+	repairer.log.Warn("not enough nodes satisfying the placement constraint", fields...)
+}
+
+// filterNodesByPlacement returns the nodes which are allowed by placement.
+func filterNodesByPlacement(nodes []*overlay.SelectedNode, placement storj.PlacementConstraint) []*overlay.SelectedNode {
+	if placement == storj.EveryCountry {
+		return nodes
+	}
+	filtered := make([]*overlay.SelectedNode, 0, len(nodes))
+	for _, node := range nodes {
+		if placement.AllowedCountry(node.CountryCode) {
+			filtered = append(filtered, node)
+		}
+	}
+	return filtered
+}
+
 // sliceToSet converts the given slice to a set.
 func sliceToSet(slice []uint16) map[uint16]bool {
 	set := make(map[uint16]bool, len(slice))
@@ -279,6 +279,66 @@ func TestDBDisqualificationAuditFailure(t *testing.T) {
 	})
 }
 
+func TestDBDisqualificationAuditWarning(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		reputationDB := db.Reputation()
+		now := time.Now().Truncate(time.Second).UTC()
+
+		config := reputation.Config{
+			AuditLambda:        1,
+			AuditWeight:        1,
+			AuditDQ:            0.6,
+			AuditDQGracePeriod: 10 * time.Hour,
+			AuditHistory:       reputation.AuditHistoryConfig{},
+		}
+
+		t.Run("disqualified after grace period", func(t *testing.T) {
+			updateReq := reputation.UpdateRequest{
+				NodeID:       testrand.NodeID(),
+				AuditOutcome: reputation.AuditFailure,
+				Config:       config,
+			}
+
+			status, err := reputationDB.Update(ctx, updateReq, now)
+			require.NoError(t, err)
+			require.Nil(t, status.Disqualified)
+			require.NotNil(t, status.AuditWarned)
+			assert.Equal(t, now, status.AuditWarned.UTC())
+
+			status, err = reputationDB.Update(ctx, updateReq, now.Add(6*time.Hour))
+			require.NoError(t, err)
+			require.Nil(t, status.Disqualified)
+			require.NotNil(t, status.AuditWarned)
+			assert.Equal(t, now, status.AuditWarned.UTC())
+
+			status, err = reputationDB.Update(ctx, updateReq, now.Add(11*time.Hour))
+			require.NoError(t, err)
+			require.NotNil(t, status.Disqualified)
+			assert.Nil(t, status.AuditWarned)
+			assert.Equal(t, now.Add(11*time.Hour), status.Disqualified.UTC())
+			assert.Equal(t, overlay.DisqualificationReasonAuditFailure, status.DisqualificationReason)
+		})
+
+		t.Run("warning lifted", func(t *testing.T) {
+			updateReq := reputation.UpdateRequest{
+				NodeID:       testrand.NodeID(),
+				AuditOutcome: reputation.AuditFailure,
+				Config:       config,
+			}
+
+			status, err := reputationDB.Update(ctx, updateReq, now)
+			require.NoError(t, err)
+			require.NotNil(t, status.AuditWarned)
+
+			updateReq.AuditOutcome = reputation.AuditSuccess
+			status, err = reputationDB.Update(ctx, updateReq, now.Add(time.Hour))
+			require.NoError(t, err)
+			assert.Nil(t, status.AuditWarned)
+			assert.Nil(t, status.Disqualified)
+		})
+	})
+}
+
 func TestDBDisqualificationSuspension(t *testing.T) {
 	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
 		reputationDB := db.Reputation()
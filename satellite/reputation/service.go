@@ -38,6 +38,7 @@ type Info struct {
 	UnknownAuditSuspended       *time.Time
 	OfflineSuspended            *time.Time
 	UnderReview                 *time.Time
+	AuditWarned                 *time.Time
 	Disqualified                *time.Time
 	DisqualificationReason      overlay.DisqualificationReason
 	OnlineScore                 float64
@@ -55,6 +56,7 @@ func (i *Info) Copy() *Info {
 	i2.UnknownAuditSuspended = cloneTime(i.UnknownAuditSuspended)
 	i2.OfflineSuspended = cloneTime(i.OfflineSuspended)
 	i2.UnderReview = cloneTime(i.UnderReview)
+	i2.AuditWarned = cloneTime(i.AuditWarned)
 	i2.Disqualified = cloneTime(i.Disqualified)
 	if i.AuditHistory != nil {
 		i2.AuditHistory = &pb.AuditHistory{
@@ -242,16 +242,30 @@ func (cdb *CachingDB) ApplyUpdates(ctx context.Context, nodeID storj.NodeID, upd
 		newAuditScore := cachedInfo.AuditReputationAlpha / (cachedInfo.AuditReputationAlpha + cachedInfo.AuditReputationBeta)
 		// disqualification case a
 		//   a) Success/fail audit reputation falls below audit DQ threshold
+		//        AND the node has been warned for longer than the audit DQ grace period
 		if newAuditScore <= config.AuditDQ {
-			if cachedInfo.Disqualified == nil {
+			switch {
+			case cachedInfo.Disqualified != nil:
+			case config.AuditDQGracePeriod <= 0 ||
+				(cachedInfo.AuditWarned != nil && now.Sub(*cachedInfo.AuditWarned) > config.AuditDQGracePeriod):
 				cachedInfo.Disqualified = &now
 				cachedInfo.DisqualificationReason = overlay.DisqualificationReasonAuditFailure
+				cachedInfo.AuditWarned = nil
 				logger.Info("Disqualified", zap.String("dq-type", "audit failure"))
 				// if we think the node is newly disqualified, perform a sync
 				// to have the best chance of propagating that information to
 				// other satellite services.
 				doRequestSync = true
+			case cachedInfo.AuditWarned == nil:
+				cachedInfo.AuditWarned = &now
+				logger.Info("Warned", zap.String("category", "audits"))
+				// the operator is notified about the warning, so perform a
+				// sync to record it as soon as possible.
+				doRequestSync = true
 			}
+		} else if cachedInfo.AuditWarned != nil {
+			cachedInfo.AuditWarned = nil
+			logger.Info("Warning lifted", zap.String("category", "audits"))
 		}
 
 		// check unknown-audits score
@@ -0,0 +1,143 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that activationTokens implements console.ActivationTokens.
+var _ console.ActivationTokens = (*activationTokens)(nil)
+
+// activationTokens is an implementation of console.ActivationTokens.
+type activationTokens struct {
+	db *satelliteDB
+	tx *dbx.Tx
+}
+
+// Insert adds an activation token.
+func (tokens *activationTokens) Insert(ctx context.Context, token console.ActivationToken) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = tokens.exec(ctx, `
+		INSERT INTO activation_tokens (secret_hash, user_id, created_at, expires_at)
+		VALUES ($1, $2, $3, $4)
+	`, token.SecretHash[:], token.UserID[:], token.CreatedAt, token.ExpiresAt)
+	return Error.Wrap(err)
+}
+
+// Get returns the activation token with the secret hash.
+func (tokens *activationTokens) Get(ctx context.Context, hash console.ActivationSecretHash) (_ *console.ActivationToken, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := tokens.query(ctx, `
+		SELECT secret_hash, user_id, created_at, expires_at, used_at
+		FROM activation_tokens
+		WHERE secret_hash = $1
+	`, hash[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	list, err := scanActivationTokens(rows)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(list) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &list[0], nil
+}
+
+// ListByUserID returns the activation tokens of the user.
+func (tokens *activationTokens) ListByUserID(ctx context.Context, userID uuid.UUID) (_ []console.ActivationToken, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := tokens.query(ctx, `
+		SELECT secret_hash, user_id, created_at, expires_at, used_at
+		FROM activation_tokens
+		WHERE user_id = $1
+		ORDER BY created_at ASC
+	`, userID[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	list, err := scanActivationTokens(rows)
+	return list, Error.Wrap(err)
+}
+
+// Delete deletes the activation token with the secret hash.
+func (tokens *activationTokens) Delete(ctx context.Context, hash console.ActivationSecretHash) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = tokens.exec(ctx, `DELETE FROM activation_tokens WHERE secret_hash = $1`, hash[:])
+	return Error.Wrap(err)
+}
+
+// MarkUsedByUserID marks the unused activation tokens of the user as used.
+func (tokens *activationTokens) MarkUsedByUserID(ctx context.Context, userID uuid.UUID, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = tokens.exec(ctx, `
+		UPDATE activation_tokens SET used_at = $2
+		WHERE user_id = $1 AND used_at IS NULL
+	`, userID[:], now)
+	return Error.Wrap(err)
+}
+
+// DeleteExpired deletes the activation tokens which expired before now.
+func (tokens *activationTokens) DeleteExpired(ctx context.Context, now time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := tokens.exec(ctx, `DELETE FROM activation_tokens WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	deleted, err := result.RowsAffected()
+	return deleted, Error.Wrap(err)
+}
+
+func (tokens *activationTokens) exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tokens.tx != nil {
+		return tokens.tx.Tx.ExecContext(ctx, query, args...)
+	}
+	return tokens.db.ExecContext(ctx, query, args...)
+}
+
+func (tokens *activationTokens) query(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	if tokens.tx != nil {
+		return tokens.tx.Tx.QueryContext(ctx, query, args...)
+	}
+	return tokens.db.QueryContext(ctx, query, args...)
+}
+
+func scanActivationTokens(rows tagsql.Rows) (list []console.ActivationToken, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var token console.ActivationToken
+		var hash []byte
+		err := rows.Scan(&hash, &token.UserID, &token.CreatedAt, &token.ExpiresAt, &token.UsedAt)
+		if err != nil {
+			return nil, err
+		}
+		if len(hash) != len(token.SecretHash) {
+			return nil, errs.New("invalid activation secret hash length %d", len(hash))
+		}
+		copy(token.SecretHash[:], hash)
+		list = append(list, token)
+	}
+	return list, rows.Err()
+}
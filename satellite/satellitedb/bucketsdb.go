@@ -8,6 +8,8 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/common/macaroon"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
@@ -186,6 +188,28 @@ func (db *bucketsDB) DeleteBucket(ctx context.Context, bucketName []byte, projec
 	return nil
 }
 
+// MoveBucket moves an existing bucket to another project, keeping its id and settings.
+func (db *bucketsDB) MoveBucket(ctx context.Context, bucketName []byte, projectID, newProjectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE bucket_metainfos SET project_id = $3
+		WHERE project_id = $1 AND name = $2
+	`, projectID[:], bucketName, newProjectID[:])
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+
+	moved, err := result.RowsAffected()
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if moved == 0 {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
 // ListBuckets returns a list of buckets for a project.
 func (db *bucketsDB) ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storj.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -272,6 +296,165 @@ func (db *bucketsDB) CountBuckets(ctx context.Context, projectID uuid.UUID) (cou
 	return int(count64), nil
 }
 
+// GetBucketQuota returns the quota of an existing bucket.
+func (db *bucketsDB) GetBucketQuota(ctx context.Context, bucketName []byte, projectID uuid.UUID) (quota buckets.Quota, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.QueryRowContext(ctx, `
+		SELECT bucket_quotas.max_objects, bucket_quotas.max_bytes
+		FROM bucket_metainfos
+		LEFT JOIN bucket_quotas ON bucket_quotas.bucket_id = bucket_metainfos.id
+		WHERE bucket_metainfos.project_id = $1 AND bucket_metainfos.name = $2
+	`, projectID[:], bucketName).Scan(&quota.MaxObjects, &quota.MaxBytes)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.Quota{}, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return buckets.Quota{}, storj.ErrBucket.Wrap(err)
+	}
+	return quota, nil
+}
+
+// UpdateBucketQuota replaces the quota of an existing bucket.
+func (db *bucketsDB) UpdateBucketQuota(ctx context.Context, bucketName []byte, projectID uuid.UUID, quota buckets.Quota) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketID, err := db.GetBucketID(ctx, metabase.BucketLocation{
+		ProjectID:  projectID,
+		BucketName: string(bucketName),
+	})
+	if err != nil {
+		return err
+	}
+
+	if quota.IsZero() {
+		_, err = db.db.ExecContext(ctx, `
+			DELETE FROM bucket_quotas WHERE bucket_id = $1
+		`, bucketID[:])
+		return storj.ErrBucket.Wrap(err)
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO bucket_quotas (bucket_id, max_objects, max_bytes, updated_at)
+		VALUES ($1, $2, $3, now())
+		ON CONFLICT (bucket_id) DO UPDATE SET
+			max_objects = EXCLUDED.max_objects,
+			max_bytes   = EXCLUDED.max_bytes,
+			updated_at  = EXCLUDED.updated_at
+	`, bucketID[:], quota.MaxObjects, quota.MaxBytes)
+	return storj.ErrBucket.Wrap(err)
+}
+
+// GetLifecycleRules returns the lifecycle rules of an existing bucket ordered by the id.
+func (db *bucketsDB) GetLifecycleRules(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ []buckets.LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketID, err := db.GetBucketID(ctx, metabase.BucketLocation{
+		ProjectID:  projectID,
+		BucketName: string(bucketName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT id, prefix, expiration_days, abort_incomplete_multipart_upload_days
+		FROM bucket_lifecycle_rules
+		WHERE bucket_id = $1
+		ORDER BY id
+	`, bucketID[:])
+	if err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var rules []buckets.LifecycleRule
+	for rows.Next() {
+		var rule buckets.LifecycleRule
+		err := rows.Scan(&rule.ID, &rule.Prefix, &rule.ExpirationDays, &rule.AbortIncompleteMultipartUploadDays)
+		if err != nil {
+			return nil, storj.ErrBucket.Wrap(err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, storj.ErrBucket.Wrap(rows.Err())
+}
+
+// UpdateLifecycleRules replaces the lifecycle rules of an existing bucket.
+func (db *bucketsDB) UpdateLifecycleRules(ctx context.Context, bucketName []byte, projectID uuid.UUID, rules []buckets.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketID, err := db.GetBucketID(ctx, metabase.BucketLocation{
+		ProjectID:  projectID,
+		BucketName: string(bucketName),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, `
+			DELETE FROM bucket_lifecycle_rules WHERE bucket_id = $1
+		`, bucketID[:])
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range rules {
+			// an empty prefix matches the whole bucket, it isn't missing.
+			prefix := rule.Prefix
+			if prefix == nil {
+				prefix = []byte{}
+			}
+
+			_, err := tx.Tx.ExecContext(ctx, `
+				INSERT INTO bucket_lifecycle_rules (
+					bucket_id, id, prefix, expiration_days, abort_incomplete_multipart_upload_days, created_at
+				) VALUES ($1, $2, $3, $4, $5, now())
+			`, bucketID[:], rule.ID, prefix, rule.ExpirationDays, rule.AbortIncompleteMultipartUploadDays)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return storj.ErrBucket.Wrap(err)
+}
+
+// ListLifecycleRules returns the lifecycle rules of all buckets.
+func (db *bucketsDB) ListLifecycleRules(ctx context.Context) (_ []buckets.BucketLifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT
+			bucket_metainfos.project_id, bucket_metainfos.name,
+			bucket_lifecycle_rules.id, bucket_lifecycle_rules.prefix,
+			bucket_lifecycle_rules.expiration_days, bucket_lifecycle_rules.abort_incomplete_multipart_upload_days
+		FROM bucket_lifecycle_rules
+		JOIN bucket_metainfos ON bucket_metainfos.id = bucket_lifecycle_rules.bucket_id
+		ORDER BY bucket_metainfos.project_id, bucket_metainfos.name, bucket_lifecycle_rules.id
+	`)
+	if err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var rules []buckets.BucketLifecycleRule
+	for rows.Next() {
+		var rule buckets.BucketLifecycleRule
+		var bucketName []byte
+		err := rows.Scan(&rule.Bucket.ProjectID, &bucketName,
+			&rule.ID, &rule.Prefix,
+			&rule.ExpirationDays, &rule.AbortIncompleteMultipartUploadDays)
+		if err != nil {
+			return nil, storj.ErrBucket.Wrap(err)
+		}
+		rule.Bucket.BucketName = string(bucketName)
+		rules = append(rules, rule)
+	}
+	return rules, storj.ErrBucket.Wrap(rows.Err())
+}
+
 func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket storj.Bucket, err error) {
 	id, err := uuid.FromBytes(dbxBucket.Id)
 	if err != nil {
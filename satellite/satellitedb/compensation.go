@@ -106,7 +106,12 @@ func (comp *compensationDB) RecordPayments(ctx context.Context, payments []compe
 
 func (comp *compensationDB) RecordPaystubs(ctx context.Context, paystubs []compensation.Paystub) error {
 	for _, paystub := range paystubs {
-		err := comp.db.ReplaceNoReturn_StoragenodePaystub(ctx,
+		walletFeatures, err := encodeWalletFeatures(paystub.WalletFeatures)
+		if err != nil {
+			return err
+		}
+
+		err = comp.db.ReplaceNoReturn_StoragenodePaystub(ctx,
 			dbx.StoragenodePaystub_Period(paystub.Period.String()),
 			dbx.StoragenodePaystub_NodeId(paystub.NodeID.Bytes()),
 			dbx.StoragenodePaystub_Codes(paystub.Codes.String()),
@@ -128,6 +133,8 @@ func (comp *compensationDB) RecordPaystubs(ctx context.Context, paystubs []compe
 			dbx.StoragenodePaystub_Disposed(paystub.Disposed.Value()),
 			dbx.StoragenodePaystub_Paid(paystub.Paid.Value()),
 			dbx.StoragenodePaystub_Distributed(paystub.Distributed.Value()),
+			dbx.StoragenodePaystub_Wallet(paystub.Wallet),
+			dbx.StoragenodePaystub_WalletFeatures(walletFeatures),
 		)
 		if err != nil {
 			return err
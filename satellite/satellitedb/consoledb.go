@@ -74,6 +74,46 @@ func (db *ConsoleDB) WebappSessions() consoleauth.WebappSessions {
 	return &webappSessions{db.methods}
 }
 
+// SignupReviews is a getter for SignupReviews repository.
+func (db *ConsoleDB) SignupReviews() console.SignupReviews {
+	return &signupReviews{db: db.db, tx: db.tx}
+}
+
+// EmailChanges is a getter for EmailChanges repository.
+func (db *ConsoleDB) EmailChanges() console.EmailChanges {
+	return &emailChanges{db: db.db, tx: db.tx}
+}
+
+// ActivationTokens is a getter for ActivationTokens repository.
+func (db *ConsoleDB) ActivationTokens() console.ActivationTokens {
+	return &activationTokens{db: db.db, tx: db.tx}
+}
+
+// ProjectActivities is a getter for ProjectActivities repository.
+func (db *ConsoleDB) ProjectActivities() console.ProjectActivities {
+	return &projectActivities{db: db.db, tx: db.tx}
+}
+
+// SecurityLogs is a getter for SecurityLogs repository.
+func (db *ConsoleDB) SecurityLogs() console.SecurityLogs {
+	return &securityLogs{db: db.db, tx: db.tx}
+}
+
+// UsageAnomalySettings is a getter for UsageAnomalySettings repository.
+func (db *ConsoleDB) UsageAnomalySettings() console.UsageAnomalySettings {
+	return &usageAnomalySettings{db: db.db, tx: db.tx}
+}
+
+// ProjectDeletions is a getter for ProjectDeletions repository.
+func (db *ConsoleDB) ProjectDeletions() console.ProjectDeletions {
+	return &projectDeletions{db: db.db, tx: db.tx}
+}
+
+// OnboardingSteps is a getter for OnboardingSteps repository.
+func (db *ConsoleDB) OnboardingSteps() console.OnboardingSteps {
+	return &onboardingSteps{db: db.db, tx: db.tx}
+}
+
 // WithTx is a method for executing and retrying transaction.
 func (db *ConsoleDB) WithTx(ctx context.Context, fn func(context.Context, console.DBTx) error) error {
 	if db.db == nil {
@@ -58,6 +58,25 @@ func (customers *customers) GetCustomerID(ctx context.Context, userID uuid.UUID)
 	return idRow.CustomerId, nil
 }
 
+// GetUserID returns the ID of the user of a stripe customer.
+func (customers *customers) GetUserID(ctx context.Context, customerID string) (_ uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var userID uuid.UUID
+	err = customers.db.QueryRowContext(ctx, `
+		SELECT user_id FROM stripe_customers WHERE customer_id = $1
+	`, customerID).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.UUID{}, stripecoinpayments.ErrNoCustomer
+		}
+
+		return uuid.UUID{}, err
+	}
+
+	return userID, nil
+}
+
 // List returns paginated customers id list, with customers created before specified date.
 func (customers *customers) List(ctx context.Context, offset int64, limit int, before time.Time) (_ stripecoinpayments.CustomersPage, err error) {
 	defer mon.Task()(&ctx)(&err)
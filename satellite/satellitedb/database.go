@@ -24,6 +24,7 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/nodeapiversion"
+	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
@@ -34,6 +35,7 @@ import (
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/satellitedb/dbx"
+	"storj.io/storj/satellite/segmentstats"
 	"storj.io/storj/satellite/snopayouts"
 )
 
@@ -205,6 +207,11 @@ func (dbc *satelliteDBCollection) RepairQueue() queue.RepairQueue {
 	return &repairQueue{db: dbc.getByName("repairqueue")}
 }
 
+// RemediationQueue is a getter for RemediationQueue repository.
+func (dbc *satelliteDBCollection) RemediationQueue() queue.RemediationQueue {
+	return &remediationQueue{db: dbc.getByName("remediationqueue")}
+}
+
 // StoragenodeAccounting returns database for tracking storagenode usage.
 func (dbc *satelliteDBCollection) StoragenodeAccounting() accounting.StoragenodeAccounting {
 	return &StoragenodeAccounting{db: dbc.getByName("storagenodeaccounting")}
@@ -297,6 +304,16 @@ func (dbc *satelliteDBCollection) NodeAPIVersion() nodeapiversion.DB {
 	return &nodeAPIVersionDB{db: dbc.getByName("nodeapiversion")}
 }
 
+// NodeEvents returns database for node events.
+func (dbc *satelliteDBCollection) NodeEvents() nodeevents.DB {
+	return &nodeEvents{db: dbc.getByName("nodeevents")}
+}
+
+// SegmentStatistics returns database for segment statistics reports.
+func (dbc *satelliteDBCollection) SegmentStatistics() segmentstats.DB {
+	return &segmentStatistics{db: dbc.getByName("segmentstatistics")}
+}
+
 // Buckets returns database for interacting with buckets.
 func (dbc *satelliteDBCollection) Buckets() buckets.DB {
 	return &bucketsDB{db: dbc.getByName("buckets")}
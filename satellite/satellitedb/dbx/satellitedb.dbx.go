@@ -337,6 +337,25 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE activation_tokens (
+	secret_hash bytea NOT NULL,
+	user_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	used_at timestamp with time zone,
+	PRIMARY KEY ( secret_hash )
+);
+CREATE TABLE api_key_bandwidth_rollups (
+	project_id bytea NOT NULL,
+	api_key_head bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	action integer NOT NULL,
+	inline bigint NOT NULL,
+	allocated bigint NOT NULL,
+	settled bigint NOT NULL,
+	request_count bigint NOT NULL,
+	PRIMARY KEY ( project_id, api_key_head, interval_start, action )
+);
 CREATE TABLE billing_transactions (
 	tx_id bytea NOT NULL,
 	user_id bytea NOT NULL,
@@ -357,6 +376,7 @@ CREATE TABLE bucket_bandwidth_rollups (
 	inline bigint NOT NULL,
 	allocated bigint NOT NULL,
 	settled bigint NOT NULL,
+	placement integer,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
 CREATE TABLE bucket_bandwidth_rollup_archives (
@@ -382,6 +402,7 @@ CREATE TABLE bucket_storage_tallies (
 	inline_segments_count integer NOT NULL,
 	object_count integer NOT NULL,
 	metadata_size bigint NOT NULL,
+	placement integer,
 	PRIMARY KEY ( bucket_name, project_id, interval_start )
 );
 CREATE TABLE coinpayments_transactions (
@@ -427,6 +448,16 @@ CREATE TABLE coupon_usages (
 	period timestamp with time zone NOT NULL,
 	PRIMARY KEY ( coupon_id, period )
 );
+CREATE TABLE email_change_tokens (
+	secret bytea NOT NULL,
+	user_id bytea NOT NULL,
+	kind integer NOT NULL,
+	old_email text NOT NULL,
+	new_email text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( secret )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -494,6 +525,15 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_events (
+	id bytea NOT NULL,
+	email text NOT NULL,
+	node_id bytea NOT NULL,
+	event integer NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	email_sent timestamp with time zone,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -565,6 +605,15 @@ CREATE TABLE projects (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE project_activities (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	actor_id bytea,
+	kind text NOT NULL,
+	details text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE project_bandwidth_daily_rollups (
 	project_id bytea NOT NULL,
 	interval_day date NOT NULL,
@@ -579,6 +628,54 @@ CREATE TABLE project_bandwidth_rollups (
 	egress_allocated bigint NOT NULL,
 	PRIMARY KEY ( project_id, interval_month )
 );
+CREATE TABLE project_deletions (
+	project_id bytea NOT NULL,
+	deleted_by bytea,
+	deleted_at timestamp with time zone NOT NULL,
+	purge_after timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_freezes (
+	project_id bytea NOT NULL,
+	reason text NOT NULL,
+	downloads boolean NOT NULL,
+	invoice_id text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_limit_exemptions (
+	project_id bytea NOT NULL,
+	limit_kind text NOT NULL,
+	reason text NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, limit_kind )
+);
+CREATE TABLE project_live_usages (
+	project_id bytea NOT NULL,
+	storage bigint NOT NULL,
+	segments bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_security_logs (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	api_key_name text NOT NULL,
+	remote_address text NOT NULL,
+	operation text NOT NULL,
+	bucket_name bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_usage_anomaly_settings (
+	project_id bytea NOT NULL,
+	sensitivity text NOT NULL,
+	last_notified_at timestamp with time zone,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE registration_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea,
@@ -587,6 +684,16 @@ CREATE TABLE registration_tokens (
 	PRIMARY KEY ( secret ),
 	UNIQUE ( owner_id )
 );
+CREATE TABLE remediation_queue (
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	violation text NOT NULL,
+	violating_pieces integer NOT NULL,
+	placement integer NOT NULL DEFAULT 0,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( stream_id, position, violation )
+);
 CREATE TABLE repair_queue (
 	stream_id bytea NOT NULL,
 	position bigint NOT NULL,
@@ -594,6 +701,7 @@ CREATE TABLE repair_queue (
 	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
+	placement integer,
 	PRIMARY KEY ( stream_id, position )
 );
 CREATE TABLE reputations (
@@ -608,6 +716,7 @@ CREATE TABLE reputations (
 	unknown_audit_suspended timestamp with time zone,
 	offline_suspended timestamp with time zone,
 	under_review timestamp with time zone,
+	audit_warned timestamp with time zone,
 	online_score double precision NOT NULL DEFAULT 1,
 	audit_history bytea NOT NULL,
 	audit_reputation_alpha double precision NOT NULL DEFAULT 1,
@@ -639,6 +748,29 @@ CREATE TABLE segment_pending_audits (
 	reverify_count bigint NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE segment_statistics_reports (
+	created_at timestamp with time zone NOT NULL,
+	report bytea NOT NULL,
+	PRIMARY KEY ( created_at )
+);
+CREATE TABLE shared_link_bandwidth_rollups (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_key bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	inline bigint NOT NULL,
+	allocated bigint NOT NULL,
+	request_count bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, object_key, interval_start )
+);
+CREATE TABLE signup_reviews (
+	user_id bytea NOT NULL,
+	reason text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	reviewed_at timestamp with time zone,
+	approved boolean NOT NULL DEFAULT false,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE storagenode_bandwidth_rollups (
 	storagenode_id bytea NOT NULL,
 	interval_start timestamp with time zone NOT NULL,
@@ -699,6 +831,8 @@ CREATE TABLE storagenode_paystubs (
 	disposed bigint NOT NULL,
 	paid bigint NOT NULL,
 	distributed bigint NOT NULL,
+	wallet text NOT NULL,
+	wallet_features text NOT NULL,
 	PRIMARY KEY ( period, node_id )
 );
 CREATE TABLE storagenode_storage_tallies (
@@ -863,13 +997,38 @@ CREATE TABLE user_credits (
 	PRIMARY KEY ( id ),
 	UNIQUE ( id, offer_id )
 );
+CREATE TABLE user_onboarding_steps (
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	step text NOT NULL,
+	completed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id, step )
+);
+CREATE TABLE bucket_lifecycle_rules (
+	bucket_id bytea NOT NULL REFERENCES bucket_metainfos( id ) ON DELETE CASCADE,
+	id text NOT NULL,
+	prefix bytea NOT NULL,
+	expiration_days integer,
+	abort_incomplete_multipart_upload_days integer,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( bucket_id, id )
+);
+CREATE TABLE bucket_quotas (
+	bucket_id bytea NOT NULL REFERENCES bucket_metainfos( id ) ON DELETE CASCADE,
+	max_objects bigint,
+	max_bytes bigint,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( bucket_id )
+);
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX activation_tokens_user_id_index ON activation_tokens ( user_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
+CREATE INDEX email_change_tokens_user_id_index ON email_change_tokens ( user_id ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
+CREATE INDEX node_events_email_sent_created_at_index ON node_events ( email_sent, created_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
@@ -880,8 +1039,14 @@ CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
+CREATE INDEX project_activities_project_id_created_at_index ON project_activities ( project_id, created_at ) ;
+CREATE INDEX project_security_logs_project_id_created_at_index ON project_security_logs ( project_id, created_at ) ;
+CREATE INDEX project_security_logs_created_at_index ON project_security_logs ( created_at ) ;
+CREATE INDEX project_deletions_purge_after_index ON project_deletions ( purge_after ) ;
+CREATE INDEX project_freezes_invoice_id_index ON project_freezes ( invoice_id ) ;
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
+CREATE INDEX remediation_queue_updated_at_index ON remediation_queue ( updated_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
@@ -1006,6 +1171,25 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE activation_tokens (
+	secret_hash bytea NOT NULL,
+	user_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	used_at timestamp with time zone,
+	PRIMARY KEY ( secret_hash )
+);
+CREATE TABLE api_key_bandwidth_rollups (
+	project_id bytea NOT NULL,
+	api_key_head bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	action integer NOT NULL,
+	inline bigint NOT NULL,
+	allocated bigint NOT NULL,
+	settled bigint NOT NULL,
+	request_count bigint NOT NULL,
+	PRIMARY KEY ( project_id, api_key_head, interval_start, action )
+);
 CREATE TABLE billing_transactions (
 	tx_id bytea NOT NULL,
 	user_id bytea NOT NULL,
@@ -1026,6 +1210,7 @@ CREATE TABLE bucket_bandwidth_rollups (
 	inline bigint NOT NULL,
 	allocated bigint NOT NULL,
 	settled bigint NOT NULL,
+	placement integer,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
 CREATE TABLE bucket_bandwidth_rollup_archives (
@@ -1051,6 +1236,7 @@ CREATE TABLE bucket_storage_tallies (
 	inline_segments_count integer NOT NULL,
 	object_count integer NOT NULL,
 	metadata_size bigint NOT NULL,
+	placement integer,
 	PRIMARY KEY ( bucket_name, project_id, interval_start )
 );
 CREATE TABLE coinpayments_transactions (
@@ -1096,6 +1282,16 @@ CREATE TABLE coupon_usages (
 	period timestamp with time zone NOT NULL,
 	PRIMARY KEY ( coupon_id, period )
 );
+CREATE TABLE email_change_tokens (
+	secret bytea NOT NULL,
+	user_id bytea NOT NULL,
+	kind integer NOT NULL,
+	old_email text NOT NULL,
+	new_email text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( secret )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -1163,6 +1359,15 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_events (
+	id bytea NOT NULL,
+	email text NOT NULL,
+	node_id bytea NOT NULL,
+	event integer NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	email_sent timestamp with time zone,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -1234,6 +1439,15 @@ CREATE TABLE projects (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE project_activities (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	actor_id bytea,
+	kind text NOT NULL,
+	details text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE project_bandwidth_daily_rollups (
 	project_id bytea NOT NULL,
 	interval_day date NOT NULL,
@@ -1248,6 +1462,54 @@ CREATE TABLE project_bandwidth_rollups (
 	egress_allocated bigint NOT NULL,
 	PRIMARY KEY ( project_id, interval_month )
 );
+CREATE TABLE project_deletions (
+	project_id bytea NOT NULL,
+	deleted_by bytea,
+	deleted_at timestamp with time zone NOT NULL,
+	purge_after timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_freezes (
+	project_id bytea NOT NULL,
+	reason text NOT NULL,
+	downloads boolean NOT NULL,
+	invoice_id text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_limit_exemptions (
+	project_id bytea NOT NULL,
+	limit_kind text NOT NULL,
+	reason text NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, limit_kind )
+);
+CREATE TABLE project_live_usages (
+	project_id bytea NOT NULL,
+	storage bigint NOT NULL,
+	segments bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_security_logs (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	api_key_name text NOT NULL,
+	remote_address text NOT NULL,
+	operation text NOT NULL,
+	bucket_name bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_usage_anomaly_settings (
+	project_id bytea NOT NULL,
+	sensitivity text NOT NULL,
+	last_notified_at timestamp with time zone,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE registration_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea,
@@ -1256,6 +1518,16 @@ CREATE TABLE registration_tokens (
 	PRIMARY KEY ( secret ),
 	UNIQUE ( owner_id )
 );
+CREATE TABLE remediation_queue (
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	violation text NOT NULL,
+	violating_pieces integer NOT NULL,
+	placement integer NOT NULL DEFAULT 0,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( stream_id, position, violation )
+);
 CREATE TABLE repair_queue (
 	stream_id bytea NOT NULL,
 	position bigint NOT NULL,
@@ -1263,6 +1535,7 @@ CREATE TABLE repair_queue (
 	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
+	placement integer,
 	PRIMARY KEY ( stream_id, position )
 );
 CREATE TABLE reputations (
@@ -1277,6 +1550,7 @@ CREATE TABLE reputations (
 	unknown_audit_suspended timestamp with time zone,
 	offline_suspended timestamp with time zone,
 	under_review timestamp with time zone,
+	audit_warned timestamp with time zone,
 	online_score double precision NOT NULL DEFAULT 1,
 	audit_history bytea NOT NULL,
 	audit_reputation_alpha double precision NOT NULL DEFAULT 1,
@@ -1308,6 +1582,29 @@ CREATE TABLE segment_pending_audits (
 	reverify_count bigint NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE segment_statistics_reports (
+	created_at timestamp with time zone NOT NULL,
+	report bytea NOT NULL,
+	PRIMARY KEY ( created_at )
+);
+CREATE TABLE shared_link_bandwidth_rollups (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_key bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	inline bigint NOT NULL,
+	allocated bigint NOT NULL,
+	request_count bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, object_key, interval_start )
+);
+CREATE TABLE signup_reviews (
+	user_id bytea NOT NULL,
+	reason text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	reviewed_at timestamp with time zone,
+	approved boolean NOT NULL DEFAULT false,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE storagenode_bandwidth_rollups (
 	storagenode_id bytea NOT NULL,
 	interval_start timestamp with time zone NOT NULL,
@@ -1368,6 +1665,8 @@ CREATE TABLE storagenode_paystubs (
 	disposed bigint NOT NULL,
 	paid bigint NOT NULL,
 	distributed bigint NOT NULL,
+	wallet text NOT NULL,
+	wallet_features text NOT NULL,
 	PRIMARY KEY ( period, node_id )
 );
 CREATE TABLE storagenode_storage_tallies (
@@ -1532,13 +1831,38 @@ CREATE TABLE user_credits (
 	PRIMARY KEY ( id ),
 	UNIQUE ( id, offer_id )
 );
+CREATE TABLE user_onboarding_steps (
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	step text NOT NULL,
+	completed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id, step )
+);
+CREATE TABLE bucket_lifecycle_rules (
+	bucket_id bytea NOT NULL REFERENCES bucket_metainfos( id ) ON DELETE CASCADE,
+	id text NOT NULL,
+	prefix bytea NOT NULL,
+	expiration_days integer,
+	abort_incomplete_multipart_upload_days integer,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( bucket_id, id )
+);
+CREATE TABLE bucket_quotas (
+	bucket_id bytea NOT NULL REFERENCES bucket_metainfos( id ) ON DELETE CASCADE,
+	max_objects bigint,
+	max_bytes bigint,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( bucket_id )
+);
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX activation_tokens_user_id_index ON activation_tokens ( user_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
+CREATE INDEX email_change_tokens_user_id_index ON email_change_tokens ( user_id ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
+CREATE INDEX node_events_email_sent_created_at_index ON node_events ( email_sent, created_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
@@ -1549,8 +1873,14 @@ CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
+CREATE INDEX project_activities_project_id_created_at_index ON project_activities ( project_id, created_at ) ;
+CREATE INDEX project_security_logs_project_id_created_at_index ON project_security_logs ( project_id, created_at ) ;
+CREATE INDEX project_security_logs_created_at_index ON project_security_logs ( created_at ) ;
+CREATE INDEX project_deletions_purge_after_index ON project_deletions ( purge_after ) ;
+CREATE INDEX project_freezes_invoice_id_index ON project_freezes ( invoice_id ) ;
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
+CREATE INDEX remediation_queue_updated_at_index ON remediation_queue ( updated_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
@@ -1879,1865 +2209,2386 @@ func (f AccountingTimestamps_Value_Field) value() interface{} {
 
 func (AccountingTimestamps_Value_Field) _Column() string { return "value" }
 
-type BillingTransaction struct {
-	TxId        []byte
-	UserId      []byte
-	Amount      int64
-	Currency    string
-	Description string
-	Type        int
-	Timestamp   time.Time
-	CreatedAt   time.Time
+type ActivationToken struct {
+	SecretHash []byte
+	UserId     []byte
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	UsedAt     *time.Time
 }
 
-func (BillingTransaction) _Table() string { return "billing_transactions" }
+func (ActivationToken) _Table() string { return "activation_tokens" }
 
-type BillingTransaction_Update_Fields struct {
+type ActivationToken_Create_Fields struct {
+	UsedAt ActivationToken_UsedAt_Field
 }
 
-type BillingTransaction_TxId_Field struct {
+type ActivationToken_Update_Fields struct {
+	UsedAt ActivationToken_UsedAt_Field
+}
+
+type ActivationToken_SecretHash_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BillingTransaction_TxId(v []byte) BillingTransaction_TxId_Field {
-	return BillingTransaction_TxId_Field{_set: true, _value: v}
+func ActivationToken_SecretHash(v []byte) ActivationToken_SecretHash_Field {
+	return ActivationToken_SecretHash_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_TxId_Field) value() interface{} {
+func (f ActivationToken_SecretHash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_TxId_Field) _Column() string { return "tx_id" }
+func (ActivationToken_SecretHash_Field) _Column() string { return "secret_hash" }
 
-type BillingTransaction_UserId_Field struct {
+type ActivationToken_UserId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BillingTransaction_UserId(v []byte) BillingTransaction_UserId_Field {
-	return BillingTransaction_UserId_Field{_set: true, _value: v}
+func ActivationToken_UserId(v []byte) ActivationToken_UserId_Field {
+	return ActivationToken_UserId_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_UserId_Field) value() interface{} {
+func (f ActivationToken_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_UserId_Field) _Column() string { return "user_id" }
+func (ActivationToken_UserId_Field) _Column() string { return "user_id" }
 
-type BillingTransaction_Amount_Field struct {
+type ActivationToken_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func BillingTransaction_Amount(v int64) BillingTransaction_Amount_Field {
-	return BillingTransaction_Amount_Field{_set: true, _value: v}
+func ActivationToken_CreatedAt(v time.Time) ActivationToken_CreatedAt_Field {
+	return ActivationToken_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Amount_Field) value() interface{} {
+func (f ActivationToken_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Amount_Field) _Column() string { return "amount" }
+func (ActivationToken_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BillingTransaction_Currency_Field struct {
+type ActivationToken_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func BillingTransaction_Currency(v string) BillingTransaction_Currency_Field {
-	return BillingTransaction_Currency_Field{_set: true, _value: v}
+func ActivationToken_ExpiresAt(v time.Time) ActivationToken_ExpiresAt_Field {
+	return ActivationToken_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Currency_Field) value() interface{} {
+func (f ActivationToken_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Currency_Field) _Column() string { return "currency" }
+func (ActivationToken_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type BillingTransaction_Description_Field struct {
+type ActivationToken_UsedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *time.Time
 }
 
-func BillingTransaction_Description(v string) BillingTransaction_Description_Field {
-	return BillingTransaction_Description_Field{_set: true, _value: v}
+func ActivationToken_UsedAt(v time.Time) ActivationToken_UsedAt_Field {
+	return ActivationToken_UsedAt_Field{_set: true, _value: &v}
 }
 
-func (f BillingTransaction_Description_Field) value() interface{} {
+func ActivationToken_UsedAt_Raw(v *time.Time) ActivationToken_UsedAt_Field {
+	if v == nil {
+		return ActivationToken_UsedAt_Null()
+	}
+	return ActivationToken_UsedAt(*v)
+}
+
+func ActivationToken_UsedAt_Null() ActivationToken_UsedAt_Field {
+	return ActivationToken_UsedAt_Field{_set: true, _null: true}
+}
+
+func (f ActivationToken_UsedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ActivationToken_UsedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Description_Field) _Column() string { return "description" }
+func (ActivationToken_UsedAt_Field) _Column() string { return "used_at" }
 
-type BillingTransaction_Type_Field struct {
+type ApiKeyBandwidthRollup struct {
+	ProjectId     []byte
+	ApiKeyHead    []byte
+	IntervalStart time.Time
+	Action        uint
+	Inline        uint64
+	Allocated     uint64
+	Settled       uint64
+	RequestCount  uint64
+}
+
+func (ApiKeyBandwidthRollup) _Table() string { return "api_key_bandwidth_rollups" }
+
+type ApiKeyBandwidthRollup_Update_Fields struct {
+	Inline       ApiKeyBandwidthRollup_Inline_Field
+	Allocated    ApiKeyBandwidthRollup_Allocated_Field
+	Settled      ApiKeyBandwidthRollup_Settled_Field
+	RequestCount ApiKeyBandwidthRollup_RequestCount_Field
+}
+
+type ApiKeyBandwidthRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func BillingTransaction_Type(v int) BillingTransaction_Type_Field {
-	return BillingTransaction_Type_Field{_set: true, _value: v}
+func ApiKeyBandwidthRollup_ProjectId(v []byte) ApiKeyBandwidthRollup_ProjectId_Field {
+	return ApiKeyBandwidthRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Type_Field) value() interface{} {
+func (f ApiKeyBandwidthRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Type_Field) _Column() string { return "type" }
+func (ApiKeyBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type BillingTransaction_Timestamp_Field struct {
+type ApiKeyBandwidthRollup_ApiKeyHead_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func BillingTransaction_Timestamp(v time.Time) BillingTransaction_Timestamp_Field {
-	return BillingTransaction_Timestamp_Field{_set: true, _value: v}
+func ApiKeyBandwidthRollup_ApiKeyHead(v []byte) ApiKeyBandwidthRollup_ApiKeyHead_Field {
+	return ApiKeyBandwidthRollup_ApiKeyHead_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Timestamp_Field) value() interface{} {
+func (f ApiKeyBandwidthRollup_ApiKeyHead_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Timestamp_Field) _Column() string { return "timestamp" }
+func (ApiKeyBandwidthRollup_ApiKeyHead_Field) _Column() string { return "api_key_head" }
 
-type BillingTransaction_CreatedAt_Field struct {
+type ApiKeyBandwidthRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func BillingTransaction_CreatedAt(v time.Time) BillingTransaction_CreatedAt_Field {
-	return BillingTransaction_CreatedAt_Field{_set: true, _value: v}
+func ApiKeyBandwidthRollup_IntervalStart(v time.Time) ApiKeyBandwidthRollup_IntervalStart_Field {
+	return ApiKeyBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_CreatedAt_Field) value() interface{} {
+func (f ApiKeyBandwidthRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_CreatedAt_Field) _Column() string { return "created_at" }
-
-type BucketBandwidthRollup struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
-}
-
-func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
-
-type BucketBandwidthRollup_Update_Fields struct {
-	Inline    BucketBandwidthRollup_Inline_Field
-	Allocated BucketBandwidthRollup_Allocated_Field
-	Settled   BucketBandwidthRollup_Settled_Field
-}
+func (ApiKeyBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type BucketBandwidthRollup_BucketName_Field struct {
+type ApiKeyBandwidthRollup_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
-	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
+func ApiKeyBandwidthRollup_Action(v uint) ApiKeyBandwidthRollup_Action_Field {
+	return ApiKeyBandwidthRollup_Action_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
+func (f ApiKeyBandwidthRollup_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
-
-type BucketBandwidthRollup_ProjectId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
-}
-
-func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
-	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
-}
-
-func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
-}
-
-func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
-
-type BucketBandwidthRollup_IntervalStart_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
-}
-
-func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
-	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
-}
-
-func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
-}
-
-func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
-
-type BucketBandwidthRollup_IntervalSeconds_Field struct {
-	_set   bool
-	_null  bool
-	_value uint
-}
-
-func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
-	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
-}
-
-func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
-}
+func (ApiKeyBandwidthRollup_Action_Field) _Column() string { return "action" }
 
-func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
-
-type BucketBandwidthRollup_Action_Field struct {
+type ApiKeyBandwidthRollup_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value uint64
 }
 
-func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
-	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
+func ApiKeyBandwidthRollup_Inline(v uint64) ApiKeyBandwidthRollup_Inline_Field {
+	return ApiKeyBandwidthRollup_Inline_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Action_Field) value() interface{} {
+func (f ApiKeyBandwidthRollup_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
+func (ApiKeyBandwidthRollup_Inline_Field) _Column() string { return "inline" }
 
-type BucketBandwidthRollup_Inline_Field struct {
+type ApiKeyBandwidthRollup_Allocated_Field struct {
 	_set   bool
 	_null  bool
 	_value uint64
 }
 
-func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
-	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
+func ApiKeyBandwidthRollup_Allocated(v uint64) ApiKeyBandwidthRollup_Allocated_Field {
+	return ApiKeyBandwidthRollup_Allocated_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
+func (f ApiKeyBandwidthRollup_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
+func (ApiKeyBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
 
-type BucketBandwidthRollup_Allocated_Field struct {
+type ApiKeyBandwidthRollup_Settled_Field struct {
 	_set   bool
 	_null  bool
 	_value uint64
 }
 
-func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
-	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
+func ApiKeyBandwidthRollup_Settled(v uint64) ApiKeyBandwidthRollup_Settled_Field {
+	return ApiKeyBandwidthRollup_Settled_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
+func (f ApiKeyBandwidthRollup_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+func (ApiKeyBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
-type BucketBandwidthRollup_Settled_Field struct {
+type ApiKeyBandwidthRollup_RequestCount_Field struct {
 	_set   bool
 	_null  bool
 	_value uint64
 }
 
-func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
-	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
+func ApiKeyBandwidthRollup_RequestCount(v uint64) ApiKeyBandwidthRollup_RequestCount_Field {
+	return ApiKeyBandwidthRollup_RequestCount_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
+func (f ApiKeyBandwidthRollup_RequestCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
+func (ApiKeyBandwidthRollup_RequestCount_Field) _Column() string { return "request_count" }
 
-type BucketBandwidthRollupArchive struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
+type BillingTransaction struct {
+	TxId        []byte
+	UserId      []byte
+	Amount      int64
+	Currency    string
+	Description string
+	Type        int
+	Timestamp   time.Time
+	CreatedAt   time.Time
 }
 
-func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
+func (BillingTransaction) _Table() string { return "billing_transactions" }
 
-type BucketBandwidthRollupArchive_Update_Fields struct {
-	Inline    BucketBandwidthRollupArchive_Inline_Field
-	Allocated BucketBandwidthRollupArchive_Allocated_Field
-	Settled   BucketBandwidthRollupArchive_Settled_Field
+type BillingTransaction_Update_Fields struct {
 }
 
-type BucketBandwidthRollupArchive_BucketName_Field struct {
+type BillingTransaction_TxId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
-	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
+func BillingTransaction_TxId(v []byte) BillingTransaction_TxId_Field {
+	return BillingTransaction_TxId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
+func (f BillingTransaction_TxId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
+func (BillingTransaction_TxId_Field) _Column() string { return "tx_id" }
 
-type BucketBandwidthRollupArchive_ProjectId_Field struct {
+type BillingTransaction_UserId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
-	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
+func BillingTransaction_UserId(v []byte) BillingTransaction_UserId_Field {
+	return BillingTransaction_UserId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
+func (f BillingTransaction_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
+func (BillingTransaction_UserId_Field) _Column() string { return "user_id" }
 
-type BucketBandwidthRollupArchive_IntervalStart_Field struct {
+type BillingTransaction_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
-	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
+func BillingTransaction_Amount(v int64) BillingTransaction_Amount_Field {
+	return BillingTransaction_Amount_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
+func (f BillingTransaction_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BillingTransaction_Amount_Field) _Column() string { return "amount" }
 
-type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
+type BillingTransaction_Currency_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
-	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
+func BillingTransaction_Currency(v string) BillingTransaction_Currency_Field {
+	return BillingTransaction_Currency_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
+func (f BillingTransaction_Currency_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (BillingTransaction_Currency_Field) _Column() string { return "currency" }
 
-type BucketBandwidthRollupArchive_Action_Field struct {
+type BillingTransaction_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
-	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
+func BillingTransaction_Description(v string) BillingTransaction_Description_Field {
+	return BillingTransaction_Description_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
+func (f BillingTransaction_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
+func (BillingTransaction_Description_Field) _Column() string { return "description" }
 
-type BucketBandwidthRollupArchive_Inline_Field struct {
+type BillingTransaction_Type_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int
 }
 
-func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
-	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
+func BillingTransaction_Type(v int) BillingTransaction_Type_Field {
+	return BillingTransaction_Type_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
+func (f BillingTransaction_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
+func (BillingTransaction_Type_Field) _Column() string { return "type" }
 
-type BucketBandwidthRollupArchive_Allocated_Field struct {
+type BillingTransaction_Timestamp_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
-	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
+func BillingTransaction_Timestamp(v time.Time) BillingTransaction_Timestamp_Field {
+	return BillingTransaction_Timestamp_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
+func (f BillingTransaction_Timestamp_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+func (BillingTransaction_Timestamp_Field) _Column() string { return "timestamp" }
 
-type BucketBandwidthRollupArchive_Settled_Field struct {
+type BillingTransaction_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
-	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
+func BillingTransaction_CreatedAt(v time.Time) BillingTransaction_CreatedAt_Field {
+	return BillingTransaction_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
+func (f BillingTransaction_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
+func (BillingTransaction_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BucketStorageTally struct {
-	BucketName          []byte
-	ProjectId           []byte
-	IntervalStart       time.Time
-	TotalBytes          uint64
-	Inline              uint64
-	Remote              uint64
-	TotalSegmentsCount  uint
-	RemoteSegmentsCount uint
-	InlineSegmentsCount uint
-	ObjectCount         uint
-	MetadataSize        uint64
+type BucketBandwidthRollup struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
+	Placement       *int
 }
 
-func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
+func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
 
-type BucketStorageTally_Create_Fields struct {
-	TotalBytes         BucketStorageTally_TotalBytes_Field
-	TotalSegmentsCount BucketStorageTally_TotalSegmentsCount_Field
+type BucketBandwidthRollup_Create_Fields struct {
+	Placement BucketBandwidthRollup_Placement_Field
 }
 
-type BucketStorageTally_Update_Fields struct {
+type BucketBandwidthRollup_Update_Fields struct {
+	Inline    BucketBandwidthRollup_Inline_Field
+	Allocated BucketBandwidthRollup_Allocated_Field
+	Settled   BucketBandwidthRollup_Settled_Field
 }
 
-type BucketStorageTally_BucketName_Field struct {
+type BucketBandwidthRollup_BucketName_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
-	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
+func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
+	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_BucketName_Field) value() interface{} {
+func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
+func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
 
-type BucketStorageTally_ProjectId_Field struct {
+type BucketBandwidthRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
-	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
+func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
+	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_ProjectId_Field) value() interface{} {
+func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
+func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketStorageTally_IntervalStart_Field struct {
+type BucketBandwidthRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
-	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
+func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
+	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
+func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type BucketStorageTally_TotalBytes_Field struct {
+type BucketBandwidthRollup_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value uint
 }
 
-func BucketStorageTally_TotalBytes(v uint64) BucketStorageTally_TotalBytes_Field {
-	return BucketStorageTally_TotalBytes_Field{_set: true, _value: v}
+func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
+	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_TotalBytes_Field) value() interface{} {
+func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_TotalBytes_Field) _Column() string { return "total_bytes" }
+func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type BucketStorageTally_Inline_Field struct {
+type BucketBandwidthRollup_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value uint
 }
 
-func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
-	return BucketStorageTally_Inline_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
+	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_Inline_Field) value() interface{} {
+func (f BucketBandwidthRollup_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
+func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
 
-type BucketStorageTally_Remote_Field struct {
+type BucketBandwidthRollup_Inline_Field struct {
 	_set   bool
 	_null  bool
 	_value uint64
 }
 
-func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
-	return BucketStorageTally_Remote_Field{_set: true, _value: v}
-}
-
-func (f BucketStorageTally_Remote_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
-}
-
-func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
-
-type BucketStorageTally_TotalSegmentsCount_Field struct {
-	_set   bool
-	_null  bool
-	_value uint
-}
-
-func BucketStorageTally_TotalSegmentsCount(v uint) BucketStorageTally_TotalSegmentsCount_Field {
-	return BucketStorageTally_TotalSegmentsCount_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
+	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_TotalSegmentsCount_Field) value() interface{} {
+func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_TotalSegmentsCount_Field) _Column() string { return "total_segments_count" }
+func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
 
-type BucketStorageTally_RemoteSegmentsCount_Field struct {
+type BucketBandwidthRollup_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value uint64
 }
 
-func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
-	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
+	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
+func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
+func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
 
-type BucketStorageTally_InlineSegmentsCount_Field struct {
+type BucketBandwidthRollup_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value uint64
 }
 
-func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
-	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
+	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
+func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
+func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
-type BucketStorageTally_ObjectCount_Field struct {
+type BucketBandwidthRollup_Placement_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value *int
 }
 
-func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
-	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Placement(v int) BucketBandwidthRollup_Placement_Field {
+	return BucketBandwidthRollup_Placement_Field{_set: true, _value: &v}
 }
 
-func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func BucketBandwidthRollup_Placement_Raw(v *int) BucketBandwidthRollup_Placement_Field {
+	if v == nil {
+		return BucketBandwidthRollup_Placement_Null()
 	}
-	return f._value
+	return BucketBandwidthRollup_Placement(*v)
 }
 
-func (BucketStorageTally_ObjectCount_Field) _Column() string { return "object_count" }
-
-type BucketStorageTally_MetadataSize_Field struct {
-	_set   bool
-	_null  bool
-	_value uint64
+func BucketBandwidthRollup_Placement_Null() BucketBandwidthRollup_Placement_Field {
+	return BucketBandwidthRollup_Placement_Field{_set: true, _null: true}
 }
 
-func BucketStorageTally_MetadataSize(v uint64) BucketStorageTally_MetadataSize_Field {
-	return BucketStorageTally_MetadataSize_Field{_set: true, _value: v}
+func (f BucketBandwidthRollup_Placement_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f BucketStorageTally_MetadataSize_Field) value() interface{} {
+func (f BucketBandwidthRollup_Placement_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_MetadataSize_Field) _Column() string { return "metadata_size" }
+func (BucketBandwidthRollup_Placement_Field) _Column() string { return "placement" }
 
-type CoinpaymentsTransaction struct {
-	Id              string
-	UserId          []byte
-	Address         string
-	AmountNumeric   int64
-	ReceivedNumeric int64
-	Status          int
-	Key             string
-	Timeout         int
-	CreatedAt       time.Time
+type BucketBandwidthRollupArchive struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
 }
 
-func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
+func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
 
-type CoinpaymentsTransaction_Update_Fields struct {
-	ReceivedNumeric CoinpaymentsTransaction_ReceivedNumeric_Field
-	Status          CoinpaymentsTransaction_Status_Field
+type BucketBandwidthRollupArchive_Update_Fields struct {
+	Inline    BucketBandwidthRollupArchive_Inline_Field
+	Allocated BucketBandwidthRollupArchive_Allocated_Field
+	Settled   BucketBandwidthRollupArchive_Settled_Field
 }
 
-type CoinpaymentsTransaction_Id_Field struct {
+type BucketBandwidthRollupArchive_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
-	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
+	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
+func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
 
-type CoinpaymentsTransaction_UserId_Field struct {
+type BucketBandwidthRollupArchive_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
-	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
+	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
+func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
 
-type CoinpaymentsTransaction_Address_Field struct {
+type BucketBandwidthRollupArchive_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
-	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
+	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
+func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type CoinpaymentsTransaction_AmountNumeric_Field struct {
+type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func CoinpaymentsTransaction_AmountNumeric(v int64) CoinpaymentsTransaction_AmountNumeric_Field {
-	return CoinpaymentsTransaction_AmountNumeric_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
+	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_AmountNumeric_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_AmountNumeric_Field) _Column() string { return "amount_numeric" }
+func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type CoinpaymentsTransaction_ReceivedNumeric_Field struct {
+type BucketBandwidthRollupArchive_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func CoinpaymentsTransaction_ReceivedNumeric(v int64) CoinpaymentsTransaction_ReceivedNumeric_Field {
-	return CoinpaymentsTransaction_ReceivedNumeric_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
+	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_ReceivedNumeric_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_ReceivedNumeric_Field) _Column() string { return "received_numeric" }
+func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
 
-type CoinpaymentsTransaction_Status_Field struct {
+type BucketBandwidthRollupArchive_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
-	return CoinpaymentsTransaction_Status_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
+	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Status_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Status_Field) _Column() string { return "status" }
+func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
 
-type CoinpaymentsTransaction_Key_Field struct {
+type BucketBandwidthRollupArchive_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Key(v string) CoinpaymentsTransaction_Key_Field {
-	return CoinpaymentsTransaction_Key_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
+	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Key_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Key_Field) _Column() string { return "key" }
+func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
 
-type CoinpaymentsTransaction_Timeout_Field struct {
+type BucketBandwidthRollupArchive_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Timeout(v int) CoinpaymentsTransaction_Timeout_Field {
-	return CoinpaymentsTransaction_Timeout_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
+	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Timeout_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Timeout_Field) _Column() string { return "timeout" }
+func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
 
-type CoinpaymentsTransaction_CreatedAt_Field struct {
+type BucketStorageTally struct {
+	BucketName          []byte
+	ProjectId           []byte
+	IntervalStart       time.Time
+	TotalBytes          uint64
+	Inline              uint64
+	Remote              uint64
+	TotalSegmentsCount  uint
+	RemoteSegmentsCount uint
+	InlineSegmentsCount uint
+	ObjectCount         uint
+	MetadataSize        uint64
+	Placement           *int
+}
+
+func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
+
+type BucketStorageTally_Create_Fields struct {
+	TotalBytes         BucketStorageTally_TotalBytes_Field
+	TotalSegmentsCount BucketStorageTally_TotalSegmentsCount_Field
+	Placement          BucketStorageTally_Placement_Field
+}
+
+type BucketStorageTally_Update_Fields struct {
+}
+
+type BucketStorageTally_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func CoinpaymentsTransaction_CreatedAt(v time.Time) CoinpaymentsTransaction_CreatedAt_Field {
-	return CoinpaymentsTransaction_CreatedAt_Field{_set: true, _value: v}
+func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
+	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
+func (f BucketStorageTally_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
 
-type Coupon struct {
-	Id             []byte
-	UserId         []byte
-	Amount         int64
-	Description    string
-	Type           int
-	Status         int
-	Duration       int64
-	BillingPeriods *int64
-	CouponCodeName *string
-	CreatedAt      time.Time
+type BucketStorageTally_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (Coupon) _Table() string { return "coupons" }
-
-type Coupon_Create_Fields struct {
-	BillingPeriods Coupon_BillingPeriods_Field
-	CouponCodeName Coupon_CouponCodeName_Field
+func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
+	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
 }
 
-type Coupon_Update_Fields struct {
-	Status Coupon_Status_Field
+func (f BucketStorageTally_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Coupon_Id_Field struct {
+func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
+
+type BucketStorageTally_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func Coupon_Id(v []byte) Coupon_Id_Field {
-	return Coupon_Id_Field{_set: true, _value: v}
+func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
+	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Id_Field) value() interface{} {
+func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Id_Field) _Column() string { return "id" }
+func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type Coupon_UserId_Field struct {
+type BucketStorageTally_TotalBytes_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func Coupon_UserId(v []byte) Coupon_UserId_Field {
-	return Coupon_UserId_Field{_set: true, _value: v}
+func BucketStorageTally_TotalBytes(v uint64) BucketStorageTally_TotalBytes_Field {
+	return BucketStorageTally_TotalBytes_Field{_set: true, _value: v}
 }
 
-func (f Coupon_UserId_Field) value() interface{} {
+func (f BucketStorageTally_TotalBytes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_UserId_Field) _Column() string { return "user_id" }
+func (BucketStorageTally_TotalBytes_Field) _Column() string { return "total_bytes" }
 
-type Coupon_Amount_Field struct {
+type BucketStorageTally_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint64
 }
 
-func Coupon_Amount(v int64) Coupon_Amount_Field {
-	return Coupon_Amount_Field{_set: true, _value: v}
+func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
+	return BucketStorageTally_Inline_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Amount_Field) value() interface{} {
+func (f BucketStorageTally_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Amount_Field) _Column() string { return "amount" }
+func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
 
-type Coupon_Description_Field struct {
+type BucketStorageTally_Remote_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint64
 }
 
-func Coupon_Description(v string) Coupon_Description_Field {
-	return Coupon_Description_Field{_set: true, _value: v}
+func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
+	return BucketStorageTally_Remote_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Description_Field) value() interface{} {
+func (f BucketStorageTally_Remote_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Description_Field) _Column() string { return "description" }
+func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
 
-type Coupon_Type_Field struct {
+type BucketStorageTally_TotalSegmentsCount_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint
 }
 
-func Coupon_Type(v int) Coupon_Type_Field {
-	return Coupon_Type_Field{_set: true, _value: v}
+func BucketStorageTally_TotalSegmentsCount(v uint) BucketStorageTally_TotalSegmentsCount_Field {
+	return BucketStorageTally_TotalSegmentsCount_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Type_Field) value() interface{} {
+func (f BucketStorageTally_TotalSegmentsCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Type_Field) _Column() string { return "type" }
+func (BucketStorageTally_TotalSegmentsCount_Field) _Column() string { return "total_segments_count" }
 
-type Coupon_Status_Field struct {
+type BucketStorageTally_RemoteSegmentsCount_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint
 }
 
-func Coupon_Status(v int) Coupon_Status_Field {
-	return Coupon_Status_Field{_set: true, _value: v}
+func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
+	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Status_Field) value() interface{} {
+func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Status_Field) _Column() string { return "status" }
+func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
 
-type Coupon_Duration_Field struct {
+type BucketStorageTally_InlineSegmentsCount_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func Coupon_Duration(v int64) Coupon_Duration_Field {
-	return Coupon_Duration_Field{_set: true, _value: v}
+func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
+	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Duration_Field) value() interface{} {
+func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Duration_Field) _Column() string { return "duration" }
+func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
 
-type Coupon_BillingPeriods_Field struct {
+type BucketStorageTally_ObjectCount_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value uint
 }
 
-func Coupon_BillingPeriods(v int64) Coupon_BillingPeriods_Field {
-	return Coupon_BillingPeriods_Field{_set: true, _value: &v}
+func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
+	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
 }
 
-func Coupon_BillingPeriods_Raw(v *int64) Coupon_BillingPeriods_Field {
-	if v == nil {
-		return Coupon_BillingPeriods_Null()
+func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Coupon_BillingPeriods(*v)
+	return f._value
 }
 
-func Coupon_BillingPeriods_Null() Coupon_BillingPeriods_Field {
-	return Coupon_BillingPeriods_Field{_set: true, _null: true}
+func (BucketStorageTally_ObjectCount_Field) _Column() string { return "object_count" }
+
+type BucketStorageTally_MetadataSize_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
 }
 
-func (f Coupon_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func BucketStorageTally_MetadataSize(v uint64) BucketStorageTally_MetadataSize_Field {
+	return BucketStorageTally_MetadataSize_Field{_set: true, _value: v}
+}
 
-func (f Coupon_BillingPeriods_Field) value() interface{} {
+func (f BucketStorageTally_MetadataSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_BillingPeriods_Field) _Column() string { return "billing_periods" }
+func (BucketStorageTally_MetadataSize_Field) _Column() string { return "metadata_size" }
 
-type Coupon_CouponCodeName_Field struct {
+type BucketStorageTally_Placement_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value *int
 }
 
-func Coupon_CouponCodeName(v string) Coupon_CouponCodeName_Field {
-	return Coupon_CouponCodeName_Field{_set: true, _value: &v}
+func BucketStorageTally_Placement(v int) BucketStorageTally_Placement_Field {
+	return BucketStorageTally_Placement_Field{_set: true, _value: &v}
 }
 
-func Coupon_CouponCodeName_Raw(v *string) Coupon_CouponCodeName_Field {
+func BucketStorageTally_Placement_Raw(v *int) BucketStorageTally_Placement_Field {
 	if v == nil {
-		return Coupon_CouponCodeName_Null()
+		return BucketStorageTally_Placement_Null()
 	}
-	return Coupon_CouponCodeName(*v)
+	return BucketStorageTally_Placement(*v)
 }
 
-func Coupon_CouponCodeName_Null() Coupon_CouponCodeName_Field {
-	return Coupon_CouponCodeName_Field{_set: true, _null: true}
+func BucketStorageTally_Placement_Null() BucketStorageTally_Placement_Field {
+	return BucketStorageTally_Placement_Field{_set: true, _null: true}
 }
 
-func (f Coupon_CouponCodeName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f BucketStorageTally_Placement_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Coupon_CouponCodeName_Field) value() interface{} {
+func (f BucketStorageTally_Placement_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_CouponCodeName_Field) _Column() string { return "coupon_code_name" }
+func (BucketStorageTally_Placement_Field) _Column() string { return "placement" }
 
-type Coupon_CreatedAt_Field struct {
+type CoinpaymentsTransaction struct {
+	Id              string
+	UserId          []byte
+	Address         string
+	AmountNumeric   int64
+	ReceivedNumeric int64
+	Status          int
+	Key             string
+	Timeout         int
+	CreatedAt       time.Time
+}
+
+func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
+
+type CoinpaymentsTransaction_Update_Fields struct {
+	ReceivedNumeric CoinpaymentsTransaction_ReceivedNumeric_Field
+	Status          CoinpaymentsTransaction_Status_Field
+}
+
+type CoinpaymentsTransaction_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func Coupon_CreatedAt(v time.Time) Coupon_CreatedAt_Field {
-	return Coupon_CreatedAt_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
+	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
 }
 
-func (f Coupon_CreatedAt_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_CreatedAt_Field) _Column() string { return "created_at" }
-
-type CouponCode struct {
-	Id             []byte
-	Name           string
-	Amount         int64
-	Description    string
-	Type           int
-	BillingPeriods *int64
-	CreatedAt      time.Time
-}
-
-func (CouponCode) _Table() string { return "coupon_codes" }
-
-type CouponCode_Create_Fields struct {
-	BillingPeriods CouponCode_BillingPeriods_Field
-}
-
-type CouponCode_Update_Fields struct {
-}
+func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
 
-type CouponCode_Id_Field struct {
+type CoinpaymentsTransaction_UserId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func CouponCode_Id(v []byte) CouponCode_Id_Field {
-	return CouponCode_Id_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
+	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Id_Field) value() interface{} {
+func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Id_Field) _Column() string { return "id" }
+func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
 
-type CouponCode_Name_Field struct {
+type CoinpaymentsTransaction_Address_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func CouponCode_Name(v string) CouponCode_Name_Field {
-	return CouponCode_Name_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
+	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Name_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Name_Field) _Column() string { return "name" }
+func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
 
-type CouponCode_Amount_Field struct {
+type CoinpaymentsTransaction_AmountNumeric_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func CouponCode_Amount(v int64) CouponCode_Amount_Field {
-	return CouponCode_Amount_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_AmountNumeric(v int64) CoinpaymentsTransaction_AmountNumeric_Field {
+	return CoinpaymentsTransaction_AmountNumeric_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Amount_Field) value() interface{} {
+func (f CoinpaymentsTransaction_AmountNumeric_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Amount_Field) _Column() string { return "amount" }
+func (CoinpaymentsTransaction_AmountNumeric_Field) _Column() string { return "amount_numeric" }
 
-type CouponCode_Description_Field struct {
+type CoinpaymentsTransaction_ReceivedNumeric_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func CouponCode_Description(v string) CouponCode_Description_Field {
-	return CouponCode_Description_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_ReceivedNumeric(v int64) CoinpaymentsTransaction_ReceivedNumeric_Field {
+	return CoinpaymentsTransaction_ReceivedNumeric_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Description_Field) value() interface{} {
+func (f CoinpaymentsTransaction_ReceivedNumeric_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Description_Field) _Column() string { return "description" }
+func (CoinpaymentsTransaction_ReceivedNumeric_Field) _Column() string { return "received_numeric" }
 
-type CouponCode_Type_Field struct {
+type CoinpaymentsTransaction_Status_Field struct {
 	_set   bool
 	_null  bool
 	_value int
 }
 
-func CouponCode_Type(v int) CouponCode_Type_Field {
-	return CouponCode_Type_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
+	return CoinpaymentsTransaction_Status_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Type_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Type_Field) _Column() string { return "type" }
+func (CoinpaymentsTransaction_Status_Field) _Column() string { return "status" }
 
-type CouponCode_BillingPeriods_Field struct {
+type CoinpaymentsTransaction_Key_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value string
 }
 
-func CouponCode_BillingPeriods(v int64) CouponCode_BillingPeriods_Field {
-	return CouponCode_BillingPeriods_Field{_set: true, _value: &v}
+func CoinpaymentsTransaction_Key(v string) CoinpaymentsTransaction_Key_Field {
+	return CoinpaymentsTransaction_Key_Field{_set: true, _value: v}
 }
 
-func CouponCode_BillingPeriods_Raw(v *int64) CouponCode_BillingPeriods_Field {
-	if v == nil {
-		return CouponCode_BillingPeriods_Null()
+func (f CoinpaymentsTransaction_Key_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return CouponCode_BillingPeriods(*v)
+	return f._value
 }
 
-func CouponCode_BillingPeriods_Null() CouponCode_BillingPeriods_Field {
-	return CouponCode_BillingPeriods_Field{_set: true, _null: true}
+func (CoinpaymentsTransaction_Key_Field) _Column() string { return "key" }
+
+type CoinpaymentsTransaction_Timeout_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f CouponCode_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func CoinpaymentsTransaction_Timeout(v int) CoinpaymentsTransaction_Timeout_Field {
+	return CoinpaymentsTransaction_Timeout_Field{_set: true, _value: v}
+}
 
-func (f CouponCode_BillingPeriods_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Timeout_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_BillingPeriods_Field) _Column() string { return "billing_periods" }
+func (CoinpaymentsTransaction_Timeout_Field) _Column() string { return "timeout" }
 
-type CouponCode_CreatedAt_Field struct {
+type CoinpaymentsTransaction_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func CouponCode_CreatedAt(v time.Time) CouponCode_CreatedAt_Field {
-	return CouponCode_CreatedAt_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_CreatedAt(v time.Time) CoinpaymentsTransaction_CreatedAt_Field {
+	return CoinpaymentsTransaction_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_CreatedAt_Field) value() interface{} {
+func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_CreatedAt_Field) _Column() string { return "created_at" }
+func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
 
-type CouponUsage struct {
-	CouponId []byte
-	Amount   int64
-	Status   int
-	Period   time.Time
+type Coupon struct {
+	Id             []byte
+	UserId         []byte
+	Amount         int64
+	Description    string
+	Type           int
+	Status         int
+	Duration       int64
+	BillingPeriods *int64
+	CouponCodeName *string
+	CreatedAt      time.Time
 }
 
-func (CouponUsage) _Table() string { return "coupon_usages" }
+func (Coupon) _Table() string { return "coupons" }
 
-type CouponUsage_Update_Fields struct {
-	Status CouponUsage_Status_Field
+type Coupon_Create_Fields struct {
+	BillingPeriods Coupon_BillingPeriods_Field
+	CouponCodeName Coupon_CouponCodeName_Field
 }
 
-type CouponUsage_CouponId_Field struct {
+type Coupon_Update_Fields struct {
+	Status Coupon_Status_Field
+}
+
+type Coupon_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func CouponUsage_CouponId(v []byte) CouponUsage_CouponId_Field {
-	return CouponUsage_CouponId_Field{_set: true, _value: v}
+func Coupon_Id(v []byte) Coupon_Id_Field {
+	return Coupon_Id_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_CouponId_Field) value() interface{} {
+func (f Coupon_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_CouponId_Field) _Column() string { return "coupon_id" }
+func (Coupon_Id_Field) _Column() string { return "id" }
 
-type CouponUsage_Amount_Field struct {
+type Coupon_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func CouponUsage_Amount(v int64) CouponUsage_Amount_Field {
-	return CouponUsage_Amount_Field{_set: true, _value: v}
+func Coupon_UserId(v []byte) Coupon_UserId_Field {
+	return Coupon_UserId_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_Amount_Field) value() interface{} {
+func (f Coupon_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_Amount_Field) _Column() string { return "amount" }
+func (Coupon_UserId_Field) _Column() string { return "user_id" }
 
-type CouponUsage_Status_Field struct {
+type Coupon_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func CouponUsage_Status(v int) CouponUsage_Status_Field {
-	return CouponUsage_Status_Field{_set: true, _value: v}
+func Coupon_Amount(v int64) Coupon_Amount_Field {
+	return Coupon_Amount_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_Status_Field) value() interface{} {
+func (f Coupon_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_Status_Field) _Column() string { return "status" }
+func (Coupon_Amount_Field) _Column() string { return "amount" }
 
-type CouponUsage_Period_Field struct {
+type Coupon_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func CouponUsage_Period(v time.Time) CouponUsage_Period_Field {
-	return CouponUsage_Period_Field{_set: true, _value: v}
+func Coupon_Description(v string) Coupon_Description_Field {
+	return Coupon_Description_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_Period_Field) value() interface{} {
+func (f Coupon_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_Period_Field) _Column() string { return "period" }
+func (Coupon_Description_Field) _Column() string { return "description" }
 
-type GracefulExitProgress struct {
-	NodeId            []byte
-	BytesTransferred  int64
-	PiecesTransferred int64
-	PiecesFailed      int64
-	UpdatedAt         time.Time
+type Coupon_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
+func Coupon_Type(v int) Coupon_Type_Field {
+	return Coupon_Type_Field{_set: true, _value: v}
+}
 
-type GracefulExitProgress_Update_Fields struct {
-	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
-	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
-	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
+func (f Coupon_Type_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type GracefulExitProgress_NodeId_Field struct {
+func (Coupon_Type_Field) _Column() string { return "type" }
+
+type Coupon_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
-	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
+func Coupon_Status(v int) Coupon_Status_Field {
+	return Coupon_Status_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_NodeId_Field) value() interface{} {
+func (f Coupon_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
+func (Coupon_Status_Field) _Column() string { return "status" }
 
-type GracefulExitProgress_BytesTransferred_Field struct {
+type Coupon_Duration_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
-	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
+func Coupon_Duration(v int64) Coupon_Duration_Field {
+	return Coupon_Duration_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
+func (f Coupon_Duration_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+func (Coupon_Duration_Field) _Column() string { return "duration" }
 
-type GracefulExitProgress_PiecesTransferred_Field struct {
+type Coupon_BillingPeriods_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *int64
 }
 
-func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
-	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
+func Coupon_BillingPeriods(v int64) Coupon_BillingPeriods_Field {
+	return Coupon_BillingPeriods_Field{_set: true, _value: &v}
 }
 
-func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+func Coupon_BillingPeriods_Raw(v *int64) Coupon_BillingPeriods_Field {
+	if v == nil {
+		return Coupon_BillingPeriods_Null()
+	}
+	return Coupon_BillingPeriods(*v)
+}
+
+func Coupon_BillingPeriods_Null() Coupon_BillingPeriods_Field {
+	return Coupon_BillingPeriods_Field{_set: true, _null: true}
+}
+
+func (f Coupon_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Coupon_BillingPeriods_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+func (Coupon_BillingPeriods_Field) _Column() string { return "billing_periods" }
 
-type GracefulExitProgress_PiecesFailed_Field struct {
+type Coupon_CouponCodeName_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *string
 }
 
-func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
-	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
+func Coupon_CouponCodeName(v string) Coupon_CouponCodeName_Field {
+	return Coupon_CouponCodeName_Field{_set: true, _value: &v}
 }
 
-func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
+func Coupon_CouponCodeName_Raw(v *string) Coupon_CouponCodeName_Field {
+	if v == nil {
+		return Coupon_CouponCodeName_Null()
+	}
+	return Coupon_CouponCodeName(*v)
+}
+
+func Coupon_CouponCodeName_Null() Coupon_CouponCodeName_Field {
+	return Coupon_CouponCodeName_Field{_set: true, _null: true}
+}
+
+func (f Coupon_CouponCodeName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Coupon_CouponCodeName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+func (Coupon_CouponCodeName_Field) _Column() string { return "coupon_code_name" }
 
-type GracefulExitProgress_UpdatedAt_Field struct {
+type Coupon_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
-	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
+func Coupon_CreatedAt(v time.Time) Coupon_CreatedAt_Field {
+	return Coupon_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
+func (f Coupon_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (Coupon_CreatedAt_Field) _Column() string { return "created_at" }
 
-type GracefulExitSegmentTransfer struct {
-	NodeId              []byte
-	StreamId            []byte
-	Position            uint64
-	PieceNum            int
-	RootPieceId         []byte
-	DurabilityRatio     float64
-	QueuedAt            time.Time
-	RequestedAt         *time.Time
-	LastFailedAt        *time.Time
-	LastFailedCode      *int
-	FailedCount         *int
-	FinishedAt          *time.Time
-	OrderLimitSendCount int
+type CouponCode struct {
+	Id             []byte
+	Name           string
+	Amount         int64
+	Description    string
+	Type           int
+	BillingPeriods *int64
+	CreatedAt      time.Time
 }
 
-func (GracefulExitSegmentTransfer) _Table() string { return "graceful_exit_segment_transfer_queue" }
+func (CouponCode) _Table() string { return "coupon_codes" }
 
-type GracefulExitSegmentTransfer_Create_Fields struct {
-	RootPieceId         GracefulExitSegmentTransfer_RootPieceId_Field
-	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
-	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
-	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
-	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
-	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
-	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+type CouponCode_Create_Fields struct {
+	BillingPeriods CouponCode_BillingPeriods_Field
 }
 
-type GracefulExitSegmentTransfer_Update_Fields struct {
-	DurabilityRatio     GracefulExitSegmentTransfer_DurabilityRatio_Field
-	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
-	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
-	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
-	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
-	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
-	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+type CouponCode_Update_Fields struct {
 }
 
-type GracefulExitSegmentTransfer_NodeId_Field struct {
+type CouponCode_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func GracefulExitSegmentTransfer_NodeId(v []byte) GracefulExitSegmentTransfer_NodeId_Field {
-	return GracefulExitSegmentTransfer_NodeId_Field{_set: true, _value: v}
+func CouponCode_Id(v []byte) CouponCode_Id_Field {
+	return CouponCode_Id_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_NodeId_Field) value() interface{} {
+func (f CouponCode_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_NodeId_Field) _Column() string { return "node_id" }
+func (CouponCode_Id_Field) _Column() string { return "id" }
 
-type GracefulExitSegmentTransfer_StreamId_Field struct {
+type CouponCode_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func GracefulExitSegmentTransfer_StreamId(v []byte) GracefulExitSegmentTransfer_StreamId_Field {
-	return GracefulExitSegmentTransfer_StreamId_Field{_set: true, _value: v}
+func CouponCode_Name(v string) CouponCode_Name_Field {
+	return CouponCode_Name_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_StreamId_Field) value() interface{} {
+func (f CouponCode_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_StreamId_Field) _Column() string { return "stream_id" }
+func (CouponCode_Name_Field) _Column() string { return "name" }
 
-type GracefulExitSegmentTransfer_Position_Field struct {
+type CouponCode_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int64
 }
 
-func GracefulExitSegmentTransfer_Position(v uint64) GracefulExitSegmentTransfer_Position_Field {
-	return GracefulExitSegmentTransfer_Position_Field{_set: true, _value: v}
+func CouponCode_Amount(v int64) CouponCode_Amount_Field {
+	return CouponCode_Amount_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_Position_Field) value() interface{} {
+func (f CouponCode_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_Position_Field) _Column() string { return "position" }
+func (CouponCode_Amount_Field) _Column() string { return "amount" }
 
-type GracefulExitSegmentTransfer_PieceNum_Field struct {
+type CouponCode_Description_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func CouponCode_Description(v string) CouponCode_Description_Field {
+	return CouponCode_Description_Field{_set: true, _value: v}
+}
+
+func (f CouponCode_Description_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponCode_Description_Field) _Column() string { return "description" }
+
+type CouponCode_Type_Field struct {
 	_set   bool
 	_null  bool
 	_value int
 }
 
-func GracefulExitSegmentTransfer_PieceNum(v int) GracefulExitSegmentTransfer_PieceNum_Field {
-	return GracefulExitSegmentTransfer_PieceNum_Field{_set: true, _value: v}
+func CouponCode_Type(v int) CouponCode_Type_Field {
+	return CouponCode_Type_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_PieceNum_Field) value() interface{} {
+func (f CouponCode_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_PieceNum_Field) _Column() string { return "piece_num" }
+func (CouponCode_Type_Field) _Column() string { return "type" }
 
-type GracefulExitSegmentTransfer_RootPieceId_Field struct {
+type CouponCode_BillingPeriods_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int64
 }
 
-func GracefulExitSegmentTransfer_RootPieceId(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
-	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _value: v}
+func CouponCode_BillingPeriods(v int64) CouponCode_BillingPeriods_Field {
+	return CouponCode_BillingPeriods_Field{_set: true, _value: &v}
 }
 
-func GracefulExitSegmentTransfer_RootPieceId_Raw(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
+func CouponCode_BillingPeriods_Raw(v *int64) CouponCode_BillingPeriods_Field {
 	if v == nil {
-		return GracefulExitSegmentTransfer_RootPieceId_Null()
+		return CouponCode_BillingPeriods_Null()
 	}
-	return GracefulExitSegmentTransfer_RootPieceId(v)
+	return CouponCode_BillingPeriods(*v)
 }
 
-func GracefulExitSegmentTransfer_RootPieceId_Null() GracefulExitSegmentTransfer_RootPieceId_Field {
-	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _null: true}
+func CouponCode_BillingPeriods_Null() CouponCode_BillingPeriods_Field {
+	return CouponCode_BillingPeriods_Field{_set: true, _null: true}
 }
 
-func (f GracefulExitSegmentTransfer_RootPieceId_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f CouponCode_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f GracefulExitSegmentTransfer_RootPieceId_Field) value() interface{} {
+func (f CouponCode_BillingPeriods_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_RootPieceId_Field) _Column() string { return "root_piece_id" }
+func (CouponCode_BillingPeriods_Field) _Column() string { return "billing_periods" }
 
-type GracefulExitSegmentTransfer_DurabilityRatio_Field struct {
+type CouponCode_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func GracefulExitSegmentTransfer_DurabilityRatio(v float64) GracefulExitSegmentTransfer_DurabilityRatio_Field {
-	return GracefulExitSegmentTransfer_DurabilityRatio_Field{_set: true, _value: v}
+func CouponCode_CreatedAt(v time.Time) CouponCode_CreatedAt_Field {
+	return CouponCode_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_DurabilityRatio_Field) value() interface{} {
+func (f CouponCode_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_DurabilityRatio_Field) _Column() string { return "durability_ratio" }
+func (CouponCode_CreatedAt_Field) _Column() string { return "created_at" }
 
-type GracefulExitSegmentTransfer_QueuedAt_Field struct {
+type CouponUsage struct {
+	CouponId []byte
+	Amount   int64
+	Status   int
+	Period   time.Time
+}
+
+func (CouponUsage) _Table() string { return "coupon_usages" }
+
+type CouponUsage_Update_Fields struct {
+	Status CouponUsage_Status_Field
+}
+
+type CouponUsage_CouponId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func GracefulExitSegmentTransfer_QueuedAt(v time.Time) GracefulExitSegmentTransfer_QueuedAt_Field {
-	return GracefulExitSegmentTransfer_QueuedAt_Field{_set: true, _value: v}
+func CouponUsage_CouponId(v []byte) CouponUsage_CouponId_Field {
+	return CouponUsage_CouponId_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_QueuedAt_Field) value() interface{} {
+func (f CouponUsage_CouponId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_QueuedAt_Field) _Column() string { return "queued_at" }
+func (CouponUsage_CouponId_Field) _Column() string { return "coupon_id" }
 
-type GracefulExitSegmentTransfer_RequestedAt_Field struct {
+type CouponUsage_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value int64
 }
 
-func GracefulExitSegmentTransfer_RequestedAt(v time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
-	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _value: &v}
+func CouponUsage_Amount(v int64) CouponUsage_Amount_Field {
+	return CouponUsage_Amount_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_RequestedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_RequestedAt_Null()
+func (f CouponUsage_Amount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_RequestedAt(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_RequestedAt_Null() GracefulExitSegmentTransfer_RequestedAt_Field {
-	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _null: true}
+func (CouponUsage_Amount_Field) _Column() string { return "amount" }
+
+type CouponUsage_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f GracefulExitSegmentTransfer_RequestedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func CouponUsage_Status(v int) CouponUsage_Status_Field {
+	return CouponUsage_Status_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_RequestedAt_Field) value() interface{} {
+func (f CouponUsage_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_RequestedAt_Field) _Column() string { return "requested_at" }
+func (CouponUsage_Status_Field) _Column() string { return "status" }
 
-type GracefulExitSegmentTransfer_LastFailedAt_Field struct {
+type CouponUsage_Period_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value time.Time
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt(v time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
-	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _value: &v}
+func CouponUsage_Period(v time.Time) CouponUsage_Period_Field {
+	return CouponUsage_Period_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_LastFailedAt_Null()
+func (f CouponUsage_Period_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_LastFailedAt(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt_Null() GracefulExitSegmentTransfer_LastFailedAt_Field {
-	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _null: true}
-}
+func (CouponUsage_Period_Field) _Column() string { return "period" }
 
-func (f GracefulExitSegmentTransfer_LastFailedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+type EmailChangeToken struct {
+	Secret    []byte
+	UserId    []byte
+	Kind      int
+	OldEmail  string
+	NewEmail  string
+	CreatedAt time.Time
+	ExpiresAt time.Time
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
-}
+func (EmailChangeToken) _Table() string { return "email_change_tokens" }
 
-func (GracefulExitSegmentTransfer_LastFailedAt_Field) _Column() string { return "last_failed_at" }
+type EmailChangeToken_Update_Fields struct {
+}
 
-type GracefulExitSegmentTransfer_LastFailedCode_Field struct {
+type EmailChangeToken_Secret_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value []byte
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode(v int) GracefulExitSegmentTransfer_LastFailedCode_Field {
-	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _value: &v}
+func EmailChangeToken_Secret(v []byte) EmailChangeToken_Secret_Field {
+	return EmailChangeToken_Secret_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode_Raw(v *int) GracefulExitSegmentTransfer_LastFailedCode_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_LastFailedCode_Null()
+func (f EmailChangeToken_Secret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_LastFailedCode(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode_Null() GracefulExitSegmentTransfer_LastFailedCode_Field {
-	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _null: true}
+func (EmailChangeToken_Secret_Field) _Column() string { return "secret" }
+
+type EmailChangeToken_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedCode_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func EmailChangeToken_UserId(v []byte) EmailChangeToken_UserId_Field {
+	return EmailChangeToken_UserId_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedCode_Field) value() interface{} {
+func (f EmailChangeToken_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_LastFailedCode_Field) _Column() string { return "last_failed_code" }
+func (EmailChangeToken_UserId_Field) _Column() string { return "user_id" }
 
-type GracefulExitSegmentTransfer_FailedCount_Field struct {
+type EmailChangeToken_Kind_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value int
 }
 
-func GracefulExitSegmentTransfer_FailedCount(v int) GracefulExitSegmentTransfer_FailedCount_Field {
-	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _value: &v}
+func EmailChangeToken_Kind(v int) EmailChangeToken_Kind_Field {
+	return EmailChangeToken_Kind_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_FailedCount_Raw(v *int) GracefulExitSegmentTransfer_FailedCount_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_FailedCount_Null()
+func (f EmailChangeToken_Kind_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_FailedCount(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_FailedCount_Null() GracefulExitSegmentTransfer_FailedCount_Field {
-	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _null: true}
+func (EmailChangeToken_Kind_Field) _Column() string { return "kind" }
+
+type EmailChangeToken_OldEmail_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f GracefulExitSegmentTransfer_FailedCount_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func EmailChangeToken_OldEmail(v string) EmailChangeToken_OldEmail_Field {
+	return EmailChangeToken_OldEmail_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_FailedCount_Field) value() interface{} {
+func (f EmailChangeToken_OldEmail_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_FailedCount_Field) _Column() string { return "failed_count" }
+func (EmailChangeToken_OldEmail_Field) _Column() string { return "old_email" }
+
+type EmailChangeToken_NewEmail_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func EmailChangeToken_NewEmail(v string) EmailChangeToken_NewEmail_Field {
+	return EmailChangeToken_NewEmail_Field{_set: true, _value: v}
+}
+
+func (f EmailChangeToken_NewEmail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (EmailChangeToken_NewEmail_Field) _Column() string { return "new_email" }
+
+type EmailChangeToken_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func EmailChangeToken_CreatedAt(v time.Time) EmailChangeToken_CreatedAt_Field {
+	return EmailChangeToken_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f EmailChangeToken_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (EmailChangeToken_CreatedAt_Field) _Column() string { return "created_at" }
+
+type EmailChangeToken_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func EmailChangeToken_ExpiresAt(v time.Time) EmailChangeToken_ExpiresAt_Field {
+	return EmailChangeToken_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f EmailChangeToken_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (EmailChangeToken_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type GracefulExitProgress struct {
+	NodeId            []byte
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	UpdatedAt         time.Time
+}
+
+func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
+
+type GracefulExitProgress_Update_Fields struct {
+	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
+	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
+	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
+}
+
+type GracefulExitProgress_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
+	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExitProgress_BytesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
+	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+
+type GracefulExitProgress_PiecesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
+	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+
+type GracefulExitProgress_PiecesFailed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
+	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+
+type GracefulExitProgress_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
+	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type GracefulExitSegmentTransfer struct {
+	NodeId              []byte
+	StreamId            []byte
+	Position            uint64
+	PieceNum            int
+	RootPieceId         []byte
+	DurabilityRatio     float64
+	QueuedAt            time.Time
+	RequestedAt         *time.Time
+	LastFailedAt        *time.Time
+	LastFailedCode      *int
+	FailedCount         *int
+	FinishedAt          *time.Time
+	OrderLimitSendCount int
+}
+
+func (GracefulExitSegmentTransfer) _Table() string { return "graceful_exit_segment_transfer_queue" }
+
+type GracefulExitSegmentTransfer_Create_Fields struct {
+	RootPieceId         GracefulExitSegmentTransfer_RootPieceId_Field
+	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
+	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
+	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
+	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
+	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
+	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+}
+
+type GracefulExitSegmentTransfer_Update_Fields struct {
+	DurabilityRatio     GracefulExitSegmentTransfer_DurabilityRatio_Field
+	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
+	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
+	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
+	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
+	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
+	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+}
+
+type GracefulExitSegmentTransfer_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitSegmentTransfer_NodeId(v []byte) GracefulExitSegmentTransfer_NodeId_Field {
+	return GracefulExitSegmentTransfer_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExitSegmentTransfer_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitSegmentTransfer_StreamId(v []byte) GracefulExitSegmentTransfer_StreamId_Field {
+	return GracefulExitSegmentTransfer_StreamId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_StreamId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_StreamId_Field) _Column() string { return "stream_id" }
+
+type GracefulExitSegmentTransfer_Position_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func GracefulExitSegmentTransfer_Position(v uint64) GracefulExitSegmentTransfer_Position_Field {
+	return GracefulExitSegmentTransfer_Position_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_Position_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_Position_Field) _Column() string { return "position" }
+
+type GracefulExitSegmentTransfer_PieceNum_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitSegmentTransfer_PieceNum(v int) GracefulExitSegmentTransfer_PieceNum_Field {
+	return GracefulExitSegmentTransfer_PieceNum_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_PieceNum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_PieceNum_Field) _Column() string { return "piece_num" }
+
+type GracefulExitSegmentTransfer_RootPieceId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitSegmentTransfer_RootPieceId(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
+	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _value: v}
+}
+
+func GracefulExitSegmentTransfer_RootPieceId_Raw(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_RootPieceId_Null()
+	}
+	return GracefulExitSegmentTransfer_RootPieceId(v)
+}
+
+func GracefulExitSegmentTransfer_RootPieceId_Null() GracefulExitSegmentTransfer_RootPieceId_Field {
+	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_RootPieceId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_RootPieceId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_RootPieceId_Field) _Column() string { return "root_piece_id" }
+
+type GracefulExitSegmentTransfer_DurabilityRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func GracefulExitSegmentTransfer_DurabilityRatio(v float64) GracefulExitSegmentTransfer_DurabilityRatio_Field {
+	return GracefulExitSegmentTransfer_DurabilityRatio_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_DurabilityRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_DurabilityRatio_Field) _Column() string { return "durability_ratio" }
+
+type GracefulExitSegmentTransfer_QueuedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitSegmentTransfer_QueuedAt(v time.Time) GracefulExitSegmentTransfer_QueuedAt_Field {
+	return GracefulExitSegmentTransfer_QueuedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_QueuedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_QueuedAt_Field) _Column() string { return "queued_at" }
+
+type GracefulExitSegmentTransfer_RequestedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitSegmentTransfer_RequestedAt(v time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
+	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_RequestedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_RequestedAt_Null()
+	}
+	return GracefulExitSegmentTransfer_RequestedAt(*v)
+}
+
+func GracefulExitSegmentTransfer_RequestedAt_Null() GracefulExitSegmentTransfer_RequestedAt_Field {
+	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_RequestedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_RequestedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_RequestedAt_Field) _Column() string { return "requested_at" }
+
+type GracefulExitSegmentTransfer_LastFailedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitSegmentTransfer_LastFailedAt(v time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
+	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_LastFailedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_LastFailedAt_Null()
+	}
+	return GracefulExitSegmentTransfer_LastFailedAt(*v)
+}
+
+func GracefulExitSegmentTransfer_LastFailedAt_Null() GracefulExitSegmentTransfer_LastFailedAt_Field {
+	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_LastFailedAt_Field) _Column() string { return "last_failed_at" }
+
+type GracefulExitSegmentTransfer_LastFailedCode_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func GracefulExitSegmentTransfer_LastFailedCode(v int) GracefulExitSegmentTransfer_LastFailedCode_Field {
+	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_LastFailedCode_Raw(v *int) GracefulExitSegmentTransfer_LastFailedCode_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_LastFailedCode_Null()
+	}
+	return GracefulExitSegmentTransfer_LastFailedCode(*v)
+}
+
+func GracefulExitSegmentTransfer_LastFailedCode_Null() GracefulExitSegmentTransfer_LastFailedCode_Field {
+	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedCode_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedCode_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_LastFailedCode_Field) _Column() string { return "last_failed_code" }
+
+type GracefulExitSegmentTransfer_FailedCount_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func GracefulExitSegmentTransfer_FailedCount(v int) GracefulExitSegmentTransfer_FailedCount_Field {
+	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_FailedCount_Raw(v *int) GracefulExitSegmentTransfer_FailedCount_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_FailedCount_Null()
+	}
+	return GracefulExitSegmentTransfer_FailedCount(*v)
+}
+
+func GracefulExitSegmentTransfer_FailedCount_Null() GracefulExitSegmentTransfer_FailedCount_Field {
+	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_FailedCount_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_FailedCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_FailedCount_Field) _Column() string { return "failed_count" }
 
 type GracefulExitSegmentTransfer_FinishedAt_Field struct {
 	_set   bool
@@ -3745,2532 +4596,3519 @@ type GracefulExitSegmentTransfer_FinishedAt_Field struct {
 	_value *time.Time
 }
 
-func GracefulExitSegmentTransfer_FinishedAt(v time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
-	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _value: &v}
+func GracefulExitSegmentTransfer_FinishedAt(v time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
+	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_FinishedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_FinishedAt_Null()
+	}
+	return GracefulExitSegmentTransfer_FinishedAt(*v)
+}
+
+func GracefulExitSegmentTransfer_FinishedAt_Null() GracefulExitSegmentTransfer_FinishedAt_Field {
+	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_FinishedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_FinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_FinishedAt_Field) _Column() string { return "finished_at" }
+
+type GracefulExitSegmentTransfer_OrderLimitSendCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitSegmentTransfer_OrderLimitSendCount(v int) GracefulExitSegmentTransfer_OrderLimitSendCount_Field {
+	return GracefulExitSegmentTransfer_OrderLimitSendCount_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_OrderLimitSendCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_OrderLimitSendCount_Field) _Column() string {
+	return "order_limit_send_count"
+}
+
+type Node struct {
+	Id                     []byte
+	Address                string
+	LastNet                string
+	LastIpPort             *string
+	CountryCode            *string
+	Protocol               int
+	Type                   int
+	Email                  string
+	Wallet                 string
+	WalletFeatures         string
+	FreeDisk               int64
+	PieceCount             int64
+	Major                  int64
+	Minor                  int64
+	Patch                  int64
+	Hash                   string
+	Timestamp              time.Time
+	Release                bool
+	Latency90              int64
+	VettedAt               *time.Time
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+	LastContactSuccess     time.Time
+	LastContactFailure     time.Time
+	Disqualified           *time.Time
+	DisqualificationReason *int
+	UnknownAuditSuspended  *time.Time
+	OfflineSuspended       *time.Time
+	UnderReview            *time.Time
+	ExitInitiatedAt        *time.Time
+	ExitLoopCompletedAt    *time.Time
+	ExitFinishedAt         *time.Time
+	ExitSuccess            bool
+}
+
+func (Node) _Table() string { return "nodes" }
+
+type Node_Create_Fields struct {
+	Address                Node_Address_Field
+	LastIpPort             Node_LastIpPort_Field
+	CountryCode            Node_CountryCode_Field
+	Protocol               Node_Protocol_Field
+	Type                   Node_Type_Field
+	WalletFeatures         Node_WalletFeatures_Field
+	FreeDisk               Node_FreeDisk_Field
+	Major                  Node_Major_Field
+	Minor                  Node_Minor_Field
+	Patch                  Node_Patch_Field
+	Hash                   Node_Hash_Field
+	Timestamp              Node_Timestamp_Field
+	Release                Node_Release_Field
+	Latency90              Node_Latency90_Field
+	VettedAt               Node_VettedAt_Field
+	LastContactSuccess     Node_LastContactSuccess_Field
+	LastContactFailure     Node_LastContactFailure_Field
+	Disqualified           Node_Disqualified_Field
+	DisqualificationReason Node_DisqualificationReason_Field
+	UnknownAuditSuspended  Node_UnknownAuditSuspended_Field
+	OfflineSuspended       Node_OfflineSuspended_Field
+	UnderReview            Node_UnderReview_Field
+	ExitInitiatedAt        Node_ExitInitiatedAt_Field
+	ExitLoopCompletedAt    Node_ExitLoopCompletedAt_Field
+	ExitFinishedAt         Node_ExitFinishedAt_Field
+	ExitSuccess            Node_ExitSuccess_Field
+}
+
+type Node_Update_Fields struct {
+	Address                Node_Address_Field
+	LastNet                Node_LastNet_Field
+	LastIpPort             Node_LastIpPort_Field
+	CountryCode            Node_CountryCode_Field
+	Protocol               Node_Protocol_Field
+	Type                   Node_Type_Field
+	Email                  Node_Email_Field
+	Wallet                 Node_Wallet_Field
+	WalletFeatures         Node_WalletFeatures_Field
+	FreeDisk               Node_FreeDisk_Field
+	PieceCount             Node_PieceCount_Field
+	Major                  Node_Major_Field
+	Minor                  Node_Minor_Field
+	Patch                  Node_Patch_Field
+	Hash                   Node_Hash_Field
+	Timestamp              Node_Timestamp_Field
+	Release                Node_Release_Field
+	Latency90              Node_Latency90_Field
+	VettedAt               Node_VettedAt_Field
+	LastContactSuccess     Node_LastContactSuccess_Field
+	LastContactFailure     Node_LastContactFailure_Field
+	Disqualified           Node_Disqualified_Field
+	DisqualificationReason Node_DisqualificationReason_Field
+	UnknownAuditSuspended  Node_UnknownAuditSuspended_Field
+	OfflineSuspended       Node_OfflineSuspended_Field
+	UnderReview            Node_UnderReview_Field
+	ExitInitiatedAt        Node_ExitInitiatedAt_Field
+	ExitLoopCompletedAt    Node_ExitLoopCompletedAt_Field
+	ExitFinishedAt         Node_ExitFinishedAt_Field
+	ExitSuccess            Node_ExitSuccess_Field
+}
+
+type Node_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Node_Id(v []byte) Node_Id_Field {
+	return Node_Id_Field{_set: true, _value: v}
+}
+
+func (f Node_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Id_Field) _Column() string { return "id" }
+
+type Node_Address_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Address(v string) Node_Address_Field {
+	return Node_Address_Field{_set: true, _value: v}
+}
+
+func (f Node_Address_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Address_Field) _Column() string { return "address" }
+
+type Node_LastNet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_LastNet(v string) Node_LastNet_Field {
+	return Node_LastNet_Field{_set: true, _value: v}
+}
+
+func (f Node_LastNet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastNet_Field) _Column() string { return "last_net" }
+
+type Node_LastIpPort_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Node_LastIpPort(v string) Node_LastIpPort_Field {
+	return Node_LastIpPort_Field{_set: true, _value: &v}
+}
+
+func Node_LastIpPort_Raw(v *string) Node_LastIpPort_Field {
+	if v == nil {
+		return Node_LastIpPort_Null()
+	}
+	return Node_LastIpPort(*v)
+}
+
+func Node_LastIpPort_Null() Node_LastIpPort_Field {
+	return Node_LastIpPort_Field{_set: true, _null: true}
+}
+
+func (f Node_LastIpPort_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_LastIpPort_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastIpPort_Field) _Column() string { return "last_ip_port" }
+
+type Node_CountryCode_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Node_CountryCode(v string) Node_CountryCode_Field {
+	return Node_CountryCode_Field{_set: true, _value: &v}
+}
+
+func Node_CountryCode_Raw(v *string) Node_CountryCode_Field {
+	if v == nil {
+		return Node_CountryCode_Null()
+	}
+	return Node_CountryCode(*v)
+}
+
+func Node_CountryCode_Null() Node_CountryCode_Field {
+	return Node_CountryCode_Field{_set: true, _null: true}
+}
+
+func (f Node_CountryCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_CountryCode_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_CountryCode_Field) _Column() string { return "country_code" }
+
+type Node_Protocol_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Node_Protocol(v int) Node_Protocol_Field {
+	return Node_Protocol_Field{_set: true, _value: v}
+}
+
+func (f Node_Protocol_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Protocol_Field) _Column() string { return "protocol" }
+
+type Node_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Node_Type(v int) Node_Type_Field {
+	return Node_Type_Field{_set: true, _value: v}
+}
+
+func (f Node_Type_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Type_Field) _Column() string { return "type" }
+
+type Node_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Email(v string) Node_Email_Field {
+	return Node_Email_Field{_set: true, _value: v}
+}
+
+func (f Node_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Email_Field) _Column() string { return "email" }
+
+type Node_Wallet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Wallet(v string) Node_Wallet_Field {
+	return Node_Wallet_Field{_set: true, _value: v}
+}
+
+func (f Node_Wallet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Wallet_Field) _Column() string { return "wallet" }
+
+type Node_WalletFeatures_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_WalletFeatures(v string) Node_WalletFeatures_Field {
+	return Node_WalletFeatures_Field{_set: true, _value: v}
+}
+
+func (f Node_WalletFeatures_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_WalletFeatures_Field) _Column() string { return "wallet_features" }
+
+type Node_FreeDisk_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_FreeDisk(v int64) Node_FreeDisk_Field {
+	return Node_FreeDisk_Field{_set: true, _value: v}
+}
+
+func (f Node_FreeDisk_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+
+type Node_PieceCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_PieceCount(v int64) Node_PieceCount_Field {
+	return Node_PieceCount_Field{_set: true, _value: v}
+}
+
+func (f Node_PieceCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_PieceCount_Field) _Column() string { return "piece_count" }
+
+type Node_Major_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Major(v int64) Node_Major_Field {
+	return Node_Major_Field{_set: true, _value: v}
+}
+
+func (f Node_Major_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Major_Field) _Column() string { return "major" }
+
+type Node_Minor_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Minor(v int64) Node_Minor_Field {
+	return Node_Minor_Field{_set: true, _value: v}
+}
+
+func (f Node_Minor_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Minor_Field) _Column() string { return "minor" }
+
+type Node_Patch_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Patch(v int64) Node_Patch_Field {
+	return Node_Patch_Field{_set: true, _value: v}
+}
+
+func (f Node_Patch_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Patch_Field) _Column() string { return "patch" }
+
+type Node_Hash_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Hash(v string) Node_Hash_Field {
+	return Node_Hash_Field{_set: true, _value: v}
+}
+
+func (f Node_Hash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Hash_Field) _Column() string { return "hash" }
+
+type Node_Timestamp_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_Timestamp(v time.Time) Node_Timestamp_Field {
+	return Node_Timestamp_Field{_set: true, _value: v}
+}
+
+func (f Node_Timestamp_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Timestamp_Field) _Column() string { return "timestamp" }
+
+type Node_Release_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_Release(v bool) Node_Release_Field {
+	return Node_Release_Field{_set: true, _value: v}
+}
+
+func (f Node_Release_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Release_Field) _Column() string { return "release" }
+
+type Node_Latency90_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Latency90(v int64) Node_Latency90_Field {
+	return Node_Latency90_Field{_set: true, _value: v}
+}
+
+func (f Node_Latency90_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Latency90_Field) _Column() string { return "latency_90" }
+
+type Node_VettedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_VettedAt(v time.Time) Node_VettedAt_Field {
+	return Node_VettedAt_Field{_set: true, _value: &v}
+}
+
+func Node_VettedAt_Raw(v *time.Time) Node_VettedAt_Field {
+	if v == nil {
+		return Node_VettedAt_Null()
+	}
+	return Node_VettedAt(*v)
+}
+
+func Node_VettedAt_Null() Node_VettedAt_Field {
+	return Node_VettedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_VettedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_VettedAt_Field) _Column() string { return "vetted_at" }
+
+type Node_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
+	return Node_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Node_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
+	return Node_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type Node_LastContactSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
+	return Node_LastContactSuccess_Field{_set: true, _value: v}
+}
+
+func (f Node_LastContactSuccess_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+
+type Node_LastContactFailure_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
+	return Node_LastContactFailure_Field{_set: true, _value: v}
+}
+
+func (f Node_LastContactFailure_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
+
+type Node_Disqualified_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_Disqualified(v time.Time) Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _value: &v}
+}
+
+func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
+	if v == nil {
+		return Node_Disqualified_Null()
+	}
+	return Node_Disqualified(*v)
+}
+
+func Node_Disqualified_Null() Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _null: true}
+}
+
+func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Disqualified_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Disqualified_Field) _Column() string { return "disqualified" }
+
+type Node_DisqualificationReason_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func Node_DisqualificationReason(v int) Node_DisqualificationReason_Field {
+	return Node_DisqualificationReason_Field{_set: true, _value: &v}
+}
+
+func Node_DisqualificationReason_Raw(v *int) Node_DisqualificationReason_Field {
+	if v == nil {
+		return Node_DisqualificationReason_Null()
+	}
+	return Node_DisqualificationReason(*v)
+}
+
+func Node_DisqualificationReason_Null() Node_DisqualificationReason_Field {
+	return Node_DisqualificationReason_Field{_set: true, _null: true}
+}
+
+func (f Node_DisqualificationReason_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Node_DisqualificationReason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
+
+type Node_UnknownAuditSuspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_UnknownAuditSuspended(v time.Time) Node_UnknownAuditSuspended_Field {
+	return Node_UnknownAuditSuspended_Field{_set: true, _value: &v}
+}
+
+func Node_UnknownAuditSuspended_Raw(v *time.Time) Node_UnknownAuditSuspended_Field {
+	if v == nil {
+		return Node_UnknownAuditSuspended_Null()
+	}
+	return Node_UnknownAuditSuspended(*v)
+}
+
+func Node_UnknownAuditSuspended_Null() Node_UnknownAuditSuspended_Field {
+	return Node_UnknownAuditSuspended_Field{_set: true, _null: true}
+}
+
+func (f Node_UnknownAuditSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_UnknownAuditSuspended_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+
+type Node_OfflineSuspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_OfflineSuspended(v time.Time) Node_OfflineSuspended_Field {
+	return Node_OfflineSuspended_Field{_set: true, _value: &v}
 }
 
-func GracefulExitSegmentTransfer_FinishedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
+func Node_OfflineSuspended_Raw(v *time.Time) Node_OfflineSuspended_Field {
 	if v == nil {
-		return GracefulExitSegmentTransfer_FinishedAt_Null()
+		return Node_OfflineSuspended_Null()
 	}
-	return GracefulExitSegmentTransfer_FinishedAt(*v)
+	return Node_OfflineSuspended(*v)
 }
 
-func GracefulExitSegmentTransfer_FinishedAt_Null() GracefulExitSegmentTransfer_FinishedAt_Field {
-	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _null: true}
+func Node_OfflineSuspended_Null() Node_OfflineSuspended_Field {
+	return Node_OfflineSuspended_Field{_set: true, _null: true}
 }
 
-func (f GracefulExitSegmentTransfer_FinishedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func (f Node_OfflineSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_OfflineSuspended_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-func (f GracefulExitSegmentTransfer_FinishedAt_Field) value() interface{} {
+func (Node_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+
+type Node_UnderReview_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_UnderReview(v time.Time) Node_UnderReview_Field {
+	return Node_UnderReview_Field{_set: true, _value: &v}
+}
+
+func Node_UnderReview_Raw(v *time.Time) Node_UnderReview_Field {
+	if v == nil {
+		return Node_UnderReview_Null()
+	}
+	return Node_UnderReview(*v)
+}
+
+func Node_UnderReview_Null() Node_UnderReview_Field {
+	return Node_UnderReview_Field{_set: true, _null: true}
+}
+
+func (f Node_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_UnderReview_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_FinishedAt_Field) _Column() string { return "finished_at" }
+func (Node_UnderReview_Field) _Column() string { return "under_review" }
 
-type GracefulExitSegmentTransfer_OrderLimitSendCount_Field struct {
+type Node_ExitInitiatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *time.Time
 }
 
-func GracefulExitSegmentTransfer_OrderLimitSendCount(v int) GracefulExitSegmentTransfer_OrderLimitSendCount_Field {
-	return GracefulExitSegmentTransfer_OrderLimitSendCount_Field{_set: true, _value: v}
+func Node_ExitInitiatedAt(v time.Time) Node_ExitInitiatedAt_Field {
+	return Node_ExitInitiatedAt_Field{_set: true, _value: &v}
 }
 
-func (f GracefulExitSegmentTransfer_OrderLimitSendCount_Field) value() interface{} {
+func Node_ExitInitiatedAt_Raw(v *time.Time) Node_ExitInitiatedAt_Field {
+	if v == nil {
+		return Node_ExitInitiatedAt_Null()
+	}
+	return Node_ExitInitiatedAt(*v)
+}
+
+func Node_ExitInitiatedAt_Null() Node_ExitInitiatedAt_Field {
+	return Node_ExitInitiatedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitInitiatedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitInitiatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_OrderLimitSendCount_Field) _Column() string {
-	return "order_limit_send_count"
+func (Node_ExitInitiatedAt_Field) _Column() string { return "exit_initiated_at" }
+
+type Node_ExitLoopCompletedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
 }
 
-type Node struct {
-	Id                     []byte
-	Address                string
-	LastNet                string
-	LastIpPort             *string
-	CountryCode            *string
-	Protocol               int
-	Type                   int
-	Email                  string
-	Wallet                 string
-	WalletFeatures         string
-	FreeDisk               int64
-	PieceCount             int64
-	Major                  int64
-	Minor                  int64
-	Patch                  int64
-	Hash                   string
-	Timestamp              time.Time
-	Release                bool
-	Latency90              int64
-	VettedAt               *time.Time
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
-	LastContactSuccess     time.Time
-	LastContactFailure     time.Time
-	Disqualified           *time.Time
-	DisqualificationReason *int
-	UnknownAuditSuspended  *time.Time
-	OfflineSuspended       *time.Time
-	UnderReview            *time.Time
-	ExitInitiatedAt        *time.Time
-	ExitLoopCompletedAt    *time.Time
-	ExitFinishedAt         *time.Time
-	ExitSuccess            bool
+func Node_ExitLoopCompletedAt(v time.Time) Node_ExitLoopCompletedAt_Field {
+	return Node_ExitLoopCompletedAt_Field{_set: true, _value: &v}
 }
 
-func (Node) _Table() string { return "nodes" }
+func Node_ExitLoopCompletedAt_Raw(v *time.Time) Node_ExitLoopCompletedAt_Field {
+	if v == nil {
+		return Node_ExitLoopCompletedAt_Null()
+	}
+	return Node_ExitLoopCompletedAt(*v)
+}
 
-type Node_Create_Fields struct {
-	Address                Node_Address_Field
-	LastIpPort             Node_LastIpPort_Field
-	CountryCode            Node_CountryCode_Field
-	Protocol               Node_Protocol_Field
-	Type                   Node_Type_Field
-	WalletFeatures         Node_WalletFeatures_Field
-	FreeDisk               Node_FreeDisk_Field
-	Major                  Node_Major_Field
-	Minor                  Node_Minor_Field
-	Patch                  Node_Patch_Field
-	Hash                   Node_Hash_Field
-	Timestamp              Node_Timestamp_Field
-	Release                Node_Release_Field
-	Latency90              Node_Latency90_Field
-	VettedAt               Node_VettedAt_Field
-	LastContactSuccess     Node_LastContactSuccess_Field
-	LastContactFailure     Node_LastContactFailure_Field
-	Disqualified           Node_Disqualified_Field
-	DisqualificationReason Node_DisqualificationReason_Field
-	UnknownAuditSuspended  Node_UnknownAuditSuspended_Field
-	OfflineSuspended       Node_OfflineSuspended_Field
-	UnderReview            Node_UnderReview_Field
-	ExitInitiatedAt        Node_ExitInitiatedAt_Field
-	ExitLoopCompletedAt    Node_ExitLoopCompletedAt_Field
-	ExitFinishedAt         Node_ExitFinishedAt_Field
-	ExitSuccess            Node_ExitSuccess_Field
+func Node_ExitLoopCompletedAt_Null() Node_ExitLoopCompletedAt_Field {
+	return Node_ExitLoopCompletedAt_Field{_set: true, _null: true}
 }
 
-type Node_Update_Fields struct {
-	Address                Node_Address_Field
-	LastNet                Node_LastNet_Field
-	LastIpPort             Node_LastIpPort_Field
-	CountryCode            Node_CountryCode_Field
-	Protocol               Node_Protocol_Field
-	Type                   Node_Type_Field
-	Email                  Node_Email_Field
-	Wallet                 Node_Wallet_Field
-	WalletFeatures         Node_WalletFeatures_Field
-	FreeDisk               Node_FreeDisk_Field
-	PieceCount             Node_PieceCount_Field
-	Major                  Node_Major_Field
-	Minor                  Node_Minor_Field
-	Patch                  Node_Patch_Field
-	Hash                   Node_Hash_Field
-	Timestamp              Node_Timestamp_Field
-	Release                Node_Release_Field
-	Latency90              Node_Latency90_Field
-	VettedAt               Node_VettedAt_Field
-	LastContactSuccess     Node_LastContactSuccess_Field
-	LastContactFailure     Node_LastContactFailure_Field
-	Disqualified           Node_Disqualified_Field
-	DisqualificationReason Node_DisqualificationReason_Field
-	UnknownAuditSuspended  Node_UnknownAuditSuspended_Field
-	OfflineSuspended       Node_OfflineSuspended_Field
-	UnderReview            Node_UnderReview_Field
-	ExitInitiatedAt        Node_ExitInitiatedAt_Field
-	ExitLoopCompletedAt    Node_ExitLoopCompletedAt_Field
-	ExitFinishedAt         Node_ExitFinishedAt_Field
-	ExitSuccess            Node_ExitSuccess_Field
+func (f Node_ExitLoopCompletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitLoopCompletedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitLoopCompletedAt_Field) _Column() string { return "exit_loop_completed_at" }
+
+type Node_ExitFinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_ExitFinishedAt(v time.Time) Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _value: &v}
+}
+
+func Node_ExitFinishedAt_Raw(v *time.Time) Node_ExitFinishedAt_Field {
+	if v == nil {
+		return Node_ExitFinishedAt_Null()
+	}
+	return Node_ExitFinishedAt(*v)
+}
+
+func Node_ExitFinishedAt_Null() Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitFinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitFinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
+
+type Node_ExitSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_ExitSuccess(v bool) Node_ExitSuccess_Field {
+	return Node_ExitSuccess_Field{_set: true, _value: v}
+}
+
+func (f Node_ExitSuccess_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
+
+type NodeApiVersion struct {
+	Id         []byte
+	ApiVersion int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
-type Node_Id_Field struct {
+func (NodeApiVersion) _Table() string { return "node_api_versions" }
+
+type NodeApiVersion_Update_Fields struct {
+	ApiVersion NodeApiVersion_ApiVersion_Field
+}
+
+type NodeApiVersion_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Node_Id(v []byte) Node_Id_Field {
-	return Node_Id_Field{_set: true, _value: v}
+func NodeApiVersion_Id(v []byte) NodeApiVersion_Id_Field {
+	return NodeApiVersion_Id_Field{_set: true, _value: v}
 }
 
-func (f Node_Id_Field) value() interface{} {
+func (f NodeApiVersion_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Id_Field) _Column() string { return "id" }
+func (NodeApiVersion_Id_Field) _Column() string { return "id" }
 
-type Node_Address_Field struct {
+type NodeApiVersion_ApiVersion_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int
 }
 
-func Node_Address(v string) Node_Address_Field {
-	return Node_Address_Field{_set: true, _value: v}
+func NodeApiVersion_ApiVersion(v int) NodeApiVersion_ApiVersion_Field {
+	return NodeApiVersion_ApiVersion_Field{_set: true, _value: v}
 }
 
-func (f Node_Address_Field) value() interface{} {
+func (f NodeApiVersion_ApiVersion_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Address_Field) _Column() string { return "address" }
+func (NodeApiVersion_ApiVersion_Field) _Column() string { return "api_version" }
 
-type Node_LastNet_Field struct {
+type NodeApiVersion_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Node_LastNet(v string) Node_LastNet_Field {
-	return Node_LastNet_Field{_set: true, _value: v}
+func NodeApiVersion_CreatedAt(v time.Time) NodeApiVersion_CreatedAt_Field {
+	return NodeApiVersion_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_LastNet_Field) value() interface{} {
+func (f NodeApiVersion_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastNet_Field) _Column() string { return "last_net" }
+func (NodeApiVersion_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_LastIpPort_Field struct {
+type NodeApiVersion_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value time.Time
 }
 
-func Node_LastIpPort(v string) Node_LastIpPort_Field {
-	return Node_LastIpPort_Field{_set: true, _value: &v}
+func NodeApiVersion_UpdatedAt(v time.Time) NodeApiVersion_UpdatedAt_Field {
+	return NodeApiVersion_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func Node_LastIpPort_Raw(v *string) Node_LastIpPort_Field {
-	if v == nil {
-		return Node_LastIpPort_Null()
+func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_LastIpPort(*v)
+	return f._value
 }
 
-func Node_LastIpPort_Null() Node_LastIpPort_Field {
-	return Node_LastIpPort_Field{_set: true, _null: true}
+func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type NodeEvent struct {
+	Id        []byte
+	Email     string
+	NodeId    []byte
+	Event     int
+	CreatedAt time.Time
+	EmailSent *time.Time
 }
 
-func (f Node_LastIpPort_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (NodeEvent) _Table() string { return "node_events" }
 
-func (f Node_LastIpPort_Field) value() interface{} {
+type NodeEvent_Create_Fields struct {
+	CreatedAt NodeEvent_CreatedAt_Field
+	EmailSent NodeEvent_EmailSent_Field
+}
+
+type NodeEvent_Update_Fields struct {
+	EmailSent NodeEvent_EmailSent_Field
+}
+
+type NodeEvent_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeEvent_Id(v []byte) NodeEvent_Id_Field {
+	return NodeEvent_Id_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastIpPort_Field) _Column() string { return "last_ip_port" }
+func (NodeEvent_Id_Field) _Column() string { return "id" }
 
-type Node_CountryCode_Field struct {
+type NodeEvent_Email_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value string
 }
 
-func Node_CountryCode(v string) Node_CountryCode_Field {
-	return Node_CountryCode_Field{_set: true, _value: &v}
+func NodeEvent_Email(v string) NodeEvent_Email_Field {
+	return NodeEvent_Email_Field{_set: true, _value: v}
 }
 
-func Node_CountryCode_Raw(v *string) Node_CountryCode_Field {
-	if v == nil {
-		return Node_CountryCode_Null()
+func (f NodeEvent_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_CountryCode(*v)
+	return f._value
 }
 
-func Node_CountryCode_Null() Node_CountryCode_Field {
-	return Node_CountryCode_Field{_set: true, _null: true}
+func (NodeEvent_Email_Field) _Column() string { return "email" }
+
+type NodeEvent_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Node_CountryCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func NodeEvent_NodeId(v []byte) NodeEvent_NodeId_Field {
+	return NodeEvent_NodeId_Field{_set: true, _value: v}
+}
 
-func (f Node_CountryCode_Field) value() interface{} {
+func (f NodeEvent_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_CountryCode_Field) _Column() string { return "country_code" }
+func (NodeEvent_NodeId_Field) _Column() string { return "node_id" }
 
-type Node_Protocol_Field struct {
+type NodeEvent_Event_Field struct {
 	_set   bool
 	_null  bool
 	_value int
 }
 
-func Node_Protocol(v int) Node_Protocol_Field {
-	return Node_Protocol_Field{_set: true, _value: v}
+func NodeEvent_Event(v int) NodeEvent_Event_Field {
+	return NodeEvent_Event_Field{_set: true, _value: v}
 }
 
-func (f Node_Protocol_Field) value() interface{} {
+func (f NodeEvent_Event_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Protocol_Field) _Column() string { return "protocol" }
+func (NodeEvent_Event_Field) _Column() string { return "event" }
 
-type Node_Type_Field struct {
+type NodeEvent_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func Node_Type(v int) Node_Type_Field {
-	return Node_Type_Field{_set: true, _value: v}
+func NodeEvent_CreatedAt(v time.Time) NodeEvent_CreatedAt_Field {
+	return NodeEvent_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_Type_Field) value() interface{} {
+func (f NodeEvent_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Type_Field) _Column() string { return "type" }
+func (NodeEvent_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_Email_Field struct {
+type NodeEvent_EmailSent_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *time.Time
 }
 
-func Node_Email(v string) Node_Email_Field {
-	return Node_Email_Field{_set: true, _value: v}
+func NodeEvent_EmailSent(v time.Time) NodeEvent_EmailSent_Field {
+	return NodeEvent_EmailSent_Field{_set: true, _value: &v}
 }
 
-func (f Node_Email_Field) value() interface{} {
+func NodeEvent_EmailSent_Raw(v *time.Time) NodeEvent_EmailSent_Field {
+	if v == nil {
+		return NodeEvent_EmailSent_Null()
+	}
+	return NodeEvent_EmailSent(*v)
+}
+
+func NodeEvent_EmailSent_Null() NodeEvent_EmailSent_Field {
+	return NodeEvent_EmailSent_Field{_set: true, _null: true}
+}
+
+func (f NodeEvent_EmailSent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeEvent_EmailSent_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Email_Field) _Column() string { return "email" }
+func (NodeEvent_EmailSent_Field) _Column() string { return "email_sent" }
 
-type Node_Wallet_Field struct {
+type OauthClient struct {
+	Id              []byte
+	EncryptedSecret []byte
+	RedirectUrl     string
+	UserId          []byte
+	AppName         string
+	AppLogoUrl      string
+}
+
+func (OauthClient) _Table() string { return "oauth_clients" }
+
+type OauthClient_Update_Fields struct {
+	EncryptedSecret OauthClient_EncryptedSecret_Field
+	RedirectUrl     OauthClient_RedirectUrl_Field
+	AppName         OauthClient_AppName_Field
+	AppLogoUrl      OauthClient_AppLogoUrl_Field
+}
+
+type OauthClient_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Node_Wallet(v string) Node_Wallet_Field {
-	return Node_Wallet_Field{_set: true, _value: v}
+func OauthClient_Id(v []byte) OauthClient_Id_Field {
+	return OauthClient_Id_Field{_set: true, _value: v}
 }
 
-func (f Node_Wallet_Field) value() interface{} {
+func (f OauthClient_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Wallet_Field) _Column() string { return "wallet" }
+func (OauthClient_Id_Field) _Column() string { return "id" }
 
-type Node_WalletFeatures_Field struct {
+type OauthClient_EncryptedSecret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OauthClient_EncryptedSecret(v []byte) OauthClient_EncryptedSecret_Field {
+	return OauthClient_EncryptedSecret_Field{_set: true, _value: v}
+}
+
+func (f OauthClient_EncryptedSecret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_EncryptedSecret_Field) _Column() string { return "encrypted_secret" }
+
+type OauthClient_RedirectUrl_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_WalletFeatures(v string) Node_WalletFeatures_Field {
-	return Node_WalletFeatures_Field{_set: true, _value: v}
+func OauthClient_RedirectUrl(v string) OauthClient_RedirectUrl_Field {
+	return OauthClient_RedirectUrl_Field{_set: true, _value: v}
 }
 
-func (f Node_WalletFeatures_Field) value() interface{} {
+func (f OauthClient_RedirectUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_WalletFeatures_Field) _Column() string { return "wallet_features" }
+func (OauthClient_RedirectUrl_Field) _Column() string { return "redirect_url" }
 
-type Node_FreeDisk_Field struct {
+type OauthClient_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Node_FreeDisk(v int64) Node_FreeDisk_Field {
-	return Node_FreeDisk_Field{_set: true, _value: v}
+func OauthClient_UserId(v []byte) OauthClient_UserId_Field {
+	return OauthClient_UserId_Field{_set: true, _value: v}
 }
 
-func (f Node_FreeDisk_Field) value() interface{} {
+func (f OauthClient_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+func (OauthClient_UserId_Field) _Column() string { return "user_id" }
 
-type Node_PieceCount_Field struct {
+type OauthClient_AppName_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Node_PieceCount(v int64) Node_PieceCount_Field {
-	return Node_PieceCount_Field{_set: true, _value: v}
+func OauthClient_AppName(v string) OauthClient_AppName_Field {
+	return OauthClient_AppName_Field{_set: true, _value: v}
 }
 
-func (f Node_PieceCount_Field) value() interface{} {
+func (f OauthClient_AppName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_PieceCount_Field) _Column() string { return "piece_count" }
+func (OauthClient_AppName_Field) _Column() string { return "app_name" }
+
+type OauthClient_AppLogoUrl_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OauthClient_AppLogoUrl(v string) OauthClient_AppLogoUrl_Field {
+	return OauthClient_AppLogoUrl_Field{_set: true, _value: v}
+}
+
+func (f OauthClient_AppLogoUrl_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OauthClient_AppLogoUrl_Field) _Column() string { return "app_logo_url" }
+
+type OauthCode struct {
+	ClientId        []byte
+	UserId          []byte
+	Scope           string
+	RedirectUrl     string
+	Challenge       string
+	ChallengeMethod string
+	Code            string
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+	ClaimedAt       *time.Time
+}
+
+func (OauthCode) _Table() string { return "oauth_codes" }
+
+type OauthCode_Create_Fields struct {
+	ClaimedAt OauthCode_ClaimedAt_Field
+}
+
+type OauthCode_Update_Fields struct {
+	ClaimedAt OauthCode_ClaimedAt_Field
+}
 
-type Node_Major_Field struct {
+type OauthCode_ClientId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Node_Major(v int64) Node_Major_Field {
-	return Node_Major_Field{_set: true, _value: v}
+func OauthCode_ClientId(v []byte) OauthCode_ClientId_Field {
+	return OauthCode_ClientId_Field{_set: true, _value: v}
 }
 
-func (f Node_Major_Field) value() interface{} {
+func (f OauthCode_ClientId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Major_Field) _Column() string { return "major" }
+func (OauthCode_ClientId_Field) _Column() string { return "client_id" }
 
-type Node_Minor_Field struct {
+type OauthCode_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Node_Minor(v int64) Node_Minor_Field {
-	return Node_Minor_Field{_set: true, _value: v}
+func OauthCode_UserId(v []byte) OauthCode_UserId_Field {
+	return OauthCode_UserId_Field{_set: true, _value: v}
 }
 
-func (f Node_Minor_Field) value() interface{} {
+func (f OauthCode_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Minor_Field) _Column() string { return "minor" }
+func (OauthCode_UserId_Field) _Column() string { return "user_id" }
 
-type Node_Patch_Field struct {
+type OauthCode_Scope_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Node_Patch(v int64) Node_Patch_Field {
-	return Node_Patch_Field{_set: true, _value: v}
+func OauthCode_Scope(v string) OauthCode_Scope_Field {
+	return OauthCode_Scope_Field{_set: true, _value: v}
 }
 
-func (f Node_Patch_Field) value() interface{} {
+func (f OauthCode_Scope_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Patch_Field) _Column() string { return "patch" }
+func (OauthCode_Scope_Field) _Column() string { return "scope" }
 
-type Node_Hash_Field struct {
+type OauthCode_RedirectUrl_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_Hash(v string) Node_Hash_Field {
-	return Node_Hash_Field{_set: true, _value: v}
+func OauthCode_RedirectUrl(v string) OauthCode_RedirectUrl_Field {
+	return OauthCode_RedirectUrl_Field{_set: true, _value: v}
 }
 
-func (f Node_Hash_Field) value() interface{} {
+func (f OauthCode_RedirectUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Hash_Field) _Column() string { return "hash" }
+func (OauthCode_RedirectUrl_Field) _Column() string { return "redirect_url" }
 
-type Node_Timestamp_Field struct {
+type OauthCode_Challenge_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func Node_Timestamp(v time.Time) Node_Timestamp_Field {
-	return Node_Timestamp_Field{_set: true, _value: v}
+func OauthCode_Challenge(v string) OauthCode_Challenge_Field {
+	return OauthCode_Challenge_Field{_set: true, _value: v}
 }
 
-func (f Node_Timestamp_Field) value() interface{} {
+func (f OauthCode_Challenge_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Timestamp_Field) _Column() string { return "timestamp" }
+func (OauthCode_Challenge_Field) _Column() string { return "challenge" }
 
-type Node_Release_Field struct {
+type OauthCode_ChallengeMethod_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value string
 }
 
-func Node_Release(v bool) Node_Release_Field {
-	return Node_Release_Field{_set: true, _value: v}
+func OauthCode_ChallengeMethod(v string) OauthCode_ChallengeMethod_Field {
+	return OauthCode_ChallengeMethod_Field{_set: true, _value: v}
 }
 
-func (f Node_Release_Field) value() interface{} {
+func (f OauthCode_ChallengeMethod_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Release_Field) _Column() string { return "release" }
+func (OauthCode_ChallengeMethod_Field) _Column() string { return "challenge_method" }
 
-type Node_Latency90_Field struct {
+type OauthCode_Code_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Node_Latency90(v int64) Node_Latency90_Field {
-	return Node_Latency90_Field{_set: true, _value: v}
+func OauthCode_Code(v string) OauthCode_Code_Field {
+	return OauthCode_Code_Field{_set: true, _value: v}
 }
 
-func (f Node_Latency90_Field) value() interface{} {
+func (f OauthCode_Code_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Latency90_Field) _Column() string { return "latency_90" }
+func (OauthCode_Code_Field) _Column() string { return "code" }
 
-type Node_VettedAt_Field struct {
+type OauthCode_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_VettedAt(v time.Time) Node_VettedAt_Field {
-	return Node_VettedAt_Field{_set: true, _value: &v}
-}
-
-func Node_VettedAt_Raw(v *time.Time) Node_VettedAt_Field {
-	if v == nil {
-		return Node_VettedAt_Null()
-	}
-	return Node_VettedAt(*v)
+	_value time.Time
 }
 
-func Node_VettedAt_Null() Node_VettedAt_Field {
-	return Node_VettedAt_Field{_set: true, _null: true}
+func OauthCode_CreatedAt(v time.Time) OauthCode_CreatedAt_Field {
+	return OauthCode_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_VettedAt_Field) value() interface{} {
+func (f OauthCode_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_VettedAt_Field) _Column() string { return "vetted_at" }
+func (OauthCode_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_CreatedAt_Field struct {
+type OauthCode_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
-	return Node_CreatedAt_Field{_set: true, _value: v}
+func OauthCode_ExpiresAt(v time.Time) OauthCode_ExpiresAt_Field {
+	return OauthCode_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f Node_CreatedAt_Field) value() interface{} {
+func (f OauthCode_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+func (OauthCode_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type Node_UpdatedAt_Field struct {
+type OauthCode_ClaimedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *time.Time
 }
 
-func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
-	return Node_UpdatedAt_Field{_set: true, _value: v}
+func OauthCode_ClaimedAt(v time.Time) OauthCode_ClaimedAt_Field {
+	return OauthCode_ClaimedAt_Field{_set: true, _value: &v}
 }
 
-func (f Node_UpdatedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func OauthCode_ClaimedAt_Raw(v *time.Time) OauthCode_ClaimedAt_Field {
+	if v == nil {
+		return OauthCode_ClaimedAt_Null()
 	}
-	return f._value
+	return OauthCode_ClaimedAt(*v)
 }
 
-func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
-
-type Node_LastContactSuccess_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func OauthCode_ClaimedAt_Null() OauthCode_ClaimedAt_Field {
+	return OauthCode_ClaimedAt_Field{_set: true, _null: true}
 }
 
-func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
-	return Node_LastContactSuccess_Field{_set: true, _value: v}
-}
+func (f OauthCode_ClaimedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Node_LastContactSuccess_Field) value() interface{} {
+func (f OauthCode_ClaimedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+func (OauthCode_ClaimedAt_Field) _Column() string { return "claimed_at" }
 
-type Node_LastContactFailure_Field struct {
+type OauthToken struct {
+	ClientId  []byte
+	UserId    []byte
+	Scope     string
+	Kind      int
+	Token     []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (OauthToken) _Table() string { return "oauth_tokens" }
+
+type OauthToken_Update_Fields struct {
+	ExpiresAt OauthToken_ExpiresAt_Field
+}
+
+type OauthToken_ClientId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
-	return Node_LastContactFailure_Field{_set: true, _value: v}
+func OauthToken_ClientId(v []byte) OauthToken_ClientId_Field {
+	return OauthToken_ClientId_Field{_set: true, _value: v}
 }
 
-func (f Node_LastContactFailure_Field) value() interface{} {
+func (f OauthToken_ClientId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
+func (OauthToken_ClientId_Field) _Column() string { return "client_id" }
 
-type Node_Disqualified_Field struct {
+type OauthToken_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_Disqualified(v time.Time) Node_Disqualified_Field {
-	return Node_Disqualified_Field{_set: true, _value: &v}
-}
-
-func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
-	if v == nil {
-		return Node_Disqualified_Null()
-	}
-	return Node_Disqualified(*v)
+	_value []byte
 }
 
-func Node_Disqualified_Null() Node_Disqualified_Field {
-	return Node_Disqualified_Field{_set: true, _null: true}
+func OauthToken_UserId(v []byte) OauthToken_UserId_Field {
+	return OauthToken_UserId_Field{_set: true, _value: v}
 }
 
-func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_Disqualified_Field) value() interface{} {
+func (f OauthToken_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Disqualified_Field) _Column() string { return "disqualified" }
+func (OauthToken_UserId_Field) _Column() string { return "user_id" }
 
-type Node_DisqualificationReason_Field struct {
+type OauthToken_Scope_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value string
 }
 
-func Node_DisqualificationReason(v int) Node_DisqualificationReason_Field {
-	return Node_DisqualificationReason_Field{_set: true, _value: &v}
+func OauthToken_Scope(v string) OauthToken_Scope_Field {
+	return OauthToken_Scope_Field{_set: true, _value: v}
 }
 
-func Node_DisqualificationReason_Raw(v *int) Node_DisqualificationReason_Field {
-	if v == nil {
-		return Node_DisqualificationReason_Null()
+func (f OauthToken_Scope_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_DisqualificationReason(*v)
+	return f._value
 }
 
-func Node_DisqualificationReason_Null() Node_DisqualificationReason_Field {
-	return Node_DisqualificationReason_Field{_set: true, _null: true}
+func (OauthToken_Scope_Field) _Column() string { return "scope" }
+
+type OauthToken_Kind_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f Node_DisqualificationReason_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func OauthToken_Kind(v int) OauthToken_Kind_Field {
+	return OauthToken_Kind_Field{_set: true, _value: v}
 }
 
-func (f Node_DisqualificationReason_Field) value() interface{} {
+func (f OauthToken_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
+func (OauthToken_Kind_Field) _Column() string { return "kind" }
 
-type Node_UnknownAuditSuspended_Field struct {
+type OauthToken_Token_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_UnknownAuditSuspended(v time.Time) Node_UnknownAuditSuspended_Field {
-	return Node_UnknownAuditSuspended_Field{_set: true, _value: &v}
-}
-
-func Node_UnknownAuditSuspended_Raw(v *time.Time) Node_UnknownAuditSuspended_Field {
-	if v == nil {
-		return Node_UnknownAuditSuspended_Null()
-	}
-	return Node_UnknownAuditSuspended(*v)
+	_value []byte
 }
 
-func Node_UnknownAuditSuspended_Null() Node_UnknownAuditSuspended_Field {
-	return Node_UnknownAuditSuspended_Field{_set: true, _null: true}
+func OauthToken_Token(v []byte) OauthToken_Token_Field {
+	return OauthToken_Token_Field{_set: true, _value: v}
 }
 
-func (f Node_UnknownAuditSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_UnknownAuditSuspended_Field) value() interface{} {
+func (f OauthToken_Token_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+func (OauthToken_Token_Field) _Column() string { return "token" }
 
-type Node_OfflineSuspended_Field struct {
+type OauthToken_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value time.Time
 }
 
-func Node_OfflineSuspended(v time.Time) Node_OfflineSuspended_Field {
-	return Node_OfflineSuspended_Field{_set: true, _value: &v}
+func OauthToken_CreatedAt(v time.Time) OauthToken_CreatedAt_Field {
+	return OauthToken_CreatedAt_Field{_set: true, _value: v}
 }
 
-func Node_OfflineSuspended_Raw(v *time.Time) Node_OfflineSuspended_Field {
-	if v == nil {
-		return Node_OfflineSuspended_Null()
+func (f OauthToken_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_OfflineSuspended(*v)
+	return f._value
 }
 
-func Node_OfflineSuspended_Null() Node_OfflineSuspended_Field {
-	return Node_OfflineSuspended_Field{_set: true, _null: true}
+func (OauthToken_CreatedAt_Field) _Column() string { return "created_at" }
+
+type OauthToken_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Node_OfflineSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func OauthToken_ExpiresAt(v time.Time) OauthToken_ExpiresAt_Field {
+	return OauthToken_ExpiresAt_Field{_set: true, _value: v}
+}
 
-func (f Node_OfflineSuspended_Field) value() interface{} {
+func (f OauthToken_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+func (OauthToken_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type Node_UnderReview_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type Offer struct {
+	Id                        int
+	Name                      string
+	Description               string
+	AwardCreditInCents        int
+	InviteeCreditInCents      int
+	AwardCreditDurationDays   *int
+	InviteeCreditDurationDays *int
+	RedeemableCap             *int
+	ExpiresAt                 time.Time
+	CreatedAt                 time.Time
+	Status                    int
+	Type                      int
 }
 
-func Node_UnderReview(v time.Time) Node_UnderReview_Field {
-	return Node_UnderReview_Field{_set: true, _value: &v}
+func (Offer) _Table() string { return "offers" }
+
+type Offer_Create_Fields struct {
+	AwardCreditInCents        Offer_AwardCreditInCents_Field
+	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
+	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
+	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
+	RedeemableCap             Offer_RedeemableCap_Field
 }
 
-func Node_UnderReview_Raw(v *time.Time) Node_UnderReview_Field {
-	if v == nil {
-		return Node_UnderReview_Null()
-	}
-	return Node_UnderReview(*v)
+type Offer_Update_Fields struct {
+	Name                      Offer_Name_Field
+	Description               Offer_Description_Field
+	AwardCreditInCents        Offer_AwardCreditInCents_Field
+	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
+	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
+	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
+	RedeemableCap             Offer_RedeemableCap_Field
+	ExpiresAt                 Offer_ExpiresAt_Field
+	Status                    Offer_Status_Field
+	Type                      Offer_Type_Field
 }
 
-func Node_UnderReview_Null() Node_UnderReview_Field {
-	return Node_UnderReview_Field{_set: true, _null: true}
+type Offer_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f Node_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func Offer_Id(v int) Offer_Id_Field {
+	return Offer_Id_Field{_set: true, _value: v}
+}
 
-func (f Node_UnderReview_Field) value() interface{} {
+func (f Offer_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UnderReview_Field) _Column() string { return "under_review" }
+func (Offer_Id_Field) _Column() string { return "id" }
 
-type Node_ExitInitiatedAt_Field struct {
+type Offer_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value string
 }
 
-func Node_ExitInitiatedAt(v time.Time) Node_ExitInitiatedAt_Field {
-	return Node_ExitInitiatedAt_Field{_set: true, _value: &v}
+func Offer_Name(v string) Offer_Name_Field {
+	return Offer_Name_Field{_set: true, _value: v}
 }
 
-func Node_ExitInitiatedAt_Raw(v *time.Time) Node_ExitInitiatedAt_Field {
-	if v == nil {
-		return Node_ExitInitiatedAt_Null()
+func (f Offer_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_ExitInitiatedAt(*v)
+	return f._value
 }
 
-func Node_ExitInitiatedAt_Null() Node_ExitInitiatedAt_Field {
-	return Node_ExitInitiatedAt_Field{_set: true, _null: true}
+func (Offer_Name_Field) _Column() string { return "name" }
+
+type Offer_Description_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Node_ExitInitiatedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func Offer_Description(v string) Offer_Description_Field {
+	return Offer_Description_Field{_set: true, _value: v}
+}
 
-func (f Node_ExitInitiatedAt_Field) value() interface{} {
+func (f Offer_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitInitiatedAt_Field) _Column() string { return "exit_initiated_at" }
+func (Offer_Description_Field) _Column() string { return "description" }
 
-type Node_ExitLoopCompletedAt_Field struct {
+type Offer_AwardCreditInCents_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value int
 }
 
-func Node_ExitLoopCompletedAt(v time.Time) Node_ExitLoopCompletedAt_Field {
-	return Node_ExitLoopCompletedAt_Field{_set: true, _value: &v}
+func Offer_AwardCreditInCents(v int) Offer_AwardCreditInCents_Field {
+	return Offer_AwardCreditInCents_Field{_set: true, _value: v}
 }
 
-func Node_ExitLoopCompletedAt_Raw(v *time.Time) Node_ExitLoopCompletedAt_Field {
-	if v == nil {
-		return Node_ExitLoopCompletedAt_Null()
+func (f Offer_AwardCreditInCents_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_ExitLoopCompletedAt(*v)
+	return f._value
 }
 
-func Node_ExitLoopCompletedAt_Null() Node_ExitLoopCompletedAt_Field {
-	return Node_ExitLoopCompletedAt_Field{_set: true, _null: true}
+func (Offer_AwardCreditInCents_Field) _Column() string { return "award_credit_in_cents" }
+
+type Offer_InviteeCreditInCents_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f Node_ExitLoopCompletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func Offer_InviteeCreditInCents(v int) Offer_InviteeCreditInCents_Field {
+	return Offer_InviteeCreditInCents_Field{_set: true, _value: v}
+}
 
-func (f Node_ExitLoopCompletedAt_Field) value() interface{} {
+func (f Offer_InviteeCreditInCents_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitLoopCompletedAt_Field) _Column() string { return "exit_loop_completed_at" }
+func (Offer_InviteeCreditInCents_Field) _Column() string { return "invitee_credit_in_cents" }
 
-type Node_ExitFinishedAt_Field struct {
+type Offer_AwardCreditDurationDays_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int
 }
 
-func Node_ExitFinishedAt(v time.Time) Node_ExitFinishedAt_Field {
-	return Node_ExitFinishedAt_Field{_set: true, _value: &v}
+func Offer_AwardCreditDurationDays(v int) Offer_AwardCreditDurationDays_Field {
+	return Offer_AwardCreditDurationDays_Field{_set: true, _value: &v}
 }
 
-func Node_ExitFinishedAt_Raw(v *time.Time) Node_ExitFinishedAt_Field {
+func Offer_AwardCreditDurationDays_Raw(v *int) Offer_AwardCreditDurationDays_Field {
 	if v == nil {
-		return Node_ExitFinishedAt_Null()
+		return Offer_AwardCreditDurationDays_Null()
 	}
-	return Node_ExitFinishedAt(*v)
+	return Offer_AwardCreditDurationDays(*v)
 }
 
-func Node_ExitFinishedAt_Null() Node_ExitFinishedAt_Field {
-	return Node_ExitFinishedAt_Field{_set: true, _null: true}
+func Offer_AwardCreditDurationDays_Null() Offer_AwardCreditDurationDays_Field {
+	return Offer_AwardCreditDurationDays_Field{_set: true, _null: true}
 }
 
-func (f Node_ExitFinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Offer_AwardCreditDurationDays_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Node_ExitFinishedAt_Field) value() interface{} {
+func (f Offer_AwardCreditDurationDays_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
+func (Offer_AwardCreditDurationDays_Field) _Column() string { return "award_credit_duration_days" }
 
-type Node_ExitSuccess_Field struct {
+type Offer_InviteeCreditDurationDays_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value *int
 }
 
-func Node_ExitSuccess(v bool) Node_ExitSuccess_Field {
-	return Node_ExitSuccess_Field{_set: true, _value: v}
+func Offer_InviteeCreditDurationDays(v int) Offer_InviteeCreditDurationDays_Field {
+	return Offer_InviteeCreditDurationDays_Field{_set: true, _value: &v}
 }
 
-func (f Node_ExitSuccess_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Offer_InviteeCreditDurationDays_Raw(v *int) Offer_InviteeCreditDurationDays_Field {
+	if v == nil {
+		return Offer_InviteeCreditDurationDays_Null()
 	}
-	return f._value
-}
-
-func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
-
-type NodeApiVersion struct {
-	Id         []byte
-	ApiVersion int
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-}
-
-func (NodeApiVersion) _Table() string { return "node_api_versions" }
-
-type NodeApiVersion_Update_Fields struct {
-	ApiVersion NodeApiVersion_ApiVersion_Field
+	return Offer_InviteeCreditDurationDays(*v)
 }
 
-type NodeApiVersion_Id_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Offer_InviteeCreditDurationDays_Null() Offer_InviteeCreditDurationDays_Field {
+	return Offer_InviteeCreditDurationDays_Field{_set: true, _null: true}
 }
 
-func NodeApiVersion_Id(v []byte) NodeApiVersion_Id_Field {
-	return NodeApiVersion_Id_Field{_set: true, _value: v}
+func (f Offer_InviteeCreditDurationDays_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f NodeApiVersion_Id_Field) value() interface{} {
+func (f Offer_InviteeCreditDurationDays_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_Id_Field) _Column() string { return "id" }
+func (Offer_InviteeCreditDurationDays_Field) _Column() string { return "invitee_credit_duration_days" }
 
-type NodeApiVersion_ApiVersion_Field struct {
+type Offer_RedeemableCap_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *int
 }
 
-func NodeApiVersion_ApiVersion(v int) NodeApiVersion_ApiVersion_Field {
-	return NodeApiVersion_ApiVersion_Field{_set: true, _value: v}
+func Offer_RedeemableCap(v int) Offer_RedeemableCap_Field {
+	return Offer_RedeemableCap_Field{_set: true, _value: &v}
 }
 
-func (f NodeApiVersion_ApiVersion_Field) value() interface{} {
+func Offer_RedeemableCap_Raw(v *int) Offer_RedeemableCap_Field {
+	if v == nil {
+		return Offer_RedeemableCap_Null()
+	}
+	return Offer_RedeemableCap(*v)
+}
+
+func Offer_RedeemableCap_Null() Offer_RedeemableCap_Field {
+	return Offer_RedeemableCap_Field{_set: true, _null: true}
+}
+
+func (f Offer_RedeemableCap_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Offer_RedeemableCap_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_ApiVersion_Field) _Column() string { return "api_version" }
+func (Offer_RedeemableCap_Field) _Column() string { return "redeemable_cap" }
 
-type NodeApiVersion_CreatedAt_Field struct {
+type Offer_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func NodeApiVersion_CreatedAt(v time.Time) NodeApiVersion_CreatedAt_Field {
-	return NodeApiVersion_CreatedAt_Field{_set: true, _value: v}
+func Offer_ExpiresAt(v time.Time) Offer_ExpiresAt_Field {
+	return Offer_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_CreatedAt_Field) value() interface{} {
+func (f Offer_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_CreatedAt_Field) _Column() string { return "created_at" }
+func (Offer_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type NodeApiVersion_UpdatedAt_Field struct {
+type Offer_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func NodeApiVersion_UpdatedAt(v time.Time) NodeApiVersion_UpdatedAt_Field {
-	return NodeApiVersion_UpdatedAt_Field{_set: true, _value: v}
+func Offer_CreatedAt(v time.Time) Offer_CreatedAt_Field {
+	return Offer_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
+func (f Offer_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
-
-type OauthClient struct {
-	Id              []byte
-	EncryptedSecret []byte
-	RedirectUrl     string
-	UserId          []byte
-	AppName         string
-	AppLogoUrl      string
-}
-
-func (OauthClient) _Table() string { return "oauth_clients" }
-
-type OauthClient_Update_Fields struct {
-	EncryptedSecret OauthClient_EncryptedSecret_Field
-	RedirectUrl     OauthClient_RedirectUrl_Field
-	AppName         OauthClient_AppName_Field
-	AppLogoUrl      OauthClient_AppLogoUrl_Field
-}
+func (Offer_CreatedAt_Field) _Column() string { return "created_at" }
 
-type OauthClient_Id_Field struct {
+type Offer_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func OauthClient_Id(v []byte) OauthClient_Id_Field {
-	return OauthClient_Id_Field{_set: true, _value: v}
+func Offer_Status(v int) Offer_Status_Field {
+	return Offer_Status_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_Id_Field) value() interface{} {
+func (f Offer_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_Id_Field) _Column() string { return "id" }
+func (Offer_Status_Field) _Column() string { return "status" }
 
-type OauthClient_EncryptedSecret_Field struct {
+type Offer_Type_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func OauthClient_EncryptedSecret(v []byte) OauthClient_EncryptedSecret_Field {
-	return OauthClient_EncryptedSecret_Field{_set: true, _value: v}
+func Offer_Type(v int) Offer_Type_Field {
+	return Offer_Type_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_EncryptedSecret_Field) value() interface{} {
+func (f Offer_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_EncryptedSecret_Field) _Column() string { return "encrypted_secret" }
+func (Offer_Type_Field) _Column() string { return "type" }
 
-type OauthClient_RedirectUrl_Field struct {
+type PeerIdentity struct {
+	NodeId           []byte
+	LeafSerialNumber []byte
+	Chain            []byte
+	UpdatedAt        time.Time
+}
+
+func (PeerIdentity) _Table() string { return "peer_identities" }
+
+type PeerIdentity_Update_Fields struct {
+	LeafSerialNumber PeerIdentity_LeafSerialNumber_Field
+	Chain            PeerIdentity_Chain_Field
+}
+
+type PeerIdentity_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func OauthClient_RedirectUrl(v string) OauthClient_RedirectUrl_Field {
-	return OauthClient_RedirectUrl_Field{_set: true, _value: v}
+func PeerIdentity_NodeId(v []byte) PeerIdentity_NodeId_Field {
+	return PeerIdentity_NodeId_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_RedirectUrl_Field) value() interface{} {
+func (f PeerIdentity_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_RedirectUrl_Field) _Column() string { return "redirect_url" }
+func (PeerIdentity_NodeId_Field) _Column() string { return "node_id" }
 
-type OauthClient_UserId_Field struct {
+type PeerIdentity_LeafSerialNumber_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthClient_UserId(v []byte) OauthClient_UserId_Field {
-	return OauthClient_UserId_Field{_set: true, _value: v}
+func PeerIdentity_LeafSerialNumber(v []byte) PeerIdentity_LeafSerialNumber_Field {
+	return PeerIdentity_LeafSerialNumber_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_UserId_Field) value() interface{} {
+func (f PeerIdentity_LeafSerialNumber_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_UserId_Field) _Column() string { return "user_id" }
+func (PeerIdentity_LeafSerialNumber_Field) _Column() string { return "leaf_serial_number" }
 
-type OauthClient_AppName_Field struct {
+type PeerIdentity_Chain_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func OauthClient_AppName(v string) OauthClient_AppName_Field {
-	return OauthClient_AppName_Field{_set: true, _value: v}
+func PeerIdentity_Chain(v []byte) PeerIdentity_Chain_Field {
+	return PeerIdentity_Chain_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_AppName_Field) value() interface{} {
+func (f PeerIdentity_Chain_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_AppName_Field) _Column() string { return "app_name" }
+func (PeerIdentity_Chain_Field) _Column() string { return "chain" }
 
-type OauthClient_AppLogoUrl_Field struct {
+type PeerIdentity_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func OauthClient_AppLogoUrl(v string) OauthClient_AppLogoUrl_Field {
-	return OauthClient_AppLogoUrl_Field{_set: true, _value: v}
+func PeerIdentity_UpdatedAt(v time.Time) PeerIdentity_UpdatedAt_Field {
+	return PeerIdentity_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_AppLogoUrl_Field) value() interface{} {
+func (f PeerIdentity_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_AppLogoUrl_Field) _Column() string { return "app_logo_url" }
+func (PeerIdentity_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type OauthCode struct {
-	ClientId        []byte
-	UserId          []byte
-	Scope           string
-	RedirectUrl     string
-	Challenge       string
-	ChallengeMethod string
-	Code            string
-	CreatedAt       time.Time
-	ExpiresAt       time.Time
-	ClaimedAt       *time.Time
+type Project struct {
+	Id             []byte
+	PublicId       []byte
+	Name           string
+	Description    string
+	UsageLimit     *int64
+	BandwidthLimit *int64
+	SegmentLimit   *int64
+	RateLimit      *int
+	BurstLimit     *int
+	MaxBuckets     *int
+	PartnerId      []byte
+	UserAgent      []byte
+	OwnerId        []byte
+	CreatedAt      time.Time
 }
 
-func (OauthCode) _Table() string { return "oauth_codes" }
+func (Project) _Table() string { return "projects" }
 
-type OauthCode_Create_Fields struct {
-	ClaimedAt OauthCode_ClaimedAt_Field
+type Project_Create_Fields struct {
+	PublicId       Project_PublicId_Field
+	UsageLimit     Project_UsageLimit_Field
+	BandwidthLimit Project_BandwidthLimit_Field
+	SegmentLimit   Project_SegmentLimit_Field
+	RateLimit      Project_RateLimit_Field
+	BurstLimit     Project_BurstLimit_Field
+	MaxBuckets     Project_MaxBuckets_Field
+	PartnerId      Project_PartnerId_Field
+	UserAgent      Project_UserAgent_Field
 }
 
-type OauthCode_Update_Fields struct {
-	ClaimedAt OauthCode_ClaimedAt_Field
+type Project_Update_Fields struct {
+	Name           Project_Name_Field
+	Description    Project_Description_Field
+	UsageLimit     Project_UsageLimit_Field
+	BandwidthLimit Project_BandwidthLimit_Field
+	SegmentLimit   Project_SegmentLimit_Field
+	RateLimit      Project_RateLimit_Field
+	BurstLimit     Project_BurstLimit_Field
+	MaxBuckets     Project_MaxBuckets_Field
 }
 
-type OauthCode_ClientId_Field struct {
+type Project_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthCode_ClientId(v []byte) OauthCode_ClientId_Field {
-	return OauthCode_ClientId_Field{_set: true, _value: v}
+func Project_Id(v []byte) Project_Id_Field {
+	return Project_Id_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_ClientId_Field) value() interface{} {
+func (f Project_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ClientId_Field) _Column() string { return "client_id" }
+func (Project_Id_Field) _Column() string { return "id" }
 
-type OauthCode_UserId_Field struct {
+type Project_PublicId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthCode_UserId(v []byte) OauthCode_UserId_Field {
-	return OauthCode_UserId_Field{_set: true, _value: v}
+func Project_PublicId(v []byte) Project_PublicId_Field {
+	return Project_PublicId_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_UserId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_PublicId_Raw(v []byte) Project_PublicId_Field {
+	if v == nil {
+		return Project_PublicId_Null()
 	}
-	return f._value
+	return Project_PublicId(v)
 }
 
-func (OauthCode_UserId_Field) _Column() string { return "user_id" }
-
-type OauthCode_Scope_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func Project_PublicId_Null() Project_PublicId_Field {
+	return Project_PublicId_Field{_set: true, _null: true}
 }
 
-func OauthCode_Scope(v string) OauthCode_Scope_Field {
-	return OauthCode_Scope_Field{_set: true, _value: v}
-}
+func (f Project_PublicId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthCode_Scope_Field) value() interface{} {
+func (f Project_PublicId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Scope_Field) _Column() string { return "scope" }
+func (Project_PublicId_Field) _Column() string { return "public_id" }
 
-type OauthCode_RedirectUrl_Field struct {
+type Project_Name_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func OauthCode_RedirectUrl(v string) OauthCode_RedirectUrl_Field {
-	return OauthCode_RedirectUrl_Field{_set: true, _value: v}
+func Project_Name(v string) Project_Name_Field {
+	return Project_Name_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_RedirectUrl_Field) value() interface{} {
+func (f Project_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_RedirectUrl_Field) _Column() string { return "redirect_url" }
+func (Project_Name_Field) _Column() string { return "name" }
 
-type OauthCode_Challenge_Field struct {
+type Project_Description_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func OauthCode_Challenge(v string) OauthCode_Challenge_Field {
-	return OauthCode_Challenge_Field{_set: true, _value: v}
+func Project_Description(v string) Project_Description_Field {
+	return Project_Description_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_Challenge_Field) value() interface{} {
+func (f Project_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Challenge_Field) _Column() string { return "challenge" }
+func (Project_Description_Field) _Column() string { return "description" }
 
-type OauthCode_ChallengeMethod_Field struct {
+type Project_UsageLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *int64
 }
 
-func OauthCode_ChallengeMethod(v string) OauthCode_ChallengeMethod_Field {
-	return OauthCode_ChallengeMethod_Field{_set: true, _value: v}
+func Project_UsageLimit(v int64) Project_UsageLimit_Field {
+	return Project_UsageLimit_Field{_set: true, _value: &v}
 }
 
-func (f OauthCode_ChallengeMethod_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_UsageLimit_Raw(v *int64) Project_UsageLimit_Field {
+	if v == nil {
+		return Project_UsageLimit_Null()
 	}
-	return f._value
-}
-
-func (OauthCode_ChallengeMethod_Field) _Column() string { return "challenge_method" }
-
-type OauthCode_Code_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+	return Project_UsageLimit(*v)
 }
 
-func OauthCode_Code(v string) OauthCode_Code_Field {
-	return OauthCode_Code_Field{_set: true, _value: v}
+func Project_UsageLimit_Null() Project_UsageLimit_Field {
+	return Project_UsageLimit_Field{_set: true, _null: true}
 }
 
-func (f OauthCode_Code_Field) value() interface{} {
+func (f Project_UsageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_UsageLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Code_Field) _Column() string { return "code" }
+func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
 
-type OauthCode_CreatedAt_Field struct {
+type Project_BandwidthLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *int64
 }
 
-func OauthCode_CreatedAt(v time.Time) OauthCode_CreatedAt_Field {
-	return OauthCode_CreatedAt_Field{_set: true, _value: v}
+func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _value: &v}
 }
 
-func (f OauthCode_CreatedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_BandwidthLimit_Raw(v *int64) Project_BandwidthLimit_Field {
+	if v == nil {
+		return Project_BandwidthLimit_Null()
 	}
-	return f._value
+	return Project_BandwidthLimit(*v)
 }
 
-func (OauthCode_CreatedAt_Field) _Column() string { return "created_at" }
-
-type OauthCode_ExpiresAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func Project_BandwidthLimit_Null() Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _null: true}
 }
 
-func OauthCode_ExpiresAt(v time.Time) OauthCode_ExpiresAt_Field {
-	return OauthCode_ExpiresAt_Field{_set: true, _value: v}
-}
+func (f Project_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthCode_ExpiresAt_Field) value() interface{} {
+func (f Project_BandwidthLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
 
-type OauthCode_ClaimedAt_Field struct {
+type Project_SegmentLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int64
 }
 
-func OauthCode_ClaimedAt(v time.Time) OauthCode_ClaimedAt_Field {
-	return OauthCode_ClaimedAt_Field{_set: true, _value: &v}
+func Project_SegmentLimit(v int64) Project_SegmentLimit_Field {
+	return Project_SegmentLimit_Field{_set: true, _value: &v}
 }
 
-func OauthCode_ClaimedAt_Raw(v *time.Time) OauthCode_ClaimedAt_Field {
+func Project_SegmentLimit_Raw(v *int64) Project_SegmentLimit_Field {
 	if v == nil {
-		return OauthCode_ClaimedAt_Null()
+		return Project_SegmentLimit_Null()
 	}
-	return OauthCode_ClaimedAt(*v)
+	return Project_SegmentLimit(*v)
 }
 
-func OauthCode_ClaimedAt_Null() OauthCode_ClaimedAt_Field {
-	return OauthCode_ClaimedAt_Field{_set: true, _null: true}
+func Project_SegmentLimit_Null() Project_SegmentLimit_Field {
+	return Project_SegmentLimit_Field{_set: true, _null: true}
 }
 
-func (f OauthCode_ClaimedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Project_SegmentLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthCode_ClaimedAt_Field) value() interface{} {
+func (f Project_SegmentLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ClaimedAt_Field) _Column() string { return "claimed_at" }
+func (Project_SegmentLimit_Field) _Column() string { return "segment_limit" }
 
-type OauthToken struct {
-	ClientId  []byte
-	UserId    []byte
-	Scope     string
-	Kind      int
-	Token     []byte
-	CreatedAt time.Time
-	ExpiresAt time.Time
+type Project_RateLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
 }
 
-func (OauthToken) _Table() string { return "oauth_tokens" }
-
-type OauthToken_Update_Fields struct {
-	ExpiresAt OauthToken_ExpiresAt_Field
+func Project_RateLimit(v int) Project_RateLimit_Field {
+	return Project_RateLimit_Field{_set: true, _value: &v}
 }
 
-type OauthToken_ClientId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Project_RateLimit_Raw(v *int) Project_RateLimit_Field {
+	if v == nil {
+		return Project_RateLimit_Null()
+	}
+	return Project_RateLimit(*v)
 }
 
-func OauthToken_ClientId(v []byte) OauthToken_ClientId_Field {
-	return OauthToken_ClientId_Field{_set: true, _value: v}
+func Project_RateLimit_Null() Project_RateLimit_Field {
+	return Project_RateLimit_Field{_set: true, _null: true}
 }
 
-func (f OauthToken_ClientId_Field) value() interface{} {
+func (f Project_RateLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_RateLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_ClientId_Field) _Column() string { return "client_id" }
+func (Project_RateLimit_Field) _Column() string { return "rate_limit" }
 
-type OauthToken_UserId_Field struct {
+type Project_BurstLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int
 }
 
-func OauthToken_UserId(v []byte) OauthToken_UserId_Field {
-	return OauthToken_UserId_Field{_set: true, _value: v}
+func Project_BurstLimit(v int) Project_BurstLimit_Field {
+	return Project_BurstLimit_Field{_set: true, _value: &v}
 }
 
-func (f OauthToken_UserId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_BurstLimit_Raw(v *int) Project_BurstLimit_Field {
+	if v == nil {
+		return Project_BurstLimit_Null()
 	}
-	return f._value
+	return Project_BurstLimit(*v)
 }
 
-func (OauthToken_UserId_Field) _Column() string { return "user_id" }
-
-type OauthToken_Scope_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func Project_BurstLimit_Null() Project_BurstLimit_Field {
+	return Project_BurstLimit_Field{_set: true, _null: true}
 }
 
-func OauthToken_Scope(v string) OauthToken_Scope_Field {
-	return OauthToken_Scope_Field{_set: true, _value: v}
-}
+func (f Project_BurstLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthToken_Scope_Field) value() interface{} {
+func (f Project_BurstLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Scope_Field) _Column() string { return "scope" }
+func (Project_BurstLimit_Field) _Column() string { return "burst_limit" }
 
-type OauthToken_Kind_Field struct {
+type Project_MaxBuckets_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *int
 }
 
-func OauthToken_Kind(v int) OauthToken_Kind_Field {
-	return OauthToken_Kind_Field{_set: true, _value: v}
+func Project_MaxBuckets(v int) Project_MaxBuckets_Field {
+	return Project_MaxBuckets_Field{_set: true, _value: &v}
 }
 
-func (f OauthToken_Kind_Field) value() interface{} {
+func Project_MaxBuckets_Raw(v *int) Project_MaxBuckets_Field {
+	if v == nil {
+		return Project_MaxBuckets_Null()
+	}
+	return Project_MaxBuckets(*v)
+}
+
+func Project_MaxBuckets_Null() Project_MaxBuckets_Field {
+	return Project_MaxBuckets_Field{_set: true, _null: true}
+}
+
+func (f Project_MaxBuckets_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_MaxBuckets_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Kind_Field) _Column() string { return "kind" }
+func (Project_MaxBuckets_Field) _Column() string { return "max_buckets" }
 
-type OauthToken_Token_Field struct {
+type Project_PartnerId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthToken_Token(v []byte) OauthToken_Token_Field {
-	return OauthToken_Token_Field{_set: true, _value: v}
+func Project_PartnerId(v []byte) Project_PartnerId_Field {
+	return Project_PartnerId_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_Token_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_PartnerId_Raw(v []byte) Project_PartnerId_Field {
+	if v == nil {
+		return Project_PartnerId_Null()
 	}
-	return f._value
+	return Project_PartnerId(v)
 }
 
-func (OauthToken_Token_Field) _Column() string { return "token" }
-
-type OauthToken_CreatedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func Project_PartnerId_Null() Project_PartnerId_Field {
+	return Project_PartnerId_Field{_set: true, _null: true}
 }
 
-func OauthToken_CreatedAt(v time.Time) OauthToken_CreatedAt_Field {
-	return OauthToken_CreatedAt_Field{_set: true, _value: v}
-}
+func (f Project_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthToken_CreatedAt_Field) value() interface{} {
+func (f Project_PartnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_CreatedAt_Field) _Column() string { return "created_at" }
+func (Project_PartnerId_Field) _Column() string { return "partner_id" }
 
-type OauthToken_ExpiresAt_Field struct {
+type Project_UserAgent_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func OauthToken_ExpiresAt(v time.Time) OauthToken_ExpiresAt_Field {
-	return OauthToken_ExpiresAt_Field{_set: true, _value: v}
+func Project_UserAgent(v []byte) Project_UserAgent_Field {
+	return Project_UserAgent_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_ExpiresAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_UserAgent_Raw(v []byte) Project_UserAgent_Field {
+	if v == nil {
+		return Project_UserAgent_Null()
 	}
-	return f._value
+	return Project_UserAgent(v)
 }
 
-func (OauthToken_ExpiresAt_Field) _Column() string { return "expires_at" }
-
-type Offer struct {
-	Id                        int
-	Name                      string
-	Description               string
-	AwardCreditInCents        int
-	InviteeCreditInCents      int
-	AwardCreditDurationDays   *int
-	InviteeCreditDurationDays *int
-	RedeemableCap             *int
-	ExpiresAt                 time.Time
-	CreatedAt                 time.Time
-	Status                    int
-	Type                      int
+func Project_UserAgent_Null() Project_UserAgent_Field {
+	return Project_UserAgent_Field{_set: true, _null: true}
 }
 
-func (Offer) _Table() string { return "offers" }
+func (f Project_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-type Offer_Create_Fields struct {
-	AwardCreditInCents        Offer_AwardCreditInCents_Field
-	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
-	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
-	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
-	RedeemableCap             Offer_RedeemableCap_Field
+func (f Project_UserAgent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Offer_Update_Fields struct {
-	Name                      Offer_Name_Field
-	Description               Offer_Description_Field
-	AwardCreditInCents        Offer_AwardCreditInCents_Field
-	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
-	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
-	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
-	RedeemableCap             Offer_RedeemableCap_Field
-	ExpiresAt                 Offer_ExpiresAt_Field
-	Status                    Offer_Status_Field
-	Type                      Offer_Type_Field
-}
+func (Project_UserAgent_Field) _Column() string { return "user_agent" }
 
-type Offer_Id_Field struct {
+type Project_OwnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Offer_Id(v int) Offer_Id_Field {
-	return Offer_Id_Field{_set: true, _value: v}
+func Project_OwnerId(v []byte) Project_OwnerId_Field {
+	return Project_OwnerId_Field{_set: true, _value: v}
 }
 
-func (f Offer_Id_Field) value() interface{} {
+func (f Project_OwnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Id_Field) _Column() string { return "id" }
+func (Project_OwnerId_Field) _Column() string { return "owner_id" }
 
-type Offer_Name_Field struct {
+type Project_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Offer_Name(v string) Offer_Name_Field {
-	return Offer_Name_Field{_set: true, _value: v}
+func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
+	return Project_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Offer_Name_Field) value() interface{} {
+func (f Project_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Name_Field) _Column() string { return "name" }
+func (Project_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Offer_Description_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+type ProjectActivity struct {
+	Id        []byte
+	ProjectId []byte
+	ActorId   []byte
+	Kind      string
+	Details   string
+	CreatedAt time.Time
 }
 
-func Offer_Description(v string) Offer_Description_Field {
-	return Offer_Description_Field{_set: true, _value: v}
-}
+func (ProjectActivity) _Table() string { return "project_activities" }
 
-func (f Offer_Description_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type ProjectActivity_Create_Fields struct {
+	ActorId ProjectActivity_ActorId_Field
 }
 
-func (Offer_Description_Field) _Column() string { return "description" }
+type ProjectActivity_Update_Fields struct {
+}
 
-type Offer_AwardCreditInCents_Field struct {
+type ProjectActivity_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Offer_AwardCreditInCents(v int) Offer_AwardCreditInCents_Field {
-	return Offer_AwardCreditInCents_Field{_set: true, _value: v}
+func ProjectActivity_Id(v []byte) ProjectActivity_Id_Field {
+	return ProjectActivity_Id_Field{_set: true, _value: v}
 }
 
-func (f Offer_AwardCreditInCents_Field) value() interface{} {
+func (f ProjectActivity_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_AwardCreditInCents_Field) _Column() string { return "award_credit_in_cents" }
+func (ProjectActivity_Id_Field) _Column() string { return "id" }
 
-type Offer_InviteeCreditInCents_Field struct {
+type ProjectActivity_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Offer_InviteeCreditInCents(v int) Offer_InviteeCreditInCents_Field {
-	return Offer_InviteeCreditInCents_Field{_set: true, _value: v}
+func ProjectActivity_ProjectId(v []byte) ProjectActivity_ProjectId_Field {
+	return ProjectActivity_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Offer_InviteeCreditInCents_Field) value() interface{} {
+func (f ProjectActivity_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_InviteeCreditInCents_Field) _Column() string { return "invitee_credit_in_cents" }
+func (ProjectActivity_ProjectId_Field) _Column() string { return "project_id" }
 
-type Offer_AwardCreditDurationDays_Field struct {
+type ProjectActivity_ActorId_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value []byte
 }
 
-func Offer_AwardCreditDurationDays(v int) Offer_AwardCreditDurationDays_Field {
-	return Offer_AwardCreditDurationDays_Field{_set: true, _value: &v}
+func ProjectActivity_ActorId(v []byte) ProjectActivity_ActorId_Field {
+	return ProjectActivity_ActorId_Field{_set: true, _value: v}
 }
 
-func Offer_AwardCreditDurationDays_Raw(v *int) Offer_AwardCreditDurationDays_Field {
+func ProjectActivity_ActorId_Raw(v []byte) ProjectActivity_ActorId_Field {
 	if v == nil {
-		return Offer_AwardCreditDurationDays_Null()
+		return ProjectActivity_ActorId_Null()
 	}
-	return Offer_AwardCreditDurationDays(*v)
+	return ProjectActivity_ActorId(v)
 }
 
-func Offer_AwardCreditDurationDays_Null() Offer_AwardCreditDurationDays_Field {
-	return Offer_AwardCreditDurationDays_Field{_set: true, _null: true}
+func ProjectActivity_ActorId_Null() ProjectActivity_ActorId_Field {
+	return ProjectActivity_ActorId_Field{_set: true, _null: true}
 }
 
-func (f Offer_AwardCreditDurationDays_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f ProjectActivity_ActorId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Offer_AwardCreditDurationDays_Field) value() interface{} {
+func (f ProjectActivity_ActorId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_AwardCreditDurationDays_Field) _Column() string { return "award_credit_duration_days" }
+func (ProjectActivity_ActorId_Field) _Column() string { return "actor_id" }
 
-type Offer_InviteeCreditDurationDays_Field struct {
+type ProjectActivity_Kind_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value string
 }
 
-func Offer_InviteeCreditDurationDays(v int) Offer_InviteeCreditDurationDays_Field {
-	return Offer_InviteeCreditDurationDays_Field{_set: true, _value: &v}
+func ProjectActivity_Kind(v string) ProjectActivity_Kind_Field {
+	return ProjectActivity_Kind_Field{_set: true, _value: v}
 }
 
-func Offer_InviteeCreditDurationDays_Raw(v *int) Offer_InviteeCreditDurationDays_Field {
-	if v == nil {
-		return Offer_InviteeCreditDurationDays_Null()
+func (f ProjectActivity_Kind_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Offer_InviteeCreditDurationDays(*v)
+	return f._value
 }
 
-func Offer_InviteeCreditDurationDays_Null() Offer_InviteeCreditDurationDays_Field {
-	return Offer_InviteeCreditDurationDays_Field{_set: true, _null: true}
+func (ProjectActivity_Kind_Field) _Column() string { return "kind" }
+
+type ProjectActivity_Details_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Offer_InviteeCreditDurationDays_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func ProjectActivity_Details(v string) ProjectActivity_Details_Field {
+	return ProjectActivity_Details_Field{_set: true, _value: v}
 }
 
-func (f Offer_InviteeCreditDurationDays_Field) value() interface{} {
+func (f ProjectActivity_Details_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_InviteeCreditDurationDays_Field) _Column() string { return "invitee_credit_duration_days" }
+func (ProjectActivity_Details_Field) _Column() string { return "details" }
 
-type Offer_RedeemableCap_Field struct {
+type ProjectActivity_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value time.Time
 }
 
-func Offer_RedeemableCap(v int) Offer_RedeemableCap_Field {
-	return Offer_RedeemableCap_Field{_set: true, _value: &v}
+func ProjectActivity_CreatedAt(v time.Time) ProjectActivity_CreatedAt_Field {
+	return ProjectActivity_CreatedAt_Field{_set: true, _value: v}
 }
 
-func Offer_RedeemableCap_Raw(v *int) Offer_RedeemableCap_Field {
-	if v == nil {
-		return Offer_RedeemableCap_Null()
+func (f ProjectActivity_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Offer_RedeemableCap(*v)
+	return f._value
 }
 
-func Offer_RedeemableCap_Null() Offer_RedeemableCap_Field {
-	return Offer_RedeemableCap_Field{_set: true, _null: true}
+func (ProjectActivity_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectBandwidthDailyRollup struct {
+	ProjectId       []byte
+	IntervalDay     time.Time
+	EgressAllocated uint64
+	EgressSettled   uint64
+	EgressDead      uint64
 }
 
-func (f Offer_RedeemableCap_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (ProjectBandwidthDailyRollup) _Table() string { return "project_bandwidth_daily_rollups" }
 
-func (f Offer_RedeemableCap_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type ProjectBandwidthDailyRollup_Create_Fields struct {
+	EgressDead ProjectBandwidthDailyRollup_EgressDead_Field
 }
 
-func (Offer_RedeemableCap_Field) _Column() string { return "redeemable_cap" }
+type ProjectBandwidthDailyRollup_Update_Fields struct {
+	EgressAllocated ProjectBandwidthDailyRollup_EgressAllocated_Field
+	EgressSettled   ProjectBandwidthDailyRollup_EgressSettled_Field
+	EgressDead      ProjectBandwidthDailyRollup_EgressDead_Field
+}
 
-type Offer_ExpiresAt_Field struct {
+type ProjectBandwidthDailyRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Offer_ExpiresAt(v time.Time) Offer_ExpiresAt_Field {
-	return Offer_ExpiresAt_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_ProjectId(v []byte) ProjectBandwidthDailyRollup_ProjectId_Field {
+	return ProjectBandwidthDailyRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Offer_ExpiresAt_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (ProjectBandwidthDailyRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type Offer_CreatedAt_Field struct {
+type ProjectBandwidthDailyRollup_IntervalDay_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Offer_CreatedAt(v time.Time) Offer_CreatedAt_Field {
-	return Offer_CreatedAt_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_IntervalDay(v time.Time) ProjectBandwidthDailyRollup_IntervalDay_Field {
+	v = toDate(v)
+	return ProjectBandwidthDailyRollup_IntervalDay_Field{_set: true, _value: v}
 }
 
-func (f Offer_CreatedAt_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_IntervalDay_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_CreatedAt_Field) _Column() string { return "created_at" }
+func (ProjectBandwidthDailyRollup_IntervalDay_Field) _Column() string { return "interval_day" }
 
-type Offer_Status_Field struct {
+type ProjectBandwidthDailyRollup_EgressAllocated_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func Offer_Status(v int) Offer_Status_Field {
-	return Offer_Status_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_EgressAllocated(v uint64) ProjectBandwidthDailyRollup_EgressAllocated_Field {
+	return ProjectBandwidthDailyRollup_EgressAllocated_Field{_set: true, _value: v}
 }
 
-func (f Offer_Status_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_EgressAllocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Status_Field) _Column() string { return "status" }
+func (ProjectBandwidthDailyRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
 
-type Offer_Type_Field struct {
+type ProjectBandwidthDailyRollup_EgressSettled_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func Offer_Type(v int) Offer_Type_Field {
-	return Offer_Type_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_EgressSettled(v uint64) ProjectBandwidthDailyRollup_EgressSettled_Field {
+	return ProjectBandwidthDailyRollup_EgressSettled_Field{_set: true, _value: v}
 }
 
-func (f Offer_Type_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_EgressSettled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Type_Field) _Column() string { return "type" }
-
-type PeerIdentity struct {
-	NodeId           []byte
-	LeafSerialNumber []byte
-	Chain            []byte
-	UpdatedAt        time.Time
-}
-
-func (PeerIdentity) _Table() string { return "peer_identities" }
-
-type PeerIdentity_Update_Fields struct {
-	LeafSerialNumber PeerIdentity_LeafSerialNumber_Field
-	Chain            PeerIdentity_Chain_Field
-}
+func (ProjectBandwidthDailyRollup_EgressSettled_Field) _Column() string { return "egress_settled" }
 
-type PeerIdentity_NodeId_Field struct {
+type ProjectBandwidthDailyRollup_EgressDead_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func PeerIdentity_NodeId(v []byte) PeerIdentity_NodeId_Field {
-	return PeerIdentity_NodeId_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_EgressDead(v uint64) ProjectBandwidthDailyRollup_EgressDead_Field {
+	return ProjectBandwidthDailyRollup_EgressDead_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_NodeId_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_EgressDead_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_NodeId_Field) _Column() string { return "node_id" }
+func (ProjectBandwidthDailyRollup_EgressDead_Field) _Column() string { return "egress_dead" }
 
-type PeerIdentity_LeafSerialNumber_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+type ProjectBandwidthRollup struct {
+	ProjectId       []byte
+	IntervalMonth   time.Time
+	EgressAllocated uint64
 }
 
-func PeerIdentity_LeafSerialNumber(v []byte) PeerIdentity_LeafSerialNumber_Field {
-	return PeerIdentity_LeafSerialNumber_Field{_set: true, _value: v}
-}
+func (ProjectBandwidthRollup) _Table() string { return "project_bandwidth_rollups" }
 
-func (f PeerIdentity_LeafSerialNumber_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type ProjectBandwidthRollup_Update_Fields struct {
+	EgressAllocated ProjectBandwidthRollup_EgressAllocated_Field
 }
 
-func (PeerIdentity_LeafSerialNumber_Field) _Column() string { return "leaf_serial_number" }
-
-type PeerIdentity_Chain_Field struct {
+type ProjectBandwidthRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func PeerIdentity_Chain(v []byte) PeerIdentity_Chain_Field {
-	return PeerIdentity_Chain_Field{_set: true, _value: v}
+func ProjectBandwidthRollup_ProjectId(v []byte) ProjectBandwidthRollup_ProjectId_Field {
+	return ProjectBandwidthRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_Chain_Field) value() interface{} {
+func (f ProjectBandwidthRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_Chain_Field) _Column() string { return "chain" }
+func (ProjectBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type PeerIdentity_UpdatedAt_Field struct {
+type ProjectBandwidthRollup_IntervalMonth_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func PeerIdentity_UpdatedAt(v time.Time) PeerIdentity_UpdatedAt_Field {
-	return PeerIdentity_UpdatedAt_Field{_set: true, _value: v}
+func ProjectBandwidthRollup_IntervalMonth(v time.Time) ProjectBandwidthRollup_IntervalMonth_Field {
+	v = toDate(v)
+	return ProjectBandwidthRollup_IntervalMonth_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_UpdatedAt_Field) value() interface{} {
+func (f ProjectBandwidthRollup_IntervalMonth_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (ProjectBandwidthRollup_IntervalMonth_Field) _Column() string { return "interval_month" }
 
-type Project struct {
-	Id             []byte
-	PublicId       []byte
-	Name           string
-	Description    string
-	UsageLimit     *int64
-	BandwidthLimit *int64
-	SegmentLimit   *int64
-	RateLimit      *int
-	BurstLimit     *int
-	MaxBuckets     *int
-	PartnerId      []byte
-	UserAgent      []byte
-	OwnerId        []byte
-	CreatedAt      time.Time
+type ProjectBandwidthRollup_EgressAllocated_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func ProjectBandwidthRollup_EgressAllocated(v uint64) ProjectBandwidthRollup_EgressAllocated_Field {
+	return ProjectBandwidthRollup_EgressAllocated_Field{_set: true, _value: v}
 }
 
-func (Project) _Table() string { return "projects" }
+func (f ProjectBandwidthRollup_EgressAllocated_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-type Project_Create_Fields struct {
-	PublicId       Project_PublicId_Field
-	UsageLimit     Project_UsageLimit_Field
-	BandwidthLimit Project_BandwidthLimit_Field
-	SegmentLimit   Project_SegmentLimit_Field
-	RateLimit      Project_RateLimit_Field
-	BurstLimit     Project_BurstLimit_Field
-	MaxBuckets     Project_MaxBuckets_Field
-	PartnerId      Project_PartnerId_Field
-	UserAgent      Project_UserAgent_Field
+func (ProjectBandwidthRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
+
+type ProjectDeletion struct {
+	ProjectId  []byte
+	DeletedBy  []byte
+	DeletedAt  time.Time
+	PurgeAfter time.Time
 }
 
-type Project_Update_Fields struct {
-	Name           Project_Name_Field
-	Description    Project_Description_Field
-	UsageLimit     Project_UsageLimit_Field
-	BandwidthLimit Project_BandwidthLimit_Field
-	SegmentLimit   Project_SegmentLimit_Field
-	RateLimit      Project_RateLimit_Field
-	BurstLimit     Project_BurstLimit_Field
-	MaxBuckets     Project_MaxBuckets_Field
+func (ProjectDeletion) _Table() string { return "project_deletions" }
+
+type ProjectDeletion_Create_Fields struct {
+	DeletedBy ProjectDeletion_DeletedBy_Field
 }
 
-type Project_Id_Field struct {
+type ProjectDeletion_Update_Fields struct {
+}
+
+type ProjectDeletion_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Project_Id(v []byte) Project_Id_Field {
-	return Project_Id_Field{_set: true, _value: v}
+func ProjectDeletion_ProjectId(v []byte) ProjectDeletion_ProjectId_Field {
+	return ProjectDeletion_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Project_Id_Field) value() interface{} {
+func (f ProjectDeletion_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Id_Field) _Column() string { return "id" }
+func (ProjectDeletion_ProjectId_Field) _Column() string { return "project_id" }
 
-type Project_PublicId_Field struct {
+type ProjectDeletion_DeletedBy_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Project_PublicId(v []byte) Project_PublicId_Field {
-	return Project_PublicId_Field{_set: true, _value: v}
+func ProjectDeletion_DeletedBy(v []byte) ProjectDeletion_DeletedBy_Field {
+	return ProjectDeletion_DeletedBy_Field{_set: true, _value: v}
 }
 
-func Project_PublicId_Raw(v []byte) Project_PublicId_Field {
+func ProjectDeletion_DeletedBy_Raw(v []byte) ProjectDeletion_DeletedBy_Field {
 	if v == nil {
-		return Project_PublicId_Null()
+		return ProjectDeletion_DeletedBy_Null()
 	}
-	return Project_PublicId(v)
+	return ProjectDeletion_DeletedBy(v)
 }
 
-func Project_PublicId_Null() Project_PublicId_Field {
-	return Project_PublicId_Field{_set: true, _null: true}
+func ProjectDeletion_DeletedBy_Null() ProjectDeletion_DeletedBy_Field {
+	return ProjectDeletion_DeletedBy_Field{_set: true, _null: true}
 }
 
-func (f Project_PublicId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ProjectDeletion_DeletedBy_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Project_PublicId_Field) value() interface{} {
+func (f ProjectDeletion_DeletedBy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PublicId_Field) _Column() string { return "public_id" }
+func (ProjectDeletion_DeletedBy_Field) _Column() string { return "deleted_by" }
 
-type Project_Name_Field struct {
+type ProjectDeletion_DeletedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Project_Name(v string) Project_Name_Field {
-	return Project_Name_Field{_set: true, _value: v}
+func ProjectDeletion_DeletedAt(v time.Time) ProjectDeletion_DeletedAt_Field {
+	return ProjectDeletion_DeletedAt_Field{_set: true, _value: v}
 }
 
-func (f Project_Name_Field) value() interface{} {
+func (f ProjectDeletion_DeletedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Name_Field) _Column() string { return "name" }
+func (ProjectDeletion_DeletedAt_Field) _Column() string { return "deleted_at" }
 
-type Project_Description_Field struct {
+type ProjectDeletion_PurgeAfter_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Project_Description(v string) Project_Description_Field {
-	return Project_Description_Field{_set: true, _value: v}
+func ProjectDeletion_PurgeAfter(v time.Time) ProjectDeletion_PurgeAfter_Field {
+	return ProjectDeletion_PurgeAfter_Field{_set: true, _value: v}
 }
 
-func (f Project_Description_Field) value() interface{} {
+func (f ProjectDeletion_PurgeAfter_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Description_Field) _Column() string { return "description" }
+func (ProjectDeletion_PurgeAfter_Field) _Column() string { return "purge_after" }
 
-type Project_UsageLimit_Field struct {
-	_set   bool
-	_null  bool
-	_value *int64
+type ProjectFreeze struct {
+	ProjectId []byte
+	Reason    string
+	Downloads bool
+	InvoiceId string
+	CreatedAt time.Time
 }
 
-func Project_UsageLimit(v int64) Project_UsageLimit_Field {
-	return Project_UsageLimit_Field{_set: true, _value: &v}
-}
+func (ProjectFreeze) _Table() string { return "project_freezes" }
 
-func Project_UsageLimit_Raw(v *int64) Project_UsageLimit_Field {
-	if v == nil {
-		return Project_UsageLimit_Null()
-	}
-	return Project_UsageLimit(*v)
+type ProjectFreeze_Update_Fields struct {
+	Reason    ProjectFreeze_Reason_Field
+	Downloads ProjectFreeze_Downloads_Field
+	InvoiceId ProjectFreeze_InvoiceId_Field
 }
 
-func Project_UsageLimit_Null() Project_UsageLimit_Field {
-	return Project_UsageLimit_Field{_set: true, _null: true}
+type ProjectFreeze_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Project_UsageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectFreeze_ProjectId(v []byte) ProjectFreeze_ProjectId_Field {
+	return ProjectFreeze_ProjectId_Field{_set: true, _value: v}
+}
 
-func (f Project_UsageLimit_Field) value() interface{} {
+func (f ProjectFreeze_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
+func (ProjectFreeze_ProjectId_Field) _Column() string { return "project_id" }
 
-type Project_BandwidthLimit_Field struct {
+type ProjectFreeze_Reason_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value string
 }
 
-func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
-	return Project_BandwidthLimit_Field{_set: true, _value: &v}
+func ProjectFreeze_Reason(v string) ProjectFreeze_Reason_Field {
+	return ProjectFreeze_Reason_Field{_set: true, _value: v}
 }
 
-func Project_BandwidthLimit_Raw(v *int64) Project_BandwidthLimit_Field {
-	if v == nil {
-		return Project_BandwidthLimit_Null()
+func (f ProjectFreeze_Reason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_BandwidthLimit(*v)
+	return f._value
 }
 
-func Project_BandwidthLimit_Null() Project_BandwidthLimit_Field {
-	return Project_BandwidthLimit_Field{_set: true, _null: true}
+func (ProjectFreeze_Reason_Field) _Column() string { return "reason" }
+
+type ProjectFreeze_Downloads_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
 }
 
-func (f Project_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectFreeze_Downloads(v bool) ProjectFreeze_Downloads_Field {
+	return ProjectFreeze_Downloads_Field{_set: true, _value: v}
+}
 
-func (f Project_BandwidthLimit_Field) value() interface{} {
+func (f ProjectFreeze_Downloads_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
+func (ProjectFreeze_Downloads_Field) _Column() string { return "downloads" }
 
-type Project_SegmentLimit_Field struct {
+type ProjectFreeze_InvoiceId_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value string
 }
 
-func Project_SegmentLimit(v int64) Project_SegmentLimit_Field {
-	return Project_SegmentLimit_Field{_set: true, _value: &v}
+func ProjectFreeze_InvoiceId(v string) ProjectFreeze_InvoiceId_Field {
+	return ProjectFreeze_InvoiceId_Field{_set: true, _value: v}
 }
 
-func Project_SegmentLimit_Raw(v *int64) Project_SegmentLimit_Field {
-	if v == nil {
-		return Project_SegmentLimit_Null()
+func (f ProjectFreeze_InvoiceId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_SegmentLimit(*v)
+	return f._value
 }
 
-func Project_SegmentLimit_Null() Project_SegmentLimit_Field {
-	return Project_SegmentLimit_Field{_set: true, _null: true}
+func (ProjectFreeze_InvoiceId_Field) _Column() string { return "invoice_id" }
+
+type ProjectFreeze_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Project_SegmentLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectFreeze_CreatedAt(v time.Time) ProjectFreeze_CreatedAt_Field {
+	return ProjectFreeze_CreatedAt_Field{_set: true, _value: v}
+}
 
-func (f Project_SegmentLimit_Field) value() interface{} {
+func (f ProjectFreeze_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_SegmentLimit_Field) _Column() string { return "segment_limit" }
+func (ProjectFreeze_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Project_RateLimit_Field struct {
-	_set   bool
-	_null  bool
-	_value *int
+type ProjectLimitExemption struct {
+	ProjectId []byte
+	LimitKind string
+	Reason    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
 }
 
-func Project_RateLimit(v int) Project_RateLimit_Field {
-	return Project_RateLimit_Field{_set: true, _value: &v}
-}
+func (ProjectLimitExemption) _Table() string { return "project_limit_exemptions" }
 
-func Project_RateLimit_Raw(v *int) Project_RateLimit_Field {
-	if v == nil {
-		return Project_RateLimit_Null()
-	}
-	return Project_RateLimit(*v)
+type ProjectLimitExemption_Update_Fields struct {
+	Reason    ProjectLimitExemption_Reason_Field
+	ExpiresAt ProjectLimitExemption_ExpiresAt_Field
 }
 
-func Project_RateLimit_Null() Project_RateLimit_Field {
-	return Project_RateLimit_Field{_set: true, _null: true}
+type ProjectLimitExemption_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Project_RateLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectLimitExemption_ProjectId(v []byte) ProjectLimitExemption_ProjectId_Field {
+	return ProjectLimitExemption_ProjectId_Field{_set: true, _value: v}
+}
 
-func (f Project_RateLimit_Field) value() interface{} {
+func (f ProjectLimitExemption_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_RateLimit_Field) _Column() string { return "rate_limit" }
+func (ProjectLimitExemption_ProjectId_Field) _Column() string { return "project_id" }
 
-type Project_BurstLimit_Field struct {
+type ProjectLimitExemption_LimitKind_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value string
 }
 
-func Project_BurstLimit(v int) Project_BurstLimit_Field {
-	return Project_BurstLimit_Field{_set: true, _value: &v}
+func ProjectLimitExemption_LimitKind(v string) ProjectLimitExemption_LimitKind_Field {
+	return ProjectLimitExemption_LimitKind_Field{_set: true, _value: v}
 }
 
-func Project_BurstLimit_Raw(v *int) Project_BurstLimit_Field {
-	if v == nil {
-		return Project_BurstLimit_Null()
+func (f ProjectLimitExemption_LimitKind_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_BurstLimit(*v)
+	return f._value
 }
 
-func Project_BurstLimit_Null() Project_BurstLimit_Field {
-	return Project_BurstLimit_Field{_set: true, _null: true}
+func (ProjectLimitExemption_LimitKind_Field) _Column() string { return "limit_kind" }
+
+type ProjectLimitExemption_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Project_BurstLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectLimitExemption_Reason(v string) ProjectLimitExemption_Reason_Field {
+	return ProjectLimitExemption_Reason_Field{_set: true, _value: v}
+}
 
-func (f Project_BurstLimit_Field) value() interface{} {
+func (f ProjectLimitExemption_Reason_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BurstLimit_Field) _Column() string { return "burst_limit" }
+func (ProjectLimitExemption_Reason_Field) _Column() string { return "reason" }
 
-type Project_MaxBuckets_Field struct {
+type ProjectLimitExemption_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value time.Time
 }
 
-func Project_MaxBuckets(v int) Project_MaxBuckets_Field {
-	return Project_MaxBuckets_Field{_set: true, _value: &v}
+func ProjectLimitExemption_ExpiresAt(v time.Time) ProjectLimitExemption_ExpiresAt_Field {
+	return ProjectLimitExemption_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func Project_MaxBuckets_Raw(v *int) Project_MaxBuckets_Field {
-	if v == nil {
-		return Project_MaxBuckets_Null()
+func (f ProjectLimitExemption_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_MaxBuckets(*v)
+	return f._value
 }
 
-func Project_MaxBuckets_Null() Project_MaxBuckets_Field {
-	return Project_MaxBuckets_Field{_set: true, _null: true}
+func (ProjectLimitExemption_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type ProjectLimitExemption_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Project_MaxBuckets_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectLimitExemption_CreatedAt(v time.Time) ProjectLimitExemption_CreatedAt_Field {
+	return ProjectLimitExemption_CreatedAt_Field{_set: true, _value: v}
+}
 
-func (f Project_MaxBuckets_Field) value() interface{} {
+func (f ProjectLimitExemption_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_MaxBuckets_Field) _Column() string { return "max_buckets" }
+func (ProjectLimitExemption_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Project_PartnerId_Field struct {
+type ProjectLiveUsage struct {
+	ProjectId []byte
+	Storage   int64
+	Segments  int64
+	UpdatedAt time.Time
+}
+
+func (ProjectLiveUsage) _Table() string { return "project_live_usages" }
+
+type ProjectLiveUsage_Update_Fields struct {
+	Storage  ProjectLiveUsage_Storage_Field
+	Segments ProjectLiveUsage_Segments_Field
+}
+
+type ProjectLiveUsage_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Project_PartnerId(v []byte) Project_PartnerId_Field {
-	return Project_PartnerId_Field{_set: true, _value: v}
+func ProjectLiveUsage_ProjectId(v []byte) ProjectLiveUsage_ProjectId_Field {
+	return ProjectLiveUsage_ProjectId_Field{_set: true, _value: v}
 }
 
-func Project_PartnerId_Raw(v []byte) Project_PartnerId_Field {
-	if v == nil {
-		return Project_PartnerId_Null()
+func (f ProjectLiveUsage_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_PartnerId(v)
+	return f._value
 }
 
-func Project_PartnerId_Null() Project_PartnerId_Field {
-	return Project_PartnerId_Field{_set: true, _null: true}
+func (ProjectLiveUsage_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectLiveUsage_Storage_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f Project_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectLiveUsage_Storage(v int64) ProjectLiveUsage_Storage_Field {
+	return ProjectLiveUsage_Storage_Field{_set: true, _value: v}
+}
 
-func (f Project_PartnerId_Field) value() interface{} {
+func (f ProjectLiveUsage_Storage_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PartnerId_Field) _Column() string { return "partner_id" }
+func (ProjectLiveUsage_Storage_Field) _Column() string { return "storage" }
 
-type Project_UserAgent_Field struct {
+type ProjectLiveUsage_Segments_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func Project_UserAgent(v []byte) Project_UserAgent_Field {
-	return Project_UserAgent_Field{_set: true, _value: v}
+func ProjectLiveUsage_Segments(v int64) ProjectLiveUsage_Segments_Field {
+	return ProjectLiveUsage_Segments_Field{_set: true, _value: v}
 }
 
-func Project_UserAgent_Raw(v []byte) Project_UserAgent_Field {
-	if v == nil {
-		return Project_UserAgent_Null()
+func (f ProjectLiveUsage_Segments_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_UserAgent(v)
+	return f._value
 }
 
-func Project_UserAgent_Null() Project_UserAgent_Field {
-	return Project_UserAgent_Field{_set: true, _null: true}
+func (ProjectLiveUsage_Segments_Field) _Column() string { return "segments" }
+
+type ProjectLiveUsage_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Project_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectLiveUsage_UpdatedAt(v time.Time) ProjectLiveUsage_UpdatedAt_Field {
+	return ProjectLiveUsage_UpdatedAt_Field{_set: true, _value: v}
+}
 
-func (f Project_UserAgent_Field) value() interface{} {
+func (f ProjectLiveUsage_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UserAgent_Field) _Column() string { return "user_agent" }
+func (ProjectLiveUsage_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Project_OwnerId_Field struct {
+type ProjectSecurityLog struct {
+	Id            []byte
+	ProjectId     []byte
+	ApiKeyId      []byte
+	ApiKeyName    string
+	RemoteAddress string
+	Operation     string
+	BucketName    []byte
+	CreatedAt     time.Time
+}
+
+func (ProjectSecurityLog) _Table() string { return "project_security_logs" }
+
+type ProjectSecurityLog_Update_Fields struct {
+}
+
+type ProjectSecurityLog_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Project_OwnerId(v []byte) Project_OwnerId_Field {
-	return Project_OwnerId_Field{_set: true, _value: v}
+func ProjectSecurityLog_Id(v []byte) ProjectSecurityLog_Id_Field {
+	return ProjectSecurityLog_Id_Field{_set: true, _value: v}
 }
 
-func (f Project_OwnerId_Field) value() interface{} {
+func (f ProjectSecurityLog_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_OwnerId_Field) _Column() string { return "owner_id" }
+func (ProjectSecurityLog_Id_Field) _Column() string { return "id" }
 
-type Project_CreatedAt_Field struct {
+type ProjectSecurityLog_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
-	return Project_CreatedAt_Field{_set: true, _value: v}
+func ProjectSecurityLog_ProjectId(v []byte) ProjectSecurityLog_ProjectId_Field {
+	return ProjectSecurityLog_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Project_CreatedAt_Field) value() interface{} {
+func (f ProjectSecurityLog_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_CreatedAt_Field) _Column() string { return "created_at" }
+func (ProjectSecurityLog_ProjectId_Field) _Column() string { return "project_id" }
 
-type ProjectBandwidthDailyRollup struct {
-	ProjectId       []byte
-	IntervalDay     time.Time
-	EgressAllocated uint64
-	EgressSettled   uint64
-	EgressDead      uint64
+type ProjectSecurityLog_ApiKeyId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (ProjectBandwidthDailyRollup) _Table() string { return "project_bandwidth_daily_rollups" }
-
-type ProjectBandwidthDailyRollup_Create_Fields struct {
-	EgressDead ProjectBandwidthDailyRollup_EgressDead_Field
+func ProjectSecurityLog_ApiKeyId(v []byte) ProjectSecurityLog_ApiKeyId_Field {
+	return ProjectSecurityLog_ApiKeyId_Field{_set: true, _value: v}
 }
 
-type ProjectBandwidthDailyRollup_Update_Fields struct {
-	EgressAllocated ProjectBandwidthDailyRollup_EgressAllocated_Field
-	EgressSettled   ProjectBandwidthDailyRollup_EgressSettled_Field
-	EgressDead      ProjectBandwidthDailyRollup_EgressDead_Field
+func (f ProjectSecurityLog_ApiKeyId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type ProjectBandwidthDailyRollup_ProjectId_Field struct {
+func (ProjectSecurityLog_ApiKeyId_Field) _Column() string { return "api_key_id" }
+
+type ProjectSecurityLog_ApiKeyName_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func ProjectBandwidthDailyRollup_ProjectId(v []byte) ProjectBandwidthDailyRollup_ProjectId_Field {
-	return ProjectBandwidthDailyRollup_ProjectId_Field{_set: true, _value: v}
+func ProjectSecurityLog_ApiKeyName(v string) ProjectSecurityLog_ApiKeyName_Field {
+	return ProjectSecurityLog_ApiKeyName_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_ProjectId_Field) value() interface{} {
+func (f ProjectSecurityLog_ApiKeyName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (ProjectSecurityLog_ApiKeyName_Field) _Column() string { return "api_key_name" }
 
-type ProjectBandwidthDailyRollup_IntervalDay_Field struct {
+type ProjectSecurityLog_RemoteAddress_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func ProjectBandwidthDailyRollup_IntervalDay(v time.Time) ProjectBandwidthDailyRollup_IntervalDay_Field {
-	v = toDate(v)
-	return ProjectBandwidthDailyRollup_IntervalDay_Field{_set: true, _value: v}
+func ProjectSecurityLog_RemoteAddress(v string) ProjectSecurityLog_RemoteAddress_Field {
+	return ProjectSecurityLog_RemoteAddress_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_IntervalDay_Field) value() interface{} {
+func (f ProjectSecurityLog_RemoteAddress_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_IntervalDay_Field) _Column() string { return "interval_day" }
+func (ProjectSecurityLog_RemoteAddress_Field) _Column() string { return "remote_address" }
 
-type ProjectBandwidthDailyRollup_EgressAllocated_Field struct {
+type ProjectSecurityLog_Operation_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value string
 }
 
-func ProjectBandwidthDailyRollup_EgressAllocated(v uint64) ProjectBandwidthDailyRollup_EgressAllocated_Field {
-	return ProjectBandwidthDailyRollup_EgressAllocated_Field{_set: true, _value: v}
+func ProjectSecurityLog_Operation(v string) ProjectSecurityLog_Operation_Field {
+	return ProjectSecurityLog_Operation_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_EgressAllocated_Field) value() interface{} {
+func (f ProjectSecurityLog_Operation_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
+func (ProjectSecurityLog_Operation_Field) _Column() string { return "operation" }
 
-type ProjectBandwidthDailyRollup_EgressSettled_Field struct {
+type ProjectSecurityLog_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func ProjectBandwidthDailyRollup_EgressSettled(v uint64) ProjectBandwidthDailyRollup_EgressSettled_Field {
-	return ProjectBandwidthDailyRollup_EgressSettled_Field{_set: true, _value: v}
+func ProjectSecurityLog_BucketName(v []byte) ProjectSecurityLog_BucketName_Field {
+	return ProjectSecurityLog_BucketName_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_EgressSettled_Field) value() interface{} {
+func (f ProjectSecurityLog_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressSettled_Field) _Column() string { return "egress_settled" }
+func (ProjectSecurityLog_BucketName_Field) _Column() string { return "bucket_name" }
 
-type ProjectBandwidthDailyRollup_EgressDead_Field struct {
+type ProjectSecurityLog_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func ProjectBandwidthDailyRollup_EgressDead(v uint64) ProjectBandwidthDailyRollup_EgressDead_Field {
-	return ProjectBandwidthDailyRollup_EgressDead_Field{_set: true, _value: v}
+func ProjectSecurityLog_CreatedAt(v time.Time) ProjectSecurityLog_CreatedAt_Field {
+	return ProjectSecurityLog_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_EgressDead_Field) value() interface{} {
+func (f ProjectSecurityLog_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressDead_Field) _Column() string { return "egress_dead" }
+func (ProjectSecurityLog_CreatedAt_Field) _Column() string { return "created_at" }
 
-type ProjectBandwidthRollup struct {
-	ProjectId       []byte
-	IntervalMonth   time.Time
-	EgressAllocated uint64
+type ProjectUsageAnomalySetting struct {
+	ProjectId      []byte
+	Sensitivity    string
+	LastNotifiedAt *time.Time
+	UpdatedAt      time.Time
 }
 
-func (ProjectBandwidthRollup) _Table() string { return "project_bandwidth_rollups" }
+func (ProjectUsageAnomalySetting) _Table() string { return "project_usage_anomaly_settings" }
 
-type ProjectBandwidthRollup_Update_Fields struct {
-	EgressAllocated ProjectBandwidthRollup_EgressAllocated_Field
+type ProjectUsageAnomalySetting_Create_Fields struct {
+	LastNotifiedAt ProjectUsageAnomalySetting_LastNotifiedAt_Field
 }
 
-type ProjectBandwidthRollup_ProjectId_Field struct {
+type ProjectUsageAnomalySetting_Update_Fields struct {
+	Sensitivity    ProjectUsageAnomalySetting_Sensitivity_Field
+	LastNotifiedAt ProjectUsageAnomalySetting_LastNotifiedAt_Field
+}
+
+type ProjectUsageAnomalySetting_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ProjectBandwidthRollup_ProjectId(v []byte) ProjectBandwidthRollup_ProjectId_Field {
-	return ProjectBandwidthRollup_ProjectId_Field{_set: true, _value: v}
+func ProjectUsageAnomalySetting_ProjectId(v []byte) ProjectUsageAnomalySetting_ProjectId_Field {
+	return ProjectUsageAnomalySetting_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthRollup_ProjectId_Field) value() interface{} {
+func (f ProjectUsageAnomalySetting_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (ProjectUsageAnomalySetting_ProjectId_Field) _Column() string { return "project_id" }
 
-type ProjectBandwidthRollup_IntervalMonth_Field struct {
+type ProjectUsageAnomalySetting_Sensitivity_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func ProjectBandwidthRollup_IntervalMonth(v time.Time) ProjectBandwidthRollup_IntervalMonth_Field {
-	v = toDate(v)
-	return ProjectBandwidthRollup_IntervalMonth_Field{_set: true, _value: v}
+func ProjectUsageAnomalySetting_Sensitivity(v string) ProjectUsageAnomalySetting_Sensitivity_Field {
+	return ProjectUsageAnomalySetting_Sensitivity_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthRollup_IntervalMonth_Field) value() interface{} {
+func (f ProjectUsageAnomalySetting_Sensitivity_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthRollup_IntervalMonth_Field) _Column() string { return "interval_month" }
+func (ProjectUsageAnomalySetting_Sensitivity_Field) _Column() string { return "sensitivity" }
 
-type ProjectBandwidthRollup_EgressAllocated_Field struct {
+type ProjectUsageAnomalySetting_LastNotifiedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value *time.Time
 }
 
-func ProjectBandwidthRollup_EgressAllocated(v uint64) ProjectBandwidthRollup_EgressAllocated_Field {
-	return ProjectBandwidthRollup_EgressAllocated_Field{_set: true, _value: v}
+func ProjectUsageAnomalySetting_LastNotifiedAt(v time.Time) ProjectUsageAnomalySetting_LastNotifiedAt_Field {
+	return ProjectUsageAnomalySetting_LastNotifiedAt_Field{_set: true, _value: &v}
 }
 
-func (f ProjectBandwidthRollup_EgressAllocated_Field) value() interface{} {
+func ProjectUsageAnomalySetting_LastNotifiedAt_Raw(v *time.Time) ProjectUsageAnomalySetting_LastNotifiedAt_Field {
+	if v == nil {
+		return ProjectUsageAnomalySetting_LastNotifiedAt_Null()
+	}
+	return ProjectUsageAnomalySetting_LastNotifiedAt(*v)
+}
+
+func ProjectUsageAnomalySetting_LastNotifiedAt_Null() ProjectUsageAnomalySetting_LastNotifiedAt_Field {
+	return ProjectUsageAnomalySetting_LastNotifiedAt_Field{_set: true, _null: true}
+}
+
+func (f ProjectUsageAnomalySetting_LastNotifiedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f ProjectUsageAnomalySetting_LastNotifiedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
+func (ProjectUsageAnomalySetting_LastNotifiedAt_Field) _Column() string { return "last_notified_at" }
+
+type ProjectUsageAnomalySetting_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectUsageAnomalySetting_UpdatedAt(v time.Time) ProjectUsageAnomalySetting_UpdatedAt_Field {
+	return ProjectUsageAnomalySetting_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectUsageAnomalySetting_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectUsageAnomalySetting_UpdatedAt_Field) _Column() string { return "updated_at" }
 
 type RegistrationToken struct {
 	Secret       []byte
@@ -6378,6 +8216,163 @@ func (f RegistrationToken_CreatedAt_Field) value() interface{} {
 
 func (RegistrationToken_CreatedAt_Field) _Column() string { return "created_at" }
 
+type RemediationQueue struct {
+	StreamId        []byte
+	Position        uint64
+	Violation       string
+	ViolatingPieces int
+	Placement       int
+	UpdatedAt       time.Time
+	InsertedAt      time.Time
+}
+
+func (RemediationQueue) _Table() string { return "remediation_queue" }
+
+type RemediationQueue_Create_Fields struct {
+	Placement  RemediationQueue_Placement_Field
+	UpdatedAt  RemediationQueue_UpdatedAt_Field
+	InsertedAt RemediationQueue_InsertedAt_Field
+}
+
+type RemediationQueue_Update_Fields struct {
+	ViolatingPieces RemediationQueue_ViolatingPieces_Field
+	Placement       RemediationQueue_Placement_Field
+	UpdatedAt       RemediationQueue_UpdatedAt_Field
+}
+
+type RemediationQueue_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func RemediationQueue_StreamId(v []byte) RemediationQueue_StreamId_Field {
+	return RemediationQueue_StreamId_Field{_set: true, _value: v}
+}
+
+func (f RemediationQueue_StreamId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RemediationQueue_StreamId_Field) _Column() string { return "stream_id" }
+
+type RemediationQueue_Position_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func RemediationQueue_Position(v uint64) RemediationQueue_Position_Field {
+	return RemediationQueue_Position_Field{_set: true, _value: v}
+}
+
+func (f RemediationQueue_Position_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RemediationQueue_Position_Field) _Column() string { return "position" }
+
+type RemediationQueue_Violation_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func RemediationQueue_Violation(v string) RemediationQueue_Violation_Field {
+	return RemediationQueue_Violation_Field{_set: true, _value: v}
+}
+
+func (f RemediationQueue_Violation_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RemediationQueue_Violation_Field) _Column() string { return "violation" }
+
+type RemediationQueue_ViolatingPieces_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func RemediationQueue_ViolatingPieces(v int) RemediationQueue_ViolatingPieces_Field {
+	return RemediationQueue_ViolatingPieces_Field{_set: true, _value: v}
+}
+
+func (f RemediationQueue_ViolatingPieces_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RemediationQueue_ViolatingPieces_Field) _Column() string { return "violating_pieces" }
+
+type RemediationQueue_Placement_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func RemediationQueue_Placement(v int) RemediationQueue_Placement_Field {
+	return RemediationQueue_Placement_Field{_set: true, _value: v}
+}
+
+func (f RemediationQueue_Placement_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RemediationQueue_Placement_Field) _Column() string { return "placement" }
+
+type RemediationQueue_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func RemediationQueue_UpdatedAt(v time.Time) RemediationQueue_UpdatedAt_Field {
+	return RemediationQueue_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f RemediationQueue_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RemediationQueue_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type RemediationQueue_InsertedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func RemediationQueue_InsertedAt(v time.Time) RemediationQueue_InsertedAt_Field {
+	return RemediationQueue_InsertedAt_Field{_set: true, _value: v}
+}
+
+func (f RemediationQueue_InsertedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RemediationQueue_InsertedAt_Field) _Column() string { return "inserted_at" }
+
 type RepairQueue struct {
 	StreamId      []byte
 	Position      uint64
@@ -6385,6 +8380,7 @@ type RepairQueue struct {
 	UpdatedAt     time.Time
 	InsertedAt    time.Time
 	SegmentHealth float64
+	Placement     *int
 }
 
 func (RepairQueue) _Table() string { return "repair_queue" }
@@ -6394,6 +8390,7 @@ type RepairQueue_Create_Fields struct {
 	UpdatedAt     RepairQueue_UpdatedAt_Field
 	InsertedAt    RepairQueue_InsertedAt_Field
 	SegmentHealth RepairQueue_SegmentHealth_Field
+	Placement     RepairQueue_Placement_Field
 }
 
 type RepairQueue_Update_Fields struct {
@@ -6504,29 +8501,61 @@ func (f RepairQueue_InsertedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
-	return f._value
+	return f._value
+}
+
+func (RepairQueue_InsertedAt_Field) _Column() string { return "inserted_at" }
+
+type RepairQueue_SegmentHealth_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func RepairQueue_SegmentHealth(v float64) RepairQueue_SegmentHealth_Field {
+	return RepairQueue_SegmentHealth_Field{_set: true, _value: v}
+}
+
+func (f RepairQueue_SegmentHealth_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RepairQueue_SegmentHealth_Field) _Column() string { return "segment_health" }
+
+type RepairQueue_Placement_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func RepairQueue_Placement(v int) RepairQueue_Placement_Field {
+	return RepairQueue_Placement_Field{_set: true, _value: &v}
+}
+
+func RepairQueue_Placement_Raw(v *int) RepairQueue_Placement_Field {
+	if v == nil {
+		return RepairQueue_Placement_Null()
+	}
+	return RepairQueue_Placement(*v)
 }
 
-func (RepairQueue_InsertedAt_Field) _Column() string { return "inserted_at" }
-
-type RepairQueue_SegmentHealth_Field struct {
-	_set   bool
-	_null  bool
-	_value float64
+func RepairQueue_Placement_Null() RepairQueue_Placement_Field {
+	return RepairQueue_Placement_Field{_set: true, _null: true}
 }
 
-func RepairQueue_SegmentHealth(v float64) RepairQueue_SegmentHealth_Field {
-	return RepairQueue_SegmentHealth_Field{_set: true, _value: v}
-}
+func (f RepairQueue_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f RepairQueue_SegmentHealth_Field) value() interface{} {
+func (f RepairQueue_Placement_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RepairQueue_SegmentHealth_Field) _Column() string { return "segment_health" }
+func (RepairQueue_Placement_Field) _Column() string { return "placement" }
 
 type Reputation struct {
 	Id                          []byte
@@ -6540,6 +8569,7 @@ type Reputation struct {
 	UnknownAuditSuspended       *time.Time
 	OfflineSuspended            *time.Time
 	UnderReview                 *time.Time
+	AuditWarned                 *time.Time
 	OnlineScore                 float64
 	AuditHistory                []byte
 	AuditReputationAlpha        float64
@@ -6559,6 +8589,7 @@ type Reputation_Create_Fields struct {
 	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
 	OfflineSuspended            Reputation_OfflineSuspended_Field
 	UnderReview                 Reputation_UnderReview_Field
+	AuditWarned                 Reputation_AuditWarned_Field
 	OnlineScore                 Reputation_OnlineScore_Field
 	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
 	AuditReputationBeta         Reputation_AuditReputationBeta_Field
@@ -6575,6 +8606,7 @@ type Reputation_Update_Fields struct {
 	UnknownAuditSuspended       Reputation_UnknownAuditSuspended_Field
 	OfflineSuspended            Reputation_OfflineSuspended_Field
 	UnderReview                 Reputation_UnderReview_Field
+	AuditWarned                 Reputation_AuditWarned_Field
 	OnlineScore                 Reputation_OnlineScore_Field
 	AuditHistory                Reputation_AuditHistory_Field
 	AuditReputationAlpha        Reputation_AuditReputationAlpha_Field
@@ -6876,6 +8908,38 @@ func (f Reputation_UnderReview_Field) value() interface{} {
 
 func (Reputation_UnderReview_Field) _Column() string { return "under_review" }
 
+type Reputation_AuditWarned_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Reputation_AuditWarned(v time.Time) Reputation_AuditWarned_Field {
+	return Reputation_AuditWarned_Field{_set: true, _value: &v}
+}
+
+func Reputation_AuditWarned_Raw(v *time.Time) Reputation_AuditWarned_Field {
+	if v == nil {
+		return Reputation_AuditWarned_Null()
+	}
+	return Reputation_AuditWarned(*v)
+}
+
+func Reputation_AuditWarned_Null() Reputation_AuditWarned_Field {
+	return Reputation_AuditWarned_Field{_set: true, _null: true}
+}
+
+func (f Reputation_AuditWarned_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Reputation_AuditWarned_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Reputation_AuditWarned_Field) _Column() string { return "audit_warned" }
+
 type Reputation_OnlineScore_Field struct {
 	_set   bool
 	_null  bool
@@ -7280,6 +9344,333 @@ func (f SegmentPendingAudits_ReverifyCount_Field) value() interface{} {
 
 func (SegmentPendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
 
+type SegmentStatisticsReport struct {
+	CreatedAt time.Time
+	Report    []byte
+}
+
+func (SegmentStatisticsReport) _Table() string { return "segment_statistics_reports" }
+
+type SegmentStatisticsReport_Update_Fields struct {
+}
+
+type SegmentStatisticsReport_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func SegmentStatisticsReport_CreatedAt(v time.Time) SegmentStatisticsReport_CreatedAt_Field {
+	return SegmentStatisticsReport_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f SegmentStatisticsReport_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SegmentStatisticsReport_CreatedAt_Field) _Column() string { return "created_at" }
+
+type SegmentStatisticsReport_Report_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func SegmentStatisticsReport_Report(v []byte) SegmentStatisticsReport_Report_Field {
+	return SegmentStatisticsReport_Report_Field{_set: true, _value: v}
+}
+
+func (f SegmentStatisticsReport_Report_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SegmentStatisticsReport_Report_Field) _Column() string { return "report" }
+
+type SharedLinkBandwidthRollup struct {
+	ProjectId     []byte
+	BucketName    []byte
+	ObjectKey     []byte
+	IntervalStart time.Time
+	Inline        uint64
+	Allocated     uint64
+	RequestCount  uint64
+}
+
+func (SharedLinkBandwidthRollup) _Table() string { return "shared_link_bandwidth_rollups" }
+
+type SharedLinkBandwidthRollup_Update_Fields struct {
+	Inline       SharedLinkBandwidthRollup_Inline_Field
+	Allocated    SharedLinkBandwidthRollup_Allocated_Field
+	RequestCount SharedLinkBandwidthRollup_RequestCount_Field
+}
+
+type SharedLinkBandwidthRollup_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func SharedLinkBandwidthRollup_ProjectId(v []byte) SharedLinkBandwidthRollup_ProjectId_Field {
+	return SharedLinkBandwidthRollup_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f SharedLinkBandwidthRollup_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SharedLinkBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
+
+type SharedLinkBandwidthRollup_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func SharedLinkBandwidthRollup_BucketName(v []byte) SharedLinkBandwidthRollup_BucketName_Field {
+	return SharedLinkBandwidthRollup_BucketName_Field{_set: true, _value: v}
+}
+
+func (f SharedLinkBandwidthRollup_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SharedLinkBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
+
+type SharedLinkBandwidthRollup_ObjectKey_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func SharedLinkBandwidthRollup_ObjectKey(v []byte) SharedLinkBandwidthRollup_ObjectKey_Field {
+	return SharedLinkBandwidthRollup_ObjectKey_Field{_set: true, _value: v}
+}
+
+func (f SharedLinkBandwidthRollup_ObjectKey_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SharedLinkBandwidthRollup_ObjectKey_Field) _Column() string { return "object_key" }
+
+type SharedLinkBandwidthRollup_IntervalStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func SharedLinkBandwidthRollup_IntervalStart(v time.Time) SharedLinkBandwidthRollup_IntervalStart_Field {
+	return SharedLinkBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+}
+
+func (f SharedLinkBandwidthRollup_IntervalStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SharedLinkBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+
+type SharedLinkBandwidthRollup_Inline_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func SharedLinkBandwidthRollup_Inline(v uint64) SharedLinkBandwidthRollup_Inline_Field {
+	return SharedLinkBandwidthRollup_Inline_Field{_set: true, _value: v}
+}
+
+func (f SharedLinkBandwidthRollup_Inline_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SharedLinkBandwidthRollup_Inline_Field) _Column() string { return "inline" }
+
+type SharedLinkBandwidthRollup_Allocated_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func SharedLinkBandwidthRollup_Allocated(v uint64) SharedLinkBandwidthRollup_Allocated_Field {
+	return SharedLinkBandwidthRollup_Allocated_Field{_set: true, _value: v}
+}
+
+func (f SharedLinkBandwidthRollup_Allocated_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SharedLinkBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+
+type SharedLinkBandwidthRollup_RequestCount_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func SharedLinkBandwidthRollup_RequestCount(v uint64) SharedLinkBandwidthRollup_RequestCount_Field {
+	return SharedLinkBandwidthRollup_RequestCount_Field{_set: true, _value: v}
+}
+
+func (f SharedLinkBandwidthRollup_RequestCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SharedLinkBandwidthRollup_RequestCount_Field) _Column() string { return "request_count" }
+
+type SignupReview struct {
+	UserId     []byte
+	Reason     string
+	CreatedAt  time.Time
+	ReviewedAt *time.Time
+	Approved   bool
+}
+
+func (SignupReview) _Table() string { return "signup_reviews" }
+
+type SignupReview_Create_Fields struct {
+	ReviewedAt SignupReview_ReviewedAt_Field
+	Approved   SignupReview_Approved_Field
+}
+
+type SignupReview_Update_Fields struct {
+	ReviewedAt SignupReview_ReviewedAt_Field
+	Approved   SignupReview_Approved_Field
+}
+
+type SignupReview_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func SignupReview_UserId(v []byte) SignupReview_UserId_Field {
+	return SignupReview_UserId_Field{_set: true, _value: v}
+}
+
+func (f SignupReview_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SignupReview_UserId_Field) _Column() string { return "user_id" }
+
+type SignupReview_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func SignupReview_Reason(v string) SignupReview_Reason_Field {
+	return SignupReview_Reason_Field{_set: true, _value: v}
+}
+
+func (f SignupReview_Reason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SignupReview_Reason_Field) _Column() string { return "reason" }
+
+type SignupReview_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func SignupReview_CreatedAt(v time.Time) SignupReview_CreatedAt_Field {
+	return SignupReview_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f SignupReview_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SignupReview_CreatedAt_Field) _Column() string { return "created_at" }
+
+type SignupReview_ReviewedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func SignupReview_ReviewedAt(v time.Time) SignupReview_ReviewedAt_Field {
+	return SignupReview_ReviewedAt_Field{_set: true, _value: &v}
+}
+
+func SignupReview_ReviewedAt_Raw(v *time.Time) SignupReview_ReviewedAt_Field {
+	if v == nil {
+		return SignupReview_ReviewedAt_Null()
+	}
+	return SignupReview_ReviewedAt(*v)
+}
+
+func SignupReview_ReviewedAt_Null() SignupReview_ReviewedAt_Field {
+	return SignupReview_ReviewedAt_Field{_set: true, _null: true}
+}
+
+func (f SignupReview_ReviewedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f SignupReview_ReviewedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SignupReview_ReviewedAt_Field) _Column() string { return "reviewed_at" }
+
+type SignupReview_Approved_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func SignupReview_Approved(v bool) SignupReview_Approved_Field {
+	return SignupReview_Approved_Field{_set: true, _value: v}
+}
+
+func (f SignupReview_Approved_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (SignupReview_Approved_Field) _Column() string { return "approved" }
+
 type StoragenodeBandwidthRollup struct {
 	StoragenodeId   []byte
 	IntervalStart   time.Time
@@ -7941,6 +10332,8 @@ type StoragenodePaystub struct {
 	Disposed       int64
 	Paid           int64
 	Distributed    int64
+	Wallet         string
+	WalletFeatures string
 }
 
 func (StoragenodePaystub) _Table() string { return "storagenode_paystubs" }
@@ -8366,6 +10759,44 @@ func (f StoragenodePaystub_Distributed_Field) value() interface{} {
 
 func (StoragenodePaystub_Distributed_Field) _Column() string { return "distributed" }
 
+type StoragenodePaystub_Wallet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StoragenodePaystub_Wallet(v string) StoragenodePaystub_Wallet_Field {
+	return StoragenodePaystub_Wallet_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_Wallet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_Wallet_Field) _Column() string { return "wallet" }
+
+type StoragenodePaystub_WalletFeatures_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StoragenodePaystub_WalletFeatures(v string) StoragenodePaystub_WalletFeatures_Field {
+	return StoragenodePaystub_WalletFeatures_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePaystub_WalletFeatures_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePaystub_WalletFeatures_Field) _Column() string { return "wallet_features" }
+
 type StoragenodeStorageTally struct {
 	NodeId          []byte
 	IntervalEndTime time.Time
@@ -10629,560 +13060,914 @@ func BucketMetainfo_DefaultSegmentSize(v int) BucketMetainfo_DefaultSegmentSize_
 	return BucketMetainfo_DefaultSegmentSize_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultSegmentSize_Field) value() interface{} {
+func (f BucketMetainfo_DefaultSegmentSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultSegmentSize_Field) _Column() string { return "default_segment_size" }
+
+type BucketMetainfo_DefaultEncryptionCipherSuite_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultEncryptionCipherSuite(v int) BucketMetainfo_DefaultEncryptionCipherSuite_Field {
+	return BucketMetainfo_DefaultEncryptionCipherSuite_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultEncryptionCipherSuite_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultEncryptionCipherSuite_Field) _Column() string {
+	return "default_encryption_cipher_suite"
+}
+
+type BucketMetainfo_DefaultEncryptionBlockSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultEncryptionBlockSize(v int) BucketMetainfo_DefaultEncryptionBlockSize_Field {
+	return BucketMetainfo_DefaultEncryptionBlockSize_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultEncryptionBlockSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultEncryptionBlockSize_Field) _Column() string {
+	return "default_encryption_block_size"
+}
+
+type BucketMetainfo_DefaultRedundancyAlgorithm_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyAlgorithm(v int) BucketMetainfo_DefaultRedundancyAlgorithm_Field {
+	return BucketMetainfo_DefaultRedundancyAlgorithm_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyAlgorithm_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyAlgorithm_Field) _Column() string {
+	return "default_redundancy_algorithm"
+}
+
+type BucketMetainfo_DefaultRedundancyShareSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyShareSize(v int) BucketMetainfo_DefaultRedundancyShareSize_Field {
+	return BucketMetainfo_DefaultRedundancyShareSize_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyShareSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyShareSize_Field) _Column() string {
+	return "default_redundancy_share_size"
+}
+
+type BucketMetainfo_DefaultRedundancyRequiredShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyRequiredShares(v int) BucketMetainfo_DefaultRedundancyRequiredShares_Field {
+	return BucketMetainfo_DefaultRedundancyRequiredShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyRequiredShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyRequiredShares_Field) _Column() string {
+	return "default_redundancy_required_shares"
+}
+
+type BucketMetainfo_DefaultRedundancyRepairShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyRepairShares(v int) BucketMetainfo_DefaultRedundancyRepairShares_Field {
+	return BucketMetainfo_DefaultRedundancyRepairShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyRepairShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyRepairShares_Field) _Column() string {
+	return "default_redundancy_repair_shares"
+}
+
+type BucketMetainfo_DefaultRedundancyOptimalShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyOptimalShares(v int) BucketMetainfo_DefaultRedundancyOptimalShares_Field {
+	return BucketMetainfo_DefaultRedundancyOptimalShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyOptimalShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyOptimalShares_Field) _Column() string {
+	return "default_redundancy_optimal_shares"
+}
+
+type BucketMetainfo_DefaultRedundancyTotalShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyTotalShares(v int) BucketMetainfo_DefaultRedundancyTotalShares_Field {
+	return BucketMetainfo_DefaultRedundancyTotalShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyTotalShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
+	return "default_redundancy_total_shares"
+}
+
+type BucketMetainfo_Placement_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func BucketMetainfo_Placement(v int) BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _value: &v}
+}
+
+func BucketMetainfo_Placement_Raw(v *int) BucketMetainfo_Placement_Field {
+	if v == nil {
+		return BucketMetainfo_Placement_Null()
+	}
+	return BucketMetainfo_Placement(*v)
+}
+
+func BucketMetainfo_Placement_Null() BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_Placement_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_Placement_Field) _Column() string { return "placement" }
+
+type ProjectMember struct {
+	MemberId  []byte
+	ProjectId []byte
+	CreatedAt time.Time
+}
+
+func (ProjectMember) _Table() string { return "project_members" }
+
+type ProjectMember_Update_Fields struct {
+}
+
+type ProjectMember_MemberId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectMember_MemberId(v []byte) ProjectMember_MemberId_Field {
+	return ProjectMember_MemberId_Field{_set: true, _value: v}
+}
+
+func (f ProjectMember_MemberId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultSegmentSize_Field) _Column() string { return "default_segment_size" }
+func (ProjectMember_MemberId_Field) _Column() string { return "member_id" }
 
-type BucketMetainfo_DefaultEncryptionCipherSuite_Field struct {
+type ProjectMember_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func BucketMetainfo_DefaultEncryptionCipherSuite(v int) BucketMetainfo_DefaultEncryptionCipherSuite_Field {
-	return BucketMetainfo_DefaultEncryptionCipherSuite_Field{_set: true, _value: v}
+func ProjectMember_ProjectId(v []byte) ProjectMember_ProjectId_Field {
+	return ProjectMember_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultEncryptionCipherSuite_Field) value() interface{} {
+func (f ProjectMember_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultEncryptionCipherSuite_Field) _Column() string {
-	return "default_encryption_cipher_suite"
-}
+func (ProjectMember_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketMetainfo_DefaultEncryptionBlockSize_Field struct {
+type ProjectMember_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func BucketMetainfo_DefaultEncryptionBlockSize(v int) BucketMetainfo_DefaultEncryptionBlockSize_Field {
-	return BucketMetainfo_DefaultEncryptionBlockSize_Field{_set: true, _value: v}
+func ProjectMember_CreatedAt(v time.Time) ProjectMember_CreatedAt_Field {
+	return ProjectMember_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultEncryptionBlockSize_Field) value() interface{} {
+func (f ProjectMember_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultEncryptionBlockSize_Field) _Column() string {
-	return "default_encryption_block_size"
+func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
+
+type StripecoinpaymentsApplyBalanceIntent struct {
+	TxId      string
+	State     int
+	CreatedAt time.Time
 }
 
-type BucketMetainfo_DefaultRedundancyAlgorithm_Field struct {
+func (StripecoinpaymentsApplyBalanceIntent) _Table() string {
+	return "stripecoinpayments_apply_balance_intents"
+}
+
+type StripecoinpaymentsApplyBalanceIntent_Update_Fields struct {
+	State StripecoinpaymentsApplyBalanceIntent_State_Field
+}
+
+type StripecoinpaymentsApplyBalanceIntent_TxId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func BucketMetainfo_DefaultRedundancyAlgorithm(v int) BucketMetainfo_DefaultRedundancyAlgorithm_Field {
-	return BucketMetainfo_DefaultRedundancyAlgorithm_Field{_set: true, _value: v}
+func StripecoinpaymentsApplyBalanceIntent_TxId(v string) StripecoinpaymentsApplyBalanceIntent_TxId_Field {
+	return StripecoinpaymentsApplyBalanceIntent_TxId_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyAlgorithm_Field) value() interface{} {
+func (f StripecoinpaymentsApplyBalanceIntent_TxId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyAlgorithm_Field) _Column() string {
-	return "default_redundancy_algorithm"
-}
+func (StripecoinpaymentsApplyBalanceIntent_TxId_Field) _Column() string { return "tx_id" }
 
-type BucketMetainfo_DefaultRedundancyShareSize_Field struct {
+type StripecoinpaymentsApplyBalanceIntent_State_Field struct {
 	_set   bool
 	_null  bool
 	_value int
 }
 
-func BucketMetainfo_DefaultRedundancyShareSize(v int) BucketMetainfo_DefaultRedundancyShareSize_Field {
-	return BucketMetainfo_DefaultRedundancyShareSize_Field{_set: true, _value: v}
+func StripecoinpaymentsApplyBalanceIntent_State(v int) StripecoinpaymentsApplyBalanceIntent_State_Field {
+	return StripecoinpaymentsApplyBalanceIntent_State_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyShareSize_Field) value() interface{} {
+func (f StripecoinpaymentsApplyBalanceIntent_State_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyShareSize_Field) _Column() string {
-	return "default_redundancy_share_size"
-}
+func (StripecoinpaymentsApplyBalanceIntent_State_Field) _Column() string { return "state" }
 
-type BucketMetainfo_DefaultRedundancyRequiredShares_Field struct {
+type StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func BucketMetainfo_DefaultRedundancyRequiredShares(v int) BucketMetainfo_DefaultRedundancyRequiredShares_Field {
-	return BucketMetainfo_DefaultRedundancyRequiredShares_Field{_set: true, _value: v}
+func StripecoinpaymentsApplyBalanceIntent_CreatedAt(v time.Time) StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field {
+	return StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyRequiredShares_Field) value() interface{} {
+func (f StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyRequiredShares_Field) _Column() string {
-	return "default_redundancy_required_shares"
+func (StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field) _Column() string { return "created_at" }
+
+type UserCredit struct {
+	Id                   int
+	UserId               []byte
+	OfferId              int
+	ReferredBy           []byte
+	Type                 string
+	CreditsEarnedInCents int
+	CreditsUsedInCents   int
+	ExpiresAt            time.Time
+	CreatedAt            time.Time
 }
 
-type BucketMetainfo_DefaultRedundancyRepairShares_Field struct {
+func (UserCredit) _Table() string { return "user_credits" }
+
+type UserCredit_Create_Fields struct {
+	ReferredBy UserCredit_ReferredBy_Field
+}
+
+type UserCredit_Update_Fields struct {
+	CreditsUsedInCents UserCredit_CreditsUsedInCents_Field
+	ExpiresAt          UserCredit_ExpiresAt_Field
+}
+
+type UserCredit_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value int
 }
 
-func BucketMetainfo_DefaultRedundancyRepairShares(v int) BucketMetainfo_DefaultRedundancyRepairShares_Field {
-	return BucketMetainfo_DefaultRedundancyRepairShares_Field{_set: true, _value: v}
+func UserCredit_Id(v int) UserCredit_Id_Field {
+	return UserCredit_Id_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyRepairShares_Field) value() interface{} {
+func (f UserCredit_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyRepairShares_Field) _Column() string {
-	return "default_redundancy_repair_shares"
-}
+func (UserCredit_Id_Field) _Column() string { return "id" }
 
-type BucketMetainfo_DefaultRedundancyOptimalShares_Field struct {
+type UserCredit_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func BucketMetainfo_DefaultRedundancyOptimalShares(v int) BucketMetainfo_DefaultRedundancyOptimalShares_Field {
-	return BucketMetainfo_DefaultRedundancyOptimalShares_Field{_set: true, _value: v}
+func UserCredit_UserId(v []byte) UserCredit_UserId_Field {
+	return UserCredit_UserId_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyOptimalShares_Field) value() interface{} {
+func (f UserCredit_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyOptimalShares_Field) _Column() string {
-	return "default_redundancy_optimal_shares"
-}
+func (UserCredit_UserId_Field) _Column() string { return "user_id" }
 
-type BucketMetainfo_DefaultRedundancyTotalShares_Field struct {
+type UserCredit_OfferId_Field struct {
 	_set   bool
 	_null  bool
 	_value int
 }
 
-func BucketMetainfo_DefaultRedundancyTotalShares(v int) BucketMetainfo_DefaultRedundancyTotalShares_Field {
-	return BucketMetainfo_DefaultRedundancyTotalShares_Field{_set: true, _value: v}
+func UserCredit_OfferId(v int) UserCredit_OfferId_Field {
+	return UserCredit_OfferId_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyTotalShares_Field) value() interface{} {
+func (f UserCredit_OfferId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
-	return "default_redundancy_total_shares"
-}
+func (UserCredit_OfferId_Field) _Column() string { return "offer_id" }
 
-type BucketMetainfo_Placement_Field struct {
+type UserCredit_ReferredBy_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value []byte
 }
 
-func BucketMetainfo_Placement(v int) BucketMetainfo_Placement_Field {
-	return BucketMetainfo_Placement_Field{_set: true, _value: &v}
+func UserCredit_ReferredBy(v []byte) UserCredit_ReferredBy_Field {
+	return UserCredit_ReferredBy_Field{_set: true, _value: v}
 }
 
-func BucketMetainfo_Placement_Raw(v *int) BucketMetainfo_Placement_Field {
+func UserCredit_ReferredBy_Raw(v []byte) UserCredit_ReferredBy_Field {
 	if v == nil {
-		return BucketMetainfo_Placement_Null()
+		return UserCredit_ReferredBy_Null()
 	}
-	return BucketMetainfo_Placement(*v)
+	return UserCredit_ReferredBy(v)
 }
 
-func BucketMetainfo_Placement_Null() BucketMetainfo_Placement_Field {
-	return BucketMetainfo_Placement_Field{_set: true, _null: true}
+func UserCredit_ReferredBy_Null() UserCredit_ReferredBy_Field {
+	return UserCredit_ReferredBy_Field{_set: true, _null: true}
 }
 
-func (f BucketMetainfo_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f UserCredit_ReferredBy_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f BucketMetainfo_Placement_Field) value() interface{} {
+func (f UserCredit_ReferredBy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_Placement_Field) _Column() string { return "placement" }
+func (UserCredit_ReferredBy_Field) _Column() string { return "referred_by" }
 
-type ProjectMember struct {
-	MemberId  []byte
-	ProjectId []byte
-	CreatedAt time.Time
+type UserCredit_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (ProjectMember) _Table() string { return "project_members" }
+func UserCredit_Type(v string) UserCredit_Type_Field {
+	return UserCredit_Type_Field{_set: true, _value: v}
+}
 
-type ProjectMember_Update_Fields struct {
+func (f UserCredit_Type_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type ProjectMember_MemberId_Field struct {
+func (UserCredit_Type_Field) _Column() string { return "type" }
+
+type UserCredit_CreditsEarnedInCents_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func ProjectMember_MemberId(v []byte) ProjectMember_MemberId_Field {
-	return ProjectMember_MemberId_Field{_set: true, _value: v}
+func UserCredit_CreditsEarnedInCents(v int) UserCredit_CreditsEarnedInCents_Field {
+	return UserCredit_CreditsEarnedInCents_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_MemberId_Field) value() interface{} {
+func (f UserCredit_CreditsEarnedInCents_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_MemberId_Field) _Column() string { return "member_id" }
+func (UserCredit_CreditsEarnedInCents_Field) _Column() string { return "credits_earned_in_cents" }
 
-type ProjectMember_ProjectId_Field struct {
+type UserCredit_CreditsUsedInCents_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func ProjectMember_ProjectId(v []byte) ProjectMember_ProjectId_Field {
-	return ProjectMember_ProjectId_Field{_set: true, _value: v}
+func UserCredit_CreditsUsedInCents(v int) UserCredit_CreditsUsedInCents_Field {
+	return UserCredit_CreditsUsedInCents_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_ProjectId_Field) value() interface{} {
+func (f UserCredit_CreditsUsedInCents_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_ProjectId_Field) _Column() string { return "project_id" }
+func (UserCredit_CreditsUsedInCents_Field) _Column() string { return "credits_used_in_cents" }
 
-type ProjectMember_CreatedAt_Field struct {
+type UserCredit_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func ProjectMember_CreatedAt(v time.Time) ProjectMember_CreatedAt_Field {
-	return ProjectMember_CreatedAt_Field{_set: true, _value: v}
+func UserCredit_ExpiresAt(v time.Time) UserCredit_ExpiresAt_Field {
+	return UserCredit_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_CreatedAt_Field) value() interface{} {
+func (f UserCredit_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
+func (UserCredit_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type StripecoinpaymentsApplyBalanceIntent struct {
-	TxId      string
-	State     int
-	CreatedAt time.Time
+type UserCredit_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (StripecoinpaymentsApplyBalanceIntent) _Table() string {
-	return "stripecoinpayments_apply_balance_intents"
+func UserCredit_CreatedAt(v time.Time) UserCredit_CreatedAt_Field {
+	return UserCredit_CreatedAt_Field{_set: true, _value: v}
 }
 
-type StripecoinpaymentsApplyBalanceIntent_Update_Fields struct {
-	State StripecoinpaymentsApplyBalanceIntent_State_Field
+func (f UserCredit_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type StripecoinpaymentsApplyBalanceIntent_TxId_Field struct {
+func (UserCredit_CreatedAt_Field) _Column() string { return "created_at" }
+
+type UserOnboardingStep struct {
+	UserId      []byte
+	Step        string
+	CompletedAt time.Time
+}
+
+func (UserOnboardingStep) _Table() string { return "user_onboarding_steps" }
+
+type UserOnboardingStep_Update_Fields struct {
+}
+
+type UserOnboardingStep_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
-
-func StripecoinpaymentsApplyBalanceIntent_TxId(v string) StripecoinpaymentsApplyBalanceIntent_TxId_Field {
-	return StripecoinpaymentsApplyBalanceIntent_TxId_Field{_set: true, _value: v}
+
+func UserOnboardingStep_UserId(v []byte) UserOnboardingStep_UserId_Field {
+	return UserOnboardingStep_UserId_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsApplyBalanceIntent_TxId_Field) value() interface{} {
+func (f UserOnboardingStep_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsApplyBalanceIntent_TxId_Field) _Column() string { return "tx_id" }
+func (UserOnboardingStep_UserId_Field) _Column() string { return "user_id" }
 
-type StripecoinpaymentsApplyBalanceIntent_State_Field struct {
+type UserOnboardingStep_Step_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func StripecoinpaymentsApplyBalanceIntent_State(v int) StripecoinpaymentsApplyBalanceIntent_State_Field {
-	return StripecoinpaymentsApplyBalanceIntent_State_Field{_set: true, _value: v}
+func UserOnboardingStep_Step(v string) UserOnboardingStep_Step_Field {
+	return UserOnboardingStep_Step_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsApplyBalanceIntent_State_Field) value() interface{} {
+func (f UserOnboardingStep_Step_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsApplyBalanceIntent_State_Field) _Column() string { return "state" }
+func (UserOnboardingStep_Step_Field) _Column() string { return "step" }
 
-type StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field struct {
+type UserOnboardingStep_CompletedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func StripecoinpaymentsApplyBalanceIntent_CreatedAt(v time.Time) StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field {
-	return StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field{_set: true, _value: v}
+func UserOnboardingStep_CompletedAt(v time.Time) UserOnboardingStep_CompletedAt_Field {
+	return UserOnboardingStep_CompletedAt_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field) value() interface{} {
+func (f UserOnboardingStep_CompletedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field) _Column() string { return "created_at" }
+func (UserOnboardingStep_CompletedAt_Field) _Column() string { return "completed_at" }
 
-type UserCredit struct {
-	Id                   int
-	UserId               []byte
-	OfferId              int
-	ReferredBy           []byte
-	Type                 string
-	CreditsEarnedInCents int
-	CreditsUsedInCents   int
-	ExpiresAt            time.Time
-	CreatedAt            time.Time
+type BucketLifecycleRule struct {
+	BucketId                           []byte
+	Id                                 string
+	Prefix                             []byte
+	ExpirationDays                     *int
+	AbortIncompleteMultipartUploadDays *int
+	CreatedAt                          time.Time
 }
 
-func (UserCredit) _Table() string { return "user_credits" }
+func (BucketLifecycleRule) _Table() string { return "bucket_lifecycle_rules" }
 
-type UserCredit_Create_Fields struct {
-	ReferredBy UserCredit_ReferredBy_Field
+type BucketLifecycleRule_Create_Fields struct {
+	ExpirationDays                     BucketLifecycleRule_ExpirationDays_Field
+	AbortIncompleteMultipartUploadDays BucketLifecycleRule_AbortIncompleteMultipartUploadDays_Field
 }
 
-type UserCredit_Update_Fields struct {
-	CreditsUsedInCents UserCredit_CreditsUsedInCents_Field
-	ExpiresAt          UserCredit_ExpiresAt_Field
+type BucketLifecycleRule_Update_Fields struct {
 }
 
-type UserCredit_Id_Field struct {
+type BucketLifecycleRule_BucketId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func UserCredit_Id(v int) UserCredit_Id_Field {
-	return UserCredit_Id_Field{_set: true, _value: v}
+func BucketLifecycleRule_BucketId(v []byte) BucketLifecycleRule_BucketId_Field {
+	return BucketLifecycleRule_BucketId_Field{_set: true, _value: v}
 }
 
-func (f UserCredit_Id_Field) value() interface{} {
+func (f BucketLifecycleRule_BucketId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserCredit_Id_Field) _Column() string { return "id" }
+func (BucketLifecycleRule_BucketId_Field) _Column() string { return "bucket_id" }
 
-type UserCredit_UserId_Field struct {
+type BucketLifecycleRule_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func BucketLifecycleRule_Id(v string) BucketLifecycleRule_Id_Field {
+	return BucketLifecycleRule_Id_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycleRule_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketLifecycleRule_Id_Field) _Column() string { return "id" }
+
+type BucketLifecycleRule_Prefix_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func UserCredit_UserId(v []byte) UserCredit_UserId_Field {
-	return UserCredit_UserId_Field{_set: true, _value: v}
+func BucketLifecycleRule_Prefix(v []byte) BucketLifecycleRule_Prefix_Field {
+	return BucketLifecycleRule_Prefix_Field{_set: true, _value: v}
 }
 
-func (f UserCredit_UserId_Field) value() interface{} {
+func (f BucketLifecycleRule_Prefix_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserCredit_UserId_Field) _Column() string { return "user_id" }
+func (BucketLifecycleRule_Prefix_Field) _Column() string { return "prefix" }
 
-type UserCredit_OfferId_Field struct {
+type BucketLifecycleRule_ExpirationDays_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *int
 }
 
-func UserCredit_OfferId(v int) UserCredit_OfferId_Field {
-	return UserCredit_OfferId_Field{_set: true, _value: v}
+func BucketLifecycleRule_ExpirationDays(v int) BucketLifecycleRule_ExpirationDays_Field {
+	return BucketLifecycleRule_ExpirationDays_Field{_set: true, _value: &v}
 }
 
-func (f UserCredit_OfferId_Field) value() interface{} {
+func BucketLifecycleRule_ExpirationDays_Raw(v *int) BucketLifecycleRule_ExpirationDays_Field {
+	if v == nil {
+		return BucketLifecycleRule_ExpirationDays_Null()
+	}
+	return BucketLifecycleRule_ExpirationDays(*v)
+}
+
+func BucketLifecycleRule_ExpirationDays_Null() BucketLifecycleRule_ExpirationDays_Field {
+	return BucketLifecycleRule_ExpirationDays_Field{_set: true, _null: true}
+}
+
+func (f BucketLifecycleRule_ExpirationDays_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketLifecycleRule_ExpirationDays_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserCredit_OfferId_Field) _Column() string { return "offer_id" }
+func (BucketLifecycleRule_ExpirationDays_Field) _Column() string { return "expiration_days" }
 
-type UserCredit_ReferredBy_Field struct {
+type BucketLifecycleRule_AbortIncompleteMultipartUploadDays_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int
 }
 
-func UserCredit_ReferredBy(v []byte) UserCredit_ReferredBy_Field {
-	return UserCredit_ReferredBy_Field{_set: true, _value: v}
+func BucketLifecycleRule_AbortIncompleteMultipartUploadDays(v int) BucketLifecycleRule_AbortIncompleteMultipartUploadDays_Field {
+	return BucketLifecycleRule_AbortIncompleteMultipartUploadDays_Field{_set: true, _value: &v}
 }
 
-func UserCredit_ReferredBy_Raw(v []byte) UserCredit_ReferredBy_Field {
+func BucketLifecycleRule_AbortIncompleteMultipartUploadDays_Raw(v *int) BucketLifecycleRule_AbortIncompleteMultipartUploadDays_Field {
 	if v == nil {
-		return UserCredit_ReferredBy_Null()
+		return BucketLifecycleRule_AbortIncompleteMultipartUploadDays_Null()
 	}
-	return UserCredit_ReferredBy(v)
+	return BucketLifecycleRule_AbortIncompleteMultipartUploadDays(*v)
 }
 
-func UserCredit_ReferredBy_Null() UserCredit_ReferredBy_Field {
-	return UserCredit_ReferredBy_Field{_set: true, _null: true}
+func BucketLifecycleRule_AbortIncompleteMultipartUploadDays_Null() BucketLifecycleRule_AbortIncompleteMultipartUploadDays_Field {
+	return BucketLifecycleRule_AbortIncompleteMultipartUploadDays_Field{_set: true, _null: true}
 }
 
-func (f UserCredit_ReferredBy_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f BucketLifecycleRule_AbortIncompleteMultipartUploadDays_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f UserCredit_ReferredBy_Field) value() interface{} {
+func (f BucketLifecycleRule_AbortIncompleteMultipartUploadDays_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserCredit_ReferredBy_Field) _Column() string { return "referred_by" }
+func (BucketLifecycleRule_AbortIncompleteMultipartUploadDays_Field) _Column() string {
+	return "abort_incomplete_multipart_upload_days"
+}
 
-type UserCredit_Type_Field struct {
+type BucketLifecycleRule_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func UserCredit_Type(v string) UserCredit_Type_Field {
-	return UserCredit_Type_Field{_set: true, _value: v}
+func BucketLifecycleRule_CreatedAt(v time.Time) BucketLifecycleRule_CreatedAt_Field {
+	return BucketLifecycleRule_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f UserCredit_Type_Field) value() interface{} {
+func (f BucketLifecycleRule_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserCredit_Type_Field) _Column() string { return "type" }
+func (BucketLifecycleRule_CreatedAt_Field) _Column() string { return "created_at" }
 
-type UserCredit_CreditsEarnedInCents_Field struct {
+type BucketQuota struct {
+	BucketId   []byte
+	MaxObjects *int64
+	MaxBytes   *int64
+	UpdatedAt  time.Time
+}
+
+func (BucketQuota) _Table() string { return "bucket_quotas" }
+
+type BucketQuota_Create_Fields struct {
+	MaxObjects BucketQuota_MaxObjects_Field
+	MaxBytes   BucketQuota_MaxBytes_Field
+}
+
+type BucketQuota_Update_Fields struct {
+	MaxObjects BucketQuota_MaxObjects_Field
+	MaxBytes   BucketQuota_MaxBytes_Field
+}
+
+type BucketQuota_BucketId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func UserCredit_CreditsEarnedInCents(v int) UserCredit_CreditsEarnedInCents_Field {
-	return UserCredit_CreditsEarnedInCents_Field{_set: true, _value: v}
+func BucketQuota_BucketId(v []byte) BucketQuota_BucketId_Field {
+	return BucketQuota_BucketId_Field{_set: true, _value: v}
 }
 
-func (f UserCredit_CreditsEarnedInCents_Field) value() interface{} {
+func (f BucketQuota_BucketId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserCredit_CreditsEarnedInCents_Field) _Column() string { return "credits_earned_in_cents" }
+func (BucketQuota_BucketId_Field) _Column() string { return "bucket_id" }
 
-type UserCredit_CreditsUsedInCents_Field struct {
+type BucketQuota_MaxObjects_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *int64
 }
 
-func UserCredit_CreditsUsedInCents(v int) UserCredit_CreditsUsedInCents_Field {
-	return UserCredit_CreditsUsedInCents_Field{_set: true, _value: v}
+func BucketQuota_MaxObjects(v int64) BucketQuota_MaxObjects_Field {
+	return BucketQuota_MaxObjects_Field{_set: true, _value: &v}
 }
 
-func (f UserCredit_CreditsUsedInCents_Field) value() interface{} {
+func BucketQuota_MaxObjects_Raw(v *int64) BucketQuota_MaxObjects_Field {
+	if v == nil {
+		return BucketQuota_MaxObjects_Null()
+	}
+	return BucketQuota_MaxObjects(*v)
+}
+
+func BucketQuota_MaxObjects_Null() BucketQuota_MaxObjects_Field {
+	return BucketQuota_MaxObjects_Field{_set: true, _null: true}
+}
+
+func (f BucketQuota_MaxObjects_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketQuota_MaxObjects_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserCredit_CreditsUsedInCents_Field) _Column() string { return "credits_used_in_cents" }
+func (BucketQuota_MaxObjects_Field) _Column() string { return "max_objects" }
 
-type UserCredit_ExpiresAt_Field struct {
+type BucketQuota_MaxBytes_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *int64
 }
 
-func UserCredit_ExpiresAt(v time.Time) UserCredit_ExpiresAt_Field {
-	return UserCredit_ExpiresAt_Field{_set: true, _value: v}
+func BucketQuota_MaxBytes(v int64) BucketQuota_MaxBytes_Field {
+	return BucketQuota_MaxBytes_Field{_set: true, _value: &v}
 }
 
-func (f UserCredit_ExpiresAt_Field) value() interface{} {
+func BucketQuota_MaxBytes_Raw(v *int64) BucketQuota_MaxBytes_Field {
+	if v == nil {
+		return BucketQuota_MaxBytes_Null()
+	}
+	return BucketQuota_MaxBytes(*v)
+}
+
+func BucketQuota_MaxBytes_Null() BucketQuota_MaxBytes_Field {
+	return BucketQuota_MaxBytes_Field{_set: true, _null: true}
+}
+
+func (f BucketQuota_MaxBytes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketQuota_MaxBytes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserCredit_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (BucketQuota_MaxBytes_Field) _Column() string { return "max_bytes" }
 
-type UserCredit_CreatedAt_Field struct {
+type BucketQuota_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func UserCredit_CreatedAt(v time.Time) UserCredit_CreatedAt_Field {
-	return UserCredit_CreatedAt_Field{_set: true, _value: v}
+func BucketQuota_UpdatedAt(v time.Time) BucketQuota_UpdatedAt_Field {
+	return BucketQuota_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f UserCredit_CreatedAt_Field) value() interface{} {
+func (f BucketQuota_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (UserCredit_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketQuota_UpdatedAt_Field) _Column() string { return "updated_at" }
 
 func toUTC(t time.Time) time.Time {
 	return t.UTC()
@@ -11799,16 +14584,17 @@ func (obj *pgxImpl) Create_Reputation(ctx context.Context,
 	__unknown_audit_suspended_val := optional.UnknownAuditSuspended.value()
 	__offline_suspended_val := optional.OfflineSuspended.value()
 	__under_review_val := optional.UnderReview.value()
+	__audit_warned_val := optional.AuditWarned.value()
 	__audit_history_val := reputation_audit_history.value()
 
-	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, vetted_at, disqualified, disqualification_reason, unknown_audit_suspended, offline_suspended, under_review, audit_history")}
-	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?")}
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, vetted_at, disqualified, disqualification_reason, unknown_audit_suspended, offline_suspended, under_review, audit_warned, audit_history")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?")}
 	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO reputations "), __clause, __sqlbundle_Literal(" RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO reputations "), __clause, __sqlbundle_Literal(" RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.audit_warned, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __vetted_at_val, __disqualified_val, __disqualification_reason_val, __unknown_audit_suspended_val, __offline_suspended_val, __under_review_val, __audit_history_val)
+	__values = append(__values, __id_val, __vetted_at_val, __disqualified_val, __disqualification_reason_val, __unknown_audit_suspended_val, __offline_suspended_val, __under_review_val, __audit_warned_val, __audit_history_val)
 
 	__optional_columns := __sqlbundle_Literals{Join: ", "}
 	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
@@ -11867,7 +14653,7 @@ func (obj *pgxImpl) Create_Reputation(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	reputation = &Reputation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.AuditWarned, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -12251,7 +15037,9 @@ func (obj *pgxImpl) ReplaceNoReturn_StoragenodePaystub(ctx context.Context,
 	storagenode_paystub_owed StoragenodePaystub_Owed_Field,
 	storagenode_paystub_disposed StoragenodePaystub_Disposed_Field,
 	storagenode_paystub_paid StoragenodePaystub_Paid_Field,
-	storagenode_paystub_distributed StoragenodePaystub_Distributed_Field) (
+	storagenode_paystub_distributed StoragenodePaystub_Distributed_Field,
+	storagenode_paystub_wallet StoragenodePaystub_Wallet_Field,
+	storagenode_paystub_wallet_features StoragenodePaystub_WalletFeatures_Field) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -12278,11 +15066,13 @@ func (obj *pgxImpl) ReplaceNoReturn_StoragenodePaystub(ctx context.Context,
 	__disposed_val := storagenode_paystub_disposed.value()
 	__paid_val := storagenode_paystub_paid.value()
 	__distributed_val := storagenode_paystub_distributed.value()
+	__wallet_val := storagenode_paystub_wallet.value()
+	__wallet_features_val := storagenode_paystub_wallet_features.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO storagenode_paystubs ( period, node_id, created_at, codes, usage_at_rest, usage_get, usage_put, usage_get_repair, usage_put_repair, usage_get_audit, comp_at_rest, comp_get, comp_put, comp_get_repair, comp_put_repair, comp_get_audit, surge_percent, held, owed, disposed, paid, distributed ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) ON CONFLICT ( period, node_id ) DO UPDATE SET period = EXCLUDED.period, node_id = EXCLUDED.node_id, created_at = EXCLUDED.created_at, codes = EXCLUDED.codes, usage_at_rest = EXCLUDED.usage_at_rest, usage_get = EXCLUDED.usage_get, usage_put = EXCLUDED.usage_put, usage_get_repair = EXCLUDED.usage_get_repair, usage_put_repair = EXCLUDED.usage_put_repair, usage_get_audit = EXCLUDED.usage_get_audit, comp_at_rest = EXCLUDED.comp_at_rest, comp_get = EXCLUDED.comp_get, comp_put = EXCLUDED.comp_put, comp_get_repair = EXCLUDED.comp_get_repair, comp_put_repair = EXCLUDED.comp_put_repair, comp_get_audit = EXCLUDED.comp_get_audit, surge_percent = EXCLUDED.surge_percent, held = EXCLUDED.held, owed = EXCLUDED.owed, disposed = EXCLUDED.disposed, paid = EXCLUDED.paid, distributed = EXCLUDED.distributed")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO storagenode_paystubs ( period, node_id, created_at, codes, usage_at_rest, usage_get, usage_put, usage_get_repair, usage_put_repair, usage_get_audit, comp_at_rest, comp_get, comp_put, comp_get_repair, comp_put_repair, comp_get_audit, surge_percent, held, owed, disposed, paid, distributed, wallet, wallet_features ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) ON CONFLICT ( period, node_id ) DO UPDATE SET period = EXCLUDED.period, node_id = EXCLUDED.node_id, created_at = EXCLUDED.created_at, codes = EXCLUDED.codes, usage_at_rest = EXCLUDED.usage_at_rest, usage_get = EXCLUDED.usage_get, usage_put = EXCLUDED.usage_put, usage_get_repair = EXCLUDED.usage_get_repair, usage_put_repair = EXCLUDED.usage_put_repair, usage_get_audit = EXCLUDED.usage_get_audit, comp_at_rest = EXCLUDED.comp_at_rest, comp_get = EXCLUDED.comp_get, comp_put = EXCLUDED.comp_put, comp_get_repair = EXCLUDED.comp_get_repair, comp_put_repair = EXCLUDED.comp_put_repair, comp_get_audit = EXCLUDED.comp_get_audit, surge_percent = EXCLUDED.surge_percent, held = EXCLUDED.held, owed = EXCLUDED.owed, disposed = EXCLUDED.disposed, paid = EXCLUDED.paid, distributed = EXCLUDED.distributed, wallet = EXCLUDED.wallet, wallet_features = EXCLUDED.wallet_features")
 
 	var __values []interface{}
-	__values = append(__values, __period_val, __node_id_val, __created_at_val, __codes_val, __usage_at_rest_val, __usage_get_val, __usage_put_val, __usage_get_repair_val, __usage_put_repair_val, __usage_get_audit_val, __comp_at_rest_val, __comp_get_val, __comp_put_val, __comp_get_repair_val, __comp_put_repair_val, __comp_get_audit_val, __surge_percent_val, __held_val, __owed_val, __disposed_val, __paid_val, __distributed_val)
+	__values = append(__values, __period_val, __node_id_val, __created_at_val, __codes_val, __usage_at_rest_val, __usage_get_val, __usage_put_val, __usage_get_repair_val, __usage_put_repair_val, __usage_get_audit_val, __comp_at_rest_val, __comp_get_val, __comp_put_val, __comp_get_repair_val, __comp_put_repair_val, __comp_get_audit_val, __surge_percent_val, __held_val, __owed_val, __disposed_val, __paid_val, __distributed_val, __wallet_val, __wallet_features_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -13194,7 +15984,7 @@ func (obj *pgxImpl) Get_Reputation_By_Id(ctx context.Context,
 	reputation *Reputation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta FROM reputations WHERE reputations.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.audit_warned, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta FROM reputations WHERE reputations.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, reputation_id.value())
@@ -13203,7 +15993,7 @@ func (obj *pgxImpl) Get_Reputation_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	reputation = &Reputation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.AuditWarned, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
 	if err != nil {
 		return (*Reputation)(nil), obj.makeErr(err)
 	}
@@ -14009,9 +16799,9 @@ func (obj *pgxImpl) Paged_BucketBandwidthRollup_By_IntervalStart_GreaterOrEqual(
 	rows []*BucketBandwidthRollup, next *Paged_BucketBandwidthRollup_By_IntervalStart_GreaterOrEqual_Continuation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.interval_seconds, bucket_bandwidth_rollups.action, bucket_bandwidth_rollups.inline, bucket_bandwidth_rollups.allocated, bucket_bandwidth_rollups.settled, bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action FROM bucket_bandwidth_rollups WHERE bucket_bandwidth_rollups.interval_start >= ? AND (bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action) > (?, ?, ?, ?) ORDER BY bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.interval_seconds, bucket_bandwidth_rollups.action, bucket_bandwidth_rollups.inline, bucket_bandwidth_rollups.allocated, bucket_bandwidth_rollups.settled, bucket_bandwidth_rollups.placement, bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action FROM bucket_bandwidth_rollups WHERE bucket_bandwidth_rollups.interval_start >= ? AND (bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action) > (?, ?, ?, ?) ORDER BY bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.interval_seconds, bucket_bandwidth_rollups.action, bucket_bandwidth_rollups.inline, bucket_bandwidth_rollups.allocated, bucket_bandwidth_rollups.settled, bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action FROM bucket_bandwidth_rollups WHERE bucket_bandwidth_rollups.interval_start >= ? ORDER BY bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.interval_seconds, bucket_bandwidth_rollups.action, bucket_bandwidth_rollups.inline, bucket_bandwidth_rollups.allocated, bucket_bandwidth_rollups.settled, bucket_bandwidth_rollups.placement, bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action FROM bucket_bandwidth_rollups WHERE bucket_bandwidth_rollups.interval_start >= ? ORDER BY bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action LIMIT ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_bandwidth_rollup_interval_start_greater_or_equal.value())
@@ -14039,7 +16829,7 @@ func (obj *pgxImpl) Paged_BucketBandwidthRollup_By_IntervalStart_GreaterOrEqual(
 
 			for __rows.Next() {
 				bucket_bandwidth_rollup := &BucketBandwidthRollup{}
-				err = __rows.Scan(&bucket_bandwidth_rollup.BucketName, &bucket_bandwidth_rollup.ProjectId, &bucket_bandwidth_rollup.IntervalStart, &bucket_bandwidth_rollup.IntervalSeconds, &bucket_bandwidth_rollup.Action, &bucket_bandwidth_rollup.Inline, &bucket_bandwidth_rollup.Allocated, &bucket_bandwidth_rollup.Settled, &__continuation._value_bucket_name, &__continuation._value_project_id, &__continuation._value_interval_start, &__continuation._value_action)
+				err = __rows.Scan(&bucket_bandwidth_rollup.BucketName, &bucket_bandwidth_rollup.ProjectId, &bucket_bandwidth_rollup.IntervalStart, &bucket_bandwidth_rollup.IntervalSeconds, &bucket_bandwidth_rollup.Action, &bucket_bandwidth_rollup.Inline, &bucket_bandwidth_rollup.Allocated, &bucket_bandwidth_rollup.Settled, &bucket_bandwidth_rollup.Placement, &__continuation._value_bucket_name, &__continuation._value_project_id, &__continuation._value_interval_start, &__continuation._value_action)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -14129,7 +16919,7 @@ func (obj *pgxImpl) All_BucketStorageTally_OrderBy_Desc_IntervalStart(ctx contex
 	rows []*BucketStorageTally, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_storage_tallies.bucket_name, bucket_storage_tallies.project_id, bucket_storage_tallies.interval_start, bucket_storage_tallies.total_bytes, bucket_storage_tallies.inline, bucket_storage_tallies.remote, bucket_storage_tallies.total_segments_count, bucket_storage_tallies.remote_segments_count, bucket_storage_tallies.inline_segments_count, bucket_storage_tallies.object_count, bucket_storage_tallies.metadata_size FROM bucket_storage_tallies ORDER BY bucket_storage_tallies.interval_start DESC")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_storage_tallies.bucket_name, bucket_storage_tallies.project_id, bucket_storage_tallies.interval_start, bucket_storage_tallies.total_bytes, bucket_storage_tallies.inline, bucket_storage_tallies.remote, bucket_storage_tallies.total_segments_count, bucket_storage_tallies.remote_segments_count, bucket_storage_tallies.inline_segments_count, bucket_storage_tallies.object_count, bucket_storage_tallies.metadata_size, bucket_storage_tallies.placement FROM bucket_storage_tallies ORDER BY bucket_storage_tallies.interval_start DESC")
 
 	var __values []interface{}
 
@@ -14146,7 +16936,7 @@ func (obj *pgxImpl) All_BucketStorageTally_OrderBy_Desc_IntervalStart(ctx contex
 
 			for __rows.Next() {
 				bucket_storage_tally := &BucketStorageTally{}
-				err = __rows.Scan(&bucket_storage_tally.BucketName, &bucket_storage_tally.ProjectId, &bucket_storage_tally.IntervalStart, &bucket_storage_tally.TotalBytes, &bucket_storage_tally.Inline, &bucket_storage_tally.Remote, &bucket_storage_tally.TotalSegmentsCount, &bucket_storage_tally.RemoteSegmentsCount, &bucket_storage_tally.InlineSegmentsCount, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.MetadataSize)
+				err = __rows.Scan(&bucket_storage_tally.BucketName, &bucket_storage_tally.ProjectId, &bucket_storage_tally.IntervalStart, &bucket_storage_tally.TotalBytes, &bucket_storage_tally.Inline, &bucket_storage_tally.Remote, &bucket_storage_tally.TotalSegmentsCount, &bucket_storage_tally.RemoteSegmentsCount, &bucket_storage_tally.InlineSegmentsCount, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.MetadataSize, &bucket_storage_tally.Placement)
 				if err != nil {
 					return nil, err
 				}
@@ -14176,7 +16966,7 @@ func (obj *pgxImpl) All_BucketStorageTally_By_ProjectId_And_BucketName_And_Inter
 	rows []*BucketStorageTally, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_storage_tallies.bucket_name, bucket_storage_tallies.project_id, bucket_storage_tallies.interval_start, bucket_storage_tallies.total_bytes, bucket_storage_tallies.inline, bucket_storage_tallies.remote, bucket_storage_tallies.total_segments_count, bucket_storage_tallies.remote_segments_count, bucket_storage_tallies.inline_segments_count, bucket_storage_tallies.object_count, bucket_storage_tallies.metadata_size FROM bucket_storage_tallies WHERE bucket_storage_tallies.project_id = ? AND bucket_storage_tallies.bucket_name = ? AND bucket_storage_tallies.interval_start >= ? AND bucket_storage_tallies.interval_start <= ? ORDER BY bucket_storage_tallies.interval_start DESC")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_storage_tallies.bucket_name, bucket_storage_tallies.project_id, bucket_storage_tallies.interval_start, bucket_storage_tallies.total_bytes, bucket_storage_tallies.inline, bucket_storage_tallies.remote, bucket_storage_tallies.total_segments_count, bucket_storage_tallies.remote_segments_count, bucket_storage_tallies.inline_segments_count, bucket_storage_tallies.object_count, bucket_storage_tallies.metadata_size, bucket_storage_tallies.placement FROM bucket_storage_tallies WHERE bucket_storage_tallies.project_id = ? AND bucket_storage_tallies.bucket_name = ? AND bucket_storage_tallies.interval_start >= ? AND bucket_storage_tallies.interval_start <= ? ORDER BY bucket_storage_tallies.interval_start DESC")
 
 	var __values []interface{}
 	__values = append(__values, bucket_storage_tally_project_id.value(), bucket_storage_tally_bucket_name.value(), bucket_storage_tally_interval_start_greater_or_equal.value(), bucket_storage_tally_interval_start_less_or_equal.value())
@@ -14194,7 +16984,7 @@ func (obj *pgxImpl) All_BucketStorageTally_By_ProjectId_And_BucketName_And_Inter
 
 			for __rows.Next() {
 				bucket_storage_tally := &BucketStorageTally{}
-				err = __rows.Scan(&bucket_storage_tally.BucketName, &bucket_storage_tally.ProjectId, &bucket_storage_tally.IntervalStart, &bucket_storage_tally.TotalBytes, &bucket_storage_tally.Inline, &bucket_storage_tally.Remote, &bucket_storage_tally.TotalSegmentsCount, &bucket_storage_tally.RemoteSegmentsCount, &bucket_storage_tally.InlineSegmentsCount, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.MetadataSize)
+				err = __rows.Scan(&bucket_storage_tally.BucketName, &bucket_storage_tally.ProjectId, &bucket_storage_tally.IntervalStart, &bucket_storage_tally.TotalBytes, &bucket_storage_tally.Inline, &bucket_storage_tally.Remote, &bucket_storage_tally.TotalSegmentsCount, &bucket_storage_tally.RemoteSegmentsCount, &bucket_storage_tally.InlineSegmentsCount, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.MetadataSize, &bucket_storage_tally.Placement)
 				if err != nil {
 					return nil, err
 				}
@@ -14602,7 +17392,7 @@ func (obj *pgxImpl) Get_StoragenodePaystub_By_NodeId_And_Period(ctx context.Cont
 	storagenode_paystub *StoragenodePaystub, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_paystubs.period, storagenode_paystubs.node_id, storagenode_paystubs.created_at, storagenode_paystubs.codes, storagenode_paystubs.usage_at_rest, storagenode_paystubs.usage_get, storagenode_paystubs.usage_put, storagenode_paystubs.usage_get_repair, storagenode_paystubs.usage_put_repair, storagenode_paystubs.usage_get_audit, storagenode_paystubs.comp_at_rest, storagenode_paystubs.comp_get, storagenode_paystubs.comp_put, storagenode_paystubs.comp_get_repair, storagenode_paystubs.comp_put_repair, storagenode_paystubs.comp_get_audit, storagenode_paystubs.surge_percent, storagenode_paystubs.held, storagenode_paystubs.owed, storagenode_paystubs.disposed, storagenode_paystubs.paid, storagenode_paystubs.distributed FROM storagenode_paystubs WHERE storagenode_paystubs.node_id = ? AND storagenode_paystubs.period = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_paystubs.period, storagenode_paystubs.node_id, storagenode_paystubs.created_at, storagenode_paystubs.codes, storagenode_paystubs.usage_at_rest, storagenode_paystubs.usage_get, storagenode_paystubs.usage_put, storagenode_paystubs.usage_get_repair, storagenode_paystubs.usage_put_repair, storagenode_paystubs.usage_get_audit, storagenode_paystubs.comp_at_rest, storagenode_paystubs.comp_get, storagenode_paystubs.comp_put, storagenode_paystubs.comp_get_repair, storagenode_paystubs.comp_put_repair, storagenode_paystubs.comp_get_audit, storagenode_paystubs.surge_percent, storagenode_paystubs.held, storagenode_paystubs.owed, storagenode_paystubs.disposed, storagenode_paystubs.paid, storagenode_paystubs.distributed, storagenode_paystubs.wallet, storagenode_paystubs.wallet_features FROM storagenode_paystubs WHERE storagenode_paystubs.node_id = ? AND storagenode_paystubs.period = ?")
 
 	var __values []interface{}
 	__values = append(__values, storagenode_paystub_node_id.value(), storagenode_paystub_period.value())
@@ -14611,7 +17401,7 @@ func (obj *pgxImpl) Get_StoragenodePaystub_By_NodeId_And_Period(ctx context.Cont
 	obj.logStmt(__stmt, __values...)
 
 	storagenode_paystub = &StoragenodePaystub{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&storagenode_paystub.Period, &storagenode_paystub.NodeId, &storagenode_paystub.CreatedAt, &storagenode_paystub.Codes, &storagenode_paystub.UsageAtRest, &storagenode_paystub.UsageGet, &storagenode_paystub.UsagePut, &storagenode_paystub.UsageGetRepair, &storagenode_paystub.UsagePutRepair, &storagenode_paystub.UsageGetAudit, &storagenode_paystub.CompAtRest, &storagenode_paystub.CompGet, &storagenode_paystub.CompPut, &storagenode_paystub.CompGetRepair, &storagenode_paystub.CompPutRepair, &storagenode_paystub.CompGetAudit, &storagenode_paystub.SurgePercent, &storagenode_paystub.Held, &storagenode_paystub.Owed, &storagenode_paystub.Disposed, &storagenode_paystub.Paid, &storagenode_paystub.Distributed)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&storagenode_paystub.Period, &storagenode_paystub.NodeId, &storagenode_paystub.CreatedAt, &storagenode_paystub.Codes, &storagenode_paystub.UsageAtRest, &storagenode_paystub.UsageGet, &storagenode_paystub.UsagePut, &storagenode_paystub.UsageGetRepair, &storagenode_paystub.UsagePutRepair, &storagenode_paystub.UsageGetAudit, &storagenode_paystub.CompAtRest, &storagenode_paystub.CompGet, &storagenode_paystub.CompPut, &storagenode_paystub.CompGetRepair, &storagenode_paystub.CompPutRepair, &storagenode_paystub.CompGetAudit, &storagenode_paystub.SurgePercent, &storagenode_paystub.Held, &storagenode_paystub.Owed, &storagenode_paystub.Disposed, &storagenode_paystub.Paid, &storagenode_paystub.Distributed, &storagenode_paystub.Wallet, &storagenode_paystub.WalletFeatures)
 	if err != nil {
 		return (*StoragenodePaystub)(nil), obj.makeErr(err)
 	}
@@ -14624,7 +17414,7 @@ func (obj *pgxImpl) All_StoragenodePaystub_By_NodeId(ctx context.Context,
 	rows []*StoragenodePaystub, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_paystubs.period, storagenode_paystubs.node_id, storagenode_paystubs.created_at, storagenode_paystubs.codes, storagenode_paystubs.usage_at_rest, storagenode_paystubs.usage_get, storagenode_paystubs.usage_put, storagenode_paystubs.usage_get_repair, storagenode_paystubs.usage_put_repair, storagenode_paystubs.usage_get_audit, storagenode_paystubs.comp_at_rest, storagenode_paystubs.comp_get, storagenode_paystubs.comp_put, storagenode_paystubs.comp_get_repair, storagenode_paystubs.comp_put_repair, storagenode_paystubs.comp_get_audit, storagenode_paystubs.surge_percent, storagenode_paystubs.held, storagenode_paystubs.owed, storagenode_paystubs.disposed, storagenode_paystubs.paid, storagenode_paystubs.distributed FROM storagenode_paystubs WHERE storagenode_paystubs.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_paystubs.period, storagenode_paystubs.node_id, storagenode_paystubs.created_at, storagenode_paystubs.codes, storagenode_paystubs.usage_at_rest, storagenode_paystubs.usage_get, storagenode_paystubs.usage_put, storagenode_paystubs.usage_get_repair, storagenode_paystubs.usage_put_repair, storagenode_paystubs.usage_get_audit, storagenode_paystubs.comp_at_rest, storagenode_paystubs.comp_get, storagenode_paystubs.comp_put, storagenode_paystubs.comp_get_repair, storagenode_paystubs.comp_put_repair, storagenode_paystubs.comp_get_audit, storagenode_paystubs.surge_percent, storagenode_paystubs.held, storagenode_paystubs.owed, storagenode_paystubs.disposed, storagenode_paystubs.paid, storagenode_paystubs.distributed, storagenode_paystubs.wallet, storagenode_paystubs.wallet_features FROM storagenode_paystubs WHERE storagenode_paystubs.node_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, storagenode_paystub_node_id.value())
@@ -14642,7 +17432,7 @@ func (obj *pgxImpl) All_StoragenodePaystub_By_NodeId(ctx context.Context,
 
 			for __rows.Next() {
 				storagenode_paystub := &StoragenodePaystub{}
-				err = __rows.Scan(&storagenode_paystub.Period, &storagenode_paystub.NodeId, &storagenode_paystub.CreatedAt, &storagenode_paystub.Codes, &storagenode_paystub.UsageAtRest, &storagenode_paystub.UsageGet, &storagenode_paystub.UsagePut, &storagenode_paystub.UsageGetRepair, &storagenode_paystub.UsagePutRepair, &storagenode_paystub.UsageGetAudit, &storagenode_paystub.CompAtRest, &storagenode_paystub.CompGet, &storagenode_paystub.CompPut, &storagenode_paystub.CompGetRepair, &storagenode_paystub.CompPutRepair, &storagenode_paystub.CompGetAudit, &storagenode_paystub.SurgePercent, &storagenode_paystub.Held, &storagenode_paystub.Owed, &storagenode_paystub.Disposed, &storagenode_paystub.Paid, &storagenode_paystub.Distributed)
+				err = __rows.Scan(&storagenode_paystub.Period, &storagenode_paystub.NodeId, &storagenode_paystub.CreatedAt, &storagenode_paystub.Codes, &storagenode_paystub.UsageAtRest, &storagenode_paystub.UsageGet, &storagenode_paystub.UsagePut, &storagenode_paystub.UsageGetRepair, &storagenode_paystub.UsagePutRepair, &storagenode_paystub.UsageGetAudit, &storagenode_paystub.CompAtRest, &storagenode_paystub.CompGet, &storagenode_paystub.CompPut, &storagenode_paystub.CompGetRepair, &storagenode_paystub.CompPutRepair, &storagenode_paystub.CompGetAudit, &storagenode_paystub.SurgePercent, &storagenode_paystub.Held, &storagenode_paystub.Owed, &storagenode_paystub.Disposed, &storagenode_paystub.Paid, &storagenode_paystub.Distributed, &storagenode_paystub.Wallet, &storagenode_paystub.WalletFeatures)
 				if err != nil {
 					return nil, err
 				}
@@ -16734,7 +19524,7 @@ func (obj *pgxImpl) Update_Reputation_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.audit_warned, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -16780,6 +19570,11 @@ func (obj *pgxImpl) Update_Reputation_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
 	}
 
+	if update.AuditWarned._set {
+		__values = append(__values, update.AuditWarned.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_warned = ?"))
+	}
+
 	if update.OnlineScore._set {
 		__values = append(__values, update.OnlineScore.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
@@ -16824,7 +19619,7 @@ func (obj *pgxImpl) Update_Reputation_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	reputation = &Reputation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.AuditWarned, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -16842,7 +19637,7 @@ func (obj *pgxImpl) Update_Reputation_By_Id_And_AuditHistory(ctx context.Context
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? AND reputations.audit_history = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? AND reputations.audit_history = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.audit_warned, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -16888,6 +19683,11 @@ func (obj *pgxImpl) Update_Reputation_By_Id_And_AuditHistory(ctx context.Context
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
 	}
 
+	if update.AuditWarned._set {
+		__values = append(__values, update.AuditWarned.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_warned = ?"))
+	}
+
 	if update.OnlineScore._set {
 		__values = append(__values, update.OnlineScore.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
@@ -16932,7 +19732,7 @@ func (obj *pgxImpl) Update_Reputation_By_Id_And_AuditHistory(ctx context.Context
 	obj.logStmt(__stmt, __values...)
 
 	reputation = &Reputation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.AuditWarned, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -16995,6 +19795,11 @@ func (obj *pgxImpl) UpdateNoReturn_Reputation_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
 	}
 
+	if update.AuditWarned._set {
+		__values = append(__values, update.AuditWarned.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_warned = ?"))
+	}
+
 	if update.OnlineScore._set {
 		__values = append(__values, update.OnlineScore.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
@@ -18412,30 +21217,140 @@ func (obj *pgxImpl) Delete_OauthClient_By_Id(ctx context.Context,
 		return false, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (impl pgxImpl) isConstraintError(err error) (
+	constraint string, ok bool) {
+	if e, ok := err.(*pgconn.PgError); ok {
+		if e.Code[:2] == "23" {
+			return e.ConstraintName, true
+		}
+	}
+	return "", false
+}
+
+func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __res sql.Result
+	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_quotas;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_onboarding_steps;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_credits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_apply_balance_intents;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_metainfos;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_keys;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webapp_sessions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM value_attributions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM users;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
-
-}
-
-func (impl pgxImpl) isConstraintError(err error) (
-	constraint string, ok bool) {
-	if e, ok := err.(*pgconn.PgError); ok {
-		if e.Code[:2] == "23" {
-			return e.ConstraintName, true
-		}
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_tx_conversion_rates;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
-	return "", false
-}
 
-func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
-	defer mon.Task()(&ctx)(&err)
-	var __res sql.Result
-	var __count int64
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_credits;")
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_invoice_project_records;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18445,7 +21360,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_apply_balance_intents;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripe_customers;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18455,7 +21370,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_members;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_wallets;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18465,7 +21380,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_metainfos;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_payments;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18475,7 +21390,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_keys;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_storage_tallies;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18485,7 +21400,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webapp_sessions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_paystubs;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18495,7 +21410,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM value_attributions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_payments;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18505,7 +21420,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM users;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups_phase2;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18515,7 +21430,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_tx_conversion_rates;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollup_archives;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18525,7 +21440,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_invoice_project_records;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18535,7 +21450,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripe_customers;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM signup_reviews;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18545,7 +21460,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_wallets;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM shared_link_bandwidth_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18555,7 +21470,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storjscan_payments;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_statistics_reports;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18565,7 +21480,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_storage_tallies;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_pending_audits;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18575,7 +21490,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_paystubs;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM revocations;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18585,7 +21500,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_payments;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reset_password_tokens;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18595,7 +21510,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups_phase2;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reputations;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18605,7 +21520,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollup_archives;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM repair_queue;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18615,7 +21530,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_bandwidth_rollups;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM remediation_queue;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18625,7 +21540,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_pending_audits;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM registration_tokens;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18635,7 +21550,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM revocations;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_usage_anomaly_settings;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18645,7 +21560,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reset_password_tokens;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_security_logs;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18655,7 +21570,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reputations;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_live_usages;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18665,7 +21580,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM repair_queue;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_freezes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18675,7 +21590,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM registration_tokens;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_deletions;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -18700,6 +21615,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_activities;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -18760,6 +21685,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_events;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -18800,6 +21735,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM email_change_tokens;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -18880,6 +21825,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM activation_tokens;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -18979,16 +21934,17 @@ func (obj *pgxcockroachImpl) Create_Reputation(ctx context.Context,
 	__unknown_audit_suspended_val := optional.UnknownAuditSuspended.value()
 	__offline_suspended_val := optional.OfflineSuspended.value()
 	__under_review_val := optional.UnderReview.value()
+	__audit_warned_val := optional.AuditWarned.value()
 	__audit_history_val := reputation_audit_history.value()
 
-	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, vetted_at, disqualified, disqualification_reason, unknown_audit_suspended, offline_suspended, under_review, audit_history")}
-	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?")}
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, vetted_at, disqualified, disqualification_reason, unknown_audit_suspended, offline_suspended, under_review, audit_warned, audit_history")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?")}
 	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO reputations "), __clause, __sqlbundle_Literal(" RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO reputations "), __clause, __sqlbundle_Literal(" RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.audit_warned, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __vetted_at_val, __disqualified_val, __disqualification_reason_val, __unknown_audit_suspended_val, __offline_suspended_val, __under_review_val, __audit_history_val)
+	__values = append(__values, __id_val, __vetted_at_val, __disqualified_val, __disqualification_reason_val, __unknown_audit_suspended_val, __offline_suspended_val, __under_review_val, __audit_warned_val, __audit_history_val)
 
 	__optional_columns := __sqlbundle_Literals{Join: ", "}
 	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
@@ -19047,7 +22003,7 @@ func (obj *pgxcockroachImpl) Create_Reputation(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	reputation = &Reputation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.AuditWarned, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -19431,7 +22387,9 @@ func (obj *pgxcockroachImpl) ReplaceNoReturn_StoragenodePaystub(ctx context.Cont
 	storagenode_paystub_owed StoragenodePaystub_Owed_Field,
 	storagenode_paystub_disposed StoragenodePaystub_Disposed_Field,
 	storagenode_paystub_paid StoragenodePaystub_Paid_Field,
-	storagenode_paystub_distributed StoragenodePaystub_Distributed_Field) (
+	storagenode_paystub_distributed StoragenodePaystub_Distributed_Field,
+	storagenode_paystub_wallet StoragenodePaystub_Wallet_Field,
+	storagenode_paystub_wallet_features StoragenodePaystub_WalletFeatures_Field) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -19458,11 +22416,13 @@ func (obj *pgxcockroachImpl) ReplaceNoReturn_StoragenodePaystub(ctx context.Cont
 	__disposed_val := storagenode_paystub_disposed.value()
 	__paid_val := storagenode_paystub_paid.value()
 	__distributed_val := storagenode_paystub_distributed.value()
+	__wallet_val := storagenode_paystub_wallet.value()
+	__wallet_features_val := storagenode_paystub_wallet_features.value()
 
-	var __embed_stmt = __sqlbundle_Literal("UPSERT INTO storagenode_paystubs ( period, node_id, created_at, codes, usage_at_rest, usage_get, usage_put, usage_get_repair, usage_put_repair, usage_get_audit, comp_at_rest, comp_get, comp_put, comp_get_repair, comp_put_repair, comp_get_audit, surge_percent, held, owed, disposed, paid, distributed ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("UPSERT INTO storagenode_paystubs ( period, node_id, created_at, codes, usage_at_rest, usage_get, usage_put, usage_get_repair, usage_put_repair, usage_get_audit, comp_at_rest, comp_get, comp_put, comp_get_repair, comp_put_repair, comp_get_audit, surge_percent, held, owed, disposed, paid, distributed, wallet, wallet_features ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __values []interface{}
-	__values = append(__values, __period_val, __node_id_val, __created_at_val, __codes_val, __usage_at_rest_val, __usage_get_val, __usage_put_val, __usage_get_repair_val, __usage_put_repair_val, __usage_get_audit_val, __comp_at_rest_val, __comp_get_val, __comp_put_val, __comp_get_repair_val, __comp_put_repair_val, __comp_get_audit_val, __surge_percent_val, __held_val, __owed_val, __disposed_val, __paid_val, __distributed_val)
+	__values = append(__values, __period_val, __node_id_val, __created_at_val, __codes_val, __usage_at_rest_val, __usage_get_val, __usage_put_val, __usage_get_repair_val, __usage_put_repair_val, __usage_get_audit_val, __comp_at_rest_val, __comp_get_val, __comp_put_val, __comp_get_repair_val, __comp_put_repair_val, __comp_get_audit_val, __surge_percent_val, __held_val, __owed_val, __disposed_val, __paid_val, __distributed_val, __wallet_val, __wallet_features_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -20374,7 +23334,7 @@ func (obj *pgxcockroachImpl) Get_Reputation_By_Id(ctx context.Context,
 	reputation *Reputation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta FROM reputations WHERE reputations.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.audit_warned, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta FROM reputations WHERE reputations.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, reputation_id.value())
@@ -20383,7 +23343,7 @@ func (obj *pgxcockroachImpl) Get_Reputation_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	reputation = &Reputation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.AuditWarned, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
 	if err != nil {
 		return (*Reputation)(nil), obj.makeErr(err)
 	}
@@ -21189,9 +24149,9 @@ func (obj *pgxcockroachImpl) Paged_BucketBandwidthRollup_By_IntervalStart_Greate
 	rows []*BucketBandwidthRollup, next *Paged_BucketBandwidthRollup_By_IntervalStart_GreaterOrEqual_Continuation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.interval_seconds, bucket_bandwidth_rollups.action, bucket_bandwidth_rollups.inline, bucket_bandwidth_rollups.allocated, bucket_bandwidth_rollups.settled, bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action FROM bucket_bandwidth_rollups WHERE bucket_bandwidth_rollups.interval_start >= ? AND (bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action) > (?, ?, ?, ?) ORDER BY bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.interval_seconds, bucket_bandwidth_rollups.action, bucket_bandwidth_rollups.inline, bucket_bandwidth_rollups.allocated, bucket_bandwidth_rollups.settled, bucket_bandwidth_rollups.placement, bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action FROM bucket_bandwidth_rollups WHERE bucket_bandwidth_rollups.interval_start >= ? AND (bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action) > (?, ?, ?, ?) ORDER BY bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.interval_seconds, bucket_bandwidth_rollups.action, bucket_bandwidth_rollups.inline, bucket_bandwidth_rollups.allocated, bucket_bandwidth_rollups.settled, bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action FROM bucket_bandwidth_rollups WHERE bucket_bandwidth_rollups.interval_start >= ? ORDER BY bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.interval_seconds, bucket_bandwidth_rollups.action, bucket_bandwidth_rollups.inline, bucket_bandwidth_rollups.allocated, bucket_bandwidth_rollups.settled, bucket_bandwidth_rollups.placement, bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action FROM bucket_bandwidth_rollups WHERE bucket_bandwidth_rollups.interval_start >= ? ORDER BY bucket_bandwidth_rollups.bucket_name, bucket_bandwidth_rollups.project_id, bucket_bandwidth_rollups.interval_start, bucket_bandwidth_rollups.action LIMIT ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_bandwidth_rollup_interval_start_greater_or_equal.value())
@@ -21219,7 +24179,7 @@ func (obj *pgxcockroachImpl) Paged_BucketBandwidthRollup_By_IntervalStart_Greate
 
 			for __rows.Next() {
 				bucket_bandwidth_rollup := &BucketBandwidthRollup{}
-				err = __rows.Scan(&bucket_bandwidth_rollup.BucketName, &bucket_bandwidth_rollup.ProjectId, &bucket_bandwidth_rollup.IntervalStart, &bucket_bandwidth_rollup.IntervalSeconds, &bucket_bandwidth_rollup.Action, &bucket_bandwidth_rollup.Inline, &bucket_bandwidth_rollup.Allocated, &bucket_bandwidth_rollup.Settled, &__continuation._value_bucket_name, &__continuation._value_project_id, &__continuation._value_interval_start, &__continuation._value_action)
+				err = __rows.Scan(&bucket_bandwidth_rollup.BucketName, &bucket_bandwidth_rollup.ProjectId, &bucket_bandwidth_rollup.IntervalStart, &bucket_bandwidth_rollup.IntervalSeconds, &bucket_bandwidth_rollup.Action, &bucket_bandwidth_rollup.Inline, &bucket_bandwidth_rollup.Allocated, &bucket_bandwidth_rollup.Settled, &bucket_bandwidth_rollup.Placement, &__continuation._value_bucket_name, &__continuation._value_project_id, &__continuation._value_interval_start, &__continuation._value_action)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -21309,7 +24269,7 @@ func (obj *pgxcockroachImpl) All_BucketStorageTally_OrderBy_Desc_IntervalStart(c
 	rows []*BucketStorageTally, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_storage_tallies.bucket_name, bucket_storage_tallies.project_id, bucket_storage_tallies.interval_start, bucket_storage_tallies.total_bytes, bucket_storage_tallies.inline, bucket_storage_tallies.remote, bucket_storage_tallies.total_segments_count, bucket_storage_tallies.remote_segments_count, bucket_storage_tallies.inline_segments_count, bucket_storage_tallies.object_count, bucket_storage_tallies.metadata_size FROM bucket_storage_tallies ORDER BY bucket_storage_tallies.interval_start DESC")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_storage_tallies.bucket_name, bucket_storage_tallies.project_id, bucket_storage_tallies.interval_start, bucket_storage_tallies.total_bytes, bucket_storage_tallies.inline, bucket_storage_tallies.remote, bucket_storage_tallies.total_segments_count, bucket_storage_tallies.remote_segments_count, bucket_storage_tallies.inline_segments_count, bucket_storage_tallies.object_count, bucket_storage_tallies.metadata_size, bucket_storage_tallies.placement FROM bucket_storage_tallies ORDER BY bucket_storage_tallies.interval_start DESC")
 
 	var __values []interface{}
 
@@ -21326,7 +24286,7 @@ func (obj *pgxcockroachImpl) All_BucketStorageTally_OrderBy_Desc_IntervalStart(c
 
 			for __rows.Next() {
 				bucket_storage_tally := &BucketStorageTally{}
-				err = __rows.Scan(&bucket_storage_tally.BucketName, &bucket_storage_tally.ProjectId, &bucket_storage_tally.IntervalStart, &bucket_storage_tally.TotalBytes, &bucket_storage_tally.Inline, &bucket_storage_tally.Remote, &bucket_storage_tally.TotalSegmentsCount, &bucket_storage_tally.RemoteSegmentsCount, &bucket_storage_tally.InlineSegmentsCount, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.MetadataSize)
+				err = __rows.Scan(&bucket_storage_tally.BucketName, &bucket_storage_tally.ProjectId, &bucket_storage_tally.IntervalStart, &bucket_storage_tally.TotalBytes, &bucket_storage_tally.Inline, &bucket_storage_tally.Remote, &bucket_storage_tally.TotalSegmentsCount, &bucket_storage_tally.RemoteSegmentsCount, &bucket_storage_tally.InlineSegmentsCount, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.MetadataSize, &bucket_storage_tally.Placement)
 				if err != nil {
 					return nil, err
 				}
@@ -21356,7 +24316,7 @@ func (obj *pgxcockroachImpl) All_BucketStorageTally_By_ProjectId_And_BucketName_
 	rows []*BucketStorageTally, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_storage_tallies.bucket_name, bucket_storage_tallies.project_id, bucket_storage_tallies.interval_start, bucket_storage_tallies.total_bytes, bucket_storage_tallies.inline, bucket_storage_tallies.remote, bucket_storage_tallies.total_segments_count, bucket_storage_tallies.remote_segments_count, bucket_storage_tallies.inline_segments_count, bucket_storage_tallies.object_count, bucket_storage_tallies.metadata_size FROM bucket_storage_tallies WHERE bucket_storage_tallies.project_id = ? AND bucket_storage_tallies.bucket_name = ? AND bucket_storage_tallies.interval_start >= ? AND bucket_storage_tallies.interval_start <= ? ORDER BY bucket_storage_tallies.interval_start DESC")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_storage_tallies.bucket_name, bucket_storage_tallies.project_id, bucket_storage_tallies.interval_start, bucket_storage_tallies.total_bytes, bucket_storage_tallies.inline, bucket_storage_tallies.remote, bucket_storage_tallies.total_segments_count, bucket_storage_tallies.remote_segments_count, bucket_storage_tallies.inline_segments_count, bucket_storage_tallies.object_count, bucket_storage_tallies.metadata_size, bucket_storage_tallies.placement FROM bucket_storage_tallies WHERE bucket_storage_tallies.project_id = ? AND bucket_storage_tallies.bucket_name = ? AND bucket_storage_tallies.interval_start >= ? AND bucket_storage_tallies.interval_start <= ? ORDER BY bucket_storage_tallies.interval_start DESC")
 
 	var __values []interface{}
 	__values = append(__values, bucket_storage_tally_project_id.value(), bucket_storage_tally_bucket_name.value(), bucket_storage_tally_interval_start_greater_or_equal.value(), bucket_storage_tally_interval_start_less_or_equal.value())
@@ -21374,7 +24334,7 @@ func (obj *pgxcockroachImpl) All_BucketStorageTally_By_ProjectId_And_BucketName_
 
 			for __rows.Next() {
 				bucket_storage_tally := &BucketStorageTally{}
-				err = __rows.Scan(&bucket_storage_tally.BucketName, &bucket_storage_tally.ProjectId, &bucket_storage_tally.IntervalStart, &bucket_storage_tally.TotalBytes, &bucket_storage_tally.Inline, &bucket_storage_tally.Remote, &bucket_storage_tally.TotalSegmentsCount, &bucket_storage_tally.RemoteSegmentsCount, &bucket_storage_tally.InlineSegmentsCount, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.MetadataSize)
+				err = __rows.Scan(&bucket_storage_tally.BucketName, &bucket_storage_tally.ProjectId, &bucket_storage_tally.IntervalStart, &bucket_storage_tally.TotalBytes, &bucket_storage_tally.Inline, &bucket_storage_tally.Remote, &bucket_storage_tally.TotalSegmentsCount, &bucket_storage_tally.RemoteSegmentsCount, &bucket_storage_tally.InlineSegmentsCount, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.MetadataSize, &bucket_storage_tally.Placement)
 				if err != nil {
 					return nil, err
 				}
@@ -21782,7 +24742,7 @@ func (obj *pgxcockroachImpl) Get_StoragenodePaystub_By_NodeId_And_Period(ctx con
 	storagenode_paystub *StoragenodePaystub, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_paystubs.period, storagenode_paystubs.node_id, storagenode_paystubs.created_at, storagenode_paystubs.codes, storagenode_paystubs.usage_at_rest, storagenode_paystubs.usage_get, storagenode_paystubs.usage_put, storagenode_paystubs.usage_get_repair, storagenode_paystubs.usage_put_repair, storagenode_paystubs.usage_get_audit, storagenode_paystubs.comp_at_rest, storagenode_paystubs.comp_get, storagenode_paystubs.comp_put, storagenode_paystubs.comp_get_repair, storagenode_paystubs.comp_put_repair, storagenode_paystubs.comp_get_audit, storagenode_paystubs.surge_percent, storagenode_paystubs.held, storagenode_paystubs.owed, storagenode_paystubs.disposed, storagenode_paystubs.paid, storagenode_paystubs.distributed FROM storagenode_paystubs WHERE storagenode_paystubs.node_id = ? AND storagenode_paystubs.period = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_paystubs.period, storagenode_paystubs.node_id, storagenode_paystubs.created_at, storagenode_paystubs.codes, storagenode_paystubs.usage_at_rest, storagenode_paystubs.usage_get, storagenode_paystubs.usage_put, storagenode_paystubs.usage_get_repair, storagenode_paystubs.usage_put_repair, storagenode_paystubs.usage_get_audit, storagenode_paystubs.comp_at_rest, storagenode_paystubs.comp_get, storagenode_paystubs.comp_put, storagenode_paystubs.comp_get_repair, storagenode_paystubs.comp_put_repair, storagenode_paystubs.comp_get_audit, storagenode_paystubs.surge_percent, storagenode_paystubs.held, storagenode_paystubs.owed, storagenode_paystubs.disposed, storagenode_paystubs.paid, storagenode_paystubs.distributed, storagenode_paystubs.wallet, storagenode_paystubs.wallet_features FROM storagenode_paystubs WHERE storagenode_paystubs.node_id = ? AND storagenode_paystubs.period = ?")
 
 	var __values []interface{}
 	__values = append(__values, storagenode_paystub_node_id.value(), storagenode_paystub_period.value())
@@ -21791,7 +24751,7 @@ func (obj *pgxcockroachImpl) Get_StoragenodePaystub_By_NodeId_And_Period(ctx con
 	obj.logStmt(__stmt, __values...)
 
 	storagenode_paystub = &StoragenodePaystub{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&storagenode_paystub.Period, &storagenode_paystub.NodeId, &storagenode_paystub.CreatedAt, &storagenode_paystub.Codes, &storagenode_paystub.UsageAtRest, &storagenode_paystub.UsageGet, &storagenode_paystub.UsagePut, &storagenode_paystub.UsageGetRepair, &storagenode_paystub.UsagePutRepair, &storagenode_paystub.UsageGetAudit, &storagenode_paystub.CompAtRest, &storagenode_paystub.CompGet, &storagenode_paystub.CompPut, &storagenode_paystub.CompGetRepair, &storagenode_paystub.CompPutRepair, &storagenode_paystub.CompGetAudit, &storagenode_paystub.SurgePercent, &storagenode_paystub.Held, &storagenode_paystub.Owed, &storagenode_paystub.Disposed, &storagenode_paystub.Paid, &storagenode_paystub.Distributed)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&storagenode_paystub.Period, &storagenode_paystub.NodeId, &storagenode_paystub.CreatedAt, &storagenode_paystub.Codes, &storagenode_paystub.UsageAtRest, &storagenode_paystub.UsageGet, &storagenode_paystub.UsagePut, &storagenode_paystub.UsageGetRepair, &storagenode_paystub.UsagePutRepair, &storagenode_paystub.UsageGetAudit, &storagenode_paystub.CompAtRest, &storagenode_paystub.CompGet, &storagenode_paystub.CompPut, &storagenode_paystub.CompGetRepair, &storagenode_paystub.CompPutRepair, &storagenode_paystub.CompGetAudit, &storagenode_paystub.SurgePercent, &storagenode_paystub.Held, &storagenode_paystub.Owed, &storagenode_paystub.Disposed, &storagenode_paystub.Paid, &storagenode_paystub.Distributed, &storagenode_paystub.Wallet, &storagenode_paystub.WalletFeatures)
 	if err != nil {
 		return (*StoragenodePaystub)(nil), obj.makeErr(err)
 	}
@@ -21804,7 +24764,7 @@ func (obj *pgxcockroachImpl) All_StoragenodePaystub_By_NodeId(ctx context.Contex
 	rows []*StoragenodePaystub, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_paystubs.period, storagenode_paystubs.node_id, storagenode_paystubs.created_at, storagenode_paystubs.codes, storagenode_paystubs.usage_at_rest, storagenode_paystubs.usage_get, storagenode_paystubs.usage_put, storagenode_paystubs.usage_get_repair, storagenode_paystubs.usage_put_repair, storagenode_paystubs.usage_get_audit, storagenode_paystubs.comp_at_rest, storagenode_paystubs.comp_get, storagenode_paystubs.comp_put, storagenode_paystubs.comp_get_repair, storagenode_paystubs.comp_put_repair, storagenode_paystubs.comp_get_audit, storagenode_paystubs.surge_percent, storagenode_paystubs.held, storagenode_paystubs.owed, storagenode_paystubs.disposed, storagenode_paystubs.paid, storagenode_paystubs.distributed FROM storagenode_paystubs WHERE storagenode_paystubs.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_paystubs.period, storagenode_paystubs.node_id, storagenode_paystubs.created_at, storagenode_paystubs.codes, storagenode_paystubs.usage_at_rest, storagenode_paystubs.usage_get, storagenode_paystubs.usage_put, storagenode_paystubs.usage_get_repair, storagenode_paystubs.usage_put_repair, storagenode_paystubs.usage_get_audit, storagenode_paystubs.comp_at_rest, storagenode_paystubs.comp_get, storagenode_paystubs.comp_put, storagenode_paystubs.comp_get_repair, storagenode_paystubs.comp_put_repair, storagenode_paystubs.comp_get_audit, storagenode_paystubs.surge_percent, storagenode_paystubs.held, storagenode_paystubs.owed, storagenode_paystubs.disposed, storagenode_paystubs.paid, storagenode_paystubs.distributed, storagenode_paystubs.wallet, storagenode_paystubs.wallet_features FROM storagenode_paystubs WHERE storagenode_paystubs.node_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, storagenode_paystub_node_id.value())
@@ -21822,7 +24782,7 @@ func (obj *pgxcockroachImpl) All_StoragenodePaystub_By_NodeId(ctx context.Contex
 
 			for __rows.Next() {
 				storagenode_paystub := &StoragenodePaystub{}
-				err = __rows.Scan(&storagenode_paystub.Period, &storagenode_paystub.NodeId, &storagenode_paystub.CreatedAt, &storagenode_paystub.Codes, &storagenode_paystub.UsageAtRest, &storagenode_paystub.UsageGet, &storagenode_paystub.UsagePut, &storagenode_paystub.UsageGetRepair, &storagenode_paystub.UsagePutRepair, &storagenode_paystub.UsageGetAudit, &storagenode_paystub.CompAtRest, &storagenode_paystub.CompGet, &storagenode_paystub.CompPut, &storagenode_paystub.CompGetRepair, &storagenode_paystub.CompPutRepair, &storagenode_paystub.CompGetAudit, &storagenode_paystub.SurgePercent, &storagenode_paystub.Held, &storagenode_paystub.Owed, &storagenode_paystub.Disposed, &storagenode_paystub.Paid, &storagenode_paystub.Distributed)
+				err = __rows.Scan(&storagenode_paystub.Period, &storagenode_paystub.NodeId, &storagenode_paystub.CreatedAt, &storagenode_paystub.Codes, &storagenode_paystub.UsageAtRest, &storagenode_paystub.UsageGet, &storagenode_paystub.UsagePut, &storagenode_paystub.UsageGetRepair, &storagenode_paystub.UsagePutRepair, &storagenode_paystub.UsageGetAudit, &storagenode_paystub.CompAtRest, &storagenode_paystub.CompGet, &storagenode_paystub.CompPut, &storagenode_paystub.CompGetRepair, &storagenode_paystub.CompPutRepair, &storagenode_paystub.CompGetAudit, &storagenode_paystub.SurgePercent, &storagenode_paystub.Held, &storagenode_paystub.Owed, &storagenode_paystub.Disposed, &storagenode_paystub.Paid, &storagenode_paystub.Distributed, &storagenode_paystub.Wallet, &storagenode_paystub.WalletFeatures)
 				if err != nil {
 					return nil, err
 				}
@@ -23914,7 +26874,7 @@ func (obj *pgxcockroachImpl) Update_Reputation_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.audit_warned, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -23960,6 +26920,11 @@ func (obj *pgxcockroachImpl) Update_Reputation_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
 	}
 
+	if update.AuditWarned._set {
+		__values = append(__values, update.AuditWarned.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_warned = ?"))
+	}
+
 	if update.OnlineScore._set {
 		__values = append(__values, update.OnlineScore.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
@@ -24004,7 +26969,7 @@ func (obj *pgxcockroachImpl) Update_Reputation_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	reputation = &Reputation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.AuditWarned, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -24022,7 +26987,7 @@ func (obj *pgxcockroachImpl) Update_Reputation_By_Id_And_AuditHistory(ctx contex
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? AND reputations.audit_history = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? AND reputations.audit_history = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.audit_warned, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -24068,6 +27033,11 @@ func (obj *pgxcockroachImpl) Update_Reputation_By_Id_And_AuditHistory(ctx contex
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
 	}
 
+	if update.AuditWarned._set {
+		__values = append(__values, update.AuditWarned.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_warned = ?"))
+	}
+
 	if update.OnlineScore._set {
 		__values = append(__values, update.OnlineScore.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
@@ -24112,7 +27082,7 @@ func (obj *pgxcockroachImpl) Update_Reputation_By_Id_And_AuditHistory(ctx contex
 	obj.logStmt(__stmt, __values...)
 
 	reputation = &Reputation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.AuditWarned, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -24175,6 +27145,11 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Reputation_By_Id(ctx context.Context
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
 	}
 
+	if update.AuditWarned._set {
+		__values = append(__values, update.AuditWarned.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_warned = ?"))
+	}
+
 	if update.OnlineScore._set {
 		__values = append(__values, update.OnlineScore.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
@@ -25615,6 +28590,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 	defer mon.Task()(&ctx)(&err)
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_quotas;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_onboarding_steps;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_credits;")
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -25800,6 +28795,36 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM signup_reviews;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM shared_link_bandwidth_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM segment_statistics_reports;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -25850,6 +28875,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM remediation_queue;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -25860,6 +28895,56 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_usage_anomaly_settings;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_security_logs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_live_usages;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_freezes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_deletions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -25880,6 +28965,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_activities;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -25940,6 +29035,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_events;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -25980,6 +29085,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM email_change_tokens;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26060,6 +29175,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM activation_tokens;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27690,13 +30815,15 @@ func (rx *Rx) ReplaceNoReturn_StoragenodePaystub(ctx context.Context,
 	storagenode_paystub_owed StoragenodePaystub_Owed_Field,
 	storagenode_paystub_disposed StoragenodePaystub_Disposed_Field,
 	storagenode_paystub_paid StoragenodePaystub_Paid_Field,
-	storagenode_paystub_distributed StoragenodePaystub_Distributed_Field) (
+	storagenode_paystub_distributed StoragenodePaystub_Distributed_Field,
+	storagenode_paystub_wallet StoragenodePaystub_Wallet_Field,
+	storagenode_paystub_wallet_features StoragenodePaystub_WalletFeatures_Field) (
 	err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.ReplaceNoReturn_StoragenodePaystub(ctx, storagenode_paystub_period, storagenode_paystub_node_id, storagenode_paystub_codes, storagenode_paystub_usage_at_rest, storagenode_paystub_usage_get, storagenode_paystub_usage_put, storagenode_paystub_usage_get_repair, storagenode_paystub_usage_put_repair, storagenode_paystub_usage_get_audit, storagenode_paystub_comp_at_rest, storagenode_paystub_comp_get, storagenode_paystub_comp_put, storagenode_paystub_comp_get_repair, storagenode_paystub_comp_put_repair, storagenode_paystub_comp_get_audit, storagenode_paystub_surge_percent, storagenode_paystub_held, storagenode_paystub_owed, storagenode_paystub_disposed, storagenode_paystub_paid, storagenode_paystub_distributed)
+	return tx.ReplaceNoReturn_StoragenodePaystub(ctx, storagenode_paystub_period, storagenode_paystub_node_id, storagenode_paystub_codes, storagenode_paystub_usage_at_rest, storagenode_paystub_usage_get, storagenode_paystub_usage_put, storagenode_paystub_usage_get_repair, storagenode_paystub_usage_put_repair, storagenode_paystub_usage_get_audit, storagenode_paystub_comp_at_rest, storagenode_paystub_comp_get, storagenode_paystub_comp_put, storagenode_paystub_comp_get_repair, storagenode_paystub_comp_put_repair, storagenode_paystub_comp_get_audit, storagenode_paystub_surge_percent, storagenode_paystub_held, storagenode_paystub_owed, storagenode_paystub_disposed, storagenode_paystub_paid, storagenode_paystub_distributed, storagenode_paystub_wallet, storagenode_paystub_wallet_features)
 
 }
 
@@ -28682,7 +31809,9 @@ type Methods interface {
 		storagenode_paystub_owed StoragenodePaystub_Owed_Field,
 		storagenode_paystub_disposed StoragenodePaystub_Disposed_Field,
 		storagenode_paystub_paid StoragenodePaystub_Paid_Field,
-		storagenode_paystub_distributed StoragenodePaystub_Distributed_Field) (
+		storagenode_paystub_distributed StoragenodePaystub_Distributed_Field,
+		storagenode_paystub_wallet StoragenodePaystub_Wallet_Field,
+		storagenode_paystub_wallet_features StoragenodePaystub_WalletFeatures_Field) (
 		err error)
 
 	UpdateNoReturn_AccountingTimestamps_By_Name(ctx context.Context,
@@ -17,6 +17,25 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE activation_tokens (
+	secret_hash bytea NOT NULL,
+	user_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	used_at timestamp with time zone,
+	PRIMARY KEY ( secret_hash )
+);
+CREATE TABLE api_key_bandwidth_rollups (
+	project_id bytea NOT NULL,
+	api_key_head bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	action integer NOT NULL,
+	inline bigint NOT NULL,
+	allocated bigint NOT NULL,
+	settled bigint NOT NULL,
+	request_count bigint NOT NULL,
+	PRIMARY KEY ( project_id, api_key_head, interval_start, action )
+);
 CREATE TABLE billing_transactions (
 	tx_id bytea NOT NULL,
 	user_id bytea NOT NULL,
@@ -37,6 +56,7 @@ CREATE TABLE bucket_bandwidth_rollups (
 	inline bigint NOT NULL,
 	allocated bigint NOT NULL,
 	settled bigint NOT NULL,
+	placement integer,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
 CREATE TABLE bucket_bandwidth_rollup_archives (
@@ -62,6 +82,7 @@ CREATE TABLE bucket_storage_tallies (
 	inline_segments_count integer NOT NULL,
 	object_count integer NOT NULL,
 	metadata_size bigint NOT NULL,
+	placement integer,
 	PRIMARY KEY ( bucket_name, project_id, interval_start )
 );
 CREATE TABLE coinpayments_transactions (
@@ -107,6 +128,16 @@ CREATE TABLE coupon_usages (
 	period timestamp with time zone NOT NULL,
 	PRIMARY KEY ( coupon_id, period )
 );
+CREATE TABLE email_change_tokens (
+	secret bytea NOT NULL,
+	user_id bytea NOT NULL,
+	kind integer NOT NULL,
+	old_email text NOT NULL,
+	new_email text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( secret )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -174,6 +205,15 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_events (
+	id bytea NOT NULL,
+	email text NOT NULL,
+	node_id bytea NOT NULL,
+	event integer NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	email_sent timestamp with time zone,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -245,6 +285,15 @@ CREATE TABLE projects (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE project_activities (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	actor_id bytea,
+	kind text NOT NULL,
+	details text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE project_bandwidth_daily_rollups (
 	project_id bytea NOT NULL,
 	interval_day date NOT NULL,
@@ -259,6 +308,54 @@ CREATE TABLE project_bandwidth_rollups (
 	egress_allocated bigint NOT NULL,
 	PRIMARY KEY ( project_id, interval_month )
 );
+CREATE TABLE project_deletions (
+	project_id bytea NOT NULL,
+	deleted_by bytea,
+	deleted_at timestamp with time zone NOT NULL,
+	purge_after timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_freezes (
+	project_id bytea NOT NULL,
+	reason text NOT NULL,
+	downloads boolean NOT NULL,
+	invoice_id text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_limit_exemptions (
+	project_id bytea NOT NULL,
+	limit_kind text NOT NULL,
+	reason text NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, limit_kind )
+);
+CREATE TABLE project_live_usages (
+	project_id bytea NOT NULL,
+	storage bigint NOT NULL,
+	segments bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_security_logs (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	api_key_name text NOT NULL,
+	remote_address text NOT NULL,
+	operation text NOT NULL,
+	bucket_name bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_usage_anomaly_settings (
+	project_id bytea NOT NULL,
+	sensitivity text NOT NULL,
+	last_notified_at timestamp with time zone,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE registration_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea,
@@ -267,6 +364,16 @@ CREATE TABLE registration_tokens (
 	PRIMARY KEY ( secret ),
 	UNIQUE ( owner_id )
 );
+CREATE TABLE remediation_queue (
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	violation text NOT NULL,
+	violating_pieces integer NOT NULL,
+	placement integer NOT NULL DEFAULT 0,
+	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( stream_id, position, violation )
+);
 CREATE TABLE repair_queue (
 	stream_id bytea NOT NULL,
 	position bigint NOT NULL,
@@ -274,6 +381,7 @@ CREATE TABLE repair_queue (
 	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
+	placement integer,
 	PRIMARY KEY ( stream_id, position )
 );
 CREATE TABLE reputations (
@@ -288,6 +396,7 @@ CREATE TABLE reputations (
 	unknown_audit_suspended timestamp with time zone,
 	offline_suspended timestamp with time zone,
 	under_review timestamp with time zone,
+	audit_warned timestamp with time zone,
 	online_score double precision NOT NULL DEFAULT 1,
 	audit_history bytea NOT NULL,
 	audit_reputation_alpha double precision NOT NULL DEFAULT 1,
@@ -319,6 +428,29 @@ CREATE TABLE segment_pending_audits (
 	reverify_count bigint NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE segment_statistics_reports (
+	created_at timestamp with time zone NOT NULL,
+	report bytea NOT NULL,
+	PRIMARY KEY ( created_at )
+);
+CREATE TABLE shared_link_bandwidth_rollups (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_key bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	inline bigint NOT NULL,
+	allocated bigint NOT NULL,
+	request_count bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, object_key, interval_start )
+);
+CREATE TABLE signup_reviews (
+	user_id bytea NOT NULL,
+	reason text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	reviewed_at timestamp with time zone,
+	approved boolean NOT NULL DEFAULT false,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE storagenode_bandwidth_rollups (
 	storagenode_id bytea NOT NULL,
 	interval_start timestamp with time zone NOT NULL,
@@ -379,6 +511,8 @@ CREATE TABLE storagenode_paystubs (
 	disposed bigint NOT NULL,
 	paid bigint NOT NULL,
 	distributed bigint NOT NULL,
+	wallet text NOT NULL,
+	wallet_features text NOT NULL,
 	PRIMARY KEY ( period, node_id )
 );
 CREATE TABLE storagenode_storage_tallies (
@@ -543,13 +677,38 @@ CREATE TABLE user_credits (
 	PRIMARY KEY ( id ),
 	UNIQUE ( id, offer_id )
 );
+CREATE TABLE user_onboarding_steps (
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	step text NOT NULL,
+	completed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id, step )
+);
+CREATE TABLE bucket_lifecycle_rules (
+	bucket_id bytea NOT NULL REFERENCES bucket_metainfos( id ) ON DELETE CASCADE,
+	id text NOT NULL,
+	prefix bytea NOT NULL,
+	expiration_days integer,
+	abort_incomplete_multipart_upload_days integer,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( bucket_id, id )
+);
+CREATE TABLE bucket_quotas (
+	bucket_id bytea NOT NULL REFERENCES bucket_metainfos( id ) ON DELETE CASCADE,
+	max_objects bigint,
+	max_bytes bigint,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( bucket_id )
+);
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX activation_tokens_user_id_index ON activation_tokens ( user_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
+CREATE INDEX email_change_tokens_user_id_index ON email_change_tokens ( user_id ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
+CREATE INDEX node_events_email_sent_created_at_index ON node_events ( email_sent, created_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
@@ -560,8 +719,14 @@ CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
+CREATE INDEX project_activities_project_id_created_at_index ON project_activities ( project_id, created_at ) ;
+CREATE INDEX project_security_logs_project_id_created_at_index ON project_security_logs ( project_id, created_at ) ;
+CREATE INDEX project_security_logs_created_at_index ON project_security_logs ( created_at ) ;
+CREATE INDEX project_deletions_purge_after_index ON project_deletions ( purge_after ) ;
+CREATE INDEX project_freezes_invoice_id_index ON project_freezes ( invoice_id ) ;
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
+CREATE INDEX remediation_queue_updated_at_index ON remediation_queue ( updated_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
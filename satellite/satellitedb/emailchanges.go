@@ -0,0 +1,129 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that emailChanges implements console.EmailChanges.
+var _ console.EmailChanges = (*emailChanges)(nil)
+
+// emailChanges is an implementation of console.EmailChanges.
+type emailChanges struct {
+	db *satelliteDB
+	tx *dbx.Tx
+}
+
+// Insert adds a pending email change.
+func (changes *emailChanges) Insert(ctx context.Context, change console.EmailChange) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = changes.exec(ctx, `
+		INSERT INTO email_change_tokens (secret, user_id, kind, old_email, new_email, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, change.Secret[:], change.UserID[:], int(change.Kind), change.OldEmail, change.NewEmail, change.CreatedAt, change.ExpiresAt)
+	return Error.Wrap(err)
+}
+
+// Get returns the email change with the secret.
+func (changes *emailChanges) Get(ctx context.Context, secret console.EmailChangeSecret) (_ *console.EmailChange, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := changes.query(ctx, `
+		SELECT secret, user_id, kind, old_email, new_email, created_at, expires_at
+		FROM email_change_tokens
+		WHERE secret = $1
+	`, secret[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	list, err := scanEmailChanges(rows)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(list) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &list[0], nil
+}
+
+// ListByUserID returns the email changes of the user.
+func (changes *emailChanges) ListByUserID(ctx context.Context, userID uuid.UUID) (_ []console.EmailChange, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := changes.query(ctx, `
+		SELECT secret, user_id, kind, old_email, new_email, created_at, expires_at
+		FROM email_change_tokens
+		WHERE user_id = $1
+		ORDER BY created_at ASC
+	`, userID[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	list, err := scanEmailChanges(rows)
+	return list, Error.Wrap(err)
+}
+
+// Delete deletes the email change with the secret.
+func (changes *emailChanges) Delete(ctx context.Context, secret console.EmailChangeSecret) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = changes.exec(ctx, `DELETE FROM email_change_tokens WHERE secret = $1`, secret[:])
+	return Error.Wrap(err)
+}
+
+// DeleteByUserID deletes all the email changes of the user.
+func (changes *emailChanges) DeleteByUserID(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = changes.exec(ctx, `DELETE FROM email_change_tokens WHERE user_id = $1`, userID[:])
+	return Error.Wrap(err)
+}
+
+func (changes *emailChanges) exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if changes.tx != nil {
+		return changes.tx.Tx.ExecContext(ctx, query, args...)
+	}
+	return changes.db.ExecContext(ctx, query, args...)
+}
+
+func (changes *emailChanges) query(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	if changes.tx != nil {
+		return changes.tx.Tx.QueryContext(ctx, query, args...)
+	}
+	return changes.db.QueryContext(ctx, query, args...)
+}
+
+func scanEmailChanges(rows tagsql.Rows) (list []console.EmailChange, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var change console.EmailChange
+		var secret []byte
+		var kind int
+		err := rows.Scan(&secret, &change.UserID, &kind, &change.OldEmail, &change.NewEmail,
+			&change.CreatedAt, &change.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+		if len(secret) != len(change.Secret) {
+			return nil, errs.New("invalid email change secret length %d", len(secret))
+		}
+		copy(change.Secret[:], secret)
+		change.Kind = console.EmailChangeKind(kind)
+		list = append(list, change)
+	}
+	return list, rows.Err()
+}
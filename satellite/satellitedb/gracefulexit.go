@@ -486,6 +486,50 @@ func (db *gracefulexitDB) GetIncompleteFailed(ctx context.Context, nodeID storj.
 	return transferQueueItemRows, nil
 }
 
+// GetFinished gets finished graceful exit transfer queue entries ordered by queued date ascending.
+func (db *gracefulexitDB) GetFinished(ctx context.Context, nodeID storj.NodeID, limit int, offset int64) (_ []*gracefulexit.TransferQueueItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sql := `
+			SELECT
+				node_id, stream_id, position,
+				piece_num, root_piece_id, durability_ratio,
+				queued_at, requested_at, last_failed_at,
+				last_failed_code, failed_count, finished_at,
+				order_limit_send_count
+			FROM graceful_exit_segment_transfer_queue
+			WHERE node_id = ?
+			AND finished_at IS NOT NULL
+			ORDER BY queued_at asc LIMIT ? OFFSET ?`
+	rows, err := db.db.Query(ctx, db.db.Rebind(sql), nodeID.Bytes(), limit, offset)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	transferQueueItemRows, err := scanRows(rows)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return transferQueueItemRows, nil
+}
+
+// RequeueTransferQueueItem marks a finished graceful exit transfer queue entry as incomplete again,
+// so that the piece is transferred once more.
+func (db *gracefulexitDB) RequeueTransferQueueItem(ctx context.Context, nodeID storj.NodeID, streamID uuid.UUID, position metabase.SegmentPosition, pieceNum int32) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sql := `UPDATE graceful_exit_segment_transfer_queue SET finished_at = NULL, order_limit_send_count = 0
+			WHERE node_id = ?
+			AND stream_id = ?
+			AND position = ?
+			AND piece_num = ?`
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(sql), nodeID, streamID, position.Encode(), pieceNum)
+
+	return Error.Wrap(err)
+}
+
 // IncrementOrderLimitSendCount increments the number of times a node has been sent an order limit for transferring.
 func (db *gracefulexitDB) IncrementOrderLimitSendCount(ctx context.Context, nodeID storj.NodeID, streamID uuid.UUID, position metabase.SegmentPosition, pieceNum int32) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -2001,6 +2001,328 @@ func (db *satelliteDB) PostgresMigration() *migrate.Migration {
 					`UPDATE accounting_rollups SET interval_end_time = start_time WHERE interval_end_time = NULL;`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add repair_queue.placement column",
+				Version:     204,
+				SeparateTx:  true,
+				Action: migrate.SQL{
+					`ALTER TABLE repair_queue ADD COLUMN placement integer;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_limit_exemptions table",
+				Version:     205,
+				Action: migrate.SQL{
+					`CREATE TABLE project_limit_exemptions (
+						project_id bytea NOT NULL,
+						limit_kind text NOT NULL,
+						reason text NOT NULL,
+						expires_at timestamp with time zone NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, limit_kind )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add api_key_bandwidth_rollups table",
+				Version:     206,
+				Action: migrate.SQL{
+					`CREATE TABLE api_key_bandwidth_rollups (
+						project_id bytea NOT NULL,
+						api_key_head bytea NOT NULL,
+						interval_start timestamp with time zone NOT NULL,
+						action integer NOT NULL,
+						inline bigint NOT NULL,
+						allocated bigint NOT NULL,
+						settled bigint NOT NULL,
+						request_count bigint NOT NULL,
+						PRIMARY KEY ( project_id, api_key_head, interval_start, action )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add reputations.audit_warned column and node_events table",
+				Version:     207,
+				Action: migrate.SQL{
+					`ALTER TABLE reputations ADD COLUMN audit_warned timestamp with time zone;`,
+					`CREATE TABLE node_events (
+						id bytea NOT NULL,
+						email text NOT NULL,
+						node_id bytea NOT NULL,
+						event integer NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						email_sent timestamp with time zone,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX node_events_email_sent_created_at_index ON node_events ( email_sent, created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add segment_statistics_reports table",
+				Version:     208,
+				Action: migrate.SQL{
+					`CREATE TABLE segment_statistics_reports (
+						created_at timestamp with time zone NOT NULL,
+						report bytea NOT NULL,
+						PRIMARY KEY ( created_at )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add signup_reviews table",
+				Version:     209,
+				Action: migrate.SQL{
+					`CREATE TABLE signup_reviews (
+						user_id bytea NOT NULL,
+						reason text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						reviewed_at timestamp with time zone,
+						approved boolean NOT NULL DEFAULT false,
+						PRIMARY KEY ( user_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add email_change_tokens table",
+				Version:     210,
+				Action: migrate.SQL{
+					`CREATE TABLE email_change_tokens (
+						secret bytea NOT NULL,
+						user_id bytea NOT NULL,
+						kind integer NOT NULL,
+						old_email text NOT NULL,
+						new_email text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						expires_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( secret )
+					);`,
+					`CREATE INDEX email_change_tokens_user_id_index ON email_change_tokens ( user_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add wallet and wallet_features to storagenode_paystubs",
+				Version:     211,
+				Action: migrate.SQL{
+					`ALTER TABLE storagenode_paystubs ADD COLUMN wallet text NOT NULL DEFAULT '';`,
+					`ALTER TABLE storagenode_paystubs ADD COLUMN wallet_features text NOT NULL DEFAULT '';`,
+					`ALTER TABLE storagenode_paystubs ALTER COLUMN wallet DROP DEFAULT;`,
+					`ALTER TABLE storagenode_paystubs ALTER COLUMN wallet_features DROP DEFAULT;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_live_usages table",
+				Version:     212,
+				Action: migrate.SQL{
+					`CREATE TABLE project_live_usages (
+						project_id bytea NOT NULL,
+						storage bigint NOT NULL,
+						segments bigint NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add remediation_queue table",
+				Version:     213,
+				Action: migrate.SQL{
+					`CREATE TABLE remediation_queue (
+						stream_id bytea NOT NULL,
+						position bigint NOT NULL,
+						violation text NOT NULL,
+						violating_pieces integer NOT NULL,
+						placement integer NOT NULL DEFAULT 0,
+						updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( stream_id, position, violation )
+					);`,
+					`CREATE INDEX remediation_queue_updated_at_index ON remediation_queue ( updated_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_activities table",
+				Version:     214,
+				Action: migrate.SQL{
+					`CREATE TABLE project_activities (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						actor_id bytea,
+						kind text NOT NULL,
+						details text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX project_activities_project_id_created_at_index ON project_activities ( project_id, created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add placement to bucket_storage_tallies and bucket_bandwidth_rollups",
+				Version:     215,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_storage_tallies ADD COLUMN placement integer;`,
+					`ALTER TABLE bucket_bandwidth_rollups ADD COLUMN placement integer;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_security_logs table",
+				Version:     216,
+				Action: migrate.SQL{
+					`CREATE TABLE project_security_logs (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						api_key_id bytea NOT NULL,
+						api_key_name text NOT NULL,
+						remote_address text NOT NULL,
+						operation text NOT NULL,
+						bucket_name bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX project_security_logs_project_id_created_at_index ON project_security_logs ( project_id, created_at );`,
+					`CREATE INDEX project_security_logs_created_at_index ON project_security_logs ( created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_quotas table",
+				Version:     217,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_quotas (
+						bucket_id bytea NOT NULL REFERENCES bucket_metainfos( id ) ON DELETE CASCADE,
+						max_objects bigint,
+						max_bytes bigint,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( bucket_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_usage_anomaly_settings table",
+				Version:     218,
+				Action: migrate.SQL{
+					`CREATE TABLE project_usage_anomaly_settings (
+						project_id bytea NOT NULL,
+						sensitivity text NOT NULL,
+						last_notified_at timestamp with time zone,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_freezes table",
+				Version:     219,
+				Action: migrate.SQL{
+					`CREATE TABLE project_freezes (
+						project_id bytea NOT NULL,
+						reason text NOT NULL,
+						downloads boolean NOT NULL,
+						invoice_id text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+					`CREATE INDEX project_freezes_invoice_id_index ON project_freezes ( invoice_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_deletions table",
+				Version:     220,
+				Action: migrate.SQL{
+					`CREATE TABLE project_deletions (
+						project_id bytea NOT NULL,
+						deleted_by bytea,
+						deleted_at timestamp with time zone NOT NULL,
+						purge_after timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+					`CREATE INDEX project_deletions_purge_after_index ON project_deletions ( purge_after );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add shared_link_bandwidth_rollups table",
+				Version:     221,
+				Action: migrate.SQL{
+					`CREATE TABLE shared_link_bandwidth_rollups (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						object_key bytea NOT NULL,
+						interval_start timestamp with time zone NOT NULL,
+						inline bigint NOT NULL,
+						allocated bigint NOT NULL,
+						request_count bigint NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name, object_key, interval_start )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add user_onboarding_steps table",
+				Version:     222,
+				Action: migrate.SQL{
+					`CREATE TABLE user_onboarding_steps (
+						user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+						step text NOT NULL,
+						completed_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( user_id, step )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_lifecycle_rules table",
+				Version:     223,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_lifecycle_rules (
+						bucket_id bytea NOT NULL REFERENCES bucket_metainfos( id ) ON DELETE CASCADE,
+						id text NOT NULL,
+						prefix bytea NOT NULL,
+						expiration_days integer,
+						abort_incomplete_multipart_upload_days integer,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( bucket_id, id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add activation_tokens table",
+				Version:     224,
+				Action: migrate.SQL{
+					`CREATE TABLE activation_tokens (
+						secret_hash bytea NOT NULL,
+						user_id bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						expires_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( secret_hash )
+					);`,
+					`CREATE INDEX activation_tokens_user_id_index ON activation_tokens ( user_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add used_at to activation_tokens",
+				Version:     225,
+				Action: migrate.SQL{
+					`ALTER TABLE activation_tokens ADD COLUMN used_at timestamp with time zone;`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -0,0 +1,90 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/nodeevents"
+)
+
+var _ nodeevents.DB = (*nodeEvents)(nil)
+
+type nodeEvents struct {
+	db *satelliteDB
+}
+
+// Insert inserts a node event.
+func (ne *nodeEvents) Insert(ctx context.Context, email string, nodeID storj.NodeID, event nodeevents.Type) (nodeEvent nodeevents.NodeEvent, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		return nodeevents.NodeEvent{}, Error.Wrap(err)
+	}
+
+	nodeEvent = nodeevents.NodeEvent{
+		ID:     id,
+		Email:  email,
+		NodeID: nodeID,
+		Event:  event,
+	}
+	err = ne.db.QueryRowContext(ctx, `
+		INSERT INTO node_events (id, email, node_id, event)
+		VALUES ($1, $2, $3, $4)
+		RETURNING created_at
+	`, id, email, nodeID, int(event)).Scan(&nodeEvent.CreatedAt)
+	if err != nil {
+		return nodeevents.NodeEvent{}, Error.Wrap(err)
+	}
+	return nodeEvent, nil
+}
+
+// GetUnsent returns the oldest node events with an email address, which
+// haven't been emailed yet.
+func (ne *nodeEvents) GetUnsent(ctx context.Context, limit int) (_ []nodeevents.NodeEvent, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := ne.db.QueryContext(ctx, `
+		SELECT id, email, node_id, event, created_at, email_sent
+		FROM node_events
+		WHERE email_sent IS NULL AND email <> ''
+		ORDER BY created_at
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var events []nodeevents.NodeEvent
+	for rows.Next() {
+		var event nodeevents.NodeEvent
+		var eventType int
+		err = rows.Scan(&event.ID, &event.Email, &event.NodeID, &eventType, &event.CreatedAt, &event.EmailSent)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		event.Event = nodeevents.Type(eventType)
+		events = append(events, event)
+	}
+	return events, Error.Wrap(rows.Err())
+}
+
+// UpdateEmailSent marks the node events as emailed.
+func (ne *nodeEvents) UpdateEmailSent(ctx context.Context, ids []uuid.UUID, timestamp time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = ne.db.ExecContext(ctx, `
+		UPDATE node_events SET email_sent = $1
+		WHERE id = ANY($2::bytea[])
+	`, timestamp, pgutil.UUIDArray(ids))
+	return Error.Wrap(err)
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that onboardingSteps implements console.OnboardingSteps.
+var _ console.OnboardingSteps = (*onboardingSteps)(nil)
+
+// onboardingSteps is an implementation of console.OnboardingSteps.
+type onboardingSteps struct {
+	db *satelliteDB
+	tx *dbx.Tx
+}
+
+// GetCompleted returns when each of the completed onboarding steps of
+// the user was completed.
+func (steps *onboardingSteps) GetCompleted(ctx context.Context, userID uuid.UUID) (_ map[console.OnboardingStep]time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := steps.query(ctx, `
+		SELECT step, completed_at
+		FROM user_onboarding_steps
+		WHERE user_id = $1
+	`, userID[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(rows.Close())) }()
+
+	completed := make(map[console.OnboardingStep]time.Time)
+	for rows.Next() {
+		var step string
+		var completedAt time.Time
+		if err := rows.Scan(&step, &completedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		completed[console.OnboardingStep(step)] = completedAt
+	}
+	return completed, Error.Wrap(rows.Err())
+}
+
+// Complete records that the user completed the onboarding step. Completing
+// an already completed step keeps its original completion time.
+func (steps *onboardingSteps) Complete(ctx context.Context, userID uuid.UUID, step console.OnboardingStep, completedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = steps.exec(ctx, `
+		INSERT INTO user_onboarding_steps (user_id, step, completed_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, step) DO NOTHING
+	`, userID[:], string(step), completedAt)
+	return Error.Wrap(err)
+}
+
+// Reset forgets all the completed onboarding steps of the user.
+func (steps *onboardingSteps) Reset(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = steps.exec(ctx, `
+		DELETE FROM user_onboarding_steps
+		WHERE user_id = $1
+	`, userID[:])
+	return Error.Wrap(err)
+}
+
+func (steps *onboardingSteps) exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if steps.tx != nil {
+		return steps.tx.Tx.ExecContext(ctx, query, args...)
+	}
+	return steps.db.ExecContext(ctx, query, args...)
+}
+
+func (steps *onboardingSteps) query(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	if steps.tx != nil {
+		return steps.tx.Tx.QueryContext(ctx, query, args...)
+	}
+	return steps.db.QueryContext(ctx, query, args...)
+}
@@ -68,12 +68,12 @@ func (db *ordersDB) UpdateBucketBandwidthAllocation(ctx context.Context, project
 		batch.Queue(`START TRANSACTION`)
 
 		statement := db.db.Rebind(
-			`INSERT INTO bucket_bandwidth_rollups (bucket_name, project_id, interval_start, interval_seconds, action, inline, allocated, settled)
-					VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+			`INSERT INTO bucket_bandwidth_rollups (bucket_name, project_id, interval_start, interval_seconds, action, inline, allocated, settled, placement)
+					VALUES (?, ?, ?, ?, ?, ?, ?, ?, (SELECT placement FROM bucket_metainfos WHERE project_id = ? AND name = ?))
 					ON CONFLICT(bucket_name, project_id, interval_start, action)
 					DO UPDATE SET allocated = bucket_bandwidth_rollups.allocated + ?`,
 		)
-		batch.Queue(statement, bucketName, projectID[:], intervalStart.UTC(), defaultIntervalSeconds, action, 0, uint64(amount), 0, uint64(amount))
+		batch.Queue(statement, bucketName, projectID[:], intervalStart.UTC(), defaultIntervalSeconds, action, 0, uint64(amount), 0, projectID[:], bucketName, uint64(amount))
 
 		if action == pb.PieceAction_GET {
 			dailyInterval := time.Date(intervalStart.Year(), intervalStart.Month(), intervalStart.Day(), 0, 0, 0, 0, time.UTC)
@@ -107,13 +107,13 @@ func (db *ordersDB) UpdateBucketBandwidthSettle(ctx context.Context, projectID u
 
 	return db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
 		statement := tx.Rebind(
-			`INSERT INTO bucket_bandwidth_rollups (bucket_name, project_id, interval_start, interval_seconds, action, inline, allocated, settled)
-			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+			`INSERT INTO bucket_bandwidth_rollups (bucket_name, project_id, interval_start, interval_seconds, action, inline, allocated, settled, placement)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, (SELECT placement FROM bucket_metainfos WHERE project_id = ? AND name = ?))
 			ON CONFLICT(bucket_name, project_id, interval_start, action)
 			DO UPDATE SET settled = bucket_bandwidth_rollups.settled + ?`,
 		)
 		_, err = tx.Tx.ExecContext(ctx, statement,
-			bucketName, projectID[:], intervalStart.UTC(), defaultIntervalSeconds, action, 0, 0, uint64(settledAmount), uint64(settledAmount),
+			bucketName, projectID[:], intervalStart.UTC(), defaultIntervalSeconds, action, 0, 0, uint64(settledAmount), projectID[:], bucketName, uint64(settledAmount),
 		)
 		if err != nil {
 			return ErrUpdateBucketBandwidthSettle.Wrap(err)
@@ -143,13 +143,13 @@ func (db *ordersDB) UpdateBucketBandwidthInline(ctx context.Context, projectID u
 	defer mon.Task()(&ctx)(&err)
 
 	statement := db.db.Rebind(
-		`INSERT INTO bucket_bandwidth_rollups (bucket_name, project_id, interval_start, interval_seconds, action, inline, allocated, settled)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		`INSERT INTO bucket_bandwidth_rollups (bucket_name, project_id, interval_start, interval_seconds, action, inline, allocated, settled, placement)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, (SELECT placement FROM bucket_metainfos WHERE project_id = ? AND name = ?))
 		ON CONFLICT(bucket_name, project_id, interval_start, action)
 		DO UPDATE SET inline = bucket_bandwidth_rollups.inline + ?`,
 	)
 	_, err = db.db.ExecContext(ctx, statement,
-		bucketName, projectID[:], intervalStart.UTC(), defaultIntervalSeconds, action, uint64(amount), 0, 0, uint64(amount),
+		bucketName, projectID[:], intervalStart.UTC(), defaultIntervalSeconds, action, uint64(amount), 0, 0, projectID[:], bucketName, uint64(amount),
 	)
 	if err != nil {
 		return err
@@ -257,11 +257,17 @@ func (db *ordersDB) UpdateBandwidthBatch(ctx context.Context, rollups []orders.B
 		INSERT INTO bucket_bandwidth_rollups (
 			bucket_name, project_id,
 			interval_start, interval_seconds,
-			action, inline, allocated, settled)
+			action, inline, allocated, settled, placement)
 		SELECT
-			unnest($1::bytea[]), unnest($2::bytea[]), unnest($3::timestamptz[]),
-			$4,
-			unnest($5::int4[]), unnest($6::bigint[]), unnest($7::bigint[]), unnest($8::bigint[])
+			rollups.bucket_name, rollups.project_id,
+			rollups.interval_start, $4,
+			rollups.action, rollups.inline, rollups.allocated, rollups.settled,
+			(SELECT placement FROM bucket_metainfos WHERE project_id = rollups.project_id AND name = rollups.bucket_name)
+		FROM (
+			SELECT
+				unnest($1::bytea[]) AS bucket_name, unnest($2::bytea[]) AS project_id, unnest($3::timestamptz[]) AS interval_start,
+				unnest($5::int4[]) AS action, unnest($6::bigint[]) AS inline, unnest($7::bigint[]) AS allocated, unnest($8::bigint[]) AS settled
+		) AS rollups
 		ON CONFLICT(bucket_name, project_id, interval_start, action)
 		DO UPDATE SET
 			allocated = bucket_bandwidth_rollups.allocated + EXCLUDED.allocated,
@@ -311,6 +317,107 @@ func (db *ordersDB) UpdateBandwidthBatch(ctx context.Context, rollups []orders.B
 	})
 }
 
+// UpdateAPIKeyBandwidth updates the bandwidth rollups of api keys.
+func (db *ordersDB) UpdateAPIKeyBandwidth(ctx context.Context, rollups []orders.APIKeyBandwidthRollup) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	projectIDs := make([]uuid.UUID, 0, len(rollups))
+	apiKeyHeads := make([][]byte, 0, len(rollups))
+	intervalStartSlice := make([]time.Time, 0, len(rollups))
+	actionSlice := make([]int32, 0, len(rollups))
+	inlineSlice := make([]int64, 0, len(rollups))
+	allocatedSlice := make([]int64, 0, len(rollups))
+	settledSlice := make([]int64, 0, len(rollups))
+	requestsSlice := make([]int64, 0, len(rollups))
+
+	for _, rollup := range rollups {
+		projectIDs = append(projectIDs, rollup.ProjectID)
+		apiKeyHeads = append(apiKeyHeads, rollup.APIKeyHead)
+		intervalStartSlice = append(intervalStartSlice, time.Unix(toHourlyInterval(rollup.IntervalStart.UTC()), 0))
+		actionSlice = append(actionSlice, int32(rollup.Action))
+		inlineSlice = append(inlineSlice, rollup.Inline)
+		allocatedSlice = append(allocatedSlice, rollup.Allocated)
+		settledSlice = append(settledSlice, rollup.Settled)
+		requestsSlice = append(requestsSlice, rollup.Requests)
+	}
+
+	// the rollups are aggregated by the callers, but the same key may still
+	// appear twice when the rollups cover the same hour.
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO api_key_bandwidth_rollups (
+			project_id, api_key_head,
+			interval_start, action,
+			inline, allocated, settled, request_count)
+		SELECT
+			project_id, api_key_head, interval_start, action,
+			SUM(inline), SUM(allocated), SUM(settled), SUM(request_count)
+		FROM unnest($1::bytea[], $2::bytea[], $3::timestamptz[], $4::int4[], $5::bigint[], $6::bigint[], $7::bigint[], $8::bigint[])
+			AS t(project_id, api_key_head, interval_start, action, inline, allocated, settled, request_count)
+		GROUP BY project_id, api_key_head, interval_start, action
+		ON CONFLICT(project_id, api_key_head, interval_start, action)
+		DO UPDATE SET
+			inline = api_key_bandwidth_rollups.inline + EXCLUDED.inline,
+			allocated = api_key_bandwidth_rollups.allocated + EXCLUDED.allocated,
+			settled = api_key_bandwidth_rollups.settled + EXCLUDED.settled,
+			request_count = api_key_bandwidth_rollups.request_count + EXCLUDED.request_count`,
+		pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(apiKeyHeads), pgutil.TimestampTZArray(intervalStartSlice),
+		pgutil.Int4Array(actionSlice), pgutil.Int8Array(inlineSlice), pgutil.Int8Array(allocatedSlice),
+		pgutil.Int8Array(settledSlice), pgutil.Int8Array(requestsSlice))
+	return Error.Wrap(err)
+}
+
+// UpdateSharedLinkBandwidth updates the bandwidth rollups of shared links.
+func (db *ordersDB) UpdateSharedLinkBandwidth(ctx context.Context, rollups []orders.SharedLinkBandwidthRollup) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	projectIDs := make([]uuid.UUID, 0, len(rollups))
+	bucketNames := make([][]byte, 0, len(rollups))
+	objectKeys := make([][]byte, 0, len(rollups))
+	intervalStartSlice := make([]time.Time, 0, len(rollups))
+	inlineSlice := make([]int64, 0, len(rollups))
+	allocatedSlice := make([]int64, 0, len(rollups))
+	requestsSlice := make([]int64, 0, len(rollups))
+
+	for _, rollup := range rollups {
+		projectIDs = append(projectIDs, rollup.ProjectID)
+		bucketNames = append(bucketNames, []byte(rollup.BucketName))
+		objectKeys = append(objectKeys, []byte(rollup.ObjectKey))
+		intervalStartSlice = append(intervalStartSlice, time.Unix(toHourlyInterval(rollup.IntervalStart.UTC()), 0))
+		inlineSlice = append(inlineSlice, rollup.Inline)
+		allocatedSlice = append(allocatedSlice, rollup.Allocated)
+		requestsSlice = append(requestsSlice, rollup.Requests)
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO shared_link_bandwidth_rollups (
+			project_id, bucket_name, object_key,
+			interval_start,
+			inline, allocated, request_count)
+		SELECT
+			project_id, bucket_name, object_key, interval_start,
+			SUM(inline), SUM(allocated), SUM(request_count)
+		FROM unnest($1::bytea[], $2::bytea[], $3::bytea[], $4::timestamptz[], $5::bigint[], $6::bigint[], $7::bigint[])
+			AS t(project_id, bucket_name, object_key, interval_start, inline, allocated, request_count)
+		GROUP BY project_id, bucket_name, object_key, interval_start
+		ON CONFLICT(project_id, bucket_name, object_key, interval_start)
+		DO UPDATE SET
+			inline = shared_link_bandwidth_rollups.inline + EXCLUDED.inline,
+			allocated = shared_link_bandwidth_rollups.allocated + EXCLUDED.allocated,
+			request_count = shared_link_bandwidth_rollups.request_count + EXCLUDED.request_count`,
+		pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames), pgutil.ByteaArray(objectKeys),
+		pgutil.TimestampTZArray(intervalStartSlice), pgutil.Int8Array(inlineSlice), pgutil.Int8Array(allocatedSlice),
+		pgutil.Int8Array(requestsSlice))
+	return Error.Wrap(err)
+}
+
 //
 // transaction/batch methods
 //
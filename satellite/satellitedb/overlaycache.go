@@ -580,8 +580,21 @@ func (cache *overlaycache) knownReliable(ctx context.Context, onlineWindow time.
 
 // Reliable returns all reliable nodes.
 func (cache *overlaycache) Reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes storj.NodeIDList, err error) {
+	reliable, err := cache.ReliableNodes(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range reliable {
+		nodes = append(nodes, node.ID)
+	}
+	return nodes, nil
+}
+
+// ReliableNodes returns all reliable nodes with their country code.
+func (cache *overlaycache) ReliableNodes(ctx context.Context, criteria *overlay.NodeCriteria) (nodes []*overlay.SelectedNode, err error) {
 	for {
-		nodes, err = cache.reliable(ctx, criteria)
+		nodes, err = cache.reliableNodes(ctx, criteria)
 		if err != nil {
 			if cockroachutil.NeedsRetry(err) {
 				continue
@@ -594,7 +607,7 @@ func (cache *overlaycache) Reliable(ctx context.Context, criteria *overlay.NodeC
 	return nodes, err
 }
 
-func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes storj.NodeIDList, err error) {
+func (cache *overlaycache) reliableNodes(ctx context.Context, criteria *overlay.NodeCriteria) (nodes []*overlay.SelectedNode, err error) {
 	args := []interface{}{
 		time.Now().Add(-criteria.OnlineWindow),
 	}
@@ -609,7 +622,7 @@ func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeC
 
 	// get reliable and online nodes
 	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
-		SELECT id
+		SELECT id, country_code
 		FROM nodes
 		`+cache.db.impl.AsOfSystemInterval(criteria.AsOfSystemInterval)+`
 		WHERE disqualified IS NULL
@@ -627,12 +640,12 @@ func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeC
 	}()
 
 	for rows.Next() {
-		var id storj.NodeID
-		err = rows.Scan(&id)
+		var node overlay.SelectedNode
+		err = rows.Scan(&node.ID, &node.CountryCode)
 		if err != nil {
 			return nil, err
 		}
-		nodes = append(nodes, id)
+		nodes = append(nodes, &node)
 	}
 	return nodes, Error.Wrap(rows.Err())
 }
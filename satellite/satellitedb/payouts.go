@@ -79,6 +79,8 @@ func convertDBXPaystub(dbxPaystub *dbx.StoragenodePaystub) (snopayouts.Paystub,
 		Disposed:       dbxPaystub.Disposed,
 		Paid:           dbxPaystub.Paid,
 		Distributed:    dbxPaystub.Distributed,
+		Wallet:         dbxPaystub.Wallet,
+		WalletFeatures: decodeWalletFeatures(dbxPaystub.WalletFeatures),
 	}, nil
 }
 
@@ -155,6 +157,11 @@ func derefStringOr(v *string, def string) string {
 
 // TestCreatePaystub inserts storagenode_paystub into database. Only used for tests.
 func (db *snopayoutsDB) TestCreatePaystub(ctx context.Context, stub snopayouts.Paystub) (err error) {
+	walletFeatures, err := encodeWalletFeatures(stub.WalletFeatures)
+	if err != nil {
+		return err
+	}
+
 	return db.db.ReplaceNoReturn_StoragenodePaystub(ctx,
 		dbx.StoragenodePaystub_Period(stub.Period),
 		dbx.StoragenodePaystub_NodeId(stub.NodeID.Bytes()),
@@ -177,6 +184,8 @@ func (db *snopayoutsDB) TestCreatePaystub(ctx context.Context, stub snopayouts.P
 		dbx.StoragenodePaystub_Disposed(stub.Disposed),
 		dbx.StoragenodePaystub_Paid(stub.Paid),
 		dbx.StoragenodePaystub_Distributed(stub.Distributed),
+		dbx.StoragenodePaystub_Wallet(stub.Wallet),
+		dbx.StoragenodePaystub_WalletFeatures(walletFeatures),
 	)
 }
 
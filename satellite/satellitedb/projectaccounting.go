@@ -15,6 +15,7 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/pb"
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/private/dbutil"
 	"storj.io/private/dbutil/pgutil"
@@ -58,13 +59,20 @@ func (db *ProjectAccounting) SaveTallies(ctx context.Context, intervalStart time
 			bucket_name, project_id,
 			total_bytes, inline, remote,
 			total_segments_count, remote_segments_count, inline_segments_count,
-			object_count, metadata_size)
+			object_count, metadata_size, placement)
 		SELECT
 			$1,
-			unnest($2::bytea[]), unnest($3::bytea[]),
-			unnest($4::int8[]), $5, $6,
-			unnest($7::int8[]), $8, $9,
-			unnest($10::int8[]), unnest($11::int8[])`),
+			tallies.bucket_name, tallies.project_id,
+			tallies.total_bytes, $5, $6,
+			tallies.total_segments_count, $8, $9,
+			tallies.object_count, tallies.metadata_size,
+			(SELECT placement FROM bucket_metainfos WHERE project_id = tallies.project_id AND name = tallies.bucket_name)
+		FROM (
+			SELECT
+				unnest($2::bytea[]) AS bucket_name, unnest($3::bytea[]) AS project_id,
+				unnest($4::int8[]) AS total_bytes, unnest($7::int8[]) AS total_segments_count,
+				unnest($10::int8[]) AS object_count, unnest($11::int8[]) AS metadata_size
+		) AS tallies`),
 		intervalStart,
 		pgutil.ByteaArray(bucketNames), pgutil.ByteaArray(projectIDs),
 		pgutil.Int8Array(totalBytes), 0, 0,
@@ -114,6 +122,44 @@ func (db *ProjectAccounting) GetTallies(ctx context.Context) (tallies []accounti
 	return tallies, nil
 }
 
+// GetLatestBucketTally returns the latest tally of the bucket. The tally is
+// empty when the bucket hasn't been tallied yet.
+func (db *ProjectAccounting) GetLatestBucketTally(ctx context.Context, bucket metabase.BucketLocation) (_ accounting.BucketTally, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tally := accounting.BucketTally{BucketLocation: bucket}
+
+	var totalBytes, inline, remote int64
+	var totalSegments, inlineSegments, remoteSegments int64
+	err = db.db.QueryRowContext(ctx, `
+		SELECT object_count, total_bytes, inline, remote,
+			total_segments_count, inline_segments_count, remote_segments_count, metadata_size
+		FROM bucket_storage_tallies
+		WHERE bucket_name = $1 AND project_id = $2
+		ORDER BY interval_start DESC
+		LIMIT 1
+	`, []byte(bucket.BucketName), bucket.ProjectID[:]).Scan(
+		&tally.ObjectCount, &totalBytes, &inline, &remote,
+		&totalSegments, &inlineSegments, &remoteSegments, &tally.MetadataSize)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return tally, nil
+		}
+		return accounting.BucketTally{}, Error.Wrap(err)
+	}
+
+	if totalBytes == 0 {
+		totalBytes = inline + remote
+	}
+	if totalSegments == 0 {
+		totalSegments = inlineSegments + remoteSegments
+	}
+	tally.TotalBytes = totalBytes
+	tally.TotalSegments = totalSegments
+
+	return tally, nil
+}
+
 // CreateStorageTally creates a record in the bucket_storage_tallies accounting table.
 func (db *ProjectAccounting) CreateStorageTally(ctx context.Context, tally accounting.BucketStorageTally) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -355,6 +401,124 @@ func (db *ProjectAccounting) GetProjectDailyUsageByDateRange(ctx context.Context
 	}, nil
 }
 
+// GetProjectHourlyUsageByDateRange returns project hourly allocated, settled bandwidth and storage usage by specific date range.
+//
+// As in the daily usage, allocated bandwidth older than allocatedExpirationInDays is reported as settled.
+// Hourly rollups don't track dead allocations, so newer hours report the full allocated amount.
+func (db *ProjectAccounting) GetProjectHourlyUsageByDateRange(ctx context.Context, projectID uuid.UUID, from, to time.Time, crdbInterval time.Duration) (_ *accounting.ProjectHourlyUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	nowBeginningOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	expiredSince := nowBeginningOfDay.Add(time.Duration(-allocatedExpirationInDays) * time.Hour * 24)
+
+	// the range is half-open: [fromBeginningOfHour, toNextHour).
+	fromBeginningOfHour := from.UTC().Truncate(time.Hour)
+	toNextHour := to.UTC().Truncate(time.Hour).Add(time.Hour)
+
+	usage := &accounting.ProjectHourlyUsage{
+		StorageUsage:            make([]accounting.ProjectUsageByHour, 0),
+		AllocatedBandwidthUsage: make([]accounting.ProjectUsageByHour, 0),
+		SettledBandwidthUsage:   make([]accounting.ProjectUsageByHour, 0),
+	}
+
+	err = pgxutil.Conn(ctx, db.db, func(conn *pgx.Conn) error {
+		var batch pgx.Batch
+
+		batch.Queue(db.db.Rebind(`
+			SELECT interval_start, SUM(settled),
+				CASE WHEN interval_start < $1
+					THEN SUM(settled)
+					ELSE SUM(allocated)
+				END AS allocated
+			FROM bucket_bandwidth_rollups
+			WHERE project_id = $2 AND action = $3 AND
+				interval_start >= $4 AND interval_start < $5
+			GROUP BY interval_start
+			ORDER BY interval_start
+		`), expiredSince, projectID, pb.PieceAction_GET, fromBeginningOfHour, toNextHour)
+
+		// Use the latest tally of every bucket within the hour and sum all buckets.
+		batch.Queue(db.db.Rebind(`
+			SELECT
+				interval_hour,
+				SUM(total_bytes) AS total_bytes
+			FROM
+				(SELECT
+					DISTINCT ON (bucket_name, interval_hour)
+					bucket_name,
+					DATE_TRUNC('hour', interval_start) AS interval_hour,
+					total_bytes
+				FROM bucket_storage_tallies
+				WHERE project_id = $1 AND
+					interval_start >= $2 AND
+					interval_start < $3
+				ORDER BY bucket_name, interval_hour, interval_start DESC) pu
+			`+db.db.impl.AsOfSystemInterval(crdbInterval)+`
+			GROUP BY interval_hour
+			ORDER BY interval_hour
+		`), projectID, fromBeginningOfHour, toNextHour)
+
+		results := conn.SendBatch(ctx, &batch)
+		defer func() { err = errs.Combine(err, results.Close()) }()
+
+		bandwidthRows, err := results.Query()
+		if err != nil {
+			return err
+		}
+		defer func() { bandwidthRows.Close() }()
+
+		for bandwidthRows.Next() {
+			var hour time.Time
+			var settled, allocated int64
+
+			err = bandwidthRows.Scan(&hour, &settled, &allocated)
+			if err != nil {
+				return err
+			}
+
+			usage.SettledBandwidthUsage = append(usage.SettledBandwidthUsage, accounting.ProjectUsageByHour{
+				Hour:  hour.UTC(),
+				Value: settled,
+			})
+			usage.AllocatedBandwidthUsage = append(usage.AllocatedBandwidthUsage, accounting.ProjectUsageByHour{
+				Hour:  hour.UTC(),
+				Value: allocated,
+			})
+		}
+		if err = bandwidthRows.Err(); err != nil {
+			return err
+		}
+
+		storageRows, err := results.Query()
+		if err != nil {
+			return err
+		}
+		defer func() { storageRows.Close() }()
+
+		for storageRows.Next() {
+			var hour time.Time
+			var amount int64
+
+			err = storageRows.Scan(&hour, &amount)
+			if err != nil {
+				return err
+			}
+
+			usage.StorageUsage = append(usage.StorageUsage, accounting.ProjectUsageByHour{
+				Hour:  hour.UTC(),
+				Value: amount,
+			})
+		}
+		return storageRows.Err()
+	})
+	if err != nil {
+		return nil, Error.New("unable to get project hourly usage: %w", err)
+	}
+
+	return usage, nil
+}
+
 // DeleteProjectBandwidthBefore deletes project bandwidth rollups before the given time.
 func (db *ProjectAccounting) DeleteProjectBandwidthBefore(ctx context.Context, before time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -581,6 +745,113 @@ func (db *ProjectAccounting) GetProjectTotal(ctx context.Context, projectID uuid
 // getTotalEgress returns total egress (settled + inline) of each bucket_bandwidth_rollup
 // in selected time period, project id.
 // only process PieceAction_GET.
+// GetProjectTotalByPlacement retrieves project usage for a given period, split
+// by the placement of the buckets. Usage recorded before the placement was
+// tracked is attributed to the current placement of the bucket.
+func (db *ProjectAccounting) GetProjectTotalByPlacement(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ map[storj.PlacementConstraint]accounting.ProjectUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	since = timeTruncateDown(since)
+
+	usages := make(map[storj.PlacementConstraint]accounting.ProjectUsage)
+	addUsage := func(placement storj.PlacementConstraint, add func(usage *accounting.ProjectUsage)) {
+		usage := usages[placement]
+		usage.Since = since
+		usage.Before = before
+		add(&usage)
+		usages[placement] = usage
+	}
+
+	storageRows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT
+			bst.bucket_name,
+			bst.interval_start,
+			bst.total_bytes,
+			bst.inline,
+			bst.remote,
+			bst.total_segments_count,
+			bst.object_count,
+			COALESCE(bst.placement, bm.placement, 0)
+		FROM
+			bucket_storage_tallies bst
+			LEFT JOIN bucket_metainfos bm ON bm.project_id = bst.project_id AND bm.name = bst.bucket_name
+		WHERE
+			bst.project_id = ? AND
+			bst.interval_start >= ? AND
+			bst.interval_start <= ?
+		ORDER BY bst.bucket_name, bst.interval_start DESC
+	`), projectID[:], since, before)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, storageRows.Close()) }()
+
+	// the usage between two tallies of a bucket is attributed to the placement
+	// of the older tally.
+	var newer *accounting.BucketStorageTally
+	for storageRows.Next() {
+		var tally accounting.BucketStorageTally
+		var bucketName []byte
+		var inline, remote int64
+		var placement int
+		err = storageRows.Scan(&bucketName, &tally.IntervalStart, &tally.TotalBytes, &inline, &remote, &tally.TotalSegmentCount, &tally.ObjectCount, &placement)
+		if err != nil {
+			return nil, err
+		}
+		if tally.TotalBytes == 0 {
+			tally.TotalBytes = inline + remote
+		}
+		tally.BucketName = string(bucketName)
+
+		if newer != nil && newer.BucketName == tally.BucketName {
+			hours := newer.IntervalStart.Sub(tally.IntervalStart).Hours()
+			addUsage(storj.PlacementConstraint(placement), func(usage *accounting.ProjectUsage) {
+				usage.Storage += memory.Size(tally.Bytes()).Float64() * hours
+				usage.SegmentCount += float64(tally.TotalSegmentCount) * hours
+				usage.ObjectCount += float64(tally.ObjectCount) * hours
+			})
+		}
+		newer = &tally
+	}
+	if err := storageRows.Err(); err != nil {
+		return nil, err
+	}
+
+	egressRows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT
+			COALESCE(bbr.placement, bm.placement, 0),
+			COALESCE(SUM(bbr.settled) + SUM(bbr.inline), 0)
+		FROM
+			bucket_bandwidth_rollups bbr
+			LEFT JOIN bucket_metainfos bm ON bm.project_id = bbr.project_id AND bm.name = bbr.bucket_name
+		WHERE
+			bbr.project_id = ? AND
+			bbr.interval_start >= ? AND
+			bbr.interval_start <= ? AND
+			bbr.action = ?
+		GROUP BY 1
+	`), projectID[:], since, before, pb.PieceAction_GET)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, egressRows.Close()) }()
+
+	for egressRows.Next() {
+		var placement int
+		var egress int64
+		if err := egressRows.Scan(&placement, &egress); err != nil {
+			return nil, err
+		}
+		addUsage(storj.PlacementConstraint(placement), func(usage *accounting.ProjectUsage) {
+			usage.Egress += egress
+		})
+	}
+	if err := egressRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return usages, nil
+}
+
 func (db *ProjectAccounting) getTotalEgress(ctx context.Context, projectID uuid.UUID, since, before time.Time) (totalEgress int64, err error) {
 	totalEgressQuery := db.db.Rebind(`
 		SELECT
@@ -625,6 +896,110 @@ func (db *ProjectAccounting) GetBucketUsageRollups(ctx context.Context, projectI
 	return bucketUsageRollups, nil
 }
 
+// GetProjectAPIKeyUsage retrieves the usage attributed to each api key of particular project for a given period.
+func (db *ProjectAccounting) GetProjectAPIKeyUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.APIKeyUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	since = timeTruncateDown(since.UTC())
+	before = before.UTC()
+
+	query := db.db.Rebind(`
+		SELECT
+			api_keys.id, api_keys.name,
+			COALESCE(SUM(CASE WHEN rollups.action = ? THEN rollups.settled + rollups.inline ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN rollups.action = ? THEN rollups.settled + rollups.inline ELSE 0 END), 0),
+			COALESCE(SUM(rollups.request_count), 0)
+		FROM
+			api_key_bandwidth_rollups AS rollups
+			LEFT JOIN api_keys ON api_keys.head = rollups.api_key_head
+		WHERE
+			rollups.project_id = ? AND
+			rollups.interval_start >= ? AND
+			rollups.interval_start <= ?
+		GROUP BY rollups.api_key_head, api_keys.id, api_keys.name
+		ORDER BY api_keys.name
+	`)
+
+	rows, err := db.db.QueryContext(ctx, query, pb.PieceAction_GET, pb.PieceAction_PUT, projectID[:], since, before)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var usages []accounting.APIKeyUsage
+	for rows.Next() {
+		var apiKeyID []byte
+		var name sql.NullString
+		usage := accounting.APIKeyUsage{
+			Since:  since,
+			Before: before,
+		}
+
+		err = rows.Scan(&apiKeyID, &name, &usage.Egress, &usage.Ingress, &usage.Requests)
+		if err != nil {
+			return nil, err
+		}
+
+		if apiKeyID != nil {
+			usage.APIKeyID, err = uuid.FromBytes(apiKeyID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		usage.Name = name.String
+
+		usages = append(usages, usage)
+	}
+
+	return usages, rows.Err()
+}
+
+// GetProjectSharedLinkUsage retrieves the usage of the objects of particular project, which were downloaded
+// through shared links, for a given period. The most requested objects are returned first.
+func (db *ProjectAccounting) GetProjectSharedLinkUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.SharedLinkUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	since = timeTruncateDown(since.UTC())
+	before = before.UTC()
+
+	query := db.db.Rebind(`
+		SELECT
+			bucket_name, object_key,
+			SUM(allocated + inline), SUM(request_count)
+		FROM
+			shared_link_bandwidth_rollups
+		WHERE
+			project_id = ? AND
+			interval_start >= ? AND
+			interval_start <= ?
+		GROUP BY bucket_name, object_key
+		ORDER BY SUM(request_count) DESC, bucket_name, object_key
+	`)
+
+	rows, err := db.db.QueryContext(ctx, query, projectID[:], since, before)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var usages []accounting.SharedLinkUsage
+	for rows.Next() {
+		var bucketName []byte
+		usage := accounting.SharedLinkUsage{
+			Since:  since,
+			Before: before,
+		}
+
+		err = rows.Scan(&bucketName, &usage.EncryptedObjectKey, &usage.Egress, &usage.Requests)
+		if err != nil {
+			return nil, err
+		}
+		usage.BucketName = string(bucketName)
+
+		usages = append(usages, usage)
+	}
+
+	return usages, rows.Err()
+}
+
 // GetSingleBucketUsageRollup retrieves usage rollup for a single bucket of particular project for a given period.
 func (db *ProjectAccounting) GetSingleBucketUsageRollup(ctx context.Context, projectID uuid.UUID, bucket string, since, before time.Time) (_ *accounting.BucketUsageRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1043,13 +1418,199 @@ func (db *ProjectAccounting) GetProjectLimits(ctx context.Context, projectID uui
 		return accounting.ProjectLimits{}, err
 	}
 
+	exemptions, err := db.getProjectLimitExemptions(ctx, projectID, true)
+	if err != nil {
+		return accounting.ProjectLimits{}, err
+	}
+
+	freeze, err := db.GetProjectFreeze(ctx, projectID)
+	if err != nil {
+		return accounting.ProjectLimits{}, err
+	}
+
+	deletedAt, err := db.getProjectDeletedAt(ctx, projectID)
+	if err != nil {
+		return accounting.ProjectLimits{}, err
+	}
+
 	return accounting.ProjectLimits{
 		Usage:     row.UsageLimit,
 		Bandwidth: row.BandwidthLimit,
 		Segments:  row.SegmentLimit,
+
+		Exemptions: exemptions,
+		Freeze:     freeze,
+		DeletedAt:  deletedAt,
 	}, nil
 }
 
+// getProjectDeletedAt returns when the project was deleted, nil when it isn't deleted.
+func (db *ProjectAccounting) getProjectDeletedAt(ctx context.Context, projectID uuid.UUID) (_ *time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var deletedAt time.Time
+	err = db.db.QueryRowContext(ctx, `
+		SELECT deleted_at
+		FROM project_deletions
+		WHERE project_id = $1
+	`, projectID).Scan(&deletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &deletedAt, nil
+}
+
+// SetProjectLimitExemption creates the limit exemption of a project or replaces the existing one for the same limit.
+func (db *ProjectAccounting) SetProjectLimitExemption(ctx context.Context, exemption accounting.LimitExemption) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := exemption.Validate(); err != nil {
+		return err
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO project_limit_exemptions (project_id, limit_kind, reason, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, now())
+		ON CONFLICT (project_id, limit_kind)
+		DO UPDATE SET reason = EXCLUDED.reason, expires_at = EXCLUDED.expires_at, created_at = EXCLUDED.created_at
+	`, exemption.ProjectID, string(exemption.Kind), string(exemption.Reason), exemption.ExpiresAt)
+	return Error.Wrap(err)
+}
+
+// DeleteProjectLimitExemption deletes the exemption of a project for the limit of the given kind.
+func (db *ProjectAccounting) DeleteProjectLimitExemption(ctx context.Context, projectID uuid.UUID, kind accounting.LimitKind) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM project_limit_exemptions
+		WHERE project_id = $1 AND limit_kind = $2
+	`, projectID, string(kind))
+	return Error.Wrap(err)
+}
+
+// GetProjectLimitExemptions returns all the limit exemptions of a project, including the expired ones.
+func (db *ProjectAccounting) GetProjectLimitExemptions(ctx context.Context, projectID uuid.UUID) (_ []accounting.LimitExemption, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.getProjectLimitExemptions(ctx, projectID, false)
+}
+
+// getProjectLimitExemptions returns the limit exemptions of a project, only
+// the ones which haven't expired when onlyActive is true.
+func (db *ProjectAccounting) getProjectLimitExemptions(ctx context.Context, projectID uuid.UUID, onlyActive bool) (_ []accounting.LimitExemption, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `
+		SELECT limit_kind, reason, expires_at, created_at
+		FROM project_limit_exemptions
+		WHERE project_id = $1
+	`
+	if onlyActive {
+		query += ` AND expires_at > now()`
+	}
+	query += ` ORDER BY limit_kind`
+
+	rows, err := db.db.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var exemptions []accounting.LimitExemption
+	for rows.Next() {
+		exemption := accounting.LimitExemption{ProjectID: projectID}
+		var kind, reason string
+		if err := rows.Scan(&kind, &reason, &exemption.ExpiresAt, &exemption.CreatedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		exemption.Kind = accounting.LimitKind(kind)
+		exemption.Reason = accounting.LimitExemptionReason(reason)
+		exemptions = append(exemptions, exemption)
+	}
+	return exemptions, Error.Wrap(rows.Err())
+}
+
+// FreezeProject freezes a project or replaces its existing freeze.
+func (db *ProjectAccounting) FreezeProject(ctx context.Context, freeze accounting.ProjectFreeze) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := freeze.Validate(); err != nil {
+		return err
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO project_freezes (project_id, reason, downloads, invoice_id, created_at)
+		VALUES ($1, $2, $3, $4, now())
+		ON CONFLICT (project_id)
+		DO UPDATE SET reason = EXCLUDED.reason, downloads = EXCLUDED.downloads, invoice_id = EXCLUDED.invoice_id, created_at = EXCLUDED.created_at
+	`, freeze.ProjectID, string(freeze.Reason), freeze.Downloads, freeze.InvoiceID)
+	return Error.Wrap(err)
+}
+
+// UnfreezeProject unfreezes a project.
+func (db *ProjectAccounting) UnfreezeProject(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM project_freezes
+		WHERE project_id = $1
+	`, projectID)
+	return Error.Wrap(err)
+}
+
+// UnfreezeProjectsByInvoice unfreezes the projects frozen because of the invoice and returns their IDs.
+func (db *ProjectAccounting) UnfreezeProjectsByInvoice(ctx context.Context, invoiceID string) (_ []uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if invoiceID == "" {
+		return nil, Error.New("missing invoice ID")
+	}
+
+	rows, err := db.db.QueryContext(ctx, `
+		DELETE FROM project_freezes
+		WHERE invoice_id = $1
+		RETURNING project_id
+	`, invoiceID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var projectIDs []uuid.UUID
+	for rows.Next() {
+		var projectID uuid.UUID
+		if err := rows.Scan(&projectID); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
+	return projectIDs, Error.Wrap(rows.Err())
+}
+
+// GetProjectFreeze returns the freeze of a project, nil when the project isn't frozen.
+func (db *ProjectAccounting) GetProjectFreeze(ctx context.Context, projectID uuid.UUID) (_ *accounting.ProjectFreeze, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	freeze := accounting.ProjectFreeze{ProjectID: projectID}
+	var reason string
+	err = db.db.QueryRowContext(ctx, `
+		SELECT reason, downloads, invoice_id, created_at
+		FROM project_freezes
+		WHERE project_id = $1
+	`, projectID).Scan(&reason, &freeze.Downloads, &freeze.InvoiceID, &freeze.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	freeze.Reason = accounting.FreezeReason(reason)
+	return &freeze, nil
+}
+
 // GetRollupsSince retrieves all archived rollup records since a given time.
 func (db *ProjectAccounting) GetRollupsSince(ctx context.Context, since time.Time) (bwRollups []orders.BucketBandwidthRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1125,3 +1686,59 @@ func (db *ProjectAccounting) GetArchivedRollupsSince(ctx context.Context, since
 		}
 	}
 }
+
+// SaveLiveUsages replaces the persisted live accounting usages with the given ones.
+// The usages of the projects, which aren't in usages, are deleted.
+func (db *ProjectAccounting) SaveLiveUsages(ctx context.Context, usages map[uuid.UUID]accounting.Usage, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectIDs := make([][]byte, 0, len(usages))
+	storage := make([]int64, 0, len(usages))
+	segments := make([]int64, 0, len(usages))
+	for projectID, usage := range usages {
+		projectID := projectID
+		projectIDs = append(projectIDs, projectID[:])
+		storage = append(storage, usage.Storage)
+		segments = append(segments, usage.Segments)
+	}
+
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		if len(usages) > 0 {
+			_, err := tx.Tx.ExecContext(ctx, `
+				INSERT INTO project_live_usages (project_id, storage, segments, updated_at)
+				SELECT unnest($1::bytea[]), unnest($2::int8[]), unnest($3::int8[]), $4
+				ON CONFLICT (project_id)
+				DO UPDATE SET storage = EXCLUDED.storage, segments = EXCLUDED.segments, updated_at = EXCLUDED.updated_at
+			`, pgutil.ByteaArray(projectIDs), pgutil.Int8Array(storage), pgutil.Int8Array(segments), now)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.Tx.ExecContext(ctx, `DELETE FROM project_live_usages WHERE updated_at < $1`, now)
+		return err
+	}))
+}
+
+// GetLiveUsages returns the persisted live accounting usages.
+func (db *ProjectAccounting) GetLiveUsages(ctx context.Context) (_ map[uuid.UUID]accounting.Usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `SELECT project_id, storage, segments FROM project_live_usages`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	usages := map[uuid.UUID]accounting.Usage{}
+	for rows.Next() {
+		var projectID uuid.UUID
+		var usage accounting.Usage
+		if err := rows.Scan(&projectID, &usage.Storage, &usage.Segments); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		usages[projectID] = usage
+	}
+
+	return usages, Error.Wrap(rows.Err())
+}
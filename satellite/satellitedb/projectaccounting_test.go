@@ -13,10 +13,13 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
 func Test_DailyUsage(t *testing.T) {
@@ -95,6 +98,83 @@ func Test_DailyUsage(t *testing.T) {
 	)
 }
 
+func Test_HourlyUsage(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			const (
+				firstBucketName  = "testbucket0"
+				secondBucketName = "testbucket1"
+			)
+
+			now := time.Now().UTC()
+			hourAgo := now.Add(-time.Hour)
+
+			var (
+				satelliteSys = planet.Satellites[0]
+				projectID    = planet.Uplinks[0].Projects[0].ID
+			)
+
+			usage0, err := satelliteSys.DB.ProjectAccounting().GetProjectHourlyUsageByDateRange(ctx, projectID, hourAgo, now, 0)
+			require.NoError(t, err)
+			require.Zero(t, len(usage0.AllocatedBandwidthUsage))
+			require.Zero(t, len(usage0.SettledBandwidthUsage))
+			require.Zero(t, len(usage0.StorageUsage))
+
+			segment := int64(15000)
+
+			firstBucketLocation := metabase.BucketLocation{
+				ProjectID:  projectID,
+				BucketName: firstBucketName,
+			}
+			secondBucketLocation := metabase.BucketLocation{
+				ProjectID:  projectID,
+				BucketName: secondBucketName,
+			}
+			tallies := map[metabase.BucketLocation]*accounting.BucketTally{
+				firstBucketLocation: {
+					BucketLocation: firstBucketLocation,
+					TotalBytes:     segment,
+				},
+				secondBucketLocation: {
+					BucketLocation: secondBucketLocation,
+					TotalBytes:     segment,
+				},
+			}
+
+			err = satelliteSys.DB.ProjectAccounting().SaveTallies(ctx, hourAgo, tallies)
+			require.NoError(t, err)
+			err = satelliteSys.DB.ProjectAccounting().SaveTallies(ctx, now, tallies)
+			require.NoError(t, err)
+
+			for _, bucket := range []string{firstBucketName, secondBucketName} {
+				err = satelliteSys.DB.Orders().UpdateBucketBandwidthAllocation(ctx, projectID, []byte(bucket), pb.PieceAction_GET, segment, hourAgo)
+				require.NoError(t, err)
+				err = satelliteSys.DB.Orders().UpdateBucketBandwidthSettle(ctx, projectID, []byte(bucket), pb.PieceAction_GET, segment, 0, hourAgo)
+				require.NoError(t, err)
+			}
+			err = satelliteSys.DB.Orders().UpdateBucketBandwidthAllocation(ctx, projectID, []byte(firstBucketName), pb.PieceAction_GET, segment, now)
+			require.NoError(t, err)
+
+			usage1, err := satelliteSys.DB.ProjectAccounting().GetProjectHourlyUsageByDateRange(ctx, projectID, hourAgo, now, 0)
+			require.NoError(t, err)
+
+			require.Len(t, usage1.StorageUsage, 2)
+			require.Equal(t, hourAgo.Truncate(time.Hour), usage1.StorageUsage[0].Hour)
+			require.Equal(t, 2*segment, usage1.StorageUsage[0].Value)
+			require.Equal(t, now.Truncate(time.Hour), usage1.StorageUsage[1].Hour)
+			require.Equal(t, 2*segment, usage1.StorageUsage[1].Value)
+
+			require.Len(t, usage1.AllocatedBandwidthUsage, 2)
+			require.Equal(t, 2*segment, usage1.AllocatedBandwidthUsage[0].Value)
+			require.Equal(t, segment, usage1.AllocatedBandwidthUsage[1].Value)
+
+			require.Len(t, usage1.SettledBandwidthUsage, 2)
+			require.Equal(t, 2*segment, usage1.SettledBandwidthUsage[0].Value)
+			require.Zero(t, usage1.SettledBandwidthUsage[1].Value)
+		},
+	)
+}
+
 func Test_GetSingleBucketRollup(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1},
 		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
@@ -177,3 +257,134 @@ func Test_GetSingleBucketRollup(t *testing.T) {
 		},
 	)
 }
+
+func TestProjectLimitExemptions(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		projectAccounting := db.ProjectAccounting()
+		projectID := testrand.UUID()
+		now := time.Now()
+
+		err := projectAccounting.SetProjectLimitExemption(ctx, accounting.LimitExemption{
+			ProjectID: projectID,
+			Kind:      accounting.LimitKindBandwidth,
+			Reason:    accounting.LimitExemptionReasonMigration,
+			ExpiresAt: now.Add(24 * time.Hour),
+		})
+		require.NoError(t, err)
+
+		err = projectAccounting.SetProjectLimitExemption(ctx, accounting.LimitExemption{
+			ProjectID: projectID,
+			Kind:      accounting.LimitKindStorage,
+			Reason:    accounting.LimitExemptionReasonSupport,
+			ExpiresAt: now.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+
+		err = projectAccounting.SetProjectLimitExemption(ctx, accounting.LimitExemption{
+			ProjectID: projectID,
+			Kind:      "unknown",
+			Reason:    accounting.LimitExemptionReasonSupport,
+			ExpiresAt: now.Add(time.Hour),
+		})
+		require.True(t, accounting.ErrInvalidLimitExemption.Has(err))
+
+		exemptions, err := projectAccounting.GetProjectLimitExemptions(ctx, projectID)
+		require.NoError(t, err)
+		require.Len(t, exemptions, 2)
+
+		limits, err := projectAccounting.GetProjectLimits(ctx, projectID)
+		require.NoError(t, err)
+		require.Len(t, limits.Exemptions, 1)
+		require.True(t, limits.IsExempt(accounting.LimitKindBandwidth, now))
+		require.False(t, limits.IsExempt(accounting.LimitKindStorage, now))
+
+		// setting the exemption again replaces the previous one.
+		err = projectAccounting.SetProjectLimitExemption(ctx, accounting.LimitExemption{
+			ProjectID: projectID,
+			Kind:      accounting.LimitKindBandwidth,
+			Reason:    accounting.LimitExemptionReasonEvaluation,
+			ExpiresAt: now.Add(48 * time.Hour),
+		})
+		require.NoError(t, err)
+
+		exemptions, err = projectAccounting.GetProjectLimitExemptions(ctx, projectID)
+		require.NoError(t, err)
+		require.Len(t, exemptions, 2)
+		require.Equal(t, accounting.LimitKindBandwidth, exemptions[0].Kind)
+		require.Equal(t, accounting.LimitExemptionReasonEvaluation, exemptions[0].Reason)
+
+		err = projectAccounting.DeleteProjectLimitExemption(ctx, projectID, accounting.LimitKindBandwidth)
+		require.NoError(t, err)
+
+		limits, err = projectAccounting.GetProjectLimits(ctx, projectID)
+		require.NoError(t, err)
+		require.Empty(t, limits.Exemptions)
+	})
+}
+
+func TestProjectFreezes(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		projectAccounting := db.ProjectAccounting()
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "frozen", OwnerID: testrand.UUID()})
+		require.NoError(t, err)
+		other, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "other", OwnerID: testrand.UUID()})
+		require.NoError(t, err)
+
+		freeze, err := projectAccounting.GetProjectFreeze(ctx, project.ID)
+		require.NoError(t, err)
+		require.Nil(t, freeze)
+
+		err = projectAccounting.FreezeProject(ctx, accounting.ProjectFreeze{
+			ProjectID: project.ID,
+			Reason:    "unknown",
+		})
+		require.True(t, accounting.ErrInvalidProjectFreeze.Has(err))
+
+		for _, projectID := range []uuid.UUID{project.ID, other.ID} {
+			err = projectAccounting.FreezeProject(ctx, accounting.ProjectFreeze{
+				ProjectID: projectID,
+				Reason:    accounting.FreezeReasonNonPayment,
+				InvoiceID: "in_unpaid",
+			})
+			require.NoError(t, err)
+		}
+
+		limits, err := projectAccounting.GetProjectLimits(ctx, project.ID)
+		require.NoError(t, err)
+		require.NotNil(t, limits.Freeze)
+		require.True(t, limits.FreezesUploads())
+		require.False(t, limits.FreezesDownloads())
+		require.Equal(t, "in_unpaid", limits.Freeze.InvoiceID)
+
+		// freezing the project again replaces the previous freeze.
+		err = projectAccounting.FreezeProject(ctx, accounting.ProjectFreeze{
+			ProjectID: project.ID,
+			Reason:    accounting.FreezeReasonSupport,
+			Downloads: true,
+		})
+		require.NoError(t, err)
+
+		freeze, err = projectAccounting.GetProjectFreeze(ctx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, accounting.FreezeReasonSupport, freeze.Reason)
+		require.True(t, freeze.Downloads)
+		require.Empty(t, freeze.InvoiceID)
+
+		// paying the invoice unfreezes only the projects frozen because of it.
+		unfrozen, err := projectAccounting.UnfreezeProjectsByInvoice(ctx, "in_unpaid")
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{other.ID}, unfrozen)
+
+		freeze, err = projectAccounting.GetProjectFreeze(ctx, project.ID)
+		require.NoError(t, err)
+		require.NotNil(t, freeze)
+
+		err = projectAccounting.UnfreezeProject(ctx, project.ID)
+		require.NoError(t, err)
+
+		limits, err = projectAccounting.GetProjectLimits(ctx, project.ID)
+		require.NoError(t, err)
+		require.Nil(t, limits.Freeze)
+	})
+}
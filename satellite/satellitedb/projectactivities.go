@@ -0,0 +1,165 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that projectActivities implements console.ProjectActivities.
+var _ console.ProjectActivities = (*projectActivities)(nil)
+
+// projectActivities is an implementation of console.ProjectActivities.
+type projectActivities struct {
+	db *satelliteDB
+	tx *dbx.Tx
+}
+
+// Insert records a project activity.
+func (activities *projectActivities) Insert(ctx context.Context, activity *console.ProjectActivity) (_ *console.ProjectActivity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	inserted := *activity
+	inserted.ID = id
+	if inserted.CreatedAt.IsZero() {
+		inserted.CreatedAt = time.Now()
+	}
+
+	var actorID []byte
+	if inserted.ActorID != nil {
+		actorID = inserted.ActorID.Bytes()
+	}
+
+	_, err = activities.exec(ctx, `
+		INSERT INTO project_activities (id, project_id, actor_id, kind, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, inserted.ID[:], inserted.ProjectID[:], actorID, string(inserted.Kind), inserted.Details, inserted.CreatedAt)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &inserted, nil
+}
+
+// GetPagedByProjectID returns the activities of the project, most recent first.
+func (activities *projectActivities) GetPagedByProjectID(ctx context.Context, projectID uuid.UUID, cursor console.ProjectActivityCursor) (_ *console.ProjectActivityPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cursor.Limit > 50 {
+		cursor.Limit = 50
+	}
+	if cursor.Limit == 0 {
+		return nil, errs.New("limit cannot be 0")
+	}
+	if cursor.Page == 0 {
+		return nil, errs.New("page cannot be 0")
+	}
+
+	page := &console.ProjectActivityPage{
+		Limit:  cursor.Limit,
+		Offset: uint64((cursor.Page - 1) * cursor.Limit),
+	}
+
+	rows, err := activities.query(ctx, `
+		SELECT COUNT(*) FROM project_activities WHERE project_id = $1
+	`, projectID[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	err = scanProjectActivityCount(rows, &page.TotalCount)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if page.TotalCount == 0 {
+		return page, nil
+	}
+	if page.Offset > page.TotalCount-1 {
+		return nil, errs.New("page is out of range")
+	}
+
+	rows, err = activities.query(ctx, `
+		SELECT pa.id, pa.project_id, pa.actor_id, COALESCE(u.email, ''), pa.kind, pa.details, pa.created_at
+		FROM project_activities pa
+		LEFT JOIN users u ON u.id = pa.actor_id
+		WHERE pa.project_id = $1
+		ORDER BY pa.created_at DESC, pa.id DESC
+		LIMIT $2 OFFSET $3
+	`, projectID[:], page.Limit, page.Offset)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	page.Activities, err = scanProjectActivities(rows)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	page.PageCount = uint(page.TotalCount / uint64(cursor.Limit))
+	if page.TotalCount%uint64(cursor.Limit) != 0 {
+		page.PageCount++
+	}
+	page.CurrentPage = cursor.Page
+
+	return page, nil
+}
+
+func (activities *projectActivities) exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if activities.tx != nil {
+		return activities.tx.Tx.ExecContext(ctx, query, args...)
+	}
+	return activities.db.ExecContext(ctx, query, args...)
+}
+
+func (activities *projectActivities) query(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	if activities.tx != nil {
+		return activities.tx.Tx.QueryContext(ctx, query, args...)
+	}
+	return activities.db.QueryContext(ctx, query, args...)
+}
+
+func scanProjectActivityCount(rows tagsql.Rows, count *uint64) (err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+	return rows.Scan(count)
+}
+
+func scanProjectActivities(rows tagsql.Rows) (list []console.ProjectActivity, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var activity console.ProjectActivity
+		var actorID uuid.NullUUID
+		var kind string
+		err := rows.Scan(&activity.ID, &activity.ProjectID, &actorID, &activity.ActorEmail,
+			&kind, &activity.Details, &activity.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if actorID.Valid {
+			activity.ActorID = &actorID.UUID
+		}
+		activity.Kind = console.ProjectActivityKind(kind)
+		list = append(list, activity)
+	}
+	return list, rows.Err()
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that projectDeletions implements console.ProjectDeletions.
+var _ console.ProjectDeletions = (*projectDeletions)(nil)
+
+// projectDeletions is an implementation of console.ProjectDeletions.
+type projectDeletions struct {
+	db *satelliteDB
+	tx *dbx.Tx
+}
+
+// Insert marks the project as deleted.
+func (deletions *projectDeletions) Insert(ctx context.Context, deletion console.ProjectDeletion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deletedBy := uuid.NullUUID{UUID: deletion.DeletedBy, Valid: !deletion.DeletedBy.IsZero()}
+	_, err = deletions.exec(ctx, `
+		INSERT INTO project_deletions (project_id, deleted_by, deleted_at, purge_after)
+		VALUES ($1, $2, $3, $4)
+	`, deletion.ProjectID[:], deletedBy, deletion.DeletedAt, deletion.PurgeAfter)
+	return Error.Wrap(err)
+}
+
+// Get returns the deletion of the project, nil when the project isn't deleted.
+func (deletions *projectDeletions) Get(ctx context.Context, projectID uuid.UUID) (_ *console.ProjectDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := deletions.query(ctx, `
+		SELECT project_id, deleted_by, deleted_at, purge_after
+		FROM project_deletions
+		WHERE project_id = $1
+	`, projectID[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	list, err := scanProjectDeletions(rows)
+	if err != nil || len(list) == 0 {
+		return nil, Error.Wrap(err)
+	}
+	return &list[0], nil
+}
+
+// Delete removes the deletion of the project, which restores the project.
+func (deletions *projectDeletions) Delete(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = deletions.exec(ctx, `
+		DELETE FROM project_deletions
+		WHERE project_id = $1
+	`, projectID[:])
+	return Error.Wrap(err)
+}
+
+// ListPurgeable returns up to limit deletions, whose grace period ended
+// before now, ordered by the project ID after cursor.
+func (deletions *projectDeletions) ListPurgeable(ctx context.Context, now time.Time, cursor uuid.UUID, limit int) (_ []console.ProjectDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := deletions.query(ctx, `
+		SELECT project_id, deleted_by, deleted_at, purge_after
+		FROM project_deletions
+		WHERE purge_after <= $1 AND project_id > $2
+		ORDER BY project_id
+		LIMIT $3
+	`, now, cursor[:], limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	list, err := scanProjectDeletions(rows)
+	return list, Error.Wrap(err)
+}
+
+func (deletions *projectDeletions) exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if deletions.tx != nil {
+		return deletions.tx.Tx.ExecContext(ctx, query, args...)
+	}
+	return deletions.db.ExecContext(ctx, query, args...)
+}
+
+func (deletions *projectDeletions) query(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	if deletions.tx != nil {
+		return deletions.tx.Tx.QueryContext(ctx, query, args...)
+	}
+	return deletions.db.QueryContext(ctx, query, args...)
+}
+
+func scanProjectDeletions(rows tagsql.Rows) (list []console.ProjectDeletion, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var deletion console.ProjectDeletion
+		var deletedBy uuid.NullUUID
+		err := rows.Scan(&deletion.ProjectID, &deletedBy, &deletion.DeletedAt, &deletion.PurgeAfter)
+		if err != nil {
+			return nil, err
+		}
+		deletion.DeletedBy = deletedBy.UUID
+		list = append(list, deletion)
+	}
+	return list, rows.Err()
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// RemediationQueueSelectLimit defines how many items can be selected at the same time.
+const RemediationQueueSelectLimit = 1000
+
+// remediationQueue implements storj.io/storj/satellite/repair/queue.RemediationQueue.
+type remediationQueue struct {
+	db *satelliteDB
+}
+
+func (r *remediationQueue) Insert(ctx context.Context, seg *queue.ViolatingSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO remediation_queue
+		(
+			stream_id, position, violation, violating_pieces, placement
+		)
+		VALUES (
+			$1, $2, $3, $4, $5
+		)
+		ON CONFLICT (stream_id, position, violation)
+		DO UPDATE
+		SET violating_pieces=$4, placement=$5, updated_at=current_timestamp
+	`, seg.StreamID, seg.Position.Encode(), string(seg.Violation), seg.ViolatingPieces, int(seg.Placement))
+	return Error.Wrap(err)
+}
+
+func (r *remediationQueue) SelectN(ctx context.Context, limit int) (segs []queue.ViolatingSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if limit <= 0 || limit > RemediationQueueSelectLimit {
+		limit = RemediationQueueSelectLimit
+	}
+
+	rows, err := r.db.QueryContext(ctx, r.db.Rebind(`
+		SELECT stream_id, position, violation, violating_pieces, placement, updated_at, inserted_at
+		FROM remediation_queue
+		ORDER BY inserted_at
+		LIMIT ?`), limit,
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var seg queue.ViolatingSegment
+		err = rows.Scan(&seg.StreamID, &seg.Position, &seg.Violation, &seg.ViolatingPieces,
+			&seg.Placement, &seg.UpdatedAt, &seg.InsertedAt)
+		if err != nil {
+			return segs, Error.Wrap(err)
+		}
+		segs = append(segs, seg)
+	}
+
+	return segs, Error.Wrap(rows.Err())
+}
+
+func (r *remediationQueue) Delete(ctx context.Context, seg *queue.ViolatingSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	_, err = r.db.ExecContext(ctx, r.db.Rebind(`DELETE FROM remediation_queue WHERE stream_id = ? AND position = ? AND violation = ?`),
+		seg.StreamID, seg.Position.Encode(), string(seg.Violation))
+	return Error.Wrap(err)
+}
+
+func (r *remediationQueue) Clean(ctx context.Context, before time.Time) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	res, err := r.db.ExecContext(ctx, r.db.Rebind(`DELETE FROM remediation_queue WHERE updated_at < ?`), before)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	deleted, err = res.RowsAffected()
+	return deleted, Error.Wrap(err)
+}
+
+func (r *remediationQueue) Count(ctx context.Context) (_ map[queue.Violation]int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := r.db.QueryContext(ctx, `SELECT violation, COUNT(*) FROM remediation_queue GROUP BY violation`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	counts := map[queue.Violation]int{}
+	for rows.Next() {
+		var violation queue.Violation
+		var count int
+		if err := rows.Scan(&violation, &count); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		counts[violation] = count
+	}
+
+	return counts, Error.Wrap(rows.Err())
+}
@@ -41,14 +41,14 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 		query = `
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health
+				stream_id, position, segment_health, placement
 			)
 			VALUES (
-				$1, $2, $3
+				$1, $2, $3, $4
 			)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=$3, updated_at=current_timestamp
+			SET segment_health=$3, placement=$4, updated_at=current_timestamp
 			RETURNING (xmax != 0) AS alreadyInserted
 		`
 	case dbutil.Cockroach:
@@ -60,18 +60,18 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 			)
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health
+				stream_id, position, segment_health, placement
 			)
 			VALUES (
-				$1, $2, $3
+				$1, $2, $3, $4
 			)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=$3, updated_at=current_timestamp
+			SET segment_health=$3, placement=$4, updated_at=current_timestamp
 			RETURNING (SELECT alreadyInserted FROM inserted)
 		`
 	}
-	rows, err := r.db.QueryContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth)
+	rows, err := r.db.QueryContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth, int(seg.Placement))
 	if err != nil {
 		return false, err
 	}
@@ -109,16 +109,17 @@ func (r *repairQueue) InsertBatch(
 		query = `
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health
+				stream_id, position, segment_health, placement
 			)
 			VALUES (
 				UNNEST($1::BYTEA[]),
 				UNNEST($2::INT8[]),
-				UNNEST($3::double precision[])
+				UNNEST($3::double precision[]),
+				UNNEST($4::INT4[])
 			)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=EXCLUDED.segment_health, updated_at=current_timestamp
+			SET segment_health=EXCLUDED.segment_health, placement=EXCLUDED.placement, updated_at=current_timestamp
 			RETURNING NOT(xmax != 0) AS newlyInserted
 		`
 	case dbutil.Cockroach:
@@ -128,18 +129,20 @@ func (r *repairQueue) InsertBatch(
 				SELECT
 					UNNEST($1::BYTEA[]) AS stream_id,
 					UNNEST($2::INT8[]) AS position,
-					UNNEST($3::double precision[]) AS segment_health
+					UNNEST($3::double precision[]) AS segment_health,
+					UNNEST($4::INT4[]) AS placement
 			),
 			do_insert AS (
 				INSERT INTO repair_queue (
-					stream_id, position, segment_health
+					stream_id, position, segment_health, placement
 				)
-				SELECT stream_id, position, segment_health
+				SELECT stream_id, position, segment_health, placement
 				FROM to_insert
 				ON CONFLICT (stream_id, position)
 				DO UPDATE
 				SET
 					segment_health=EXCLUDED.segment_health,
+					placement=EXCLUDED.placement,
 					updated_at=current_timestamp
 				RETURNING false
 			)
@@ -156,12 +159,14 @@ func (r *repairQueue) InsertBatch(
 		StreamIDs      []uuid.UUID
 		Positions      []int64
 		SegmentHealths []float64
+		Placements     []int32
 	}
 
 	for _, segment := range segments {
 		insertData.StreamIDs = append(insertData.StreamIDs, segment.StreamID)
 		insertData.Positions = append(insertData.Positions, int64(segment.Position.Encode()))
 		insertData.SegmentHealths = append(insertData.SegmentHealths, segment.SegmentHealth)
+		insertData.Placements = append(insertData.Placements, int32(segment.Placement))
 	}
 
 	rows, err := r.db.QueryContext(
@@ -169,6 +174,7 @@ func (r *repairQueue) InsertBatch(
 		pgutil.UUIDArray(insertData.StreamIDs),
 		pgutil.Int8Array(insertData.Positions),
 		pgutil.Float8Array(insertData.SegmentHealths),
+		pgutil.Int4Array(insertData.Placements),
 	)
 
 	if err != nil {
@@ -205,18 +211,18 @@ func (r *repairQueue) Select(ctx context.Context) (seg *queue.InjuredSegment, er
 				WHERE attempted_at IS NULL OR attempted_at < now() - interval '6 hours'
 				ORDER BY segment_health ASC, attempted_at NULLS FIRST
 				LIMIT 1
-				RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health
+				RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health, COALESCE(placement, 0)
 		`).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
-			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth)
+			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth, &segment.Placement)
 	case dbutil.Postgres:
 		err = r.db.QueryRowContext(ctx, `
 				UPDATE repair_queue SET attempted_at = now() WHERE (stream_id, position) = (
 					SELECT stream_id, position FROM repair_queue
 					WHERE attempted_at IS NULL OR attempted_at < now() - interval '6 hours'
 					ORDER BY segment_health ASC, attempted_at NULLS FIRST FOR UPDATE SKIP LOCKED LIMIT 1
-				) RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health
+				) RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health, COALESCE(placement, 0)
 		`).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
-			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth)
+			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth, &segment.Placement)
 	default:
 		return seg, errs.New("unhandled database: %v", r.db.impl)
 	}
@@ -248,7 +254,7 @@ func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []queue.Inju
 	}
 	// TODO: strictly enforce order-by or change tests
 	rows, err := r.db.QueryContext(ctx,
-		r.db.Rebind(`SELECT stream_id, position, attempted_at, updated_at, segment_health
+		r.db.Rebind(`SELECT stream_id, position, attempted_at, updated_at, segment_health, COALESCE(placement, 0)
 					FROM repair_queue LIMIT ?`), limit,
 	)
 	if err != nil {
@@ -259,7 +265,7 @@ func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []queue.Inju
 	for rows.Next() {
 		var seg queue.InjuredSegment
 		err = rows.Scan(&seg.StreamID, &seg.Position, &seg.AttemptedAt,
-			&seg.UpdatedAt, &seg.SegmentHealth)
+			&seg.UpdatedAt, &seg.SegmentHealth, &seg.Placement)
 		if err != nil {
 			return segs, Error.Wrap(err)
 		}
@@ -278,6 +284,17 @@ func (r *repairQueue) Count(ctx context.Context) (count int, err error) {
 	return count, Error.Wrap(err)
 }
 
+// Stat returns the statistics of the repair queue.
+func (r *repairQueue) Stat(ctx context.Context) (stat queue.Stat, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = r.db.QueryRowContext(ctx, r.db.Rebind(`
+		SELECT COUNT(*), MIN(inserted_at) FROM repair_queue
+	`)).Scan(&stat.Count, &stat.OldestInsertedAt)
+
+	return stat, Error.Wrap(err)
+}
+
 // TestingSetAttemptedTime sets attempted time for a segment.
 func (r *repairQueue) TestingSetAttemptedTime(ctx context.Context, streamID uuid.UUID,
 	position metabase.SegmentPosition, t time.Time) (rowsAffected int64, err error) {
@@ -15,6 +15,7 @@ import (
 
 	"storj.io/common/pb"
 	"storj.io/common/storj"
+	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/satellitedb/dbx"
@@ -103,6 +104,8 @@ func (reputations *reputations) ApplyUpdates(ctx context.Context, nodeID storj.N
 				return nil, Error.Wrap(err)
 			}
 
+			reputations.recordNodeEvents(ctx, nodeID, nodeEventsOf(&newNode, stats, reputationConfig, now))
+
 			status, err := dbxToReputationInfo(stats)
 			if err != nil {
 				return nil, Error.Wrap(err)
@@ -125,6 +128,7 @@ func (reputations *reputations) ApplyUpdates(ctx context.Context, nodeID storj.N
 			update := reputations.populateUpdateNodeStats(dbNode, updates, reputationConfig, auditHistoryResponse, now)
 
 			updateFields := reputations.populateUpdateFields(update, auditHistoryResponse.History)
+			oldNode := dbNode
 			oldAuditHistory := dbx.Reputation_AuditHistory(dbNode.AuditHistory)
 			dbNode, err = reputations.db.Update_Reputation_By_Id_And_AuditHistory(ctx, dbx.Reputation_Id(nodeID.Bytes()), oldAuditHistory, updateFields)
 			if err != nil && !errors.Is(err, sql.ErrNoRows) {
@@ -137,6 +141,8 @@ func (reputations *reputations) ApplyUpdates(ctx context.Context, nodeID storj.N
 				mon.Event("reputations_update_query_retry_update")
 				continue
 			}
+
+			reputations.recordNodeEvents(ctx, nodeID, nodeEventsOf(oldNode, dbNode, reputationConfig, now))
 		}
 
 		status, err := dbxToReputationInfo(dbNode)
@@ -172,6 +178,7 @@ func (reputations *reputations) Get(ctx context.Context, nodeID storj.NodeID) (*
 		UnknownAuditSuspended:       res.UnknownAuditSuspended,
 		OfflineSuspended:            res.OfflineSuspended,
 		UnderReview:                 res.UnderReview,
+		AuditWarned:                 res.AuditWarned,
 		Disqualified:                res.Disqualified,
 		DisqualificationReason:      dqReason,
 		OnlineScore:                 res.OnlineScore,
@@ -354,6 +361,13 @@ func (reputations *reputations) populateCreateFields(update updateNodeStats) dbx
 			createFields.UnderReview = dbx.Reputation_UnderReview(update.OfflineUnderReview.value)
 		}
 	}
+	if update.AuditWarned.set {
+		if update.AuditWarned.isNil {
+			createFields.AuditWarned = dbx.Reputation_AuditWarned_Null()
+		} else {
+			createFields.AuditWarned = dbx.Reputation_AuditWarned(update.AuditWarned.value)
+		}
+	}
 
 	return createFields
 }
@@ -414,6 +428,13 @@ func (reputations *reputations) populateUpdateFields(update updateNodeStats, his
 			updateFields.UnderReview = dbx.Reputation_UnderReview(update.OfflineUnderReview.value)
 		}
 	}
+	if update.AuditWarned.set {
+		if update.AuditWarned.isNil {
+			updateFields.AuditWarned = dbx.Reputation_AuditWarned_Null()
+		} else {
+			updateFields.AuditWarned = dbx.Reputation_AuditWarned(update.AuditWarned.value)
+		}
+	}
 
 	return updateFields
 }
@@ -516,12 +537,27 @@ func (reputations *reputations) populateUpdateNodeStats(dbNode *dbx.Reputation,
 
 	// disqualification case a
 	//   a) Success/fail audit reputation falls below audit DQ threshold
+	//        AND the node has been warned for longer than the audit DQ grace period
 	auditRep := auditAlpha / (auditAlpha + auditBeta)
 	if auditRep <= config.AuditDQ {
-		logger.Info("Disqualified", zap.String("DQ type", "audit failure"))
-		mon.Meter("bad_audit_dqs").Mark(1) //mon:locked
-		updateFields.Disqualified = timeField{set: true, value: now}
-		updateFields.DisqualificationReason = intField{set: true, value: int(overlay.DisqualificationReasonAuditFailure)}
+		switch {
+		case config.AuditDQGracePeriod <= 0 || dbNode.Disqualified != nil ||
+			(dbNode.AuditWarned != nil && now.Sub(*dbNode.AuditWarned) > config.AuditDQGracePeriod):
+			logger.Info("Disqualified", zap.String("DQ type", "audit failure"))
+			mon.Meter("bad_audit_dqs").Mark(1) //mon:locked
+			updateFields.Disqualified = timeField{set: true, value: now}
+			updateFields.DisqualificationReason = intField{set: true, value: int(overlay.DisqualificationReasonAuditFailure)}
+			if dbNode.AuditWarned != nil {
+				updateFields.AuditWarned = timeField{set: true, isNil: true}
+			}
+		case dbNode.AuditWarned == nil:
+			logger.Info("Warned", zap.String("Category", "Audits"))
+			mon.Meter("bad_audit_warnings").Mark(1)
+			updateFields.AuditWarned = timeField{set: true, value: now}
+		}
+	} else if dbNode.AuditWarned != nil {
+		logger.Info("Warning lifted", zap.String("Category", "Audits"))
+		updateFields.AuditWarned = timeField{set: true, isNil: true}
 	}
 
 	// if unknown audit rep goes below threshold, suspend node. Otherwise unsuspend node.
@@ -654,6 +690,7 @@ type updateNodeStats struct {
 	OfflineUnderReview          timeField
 	OfflineSuspended            timeField
 	OnlineScore                 float64Field
+	AuditWarned                 timeField
 }
 
 func dbxToReputationInfo(dbNode *dbx.Reputation) (reputation.Info, error) {
@@ -664,6 +701,7 @@ func dbxToReputationInfo(dbNode *dbx.Reputation) (reputation.Info, error) {
 		UnknownAuditSuspended:       dbNode.UnknownAuditSuspended,
 		OfflineSuspended:            dbNode.OfflineSuspended,
 		UnderReview:                 dbNode.UnderReview,
+		AuditWarned:                 dbNode.AuditWarned,
 		Disqualified:                dbNode.Disqualified,
 		OnlineScore:                 dbNode.OnlineScore,
 		AuditReputationAlpha:        dbNode.AuditReputationAlpha,
@@ -683,6 +721,67 @@ func dbxToReputationInfo(dbNode *dbx.Reputation) (reputation.Info, error) {
 	return info, nil
 }
 
+// recordNodeEvents records the node events, which the node operator is
+// notified about. Failures are only logged, because the reputation has
+// already been updated.
+func (reputations *reputations) recordNodeEvents(ctx context.Context, nodeID storj.NodeID, events []nodeevents.Type) {
+	if len(events) == 0 {
+		return
+	}
+
+	logger := reputations.db.log.With(zap.Stringer("Node ID", nodeID))
+
+	var email string
+	err := reputations.db.QueryRowContext(ctx, `SELECT email FROM nodes WHERE id = $1`, nodeID).Scan(&email)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		logger.Warn("failed to get node email", zap.Error(err))
+	}
+
+	db := &nodeEvents{db: reputations.db}
+	for _, event := range events {
+		if _, err := db.Insert(ctx, email, nodeID, event); err != nil {
+			logger.Warn("failed to record node event", zap.Stringer("Event", event), zap.Error(err))
+		}
+	}
+}
+
+// nodeEventsOf returns the node events of the changes between the stored and
+// the updated reputation of a node.
+func nodeEventsOf(before, after *dbx.Reputation, config reputation.Config, now time.Time) []nodeevents.Type {
+	switch {
+	case before.Disqualified == nil && after.Disqualified != nil:
+		return []nodeevents.Type{nodeevents.Disqualified}
+	case before.AuditWarned == nil && after.AuditWarned != nil:
+		return []nodeevents.Type{nodeevents.AuditWarning}
+	case before.AuditWarned != nil && after.AuditWarned == nil:
+		return []nodeevents.Type{nodeevents.AuditWarningLifted}
+	case before.AuditWarned != nil && after.AuditWarned != nil:
+		previous := auditWarningStage(before.UpdatedAt.Sub(*before.AuditWarned), config.AuditDQGracePeriod)
+		switch current := auditWarningStage(now.Sub(*after.AuditWarned), config.AuditDQGracePeriod); {
+		case current <= previous:
+		case current == nodeevents.AuditWarningFinal:
+			return []nodeevents.Type{nodeevents.AuditWarningFinal}
+		case current == nodeevents.AuditWarningReminder:
+			return []nodeevents.Type{nodeevents.AuditWarningReminder}
+		}
+	}
+	return nil
+}
+
+// auditWarningStage returns the escalation of an audit warning, which has
+// lasted for the given duration: the operator is reminded after half of the
+// grace period and warned for the last time after three quarters of it.
+func auditWarningStage(warnedFor, gracePeriod time.Duration) nodeevents.Type {
+	switch {
+	case warnedFor >= gracePeriod*3/4:
+		return nodeevents.AuditWarningFinal
+	case warnedFor >= gracePeriod/2:
+		return nodeevents.AuditWarningReminder
+	default:
+		return nodeevents.AuditWarning
+	}
+}
+
 type zapNodeIDBytes []byte
 
 func (z zapNodeIDBytes) String() string {
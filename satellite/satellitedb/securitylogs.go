@@ -0,0 +1,156 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that securityLogs implements console.SecurityLogs.
+var _ console.SecurityLogs = (*securityLogs)(nil)
+
+// securityLogs is an implementation of console.SecurityLogs.
+type securityLogs struct {
+	db *satelliteDB
+	tx *dbx.Tx
+}
+
+// Insert records a security log event.
+func (logs *securityLogs) Insert(ctx context.Context, event *console.SecurityLogEvent) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	createdAt := event.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	_, err = logs.exec(ctx, `
+		INSERT INTO project_security_logs (id, project_id, api_key_id, api_key_name, remote_address, operation, bucket_name, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, id[:], event.ProjectID[:], event.APIKeyID[:], event.APIKeyName, event.RemoteAddress,
+		string(event.Operation), []byte(event.BucketName), createdAt)
+	return Error.Wrap(err)
+}
+
+// GetPagedByProjectID returns the security log events of the project, most recent first.
+func (logs *securityLogs) GetPagedByProjectID(ctx context.Context, projectID uuid.UUID, cursor console.SecurityLogCursor) (_ *console.SecurityLogPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cursor.Limit > 50 {
+		cursor.Limit = 50
+	}
+	if cursor.Limit == 0 {
+		return nil, errs.New("limit cannot be 0")
+	}
+	if cursor.Page == 0 {
+		return nil, errs.New("page cannot be 0")
+	}
+
+	page := &console.SecurityLogPage{
+		Limit:  cursor.Limit,
+		Offset: uint64((cursor.Page - 1) * cursor.Limit),
+	}
+
+	rows, err := logs.query(ctx, `
+		SELECT COUNT(*) FROM project_security_logs WHERE project_id = $1
+	`, projectID[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	err = scanProjectActivityCount(rows, &page.TotalCount)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if page.TotalCount == 0 {
+		return page, nil
+	}
+	if page.Offset > page.TotalCount-1 {
+		return nil, errs.New("page is out of range")
+	}
+
+	rows, err = logs.query(ctx, `
+		SELECT id, project_id, api_key_id, api_key_name, remote_address, operation, bucket_name, created_at
+		FROM project_security_logs
+		WHERE project_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2 OFFSET $3
+	`, projectID[:], page.Limit, page.Offset)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	page.Events, err = scanSecurityLogEvents(rows)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	page.PageCount = uint(page.TotalCount / uint64(cursor.Limit))
+	if page.TotalCount%uint64(cursor.Limit) != 0 {
+		page.PageCount++
+	}
+	page.CurrentPage = cursor.Page
+
+	return page, nil
+}
+
+// DeleteBefore deletes the security log events created before the given time.
+func (logs *securityLogs) DeleteBefore(ctx context.Context, before time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := logs.exec(ctx, `
+		DELETE FROM project_security_logs WHERE created_at < $1
+	`, before)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	deleted, err := result.RowsAffected()
+	return deleted, Error.Wrap(err)
+}
+
+func (logs *securityLogs) exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if logs.tx != nil {
+		return logs.tx.Tx.ExecContext(ctx, query, args...)
+	}
+	return logs.db.ExecContext(ctx, query, args...)
+}
+
+func (logs *securityLogs) query(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	if logs.tx != nil {
+		return logs.tx.Tx.QueryContext(ctx, query, args...)
+	}
+	return logs.db.QueryContext(ctx, query, args...)
+}
+
+func scanSecurityLogEvents(rows tagsql.Rows) (list []console.SecurityLogEvent, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var event console.SecurityLogEvent
+		var operation string
+		var bucketName []byte
+		err := rows.Scan(&event.ID, &event.ProjectID, &event.APIKeyID, &event.APIKeyName,
+			&event.RemoteAddress, &operation, &bucketName, &event.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		event.Operation = console.SecurityLogOperation(operation)
+		event.BucketName = string(bucketName)
+		list = append(list, event)
+	}
+	return list, rows.Err()
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"storj.io/storj/satellite/segmentstats"
+)
+
+var _ segmentstats.DB = (*segmentStatistics)(nil)
+
+type segmentStatistics struct {
+	db *satelliteDB
+}
+
+// Insert stores the report.
+func (ss *segmentStatistics) Insert(ctx context.Context, report segmentstats.Report) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = ss.db.ExecContext(ctx, `
+		INSERT INTO segment_statistics_reports (created_at, report)
+		VALUES ($1, $2)
+	`, report.CreatedAt, data)
+	return Error.Wrap(err)
+}
+
+// GetLatest returns the most recent report.
+func (ss *segmentStatistics) GetLatest(ctx context.Context) (_ segmentstats.Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var data []byte
+	err = ss.db.QueryRowContext(ctx, `
+		SELECT report FROM segment_statistics_reports
+		ORDER BY created_at DESC
+		LIMIT 1
+	`).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return segmentstats.Report{}, segmentstats.ErrReportNotFound.New("")
+	}
+	if err != nil {
+		return segmentstats.Report{}, Error.Wrap(err)
+	}
+
+	var report segmentstats.Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return segmentstats.Report{}, Error.Wrap(err)
+	}
+	return report, nil
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that signupReviews implements console.SignupReviews.
+var _ console.SignupReviews = (*signupReviews)(nil)
+
+// signupReviews is an implementation of console.SignupReviews.
+type signupReviews struct {
+	db *satelliteDB
+	tx *dbx.Tx
+}
+
+// Insert adds the signup of a user to the review queue.
+func (reviews *signupReviews) Insert(ctx context.Context, review console.SignupReview) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `
+		INSERT INTO signup_reviews (user_id, reason, created_at)
+		VALUES ($1, $2, $3)
+	`
+	args := []interface{}{review.UserID[:], review.Reason, review.CreatedAt}
+
+	if reviews.tx != nil {
+		_, err = reviews.tx.Tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = reviews.db.ExecContext(ctx, query, args...)
+	}
+	return Error.Wrap(err)
+}
+
+// Get returns the review of the signup of the user.
+func (reviews *signupReviews) Get(ctx context.Context, userID uuid.UUID) (_ *console.SignupReview, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := reviews.query(ctx, `
+		SELECT signup_reviews.user_id, users.email, signup_reviews.reason,
+			signup_reviews.created_at, signup_reviews.reviewed_at, signup_reviews.approved
+		FROM signup_reviews
+		LEFT JOIN users ON users.id = signup_reviews.user_id
+		WHERE signup_reviews.user_id = $1
+	`, userID[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	list, err := scanSignupReviews(rows)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(list) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &list[0], nil
+}
+
+// ListPending returns the oldest signups which weren't reviewed yet.
+func (reviews *signupReviews) ListPending(ctx context.Context, limit int) (_ []console.SignupReview, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := reviews.query(ctx, `
+		SELECT signup_reviews.user_id, users.email, signup_reviews.reason,
+			signup_reviews.created_at, signup_reviews.reviewed_at, signup_reviews.approved
+		FROM signup_reviews
+		LEFT JOIN users ON users.id = signup_reviews.user_id
+		WHERE signup_reviews.reviewed_at IS NULL
+		ORDER BY signup_reviews.created_at ASC
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	list, err := scanSignupReviews(rows)
+	return list, Error.Wrap(err)
+}
+
+// Review marks the signup of the user as reviewed.
+func (reviews *signupReviews) Review(ctx context.Context, userID uuid.UUID, approved bool, reviewedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `
+		UPDATE signup_reviews
+		SET reviewed_at = $2, approved = $3
+		WHERE user_id = $1
+	`
+	args := []interface{}{userID[:], reviewedAt, approved}
+
+	var result sql.Result
+	if reviews.tx != nil {
+		result, err = reviews.tx.Tx.ExecContext(ctx, query, args...)
+	} else {
+		result, err = reviews.db.ExecContext(ctx, query, args...)
+	}
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (reviews *signupReviews) query(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	if reviews.tx != nil {
+		return reviews.tx.Tx.QueryContext(ctx, query, args...)
+	}
+	return reviews.db.QueryContext(ctx, query, args...)
+}
+
+func scanSignupReviews(rows tagsql.Rows) (list []console.SignupReview, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var review console.SignupReview
+		var email sql.NullString
+		err := rows.Scan(&review.UserID, &email, &review.Reason,
+			&review.CreatedAt, &review.ReviewedAt, &review.Approved)
+		if err != nil {
+			return nil, err
+		}
+		review.Email = email.String
+		list = append(list, review)
+	}
+	return list, rows.Err()
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that usageAnomalySettings implements console.UsageAnomalySettings.
+var _ console.UsageAnomalySettings = (*usageAnomalySettings)(nil)
+
+// usageAnomalySettings is an implementation of console.UsageAnomalySettings.
+type usageAnomalySettings struct {
+	db *satelliteDB
+	tx *dbx.Tx
+}
+
+// Get returns the settings of the project. The default settings are
+// returned when they haven't been changed for the project.
+func (settings *usageAnomalySettings) Get(ctx context.Context, projectID uuid.UUID) (_ console.UsageAnomalySetting, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := settings.query(ctx, `
+		SELECT sensitivity, last_notified_at
+		FROM project_usage_anomaly_settings
+		WHERE project_id = $1
+	`, projectID[:])
+	if err != nil {
+		return console.UsageAnomalySetting{}, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(rows.Close())) }()
+
+	setting := console.UsageAnomalySetting{
+		ProjectID:   projectID,
+		Sensitivity: console.DefaultUsageAnomalySensitivity,
+	}
+	if rows.Next() {
+		var sensitivity string
+		var lastNotifiedAt *time.Time
+		if err := rows.Scan(&sensitivity, &lastNotifiedAt); err != nil {
+			return console.UsageAnomalySetting{}, Error.Wrap(err)
+		}
+		setting.Sensitivity = console.UsageAnomalySensitivity(sensitivity)
+		setting.LastNotifiedAt = lastNotifiedAt
+	}
+	return setting, Error.Wrap(rows.Err())
+}
+
+// UpdateSensitivity replaces the sensitivity of the detection for the project.
+func (settings *usageAnomalySettings) UpdateSensitivity(ctx context.Context, projectID uuid.UUID, sensitivity console.UsageAnomalySensitivity) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = settings.exec(ctx, `
+		INSERT INTO project_usage_anomaly_settings (project_id, sensitivity, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (project_id) DO UPDATE SET
+			sensitivity = EXCLUDED.sensitivity,
+			updated_at  = EXCLUDED.updated_at
+	`, projectID[:], string(sensitivity), time.Now())
+	return Error.Wrap(err)
+}
+
+// UpdateLastNotified records when the owner of the project was last
+// notified about a usage anomaly.
+func (settings *usageAnomalySettings) UpdateLastNotified(ctx context.Context, projectID uuid.UUID, notifiedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = settings.exec(ctx, `
+		INSERT INTO project_usage_anomaly_settings (project_id, sensitivity, last_notified_at, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (project_id) DO UPDATE SET
+			last_notified_at = EXCLUDED.last_notified_at,
+			updated_at       = EXCLUDED.updated_at
+	`, projectID[:], string(console.DefaultUsageAnomalySensitivity), notifiedAt, time.Now())
+	return Error.Wrap(err)
+}
+
+func (settings *usageAnomalySettings) exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if settings.tx != nil {
+		return settings.tx.Tx.ExecContext(ctx, query, args...)
+	}
+	return settings.db.ExecContext(ctx, query, args...)
+}
+
+func (settings *usageAnomalySettings) query(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error) {
+	if settings.tx != nil {
+		return settings.tx.Tx.QueryContext(ctx, query, args...)
+	}
+	return settings.db.QueryContext(ctx, query, args...)
+}
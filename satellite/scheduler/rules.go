@@ -0,0 +1,174 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+// Rule restricts when a background task can run.
+type Rule struct {
+	// Task is the name of the task.
+	Task string
+	// Group is the mutual exclusion group of the task. Only a single task of
+	// the group can run at the same time. Empty means no group.
+	Group string
+	// Window is the time of the day when the task can start. Nil means any time.
+	Window *Window
+}
+
+// String formats the rule as task[/group][@HH:MM-HH:MM].
+func (rule Rule) String() string {
+	s := rule.Task
+	if rule.Group != "" {
+		s += "/" + rule.Group
+	}
+	if rule.Window != nil {
+		s += "@" + rule.Window.String()
+	}
+	return s
+}
+
+// ParseRule parses a rule in the task[/group][@HH:MM-HH:MM] format.
+func ParseRule(s string) (Rule, error) {
+	var rule Rule
+
+	s, window, hasWindow := cut(s, "@")
+	if hasWindow {
+		parsed, err := ParseWindow(window)
+		if err != nil {
+			return Rule{}, err
+		}
+		rule.Window = &parsed
+	}
+
+	rule.Task, rule.Group, _ = cut(s, "/")
+	if rule.Task == "" {
+		return Rule{}, Error.New("missing task name in rule %q", s)
+	}
+	return rule, nil
+}
+
+// Window is a daily time window in UTC. The window wraps around midnight when
+// its end is before its start.
+type Window struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// String formats the window as HH:MM-HH:MM.
+func (window Window) String() string {
+	return formatTimeOfDay(window.Start) + "-" + formatTimeOfDay(window.End)
+}
+
+// ParseWindow parses a window in the HH:MM-HH:MM format.
+func ParseWindow(s string) (Window, error) {
+	start, end, ok := cut(s, "-")
+	if !ok {
+		return Window{}, Error.New("invalid window %q", s)
+	}
+
+	var window Window
+	var err error
+	if window.Start, err = parseTimeOfDay(start); err != nil {
+		return Window{}, err
+	}
+	if window.End, err = parseTimeOfDay(end); err != nil {
+		return Window{}, err
+	}
+	if window.Start == window.End {
+		return Window{}, Error.New("empty window %q", s)
+	}
+	return window, nil
+}
+
+// Contains returns whether the time is inside of the window.
+func (window Window) Contains(t time.Time) bool {
+	return window.Until(t) == 0
+}
+
+// Until returns how long until the window opens, or zero when the time is
+// inside of the window.
+func (window Window) Until(t time.Time) time.Duration {
+	t = t.UTC()
+	sinceMidnight := t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+
+	if window.Start < window.End {
+		if window.Start <= sinceMidnight && sinceMidnight < window.End {
+			return 0
+		}
+	} else if sinceMidnight >= window.Start || sinceMidnight < window.End {
+		return 0
+	}
+
+	if sinceMidnight < window.Start {
+		return window.Start - sinceMidnight
+	}
+	return day - sinceMidnight + window.Start
+}
+
+// Rules is a list of rules, which implements pflag.Value.
+type Rules []Rule
+
+// String formats the rules as a comma separated list.
+func (rules Rules) String() string {
+	s := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		s = append(s, rule.String())
+	}
+	return strings.Join(s, ",")
+}
+
+// Set implements pflag.Value by parsing a comma separated list of rules.
+func (rules *Rules) Set(value string) error {
+	var toSet Rules
+	seen := map[string]bool{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		rule, err := ParseRule(entry)
+		if err != nil {
+			return err
+		}
+		if seen[rule.Task] {
+			return Error.New("duplicate rule for task %q", rule.Task)
+		}
+		seen[rule.Task] = true
+		toSet = append(toSet, rule)
+	}
+
+	*rules = toSet
+	return nil
+}
+
+// Type returns the type of the pflag.Value.
+func (rules Rules) Type() string {
+	return "scheduler-rules"
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, Error.New("invalid time of day %q: %w", s, err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func formatTimeOfDay(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int(d%time.Hour/time.Minute))
+}
+
+// cut is strings.Cut, which isn't available in the Go version we build with.
+func cut(s, sep string) (before, after string, found bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
@@ -0,0 +1,196 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/sync2"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the scheduler package.
+	Error = errs.Class("scheduler")
+)
+
+// Config contains configurable values for the background task scheduler.
+type Config struct {
+	Enabled       bool          `help:"whether the background tasks (tally, checker, audit) are coordinated by the scheduler, tasks are only coordinated within a single process" default:"false"`
+	Concurrency   int           `help:"how many background tasks can run at the same time, zero means no limit" default:"2"`
+	StartInterval time.Duration `help:"average interval between starting background tasks, zero means no limit" default:"0s"`
+	Rules         Rules         `help:"comma separated scheduling rules of the background tasks in the task[/group][@HH:MM-HH:MM] format, e.g. tally/db,checker/db@01:00-05:00" default:""`
+}
+
+// Scheduler coordinates the background tasks of the satellite, so that they
+// don't start at the same time and overload the database.
+//
+// Before each iteration a task acquires the permission to run from the
+// scheduler, which waits until the time window of the task is open, no other
+// task of its mutual exclusion group is running, and a token of the
+// concurrency budget is available.
+//
+// The scheduler only coordinates the tasks of its own process. Garbage
+// collection runs in a separate peer with its own scheduler, so it can't share
+// a group or the concurrency budget with the core tasks, only its time window
+// applies.
+//
+// architecture: Service
+type Scheduler struct {
+	log   *zap.Logger
+	rules map[string]Rule
+
+	// tokens is the concurrency budget, nil when there's no limit.
+	tokens chan struct{}
+	// starts limits the rate of starting tasks, nil when there's no limit.
+	starts *rate.Limiter
+
+	nowFn func() time.Time
+
+	mu sync.Mutex
+	// running contains the task running in each mutual exclusion group.
+	running map[string]string
+	// released is closed when a mutual exclusion group is released.
+	released chan struct{}
+}
+
+// New creates a new scheduler. It returns nil when the scheduler is disabled,
+// which lets all the tasks run as soon as they want.
+func New(log *zap.Logger, config Config) *Scheduler {
+	if !config.Enabled {
+		return nil
+	}
+
+	scheduler := &Scheduler{
+		log:      log,
+		rules:    make(map[string]Rule, len(config.Rules)),
+		nowFn:    time.Now,
+		running:  map[string]string{},
+		released: make(chan struct{}),
+	}
+	for _, rule := range config.Rules {
+		scheduler.rules[rule.Task] = rule
+	}
+	if config.Concurrency > 0 {
+		scheduler.tokens = make(chan struct{}, config.Concurrency)
+	}
+	if config.StartInterval > 0 {
+		burst := config.Concurrency
+		if burst <= 0 {
+			burst = 1
+		}
+		scheduler.starts = rate.NewLimiter(rate.Every(config.StartInterval), burst)
+	}
+	return scheduler
+}
+
+// Acquire waits until the task is allowed to run. The returned release
+// function must be called when the task finishes its iteration.
+//
+// Acquire on a nil scheduler returns immediately.
+func (scheduler *Scheduler) Acquire(ctx context.Context, task string) (release func(), err error) {
+	if scheduler == nil {
+		return func() {}, nil
+	}
+	defer mon.Task()(&ctx, task)(&err)
+
+	waitStart := time.Now()
+	rule := scheduler.rules[task]
+
+	if rule.Window != nil {
+		if until := rule.Window.Until(scheduler.nowFn()); until > 0 {
+			scheduler.log.Debug("waiting for the time window of the task",
+				zap.String("Task", task), zap.Stringer("Window", rule.Window), zap.Duration("Wait", until))
+			if !sync2.Sleep(ctx, until) {
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	if scheduler.starts != nil {
+		if err := scheduler.starts.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := scheduler.lockGroup(ctx, task, rule.Group); err != nil {
+		return nil, err
+	}
+
+	if scheduler.tokens != nil {
+		select {
+		case scheduler.tokens <- struct{}{}:
+		case <-ctx.Done():
+			scheduler.unlockGroup(rule.Group)
+			return nil, ctx.Err()
+		}
+	}
+
+	mon.DurationVal("scheduler_wait", monkit.NewSeriesTag("task", task)).Observe(time.Since(waitStart))
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			if scheduler.tokens != nil {
+				<-scheduler.tokens
+			}
+			scheduler.unlockGroup(rule.Group)
+		})
+	}, nil
+}
+
+// lockGroup waits until no other task of the group is running.
+func (scheduler *Scheduler) lockGroup(ctx context.Context, task, group string) error {
+	if group == "" {
+		return nil
+	}
+
+	for {
+		scheduler.mu.Lock()
+		running, ok := scheduler.running[group]
+		if !ok {
+			scheduler.running[group] = task
+			scheduler.mu.Unlock()
+			return nil
+		}
+		released := scheduler.released
+		scheduler.mu.Unlock()
+
+		scheduler.log.Debug("waiting for another task of the group",
+			zap.String("Task", task), zap.String("Group", group), zap.String("Running", running))
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// unlockGroup lets the next task of the group run.
+func (scheduler *Scheduler) unlockGroup(group string) {
+	if group == "" {
+		return
+	}
+
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	delete(scheduler.running, group)
+	close(scheduler.released)
+	scheduler.released = make(chan struct{})
+}
+
+// SetNow allows tests to have the scheduler act as if the current time is whatever they want.
+func (scheduler *Scheduler) SetNow(nowFn func() time.Time) {
+	scheduler.nowFn = nowFn
+}
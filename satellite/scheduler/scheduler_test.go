@@ -0,0 +1,152 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/scheduler"
+)
+
+func TestRules(t *testing.T) {
+	var rules scheduler.Rules
+	require.NoError(t, rules.Set("tally/db, checker ,audit/db@22:00-02:30,gc@01:00-05:00"))
+	require.Equal(t, scheduler.Rules{
+		{Task: "tally", Group: "db"},
+		{Task: "checker"},
+		{Task: "audit", Group: "db", Window: &scheduler.Window{Start: 22 * time.Hour, End: 2*time.Hour + 30*time.Minute}},
+		{Task: "gc", Window: &scheduler.Window{Start: time.Hour, End: 5 * time.Hour}},
+	}, rules)
+	require.Equal(t, "tally/db,checker,audit/db@22:00-02:30,gc@01:00-05:00", rules.String())
+
+	require.NoError(t, rules.Set(""))
+	require.Empty(t, rules)
+
+	for _, invalid := range []string{
+		"/db",
+		"tally,tally/db",
+		"gc@01:00",
+		"gc@01:00-01:00",
+		"gc@25:00-01:00",
+	} {
+		require.Error(t, rules.Set(invalid), invalid)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2022, 8, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	window := scheduler.Window{Start: time.Hour, End: 5 * time.Hour}
+	require.Equal(t, time.Duration(0), window.Until(at(1, 0)))
+	require.Equal(t, time.Duration(0), window.Until(at(4, 59)))
+	require.Equal(t, 30*time.Minute, window.Until(at(0, 30)))
+	require.Equal(t, 20*time.Hour, window.Until(at(5, 0)))
+
+	wrapping := scheduler.Window{Start: 22 * time.Hour, End: 2 * time.Hour}
+	require.True(t, wrapping.Contains(at(23, 0)))
+	require.True(t, wrapping.Contains(at(1, 0)))
+	require.False(t, wrapping.Contains(at(2, 0)))
+	require.Equal(t, 2*time.Hour, wrapping.Until(at(20, 0)))
+}
+
+func TestSchedulerDisabled(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	s := scheduler.New(zaptest.NewLogger(t), scheduler.Config{Enabled: false})
+	require.Nil(t, s)
+
+	release, err := s.Acquire(ctx, "tally")
+	require.NoError(t, err)
+	release()
+}
+
+func TestSchedulerGroup(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var rules scheduler.Rules
+	require.NoError(t, rules.Set("tally/db,audit/db"))
+	s := scheduler.New(zaptest.NewLogger(t), scheduler.Config{Enabled: true, Rules: rules})
+
+	releaseTally, err := s.Acquire(ctx, "tally")
+	require.NoError(t, err)
+
+	// tasks outside of the group are not blocked.
+	releaseChecker, err := s.Acquire(ctx, "checker")
+	require.NoError(t, err)
+	releaseChecker()
+
+	acquired := make(chan struct{})
+	ctx.Go(func() error {
+		releaseAudit, err := s.Acquire(ctx, "audit")
+		if err != nil {
+			return err
+		}
+		close(acquired)
+		releaseAudit()
+		return nil
+	})
+
+	select {
+	case <-acquired:
+		t.Fatal("audit acquired while tally is running")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	releaseTally()
+	// releasing twice doesn't release the group of another task.
+	releaseTally()
+
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("audit didn't acquire after tally finished")
+	}
+}
+
+func TestSchedulerConcurrency(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	s := scheduler.New(zaptest.NewLogger(t), scheduler.Config{Enabled: true, Concurrency: 1})
+
+	release, err := s.Acquire(ctx, "tally")
+	require.NoError(t, err)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = s.Acquire(timeoutCtx, "checker")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	release()
+
+	release, err = s.Acquire(ctx, "checker")
+	require.NoError(t, err)
+	release()
+}
+
+func TestSchedulerWindow(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var rules scheduler.Rules
+	require.NoError(t, rules.Set("gc@01:00-05:00"))
+	s := scheduler.New(zaptest.NewLogger(t), scheduler.Config{Enabled: true, Rules: rules})
+
+	s.SetNow(func() time.Time { return time.Date(2022, 8, 1, 2, 0, 0, 0, time.UTC) })
+	release, err := s.Acquire(ctx, "gc")
+	require.NoError(t, err)
+	release()
+
+	s.SetNow(func() time.Time { return time.Date(2022, 8, 1, 6, 0, 0, 0, time.UTC) })
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = s.Acquire(timeoutCtx, "gc")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
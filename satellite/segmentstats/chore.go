@@ -0,0 +1,107 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package segmentstats computes the distribution of the segments, to guide the
+// tuning of the redundancy schemes.
+package segmentstats
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+var (
+	// Error defines the segment statistics errors class.
+	Error = errs.Class("segment statistics")
+	// ErrReportNotFound is returned when no report has been stored yet.
+	ErrReportNotFound = errs.Class("segment statistics report not found")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the segment statistics chore.
+type Config struct {
+	Enabled  bool          `help:"whether to compute the segment statistics" default:"false"`
+	Interval time.Duration `help:"how often to compute the segment statistics" releaseDefault:"24h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+}
+
+// Chore computes the segment statistics with the segment loop, publishes them
+// as metrics and stores them for the admin report.
+//
+// architecture: Chore
+type Chore struct {
+	log         *zap.Logger
+	db          DB
+	segmentLoop *segmentloop.Service
+	nowFn       func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new segment statistics chore.
+func NewChore(log *zap.Logger, db DB, segmentLoop *segmentloop.Service, config Config) *Chore {
+	return &Chore{
+		log:         log,
+		db:          db,
+		segmentLoop: segmentLoop,
+		nowFn:       time.Now,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the segment statistics chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+
+		collector := NewCollector()
+		if err := chore.segmentLoop.Join(ctx, collector); err != nil {
+			chore.log.Error("error joining segment loop", zap.Error(err))
+			return nil
+		}
+
+		report := collector.Report(chore.nowFn())
+		publish(report)
+
+		if err := chore.db.Insert(ctx, report); err != nil {
+			chore.log.Error("error storing segment statistics", zap.Error(Error.Wrap(err)))
+		}
+		return nil
+	})
+}
+
+// publish publishes the report as metrics.
+func publish(report Report) {
+	for _, bucket := range report.SegmentSizes {
+		mon.IntVal("segment_stats_size_segments",
+			monkit.NewSeriesTag("max_size", strconv.FormatInt(bucket.MaxSize, 10))).Observe(bucket.Segments)
+	}
+	for _, count := range report.PiecesPerSegment {
+		mon.IntVal("segment_stats_pieces_segments",
+			monkit.NewSeriesTag("pieces", strconv.Itoa(count.Pieces))).Observe(count.Segments)
+	}
+	for _, totals := range report.Placements {
+		placement := monkit.NewSeriesTag("placement", strconv.Itoa(int(totals.Placement)))
+		mon.IntVal("segment_stats_placement_segments", placement).Observe(totals.Segments)
+		mon.IntVal("segment_stats_placement_bytes", placement).Observe(totals.Bytes)
+	}
+	mon.IntVal("segment_stats_inline_segments").Observe(report.InlineSegments)
+	mon.IntVal("segment_stats_remote_segments").Observe(report.RemoteSegments)
+}
+
+// Close stops the segment statistics chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentstats
+
+import (
+	"context"
+	"math/bits"
+	"sort"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+var (
+	remoteSegmentFunc = mon.Func()
+	inlineSegmentFunc = mon.Func()
+)
+
+var _ segmentloop.Observer = (*Collector)(nil)
+
+// Collector implements the segment loop observer interface to compute the
+// distribution of the segments.
+//
+// architecture: Observer
+type Collector struct {
+	inlineSegments int64
+	inlineBytes    int64
+	remoteSegments int64
+	remoteBytes    int64
+
+	sizes      map[int64]int64
+	pieces     map[int]int64
+	placements map[storj.PlacementConstraint]*PlacementTotals
+}
+
+// NewCollector instantiates a new collector to be subscribed to the segment loop.
+func NewCollector() *Collector {
+	return &Collector{
+		sizes:      map[int64]int64{},
+		pieces:     map[int]int64{},
+		placements: map[storj.PlacementConstraint]*PlacementTotals{},
+	}
+}
+
+// LoopStarted is called at each start of a loop.
+func (collector *Collector) LoopStarted(context.Context, segmentloop.LoopInfo) (err error) {
+	return nil
+}
+
+// RemoteSegment adds the remote segment to the statistics.
+func (collector *Collector) RemoteSegment(ctx context.Context, segment *segmentloop.Segment) error {
+	defer remoteSegmentFunc.Task(&ctx)(nil) // method always returns nil
+
+	collector.remoteSegments++
+	collector.remoteBytes += int64(segment.EncryptedSize)
+	collector.pieces[len(segment.Pieces)]++
+	collector.add(segment)
+	return nil
+}
+
+// InlineSegment adds the inline segment to the statistics.
+func (collector *Collector) InlineSegment(ctx context.Context, segment *segmentloop.Segment) error {
+	defer inlineSegmentFunc.Task(&ctx)(nil) // method always returns nil
+
+	collector.inlineSegments++
+	collector.inlineBytes += int64(segment.EncryptedSize)
+	collector.add(segment)
+	return nil
+}
+
+// add adds the segment to the statistics shared by inline and remote segments.
+func (collector *Collector) add(segment *segmentloop.Segment) {
+	collector.sizes[sizeBucket(int64(segment.EncryptedSize))]++
+
+	totals, ok := collector.placements[segment.Placement]
+	if !ok {
+		totals = &PlacementTotals{Placement: segment.Placement}
+		collector.placements[segment.Placement] = totals
+	}
+	totals.Segments++
+	totals.Bytes += int64(segment.EncryptedSize)
+}
+
+// Report returns the statistics collected so far.
+func (collector *Collector) Report(createdAt time.Time) Report {
+	report := Report{
+		CreatedAt: createdAt,
+
+		InlineSegments: collector.inlineSegments,
+		InlineBytes:    collector.inlineBytes,
+		RemoteSegments: collector.remoteSegments,
+		RemoteBytes:    collector.remoteBytes,
+	}
+
+	for maxSize, segments := range collector.sizes {
+		report.SegmentSizes = append(report.SegmentSizes, SizeBucket{MaxSize: maxSize, Segments: segments})
+	}
+	sort.Slice(report.SegmentSizes, func(i, k int) bool {
+		return report.SegmentSizes[i].MaxSize < report.SegmentSizes[k].MaxSize
+	})
+
+	for pieces, segments := range collector.pieces {
+		report.PiecesPerSegment = append(report.PiecesPerSegment, PiecesCount{Pieces: pieces, Segments: segments})
+	}
+	sort.Slice(report.PiecesPerSegment, func(i, k int) bool {
+		return report.PiecesPerSegment[i].Pieces < report.PiecesPerSegment[k].Pieces
+	})
+
+	for _, totals := range collector.placements {
+		report.Placements = append(report.Placements, *totals)
+	}
+	sort.Slice(report.Placements, func(i, k int) bool {
+		return report.Placements[i].Placement < report.Placements[k].Placement
+	})
+
+	return report
+}
+
+// sizeBucket returns the smallest power of two which is greater than or
+// equal to the size.
+func sizeBucket(size int64) int64 {
+	if size <= 0 {
+		return 0
+	}
+	return 1 << bits.Len64(uint64(size-1))
+}
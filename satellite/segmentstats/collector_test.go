@@ -0,0 +1,100 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentstats_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+	"storj.io/storj/satellite/segmentstats"
+)
+
+func TestCollector(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	remote := func(size int32, pieces int, placement storj.PlacementConstraint) *segmentloop.Segment {
+		return &segmentloop.Segment{
+			StreamID:      testrand.UUID(),
+			EncryptedSize: size,
+			Pieces:        make(metabase.Pieces, pieces),
+			Placement:     placement,
+		}
+	}
+
+	collector := segmentstats.NewCollector()
+	require.NoError(t, collector.RemoteSegment(ctx, remote(64<<20, 80, storj.EveryCountry)))
+	require.NoError(t, collector.RemoteSegment(ctx, remote(64<<20, 79, storj.EveryCountry)))
+	require.NoError(t, collector.RemoteSegment(ctx, remote(5000, 80, storj.EU)))
+	require.NoError(t, collector.InlineSegment(ctx, &segmentloop.Segment{
+		StreamID:      testrand.UUID(),
+		EncryptedSize: 4096,
+	}))
+	require.NoError(t, collector.InlineSegment(ctx, &segmentloop.Segment{
+		StreamID: testrand.UUID(),
+	}))
+
+	now := time.Now()
+	require.Equal(t, segmentstats.Report{
+		CreatedAt: now,
+
+		InlineSegments: 2,
+		InlineBytes:    4096,
+		RemoteSegments: 3,
+		RemoteBytes:    2*64<<20 + 5000,
+
+		SegmentSizes: []segmentstats.SizeBucket{
+			{MaxSize: 0, Segments: 1},
+			{MaxSize: 4096, Segments: 1},
+			{MaxSize: 8192, Segments: 1},
+			{MaxSize: 64 << 20, Segments: 2},
+		},
+		PiecesPerSegment: []segmentstats.PiecesCount{
+			{Pieces: 79, Segments: 1},
+			{Pieces: 80, Segments: 2},
+		},
+		Placements: []segmentstats.PlacementTotals{
+			{Placement: storj.EveryCountry, Segments: 4, Bytes: 2*64<<20 + 4096},
+			{Placement: storj.EU, Segments: 1, Bytes: 5000},
+		},
+	}, collector.Report(now))
+}
+
+func TestDB(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		statsDB := db.SegmentStatistics()
+
+		_, err := statsDB.GetLatest(ctx)
+		require.True(t, segmentstats.ErrReportNotFound.Has(err))
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		older := segmentstats.Report{
+			CreatedAt:      now.Add(-24 * time.Hour),
+			RemoteSegments: 1,
+		}
+		latest := segmentstats.Report{
+			CreatedAt:        now,
+			InlineSegments:   1,
+			RemoteSegments:   2,
+			SegmentSizes:     []segmentstats.SizeBucket{{MaxSize: 4096, Segments: 3}},
+			PiecesPerSegment: []segmentstats.PiecesCount{{Pieces: 80, Segments: 2}},
+			Placements:       []segmentstats.PlacementTotals{{Placement: storj.EU, Segments: 3, Bytes: 10}},
+		}
+
+		require.NoError(t, statsDB.Insert(ctx, latest))
+		require.NoError(t, statsDB.Insert(ctx, older))
+
+		report, err := statsDB.GetLatest(ctx)
+		require.NoError(t, err)
+		require.Equal(t, latest, report)
+	})
+}
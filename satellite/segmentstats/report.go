@@ -0,0 +1,63 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentstats
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// DB stores the segment statistics reports.
+//
+// architecture: Database
+type DB interface {
+	// Insert stores the report.
+	Insert(ctx context.Context, report Report) error
+	// GetLatest returns the most recent report, or ErrReportNotFound when
+	// there's none.
+	GetLatest(ctx context.Context) (Report, error)
+}
+
+// Report is the distribution of the segments at the time of a segment loop
+// iteration.
+type Report struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	InlineSegments int64 `json:"inlineSegments"`
+	InlineBytes    int64 `json:"inlineBytes"`
+	RemoteSegments int64 `json:"remoteSegments"`
+	RemoteBytes    int64 `json:"remoteBytes"`
+
+	// SegmentSizes is the histogram of the encrypted size of the segments,
+	// with power of two buckets.
+	SegmentSizes []SizeBucket `json:"segmentSizes"`
+	// PiecesPerSegment is the distribution of the number of pieces of the
+	// remote segments.
+	PiecesPerSegment []PiecesCount `json:"piecesPerSegment"`
+	// Placements are the totals of the segments per placement constraint.
+	Placements []PlacementTotals `json:"placements"`
+}
+
+// SizeBucket is the number of segments with an encrypted size in
+// (MaxSize/2, MaxSize].
+type SizeBucket struct {
+	MaxSize  int64 `json:"maxSize"`
+	Segments int64 `json:"segments"`
+}
+
+// PiecesCount is the number of remote segments with the given number of
+// pieces.
+type PiecesCount struct {
+	Pieces   int   `json:"pieces"`
+	Segments int64 `json:"segments"`
+}
+
+// PlacementTotals are the totals of the segments of a placement constraint.
+type PlacementTotals struct {
+	Placement storj.PlacementConstraint `json:"placement"`
+	Segments  int64                     `json:"segments"`
+	Bytes     int64                     `json:"bytes"`
+}
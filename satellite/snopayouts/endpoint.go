@@ -28,18 +28,20 @@ type Endpoint struct {
 	pb.DRPCHeldAmountUnimplementedServer
 
 	service    *Service
+	estimator  *Estimator
 	log        *zap.Logger
 	overlay    overlay.DB
 	accounting accounting.StoragenodeAccounting
 }
 
 // NewEndpoint creates new endpoint.
-func NewEndpoint(log *zap.Logger, accounting accounting.StoragenodeAccounting, overlay overlay.DB, service *Service) *Endpoint {
+func NewEndpoint(log *zap.Logger, accounting accounting.StoragenodeAccounting, overlay overlay.DB, service *Service, estimator *Estimator) *Endpoint {
 	return &Endpoint{
 		log:        log,
 		accounting: accounting,
 		overlay:    overlay,
 		service:    service,
+		estimator:  estimator,
 	}
 }
 
@@ -57,7 +59,12 @@ func (e *Endpoint) GetPayStub(ctx context.Context, req *pb.GetHeldAmountRequest)
 		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
 	}
 
-	paystub, err := e.service.GetPaystub(ctx, node.Id, req.Period.Format("2006-01"))
+	period := req.Period.Format("2006-01")
+	paystub, err := e.service.GetPaystub(ctx, node.Id, period)
+	if ErrNoDataForPeriod.Has(err) && e.estimator != nil && period == e.estimator.CurrentPeriod() {
+		// the current period isn't closed yet, so we send the estimate instead.
+		paystub, err = e.estimator.EstimatePaystub(ctx, node.Id)
+	}
 	if err != nil {
 		if ErrNoDataForPeriod.Has(err) {
 			return nil, rpcstatus.Wrap(rpcstatus.OutOfRange, err)
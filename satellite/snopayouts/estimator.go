@@ -0,0 +1,210 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package snopayouts
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/overlay"
+)
+
+// Config contains configurable values for the month-to-date payout estimates.
+type Config struct {
+	EstimateInterval time.Duration `help:"how often the month-to-date usage for payout estimates is recalculated" releaseDefault:"24h" devDefault:"1h"`
+	SurgePercent     int64         `help:"surge percent used for month-to-date payout estimates" default:"0"`
+}
+
+// Estimator estimates the payout of the storage nodes for the current period,
+// before the period is closed and the paystubs are recorded.
+//
+// The usage of all the nodes is recalculated once per EstimateInterval, while
+// the held amounts and the node information are read when an estimate is requested.
+//
+// architecture: Chore
+type Estimator struct {
+	log          *zap.Logger
+	accounting   accounting.StoragenodeAccounting
+	overlay      overlay.DB
+	compensation compensation.DB
+	config       compensation.Config
+	surgePercent int64
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+
+	mu         sync.Mutex
+	period     compensation.Period
+	calculated time.Time
+	usage      map[storj.NodeID]accounting.StorageNodePeriodUsage
+}
+
+// NewEstimator creates a new payout estimator.
+func NewEstimator(log *zap.Logger, accounting accounting.StoragenodeAccounting, overlay overlay.DB, compensationDB compensation.DB, compensationConfig compensation.Config, config Config) *Estimator {
+	return &Estimator{
+		log:          log,
+		accounting:   accounting,
+		overlay:      overlay,
+		compensation: compensationDB,
+		config:       compensationConfig,
+		surgePercent: config.SurgePercent,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.EstimateInterval),
+	}
+}
+
+// Run runs the estimator.
+func (estimator *Estimator) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return estimator.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := estimator.CalculateUsage(ctx); err != nil {
+			estimator.log.Error("failed to calculate month-to-date usage for payout estimates", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// CalculateUsage recalculates the month-to-date usage of all the nodes.
+func (estimator *Estimator) CalculateUsage(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := estimator.nowFn()
+	period := compensation.PeriodFromTime(now)
+
+	periodUsage, err := estimator.accounting.QueryStorageNodePeriodUsage(ctx, period)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	usage := make(map[storj.NodeID]accounting.StorageNodePeriodUsage, len(periodUsage))
+	for _, nodeUsage := range periodUsage {
+		usage[nodeUsage.NodeID] = nodeUsage
+	}
+
+	estimator.mu.Lock()
+	defer estimator.mu.Unlock()
+
+	estimator.period = period
+	estimator.calculated = now
+	estimator.usage = usage
+	return nil
+}
+
+// CurrentPeriod returns the period for which the estimates are computed.
+func (estimator *Estimator) CurrentPeriod() string {
+	return compensation.PeriodFromTime(estimator.nowFn()).String()
+}
+
+// EstimatePaystub returns the estimated paystub of the node for the current period,
+// computed the same way as the final paystub of the period.
+func (estimator *Estimator) EstimatePaystub(ctx context.Context, nodeID storj.NodeID) (_ Paystub, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	estimator.mu.Lock()
+	period, calculated, usageCalculated := estimator.period, estimator.calculated, estimator.usage != nil
+	// the zero value of period usage is acceptable for if the node does not have
+	// any usage for the period.
+	usage := estimator.usage[nodeID]
+	estimator.mu.Unlock()
+
+	if !usageCalculated || period.String() != estimator.CurrentPeriod() {
+		return Paystub{}, ErrNoDataForPeriod.New("estimate for %s is not calculated yet", estimator.CurrentPeriod())
+	}
+
+	node, err := estimator.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return Paystub{}, Error.Wrap(err)
+	}
+
+	totalAmounts, err := estimator.compensation.QueryTotalAmounts(ctx, nodeID)
+	if err != nil {
+		return Paystub{}, Error.Wrap(err)
+	}
+
+	var gracefulExit *time.Time
+	if node.ExitStatus.ExitSuccess {
+		gracefulExit = node.ExitStatus.ExitFinishedAt
+	}
+
+	statements, err := compensation.GenerateStatements(compensation.PeriodInfo{
+		Period: period,
+		Nodes: []compensation.NodeInfo{{
+			ID:                 nodeID,
+			CreatedAt:          node.CreatedAt,
+			LastContactSuccess: node.Reputation.LastContactSuccess,
+			Disqualified:       node.Disqualified,
+			GracefulExit:       gracefulExit,
+			UsageAtRest:        usage.AtRestTotal,
+			UsageGet:           usage.GetTotal,
+			UsagePut:           usage.PutTotal,
+			UsageGetRepair:     usage.GetRepairTotal,
+			UsagePutRepair:     usage.PutRepairTotal,
+			UsageGetAudit:      usage.GetAuditTotal,
+			TotalHeld:          totalAmounts.TotalHeld,
+			TotalDisposed:      totalAmounts.TotalDisposed,
+			TotalPaid:          totalAmounts.TotalPaid,
+			TotalDistributed:   totalAmounts.TotalDistributed,
+		}},
+		Rates: &compensation.Rates{
+			AtRestGBHours: estimator.config.Rates.AtRestGBHours,
+			GetTB:         estimator.config.Rates.GetTB,
+			PutTB:         estimator.config.Rates.PutTB,
+			GetRepairTB:   estimator.config.Rates.GetRepairTB,
+			PutRepairTB:   estimator.config.Rates.PutRepairTB,
+			GetAuditTB:    estimator.config.Rates.GetAuditTB,
+		},
+		SurgePercent:     estimator.surgePercent,
+		DisposePercent:   estimator.config.DisposePercent,
+		WithheldPercents: estimator.config.WithheldPercents,
+	})
+	if err != nil {
+		return Paystub{}, Error.Wrap(err)
+	}
+	statement := statements[0]
+
+	return Paystub{
+		Period:         period.String(),
+		NodeID:         nodeID,
+		Created:        calculated,
+		Codes:          statement.Codes.String(),
+		UsageAtRest:    usage.AtRestTotal,
+		UsageGet:       usage.GetTotal,
+		UsagePut:       usage.PutTotal,
+		UsageGetRepair: usage.GetRepairTotal,
+		UsagePutRepair: usage.PutRepairTotal,
+		UsageGetAudit:  usage.GetAuditTotal,
+		CompAtRest:     statement.AtRest.Value(),
+		CompGet:        statement.Get.Value(),
+		CompPut:        statement.Put.Value(),
+		CompGetRepair:  statement.GetRepair.Value(),
+		CompPutRepair:  statement.PutRepair.Value(),
+		CompGetAudit:   statement.GetAudit.Value(),
+		SurgePercent:   statement.SurgePercent,
+		Held:           statement.Held.Value(),
+		Owed:           statement.Owed.Value(),
+		Disposed:       statement.Disposed.Value(),
+		Wallet:         node.Operator.Wallet,
+		WalletFeatures: node.Operator.WalletFeatures,
+	}, nil
+}
+
+// SetNow allows tests to have the estimator act as if the current time is whatever they want.
+func (estimator *Estimator) SetNow(nowFn func() time.Time) {
+	estimator.nowFn = nowFn
+}
+
+// Close closes the estimator.
+func (estimator *Estimator) Close() error {
+	estimator.Loop.Close()
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package snopayouts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/snopayouts"
+)
+
+func TestEstimatePaystub(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		estimator := satellite.API.SNOPayouts.Estimator
+		estimator.Loop.Pause()
+
+		nodeID := planet.StorageNodes[0].ID()
+		now := time.Now().UTC()
+		estimator.SetNow(func() time.Time { return now })
+
+		err := satellite.DB.StoragenodeAccounting().SaveRollup(ctx, now, accounting.RollupStats{
+			compensation.PeriodFromTime(now).StartDate(): {
+				nodeID: &accounting.Rollup{
+					NodeID:    nodeID,
+					StartTime: compensation.PeriodFromTime(now).StartDate(),
+					GetTotal:  memory.TB.Int64(),
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, estimator.CalculateUsage(ctx))
+
+		paystub, err := estimator.EstimatePaystub(ctx, nodeID)
+		require.NoError(t, err)
+		require.Equal(t, compensation.PeriodFromTime(now).String(), paystub.Period)
+		require.Equal(t, nodeID, paystub.NodeID)
+		require.Equal(t, memory.TB.Int64(), paystub.UsageGet)
+		// $20 per TB of egress, of which 75% is held for new nodes.
+		require.EqualValues(t, 20e6, paystub.CompGet)
+		require.EqualValues(t, 15e6, paystub.Held)
+		require.EqualValues(t, 5e6, paystub.Owed)
+
+		// estimates are not served for other periods than the current one.
+		estimator.SetNow(func() time.Time { return now.AddDate(0, 1, 0) })
+		_, err = estimator.EstimatePaystub(ctx, nodeID)
+		require.True(t, snopayouts.ErrNoDataForPeriod.Has(err))
+	})
+}
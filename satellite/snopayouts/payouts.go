@@ -63,6 +63,8 @@ type Paystub struct {
 	Disposed       int64        `json:"disposed"`
 	Paid           int64        `json:"paid"`
 	Distributed    int64        `json:"distributed"`
+	Wallet         string       `json:"wallet"`
+	WalletFeatures []string     `json:"walletFeatures"`
 }
 
 // Payment is an entity that holds payment to storagenode operator parameters.
@@ -44,6 +44,8 @@ func TestPayoutDB(t *testing.T) {
 			Disposed:       16,
 			Paid:           17,
 			Distributed:    18,
+			Wallet:         "0x1111111111111111111111111111111111111111",
+			WalletFeatures: []string{"zksync"},
 		}
 
 		paystub2 := snopayouts.Paystub{
@@ -68,6 +70,7 @@ func TestPayoutDB(t *testing.T) {
 			Disposed:       19,
 			Paid:           20,
 			Distributed:    21,
+			Wallet:         "0x2222222222222222222222222222222222222222",
 		}
 
 		paystub3 := snopayouts.Paystub{
@@ -0,0 +1,358 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package tuning implements changing selected settings of a running satellite
+// process without restarting it.
+package tuning
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/errs2"
+)
+
+var (
+	// Error is the default error class for the package.
+	Error = errs.Class("tuning")
+
+	// ErrNotFound is returned when the setting doesn't exist.
+	ErrNotFound = errs.Class("setting not found")
+
+	// ErrInvalidValue is returned when the value of the setting isn't valid.
+	ErrInvalidValue = errs.Class("invalid value")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the tuning endpoint.
+type Config struct {
+	Address            string `help:"address to listen on for changing the selected settings at runtime, disabled when empty" default:""`
+	AuthorizationToken string `help:"token required in the Authorization header for changing the settings, changes are rejected when empty" default:""`
+	MaxChanges         int    `help:"how many changes to keep in the change log" default:"1000"`
+}
+
+// Setting is a value, which can be changed while the process is running.
+//
+// Get and Set are called with the service lock held, so they are never
+// called concurrently.
+type Setting struct {
+	Name        string
+	Description string
+
+	// Get returns the current value.
+	Get func() string
+	// Set validates and applies the value.
+	Set func(value string) error
+}
+
+// Value is the state of a setting.
+type Value struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Value       string `json:"value"`
+	Initial     string `json:"initial"`
+	Changed     bool   `json:"changed"`
+}
+
+// Change is a change of a setting.
+type Change struct {
+	Time     time.Time `json:"time"`
+	Name     string    `json:"name"`
+	Previous string    `json:"previous"`
+	Value    string    `json:"value"`
+	Source   string    `json:"source"`
+	Revert   bool      `json:"revert"`
+}
+
+// setting is a registered setting with its value at registration.
+type setting struct {
+	Setting
+	initial string
+}
+
+// Service keeps the settings, which can be changed at runtime, and serves
+// them over HTTP.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	config Config
+
+	listener net.Listener
+	server   http.Server
+
+	mu       sync.Mutex
+	settings map[string]*setting
+	changes  []Change
+
+	nowFn func() time.Time
+}
+
+// NewService returns a new tuning service. The settings are served on the
+// listener, when it isn't nil.
+func NewService(log *zap.Logger, listener net.Listener, config Config) *Service {
+	service := &Service{
+		log:    log,
+		config: config,
+
+		listener: listener,
+
+		settings: map[string]*setting{},
+
+		nowFn: time.Now,
+	}
+
+	root := mux.NewRouter()
+	root.HandleFunc("/settings", service.listSettings).Methods("GET")
+	root.HandleFunc("/settings/{name}", service.setSetting).Methods("PUT")
+	root.HandleFunc("/settings/{name}", service.revertSetting).Methods("DELETE")
+	root.HandleFunc("/changes", service.listChanges).Methods("GET")
+	service.server.Handler = root
+
+	return service
+}
+
+// Add registers the settings. The current values are used when the settings
+// are reverted.
+func (service *Service) Add(settings ...Setting) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	for _, s := range settings {
+		service.settings[s.Name] = &setting{
+			Setting: s,
+			initial: s.Get(),
+		}
+	}
+}
+
+// Values returns the states of the settings ordered by the name.
+func (service *Service) Values() []Value {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	values := make([]Value, 0, len(service.settings))
+	for _, s := range service.settings {
+		value := s.Get()
+		values = append(values, Value{
+			Name:        s.Name,
+			Description: s.Description,
+			Value:       value,
+			Initial:     s.initial,
+			Changed:     value != s.initial,
+		})
+	}
+	sort.Slice(values, func(i, k int) bool {
+		return values[i].Name < values[k].Name
+	})
+	return values
+}
+
+// Changes returns the changes of the settings, the oldest first.
+func (service *Service) Changes() []Change {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	return append([]Change(nil), service.changes...)
+}
+
+// Set changes the setting to the value. The source identifies who made the
+// change in the change log.
+func (service *Service) Set(ctx context.Context, name, value, source string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.change(name, source, false, func(s *setting) string { return value })
+}
+
+// Revert changes the setting back to its value at registration.
+func (service *Service) Revert(ctx context.Context, name, source string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.change(name, source, true, func(s *setting) string { return s.initial })
+}
+
+func (service *Service) change(name, source string, revert bool, valueOf func(s *setting) string) error {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	s, ok := service.settings[name]
+	if !ok {
+		return ErrNotFound.New("%q", name)
+	}
+
+	previous := s.Get()
+	value := valueOf(s)
+	if err := s.Set(value); err != nil {
+		return ErrInvalidValue.New("%q: %v", name, err)
+	}
+	value = s.Get()
+
+	change := Change{
+		Time:     service.nowFn(),
+		Name:     name,
+		Previous: previous,
+		Value:    value,
+		Source:   source,
+		Revert:   revert,
+	}
+	service.changes = append(service.changes, change)
+	if service.config.MaxChanges > 0 && len(service.changes) > service.config.MaxChanges {
+		service.changes = append(service.changes[:0], service.changes[len(service.changes)-service.config.MaxChanges:]...)
+	}
+
+	service.log.Info("setting changed",
+		zap.String("name", name),
+		zap.String("previous", previous),
+		zap.String("value", value),
+		zap.String("source", source),
+		zap.Bool("revert", revert))
+
+	return nil
+}
+
+// Run starts serving the settings.
+func (service *Service) Run(ctx context.Context) error {
+	if service.listener == nil {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	var group errgroup.Group
+	group.Go(func() error {
+		<-ctx.Done()
+		return Error.Wrap(service.server.Shutdown(context.Background()))
+	})
+	group.Go(func() error {
+		defer cancel()
+		err := service.server.Serve(service.listener)
+		if errs2.IsCanceled(err) || errors.Is(err, http.ErrServerClosed) {
+			err = nil
+		}
+		return Error.Wrap(err)
+	})
+	return group.Wait()
+}
+
+// SetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	service.nowFn = nowFn
+}
+
+// ServeHTTP serves the settings.
+func (service *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	service.server.Handler.ServeHTTP(w, r)
+}
+
+// Close closes the server and the underlying listener.
+func (service *Service) Close() error {
+	return Error.Wrap(service.server.Close())
+}
+
+func (service *Service) listSettings(w http.ResponseWriter, r *http.Request) {
+	sendJSON(w, http.StatusOK, service.Values())
+}
+
+func (service *Service) listChanges(w http.ResponseWriter, r *http.Request) {
+	sendJSON(w, http.StatusOK, service.Changes())
+}
+
+func (service *Service) setSetting(w http.ResponseWriter, r *http.Request) {
+	if !service.authorized(w, r) {
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(body, &input); err != nil {
+		sendJSONError(w, "failed to unmarshal request", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = service.Set(r.Context(), mux.Vars(r)["name"], input.Value, r.RemoteAddr)
+	service.sendResult(w, err)
+}
+
+func (service *Service) revertSetting(w http.ResponseWriter, r *http.Request) {
+	if !service.authorized(w, r) {
+		return
+	}
+
+	err := service.Revert(r.Context(), mux.Vars(r)["name"], r.RemoteAddr)
+	service.sendResult(w, err)
+}
+
+func (service *Service) sendResult(w http.ResponseWriter, err error) {
+	switch {
+	case ErrNotFound.Has(err):
+		sendJSONError(w, "setting not found", err.Error(), http.StatusNotFound)
+	case ErrInvalidValue.Has(err):
+		sendJSONError(w, "invalid value", err.Error(), http.StatusBadRequest)
+	case err != nil:
+		sendJSONError(w, "failed to change setting", err.Error(), http.StatusInternalServerError)
+	default:
+		sendJSON(w, http.StatusOK, service.Values())
+	}
+}
+
+// authorized checks the authorization token of the request, which changes a
+// setting.
+func (service *Service) authorized(w http.ResponseWriter, r *http.Request) bool {
+	if service.config.AuthorizationToken == "" {
+		sendJSONError(w, "changing settings is disabled", "authorization token isn't configured", http.StatusForbidden)
+		return false
+	}
+
+	token := r.Header.Get("Authorization")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(service.config.AuthorizationToken)) != 1 {
+		service.log.Warn("unauthorized setting change", zap.String("source", r.RemoteAddr))
+		sendJSONError(w, "unauthorized", "invalid authorization token", http.StatusUnauthorized)
+		return false
+	}
+	return true
+}
+
+func sendJSON(w http.ResponseWriter, statusCode int, data interface{}) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body) // any error here entitles a client side disconnect or similar, which we do not care about.
+}
+
+func sendJSONError(w http.ResponseWriter, errMsg, detail string, statusCode int) {
+	sendJSON(w, statusCode, struct {
+		Error  string `json:"error"`
+		Detail string `json:"detail"`
+	}{
+		Error:  errMsg,
+		Detail: detail,
+	})
+}
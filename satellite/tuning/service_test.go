@@ -0,0 +1,134 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tuning_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/tuning"
+)
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	service := tuning.NewService(zaptest.NewLogger(t), nil, tuning.Config{MaxChanges: 2})
+
+	interval := time.Hour
+	rate := 100.0
+	service.Add(
+		tuning.Duration("chore.interval", "interval", func() time.Duration { return interval }, func(d time.Duration) { interval = d }),
+		tuning.Float("rate", "rate", func() float64 { return rate }, func(f float64) { rate = f }),
+	)
+
+	require.Equal(t, []tuning.Value{
+		{Name: "chore.interval", Description: "interval", Value: "1h0m0s", Initial: "1h0m0s"},
+		{Name: "rate", Description: "rate", Value: "100", Initial: "100"},
+	}, service.Values())
+
+	require.True(t, tuning.ErrNotFound.Has(service.Set(ctx, "unknown", "1", "test")))
+	require.True(t, tuning.ErrInvalidValue.Has(service.Set(ctx, "chore.interval", "-1m", "test")))
+	require.True(t, tuning.ErrInvalidValue.Has(service.Set(ctx, "rate", "fast", "test")))
+	require.Equal(t, time.Hour, interval)
+	require.Empty(t, service.Changes())
+
+	require.NoError(t, service.Set(ctx, "chore.interval", "5m", "test"))
+	require.NoError(t, service.Set(ctx, "rate", "2.5", "test"))
+	require.Equal(t, 5*time.Minute, interval)
+	require.Equal(t, 2.5, rate)
+
+	require.Equal(t, []tuning.Value{
+		{Name: "chore.interval", Description: "interval", Value: "5m0s", Initial: "1h0m0s", Changed: true},
+		{Name: "rate", Description: "rate", Value: "2.5", Initial: "100", Changed: true},
+	}, service.Values())
+
+	require.NoError(t, service.Revert(ctx, "chore.interval", "test"))
+	require.Equal(t, time.Hour, interval)
+
+	// only the last changes are kept.
+	changes := service.Changes()
+	require.Len(t, changes, 2)
+	require.Equal(t, "rate", changes[0].Name)
+	require.Equal(t, "100", changes[0].Previous)
+	require.Equal(t, "2.5", changes[0].Value)
+	require.False(t, changes[0].Revert)
+	require.Equal(t, "chore.interval", changes[1].Name)
+	require.Equal(t, "5m0s", changes[1].Previous)
+	require.Equal(t, "1h0m0s", changes[1].Value)
+	require.True(t, changes[1].Revert)
+}
+
+func TestServiceHTTP(t *testing.T) {
+	rate := 100.0
+	newService := func(token string) *tuning.Service {
+		service := tuning.NewService(zaptest.NewLogger(t), nil, tuning.Config{AuthorizationToken: token})
+		service.Add(tuning.Float("rate", "rate", func() float64 { return rate }, func(f float64) { rate = f }))
+		return service
+	}
+
+	do := func(service *tuning.Service, method, path, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", token)
+		}
+		rec := httptest.NewRecorder()
+		service.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("disabled changes", func(t *testing.T) {
+		service := newService("")
+
+		rec := do(service, http.MethodGet, "/settings", "", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var values []tuning.Value
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &values))
+		require.Len(t, values, 1)
+		require.Equal(t, "100", values[0].Value)
+
+		rec = do(service, http.MethodPut, "/settings/rate", "", `{"value": "1"}`)
+		require.Equal(t, http.StatusForbidden, rec.Code)
+		require.Equal(t, 100.0, rate)
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		service := newService("secret")
+
+		rec := do(service, http.MethodPut, "/settings/rate", "wrong", `{"value": "1"}`)
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = do(service, http.MethodPut, "/settings/unknown", "secret", `{"value": "1"}`)
+		require.Equal(t, http.StatusNotFound, rec.Code)
+
+		rec = do(service, http.MethodPut, "/settings/rate", "secret", `{"value": "-1"}`)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Equal(t, 100.0, rate)
+
+		rec = do(service, http.MethodPut, "/settings/rate", "secret", `{"value": "1"}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, 1.0, rate)
+
+		rec = do(service, http.MethodDelete, "/settings/rate", "secret", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, 100.0, rate)
+
+		rec = do(service, http.MethodGet, "/changes", "", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var changes []tuning.Change
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &changes))
+		require.Len(t, changes, 2)
+		require.Equal(t, "1", changes[0].Value)
+		require.Equal(t, "100", changes[1].Value)
+	})
+}
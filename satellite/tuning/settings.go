@@ -0,0 +1,87 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tuning
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/sync2"
+)
+
+// Duration returns a setting for a positive duration.
+func Duration(name, description string, get func() time.Duration, set func(time.Duration)) Setting {
+	return Setting{
+		Name:        name,
+		Description: description,
+		Get: func() string {
+			return get().String()
+		},
+		Set: func(value string) error {
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return err
+			}
+			if d <= 0 {
+				return errs.New("duration must be positive: %v", d)
+			}
+			set(d)
+			return nil
+		},
+	}
+}
+
+// Float returns a setting for a non-negative number.
+func Float(name, description string, get func() float64, set func(float64)) Setting {
+	return Setting{
+		Name:        name,
+		Description: description,
+		Get: func() string {
+			return strconv.FormatFloat(get(), 'g', -1, 64)
+		},
+		Set: func(value string) error {
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return err
+			}
+			if f < 0 {
+				return errs.New("value must not be negative: %v", f)
+			}
+			set(f)
+			return nil
+		},
+	}
+}
+
+// Bool returns a setting for a boolean.
+func Bool(name, description string, get func() bool, set func(bool)) Setting {
+	return Setting{
+		Name:        name,
+		Description: description,
+		Get: func() string {
+			return strconv.FormatBool(get())
+		},
+		Set: func(value string) error {
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return err
+			}
+			set(b)
+			return nil
+		},
+	}
+}
+
+// Cycle returns a setting for the interval of a chore cycle, which was
+// created with the interval.
+func Cycle(name string, cycle *sync2.Cycle, interval time.Duration) Setting {
+	return Duration(name, "interval of the chore", func() time.Duration {
+		return interval
+	}, func(d time.Duration) {
+		interval = d
+		cycle.ChangeInterval(d)
+	})
+}
@@ -97,6 +97,8 @@ type Blobs interface {
 	StatWithStorageFormat(ctx context.Context, ref BlobRef, formatVer FormatVersion) (BlobInfo, error)
 	// FreeSpace return how much free space is available to the blobstore.
 	FreeSpace(ctx context.Context) (int64, error)
+	// TotalSpace returns the capacity of the filesystem of the blobstore.
+	TotalSpace(ctx context.Context) (int64, error)
 	// CheckWritability tests writability of the storage directory by creating and deleting a file.
 	CheckWritability(ctx context.Context) error
 	// SpaceUsedForTrash returns the total space used by the trash.
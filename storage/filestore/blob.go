@@ -20,19 +20,23 @@ const (
 	FormatV0 storage.FormatVersion = 0
 	// FormatV1 is the identifier for storage format v1.
 	FormatV1 storage.FormatVersion = 1
+	// FormatV2 is the identifier for storage format v2.
+	FormatV2 storage.FormatVersion = 2
 
 	// Note: New FormatVersion values should be consecutive, as certain parts of this blob store
 	// iterate over them numerically and check for blobs stored with each version.
 )
 
 const (
-	// MaxFormatVersionSupported is the highest supported storage format version for reading, and
-	// the only supported storage format version for writing. If stored blobs claim a higher
-	// storage format version than this, or a caller requests _writing_ a storage format version
-	// which is not this, this software will not know how to perform the read or write and an error
-	// will be returned.
+	// MaxFormatVersionSupported is the storage format version for writing, unless
+	// Config.WriteFormatV2 is set.
 	MaxFormatVersionSupported = FormatV1
 
+	// MaxFormatVersionReadable is the highest supported storage format version for reading. If
+	// stored blobs claim a higher storage format version than this, this software will not know
+	// how to perform the read and an error will be returned.
+	MaxFormatVersionReadable = FormatV2
+
 	// MinFormatVersionSupported is the lowest supported storage format version for reading. If
 	// stored blobs claim a lower storage format version than this, this software will not know how
 	// to perform the read and an error will be returned.
@@ -30,6 +30,7 @@ const (
 
 	v0PieceFileSuffix      = ""
 	v1PieceFileSuffix      = ".sj1"
+	v2PieceFileSuffix      = ".sj2"
 	unknownPieceFileSuffix = "/..error_unknown_format../"
 	verificationFileName   = "storage-dir-verification"
 )
@@ -200,6 +201,8 @@ func blobPathForFormatVersion(path string, formatVersion storage.FormatVersion)
 		return path + v0PieceFileSuffix
 	case FormatV1:
 		return path + v1PieceFileSuffix
+	case FormatV2:
+		return path + v2PieceFileSuffix
 	}
 	return path + unknownPieceFileSuffix
 }
@@ -264,7 +267,7 @@ func (dir *Dir) Open(ctx context.Context, ref storage.BlobRef) (_ *os.File, _ st
 	if err != nil {
 		return nil, FormatV0, err
 	}
-	for formatVer := MaxFormatVersionSupported; formatVer >= MinFormatVersionSupported; formatVer-- {
+	for formatVer := MaxFormatVersionReadable; formatVer >= MinFormatVersionSupported; formatVer-- {
 		vPath := blobPathForFormatVersion(path, formatVer)
 		file, err := openFileReadOnly(vPath, blobPermission)
 		if err == nil {
@@ -315,7 +318,7 @@ func (dir *Dir) Stat(ctx context.Context, ref storage.BlobRef) (_ storage.BlobIn
 	if err != nil {
 		return nil, err
 	}
-	for formatVer := MaxFormatVersionSupported; formatVer >= MinFormatVersionSupported; formatVer-- {
+	for formatVer := MaxFormatVersionReadable; formatVer >= MinFormatVersionSupported; formatVer-- {
 		vPath := blobPathForFormatVersion(path, formatVer)
 		stat, err := os.Stat(vPath)
 		if err == nil {
@@ -501,7 +504,7 @@ func (dir *Dir) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore
 func (dir *Dir) iterateStorageFormatVersions(ctx context.Context, ref storage.BlobRef, f func(ctx context.Context, ref storage.BlobRef, i storage.FormatVersion) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	var combinedErrors errs.Group
-	for i := MinFormatVersionSupported; i <= MaxFormatVersionSupported; i++ {
+	for i := MinFormatVersionSupported; i <= MaxFormatVersionReadable; i++ {
 		combinedErrors.Add(f(ctx, ref, i))
 	}
 	return combinedErrors.Err()
@@ -734,9 +737,13 @@ func decodeBlobInfo(namespace []byte, keyPrefix, keyDir string, keyInfo os.FileI
 	blobFileName := keyInfo.Name()
 	encodedKey := keyPrefix + blobFileName
 	formatVer := FormatV0
-	if strings.HasSuffix(blobFileName, v1PieceFileSuffix) {
+	switch {
+	case strings.HasSuffix(blobFileName, v1PieceFileSuffix):
 		formatVer = FormatV1
 		encodedKey = encodedKey[0 : len(encodedKey)-len(v1PieceFileSuffix)]
+	case strings.HasSuffix(blobFileName, v2PieceFileSuffix):
+		formatVer = FormatV2
+		encodedKey = encodedKey[0 : len(encodedKey)-len(v2PieceFileSuffix)]
 	}
 	key, err := pathEncoding.DecodeString(encodedKey)
 	if err != nil {
@@ -833,6 +840,7 @@ func removeAllContent(ctx context.Context, path string) (err error) {
 type DiskInfo struct {
 	ID             string
 	AvailableSpace int64
+	TotalSpace     int64
 }
 
 // Info returns information about the current state of the dir.
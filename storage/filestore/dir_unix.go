@@ -23,14 +23,15 @@ func diskInfoFromPath(path string) (info DiskInfo, err error) {
 	var stat unix.Statfs_t
 	err = unix.Statfs(path, &stat)
 	if err != nil {
-		return DiskInfo{"", -1}, err
+		return DiskInfo{"", -1, -1}, err
 	}
 
 	// the Bsize size depends on the OS and unconvert gives a false-positive
 	availableSpace := int64(stat.Bavail) * int64(stat.Bsize) //nolint: unconvert
+	totalSpace := int64(stat.Blocks) * int64(stat.Bsize)     //nolint: unconvert
 	filesystemID := fmt.Sprintf("%08x%08x", stat.Fsid.Val[0], stat.Fsid.Val[1])
 
-	return DiskInfo{filesystemID, availableSpace}, nil
+	return DiskInfo{filesystemID, availableSpace, totalSpace}, nil
 }
 
 // rename renames oldpath to newpath.
@@ -38,6 +38,7 @@ func monFileInTrash(namespace []byte) *monkit.Meter {
 // Config is configuration for the blob store.
 type Config struct {
 	WriteBufferSize memory.Size `help:"in-memory buffer for uploads" default:"128KiB"`
+	WriteFormatV2   bool        `help:"write new pieces with storage format v2, which keeps the creation time and expiration at a fixed offset of the piece header" default:"false"`
 }
 
 // DefaultConfig is the default value for Config.
@@ -169,7 +170,15 @@ func (store *blobStore) Create(ctx context.Context, ref storage.BlobRef, size in
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
-	return newBlobWriter(ref, store, MaxFormatVersionSupported, file, store.config.WriteBufferSize.Int()), nil
+	return newBlobWriter(ref, store, store.WriteFormatVersion(), file, store.config.WriteBufferSize.Int()), nil
+}
+
+// WriteFormatVersion returns the storage format version of the created blobs.
+func (store *blobStore) WriteFormatVersion() storage.FormatVersion {
+	if store.config.WriteFormatV2 {
+		return FormatV2
+	}
+	return MaxFormatVersionSupported
 }
 
 // SpaceUsedForBlobs adds up the space used in all namespaces for blob storage.
@@ -259,6 +268,15 @@ func (store *blobStore) FreeSpace(ctx context.Context) (int64, error) {
 	return info.AvailableSpace, nil
 }
 
+// TotalSpace returns the capacity of the filesystem of the underlying directory.
+func (store *blobStore) TotalSpace(ctx context.Context) (int64, error) {
+	info, err := store.dir.Info(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return info.TotalSpace, nil
+}
+
 // CheckWritability tests writability of the storage directory by creating and deleting a file.
 func (store *blobStore) CheckWritability(ctx context.Context) error {
 	f, err := ioutil.TempFile(store.dir.Path(), "write-test")
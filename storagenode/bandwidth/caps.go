@@ -0,0 +1,167 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bandwidth
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+)
+
+// ErrEgressCaps is the error class for the egress caps.
+var ErrEgressCaps = errs.Class("egress caps")
+
+// capsCacheTTL is how long the egress of a satellite is cached, before it's
+// read again from the database.
+const capsCacheTTL = time.Minute
+
+// EgressCaps are the monthly egress caps of the satellites.
+type EgressCaps map[storj.NodeID]memory.Size
+
+// String implements pflag.Value.
+func (caps EgressCaps) String() string {
+	values := make([]string, 0, len(caps))
+	for satelliteID, size := range caps {
+		values = append(values, satelliteID.String()+":"+size.String())
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+// Set implements pflag.Value. It parses a comma separated list of
+// <satellite-id>:<size>.
+func (caps *EgressCaps) Set(s string) error {
+	parsed := EgressCaps{}
+	for _, value := range strings.Split(s, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		parts := strings.SplitN(value, ":", 2)
+		if len(parts) != 2 {
+			return ErrEgressCaps.New("invalid cap %q, expected <satellite-id>:<size>", value)
+		}
+
+		satelliteID, err := storj.NodeIDFromString(parts[0])
+		if err != nil {
+			return ErrEgressCaps.New("invalid satellite id %q: %v", parts[0], err)
+		}
+
+		var size memory.Size
+		if err := size.Set(parts[1]); err != nil {
+			return ErrEgressCaps.New("invalid size %q: %v", parts[1], err)
+		}
+
+		parsed[satelliteID] = size
+	}
+
+	*caps = parsed
+	return nil
+}
+
+// Type implements pflag.Value.
+func (caps EgressCaps) Type() string { return "bandwidth.EgressCaps" }
+
+// Caps checks the egress of the current month of the satellites against
+// their caps.
+type Caps struct {
+	db    DB
+	caps  EgressCaps
+	nowFn func() time.Time
+
+	mu    sync.Mutex
+	cache map[storj.NodeID]cachedEgress
+}
+
+type cachedEgress struct {
+	egress    int64
+	expiresAt time.Time
+}
+
+// NewCaps creates a new egress caps checker.
+func NewCaps(db DB, caps EgressCaps) *Caps {
+	return &Caps{
+		db:    db,
+		caps:  caps,
+		nowFn: time.Now,
+		cache: map[storj.NodeID]cachedEgress{},
+	}
+}
+
+// Remaining returns how much egress is left this month for the satellite.
+// capped is false when the satellite has no cap. It's safe to call on a nil
+// Caps, which caps no satellite.
+func (caps *Caps) Remaining(ctx context.Context, satelliteID storj.NodeID) (remaining int64, capped bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if caps == nil {
+		return 0, false, nil
+	}
+
+	limit, ok := caps.caps[satelliteID]
+	if !ok {
+		return 0, false, nil
+	}
+
+	egress, err := caps.egress(ctx, satelliteID)
+	if err != nil {
+		return 0, true, ErrEgressCaps.Wrap(err)
+	}
+
+	remaining = limit.Int64() - egress
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, true, nil
+}
+
+// Reached returns whether the satellite reached its egress cap this month.
+// It's safe to call on a nil Caps.
+func (caps *Caps) Reached(ctx context.Context, satelliteID storj.NodeID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	remaining, capped, err := caps.Remaining(ctx, satelliteID)
+	if err != nil {
+		return false, err
+	}
+	return capped && remaining <= 0, nil
+}
+
+// egress returns the egress of the current month of the satellite.
+func (caps *Caps) egress(ctx context.Context, satelliteID storj.NodeID) (int64, error) {
+	now := caps.nowFn()
+
+	caps.mu.Lock()
+	cached, ok := caps.cache[satelliteID]
+	caps.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.egress, nil
+	}
+
+	year, month, _ := now.Date()
+	beginningOfMonth := time.Date(year, month, 1, 0, 0, 0, 0, now.Location())
+
+	usage, err := caps.db.SatelliteEgressSummary(ctx, satelliteID, beginningOfMonth, now)
+	if err != nil {
+		return 0, err
+	}
+	egress := usage.Total()
+
+	caps.mu.Lock()
+	caps.cache[satelliteID] = cachedEgress{
+		egress:    egress,
+		expiresAt: now.Add(capsCacheTTL),
+	}
+	caps.mu.Unlock()
+
+	return egress, nil
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bandwidth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestEgressCapsSet(t *testing.T) {
+	satellite1, satellite2 := testrand.NodeID(), testrand.NodeID()
+
+	var caps bandwidth.EgressCaps
+	require.NoError(t, caps.Set(satellite1.String()+":1TB, "+satellite2.String()+":500GB"))
+	require.Equal(t, bandwidth.EgressCaps{
+		satellite1: memory.TB,
+		satellite2: 500 * memory.GB,
+	}, caps)
+
+	require.NoError(t, caps.Set(""))
+	require.Empty(t, caps)
+
+	require.Error(t, caps.Set("1TB"))
+	require.Error(t, caps.Set("invalid:1TB"))
+	require.Error(t, caps.Set(satellite1.String()+":1.2.3TB"))
+}
+
+func TestCaps(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		capped, uncapped := testrand.NodeID(), testrand.NodeID()
+		now := time.Now()
+
+		require.NoError(t, db.Bandwidth().Add(ctx, capped, pb.PieceAction_GET, 600, now))
+		require.NoError(t, db.Bandwidth().Add(ctx, capped, pb.PieceAction_GET_AUDIT, 100, now))
+		require.NoError(t, db.Bandwidth().Add(ctx, capped, pb.PieceAction_PUT, 1000, now))
+		require.NoError(t, db.Bandwidth().Add(ctx, uncapped, pb.PieceAction_GET, 1000, now))
+
+		caps := bandwidth.NewCaps(db.Bandwidth(), bandwidth.EgressCaps{capped: 1000})
+
+		remaining, isCapped, err := caps.Remaining(ctx, capped)
+		require.NoError(t, err)
+		require.True(t, isCapped)
+		require.EqualValues(t, 300, remaining)
+
+		reached, err := caps.Reached(ctx, capped)
+		require.NoError(t, err)
+		require.False(t, reached)
+
+		_, isCapped, err = caps.Remaining(ctx, uncapped)
+		require.NoError(t, err)
+		require.False(t, isCapped)
+
+		reached, err = caps.Reached(ctx, uncapped)
+		require.NoError(t, err)
+		require.False(t, reached)
+
+		require.NoError(t, db.Bandwidth().Add(ctx, capped, pb.PieceAction_GET, 500, now))
+
+		caps = bandwidth.NewCaps(db.Bandwidth(), bandwidth.EgressCaps{capped: 1000})
+
+		remaining, _, err = caps.Remaining(ctx, capped)
+		require.NoError(t, err)
+		require.Zero(t, remaining)
+
+		reached, err = caps.Reached(ctx, capped)
+		require.NoError(t, err)
+		require.True(t, reached)
+
+		var nilCaps *bandwidth.Caps
+		reached, err = nilCaps.Reached(ctx, capped)
+		require.NoError(t, err)
+		require.False(t, reached)
+	})
+}
@@ -19,6 +19,8 @@ var mon = monkit.Package()
 // Config defines parameters for storage node Collector.
 type Config struct {
 	Interval time.Duration `help:"how frequently bandwidth usage rollups are calculated" default:"1h0m0s"`
+
+	EgressCaps EgressCaps `user:"true" help:"monthly egress caps per satellite, as a comma separated list of <satellite-id>:<size>. once a cap is reached, downloads of customers of the satellite are rejected, audits and repairs are not" default:""`
 }
 
 // Service implements the bandwidth usage rollup service.
@@ -93,11 +93,30 @@ func (service *Service) Collect(ctx context.Context, now time.Time) (err error)
 			return nil
 		}
 
+		// the expiration records of the deleted pieces are removed in a
+		// batch, the ones which failed are kept to retry them later.
+		deleted := make([]pieces.ExpiredInfo, 0, len(infos))
 		for _, expired := range infos {
-			err := service.pieces.Delete(ctx, expired.SatelliteID, expired.PieceID)
+			// the header of pieces stored with storage format v2 has the
+			// expiration of the order limit, which wins over the record.
+			expiration, ok, err := service.pieces.HeaderExpiration(ctx, expired.SatelliteID, expired.PieceID)
+			if err == nil && ok && (expiration.IsZero() || expiration.After(now)) {
+				service.log.Warn("piece expiration doesn't match its header", zap.Stringer("Satellite ID", expired.SatelliteID), zap.Stringer("Piece ID", expired.PieceID), zap.Time("Expiration", expiration))
+				if expiration.IsZero() {
+					deleted = append(deleted, expired)
+					continue
+				}
+				if err := service.pieces.SetExpiration(ctx, expired.SatelliteID, expired.PieceID, expiration); err != nil {
+					service.log.Error("unable to update piece expiration", zap.Stringer("Satellite ID", expired.SatelliteID), zap.Stringer("Piece ID", expired.PieceID), zap.Error(err))
+				}
+				continue
+			}
+
+			err = service.pieces.Delete(ctx, expired.SatelliteID, expired.PieceID)
 			if err != nil {
 				if os.IsNotExist(errors.Unwrap(err)) {
 					service.log.Info("file does not exist", zap.Stringer("Satellite ID", expired.SatelliteID), zap.Stringer("Piece ID", expired.PieceID))
+					deleted = append(deleted, expired)
 					continue
 				}
 				errfailed := service.pieces.DeleteFailed(ctx, expired, now)
@@ -108,9 +127,14 @@ func (service *Service) Collect(ctx context.Context, now time.Time) (err error)
 				continue
 			}
 			service.log.Info("delete expired", zap.Stringer("Satellite ID", expired.SatelliteID), zap.Stringer("Piece ID", expired.PieceID))
+			deleted = append(deleted, expired)
 
 			count++
 		}
+
+		if err := service.pieces.DeleteExpirations(ctx, deleted); err != nil {
+			return err
+		}
 	}
 
 	return nil
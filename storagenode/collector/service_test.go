@@ -4,15 +4,26 @@
 package collector_test
 
 import (
+	"os"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/collector"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/piecestore/usedserials"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
 )
 
 func TestCollector(t *testing.T) {
@@ -108,3 +119,63 @@ func TestCollector(t *testing.T) {
 		require.Equal(t, 0, serialsPresent)
 	})
 }
+
+func TestCollectorHeaderExpiration(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		blobsConfig := filestore.DefaultConfig
+		blobsConfig.WriteFormatV2 = true
+		blobs, err := filestore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), blobsConfig)
+		require.NoError(t, err)
+		defer ctx.Check(blobs.Close)
+
+		store := pieces.NewStore(zaptest.NewLogger(t), blobs, nil, db.PieceExpirationDB(), nil, pieces.DefaultConfig)
+		service := collector.NewService(zaptest.NewLogger(t), store, usedserials.NewTable(memory.MiB), collector.Config{})
+
+		satellite := testrand.NodeID()
+		now := time.Now()
+
+		writePiece := func(pieceID storj.PieceID, headerExpiration, recordExpiration time.Time) {
+			w, err := store.Writer(ctx, satellite, pieceID)
+			require.NoError(t, err)
+			_, err = w.Write(testrand.Bytes(100 * memory.B))
+			require.NoError(t, err)
+			require.NoError(t, w.Commit(ctx, &pb.PieceHeader{
+				CreationTime: now.Add(-48 * time.Hour),
+				OrderLimit:   pb.OrderLimit{PieceExpiration: headerExpiration},
+			}))
+			require.NoError(t, store.SetExpiration(ctx, satellite, pieceID, recordExpiration))
+		}
+
+		var (
+			expired     = testrand.PieceID()
+			notExpired  = testrand.PieceID()
+			noExpiry    = testrand.PieceID()
+			expiration  = now.Add(-time.Hour)
+			extendedExp = now.Add(24 * time.Hour)
+		)
+		writePiece(expired, expiration, expiration)
+		writePiece(notExpired, extendedExp, expiration)
+		writePiece(noExpiry, time.Time{}, expiration)
+
+		require.NoError(t, service.Collect(ctx, now))
+
+		_, err = store.Stat(ctx, satellite, expired)
+		require.True(t, errs.IsFunc(err, os.IsNotExist), err)
+
+		// the pieces which don't expire by their header are kept, and the
+		// records are updated from the header.
+		_, err = store.Stat(ctx, satellite, notExpired)
+		require.NoError(t, err)
+		_, err = store.Stat(ctx, satellite, noExpiry)
+		require.NoError(t, err)
+
+		infos, err := store.GetExpired(ctx, now, 10)
+		require.NoError(t, err)
+		require.Empty(t, infos)
+
+		infos, err = store.GetExpired(ctx, extendedExp.Add(time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, infos, 1)
+		require.Equal(t, notExpired, infos[0].PieceID)
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/forgetsatellite"
+)
+
+// ErrForgetSatelliteAPI - console forget satellite api error type.
+var ErrForgetSatelliteAPI = errs.Class("consoleapi forget satellite")
+
+// ForgetSatellite is an api controller that exposes the removal of satellites.
+type ForgetSatellite struct {
+	service *forgetsatellite.Service
+
+	log *zap.Logger
+}
+
+// NewForgetSatellite is a constructor for forget satellite controller.
+func NewForgetSatellite(log *zap.Logger, service *forgetsatellite.Service) *ForgetSatellite {
+	return &ForgetSatellite{
+		log:     log,
+		service: service,
+	}
+}
+
+// ForgetSatellite stops trusting the satellite and moves all its data to the trash.
+func (controller *ForgetSatellite) ForgetSatellite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	params := mux.Vars(r)
+	id, ok := params["id"]
+	if !ok {
+		controller.serveJSONError(w, http.StatusBadRequest, ErrForgetSatelliteAPI.New("missing satellite id"))
+		return
+	}
+
+	satelliteID, err := storj.NodeIDFromString(id)
+	if err != nil {
+		controller.serveJSONError(w, http.StatusBadRequest, ErrForgetSatelliteAPI.Wrap(err))
+		return
+	}
+
+	if err = controller.service.ForgetSatellite(ctx, satelliteID); err != nil {
+		controller.serveJSONError(w, http.StatusInternalServerError, ErrForgetSatelliteAPI.Wrap(err))
+		return
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (controller *ForgetSatellite) serveJSONError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(ErrForgetSatelliteAPI.Wrap(err)))
+		return
+	}
+}
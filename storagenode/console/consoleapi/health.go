@@ -0,0 +1,73 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/healthcheck"
+)
+
+// ErrHealthAPI - console health api error type.
+var ErrHealthAPI = errs.Class("consoleapi health")
+
+// Health is an api controller that exposes the health checks of the node,
+// for uptime monitors and orchestrator probes.
+type Health struct {
+	log     *zap.Logger
+	service *healthcheck.Service
+}
+
+// NewHealth is a constructor for the health controller.
+func NewHealth(log *zap.Logger, service *healthcheck.Service) *Health {
+	return &Health{
+		log:     log,
+		service: service,
+	}
+}
+
+// Health returns the report of the last health checks. The response status
+// is 503 Service Unavailable when any check is critical.
+func (health *Health) Health(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	report := health.service.Report()
+
+	status := http.StatusOK
+	if report.Status == healthcheck.StatusCritical {
+		status = http.StatusServiceUnavailable
+	}
+	health.serveReport(w, status, report)
+}
+
+// Ready returns the report of the last health checks. The response status is
+// 503 Service Unavailable until the checks have run, or when any check is critical.
+func (health *Health) Ready(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	report := health.service.Report()
+
+	status := http.StatusOK
+	if !report.Ready() {
+		status = http.StatusServiceUnavailable
+	}
+	health.serveReport(w, status, report)
+}
+
+func (health *Health) serveReport(w http.ResponseWriter, status int, report healthcheck.Report) {
+	w.Header().Set(contentType, applicationJSON)
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		health.log.Error("failed to encode json response", zap.Error(ErrHealthAPI.Wrap(err)))
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode/healthcheck"
+)
+
+func TestHealthApi(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sno := planet.StorageNodes[0]
+		baseURL := fmt.Sprintf("http://%s", sno.Console.Listener.Addr())
+
+		get := func(path string) (int, healthcheck.Report) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+path, nil)
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, res.Body.Close()) }()
+
+			var report healthcheck.Report
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&report))
+			return res.StatusCode, report
+		}
+
+		sno.Contact.Chore.TriggerWait(ctx)
+		sno.Healthcheck.Service.Loop.TriggerWait()
+
+		status, report := get("/health")
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, healthcheck.StatusOK, report.Status)
+		require.Len(t, report.Checks, 4)
+		for _, check := range report.Checks {
+			require.Equal(t, healthcheck.StatusOK, check.Status, check.Name)
+		}
+
+		status, report = get("/ready")
+		require.Equal(t, http.StatusOK, status)
+		require.True(t, report.Ready())
+	})
+}
@@ -21,6 +21,8 @@ import (
 	"storj.io/storj/private/web"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleapi"
+	"storj.io/storj/storagenode/forgetsatellite"
+	"storj.io/storj/storagenode/healthcheck"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/payouts"
 )
@@ -46,6 +48,8 @@ type Server struct {
 	service       *console.Service
 	notifications *notifications.Service
 	payout        *payouts.Service
+	health        *healthcheck.Service
+	forget        *forgetsatellite.Service
 	listener      net.Listener
 	assets        fs.FS
 
@@ -53,7 +57,7 @@ type Server struct {
 }
 
 // NewServer creates new instance of storagenode console web server.
-func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Service, service *console.Service, payout *payouts.Service, listener net.Listener) *Server {
+func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Service, service *console.Service, payout *payouts.Service, health *healthcheck.Service, forget *forgetsatellite.Service, listener net.Listener) *Server {
 	server := Server{
 		log:           logger,
 		service:       service,
@@ -61,6 +65,8 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 		assets:        assets,
 		notifications: notifications,
 		payout:        payout,
+		health:        health,
+		forget:        forget,
 	}
 
 	router := mux.NewRouter()
@@ -74,6 +80,9 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 	storageNodeRouter.HandleFunc("/satellite/{id}", storageNodeController.Satellite).Methods(http.MethodGet)
 	storageNodeRouter.HandleFunc("/estimated-payout", storageNodeController.EstimatedPayout).Methods(http.MethodGet)
 
+	forgetSatelliteController := consoleapi.NewForgetSatellite(server.log, server.forget)
+	storageNodeRouter.HandleFunc("/satellite/{id}/forget", forgetSatelliteController.ForgetSatellite).Methods(http.MethodPost)
+
 	notificationController := consoleapi.NewNotifications(server.log, server.notifications)
 	notificationRouter := router.PathPrefix("/api/notifications").Subrouter()
 	notificationRouter.StrictSlash(true)
@@ -90,6 +99,10 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 	payoutRouter.HandleFunc("/periods", payoutController.HeldAmountPeriods).Methods(http.MethodGet)
 	payoutRouter.HandleFunc("/payout-history/{period}", payoutController.PayoutHistory).Methods(http.MethodGet)
 
+	healthController := consoleapi.NewHealth(server.log, server.health)
+	router.HandleFunc("/health", healthController.Health).Methods(http.MethodGet)
+	router.HandleFunc("/ready", healthController.Ready).Methods(http.MethodGet)
+
 	staticServer := http.FileServer(http.FS(server.assets))
 	router.PathPrefix("/static/").Handler(web.CacheHandler(staticServer))
 	router.PathPrefix("/").HandlerFunc(server.appHandler)
@@ -5,8 +5,10 @@ package console
 
 // DiskSpaceInfo stores all info about storagenode disk space usage.
 type DiskSpaceInfo struct {
-	Used      int64 `json:"used"`
-	Available int64 `json:"available"`
-	Trash     int64 `json:"trash"`
-	Overused  int64 `json:"overused"`
+	Used       int64  `json:"used"`
+	Available  int64  `json:"available"`
+	Trash      int64  `json:"trash"`
+	Overused   int64  `json:"overused"`
+	Configured int64  `json:"configured"`
+	Warning    string `json:"warning"`
 }
@@ -19,6 +19,7 @@ import (
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/operator"
 	"storj.io/storj/storagenode/payouts/estimatedpayouts"
 	"storj.io/storj/storagenode/pieces"
@@ -158,6 +159,8 @@ type Dashboard struct {
 
 	ConfiguredPort string `json:"configuredPort"`
 	QUICEnabled    bool   `json:"quicEnabled"`
+
+	Reachability contact.Reachability `json:"reachability"`
 }
 
 // GetDashboardData returns stale dashboard data.
@@ -177,6 +180,8 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 	data.QUICEnabled = s.quicEnabled
 	data.ConfiguredPort = s.configuredPort
 
+	data.Reachability = s.contact.Reachability()
+
 	stats, err := s.reputationDB.All(ctx)
 	if err != nil {
 		return nil, SNOServiceErr.Wrap(err)
@@ -222,13 +227,23 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 		return nil, SNOServiceErr.Wrap(err)
 	}
 
+	storageStatus, err := s.pieceStore.StorageStatus(ctx)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
+	// the allocated space is reduced when it doesn't fit on the disk anymore.
+	allocated, warning := monitor.EffectiveAllocation(s.allocatedDiskSpace.Int64(), pieceTotal+trash, storageStatus)
+
 	data.DiskSpace = DiskSpaceInfo{
-		Used:      pieceTotal,
-		Available: s.allocatedDiskSpace.Int64(),
-		Trash:     trash,
+		Used:       pieceTotal,
+		Available:  allocated,
+		Trash:      trash,
+		Configured: s.allocatedDiskSpace.Int64(),
+		Warning:    string(warning),
 	}
 
-	overused := s.allocatedDiskSpace.Int64() - pieceTotal - trash
+	overused := allocated - pieceTotal - trash
 	if overused < 0 {
 		data.DiskSpace.Overused = int64(math.Abs(float64(overused)))
 	}
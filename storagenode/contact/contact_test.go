@@ -19,6 +19,7 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/storagenode/contact"
 )
 
 func TestStoragenodeContactEndpoint(t *testing.T) {
@@ -115,6 +116,12 @@ func TestServicePingSatellites(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, newCapacity, info.Capacity)
 		}
+
+		// the reachability is resolved with the check-in.
+		require.Equal(t, contact.Reachability{
+			Address: node.Contact.Service.Local().Address,
+			IPv4:    true,
+		}, node.Contact.Service.Reachability())
 	})
 }
 
@@ -0,0 +1,48 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"net"
+)
+
+// Reachability describes over which IP versions the node is advertised to
+// the satellites.
+type Reachability struct {
+	Address string `json:"address"`
+	IPv4    bool   `json:"ipv4"`
+	IPv6    bool   `json:"ipv6"`
+}
+
+// ResolveReachability resolves the advertised address of the node and returns
+// over which IP versions it can be dialed. A host name with both A and AAAA
+// records makes the node reachable over both IP versions.
+func ResolveReachability(ctx context.Context, address string) (_ Reachability, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reachability := Reachability{Address: address}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return reachability, Error.Wrap(err)
+	}
+
+	ipAddrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return reachability, Error.Wrap(err)
+	}
+
+	for _, ipAddr := range ipAddrs {
+		if ipAddr.IP.IsUnspecified() {
+			continue
+		}
+		if ipAddr.IP.To4() != nil {
+			reachability.IPv4 = true
+		} else {
+			reachability.IPv6 = true
+		}
+	}
+	return reachability, nil
+}
@@ -0,0 +1,34 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/storagenode/contact"
+)
+
+func TestResolveReachability(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	reachability, err := contact.ResolveReachability(ctx, "8.8.8.8:28967")
+	require.NoError(t, err)
+	require.Equal(t, contact.Reachability{Address: "8.8.8.8:28967", IPv4: true}, reachability)
+
+	reachability, err = contact.ResolveReachability(ctx, "[2001:db8::1]:28967")
+	require.NoError(t, err)
+	require.Equal(t, contact.Reachability{Address: "[2001:db8::1]:28967", IPv6: true}, reachability)
+
+	// listening on all the interfaces doesn't advertise any address.
+	reachability, err = contact.ResolveReachability(ctx, "[::]:28967")
+	require.NoError(t, err)
+	require.Equal(t, contact.Reachability{Address: "[::]:28967"}, reachability)
+
+	_, err = contact.ResolveReachability(ctx, "8.8.8.8")
+	require.Error(t, err)
+}
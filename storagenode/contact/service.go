@@ -18,6 +18,7 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -58,25 +59,38 @@ type Service struct {
 	mu   sync.Mutex
 	self NodeInfo
 
-	trust *trust.Pool
+	// lastCheckIns is when each satellite was last reached by a check-in.
+	lastCheckIns map[storj.NodeID]time.Time
+	// reachability is resolved once per check-in, so that the dashboard
+	// doesn't do a DNS lookup on every request.
+	reachability Reachability
+
+	trust      *trust.Pool
+	egressCaps *bandwidth.Caps
 
 	initialized sync2.Fence
 }
 
 // NewService creates a new contact service.
-func NewService(log *zap.Logger, dialer rpc.Dialer, self NodeInfo, trust *trust.Pool) *Service {
+func NewService(log *zap.Logger, dialer rpc.Dialer, self NodeInfo, trust *trust.Pool, egressCaps *bandwidth.Caps) *Service {
 	return &Service{
-		log:    log,
-		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
-		dialer: dialer,
-		trust:  trust,
-		self:   self,
+		log:        log,
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
+		dialer:     dialer,
+		trust:      trust,
+		egressCaps: egressCaps,
+		self:       self,
+
+		lastCheckIns: make(map[storj.NodeID]time.Time),
+		reachability: Reachability{Address: self.Address},
 	}
 }
 
 // PingSatellites attempts to ping all satellites in trusted list until backoff reaches maxInterval.
 func (service *Service) PingSatellites(ctx context.Context, maxInterval time.Duration) (err error) {
 	defer mon.Task()(&ctx)(&err)
+	service.updateReachability(ctx)
+
 	satellites := service.trust.GetSatellites(ctx)
 	var group errgroup.Group
 	for _, satellite := range satellites {
@@ -126,15 +140,33 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 	defer func() { err = errs.Combine(err, conn.Close()) }()
 
 	self := service.Local()
+
+	// report the egress left for the satellite when it's capped, so that it
+	// stops sending downloads once the cap is reached.
+	capacity := self.Capacity
+	remaining, capped, err := service.egressCaps.Remaining(ctx, id)
+	if err != nil {
+		service.log.Warn("failed to check egress cap", zap.Stringer("Satellite ID", id), zap.Error(err))
+	} else if capped {
+		//lint:ignore SA1019 deprecated is fine here.
+		//nolint:staticcheck // deprecated is fine here.
+		capacity.FreeBandwidth = remaining
+	}
+
 	resp, err := pb.NewDRPCNodeClient(conn).CheckIn(ctx, &pb.CheckInRequest{
 		Address:  self.Address,
 		Version:  &self.Version,
-		Capacity: &self.Capacity,
+		Capacity: &capacity,
 		Operator: &self.Operator,
 	})
 	if err != nil {
 		return errPingSatellite.Wrap(err)
 	}
+
+	service.mu.Lock()
+	service.lastCheckIns[id] = time.Now()
+	service.mu.Unlock()
+
 	if resp != nil && !resp.PingNodeSuccess {
 		return errPingSatellite.New("%s", resp.PingErrorMessage)
 	}
@@ -213,6 +245,43 @@ func (service *Service) Local() NodeInfo {
 	return service.self
 }
 
+// Reachability returns over which IP versions the node was reachable at the
+// last check-in.
+func (service *Service) Reachability() Reachability {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.reachability
+}
+
+// updateReachability resolves the address of the node. The last known
+// reachability is kept when the address can't be resolved.
+func (service *Service) updateReachability(ctx context.Context) {
+	address := service.Local().Address
+
+	reachability, err := ResolveReachability(ctx, address)
+	if err != nil {
+		service.log.Warn("unable to resolve the node address", zap.String("Address", address), zap.Error(err))
+		return
+	}
+
+	service.mu.Lock()
+	service.reachability = reachability
+	service.mu.Unlock()
+}
+
+// LastCheckIns returns when each satellite was last reached by a check-in.
+// Satellites, which haven't been reached since the node started, are missing.
+func (service *Service) LastCheckIns() map[storj.NodeID]time.Time {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	lastCheckIns := make(map[storj.NodeID]time.Time, len(service.lastCheckIns))
+	for id, checkedIn := range service.lastCheckIns {
+		lastCheckIns[id] = checkedIn
+	}
+	return lastCheckIns
+}
+
 // UpdateSelf updates the local node with the capacity.
 func (service *Service) UpdateSelf(capacity *pb.NodeCapacity) {
 	service.mu.Lock()
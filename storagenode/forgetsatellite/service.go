@@ -0,0 +1,122 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package forgetsatellite
+
+import (
+	"context"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/payouts"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/piecestore/usedserials"
+	"storj.io/storj/storagenode/pricing"
+	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/storageusage"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for forget satellite package.
+	Error = errs.Class("forgetsatellite")
+
+	mon = monkit.Package()
+)
+
+// Service removes a satellite, which was decommissioned or is no longer
+// trusted, from the storage node.
+//
+// architecture: Service
+type Service struct {
+	log         *zap.Logger
+	store       *pieces.Store
+	trust       *trust.Pool
+	usedSerials *usedserials.Table
+	ordersStore *orders.FileStore
+
+	satellitesDB   satellites.DB
+	reputationDB   reputation.DB
+	payoutsDB      payouts.DB
+	pricingDB      pricing.DB
+	storageUsageDB storageusage.DB
+}
+
+// NewService creates a new forget satellite service. trust and usedSerials
+// can be nil when the storage node isn't running.
+func NewService(log *zap.Logger, store *pieces.Store, trust *trust.Pool, usedSerials *usedserials.Table, ordersStore *orders.FileStore, satellitesDB satellites.DB, reputationDB reputation.DB, payoutsDB payouts.DB, pricingDB pricing.DB, storageUsageDB storageusage.DB) *Service {
+	return &Service{
+		log:            log,
+		store:          store,
+		trust:          trust,
+		usedSerials:    usedSerials,
+		ordersStore:    ordersStore,
+		satellitesDB:   satellitesDB,
+		reputationDB:   reputationDB,
+		payoutsDB:      payoutsDB,
+		pricingDB:      pricingDB,
+		storageUsageDB: storageUsageDB,
+	}
+}
+
+// ForgetSatellite stops trusting the satellite, moves all its pieces to the
+// trash and deletes its unsent orders, used serials, piece expirations,
+// reputation, payouts, pricing and storage usage.
+//
+// The satellite is marked as forgotten before anything is deleted, so the
+// traffic of the satellite isn't accepted anymore, even after a restart, and
+// ForgetSatellite can be called again when it fails midway.
+func (service *Service) ForgetSatellite(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx, satelliteID)(&err)
+
+	log := service.log.With(zap.Stringer("Satellite ID", satelliteID))
+
+	if err := service.satellitesDB.ForgetSatellite(ctx, satelliteID); err != nil {
+		return Error.Wrap(err)
+	}
+	if service.trust != nil {
+		service.trust.ForgetSatellite(ctx, satelliteID)
+	}
+	log.Info("Satellite is forgotten, its traffic is no longer accepted")
+
+	var trashed, failed int64
+	err = service.store.WalkSatellitePieces(ctx, satelliteID, func(access pieces.StoredPieceAccess) error {
+		if err := service.store.Trash(ctx, satelliteID, access.PieceID()); err != nil {
+			log.Debug("failed to trash piece", zap.Stringer("Piece ID", access.PieceID()), zap.Error(err))
+			failed++
+			return nil
+		}
+		trashed++
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if failed > 0 {
+		return Error.New("failed to trash %d pieces", failed)
+	}
+	log.Info("Moved the pieces of the satellite to the trash", zap.Int64("count", trashed))
+
+	if service.usedSerials != nil {
+		service.usedSerials.DeleteSatellite(satelliteID)
+	}
+
+	var group errs.Group
+	group.Add(service.ordersStore.DeleteUnsentBySatellite(satelliteID))
+	group.Add(service.store.DeleteSatelliteExpirations(ctx, satelliteID))
+	group.Add(service.reputationDB.Delete(ctx, satelliteID))
+	group.Add(service.payoutsDB.DeleteSatellite(ctx, satelliteID))
+	group.Add(service.pricingDB.Delete(ctx, satelliteID))
+	group.Add(service.storageUsageDB.Delete(ctx, satelliteID))
+	if err := group.Err(); err != nil {
+		return Error.Wrap(err)
+	}
+	log.Info("Deleted the unsent orders, piece expirations, reputation, payouts, pricing and storage usage of the satellite")
+
+	return nil
+}
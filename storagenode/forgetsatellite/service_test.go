@@ -0,0 +1,173 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package forgetsatellite_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/forgetsatellite"
+	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/orders/ordersfile"
+	"storj.io/storj/storagenode/payouts"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/piecestore/usedserials"
+	"storj.io/storj/storagenode/pricing"
+	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+	"storj.io/storj/storagenode/storageusage"
+	"storj.io/storj/storagenode/trust"
+)
+
+func TestForgetSatellite(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+
+		dir, err := filestore.NewDir(log, ctx.Dir("store"))
+		require.NoError(t, err)
+
+		blobs := filestore.New(log, dir, filestore.DefaultConfig)
+		defer ctx.Check(blobs.Close)
+
+		store := pieces.NewStore(log, blobs, db.V0PieceInfo(), db.PieceExpirationDB(), nil, pieces.DefaultConfig)
+		usedSerials := usedserials.NewTable(memory.MiB)
+		ordersStore, err := orders.NewFileStore(log, ctx.Dir("orders"), time.Hour)
+		require.NoError(t, err)
+
+		forgotten, kept := testrand.NodeID(), testrand.NodeID()
+
+		pool, err := trust.NewPool(log, trust.Dialer(rpc.Dialer{}), trust.Config{
+			Sources: []trust.Source{
+				&trust.StaticURLSource{URL: trust.SatelliteURL{ID: forgotten, Host: "127.0.0.1", Port: 7777}},
+				&trust.StaticURLSource{URL: trust.SatelliteURL{ID: kept, Host: "127.0.0.1", Port: 7778}},
+			},
+			CachePath: ctx.File("trust-cache.json"),
+		}, db.Satellites())
+		require.NoError(t, err)
+		require.NoError(t, pool.Refresh(ctx))
+
+		now := time.Now().UTC()
+		period := now.Format("2006-01")
+
+		piecesBySatellite := make(map[storj.NodeID]storj.PieceID)
+		serialsBySatellite := make(map[storj.NodeID]storj.SerialNumber)
+		for _, satelliteID := range []storj.NodeID{forgotten, kept} {
+			pieceID := testrand.PieceID()
+			writer, err := store.Writer(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+			piecesBySatellite[satelliteID] = pieceID
+			require.NoError(t, store.SetExpiration(ctx, satelliteID, pieceID, now.Add(time.Hour)))
+
+			serialNumber := testrand.SerialNumber()
+			require.NoError(t, usedSerials.Add(satelliteID, serialNumber, now.Add(time.Hour)))
+			serialsBySatellite[satelliteID] = serialNumber
+			require.NoError(t, ordersStore.Enqueue(&ordersfile.Info{
+				Limit: &pb.OrderLimit{
+					SerialNumber:    serialNumber,
+					SatelliteId:     satelliteID,
+					Action:          pb.PieceAction_GET,
+					OrderCreation:   now,
+					OrderExpiration: now.Add(time.Hour),
+				},
+				Order: &pb.Order{
+					SerialNumber: serialNumber,
+					Amount:       10,
+				},
+			}))
+
+			require.NoError(t, db.Satellites().SetAddress(ctx, satelliteID, "127.0.0.1:7777"))
+			require.NoError(t, db.Reputation().Store(ctx, reputation.Stats{SatelliteID: satelliteID}))
+			require.NoError(t, db.Pricing().Store(ctx, pricing.Pricing{SatelliteID: satelliteID, DiskSpace: 150}))
+			require.NoError(t, db.StorageUsage().Store(ctx, []storageusage.Stamp{{SatelliteID: satelliteID, AtRestTotal: 10, IntervalStart: now}}))
+			require.NoError(t, db.Payout().StorePayStub(ctx, payouts.PayStub{SatelliteID: satelliteID, Period: period, Created: now}))
+		}
+
+		service := forgetsatellite.NewService(log, store, pool, usedSerials, ordersStore,
+			db.Satellites(), db.Reputation(), db.Payout(), db.Pricing(), db.StorageUsage())
+		require.NoError(t, service.ForgetSatellite(ctx, forgotten))
+
+		// the satellite isn't trusted anymore, even after a refresh.
+		require.Error(t, pool.VerifySatelliteID(ctx, forgotten))
+		require.NoError(t, pool.Refresh(ctx))
+		require.Error(t, pool.VerifySatelliteID(ctx, forgotten))
+		require.NoError(t, pool.VerifySatelliteID(ctx, kept))
+
+		satellite, err := db.Satellites().GetSatellite(ctx, forgotten)
+		require.NoError(t, err)
+		require.EqualValues(t, satellites.Forgotten, satellite.Status)
+
+		// the piece of the forgotten satellite is in the trash.
+		_, err = store.Reader(ctx, forgotten, piecesBySatellite[forgotten])
+		require.True(t, errors.Is(err, os.ErrNotExist))
+		require.NoError(t, store.RestoreTrash(ctx, forgotten))
+		reader, err := store.Reader(ctx, forgotten, piecesBySatellite[forgotten])
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		reader, err = store.Reader(ctx, kept, piecesBySatellite[kept])
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		// only the unsent orders, used serials and piece expirations of the
+		// kept satellite remain.
+		unsent, err := ordersStore.ListUnsentBySatellite(ctx, now.Add(3*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, unsent, 1)
+		require.Contains(t, unsent, kept)
+
+		require.False(t, usedSerials.Exists(forgotten, serialsBySatellite[forgotten], now.Add(time.Hour)))
+		require.True(t, usedSerials.Exists(kept, serialsBySatellite[kept], now.Add(time.Hour)))
+		require.Equal(t, 1, usedSerials.Count())
+
+		expired, err := store.GetExpired(ctx, now.Add(2*time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, expired, 1)
+		require.Equal(t, kept, expired[0].SatelliteID)
+
+		// only the rows of the kept satellite remain.
+		stats, err := db.Reputation().All(ctx)
+		require.NoError(t, err)
+		require.Len(t, stats, 1)
+		require.Equal(t, kept, stats[0].SatelliteID)
+
+		forgottenPricing, err := db.Pricing().Get(ctx, forgotten)
+		require.NoError(t, err)
+		require.Zero(t, forgottenPricing.DiskSpace)
+		keptPricing, err := db.Pricing().Get(ctx, kept)
+		require.NoError(t, err)
+		require.EqualValues(t, 150, keptPricing.DiskSpace)
+
+		stamps, err := db.StorageUsage().GetDaily(ctx, forgotten, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, stamps)
+		stamps, err = db.StorageUsage().GetDaily(ctx, kept, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, stamps, 1)
+
+		paystubs, err := db.Payout().AllPayStubs(ctx, period)
+		require.NoError(t, err)
+		require.Len(t, paystubs, 1)
+		require.Equal(t, kept, paystubs[0].SatelliteID)
+
+		// forgetting a satellite again is not an error.
+		require.NoError(t, service.ForgetSatellite(ctx, forgotten))
+	})
+}
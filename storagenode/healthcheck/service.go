@@ -0,0 +1,237 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/preflight"
+	"storj.io/storj/storagenode/trust"
+)
+
+var mon = monkit.Package()
+
+// Config contains configurable values for the health checks.
+type Config struct {
+	Interval      time.Duration `help:"how often the health checks are run" default:"1m0s"`
+	MaxCheckInAge time.Duration `help:"how long ago the last check-in with a satellite can be, before the satellite is reported unreachable" releaseDefault:"3h0m0s" devDefault:"5m0s"`
+	MaxClockSkew  time.Duration `help:"maximum difference between the local clock and the satellites clock, before the clock is reported out of sync" default:"10m0s"`
+}
+
+// Status is the status level of a health check.
+type Status string
+
+const (
+	// StatusUnknown is used before the check has run.
+	StatusUnknown = Status("unknown")
+	// StatusOK is used when the check passed.
+	StatusOK = Status("ok")
+	// StatusWarning is used when the node works, but it needs the attention of the operator.
+	StatusWarning = Status("warning")
+	// StatusCritical is used when the node can't work properly.
+	StatusCritical = Status("critical")
+)
+
+// severity orders the status levels from the best to the worst.
+func (status Status) severity() int {
+	switch status {
+	case StatusOK:
+		return 0
+	case StatusUnknown:
+		return 1
+	case StatusWarning:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// Check is the result of a single health check.
+type Check struct {
+	Name    string `json:"name"`
+	Status  Status `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// Report is the result of all the health checks.
+type Report struct {
+	// Status is the worst status of the checks.
+	Status    Status    `json:"status"`
+	CheckedAt time.Time `json:"checkedAt"`
+	Checks    []Check   `json:"checks"`
+}
+
+// Ready returns whether the node is ready to serve requests, which is when the
+// health checks have run and none of them is critical.
+func (report Report) Ready() bool {
+	return !report.CheckedAt.IsZero() && report.Status != StatusCritical
+}
+
+// DB is the storage node database, which integrity is checked.
+type DB interface {
+	// CheckIntegrity checks the integrity of the databases, without modifying them.
+	CheckIntegrity(ctx context.Context) error
+}
+
+// Service periodically checks the health of the storage node.
+//
+// architecture: Service
+type Service struct {
+	log       *zap.Logger
+	db        DB
+	store     *pieces.Store
+	trust     *trust.Pool
+	contact   *contact.Service
+	localTime *preflight.LocalTime
+	config    Config
+
+	Loop *sync2.Cycle
+
+	mu     sync.Mutex
+	report Report
+}
+
+// NewService creates a new health check service.
+func NewService(log *zap.Logger, db DB, store *pieces.Store, trust *trust.Pool, contact *contact.Service, localTime *preflight.LocalTime, config Config) *Service {
+	return &Service{
+		log:       log,
+		db:        db,
+		store:     store,
+		trust:     trust,
+		contact:   contact,
+		localTime: localTime,
+		config:    config,
+		Loop:      sync2.NewCycle(config.Interval),
+		report: Report{
+			Status: StatusUnknown,
+		},
+	}
+}
+
+// Run runs the health checks every interval.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		report := service.Check(ctx)
+		if report.Status != StatusOK {
+			service.log.Warn("health check failed", zap.String("Status", string(report.Status)), zap.Any("Checks", report.Checks))
+		}
+		return nil
+	})
+}
+
+// Check runs all the health checks and returns their report, which is also
+// returned by Report until the next check.
+func (service *Service) Check(ctx context.Context) Report {
+	checks := []Check{
+		service.checkDatabase(ctx),
+		service.checkDisk(ctx),
+		service.checkSatellites(ctx),
+		service.checkClock(ctx),
+	}
+
+	report := Report{
+		Status:    StatusOK,
+		CheckedAt: time.Now(),
+		Checks:    checks,
+	}
+	for _, check := range checks {
+		if check.Status.severity() > report.Status.severity() {
+			report.Status = check.Status
+		}
+		mon.Event("healthcheck_status", monkit.NewSeriesTag("check", check.Name), monkit.NewSeriesTag("status", string(check.Status)))
+	}
+
+	service.mu.Lock()
+	service.report = report
+	service.mu.Unlock()
+
+	return report
+}
+
+// Report returns the report of the last health checks.
+func (service *Service) Report() Report {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.report
+}
+
+// Close stops the health checks.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+func (service *Service) checkDatabase(ctx context.Context) Check {
+	check := Check{Name: "database", Status: StatusOK}
+	if err := service.db.CheckIntegrity(ctx); err != nil {
+		check.Status = StatusCritical
+		check.Message = err.Error()
+	}
+	return check
+}
+
+func (service *Service) checkDisk(ctx context.Context) Check {
+	check := Check{Name: "disk", Status: StatusOK}
+	if err := service.store.CheckWritability(ctx); err != nil {
+		check.Status = StatusCritical
+		check.Message = err.Error()
+	}
+	return check
+}
+
+func (service *Service) checkSatellites(ctx context.Context) Check {
+	check := Check{Name: "satellites", Status: StatusOK}
+
+	satellites := service.trust.GetSatellites(ctx)
+	if len(satellites) == 0 {
+		check.Status = StatusCritical
+		check.Message = "no trusted satellites"
+		return check
+	}
+
+	lastCheckIns := service.contact.LastCheckIns()
+	unreachable := 0
+	for _, satellite := range satellites {
+		checkedIn, ok := lastCheckIns[satellite]
+		if !ok || time.Since(checkedIn) > service.config.MaxCheckInAge {
+			unreachable++
+		}
+	}
+
+	switch {
+	case unreachable == len(satellites):
+		check.Status = StatusCritical
+		check.Message = "no satellite was reached recently"
+	case unreachable > 0:
+		check.Status = StatusWarning
+		check.Message = fmt.Sprintf("%d of %d satellites were not reached recently", unreachable, len(satellites))
+	}
+	return check
+}
+
+func (service *Service) checkClock(ctx context.Context) Check {
+	check := Check{Name: "clock", Status: StatusOK}
+
+	skew, err := service.localTime.Skew(ctx)
+	switch {
+	case err != nil:
+		check.Status = StatusUnknown
+		check.Message = err.Error()
+	case skew > service.config.MaxClockSkew:
+		check.Status = StatusWarning
+		check.Message = fmt.Sprintf("system clock is off by %s", skew.Round(time.Second))
+	}
+	return check
+}
@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pieceinventory.proto
+
+package internalpb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ListPiecesRequest struct {
+	// only pieces with an id greater than the cursor are listed.
+	Cursor PieceID `protobuf:"bytes,1,opt,name=cursor,proto3,customtype=PieceID" json:"cursor"`
+	// maximum number of pieces to list, the storagenode may list less.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// when set, only pieces created at or after it are listed.
+	CreatedAfter *time.Time `protobuf:"bytes,3,opt,name=created_after,json=createdAfter,proto3,stdtime" json:"created_after,omitempty"`
+	// when set, only pieces created before it are listed.
+	CreatedBefore        *time.Time `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3,stdtime" json:"created_before,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListPiecesRequest) Reset()         { *m = ListPiecesRequest{} }
+func (m *ListPiecesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPiecesRequest) ProtoMessage()    {}
+func (*ListPiecesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92e895c40f9529df, []int{0}
+}
+func (m *ListPiecesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPiecesRequest.Unmarshal(m, b)
+}
+func (m *ListPiecesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPiecesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPiecesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPiecesRequest.Merge(m, src)
+}
+func (m *ListPiecesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPiecesRequest.Size(m)
+}
+func (m *ListPiecesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPiecesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPiecesRequest proto.InternalMessageInfo
+
+func (m *ListPiecesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListPiecesRequest) GetCreatedAfter() *time.Time {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return nil
+}
+
+func (m *ListPiecesRequest) GetCreatedBefore() *time.Time {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return nil
+}
+
+type ListPiecesResponse struct {
+	// piece ids in ascending order.
+	PieceIds []PieceID `protobuf:"bytes,1,rep,name=piece_ids,json=pieceIds,proto3,customtype=PieceID" json:"piece_ids"`
+	// set on the last response when there are pieces after the listed ones.
+	More                 bool     `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPiecesResponse) Reset()         { *m = ListPiecesResponse{} }
+func (m *ListPiecesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPiecesResponse) ProtoMessage()    {}
+func (*ListPiecesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92e895c40f9529df, []int{1}
+}
+func (m *ListPiecesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPiecesResponse.Unmarshal(m, b)
+}
+func (m *ListPiecesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPiecesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPiecesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPiecesResponse.Merge(m, src)
+}
+func (m *ListPiecesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPiecesResponse.Size(m)
+}
+func (m *ListPiecesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPiecesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPiecesResponse proto.InternalMessageInfo
+
+func (m *ListPiecesResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type ExistsRequest struct {
+	// piece ids to check, the storagenode may reject too large requests.
+	PieceIds             []PieceID `protobuf:"bytes,1,rep,name=piece_ids,json=pieceIds,proto3,customtype=PieceID" json:"piece_ids"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ExistsRequest) Reset()         { *m = ExistsRequest{} }
+func (m *ExistsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExistsRequest) ProtoMessage()    {}
+func (*ExistsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92e895c40f9529df, []int{2}
+}
+func (m *ExistsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExistsRequest.Unmarshal(m, b)
+}
+func (m *ExistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExistsRequest.Marshal(b, m, deterministic)
+}
+func (m *ExistsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExistsRequest.Merge(m, src)
+}
+func (m *ExistsRequest) XXX_Size() int {
+	return xxx_messageInfo_ExistsRequest.Size(m)
+}
+func (m *ExistsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExistsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExistsRequest proto.InternalMessageInfo
+
+type ExistsResponse struct {
+	// indexes of the requested piece ids, which are missing on the storagenode.
+	Missing              []uint32 `protobuf:"varint,1,rep,packed,name=missing,proto3" json:"missing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExistsResponse) Reset()         { *m = ExistsResponse{} }
+func (m *ExistsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExistsResponse) ProtoMessage()    {}
+func (*ExistsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92e895c40f9529df, []int{3}
+}
+func (m *ExistsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExistsResponse.Unmarshal(m, b)
+}
+func (m *ExistsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExistsResponse.Marshal(b, m, deterministic)
+}
+func (m *ExistsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExistsResponse.Merge(m, src)
+}
+func (m *ExistsResponse) XXX_Size() int {
+	return xxx_messageInfo_ExistsResponse.Size(m)
+}
+func (m *ExistsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExistsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExistsResponse proto.InternalMessageInfo
+
+func (m *ExistsResponse) GetMissing() []uint32 {
+	if m != nil {
+		return m.Missing
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListPiecesRequest)(nil), "storagenode.pieceinventory.ListPiecesRequest")
+	proto.RegisterType((*ListPiecesResponse)(nil), "storagenode.pieceinventory.ListPiecesResponse")
+	proto.RegisterType((*ExistsRequest)(nil), "storagenode.pieceinventory.ExistsRequest")
+	proto.RegisterType((*ExistsResponse)(nil), "storagenode.pieceinventory.ExistsResponse")
+}
+
+func init() { proto.RegisterFile("pieceinventory.proto", fileDescriptor_92e895c40f9529df) }
+
+var fileDescriptor_92e895c40f9529df = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbb, 0xce, 0xd3, 0x40,
+	0x10, 0x85, 0x59, 0x72, 0x65, 0x48, 0x82, 0x58, 0xa5, 0xb0, 0xdc, 0xd8, 0xb2, 0x84, 0x12, 0x22,
+	0x58, 0xa3, 0x50, 0x53, 0x60, 0x41, 0x11, 0x41, 0x81, 0x2c, 0x44, 0x41, 0x13, 0xd9, 0xf1, 0xc4,
+	0x5a, 0x14, 0x7b, 0xcd, 0xee, 0x06, 0xc1, 0x5b, 0xf0, 0x58, 0x79, 0x06, 0x8a, 0x40, 0xcd, 0x53,
+	0x20, 0xef, 0xc6, 0xfc, 0x89, 0xfe, 0x8b, 0x92, 0x6e, 0x46, 0x3e, 0xe7, 0xf8, 0x9b, 0x99, 0x85,
+	0x71, 0xc5, 0x71, 0x85, 0xbc, 0xfc, 0x86, 0xa5, 0x16, 0xf2, 0x07, 0xab, 0xa4, 0xd0, 0x82, 0xba,
+	0x4a, 0x0b, 0x99, 0xe4, 0x58, 0x8a, 0x0c, 0xd9, 0xa9, 0xc2, 0x85, 0x5c, 0xe4, 0xc2, 0xea, 0x5c,
+	0x2f, 0x17, 0x22, 0xdf, 0x60, 0x68, 0xba, 0x74, 0xbb, 0x0e, 0x35, 0x2f, 0x50, 0xe9, 0xa4, 0xa8,
+	0xac, 0x20, 0xf8, 0x4b, 0xe0, 0xf1, 0x7b, 0xae, 0xf4, 0x87, 0x3a, 0x43, 0xc5, 0xf8, 0x75, 0x8b,
+	0x4a, 0xd3, 0x09, 0x74, 0x57, 0x5b, 0xa9, 0x84, 0x74, 0x88, 0x4f, 0xa6, 0x83, 0xe8, 0xd1, 0x6e,
+	0xef, 0xdd, 0xfb, 0xb5, 0xf7, 0x7a, 0x46, 0xb6, 0x78, 0x13, 0x1f, 0x3e, 0xd3, 0x31, 0x74, 0x36,
+	0xbc, 0xe0, 0xda, 0xb9, 0xef, 0x93, 0x69, 0x27, 0xb6, 0x0d, 0x5d, 0xc0, 0x70, 0x25, 0x31, 0xd1,
+	0x98, 0x2d, 0x93, 0xb5, 0x46, 0xe9, 0xb4, 0x7c, 0x32, 0x7d, 0x38, 0x77, 0x99, 0xa5, 0x61, 0x0d,
+	0x0d, 0xfb, 0xd8, 0xd0, 0x44, 0xfd, 0xdd, 0xde, 0x23, 0x3f, 0x7f, 0x7b, 0x24, 0x1e, 0x1c, 0xac,
+	0xaf, 0x6b, 0x27, 0x7d, 0x07, 0xa3, 0x26, 0x2a, 0xc5, 0xb5, 0x90, 0xe8, 0xb4, 0x2f, 0xc8, 0x6a,
+	0x30, 0x22, 0x63, 0x0d, 0x3e, 0x01, 0x3d, 0x9e, 0x55, 0x55, 0xa2, 0x54, 0x48, 0x9f, 0xc1, 0x03,
+	0xb3, 0xc1, 0x25, 0xcf, 0x94, 0x43, 0xfc, 0xd6, 0x4d, 0xf3, 0xf6, 0x8d, 0x62, 0x91, 0x29, 0x4a,
+	0xa1, 0x5d, 0xd4, 0x18, 0xf5, 0xc0, 0xfd, 0xd8, 0xd4, 0xc1, 0x2b, 0x18, 0xbe, 0xfd, 0xce, 0x95,
+	0xfe, 0xbf, 0xbf, 0x8b, 0x22, 0x83, 0x19, 0x8c, 0x1a, 0xfb, 0x01, 0xc9, 0x81, 0x5e, 0xc1, 0x95,
+	0xe2, 0x65, 0x6e, 0xdc, 0xc3, 0xb8, 0x69, 0xe7, 0x7f, 0x08, 0x8c, 0x6c, 0x42, 0x73, 0x6f, 0x5a,
+	0x00, 0x5c, 0x4d, 0x45, 0x9f, 0xb3, 0xdb, 0x9f, 0x06, 0xbb, 0x76, 0x69, 0x97, 0x9d, 0x2b, 0xb7,
+	0x64, 0x2f, 0x08, 0x5d, 0x42, 0xd7, 0xd2, 0xd2, 0xa7, 0x77, 0x79, 0x4f, 0x16, 0xe2, 0xce, 0xce,
+	0x91, 0xda, 0x5f, 0x44, 0x93, 0xcf, 0x4f, 0x6a, 0xf1, 0x17, 0xc6, 0x45, 0x68, 0x8a, 0xf0, 0xc8,
+	0x1b, 0xf2, 0x52, 0xa3, 0x2c, 0x93, 0x4d, 0x95, 0xa6, 0x5d, 0x73, 0xfb, 0x97, 0xff, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0x11, 0x36, 0x77, 0x09, 0x23, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.32
+// source: pieceinventory.proto
+
+package internalpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_pieceinventory_proto struct{}
+
+func (drpcEncoding_File_pieceinventory_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_pieceinventory_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_pieceinventory_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_pieceinventory_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCPieceInventoryClient interface {
+	DRPCConn() drpc.Conn
+
+	ListPieces(ctx context.Context, in *ListPiecesRequest) (DRPCPieceInventory_ListPiecesClient, error)
+	Exists(ctx context.Context, in *ExistsRequest) (*ExistsResponse, error)
+}
+
+type drpcPieceInventoryClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCPieceInventoryClient(cc drpc.Conn) DRPCPieceInventoryClient {
+	return &drpcPieceInventoryClient{cc}
+}
+
+func (c *drpcPieceInventoryClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcPieceInventoryClient) ListPieces(ctx context.Context, in *ListPiecesRequest) (DRPCPieceInventory_ListPiecesClient, error) {
+	stream, err := c.cc.NewStream(ctx, "/storagenode.pieceinventory.PieceInventory/ListPieces", drpcEncoding_File_pieceinventory_proto{})
+	if err != nil {
+		return nil, err
+	}
+	x := &drpcPieceInventory_ListPiecesClient{stream}
+	if err := x.MsgSend(in, drpcEncoding_File_pieceinventory_proto{}); err != nil {
+		return nil, err
+	}
+	if err := x.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DRPCPieceInventory_ListPiecesClient interface {
+	drpc.Stream
+	Recv() (*ListPiecesResponse, error)
+}
+
+type drpcPieceInventory_ListPiecesClient struct {
+	drpc.Stream
+}
+
+func (x *drpcPieceInventory_ListPiecesClient) Recv() (*ListPiecesResponse, error) {
+	m := new(ListPiecesResponse)
+	if err := x.MsgRecv(m, drpcEncoding_File_pieceinventory_proto{}); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (x *drpcPieceInventory_ListPiecesClient) RecvMsg(m *ListPiecesResponse) error {
+	return x.MsgRecv(m, drpcEncoding_File_pieceinventory_proto{})
+}
+
+func (c *drpcPieceInventoryClient) Exists(ctx context.Context, in *ExistsRequest) (*ExistsResponse, error) {
+	out := new(ExistsResponse)
+	err := c.cc.Invoke(ctx, "/storagenode.pieceinventory.PieceInventory/Exists", drpcEncoding_File_pieceinventory_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCPieceInventoryServer interface {
+	ListPieces(*ListPiecesRequest, DRPCPieceInventory_ListPiecesStream) error
+	Exists(context.Context, *ExistsRequest) (*ExistsResponse, error)
+}
+
+type DRPCPieceInventoryUnimplementedServer struct{}
+
+func (s *DRPCPieceInventoryUnimplementedServer) ListPieces(*ListPiecesRequest, DRPCPieceInventory_ListPiecesStream) error {
+	return drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCPieceInventoryUnimplementedServer) Exists(context.Context, *ExistsRequest) (*ExistsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCPieceInventoryDescription struct{}
+
+func (DRPCPieceInventoryDescription) NumMethods() int { return 2 }
+
+func (DRPCPieceInventoryDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/storagenode.pieceinventory.PieceInventory/ListPieces", drpcEncoding_File_pieceinventory_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return nil, srv.(DRPCPieceInventoryServer).
+					ListPieces(
+						in1.(*ListPiecesRequest),
+						&drpcPieceInventory_ListPiecesStream{in2.(drpc.Stream)},
+					)
+			}, DRPCPieceInventoryServer.ListPieces, true
+	case 1:
+		return "/storagenode.pieceinventory.PieceInventory/Exists", drpcEncoding_File_pieceinventory_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCPieceInventoryServer).
+					Exists(
+						ctx,
+						in1.(*ExistsRequest),
+					)
+			}, DRPCPieceInventoryServer.Exists, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterPieceInventory(mux drpc.Mux, impl DRPCPieceInventoryServer) error {
+	return mux.Register(impl, DRPCPieceInventoryDescription{})
+}
+
+type DRPCPieceInventory_ListPiecesStream interface {
+	drpc.Stream
+	Send(*ListPiecesResponse) error
+}
+
+type drpcPieceInventory_ListPiecesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcPieceInventory_ListPiecesStream) Send(m *ListPiecesResponse) error {
+	return x.MsgSend(m, drpcEncoding_File_pieceinventory_proto{})
+}
+
+type DRPCPieceInventory_ExistsStream interface {
+	drpc.Stream
+	SendAndClose(*ExistsResponse) error
+}
+
+type drpcPieceInventory_ExistsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcPieceInventory_ExistsStream) SendAndClose(m *ExistsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_pieceinventory_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
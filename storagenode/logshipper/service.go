@@ -0,0 +1,318 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package logshipper
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"storj.io/common/storj"
+)
+
+var (
+	// Error is the default error class for the log shipper.
+	Error = errs.Class("logshipper")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for shipping the logs to remote
+// collectors.
+type Config struct {
+	SyslogAddress string        `help:"address (host:port) of the syslog server to ship the logs to over UDP, disabled when empty" default:""`
+	LokiURL       string        `help:"URL of the Loki push API to ship the logs to, e.g. http://loki:3100/loki/api/v1/push, disabled when empty" default:""`
+	Level         string        `help:"minimum level of the shipped logs" default:"info"`
+	BufferSize    int           `help:"maximum number of log entries waiting to be shipped, new entries are dropped when the buffer is full" default:"10000"`
+	BatchSize     int           `help:"maximum number of log entries shipped at once" default:"100"`
+	FlushInterval time.Duration `help:"how often the buffered log entries are shipped" default:"5s"`
+}
+
+// Enabled returns true when the logs are shipped to at least one collector.
+func (config Config) Enabled() bool {
+	return config.SyslogAddress != "" || config.LokiURL != ""
+}
+
+// entry is a log entry waiting to be shipped.
+type entry struct {
+	Time  time.Time
+	Level zapcore.Level
+	Line  string
+}
+
+// sink ships log entries to a remote collector.
+type sink interface {
+	Name() string
+	Ship(ctx context.Context, entries []entry) error
+	Close() error
+}
+
+// queue contains the entries waiting to be shipped to a sink.
+type queue struct {
+	sink    sink
+	pending []entry
+	failing bool
+}
+
+// Service ships the log entries of the node to remote collectors.
+//
+// The entries are buffered and shipped in batches, so that logging never
+// waits for the collectors. When the collectors can't keep up, the buffer
+// fills up and the new entries are dropped.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	config Config
+	level  zapcore.Level
+
+	entries chan entry
+
+	mu     sync.Mutex
+	queues []*queue
+}
+
+// NewService creates a new log shipper. log must not be wrapped with the
+// shipper, because the failures of the shipping are logged into it.
+func NewService(log *zap.Logger, config Config, nodeID storj.NodeID) (*Service, error) {
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(config.Level)); err != nil {
+		return nil, Error.New("invalid level %q: %w", config.Level, err)
+	}
+	if config.BufferSize <= 0 {
+		return nil, Error.New("buffer size must be positive")
+	}
+	if config.BatchSize <= 0 {
+		return nil, Error.New("batch size must be positive")
+	}
+	if config.FlushInterval <= 0 {
+		return nil, Error.New("flush interval must be positive")
+	}
+
+	service := &Service{
+		log:     log,
+		config:  config,
+		level:   level,
+		entries: make(chan entry, config.BufferSize),
+	}
+
+	if config.SyslogAddress != "" {
+		syslog, err := newSyslogSink(config.SyslogAddress)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		service.queues = append(service.queues, &queue{sink: syslog})
+	}
+	if config.LokiURL != "" {
+		service.queues = append(service.queues, &queue{sink: newLokiSink(config.LokiURL, map[string]string{
+			"job":     "storagenode",
+			"node_id": nodeID.String(),
+		})})
+	}
+
+	return service, nil
+}
+
+// Tee returns a core, which writes the entries into core and ships them too.
+// It's meant to be used with zap.WrapCore.
+func (service *Service) Tee(core zapcore.Core) zapcore.Core {
+	return zapcore.NewTee(core, &shipperCore{
+		service: service,
+		encoder: zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()),
+	})
+}
+
+// enqueue adds the entry to the buffer without waiting. The entry is dropped
+// when the buffer is full.
+func (service *Service) enqueue(e entry) {
+	select {
+	case service.entries <- e:
+	default:
+		mon.Counter("logshipper_dropped_entries").Inc(1)
+	}
+}
+
+// Run ships the buffered entries until the context is canceled.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ticker := time.NewTicker(service.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case e := <-service.entries:
+			service.add(ctx, e)
+
+		case <-ticker.C:
+			service.mu.Lock()
+			service.drain()
+			service.flush(ctx)
+			service.mu.Unlock()
+		}
+	}
+}
+
+// add adds the entry to every queue. The full batches are shipped right away,
+// except to the failing sinks, which are retried only with the flush interval.
+func (service *Service) add(ctx context.Context, e entry) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	for _, queue := range service.queues {
+		queue.pending = append(queue.pending, e)
+		if !queue.failing && len(queue.pending) >= service.config.BatchSize {
+			service.flushQueue(ctx, queue)
+		}
+	}
+}
+
+// drain moves the buffered entries to the queues.
+func (service *Service) drain() {
+	for {
+		select {
+		case e := <-service.entries:
+			for _, queue := range service.queues {
+				queue.pending = append(queue.pending, e)
+			}
+		default:
+			return
+		}
+	}
+}
+
+// flush ships the waiting entries of every queue in batches. The caller must
+// hold the mutex.
+func (service *Service) flush(ctx context.Context) {
+	defer mon.Task()(&ctx)(nil)
+
+	for _, queue := range service.queues {
+		service.flushQueue(ctx, queue)
+	}
+}
+
+// flushQueue ships the waiting entries of the queue in batches. The entries,
+// which couldn't be shipped, are retried with the next flush. The oldest
+// entries are dropped, when more than the buffer size of entries are waiting.
+func (service *Service) flushQueue(ctx context.Context, queue *queue) {
+	name := queue.sink.Name()
+
+	var err error
+	for len(queue.pending) > 0 {
+		batch := queue.pending
+		if len(batch) > service.config.BatchSize {
+			batch = batch[:service.config.BatchSize]
+		}
+
+		err = queue.sink.Ship(ctx, batch)
+		if err != nil {
+			mon.Counter("logshipper_failures", monkit.NewSeriesTag("sink", name)).Inc(1)
+			break
+		}
+		mon.Counter("logshipper_shipped_entries", monkit.NewSeriesTag("sink", name)).Inc(int64(len(batch)))
+		queue.pending = queue.pending[len(batch):]
+	}
+
+	// the failures are logged only when the sink starts or stops failing,
+	// so that an unreachable collector doesn't flood the logs.
+	if failing := err != nil; failing != queue.failing {
+		queue.failing = failing
+		if failing {
+			service.log.Warn("failed to ship logs", zap.String("sink", name), zap.Error(err))
+		} else {
+			service.log.Info("shipping logs again", zap.String("sink", name))
+		}
+	}
+
+	if dropped := len(queue.pending) - service.config.BufferSize; dropped > 0 {
+		mon.Counter("logshipper_dropped_entries").Inc(int64(dropped))
+		queue.pending = queue.pending[dropped:]
+	}
+	if len(queue.pending) == 0 {
+		// release the memory of the shipped entries.
+		queue.pending = nil
+	}
+}
+
+// Close ships the remaining entries and closes the connections to the
+// collectors. The entries logged after Close aren't shipped.
+func (service *Service) Close() error {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), service.config.FlushInterval)
+	defer cancel()
+
+	service.drain()
+	service.flush(ctx)
+
+	var group errs.Group
+	for _, queue := range service.queues {
+		group.Add(queue.sink.Close())
+	}
+	return Error.Wrap(group.Err())
+}
+
+// shipperCore is a zapcore.Core, which encodes the entries and passes them to
+// the service.
+type shipperCore struct {
+	service *Service
+	encoder zapcore.Encoder
+}
+
+// Enabled returns true when the entries of the level are shipped.
+func (core *shipperCore) Enabled(level zapcore.Level) bool {
+	return core.service.level.Enabled(level)
+}
+
+// With adds structured context to the core.
+func (core *shipperCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := &shipperCore{
+		service: core.service,
+		encoder: core.encoder.Clone(),
+	}
+	for _, field := range fields {
+		field.AddTo(clone.encoder)
+	}
+	return clone
+}
+
+// Check adds the core to the checked entry, when the entry is shipped.
+func (core *shipperCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if core.Enabled(ent.Level) {
+		return ce.AddCore(ent, core)
+	}
+	return ce
+}
+
+// Write encodes the entry and adds it to the buffer of the service.
+func (core *shipperCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	buf, err := core.encoder.EncodeEntry(ent, fields)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	line := strings.TrimSuffix(buf.String(), "\n")
+	buf.Free()
+
+	core.service.enqueue(entry{
+		Time:  ent.Time,
+		Level: ent.Level,
+		Line:  line,
+	})
+	return nil
+}
+
+// Sync does nothing, because the entries are shipped asynchronously.
+func (core *shipperCore) Sync() error {
+	return nil
+}
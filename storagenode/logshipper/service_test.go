@@ -0,0 +1,191 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package logshipper_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/logshipper"
+)
+
+func TestSyslog(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ctx.Check(conn.Close)
+
+	service, err := logshipper.NewService(zaptest.NewLogger(t), logshipper.Config{
+		SyslogAddress: conn.LocalAddr().String(),
+		Level:         "info",
+		BufferSize:    10,
+		BatchSize:     1,
+		FlushInterval: time.Hour,
+	}, testrand.NodeID())
+	require.NoError(t, err)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	ctx.Go(func() error { return service.Run(runCtx) })
+	defer ctx.Check(service.Close)
+	defer cancel()
+
+	log := zap.NewNop().WithOptions(zap.WrapCore(service.Tee))
+	log.Debug("not shipped")
+	log.Warn("shipped", zap.String("key", "value"))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Second)))
+	buf := make([]byte, 4096)
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+
+	message := string(buf[:n])
+	// daemon facility with warning severity.
+	require.True(t, strings.HasPrefix(message, "<28>1 "), message)
+	require.Contains(t, message, " storagenode ")
+	require.Contains(t, message, `"msg":"shipped"`)
+	require.Contains(t, message, `"key":"value"`)
+}
+
+func TestLoki(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var mu sync.Mutex
+	var lines []string
+	var labels map[string]string
+	failing := true
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// the first push fails, so that the entries are retried.
+		if failing {
+			failing = false
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var push struct {
+			Streams []struct {
+				Stream map[string]string `json:"stream"`
+				Values [][2]string       `json:"values"`
+			} `json:"streams"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&push); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, stream := range push.Streams {
+			labels = stream.Stream
+			for _, value := range stream.Values {
+				lines = append(lines, value[1])
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	nodeID := testrand.NodeID()
+	service, err := logshipper.NewService(zaptest.NewLogger(t), logshipper.Config{
+		LokiURL:       server.URL,
+		Level:         "info",
+		BufferSize:    10,
+		BatchSize:     2,
+		FlushInterval: 10 * time.Millisecond,
+	}, nodeID)
+	require.NoError(t, err)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	ctx.Go(func() error { return service.Run(runCtx) })
+
+	log := zap.NewNop().WithOptions(zap.WrapCore(service.Tee))
+	for i := 0; i < 3; i++ {
+		log.Info("entry")
+	}
+
+	// the failed push is retried with the flush interval.
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(lines) == 3
+	}, 10*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, service.Close())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, "storagenode", labels["job"])
+	require.Equal(t, nodeID.String(), labels["node_id"])
+}
+
+func TestBufferFull(t *testing.T) {
+	var mu sync.Mutex
+	var lines int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		var push struct {
+			Streams []struct {
+				Values [][2]string `json:"values"`
+			} `json:"streams"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&push); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, stream := range push.Streams {
+			lines += len(stream.Values)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	service, err := logshipper.NewService(zaptest.NewLogger(t), logshipper.Config{
+		LokiURL:       server.URL,
+		Level:         "info",
+		BufferSize:    5,
+		BatchSize:     100,
+		FlushInterval: time.Hour,
+	}, testrand.NodeID())
+	require.NoError(t, err)
+
+	// logging doesn't wait when nothing ships the entries.
+	log := zap.NewNop().WithOptions(zap.WrapCore(service.Tee))
+	for i := 0; i < 20; i++ {
+		log.Info("entry")
+	}
+
+	require.NoError(t, service.Close())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, 5, lines)
+}
+
+func TestInvalidConfig(t *testing.T) {
+	_, err := logshipper.NewService(zaptest.NewLogger(t), logshipper.Config{
+		LokiURL:       "http://localhost",
+		Level:         "loud",
+		BufferSize:    10,
+		BatchSize:     10,
+		FlushInterval: time.Second,
+	}, testrand.NodeID())
+	require.Error(t, err)
+}
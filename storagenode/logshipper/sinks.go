@@ -0,0 +1,168 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package logshipper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zapcore"
+)
+
+// syslogFacility is the daemon facility of RFC 5424.
+const syslogFacility = 3
+
+// syslogSink ships the entries to a syslog server over UDP, formatted as
+// described by RFC 5424. Every entry is sent in its own datagram.
+type syslogSink struct {
+	conn     net.Conn
+	hostname string
+	pid      string
+}
+
+func newSyslogSink(address string) (*syslogSink, error) {
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+
+	return &syslogSink{
+		conn:     conn,
+		hostname: hostname,
+		pid:      strconv.Itoa(os.Getpid()),
+	}, nil
+}
+
+// Name returns the name of the sink.
+func (sink *syslogSink) Name() string { return "syslog" }
+
+// Ship sends the entries to the syslog server.
+func (sink *syslogSink) Ship(ctx context.Context, entries []entry) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := sink.conn.SetWriteDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	for _, e := range entries {
+		priority := syslogFacility*8 + syslogSeverity(e.Level)
+		message := fmt.Sprintf("<%d>1 %s %s storagenode %s - - %s",
+			priority, e.Time.UTC().Format(time.RFC3339Nano), sink.hostname, sink.pid, e.Line)
+
+		if _, err := sink.conn.Write([]byte(message)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes the connection.
+func (sink *syslogSink) Close() error {
+	return sink.conn.Close()
+}
+
+// syslogSeverity returns the RFC 5424 severity of the level.
+func syslogSeverity(level zapcore.Level) int {
+	switch level {
+	case zapcore.DebugLevel:
+		return 7
+	case zapcore.InfoLevel:
+		return 6
+	case zapcore.WarnLevel:
+		return 4
+	case zapcore.ErrorLevel:
+		return 3
+	case zapcore.DPanicLevel, zapcore.PanicLevel:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// lokiSink ships the entries to the Loki push API.
+type lokiSink struct {
+	url    string
+	labels map[string]string
+	client *http.Client
+}
+
+func newLokiSink(url string, labels map[string]string) *lokiSink {
+	return &lokiSink{
+		url:    url,
+		labels: labels,
+		client: &http.Client{Timeout: time.Minute},
+	}
+}
+
+// lokiPush is the request body of the Loki push API.
+type lokiPush struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+// lokiStream is a stream of entries with the same labels.
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	// Values contains the timestamp in nanoseconds and the line of every entry.
+	Values [][2]string `json:"values"`
+}
+
+// Name returns the name of the sink.
+func (sink *lokiSink) Name() string { return "loki" }
+
+// Ship pushes the entries to Loki.
+func (sink *lokiSink) Ship(ctx context.Context, entries []entry) (err error) {
+	stream := lokiStream{
+		Stream: sink.labels,
+		Values: make([][2]string, 0, len(entries)),
+	}
+	for _, e := range entries {
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(e.Time.UnixNano(), 10), e.Line})
+	}
+
+	body, err := json.Marshal(lokiPush{Streams: []lokiStream{stream}})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := sink.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		err = errs.Combine(err, resp.Body.Close())
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
+
+// Close closes the idle connections.
+func (sink *lokiSink) Close() error {
+	sink.client.CloseIdleConnections()
+	return nil
+}
@@ -7,6 +7,7 @@ package monitor
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -29,14 +30,50 @@ var (
 	Error = errs.Class("piecestore monitor")
 )
 
+// DiskSpaceWarning describes why the allocated disk space was reduced below
+// the configured allocation.
+type DiskSpaceWarning string
+
+const (
+	// DiskSpaceOK means the configured allocation fits on the disk.
+	DiskSpaceOK DiskSpaceWarning = ""
+	// DiskSpaceExceedsCapacity means the configured allocation is larger than
+	// the capacity of the disk, e.g. because the disk shrank.
+	DiskSpaceExceedsCapacity DiskSpaceWarning = "exceeds-capacity"
+	// DiskSpaceExceedsFree means the free space of the disk is less than the
+	// remaining allocation, e.g. because other data filled the disk.
+	DiskSpaceExceedsFree DiskSpaceWarning = "exceeds-free"
+)
+
 // DiskSpace consolidates monitored disk space statistics.
 type DiskSpace struct {
 	Allocated     int64
+	Configured    int64
 	UsedForPieces int64
 	UsedForTrash  int64
 	Free          int64
 	Available     int64
 	Overused      int64
+	Warning       DiskSpaceWarning
+}
+
+// EffectiveAllocation returns how much of the configured allocation fits on
+// the disk given the space used by the node and the disk status, and the
+// warning describing why it was reduced.
+func EffectiveAllocation(configured, used int64, status pieces.StorageStatus) (allocated int64, warning DiskSpaceWarning) {
+	allocated = configured
+
+	if status.DiskTotal > 0 && allocated > status.DiskTotal {
+		allocated = status.DiskTotal
+		warning = DiskSpaceExceedsCapacity
+	}
+
+	if status.DiskFree < allocated-used {
+		allocated = status.DiskFree + used
+		warning = DiskSpaceExceedsFree
+	}
+
+	return allocated, warning
 }
 
 // Config defines parameters for storage node disk and bandwidth usage monitoring.
@@ -57,12 +94,16 @@ type Service struct {
 	store                 *pieces.Store
 	contact               *contact.Service
 	usageDB               bandwidth.DB
-	allocatedDiskSpace    int64
+	configuredDiskSpace   int64
 	cooldown              *sync2.Cooldown
 	Loop                  *sync2.Cycle
 	VerifyDirReadableLoop *sync2.Cycle
 	VerifyDirWritableLoop *sync2.Cycle
 	Config                Config
+
+	mu                 sync.Mutex
+	allocatedDiskSpace int64
+	diskSpaceWarning   DiskSpaceWarning
 }
 
 // NewService creates a new storage node monitoring service.
@@ -72,6 +113,7 @@ func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service,
 		store:                 store,
 		contact:               contact,
 		usageDB:               usageDB,
+		configuredDiskSpace:   allocatedDiskSpace,
 		allocatedDiskSpace:    allocatedDiskSpace,
 		cooldown:              sync2.NewCooldown(config.NotifyLowDiskCooldown),
 		Loop:                  sync2.NewCycle(interval),
@@ -85,42 +127,23 @@ func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service,
 func (service *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	// get the disk space details
-	// The returned path ends in a slash only if it represents a root directory, such as "/" on Unix or `C:\` on Windows.
-	storageStatus, err := service.store.StorageStatus(ctx)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-	freeDiskSpace := storageStatus.DiskFree
-
-	totalUsed, err := service.store.SpaceUsedForPiecesAndTrash(ctx)
+	// check your hard drive is big enough for the allocated space
+	totalUsed, err := service.checkAllocation(ctx)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
-	// check your hard drive is big enough
-	// first time setup as a piece node server
-	if totalUsed == 0 && freeDiskSpace < service.allocatedDiskSpace {
-		service.allocatedDiskSpace = freeDiskSpace
-		service.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", service.allocatedDiskSpace))
-	}
+	allocated, _ := service.allocation()
 
 	// on restarting the Piece node server, assuming already been working as a node
 	// used above the alloacated space, user changed the allocation space setting
 	// before restarting
-	if totalUsed >= service.allocatedDiskSpace {
-		service.log.Warn("Used more space than allocated. Allocated space is", zap.Int64("bytes", service.allocatedDiskSpace))
-	}
-
-	// the available disk space is less than remaining allocated space,
-	// due to change of setting before restarting
-	if freeDiskSpace < service.allocatedDiskSpace-totalUsed {
-		service.allocatedDiskSpace = freeDiskSpace + totalUsed
-		service.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", service.allocatedDiskSpace))
+	if totalUsed >= allocated {
+		service.log.Warn("Used more space than allocated. Allocated space is", zap.Int64("bytes", allocated))
 	}
 
 	// Ensure the disk is at least 500GB in size, which is our current minimum required to be an operator
-	if service.allocatedDiskSpace < service.Config.MinimumDiskSpace.Int64() {
+	if allocated < service.Config.MinimumDiskSpace.Int64() {
 		service.log.Error("Total disk space is less than required minimum", zap.Int64("bytes", service.Config.MinimumDiskSpace.Int64()))
 		return Error.New("disk space requirement not met")
 	}
@@ -182,9 +205,63 @@ func (service *Service) Close() (err error) {
 	return nil
 }
 
+// checkAllocation compares the configured allocation against the capacity and
+// the free space of the disk and reduces the allocated space when it doesn't
+// fit on the disk anymore. The allocated space grows back up to the
+// configured allocation once the disk has enough space again. It returns the
+// space used for pieces and trash.
+func (service *Service) checkAllocation(ctx context.Context) (totalUsed int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storageStatus, err := service.store.StorageStatus(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	totalUsed, err = service.store.SpaceUsedForPiecesAndTrash(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	allocated, warning := EffectiveAllocation(service.configuredDiskSpace, totalUsed, storageStatus)
+
+	service.mu.Lock()
+	previous := service.diskSpaceWarning
+	service.allocatedDiskSpace = allocated
+	service.diskSpaceWarning = warning
+	service.mu.Unlock()
+
+	if warning != previous {
+		switch warning {
+		case DiskSpaceExceedsCapacity:
+			service.log.Warn("Disk capacity is less than requested. Allocated space is",
+				zap.Int64("bytes", allocated), zap.Int64("Disk Capacity", storageStatus.DiskTotal))
+		case DiskSpaceExceedsFree:
+			service.log.Warn("Disk space is less than requested. Allocated space is",
+				zap.Int64("bytes", allocated), zap.Int64("Disk Free", storageStatus.DiskFree))
+		default:
+			service.log.Info("Disk space is sufficient again. Allocated space is", zap.Int64("bytes", allocated))
+		}
+	}
+
+	return totalUsed, nil
+}
+
+// allocation returns the allocated disk space and the warning describing why
+// it's less than the configured allocation.
+func (service *Service) allocation() (int64, DiskSpaceWarning) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.allocatedDiskSpace, service.diskSpaceWarning
+}
+
 func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if _, err := service.checkAllocation(ctx); err != nil {
+		return Error.Wrap(err)
+	}
+
 	freeSpace, err := service.AvailableSpace(ctx)
 	if err != nil {
 		return err
@@ -205,7 +282,8 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 		return 0, err
 	}
 
-	freeSpaceForStorj := service.allocatedDiskSpace - usedSpace
+	allocated, _ := service.allocation()
+	freeSpaceForStorj := allocated - usedSpace
 
 	diskStatus, err := service.store.StorageStatus(ctx)
 	if err != nil {
@@ -215,7 +293,7 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 		freeSpaceForStorj = diskStatus.DiskFree
 	}
 
-	mon.IntVal("allocated_space").Observe(service.allocatedDiskSpace)
+	mon.IntVal("allocated_space").Observe(allocated)
 	mon.IntVal("used_space").Observe(usedSpace)
 	mon.IntVal("available_space").Observe(freeSpaceForStorj)
 
@@ -240,9 +318,11 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 		return DiskSpace{}, Error.Wrap(err)
 	}
 
+	allocated, warning := service.allocation()
+
 	overused := int64(0)
 
-	available := service.allocatedDiskSpace - (usedForPieces + usedForTrash)
+	available := allocated - (usedForPieces + usedForTrash)
 	if available < 0 {
 		overused = -available
 	}
@@ -251,11 +331,13 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 	}
 
 	return DiskSpace{
-		Allocated:     service.allocatedDiskSpace,
+		Allocated:     allocated,
+		Configured:    service.configuredDiskSpace,
 		UsedForPieces: usedForPieces,
 		UsedForTrash:  usedForTrash,
 		Free:          storageStatus.DiskFree,
 		Available:     available,
 		Overused:      overused,
+		Warning:       warning,
 	}, nil
 }
@@ -14,6 +14,8 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/pieces"
 )
 
 func TestMonitor(t *testing.T) {
@@ -43,3 +45,43 @@ func TestMonitor(t *testing.T) {
 		assert.NotZero(t, nodeAssertions, "No storage node were verifed")
 	})
 }
+
+func TestEffectiveAllocation(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		configured int64
+		used       int64
+		status     pieces.StorageStatus
+		allocated  int64
+		warning    monitor.DiskSpaceWarning
+	}{
+		{
+			name:       "fits on disk",
+			configured: 1000, used: 400,
+			status:    pieces.StorageStatus{DiskFree: 800, DiskTotal: 2000},
+			allocated: 1000, warning: monitor.DiskSpaceOK,
+		},
+		{
+			name:       "disk shrank",
+			configured: 1000, used: 100,
+			status:    pieces.StorageStatus{DiskFree: 700, DiskTotal: 800},
+			allocated: 800, warning: monitor.DiskSpaceExceedsCapacity,
+		},
+		{
+			name:       "filled by other data",
+			configured: 1000, used: 400,
+			status:    pieces.StorageStatus{DiskFree: 100, DiskTotal: 2000},
+			allocated: 500, warning: monitor.DiskSpaceExceedsFree,
+		},
+		{
+			name:       "unknown capacity",
+			configured: 1000, used: 0,
+			status:    pieces.StorageStatus{DiskFree: 1000},
+			allocated: 1000, warning: monitor.DiskSpaceOK,
+		},
+	} {
+		allocated, warning := monitor.EffectiveAllocation(tt.configured, tt.used, tt.status)
+		assert.Equal(t, tt.allocated, allocated, tt.name)
+		assert.Equal(t, tt.warning, warning, tt.name)
+	}
+}
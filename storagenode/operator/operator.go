@@ -57,9 +57,17 @@ func isOperatorWalletValid(log *zap.Logger, wallet string) error {
 	return nil
 }
 
-// isOperatorWalletFeaturesValid checks if wallet features list does not exceed length limits.
+// isOperatorWalletFeaturesValid checks if wallet features list does not exceed length limits
+// and whether every feature is well formed.
 func isOperatorWalletFeaturesValid(log *zap.Logger, features WalletFeatures) error {
-	return nodeoperator.DefaultWalletFeaturesValidation.Validate(features)
+	if err := nodeoperator.DefaultWalletFeaturesValidation.Validate(features); err != nil {
+		return err
+	}
+
+	if len(features) > 0 {
+		log.Info("Operator wallet features", zap.Strings("Features", features))
+	}
+	return nil
 }
 
 // ensure WalletFeatures implements pflag.Value.
@@ -77,6 +85,9 @@ func (features WalletFeatures) String() string {
 func (features *WalletFeatures) Set(value string) error {
 	if value != "" {
 		*features = strings.Split(value, ",")
+		for i, feature := range *features {
+			(*features)[i] = strings.TrimSpace(feature)
+		}
 	}
 	return nil
 }
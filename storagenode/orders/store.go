@@ -382,6 +382,34 @@ func (store *FileStore) CleanArchive(deleteBefore time.Time) error {
 	return errs.Combine(errList, err)
 }
 
+// DeleteUnsentBySatellite deletes the unsent orders of the satellite, which
+// will never be sent, e.g. because the satellite is forgotten.
+func (store *FileStore) DeleteUnsentBySatellite(satelliteID storj.NodeID) error {
+	store.unsentMu.Lock()
+	defer store.unsentMu.Unlock()
+
+	var errList error
+	err := filepath.Walk(store.unsentDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			errList = errs.Combine(errList, OrderError.Wrap(err))
+			return nil
+		}
+		if info.IsDir() {
+			return nil
+		}
+		fileInfo, err := ordersfile.GetUnsentInfo(info)
+		if err != nil {
+			errList = errs.Combine(errList, err)
+			return nil
+		}
+		if fileInfo.SatelliteID == satelliteID {
+			return OrderError.Wrap(os.Remove(path))
+		}
+		return nil
+	})
+	return errs.Combine(errList, err)
+}
+
 // ensureDirectories checks for the existence of the unsent and archived directories, and creates them if they do not exist.
 func (store *FileStore) ensureDirectories() error {
 	if _, err := os.Stat(store.unsentDir); os.IsNotExist(err) {
@@ -34,6 +34,12 @@ type DB interface {
 	StorePayment(ctx context.Context, payment Payment) error
 	// GetReceipt retrieves receipt for specific satellite and period.
 	GetReceipt(ctx context.Context, satelliteID storj.NodeID, period string) (string, error)
+	// GetReceiptStatus retrieves receipt verification status for specific satellite and period.
+	GetReceiptStatus(ctx context.Context, satelliteID storj.NodeID, period string) (ReceiptStatus, error)
+	// UnverifiedPayments returns all payments with a receipt, which wasn't verified yet.
+	UnverifiedPayments(ctx context.Context) ([]Payment, error)
+	// SetReceiptStatus sets the receipt verification status of a payment.
+	SetReceiptStatus(ctx context.Context, paymentID int64, status ReceiptStatus) error
 	// GetTotalEarned returns total earned amount of node from all paystubs.
 	GetTotalEarned(ctx context.Context) (_ int64, err error)
 	// GetEarnedAtSatellite returns total earned value for node from specific satellite.
@@ -56,6 +62,8 @@ type DB interface {
 	GetSatellitePeriodPaystubs(ctx context.Context, period string, satelliteID storj.NodeID) (*PayStub, error)
 	// HeldAmountHistory retrieves held amount history for all satellites.
 	HeldAmountHistory(ctx context.Context) ([]HeldAmountHistory, error)
+	// DeleteSatellite removes all paystubs and payments of specific satellite.
+	DeleteSatellite(ctx context.Context, satelliteID storj.NodeID) error
 }
 
 // ErrNoPayStubForPeriod represents errors from the payouts database.
@@ -109,6 +117,8 @@ type Payment struct {
 	Amount      int64        `json:"amount"`
 	Receipt     string       `json:"receipt"`
 	Notes       string       `json:"notes"`
+
+	ReceiptStatus ReceiptStatus `json:"receiptStatus"`
 }
 
 // SatelliteHeldHistory amount of held for specific satellite for all time since join.
@@ -137,6 +147,7 @@ type SatellitePayoutForPeriod struct {
 	Disposed       int64   `json:"disposed"`
 	Paid           int64   `json:"paid"`
 	Receipt        string  `json:"receipt"`
+	ReceiptStatus  string  `json:"receiptStatus"`
 	IsExitComplete bool    `json:"isExitComplete"`
 	Distributed    int64   `json:"distributed"`
 }
@@ -0,0 +1,289 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+// ErrReceipts defines payout receipts verification error.
+var ErrReceipts = errs.Class("payout receipts")
+
+// Config defines payouts configuration.
+type Config struct {
+	Receipts ReceiptsConfig
+}
+
+// ReceiptsConfig defines payout receipts verification configuration.
+type ReceiptsConfig struct {
+	Enabled        bool          `help:"whether payout receipts are verified with a block explorer" default:"false"`
+	Interval       time.Duration `help:"how often unverified payout receipts are verified" releaseDefault:"24h" devDefault:"1m"`
+	Timeout        time.Duration `help:"timeout of a block explorer request" default:"30s"`
+	EthereumURL    string        `help:"url of the etherscan compatible api used to verify ethereum payout receipts" default:"https://api.etherscan.io/api"`
+	EthereumAPIKey string        `help:"api key of the etherscan compatible api used to verify ethereum payout receipts" default:""`
+	ZkSyncURL      string        `help:"url of the zkSync api used to verify zkSync payout receipts" default:"https://api.zksync.io/api/v0.2"`
+}
+
+// ReceiptStatus is the verification status of a payout receipt.
+type ReceiptStatus int
+
+const (
+	// ReceiptUnverified is used when the receipt hasn't been verified yet.
+	ReceiptUnverified ReceiptStatus = 0
+	// ReceiptVerified is used when the block explorer confirmed the transaction of the receipt.
+	ReceiptVerified ReceiptStatus = 1
+	// ReceiptFailed is used when the transaction of the receipt failed or doesn't exist.
+	ReceiptFailed ReceiptStatus = 2
+	// ReceiptUnsupported is used when the receipt can't be verified, because
+	// its network isn't supported.
+	ReceiptUnsupported ReceiptStatus = 3
+)
+
+// String implements fmt.Stringer.
+func (status ReceiptStatus) String() string {
+	switch status {
+	case ReceiptUnverified:
+		return "unverified"
+	case ReceiptVerified:
+		return "verified"
+	case ReceiptFailed:
+		return "failed"
+	case ReceiptUnsupported:
+		return "unsupported"
+	default:
+		return "unknown"
+	}
+}
+
+// Receipt networks, which are used as the prefix of the payout receipts.
+const (
+	ReceiptNetworkEthereum = "eth"
+	ReceiptNetworkZkSync   = "zksync"
+)
+
+// ParseReceipt splits a payout receipt in the form of "network:transaction"
+// into the network and the transaction hash.
+func ParseReceipt(receipt string) (network, txHash string, err error) {
+	receipt = strings.TrimSpace(receipt)
+	splitAt := strings.Index(receipt, ":")
+	if splitAt <= 0 || splitAt == len(receipt)-1 {
+		return "", "", ErrReceipts.New("invalid receipt %q", receipt)
+	}
+	return strings.ToLower(receipt[:splitAt]), receipt[splitAt+1:], nil
+}
+
+// ReceiptExplorer verifies payout receipts with block explorer APIs.
+type ReceiptExplorer struct {
+	config ReceiptsConfig
+	client *http.Client
+}
+
+// NewReceiptExplorer creates a new payout receipt explorer.
+func NewReceiptExplorer(config ReceiptsConfig) *ReceiptExplorer {
+	return &ReceiptExplorer{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+// Verify returns the status of the transaction of the payout receipt.
+// ReceiptUnverified is returned when the transaction isn't final yet.
+func (explorer *ReceiptExplorer) Verify(ctx context.Context, receipt string) (_ ReceiptStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	network, txHash, err := ParseReceipt(receipt)
+	if err != nil {
+		return ReceiptUnsupported, nil
+	}
+
+	switch network {
+	case ReceiptNetworkEthereum:
+		return explorer.verifyEthereum(ctx, txHash)
+	case ReceiptNetworkZkSync:
+		return explorer.verifyZkSync(ctx, txHash)
+	default:
+		return ReceiptUnsupported, nil
+	}
+}
+
+// verifyEthereum verifies the transaction with an etherscan compatible API.
+func (explorer *ReceiptExplorer) verifyEthereum(ctx context.Context, txHash string) (_ ReceiptStatus, err error) {
+	query := url.Values{}
+	query.Set("module", "transaction")
+	query.Set("action", "gettxreceiptstatus")
+	query.Set("txhash", txHash)
+	if explorer.config.EthereumAPIKey != "" {
+		query.Set("apikey", explorer.config.EthereumAPIKey)
+	}
+
+	var response struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+		Result  struct {
+			Status string `json:"status"`
+		} `json:"result"`
+	}
+	if err := explorer.get(ctx, explorer.config.EthereumURL+"?"+query.Encode(), &response); err != nil {
+		return ReceiptUnverified, err
+	}
+	if response.Status != "1" {
+		return ReceiptUnverified, ErrReceipts.New("ethereum explorer error: %s", response.Message)
+	}
+
+	switch response.Result.Status {
+	case "1":
+		return ReceiptVerified, nil
+	case "0":
+		return ReceiptFailed, nil
+	default:
+		// the transaction is pending.
+		return ReceiptUnverified, nil
+	}
+}
+
+// verifyZkSync verifies the transaction with the zkSync API.
+func (explorer *ReceiptExplorer) verifyZkSync(ctx context.Context, txHash string) (_ ReceiptStatus, err error) {
+	var response struct {
+		Status string `json:"status"`
+		Result *struct {
+			Tx struct {
+				Status string `json:"status"`
+			} `json:"tx"`
+		} `json:"result"`
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	endpoint := strings.TrimSuffix(explorer.config.ZkSyncURL, "/") + "/transactions/" + url.PathEscape(txHash) + "/data"
+	if err := explorer.get(ctx, endpoint, &response); err != nil {
+		return ReceiptUnverified, err
+	}
+	if response.Status != "success" {
+		message := response.Status
+		if response.Error != nil {
+			message = response.Error.Message
+		}
+		return ReceiptUnverified, ErrReceipts.New("zkSync explorer error: %s", message)
+	}
+	if response.Result == nil {
+		return ReceiptFailed, nil
+	}
+
+	switch response.Result.Tx.Status {
+	case "finalized":
+		return ReceiptVerified, nil
+	case "rejected":
+		return ReceiptFailed, nil
+	default:
+		// the transaction is queued or committed, but not finalized yet.
+		return ReceiptUnverified, nil
+	}
+}
+
+// get requests the url and decodes the JSON response.
+func (explorer *ReceiptExplorer) get(ctx context.Context, url string, response interface{}) (err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ErrReceipts.Wrap(err)
+	}
+
+	resp, err := explorer.client.Do(req)
+	if err != nil {
+		return ErrReceipts.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrReceipts.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return ErrReceipts.New("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return ErrReceipts.Wrap(json.NewDecoder(resp.Body).Decode(response))
+}
+
+// ReceiptVerifier periodically verifies the unverified payout receipts.
+//
+// architecture: Chore
+type ReceiptVerifier struct {
+	log      *zap.Logger
+	db       DB
+	explorer *ReceiptExplorer
+
+	Loop *sync2.Cycle
+}
+
+// NewReceiptVerifier creates a new payout receipts verifier.
+func NewReceiptVerifier(log *zap.Logger, db DB, explorer *ReceiptExplorer, config ReceiptsConfig) *ReceiptVerifier {
+	return &ReceiptVerifier{
+		log:      log,
+		db:       db,
+		explorer: explorer,
+		Loop:     sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the verifier loop.
+func (verifier *ReceiptVerifier) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return verifier.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := verifier.VerifyReceipts(ctx); err != nil {
+			verifier.log.Error("failed to verify payout receipts", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// VerifyReceipts verifies all the unverified payout receipts.
+func (verifier *ReceiptVerifier) VerifyReceipts(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	payments, err := verifier.db.UnverifiedPayments(ctx)
+	if err != nil {
+		return ErrReceipts.Wrap(err)
+	}
+
+	for _, payment := range payments {
+		status, err := verifier.explorer.Verify(ctx, payment.Receipt)
+		if err != nil {
+			verifier.log.Warn("unable to verify payout receipt",
+				zap.Stringer("Satellite ID", payment.SatelliteID),
+				zap.String("period", payment.Period),
+				zap.Error(err))
+			continue
+		}
+		if status == ReceiptUnverified {
+			continue
+		}
+
+		if status == ReceiptFailed {
+			verifier.log.Warn("payout receipt transaction failed",
+				zap.Stringer("Satellite ID", payment.SatelliteID),
+				zap.String("period", payment.Period),
+				zap.String("receipt", payment.Receipt))
+		}
+		mon.Meter("payout_receipt_" + status.String()).Mark(1)
+
+		if err := verifier.db.SetReceiptStatus(ctx, payment.ID, status); err != nil {
+			return ErrReceipts.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// Close stops the verifier loop.
+func (verifier *ReceiptVerifier) Close() error {
+	verifier.Loop.Close()
+	return nil
+}
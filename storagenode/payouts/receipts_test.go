@@ -0,0 +1,146 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/payouts"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestParseReceipt(t *testing.T) {
+	network, txHash, err := payouts.ParseReceipt("zkSync:0x123")
+	require.NoError(t, err)
+	require.Equal(t, payouts.ReceiptNetworkZkSync, network)
+	require.Equal(t, "0x123", txHash)
+
+	for _, receipt := range []string{"", "0x123", ":0x123", "eth:"} {
+		_, _, err := payouts.ParseReceipt(receipt)
+		require.Error(t, err, receipt)
+	}
+}
+
+// newTestExplorerServer returns a block explorer, which knows the transactions
+// "0xok" and "0xfailed" and considers "0xpending" pending.
+func newTestExplorerServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth", func(w http.ResponseWriter, r *http.Request) {
+		status := map[string]string{"0xok": "1", "0xfailed": "0"}[r.URL.Query().Get("txhash")]
+		_, err := w.Write([]byte(`{"status":"1","message":"OK","result":{"status":"` + status + `"}}`))
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/zksync/transactions/", func(w http.ResponseWriter, r *http.Request) {
+		var result string
+		switch r.URL.Path {
+		case "/zksync/transactions/0xok/data":
+			result = `{"tx":{"status":"finalized"}}`
+		case "/zksync/transactions/0xfailed/data":
+			result = `{"tx":{"status":"rejected"}}`
+		case "/zksync/transactions/0xpending/data":
+			result = `{"tx":{"status":"committed"}}`
+		default:
+			result = `null`
+		}
+		_, err := w.Write([]byte(`{"status":"success","result":` + result + `,"error":null}`))
+		require.NoError(t, err)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestReceiptExplorer(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	server := newTestExplorerServer(t)
+	defer server.Close()
+
+	explorer := payouts.NewReceiptExplorer(payouts.ReceiptsConfig{
+		Timeout:     time.Minute,
+		EthereumURL: server.URL + "/eth",
+		ZkSyncURL:   server.URL + "/zksync",
+	})
+
+	for receipt, expected := range map[string]payouts.ReceiptStatus{
+		"eth:0xok":          payouts.ReceiptVerified,
+		"eth:0xfailed":      payouts.ReceiptFailed,
+		"eth:0xpending":     payouts.ReceiptUnverified,
+		"zksync:0xok":       payouts.ReceiptVerified,
+		"zksync:0xfailed":   payouts.ReceiptFailed,
+		"zksync:0xpending":  payouts.ReceiptUnverified,
+		"zksync:0xmissing":  payouts.ReceiptFailed,
+		"polygon:0xok":      payouts.ReceiptUnsupported,
+		"not a transaction": payouts.ReceiptUnsupported,
+	} {
+		status, err := explorer.Verify(ctx, receipt)
+		require.NoError(t, err, receipt)
+		require.Equal(t, expected, status, receipt)
+	}
+}
+
+func TestReceiptVerifier(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		server := newTestExplorerServer(t)
+		defer server.Close()
+
+		config := payouts.ReceiptsConfig{
+			Interval:    time.Hour,
+			Timeout:     time.Minute,
+			EthereumURL: server.URL + "/eth",
+			ZkSyncURL:   server.URL + "/zksync",
+		}
+		verifier := payouts.NewReceiptVerifier(zaptest.NewLogger(t), db.Payout(), payouts.NewReceiptExplorer(config), config)
+
+		satelliteID := testrand.NodeID()
+		payments := map[string]payouts.Payment{
+			"2021-01": {ID: 1, SatelliteID: satelliteID, Period: "2021-01", Amount: 1, Receipt: "eth:0xok"},
+			"2021-02": {ID: 2, SatelliteID: satelliteID, Period: "2021-02", Amount: 1, Receipt: "zksync:0xfailed"},
+			"2021-03": {ID: 3, SatelliteID: satelliteID, Period: "2021-03", Amount: 1, Receipt: "zksync:0xpending"},
+		}
+		for _, payment := range payments {
+			require.NoError(t, db.Payout().StorePayment(ctx, payment))
+		}
+
+		require.NoError(t, verifier.VerifyReceipts(ctx))
+
+		expected := map[string]payouts.ReceiptStatus{
+			"2021-01": payouts.ReceiptVerified,
+			"2021-02": payouts.ReceiptFailed,
+			"2021-03": payouts.ReceiptUnverified,
+		}
+		for period, status := range expected {
+			actual, err := db.Payout().GetReceiptStatus(ctx, satelliteID, period)
+			require.NoError(t, err)
+			require.Equal(t, status, actual, period)
+		}
+
+		unverified, err := db.Payout().UnverifiedPayments(ctx)
+		require.NoError(t, err)
+		require.Len(t, unverified, 1)
+		require.Equal(t, int64(3), unverified[0].ID)
+
+		// storing the same payment again keeps the status.
+		require.NoError(t, db.Payout().StorePayment(ctx, payments["2021-01"]))
+		status, err := db.Payout().GetReceiptStatus(ctx, satelliteID, "2021-01")
+		require.NoError(t, err)
+		require.Equal(t, payouts.ReceiptVerified, status)
+
+		// a changed receipt must be verified again.
+		changed := payments["2021-01"]
+		changed.Receipt = "eth:0xfailed"
+		require.NoError(t, db.Payout().StorePayment(ctx, changed))
+		status, err = db.Payout().GetReceiptStatus(ctx, satelliteID, "2021-01")
+		require.NoError(t, err)
+		require.Equal(t, payouts.ReceiptUnverified, status)
+	})
+}
@@ -256,6 +256,13 @@ func (service *Service) AllSatellitesPayoutPeriod(ctx context.Context, period st
 			}
 		}
 
+		receiptStatus, err := service.db.GetReceiptStatus(ctx, satelliteIDs[i], period)
+		if err != nil {
+			if !ErrNoPayStubForPeriod.Has(err) {
+				return nil, ErrPayoutService.Wrap(err)
+			}
+		}
+
 		stats, err := service.reputationDB.Get(ctx, satelliteIDs[i])
 		if err != nil {
 			return nil, ErrPayoutService.Wrap(err)
@@ -299,6 +306,7 @@ func (service *Service) AllSatellitesPayoutPeriod(ctx context.Context, period st
 		heldPercent := GetHeldRate(stats.JoinedAt, heldPeriod)
 		payoutForPeriod.Held = paystub.Held
 		payoutForPeriod.Receipt = receipt
+		payoutForPeriod.ReceiptStatus = receiptStatus.String()
 		payoutForPeriod.Surge = surge
 		payoutForPeriod.AfterHeld = surge - paystub.Held
 		payoutForPeriod.Age = int64(date.MonthsCountSince(stats.JoinedAt))
@@ -39,9 +39,12 @@ import (
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/forgetsatellite"
 	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/storagenode/healthcheck"
 	"storj.io/storj/storagenode/inspector"
 	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/logshipper"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/multinode"
 	"storj.io/storj/storagenode/nodestats"
@@ -95,6 +98,8 @@ type DB interface {
 	APIKeys() apikeys.DB
 
 	Preflight(ctx context.Context) error
+	// CheckIntegrity checks the integrity of the databases, without modifying them.
+	CheckIntegrity(ctx context.Context) error
 }
 
 // Config is all the configuration parameters for a Storage Node.
@@ -123,11 +128,17 @@ type Config struct {
 
 	Console consoleserver.Config
 
+	Healthcheck healthcheck.Config
+
 	Version checker.Config
 
 	Bandwidth bandwidth.Config
 
 	GracefulExit gracefulexit.Config
+
+	Payouts payouts.Config
+
+	LogShipper logshipper.Config
 }
 
 // DatabaseConfig returns the storagenodedb.Config that should be used with this Config.
@@ -142,6 +153,11 @@ func (config *Config) DatabaseConfig() storagenodedb.Config {
 		Info2:     filepath.Join(dbdir, "info.db"),
 		Pieces:    config.Storage.Path,
 		Filestore: config.Filestore,
+
+		Backend:     storagenodedb.Backend(config.Storage2.DatabaseBackend),
+		JournalMode: config.Storage2.DatabaseJournalMode,
+		Synchronous: config.Storage2.DatabaseSynchronous,
+		BusyTimeout: config.Storage2.DatabaseBusyTimeout,
 	}
 }
 
@@ -208,6 +224,10 @@ type Peer struct {
 		Service *checker.Service
 	}
 
+	LogShipper struct {
+		Service *logshipper.Service
+	}
+
 	Debug struct {
 		Listener net.Listener
 		Server   *debug.Server
@@ -244,6 +264,7 @@ type Peer struct {
 		Inspector     *inspector.Endpoint
 		Monitor       *monitor.Service
 		Orders        *orders.Service
+		EgressCaps    *bandwidth.Caps
 	}
 
 	Collector *collector.Service
@@ -254,6 +275,10 @@ type Peer struct {
 	}
 
 	// Web server with web UI
+	Healthcheck struct {
+		Service *healthcheck.Service
+	}
+
 	Console struct {
 		Listener net.Listener
 		Service  *console.Service
@@ -264,6 +289,10 @@ type Peer struct {
 		Service piecetransfer.Service
 	}
 
+	ForgetSatellite struct {
+		Service *forgetsatellite.Service
+	}
+
 	GracefulExit struct {
 		Service      gracefulexit.Service
 		Endpoint     *gracefulexit.Endpoint
@@ -276,8 +305,9 @@ type Peer struct {
 	}
 
 	Payout struct {
-		Service  *payouts.Service
-		Endpoint *payouts.Endpoint
+		Service         *payouts.Service
+		Endpoint        *payouts.Endpoint
+		ReceiptVerifier *payouts.ReceiptVerifier
 	}
 
 	Bandwidth *bandwidth.Service
@@ -294,6 +324,18 @@ type Peer struct {
 
 // New creates a new Storage Node.
 func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB extensions.RevocationDB, config Config, versionInfo version.Info, atomicLogLevel *zap.AtomicLevel) (*Peer, error) {
+	// the log shipper is set up before everything else, so that the logs of
+	// every component are shipped.
+	var logShipper *logshipper.Service
+	if config.LogShipper.Enabled() {
+		var err error
+		logShipper, err = logshipper.NewService(log.Named("logshipper"), config.LogShipper, full.ID)
+		if err != nil {
+			return nil, err
+		}
+		log = log.WithOptions(zap.WrapCore(logShipper.Tee))
+	}
+
 	peer := &Peer{
 		Log:      log,
 		Identity: full,
@@ -303,6 +345,15 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		Services: lifecycle.NewGroup(log.Named("services")),
 	}
 
+	if logShipper != nil {
+		peer.LogShipper.Service = logShipper
+		peer.Services.Add(lifecycle.Item{
+			Name:  "logshipper",
+			Run:   peer.LogShipper.Service.Run,
+			Close: peer.LogShipper.Service.Close,
+		})
+	}
+
 	{ // setup notification service.
 		peer.Notifications.Service = notifications.NewService(peer.Log, peer.DB.Notifications())
 	}
@@ -411,7 +462,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			Version: *pbVersion,
 		}
 		peer.Contact.PingStats = new(contact.PingStats)
-		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), peer.Dialer, self, peer.Storage2.Trust)
+		peer.Storage2.EgressCaps = bandwidth.NewCaps(peer.DB.Bandwidth(), config.Bandwidth.EgressCaps)
+		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), peer.Dialer, self, peer.Storage2.Trust, peer.Storage2.EgressCaps)
 
 		peer.Contact.Chore = contact.NewChore(peer.Log.Named("contact:chore"), config.Contact.Interval, peer.Contact.Service)
 		peer.Services.Add(lifecycle.Item{
@@ -523,6 +575,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Storage2.PieceDeleter,
 			peer.OrdersStore,
 			peer.DB.Bandwidth(),
+			peer.Storage2.EgressCaps,
 			peer.UsedSerials,
 			config.Storage2,
 		)
@@ -533,6 +586,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		if err := pb.DRPCRegisterPiecestore(peer.Server.DRPC(), peer.Storage2.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := internalpb.DRPCRegisterPieceInventory(peer.Server.DRPC(), peer.Storage2.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		// TODO workaround for custom timeout for order sending request (read/write)
 		sc := config.Server
@@ -581,6 +637,22 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Dialer,
 			peer.Storage2.Trust,
 		)
+
+		if config.Payouts.Receipts.Enabled {
+			peer.Payout.ReceiptVerifier = payouts.NewReceiptVerifier(
+				peer.Log.Named("payouts:receipts"),
+				peer.DB.Payout(),
+				payouts.NewReceiptExplorer(config.Payouts.Receipts),
+				config.Payouts.Receipts,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "payouts:receipts",
+				Run:   peer.Payout.ReceiptVerifier.Run,
+				Close: peer.Payout.ReceiptVerifier.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Payout Receipts Verifier", peer.Payout.ReceiptVerifier.Loop))
+		}
 	}
 
 	{ // setup reputation service.
@@ -635,6 +707,40 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		)
 	}
 
+	{ // setup health checks
+		peer.Healthcheck.Service = healthcheck.NewService(
+			peer.Log.Named("healthcheck"),
+			peer.DB,
+			peer.Storage2.Store,
+			peer.Storage2.Trust,
+			peer.Contact.Service,
+			peer.Preflight.LocalTime,
+			config.Healthcheck,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "healthcheck",
+			Run:   peer.Healthcheck.Service.Run,
+			Close: peer.Healthcheck.Service.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Health Check", peer.Healthcheck.Service.Loop))
+	}
+
+	{ // setup forget satellite service
+		peer.ForgetSatellite.Service = forgetsatellite.NewService(
+			peer.Log.Named("forgetsatellite"),
+			peer.Storage2.Store,
+			peer.Storage2.Trust,
+			peer.UsedSerials,
+			peer.OrdersStore,
+			peer.DB.Satellites(),
+			peer.DB.Reputation(),
+			peer.DB.Payout(),
+			peer.DB.Pricing(),
+			peer.DB.StorageUsage(),
+		)
+	}
+
 	{ // setup storage node operator dashboard
 		_, port, _ := net.SplitHostPort(peer.Addr())
 		peer.Console.Service, err = console.NewService(
@@ -682,6 +788,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Notifications.Service,
 			peer.Console.Service,
 			peer.Payout.Service,
+			peer.Healthcheck.Service,
+			peer.ForgetSatellite.Service,
 			peer.Console.Listener,
 		)
 		// NOTE: Console service is added to peer services during peer run to allow for QUIC checkins
@@ -15,6 +15,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
 )
 
 // CacheService updates the space used cache.
@@ -44,13 +45,60 @@ func NewService(log *zap.Logger, usageCache *BlobsUsageCache, pieces *Store, int
 
 // Run recalculates the space used cache once and also runs a loop to sync the space used cache
 // to persistent storage on an interval.
+//
+// Between the walks over all the pieces, the cache is kept up to date with the sizes of the added
+// and deleted pieces. The walk on startup is skipped when it's disabled and the totals persisted by
+// the previous run were loaded with Init.
 func (service *CacheService) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	defer service.InitFence.Release()
 
+	totals := service.usageCache.copyCacheTotals()
+	hasTotals := totals.piecesTotal != 0 || totals.trashTotal != 0 || len(totals.spaceUsedBySatellite) > 0
+
+	if service.store.config.ScanOnStartup || !hasTotals {
+		if err := service.recalculate(ctx); err != nil {
+			return err
+		}
+	} else {
+		service.log.Info("skipping the walk over the pieces on startup, using the persisted space used totals")
+	}
+
+	if err = service.store.spaceUsedDB.Init(ctx); err != nil {
+		service.log.Error("error during init space usage db: ", zap.Error(err))
+		return err
+	}
+
+	lastScan := time.Now()
+	return service.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+
+		// walk the pieces to reconcile the totals tracked from the piece
+		// additions and deletions with the space actually used.
+		if interval := service.store.config.ScanInterval; interval > 0 && time.Since(lastScan) >= interval {
+			lastScan = time.Now()
+			if err := service.recalculate(ctx); err != nil {
+				service.log.Error("error reconciling the space used cache: ", zap.Error(err))
+			}
+		}
+
+		// on a loop sync the cache values to the db so that we have the them saved
+		// in the case that the storagenode restarts
+		if err := service.PersistCacheTotals(ctx); err != nil {
+			service.log.Error("error persisting cache totals to the database: ", zap.Error(err))
+		}
+		service.InitFence.Release()
+		return err
+	})
+}
+
+// recalculate walks all the pieces and replaces the totals of the space used cache with the
+// result, estimating the changes made during the walk.
+func (service *CacheService) recalculate(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	totalsAtStart := service.usageCache.copyCacheTotals()
 
-	// recalculate the cache once
 	piecesTotal, piecesContentSize, totalsBySatellite, err := service.store.SpaceUsedTotalAndBySatellite(ctx)
 	if err != nil {
 		service.log.Error("error getting current used space: ", zap.Error(err))
@@ -61,6 +109,8 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 		service.log.Error("error getting current used space for trash: ", zap.Error(err))
 		return err
 	}
+
+	totalsAtEnd := service.usageCache.copyCacheTotals()
 	service.usageCache.Recalculate(
 		piecesTotal,
 		totalsAtStart.piecesTotal,
@@ -71,23 +121,19 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 		totalsBySatellite,
 		totalsAtStart.spaceUsedBySatellite,
 	)
+	reconciled := service.usageCache.copyCacheTotals()
+
+	piecesTotalDrift := reconciled.piecesTotal - totalsAtEnd.piecesTotal
+	trashTotalDrift := reconciled.trashTotal - totalsAtEnd.trashTotal
+	mon.IntVal("space_used_pieces_total_drift").Observe(piecesTotalDrift)
+	mon.IntVal("space_used_trash_total_drift").Observe(trashTotalDrift)
+	service.log.Info("space used cache recalculated",
+		zap.Int64("pieces total", reconciled.piecesTotal),
+		zap.Int64("pieces total drift", piecesTotalDrift),
+		zap.Int64("trash total", reconciled.trashTotal),
+		zap.Int64("trash total drift", trashTotalDrift))
 
-	if err = service.store.spaceUsedDB.Init(ctx); err != nil {
-		service.log.Error("error during init space usage db: ", zap.Error(err))
-		return err
-	}
-
-	return service.Loop.Run(ctx, func(ctx context.Context) (err error) {
-		defer mon.Task()(&ctx)(&err)
-
-		// on a loop sync the cache values to the db so that we have the them saved
-		// in the case that the storagenode restarts
-		if err := service.PersistCacheTotals(ctx); err != nil {
-			service.log.Error("error persisting cache totals to the database: ", zap.Error(err))
-		}
-		service.InitFence.Release()
-		return err
-	})
+	return nil
 }
 
 // PersistCacheTotals saves the current totals of the space used cache to the database
@@ -492,3 +538,11 @@ func (blobs *BlobsUsageCache) TestCreateV0(ctx context.Context, ref storage.Blob
 	})
 	return fStore.TestCreateV0(ctx, ref)
 }
+
+// WriteFormatVersion returns the storage format version of the created blobs.
+func (blobs *BlobsUsageCache) WriteFormatVersion() storage.FormatVersion {
+	if fStore, ok := blobs.Blobs.(interface{ WriteFormatVersion() storage.FormatVersion }); ok {
+		return fStore.WriteFormatVersion()
+	}
+	return filestore.MaxFormatVersionSupported
+}
@@ -264,6 +264,79 @@ func TestCachServiceRun(t *testing.T) {
 	})
 }
 
+func TestCacheServiceScanDisabledOnStartup(t *testing.T) {
+	log := zaptest.NewLogger(t)
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		spaceUsedDB := db.PieceSpaceUsedDB()
+
+		blobstore, err := filestore.NewAt(log, ctx.Dir(), filestore.DefaultConfig)
+		require.NoError(t, err)
+
+		expBlobSize := memory.KB
+		w, err := blobstore.Create(ctx, storage.BlobRef{
+			Namespace: testrand.NodeID().Bytes(),
+			Key:       testrand.PieceID().Bytes(),
+		}, -1)
+		require.NoError(t, err)
+		_, err = w.Write(testrand.Bytes(expBlobSize))
+		require.NoError(t, err)
+		require.NoError(t, w.Commit(ctx))
+
+		// the totals persisted by the previous run don't match the pieces.
+		satelliteID := testrand.NodeID()
+		cache := pieces.NewBlobsUsageCacheTest(log, blobstore, 150, 151, 0, map[storj.NodeID]pieces.SatelliteUsage{
+			satelliteID: {Total: 150, ContentSize: 151},
+		})
+
+		runCacheService := func(config pieces.Config, check func()) {
+			cacheService := pieces.NewService(log,
+				cache,
+				pieces.NewStore(log, cache, nil, nil, spaceUsedDB, config),
+				1*time.Hour,
+			)
+
+			var eg errgroup.Group
+			eg.Go(func() error {
+				return cacheService.Run(ctx)
+			})
+
+			// wait for the first loop to finish
+			cacheService.InitFence.Wait(ctx)
+			check()
+
+			require.NoError(t, cacheService.Close())
+			require.NoError(t, eg.Wait())
+		}
+
+		config := pieces.DefaultConfig
+		config.ScanOnStartup = false
+
+		runCacheService(config, func() {
+			// the persisted totals are used without walking the pieces.
+			piecesTotal, piecesContentSize, err := cache.SpaceUsedForPieces(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, int64(150), piecesTotal)
+			assert.Equal(t, int64(151), piecesContentSize)
+
+			// the deltas are tracked between the walks.
+			cache.Update(ctx, satelliteID, 50, 49, 0)
+			piecesTotal, _, err = cache.SpaceUsedForPieces(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, int64(200), piecesTotal)
+		})
+
+		config.ScanInterval = time.Nanosecond
+
+		runCacheService(config, func() {
+			// the walk in the loop reconciles the totals with the pieces.
+			piecesTotal, piecesContentSize, err := cache.SpaceUsedForPieces(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, int64(expBlobSize), piecesTotal)
+			assert.Equal(t, int64(expBlobSize-pieces.V1PieceHeaderReservedArea), piecesContentSize)
+		})
+	})
+}
+
 func TestPersistCacheTotals(t *testing.T) {
 	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
 		log := zaptest.NewLogger(t)
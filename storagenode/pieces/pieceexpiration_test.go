@@ -50,9 +50,15 @@ func TestPieceExpirationDB(t *testing.T) {
 		err = expireDB.SetExpiration(ctx, satelliteID, pieceID, expireAt)
 		require.NoError(t, err)
 
-		// SetExpiration duplicate
+		// SetExpiration duplicate replaces the expiration
 		err = expireDB.SetExpiration(ctx, satelliteID, pieceID, expireAt.Add(time.Hour))
-		require.Error(t, err)
+		require.NoError(t, err)
+		expiredPieceIDs, err = expireDB.GetExpired(ctx, expireAt.Add(time.Microsecond), 1000)
+		require.NoError(t, err)
+		require.Len(t, expiredPieceIDs, 0)
+
+		err = expireDB.SetExpiration(ctx, satelliteID, pieceID, expireAt)
+		require.NoError(t, err)
 
 		// GetExpired normal usage
 		expiredPieceIDs, err = expireDB.GetExpired(ctx, expireAt.Add(time.Microsecond), 1000)
@@ -86,3 +92,59 @@ func TestPieceExpirationDB(t *testing.T) {
 		require.Len(t, expiredPieceIDs, 0)
 	})
 }
+
+func TestPieceExpirationBatch(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		expireDB := db.PieceExpirationDB()
+
+		satelliteID := testrand.NodeID()
+		now := time.Now()
+
+		// SetExpirations with an empty batch
+		require.NoError(t, expireDB.SetExpirations(ctx, nil))
+
+		var expirations []pieces.ExpirationInfo
+		var expected []pieces.ExpiredInfo
+		for i := 0; i < 500; i++ {
+			pieceID := testrand.PieceID()
+			expirations = append(expirations, pieces.ExpirationInfo{
+				SatelliteID:     satelliteID,
+				PieceID:         pieceID,
+				PieceExpiration: now.Add(-time.Duration(i) * time.Minute),
+			})
+			expected = append(expected, pieces.ExpiredInfo{
+				SatelliteID: satelliteID,
+				PieceID:     pieceID,
+			})
+		}
+		// the last expiration of a piece which appears twice in the batch wins.
+		expirations = append(expirations, pieces.ExpirationInfo{
+			SatelliteID:     satelliteID,
+			PieceID:         expirations[0].PieceID,
+			PieceExpiration: now.Add(time.Hour),
+		})
+		require.NoError(t, expireDB.SetExpirations(ctx, expirations))
+
+		expired, err := expireDB.GetExpired(ctx, now.Add(time.Minute), 1000)
+		require.NoError(t, err)
+		require.ElementsMatch(t, expected[1:], expired)
+
+		// a batch with existing pieces replaces their expirations.
+		require.NoError(t, expireDB.SetExpirations(ctx, []pieces.ExpirationInfo{{
+			SatelliteID:     satelliteID,
+			PieceID:         expirations[1].PieceID,
+			PieceExpiration: now.Add(time.Hour),
+		}}))
+
+		expired, err = expireDB.GetExpired(ctx, now.Add(time.Minute), 1000)
+		require.NoError(t, err)
+		require.ElementsMatch(t, expected[2:], expired)
+
+		// DeleteExpirations removes the records of the batch only.
+		require.NoError(t, expireDB.DeleteExpirations(ctx, expected[2:300]))
+
+		expired, err = expireDB.GetExpired(ctx, now.Add(2*time.Hour), 1000)
+		require.NoError(t, err)
+		require.ElementsMatch(t, append(expected[:2:2], expected[300:]...), expired)
+	})
+}
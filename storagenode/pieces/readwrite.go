@@ -9,6 +9,7 @@ import (
 	"errors"
 	"hash"
 	"io"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -55,8 +56,83 @@ const (
 	// files to indicate the size of the marshaled piece header within the reserved header
 	// area (because protobufs are not self-delimiting, which is lame).
 	v1PieceHeaderFramingSize = 2
+
+	// v2PieceHeaderTrailerSize is the size of the fixed fields at the end of the reserved
+	// header area of pieces stored with filestore.FormatV2 or greater: the creation time and
+	// the expiration as unix nanoseconds, and the signature version. They can be read without
+	// unmarshaling the piece header.
+	v2PieceHeaderTrailerSize = 8 + 8 + 1
 )
 
+// SignatureVersion is the version of the satellite signature over the order limit of the
+// piece.
+type SignatureVersion uint8
+
+const (
+	// SignatureV1 is the order limit signature of storj.io/common/signing.
+	SignatureV1 SignatureVersion = 1
+
+	// CurrentSignatureVersion is the signature version of the newly written pieces.
+	CurrentSignatureVersion = SignatureV1
+)
+
+// HeaderInfo is the part of the piece header, which can be read without unmarshaling the piece
+// header from pieces stored with filestore.FormatV2. Garbage collection uses the creation time,
+// and the collector checks the expiration before it deletes the pieces found through the piece
+// expiration database.
+type HeaderInfo struct {
+	FormatVersion storage.FormatVersion
+	CreationTime  time.Time
+	// Expiration is zero when the piece doesn't expire.
+	Expiration       time.Time
+	SignatureVersion SignatureVersion
+}
+
+// headerInfoFromPieceHeader returns the header info of pieces stored with filestore.FormatV1
+// or greater.
+func headerInfoFromPieceHeader(formatVersion storage.FormatVersion, header *pb.PieceHeader) HeaderInfo {
+	return HeaderInfo{
+		FormatVersion:    formatVersion,
+		CreationTime:     header.CreationTime,
+		Expiration:       header.OrderLimit.PieceExpiration,
+		SignatureVersion: SignatureV1,
+	}
+}
+
+// encodeHeaderTrailer encodes the fixed fields of the filestore.FormatV2 piece header.
+func encodeHeaderTrailer(info HeaderInfo) (trailer [v2PieceHeaderTrailerSize]byte) {
+	binary.BigEndian.PutUint64(trailer[0:8], uint64(unixNano(info.CreationTime)))
+	binary.BigEndian.PutUint64(trailer[8:16], uint64(unixNano(info.Expiration)))
+	trailer[16] = byte(info.SignatureVersion)
+	return trailer
+}
+
+// decodeHeaderTrailer decodes the fixed fields of the filestore.FormatV2 piece header.
+func decodeHeaderTrailer(formatVersion storage.FormatVersion, trailer []byte) HeaderInfo {
+	return HeaderInfo{
+		FormatVersion:    formatVersion,
+		CreationTime:     fromUnixNano(int64(binary.BigEndian.Uint64(trailer[0:8]))),
+		Expiration:       fromUnixNano(int64(binary.BigEndian.Uint64(trailer[8:16]))),
+		SignatureVersion: SignatureVersion(trailer[16]),
+	}
+}
+
+// unixNano returns the unix nanoseconds of t, zero for the zero time.
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// fromUnixNano is the inverse of unixNano.
+func fromUnixNano(nanos int64) time.Time {
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos).UTC()
+}
+
 // BadFormatVersion is returned when a storage format cannot support the request function.
 var BadFormatVersion = errs.Class("Incompatible storage format version")
 
@@ -158,7 +234,7 @@ func (w *Writer) Commit(ctx context.Context, pieceHeader *pb.PieceHeader) (err e
 		return err
 	}
 	mon.IntVal("storagenode_pieces_pieceheader_size").Observe(int64(len(headerBytes)))
-	if len(headerBytes) > (V1PieceHeaderReservedArea - v1PieceHeaderFramingSize) {
+	if len(headerBytes) > maxPieceHeaderSize(formatVer) {
 		// This should never happen under normal circumstances, and it might deserve a panic(),
 		// but I'm not *entirely* sure this case can't be triggered by a malicious uplink. Are
 		// google.protobuf.Timestamp fields variable-width?
@@ -189,6 +265,20 @@ func (w *Writer) Commit(ctx context.Context, pieceHeader *pb.PieceHeader) (err e
 		return Error.New("failed writing piece header at file start: %w", err)
 	}
 
+	if formatVer >= filestore.FormatV2 {
+		trailer := encodeHeaderTrailer(HeaderInfo{
+			CreationTime:     pieceHeader.CreationTime,
+			Expiration:       pieceHeader.OrderLimit.PieceExpiration,
+			SignatureVersion: CurrentSignatureVersion,
+		})
+		if _, err := w.blob.Seek(V1PieceHeaderReservedArea-v2PieceHeaderTrailerSize, io.SeekStart); err != nil {
+			return err
+		}
+		if _, err = w.blob.Write(trailer[:]); err != nil {
+			return Error.New("failed writing piece header trailer: %w", err)
+		}
+	}
+
 	// seek back to the end, as blob.Commit will truncate from the current file position.
 	// (don't try to seek(0, io.SeekEnd), because dir.CreateTemporaryFile preallocs space
 	// and the actual end of the file might be far past the intended end of the piece.)
@@ -198,6 +288,15 @@ func (w *Writer) Commit(ctx context.Context, pieceHeader *pb.PieceHeader) (err e
 	return nil
 }
 
+// maxPieceHeaderSize returns the maximum size of the marshaled piece header for the storage
+// format version.
+func maxPieceHeaderSize(formatVersion storage.FormatVersion) int {
+	if formatVersion >= filestore.FormatV2 {
+		return V1PieceHeaderReservedArea - v1PieceHeaderFramingSize - v2PieceHeaderTrailerSize
+	}
+	return V1PieceHeaderReservedArea - v1PieceHeaderFramingSize
+}
+
 // Cancel deletes any temporarily written data.
 func (w *Writer) Cancel(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -271,7 +370,7 @@ func (r *Reader) GetPieceHeader() (*pb.PieceHeader, error) {
 	}
 	r.pos += int64(n)
 	headerSize := binary.BigEndian.Uint16(framingBytes)
-	if headerSize > (V1PieceHeaderReservedArea - v1PieceHeaderFramingSize) {
+	if int(headerSize) > maxPieceHeaderSize(r.formatVersion) {
 		return nil, Error.New("PieceHeader framing field claims impossible size of %d bytes", headerSize)
 	}
 
@@ -291,6 +390,26 @@ func (r *Reader) GetPieceHeader() (*pb.PieceHeader, error) {
 	return header, nil
 }
 
+// GetHeaderInfo returns the creation time, expiration and signature version of the piece.
+// For pieces stored with filestore.FormatV2 or greater it reads only the fixed
+// fields of the header and it may be called at any time. Otherwise it unmarshals the piece
+// header, so it has the same restrictions as GetPieceHeader.
+func (r *Reader) GetHeaderInfo() (HeaderInfo, error) {
+	if r.formatVersion < filestore.FormatV2 {
+		header, err := r.GetPieceHeader()
+		if err != nil {
+			return HeaderInfo{}, err
+		}
+		return headerInfoFromPieceHeader(r.formatVersion, header), nil
+	}
+
+	var trailer [v2PieceHeaderTrailerSize]byte
+	if _, err := r.blob.ReadAt(trailer[:], V1PieceHeaderReservedArea-v2PieceHeaderTrailerSize); err != nil {
+		return HeaderInfo{}, Error.Wrap(err)
+	}
+	return decodeHeaderTrailer(r.formatVersion, trailer[:]), nil
+}
+
 // Read reads data from the underlying blob, buffering as necessary.
 func (r *Reader) Read(data []byte) (int, error) {
 	if r.formatVersion >= filestore.FormatV1 && r.pos < V1PieceHeaderReservedArea {
@@ -217,3 +217,63 @@ func TestEmptyPiece(t *testing.T) {
 	var content [0]byte
 	readAndWritePiece(t, content[:])
 }
+
+func TestReadWriteV2(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir, err := filestore.NewDir(zaptest.NewLogger(t), ctx.Dir("pieces"))
+	require.NoError(t, err)
+	config := filestore.DefaultConfig
+	config.WriteFormatV2 = true
+	blobs := filestore.New(zaptest.NewLogger(t), dir, config)
+	defer ctx.Check(blobs.Close)
+
+	store := pieces.NewStore(zaptest.NewLogger(t), blobs, nil, nil, nil, pieces.DefaultConfig)
+
+	satelliteID := testrand.NodeID()
+	pieceID := testrand.PieceID()
+	content := testrand.Bytes(memory.KiB)
+	fakeHash := testrand.Bytes(32)
+	creationTime := time.Unix(1564362827, 18364029)
+	expirationTime := time.Unix(1595898827, 18364029)
+
+	w, err := store.Writer(ctx, satelliteID, pieceID)
+	require.NoError(t, err)
+	_, err = w.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Commit(ctx, &pb.PieceHeader{
+		Hash:         fakeHash,
+		CreationTime: creationTime,
+		OrderLimit: pb.OrderLimit{
+			PieceExpiration: expirationTime.UTC(),
+		},
+	}))
+
+	r, err := store.Reader(ctx, satelliteID, pieceID)
+	require.NoError(t, err)
+	defer ctx.Check(r.Close)
+	assert.Equal(t, filestore.FormatV2, r.StorageFormatVersion())
+	assert.Equal(t, int64(len(content)), r.Size())
+
+	// the header info can be read without reading the piece header first
+	info, err := r.GetHeaderInfo()
+	require.NoError(t, err)
+	assert.Equal(t, filestore.FormatV2, info.FormatVersion)
+	assert.Truef(t, info.CreationTime.Equal(creationTime),
+		"info.CreationTime = %s, but expected creationTime = %s", info.CreationTime, creationTime)
+	assert.Truef(t, info.Expiration.Equal(expirationTime),
+		"info.Expiration = %s, but expected expirationTime = %s", info.Expiration, expirationTime)
+	assert.Equal(t, pieces.CurrentSignatureVersion, info.SignatureVersion)
+
+	header, err := r.GetPieceHeader()
+	require.NoError(t, err)
+	assert.Equal(t, fakeHash, header.Hash)
+	assert.Truef(t, header.CreationTime.Equal(creationTime),
+		"header.CreationTime = %s, but expected creationTime = %s", header.CreationTime, creationTime)
+	assert.Equal(t, filestore.FormatV2, storage.FormatVersion(header.FormatVersion))
+
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, content, data)
+}
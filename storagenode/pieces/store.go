@@ -50,6 +50,13 @@ type ExpiredInfo struct {
 	InPieceInfo bool
 }
 
+// ExpirationInfo is the expiration time of a piece.
+type ExpirationInfo struct {
+	SatelliteID     storj.NodeID
+	PieceID         storj.PieceID
+	PieceExpiration time.Time
+}
+
 // PieceExpirationDB stores information about pieces with expiration dates.
 //
 // architecture: Database
@@ -58,8 +65,14 @@ type PieceExpirationDB interface {
 	GetExpired(ctx context.Context, expiresBefore time.Time, limit int64) ([]ExpiredInfo, error)
 	// SetExpiration sets an expiration time for the given piece ID on the given satellite
 	SetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, expiresAt time.Time) error
+	// SetExpirations sets the expiration times of a batch of pieces. When a
+	// piece appears more than once, or already has an expiration time, the
+	// last expiration time wins.
+	SetExpirations(ctx context.Context, expirations []ExpirationInfo) error
 	// DeleteExpiration removes an expiration record for the given piece ID on the given satellite
 	DeleteExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (found bool, err error)
+	// DeleteExpirations removes the expiration records of a batch of pieces
+	DeleteExpirations(ctx context.Context, expired []ExpiredInfo) error
 	// DeleteFailed marks an expiration record as having experienced a failure in deleting the
 	// piece from the disk
 	DeleteFailed(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, failedAt time.Time) error
@@ -67,6 +80,8 @@ type PieceExpirationDB interface {
 	Trash(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error
 	// RestoreTrash marks all piece as not being in trash
 	RestoreTrash(ctx context.Context, satelliteID storj.NodeID) error
+	// DeleteSatellite removes the expiration records of all the pieces of the satellite
+	DeleteSatellite(ctx context.Context, satelliteID storj.NodeID) error
 }
 
 // V0PieceInfoDB stores meta information about pieces stored with storage format V0 (where
@@ -156,11 +171,16 @@ type SatelliteUsage struct {
 type Config struct {
 	WritePreallocSize memory.Size `help:"file preallocated for uploading" default:"4MiB"`
 	DeleteToTrash     bool        `help:"move pieces to trash upon deletion. Warning: if set to false, you risk disqualification for failed audits if a satellite database is restored from backup." default:"true"`
+	RewriteHeaders    bool        `help:"rewrite the pieces stored with older storage formats to the written format when their header info is read. v1 pieces are only rewritten when filestore.write-format-v2 is set" default:"false"`
+
+	ScanOnStartup bool          `help:"walk all the pieces on startup to calculate the space used. when false, the space used totals persisted by the previous run are used, if there are any" default:"true"`
+	ScanInterval  time.Duration `help:"how often all the pieces are walked to reconcile the space used totals tracked from the piece additions and deletions. 0 disables the walks after startup" default:"0s"`
 }
 
 // DefaultConfig is the default value for the Config.
 var DefaultConfig = Config{
 	WritePreallocSize: 4 * memory.MiB,
+	ScanOnStartup:     true,
 }
 
 // Store implements storing pieces onto a blob storage implementation.
@@ -244,7 +264,7 @@ func (store StoreForTest) WriterForFormatVersion(ctx context.Context, satellite
 			return nil, Error.New("can't make a WriterForFormatVersion with this blob store (%T)", store.blobs)
 		}
 		blobWriter, err = fStore.TestCreateV0(ctx, blobRef)
-	case filestore.FormatV1:
+	case store.writeFormatVersion():
 		blobWriter, err = store.blobs.Create(ctx, blobRef, store.config.WritePreallocSize.Int64())
 	default:
 		return nil, Error.New("please teach me how to make V%d pieces", formatVersion)
@@ -274,6 +294,17 @@ func (store *Store) Reader(ctx context.Context, satellite storj.NodeID, pieceID
 	return reader, Error.Wrap(err)
 }
 
+// Stat looks up disk metadata on the piece file. It returns an error
+// matching os.ErrNotExist when the piece is not stored.
+func (store *Store) Stat(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	info, err := store.blobs.Stat(ctx, storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	})
+	return info, Error.Wrap(err)
+}
+
 // ReaderWithStorageFormat returns a new piece reader for a located piece, which avoids the
 // potential need to check multiple storage formats to find the right blob.
 func (store *Store) ReaderWithStorageFormat(ctx context.Context, satellite storj.NodeID,
@@ -304,13 +335,11 @@ func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID
 		return Error.Wrap(err)
 	}
 
-	// delete records in both the piece_expirations and pieceinfo DBs, wherever we find it.
-	// both of these calls should return no error if the requested record is not found.
-	if store.expirationInfo != nil {
-		_, err = store.expirationInfo.DeleteExpiration(ctx, satellite, pieceID)
-	}
+	// the piece_expirations record is deleted lazily, by the collector once
+	// the piece expires, to avoid a database write for every deleted piece.
+	// this call should return no error if the requested record is not found.
 	if store.v0PieceInfo != nil {
-		err = errs.Combine(err, store.v0PieceInfo.Delete(ctx, satellite, pieceID))
+		err = store.v0PieceInfo.Delete(ctx, satellite, pieceID)
 	}
 
 	store.log.Debug("deleted piece", zap.String("Satellite ID", satellite.String()),
@@ -328,23 +357,23 @@ func (store *Store) DeleteSatelliteBlobs(ctx context.Context, satellite storj.No
 }
 
 // Trash moves the specified piece to the blob trash. If necessary, it converts
-// the v0 piece to a v1 piece. It also marks the item as "trashed" in the
-// pieceExpirationDB.
+// the v0 piece to a piece with the written storage format. It also marks the
+// item as "trashed" in the pieceExpirationDB.
 func (store *Store) Trash(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	// Check if the MaxFormatVersionSupported piece exists. If not, we assume
-	// this is an old piece version and attempt to migrate it.
-	_, err = store.blobs.StatWithStorageFormat(ctx, storage.BlobRef{
+	// Check if the piece is stored with a format, which carries a piece
+	// header. If not, we assume this is an old piece version and attempt to
+	// migrate it.
+	info, err := store.blobs.Stat(ctx, storage.BlobRef{
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
-	}, filestore.MaxFormatVersionSupported)
+	})
 	if err != nil && !errs.IsFunc(err, os.IsNotExist) {
 		return Error.Wrap(err)
 	}
 
-	if errs.IsFunc(err, os.IsNotExist) {
-		// MaxFormatVersionSupported does not exist, migrate.
+	if errs.IsFunc(err, os.IsNotExist) || info.StorageFormatVersion() < filestore.FormatV1 {
 		err = store.MigrateV0ToV1(ctx, satellite, pieceID)
 		if err != nil {
 			return Error.Wrap(err)
@@ -380,6 +409,45 @@ func (store *Store) EmptyTrash(ctx context.Context, satelliteID storj.NodeID, tr
 	return Error.Wrap(err)
 }
 
+// EmptyAllTrash deletes pieces in the trash of every satellite the node
+// stores pieces for, which have been trashed before trashedBefore.
+func (store *Store) EmptyAllTrash(ctx context.Context, trashedBefore time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellites, err := store.getAllStoringSatellites(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, satelliteID := range satellites {
+		group.Add(store.EmptyTrash(ctx, satelliteID, trashedBefore))
+	}
+	return group.Err()
+}
+
+// ForgetSatellite deletes all the pieces and the trash of the satellite. It
+// is meant to reclaim the space used by satellites that were decommissioned
+// or are no longer trusted.
+func (store *Store) ForgetSatellite(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// everything that has been trashed up to now is deleted.
+	if err := store.EmptyTrash(ctx, satelliteID, time.Now()); err != nil {
+		return err
+	}
+
+	return store.DeleteSatelliteBlobs(ctx, satelliteID)
+}
+
+// DeleteSatelliteExpirations removes the expiration records of all the pieces
+// of the satellite, including the trashed ones.
+func (store *Store) DeleteSatelliteExpirations(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(store.expirationInfo.DeleteSatellite(ctx, satelliteID))
+}
+
 // RestoreTrash restores all pieces in the trash.
 func (store *Store) RestoreTrash(ctx context.Context, satelliteID storj.NodeID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -391,8 +459,9 @@ func (store *Store) RestoreTrash(ctx context.Context, satelliteID storj.NodeID)
 	return Error.Wrap(store.expirationInfo.RestoreTrash(ctx, satelliteID))
 }
 
-// MigrateV0ToV1 will migrate a piece stored with storage format v0 to storage
-// format v1. If the piece is not stored as a v0 piece it will return an error.
+// MigrateV0ToV1 will migrate a piece stored with storage format v0 to the
+// written storage format, which is v1 unless the blob store writes v2 pieces.
+// If the piece is not stored as a v0 piece it will return an error.
 // The follow failures are possible:
 //   - Fail to open or read v0 piece. In this case no artifacts remain.
 //   - Fail to Write or Commit v1 piece. In this case no artifacts remain.
@@ -458,6 +527,156 @@ func (store StoreForTest) GetV0PieceInfoDBForTest() V0PieceInfoDBForTest {
 	return store.v0PieceInfo.(V0PieceInfoDBForTest)
 }
 
+// MigrateV1ToV2 will migrate a piece stored with storage format v1 to storage
+// format v2. If the piece is not stored as a v1 piece, or the blob store
+// doesn't write v2 pieces, it will return an error.
+// The possible failures are the same as for MigrateV0ToV1.
+func (store *Store) MigrateV1ToV2(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if store.writeFormatVersion() < filestore.FormatV2 {
+		return Error.New("storage format v2 is not enabled")
+	}
+
+	var contentSize int64
+	err = func() (err error) {
+		r, err := store.ReaderWithStorageFormat(ctx, satelliteID, pieceID, filestore.FormatV1)
+		if err != nil {
+			return err
+		}
+		defer func() { err = errs.Combine(err, r.Close()) }()
+		contentSize = r.Size()
+
+		header, err := r.GetPieceHeader()
+		if err != nil {
+			return err
+		}
+
+		w, err := store.Writer(ctx, satelliteID, pieceID)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.Copy(w, r)
+		if err != nil {
+			return errs.Combine(err, w.Cancel(ctx))
+		}
+
+		return w.Commit(ctx, header)
+	}()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = store.blobs.DeleteWithStorageFormat(ctx, storage.BlobRef{
+		Namespace: satelliteID.Bytes(),
+		Key:       pieceID.Bytes(),
+	}, filestore.FormatV1)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the writer added the v2 piece to the cache, but deleting with the
+	// storage format bypasses it.
+	if cache, ok := store.blobs.(*BlobsUsageCache); ok {
+		cache.Update(ctx, satelliteID, -(contentSize + V1PieceHeaderReservedArea), -contentSize, 0)
+	}
+	return nil
+}
+
+// HeaderInfo returns the creation time, expiration and signature version of
+// the piece. For pieces stored with storage format v2 it doesn't need to
+// unmarshal the piece header, and for v0 pieces it reads the V0 piece info
+// database.
+//
+// When Config.RewriteHeaders is set, the pieces stored with older storage
+// formats than the written one are rewritten afterwards, so the next reads
+// are cheaper. A failed rewrite is only logged.
+func (store *Store) HeaderInfo(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ HeaderInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := func() (_ HeaderInfo, err error) {
+		reader, err := store.Reader(ctx, satellite, pieceID)
+		if err != nil {
+			return HeaderInfo{}, err
+		}
+		defer func() { err = errs.Combine(err, reader.Close()) }()
+
+		if reader.StorageFormatVersion() == filestore.FormatV0 {
+			v0Info, err := store.GetV0PieceInfo(ctx, satellite, pieceID)
+			if err != nil {
+				return HeaderInfo{}, err
+			}
+			return HeaderInfo{
+				FormatVersion:    filestore.FormatV0,
+				CreationTime:     v0Info.PieceCreation,
+				Expiration:       v0Info.PieceExpiration,
+				SignatureVersion: SignatureV1,
+			}, nil
+		}
+		return reader.GetHeaderInfo()
+	}()
+	if err != nil {
+		return HeaderInfo{}, err
+	}
+
+	if store.RewritesHeader(info.FormatVersion) {
+		var rewriteErr error
+		switch info.FormatVersion {
+		case filestore.FormatV0:
+			rewriteErr = store.MigrateV0ToV1(ctx, satellite, pieceID)
+		case filestore.FormatV1:
+			rewriteErr = store.MigrateV1ToV2(ctx, satellite, pieceID)
+		}
+		if rewriteErr != nil {
+			store.log.Warn("failed to rewrite piece to the latest storage format",
+				zap.Stringer("Satellite ID", satellite),
+				zap.Stringer("Piece ID", pieceID),
+				zap.Error(rewriteErr))
+		}
+	}
+
+	return info, nil
+}
+
+// RewritesHeader returns whether HeaderInfo rewrites the pieces stored with
+// the given storage format.
+func (store *Store) RewritesHeader(formatVersion storage.FormatVersion) bool {
+	return store.config.RewriteHeaders && formatVersion < store.writeFormatVersion()
+}
+
+// HeaderExpiration returns the expiration kept in the header of a piece stored
+// with storage format v2 or greater, without rewriting the piece. ok is false
+// for the pieces of older storage formats, their expiration is only known from
+// the piece expiration database.
+func (store *Store) HeaderExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (_ time.Time, ok bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := store.Reader(ctx, satellite, pieceID)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	if reader.StorageFormatVersion() < filestore.FormatV2 {
+		return time.Time{}, false, nil
+	}
+
+	info, err := reader.GetHeaderInfo()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return info.Expiration, true, nil
+}
+
+// writeFormatVersion returns the storage format version of the written pieces.
+func (store *Store) writeFormatVersion() storage.FormatVersion {
+	if blobs, ok := store.blobs.(interface{ WriteFormatVersion() storage.FormatVersion }); ok {
+		return blobs.WriteFormatVersion()
+	}
+	return filestore.MaxFormatVersionSupported
+}
+
 // GetHashAndLimit returns the PieceHash and OrderLimit associated with the specified piece. The
 // piece must already have been opened for reading, and the associated *Reader passed in.
 //
@@ -537,6 +756,31 @@ func (store *Store) SetExpiration(ctx context.Context, satellite storj.NodeID, p
 	return store.expirationInfo.SetExpiration(ctx, satellite, pieceID, expiresAt)
 }
 
+// SetExpirations records the expiration times of a batch of pieces.
+func (store *Store) SetExpirations(ctx context.Context, expirations []ExpirationInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return store.expirationInfo.SetExpirations(ctx, expirations)
+}
+
+// DeleteExpirations removes the expiration records of expired pieces which
+// have been deleted. The records of pieces stored with storage format V0 are
+// removed when the piece is deleted.
+func (store *Store) DeleteExpirations(ctx context.Context, expired []ExpiredInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var batch []ExpiredInfo
+	for _, info := range expired {
+		if !info.InPieceInfo {
+			batch = append(batch, info)
+		}
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+	return store.expirationInfo.DeleteExpirations(ctx, batch)
+}
+
 // DeleteFailed marks piece as a failed deletion.
 func (store *Store) DeleteFailed(ctx context.Context, expired ExpiredInfo, when time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -690,8 +934,9 @@ func (store *Store) GetV0PieceInfo(ctx context.Context, satellite storj.NodeID,
 
 // StorageStatus contains information about the disk store is using.
 type StorageStatus struct {
-	DiskUsed int64
-	DiskFree int64
+	DiskUsed  int64
+	DiskFree  int64
+	DiskTotal int64
 }
 
 // StorageStatus returns information about the disk.
@@ -701,9 +946,14 @@ func (store *Store) StorageStatus(ctx context.Context) (_ StorageStatus, err err
 	if err != nil {
 		return StorageStatus{}, err
 	}
+	diskTotal, err := store.blobs.TotalSpace(ctx)
+	if err != nil {
+		return StorageStatus{}, err
+	}
 	return StorageStatus{
-		DiskUsed: -1, // TODO set value
-		DiskFree: diskFree,
+		DiskUsed:  -1, // TODO set value
+		DiskFree:  diskFree,
+		DiskTotal: diskTotal,
 	}, nil
 }
 
@@ -768,11 +1018,13 @@ func (access storedPieceAccess) CreationTime(ctx context.Context) (cTime time.Ti
 	if err != nil {
 		return time.Time{}, err
 	}
-	header, err := reader.GetPieceHeader()
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	info, err := reader.GetHeaderInfo()
 	if err != nil {
 		return time.Time{}, err
 	}
-	return header.CreationTime, nil
+	return info.CreationTime, nil
 }
 
 // ModTime returns a less-precise piece creation time than CreationTime, but is generally
@@ -514,6 +514,42 @@ func verifyPieceData(ctx context.Context, t testing.TB, store *pieces.Store, sat
 	}
 }
 
+func TestForgetSatellite(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		dir, err := filestore.NewDir(zaptest.NewLogger(t), ctx.Dir("store"))
+		require.NoError(t, err)
+
+		blobs := filestore.New(zaptest.NewLogger(t), dir, filestore.DefaultConfig)
+		defer ctx.Check(blobs.Close)
+
+		store := pieces.NewStore(zaptest.NewLogger(t), blobs, db.V0PieceInfo(), db.PieceExpirationDB(), nil, pieces.DefaultConfig)
+
+		forgotten, kept := testrand.NodeID(), testrand.NodeID()
+		forgottenPiece, trashedPiece, keptPiece := testrand.PieceID(), testrand.PieceID(), testrand.PieceID()
+		now := time.Now()
+
+		writeAPiece(ctx, t, store, forgotten, forgottenPiece, testrand.Bytes(memory.KiB), now, nil, filestore.FormatV1)
+		writeAPiece(ctx, t, store, forgotten, trashedPiece, testrand.Bytes(memory.KiB), now, nil, filestore.FormatV1)
+		writeAPiece(ctx, t, store, kept, keptPiece, testrand.Bytes(memory.KiB), now, nil, filestore.FormatV1)
+		require.NoError(t, store.Trash(ctx, forgotten, trashedPiece))
+
+		require.NoError(t, store.ForgetSatellite(ctx, forgotten))
+
+		_, err = store.Reader(ctx, forgotten, forgottenPiece)
+		require.True(t, errors.Is(err, os.ErrNotExist))
+
+		// the trashed piece is gone too, so it cannot be restored.
+		require.NoError(t, store.RestoreTrash(ctx, forgotten))
+		_, err = store.Reader(ctx, forgotten, trashedPiece)
+		require.True(t, errors.Is(err, os.ErrNotExist))
+
+		tryOpeningAPiece(ctx, t, store, kept, keptPiece, memory.KiB.Int(), now, filestore.FormatV1)
+
+		// forgetting a satellite without any data is not an error.
+		require.NoError(t, store.ForgetSatellite(ctx, testrand.NodeID()))
+	})
+}
+
 func TestPieceVersionMigrate(t *testing.T) {
 	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
 		const pieceSize = 1024
@@ -685,6 +721,73 @@ func TestMultipleStorageFormatVersions(t *testing.T) {
 	assert.Nil(t, reader)
 }
 
+func TestHeaderInfoRewrite(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	v1Blobs, err := filestore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), filestore.DefaultConfig)
+	require.NoError(t, err)
+	defer ctx.Check(v1Blobs.Close)
+	v1Store := pieces.NewStore(zaptest.NewLogger(t), v1Blobs, nil, nil, nil, pieces.DefaultConfig)
+
+	v2Config := filestore.DefaultConfig
+	v2Config.WriteFormatV2 = true
+	v2Blobs, err := filestore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), v2Config)
+	require.NoError(t, err)
+	defer ctx.Check(v2Blobs.Close)
+
+	config := pieces.DefaultConfig
+	config.RewriteHeaders = true
+	v2Store := pieces.NewStore(zaptest.NewLogger(t), v2Blobs, nil, nil, nil, config)
+
+	var (
+		data       = testrand.Bytes(memory.KiB)
+		satellite  = testrand.NodeID()
+		v1PieceID  = testrand.PieceID()
+		v2PieceID  = testrand.PieceID()
+		now        = time.Now()
+		expiration = now.Add(24 * time.Hour)
+	)
+
+	writeAPiece(ctx, t, v1Store, satellite, v1PieceID, data, now, &expiration, filestore.FormatV1)
+	writeAPiece(ctx, t, v2Store, satellite, v2PieceID, data, now, nil, filestore.FormatV2)
+
+	// v1 pieces aren't rewritten when the blob store doesn't write v2 pieces.
+	require.Error(t, v1Store.MigrateV1ToV2(ctx, satellite, v1PieceID))
+	tryOpeningAPiece(ctx, t, v1Store, satellite, v1PieceID, len(data), now, filestore.FormatV1)
+
+	info, err := v2Store.HeaderInfo(ctx, satellite, v2PieceID)
+	require.NoError(t, err)
+	require.Equal(t, filestore.FormatV2, info.FormatVersion)
+	require.True(t, now.Equal(info.CreationTime))
+	require.True(t, info.Expiration.IsZero())
+	require.Equal(t, pieces.SignatureV1, info.SignatureVersion)
+
+	// the v1 piece is rewritten as v2 after reading its header info.
+	info, err = v2Store.HeaderInfo(ctx, satellite, v1PieceID)
+	require.NoError(t, err)
+	require.Equal(t, filestore.FormatV1, info.FormatVersion)
+	require.True(t, now.Equal(info.CreationTime))
+	require.True(t, expiration.Equal(info.Expiration))
+
+	_, err = v2Store.ReaderWithStorageFormat(ctx, satellite, v1PieceID, filestore.FormatV1)
+	require.Error(t, err)
+	tryOpeningAPiece(ctx, t, v2Store, satellite, v1PieceID, len(data), now, filestore.FormatV2)
+
+	info, err = v2Store.HeaderInfo(ctx, satellite, v1PieceID)
+	require.NoError(t, err)
+	require.Equal(t, filestore.FormatV2, info.FormatVersion)
+	require.True(t, now.Equal(info.CreationTime))
+	require.True(t, expiration.Equal(info.Expiration))
+
+	reader, err := v2Store.Reader(ctx, satellite, v1PieceID)
+	require.NoError(t, err)
+	content, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, data, content)
+	require.NoError(t, reader.Close())
+}
+
 func TestGetExpired(t *testing.T) {
 	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
 		v0PieceInfo, ok := db.V0PieceInfo().(pieces.V0PieceInfoDBForTest)
@@ -16,7 +16,6 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
-	"storj.io/common/bloomfilter"
 	"storj.io/common/context2"
 	"storj.io/common/errs2"
 	"storj.io/common/identity"
@@ -28,6 +27,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/drpc"
+	"storj.io/storj/private/retainfilter"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
@@ -54,8 +54,16 @@ type OldConfig struct {
 // Config defines parameters for piecestore endpoint.
 type Config struct {
 	DatabaseDir             string        `help:"directory to store databases. if empty, uses data path" default:""`
+	DatabaseBackend         string        `help:"layout of the databases, 'sqlite' uses a database per component and 'sqlite-consolidated' a single database. it can only be chosen when the node is set up" default:"sqlite"`
+	DatabaseJournalMode     string        `help:"SQLite journal mode of the databases" default:"WAL"`
+	DatabaseSynchronous     string        `help:"SQLite synchronous setting of the databases (OFF, NORMAL, FULL or EXTRA). if empty, uses the SQLite default" default:""`
+	DatabaseBusyTimeout     time.Duration `help:"how long to wait for a locked database before failing" default:"10s"`
 	ExpirationGracePeriod   time.Duration `help:"how soon before expiration date should things be considered expired" default:"48h0m0s"`
-	MaxConcurrentRequests   int           `help:"how many concurrent requests are allowed, before uploads are rejected. 0 represents unlimited." default:"0"`
+	MaxConcurrentRequests   int           `help:"how many concurrent uploads are allowed, before uploads are rejected. 0 represents unlimited. (deprecated, use max-concurrent-uploads)" default:"0"`
+	MaxConcurrentUploads    int           `help:"how many concurrent uploads are allowed, before uploads are rejected. the slots are shared fairly between satellites. 0 represents unlimited." default:"0"`
+	MaxConcurrentDownloads  int           `help:"how many concurrent downloads are allowed, before downloads are rejected. the slots are shared fairly between satellites. 0 represents unlimited." default:"0"`
+	ReservedSlots           float64       `help:"the portion of the upload and download slots, which is reserved evenly for the trusted satellites. a satellite holding its reserved slots can't take the slots reserved for the others" default:"0.5"`
+	SlotWaitTimeout         time.Duration `help:"how long an upload or download waits for a slot to be released, before it is rejected. 0 rejects it right away" default:"0s"`
 	DeleteWorkers           int           `help:"how many piece delete workers" default:"1"`
 	DeleteQueueSize         int           `help:"size of the piece delete queue" default:"10000"`
 	OrderLimitGracePeriod   time.Duration `help:"how long after OrderLimit creation date are OrderLimits no longer accepted" default:"1h0m0s"`
@@ -67,12 +75,13 @@ type Config struct {
 
 	MinUploadSpeed                    memory.Size   `help:"a client upload speed should not be lower than MinUploadSpeed in bytes-per-second (E.g: 1Mb), otherwise, it will be flagged as slow-connection and potentially be closed" default:"0Mb"`
 	MinUploadSpeedGraceDuration       time.Duration `help:"if MinUploadSpeed is configured, after a period of time after the client initiated the upload, the server will flag unusually slow upload client" default:"0h0m10s"`
-	MinUploadSpeedCongestionThreshold float64       `help:"if the portion defined by the total number of alive connection per MaxConcurrentRequest reaches this threshold, a slow upload client will no longer be monitored and flagged" default:"0.8"`
+	MinUploadSpeedCongestionThreshold float64       `help:"if the portion defined by the total number of alive uploads per MaxConcurrentUploads reaches this threshold, a slow upload client will no longer be monitored and flagged" default:"0.8"`
 
 	Trust trust.Config
 
-	Monitor monitor.Config
-	Orders  orders.Config
+	Monitor   monitor.Config
+	Orders    orders.Config
+	Inventory InventoryConfig
 }
 
 type pingStatsSource interface {
@@ -97,14 +106,26 @@ type Endpoint struct {
 	store        *pieces.Store
 	ordersStore  *orders.FileStore
 	usage        bandwidth.DB
+	egressCaps   *bandwidth.Caps
 	usedSerials  *usedserials.Table
 	pieceDeleter *pieces.Deleter
+	inventory    *inventoryLimiter
+	uploads      *slotLimiter
+	downloads    *slotLimiter
 
 	liveRequests int32
 }
 
 // NewEndpoint creates a new piecestore endpoint.
-func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, retain *retain.Service, pingStats pingStatsSource, store *pieces.Store, pieceDeleter *pieces.Deleter, ordersStore *orders.FileStore, usage bandwidth.DB, usedSerials *usedserials.Table, config Config) (*Endpoint, error) {
+func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, retain *retain.Service, pingStats pingStatsSource, store *pieces.Store, pieceDeleter *pieces.Deleter, ordersStore *orders.FileStore, usage bandwidth.DB, egressCaps *bandwidth.Caps, usedSerials *usedserials.Table, config Config) (*Endpoint, error) {
+	if config.MaxConcurrentUploads == 0 {
+		config.MaxConcurrentUploads = config.MaxConcurrentRequests
+	}
+
+	satellites := func() []storj.NodeID {
+		return trust.GetSatellites(context.Background())
+	}
+
 	return &Endpoint{
 		log:    log,
 		config: config,
@@ -118,8 +139,12 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, moni
 		store:        store,
 		ordersStore:  ordersStore,
 		usage:        usage,
+		egressCaps:   egressCaps,
 		usedSerials:  usedSerials,
 		pieceDeleter: pieceDeleter,
+		inventory:    newInventoryLimiter(config.Inventory),
+		uploads:      newSlotLimiter(config.MaxConcurrentUploads, config.ReservedSlots, config.SlotWaitTimeout, satellites),
+		downloads:    newSlotLimiter(config.MaxConcurrentDownloads, config.ReservedSlots, config.SlotWaitTimeout, satellites),
 
 		liveRequests: 0,
 	}, nil
@@ -192,20 +217,22 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 	defer monLiveRequests(&ctx)(&err)
 	defer mon.Task()(&ctx)(&err)
 
-	liveRequests := atomic.AddInt32(&endpoint.liveRequests, 1)
+	// committed is set to true when the piece is committed.
+	// It is used to distinguish successful pieces where the uplink cancels the connections,
+	// and pieces that were actually canceled before being completed.
+	var committed bool
+	defer func() {
+		if committed && (errs2.IsCanceled(err) || drpc.ClosedError.Has(err)) {
+			return
+		}
+		monErrorKind("upload", err)
+	}()
+
+	atomic.AddInt32(&endpoint.liveRequests, 1)
 	defer atomic.AddInt32(&endpoint.liveRequests, -1)
 
 	endpoint.pingStats.WasPinged(time.Now())
 
-	if endpoint.config.MaxConcurrentRequests > 0 && int(liveRequests) > endpoint.config.MaxConcurrentRequests {
-		endpoint.log.Error("upload rejected, too many requests",
-			zap.Int32("live requests", liveRequests),
-			zap.Int("requestLimit", endpoint.config.MaxConcurrentRequests),
-		)
-		errMsg := fmt.Sprintf("storage node overloaded, request limit: %d", endpoint.config.MaxConcurrentRequests)
-		return rpcstatus.Error(rpcstatus.Unavailable, errMsg)
-	}
-
 	startTime := time.Now().UTC()
 
 	// TODO: set maximum message size
@@ -231,6 +258,18 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 		return rpcstatus.Errorf(rpcstatus.InvalidArgument, "expected put or put repair action got %v", limit.Action)
 	}
 
+	if !endpoint.uploads.acquire(ctx, limit.SatelliteId) {
+		mon.Meter("upload_slot_rejected").Mark(1)
+		endpoint.log.Error("upload rejected, too many requests",
+			zap.Stringer("Satellite ID", limit.SatelliteId),
+			zap.Int("live uploads", endpoint.uploads.count()),
+			zap.Int("requestLimit", endpoint.config.MaxConcurrentUploads),
+		)
+		errMsg := fmt.Sprintf("storage node overloaded, request limit: %d", endpoint.config.MaxConcurrentUploads)
+		return rpcstatus.Error(rpcstatus.Unavailable, errMsg)
+	}
+	defer endpoint.uploads.release(limit.SatelliteId)
+
 	if err := endpoint.verifyOrderLimit(ctx, limit); err != nil {
 		return err
 	}
@@ -251,10 +290,6 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 	}
 
 	var pieceWriter *pieces.Writer
-	// committed is set to true when the piece is committed.
-	// It is used to distinguish successful pieces where the uplink cancels the connections,
-	// and pieces that were actually canceled before being completed.
-	var committed bool
 	defer func() {
 		endTime := time.Now().UTC()
 		dt := endTime.Sub(startTime)
@@ -274,14 +309,14 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 			mon.IntVal("upload_failure_size_bytes").Observe(uploadSize)
 			mon.IntVal("upload_failure_duration_ns").Observe(uploadDuration)
 			mon.FloatVal("upload_failure_rate_bytes_per_sec").Observe(uploadRate)
-			endpoint.log.Error("upload failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Error(err), zap.Int64("Size", uploadSize))
+			endpoint.log.Error("upload failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.String("Error Kind", string(classifyError(err))), zap.Error(err), zap.Int64("Size", uploadSize))
 		} else if (errs2.IsCanceled(err) || drpc.ClosedError.Has(err)) && !committed {
 			mon.Counter("upload_cancel_count").Inc(1)
 			mon.Meter("upload_cancel_byte_meter").Mark64(uploadSize)
 			mon.IntVal("upload_cancel_size_bytes").Observe(uploadSize)
 			mon.IntVal("upload_cancel_duration_ns").Observe(uploadDuration)
 			mon.FloatVal("upload_cancel_rate_bytes_per_sec").Observe(uploadRate)
-			endpoint.log.Info("upload canceled", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Int64("Size", uploadSize))
+			endpoint.log.Info("upload canceled", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.String("Error Kind", string(classifyError(err))), zap.Int64("Size", uploadSize))
 		} else {
 			mon.Counter("upload_success_count").Inc(1)
 			mon.Meter("upload_success_byte_meter").Mark64(uploadSize)
@@ -440,13 +475,13 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 }
 
 // isCongested identifies state of congestion. If the total number of
-// connections is above 80% of the MaxConcurrentRequests, then it is defined
+// uploads is above 80% of the MaxConcurrentUploads, then it is defined
 // as congestion.
 func (endpoint *Endpoint) isCongested() bool {
 
-	requestCongestionThreshold := int32(float64(endpoint.config.MaxConcurrentRequests) * endpoint.config.MinUploadSpeedCongestionThreshold)
+	requestCongestionThreshold := int(float64(endpoint.config.MaxConcurrentUploads) * endpoint.config.MinUploadSpeedCongestionThreshold)
 
-	connectionCount := atomic.LoadInt32(&endpoint.liveRequests)
+	connectionCount := endpoint.uploads.count()
 	return connectionCount > requestCongestionThreshold
 }
 
@@ -455,6 +490,7 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 	ctx := stream.Context()
 	defer monLiveRequests(&ctx)(&err)
 	defer mon.Task()(&ctx)(&err)
+	defer func() { monErrorKind("download", err) }()
 
 	atomic.AddInt32(&endpoint.liveRequests, 1)
 	defer atomic.AddInt32(&endpoint.liveRequests, -1)
@@ -485,6 +521,18 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 			"expected get or get repair or audit action got %v", limit.Action)
 	}
 
+	if !endpoint.downloads.acquire(ctx, limit.SatelliteId) {
+		mon.Meter("download_slot_rejected").Mark(1)
+		endpoint.log.Error("download rejected, too many requests",
+			zap.Stringer("Satellite ID", limit.SatelliteId),
+			zap.Int("live downloads", endpoint.downloads.count()),
+			zap.Int("requestLimit", endpoint.config.MaxConcurrentDownloads),
+		)
+		errMsg := fmt.Sprintf("storage node overloaded, download limit: %d", endpoint.config.MaxConcurrentDownloads)
+		return rpcstatus.Error(rpcstatus.Unavailable, errMsg)
+	}
+	defer endpoint.downloads.release(limit.SatelliteId)
+
 	if chunk.ChunkSize > limit.Limit {
 		return rpcstatus.Errorf(rpcstatus.InvalidArgument,
 			"requested more that order limit allows, limit=%v requested=%v", limit.Limit, chunk.ChunkSize)
@@ -498,10 +546,21 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 	if err := endpoint.verifyOrderLimit(ctx, limit); err != nil {
 		mon.Counter("download_failure_count", actionSeriesTag).Inc(1)
 		mon.Meter("download_verify_orderlimit_failed", actionSeriesTag).Mark(1)
-		endpoint.log.Error("download failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Error(err))
+		endpoint.log.Error("download failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.String("Error Kind", string(classifyError(err))), zap.Error(err))
 		return err
 	}
 
+	// audits and repairs are not capped, so that the node isn't penalized
+	// for reaching the cap.
+	if limit.Action == pb.PieceAction_GET {
+		if err := endpoint.verifyEgressCap(ctx, limit.SatelliteId); err != nil {
+			mon.Counter("download_failure_count", actionSeriesTag).Inc(1)
+			mon.Meter("download_egress_cap_reached", actionSeriesTag).Mark(1)
+			endpoint.log.Info("download rejected", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.String("Error Kind", string(classifyError(err))), zap.Error(err))
+			return err
+		}
+	}
+
 	var pieceReader *pieces.Reader
 	defer func() {
 		endTime := time.Now().UTC()
@@ -521,14 +580,14 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 			mon.IntVal("download_cancel_size_bytes", actionSeriesTag).Observe(downloadSize)
 			mon.IntVal("download_cancel_duration_ns", actionSeriesTag).Observe(downloadDuration)
 			mon.FloatVal("download_cancel_rate_bytes_per_sec", actionSeriesTag).Observe(downloadRate)
-			endpoint.log.Info("download canceled", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action))
+			endpoint.log.Info("download canceled", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.String("Error Kind", string(classifyError(err))))
 		} else if err != nil {
 			mon.Counter("download_failure_count", actionSeriesTag).Inc(1)
 			mon.Meter("download_failure_byte_meter", actionSeriesTag).Mark64(downloadSize)
 			mon.IntVal("download_failure_size_bytes", actionSeriesTag).Observe(downloadSize)
 			mon.IntVal("download_failure_duration_ns", actionSeriesTag).Observe(downloadDuration)
 			mon.FloatVal("download_failure_rate_bytes_per_sec", actionSeriesTag).Observe(downloadRate)
-			endpoint.log.Error("download failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Error(err))
+			endpoint.log.Error("download failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.String("Error Kind", string(classifyError(err))), zap.Error(err))
 		} else {
 			mon.Counter("download_success_count", actionSeriesTag).Inc(1)
 			mon.Meter("download_success_byte_meter", actionSeriesTag).Mark64(downloadSize)
@@ -772,7 +831,7 @@ func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainReques
 		return nil, rpcstatus.Errorf(rpcstatus.PermissionDenied, "retain called with untrusted ID")
 	}
 
-	filter, err := bloomfilter.NewFromBytes(retainReq.GetFilter())
+	filter, err := retainfilter.Decode(retainReq.GetFilter())
 	if err != nil {
 		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
 	}
@@ -623,6 +623,8 @@ func TestTooManyRequests(t *testing.T) {
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: uplinkCount,
 		Reconfigure: testplanet.Reconfigure{
 			StorageNode: func(index int, config *storagenode.Config) {
+				// the deprecated limit applies when max-concurrent-uploads isn't set.
+				config.Storage2.MaxConcurrentUploads = 0
 				config.Storage2.MaxConcurrentRequests = maxConcurrent
 			},
 		},
@@ -0,0 +1,96 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"syscall"
+
+	"github.com/spacemonkeygo/monkit/v3"
+
+	"storj.io/common/errs2"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/drpc"
+)
+
+// ErrorKind is the classification of the errors of the piecestore requests.
+// The kinds are stable, so they can be used to filter the logs and metrics,
+// and to distinguish the problems of the node from the network noise.
+type ErrorKind string
+
+const (
+	// ErrorKindClientCanceled is the request canceled by the client, e.g.
+	// because the client got enough pieces from faster nodes.
+	ErrorKindClientCanceled = ErrorKind("client_canceled")
+	// ErrorKindDeadlineExceeded is the request which took too long, e.g.
+	// because of a slow connection or a slow disk.
+	ErrorKindDeadlineExceeded = ErrorKind("deadline_exceeded")
+	// ErrorKindDiskIO is the failure to read or write the disk of the node.
+	ErrorKindDiskIO = ErrorKind("disk_io")
+	// ErrorKindDiskFull is the upload rejected because the node doesn't have
+	// enough available space.
+	ErrorKindDiskFull = ErrorKind("disk_full")
+	// ErrorKindNotFound is the request of a piece which doesn't exist on the node.
+	ErrorKindNotFound = ErrorKind("not_found")
+	// ErrorKindOrderInvalid is the request with an invalid, expired or
+	// untrusted order limit or order.
+	ErrorKindOrderInvalid = ErrorKind("order_invalid")
+	// ErrorKindRateLimited is the request rejected because the node has
+	// too many concurrent requests or the satellite reached its egress cap.
+	ErrorKindRateLimited = ErrorKind("rate_limited")
+	// ErrorKindOther is any other error.
+	ErrorKindOther = ErrorKind("other")
+)
+
+// classifyError returns the kind of the error of a piecestore request.
+// It returns an empty kind for a nil error.
+func classifyError(err error) ErrorKind {
+	if err == nil {
+		return ""
+	}
+
+	// the disk errors are wrapped with various status codes, hence they're
+	// checked first.
+	var pathErr *fs.PathError
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorKindDeadlineExceeded
+	case errs2.IsCanceled(err), drpc.ClosedError.Has(err):
+		return ErrorKindClientCanceled
+	case os.IsNotExist(err), errors.Is(err, fs.ErrNotExist):
+		return ErrorKindNotFound
+	case errors.Is(err, syscall.ENOSPC):
+		return ErrorKindDiskFull
+	case errors.As(err, &pathErr), errors.Is(err, syscall.EIO):
+		return ErrorKindDiskIO
+	}
+
+	switch rpcstatus.Code(err) {
+	case rpcstatus.DeadlineExceeded:
+		return ErrorKindDeadlineExceeded
+	case rpcstatus.NotFound:
+		return ErrorKindNotFound
+	case rpcstatus.Aborted:
+		return ErrorKindDiskFull
+	case rpcstatus.InvalidArgument, rpcstatus.Unauthenticated, rpcstatus.PermissionDenied:
+		return ErrorKindOrderInvalid
+	case rpcstatus.Unavailable, rpcstatus.ResourceExhausted:
+		return ErrorKindRateLimited
+	default:
+		return ErrorKindOther
+	}
+}
+
+// monErrorKind counts the error of the operation by its kind.
+func monErrorKind(operation string, err error, tags ...monkit.SeriesTag) {
+	kind := classifyError(err)
+	if kind == "" {
+		return
+	}
+	tags = append(tags, monkit.NewSeriesTag("kind", string(kind)))
+	mon.Counter(operation+"_error_count", tags...).Inc(1)
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"context"
+	"io/fs"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/drpc"
+)
+
+func TestClassifyError(t *testing.T) {
+	for _, tt := range []struct {
+		err  error
+		kind ErrorKind
+	}{
+		{nil, ""},
+		{context.Canceled, ErrorKindClientCanceled},
+		{rpcstatus.Wrap(rpcstatus.Canceled, errs.New("canceled")), ErrorKindClientCanceled},
+		{drpc.ClosedError.New("closed"), ErrorKindClientCanceled},
+		{errs.Wrap(context.DeadlineExceeded), ErrorKindDeadlineExceeded},
+		{rpcstatus.Wrap(rpcstatus.Internal, &fs.PathError{Op: "read", Path: "piece", Err: syscall.EIO}), ErrorKindDiskIO},
+		{rpcstatus.Wrap(rpcstatus.Internal, &fs.PathError{Op: "write", Path: "piece", Err: syscall.ENOSPC}), ErrorKindDiskFull},
+		{rpcstatus.Wrap(rpcstatus.Internal, &fs.PathError{Op: "open", Path: "piece", Err: fs.ErrNotExist}), ErrorKindNotFound},
+		{rpcstatus.Error(rpcstatus.NotFound, "file does not exist"), ErrorKindNotFound},
+		{rpcstatus.Error(rpcstatus.Aborted, "not enough available disk space"), ErrorKindDiskFull},
+		{rpcstatus.Error(rpcstatus.InvalidArgument, "order expired"), ErrorKindOrderInvalid},
+		{rpcstatus.Error(rpcstatus.Unauthenticated, "invalid signature"), ErrorKindOrderInvalid},
+		{rpcstatus.Error(rpcstatus.PermissionDenied, "untrusted satellite"), ErrorKindOrderInvalid},
+		{rpcstatus.Error(rpcstatus.Unavailable, "storage node overloaded"), ErrorKindRateLimited},
+		{rpcstatus.Error(rpcstatus.ResourceExhausted, "egress cap reached"), ErrorKindRateLimited},
+		{rpcstatus.Error(rpcstatus.Internal, "database is locked"), ErrorKindOther},
+		{errs.New("unknown"), ErrorKindOther},
+	} {
+		require.Equal(t, tt.kind, classifyError(tt.err), "%v", tt.err)
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"bytes"
+	"container/heap"
+	"context"
+	"errors"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/identity"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/pieces"
+)
+
+// InventoryConfig defines parameters for listing the pieces stored for a satellite.
+type InventoryConfig struct {
+	MaxLimit        int     `help:"maximum number of piece ids listed in a single piece inventory request" default:"100000"`
+	BatchSize       int     `help:"number of piece ids sent in a single piece inventory response message" default:"1000"`
+	PiecesPerSecond float64 `help:"maximum number of piece ids sent per second to a satellite. 0 represents unlimited." default:"10000"`
+
+	ExistsMaxPieces int     `help:"maximum number of piece ids checked in a single exists request" default:"1000"`
+	ExistsPerSecond float64 `help:"maximum number of piece ids checked per second for a satellite. 0 represents unlimited." default:"1000"`
+}
+
+// inventoryLimiter allows a single piece inventory listing per satellite at
+// a time and limits the rate at which piece ids are sent and checked.
+type inventoryLimiter struct {
+	config InventoryConfig
+
+	mu       sync.Mutex
+	active   map[storj.NodeID]struct{}
+	limiters map[storj.NodeID]*rate.Limiter
+	exists   map[storj.NodeID]*rate.Limiter
+}
+
+func newInventoryLimiter(config InventoryConfig) *inventoryLimiter {
+	return &inventoryLimiter{
+		config:   config,
+		active:   make(map[storj.NodeID]struct{}),
+		limiters: make(map[storj.NodeID]*rate.Limiter),
+		exists:   make(map[storj.NodeID]*rate.Limiter),
+	}
+}
+
+// acquire marks a listing for the satellite as active and returns its rate
+// limiter. It returns false when there is already a listing in progress.
+func (limiter *inventoryLimiter) acquire(satelliteID storj.NodeID) (_ *rate.Limiter, ok bool) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	if _, active := limiter.active[satelliteID]; active {
+		return nil, false
+	}
+	limiter.active[satelliteID] = struct{}{}
+
+	satelliteLimiter, ok := limiter.limiters[satelliteID]
+	if !ok {
+		limit := rate.Inf
+		if limiter.config.PiecesPerSecond > 0 {
+			limit = rate.Limit(limiter.config.PiecesPerSecond)
+		}
+		satelliteLimiter = rate.NewLimiter(limit, limiter.config.BatchSize)
+		limiter.limiters[satelliteID] = satelliteLimiter
+	}
+	return satelliteLimiter, true
+}
+
+// release marks the listing for the satellite as finished.
+func (limiter *inventoryLimiter) release(satelliteID storj.NodeID) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	delete(limiter.active, satelliteID)
+}
+
+// allowExists reports whether the satellite may check the existence of count
+// pieces now. Requests over the rate are rejected instead of delayed, so that
+// a satellite can't queue up work on the node.
+func (limiter *inventoryLimiter) allowExists(satelliteID storj.NodeID, count int) bool {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	satelliteLimiter, ok := limiter.exists[satelliteID]
+	if !ok {
+		limit := rate.Inf
+		if limiter.config.ExistsPerSecond > 0 {
+			limit = rate.Limit(limiter.config.ExistsPerSecond)
+		}
+		satelliteLimiter = rate.NewLimiter(limit, limiter.config.ExistsMaxPieces)
+		limiter.exists[satelliteID] = satelliteLimiter
+	}
+	return satelliteLimiter.AllowN(time.Now(), count)
+}
+
+// ListPieces streams the ids of the pieces stored for the calling satellite
+// in ascending order, starting after the requested cursor.
+func (endpoint *Endpoint) ListPieces(req *internalpb.ListPiecesRequest, stream internalpb.DRPCPieceInventory_ListPiecesStream) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	err = endpoint.trust.VerifySatelliteID(ctx, peer.ID)
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.PermissionDenied, "piece inventory called with untrusted ID")
+	}
+
+	if req.Limit < 0 {
+		return rpcstatus.Error(rpcstatus.InvalidArgument, "limit is negative")
+	}
+	limit := endpoint.config.Inventory.MaxLimit
+	if req.Limit > 0 && int(req.Limit) < limit {
+		limit = int(req.Limit)
+	}
+
+	limiter, ok := endpoint.inventory.acquire(peer.ID)
+	if !ok {
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "piece inventory listing already in progress")
+	}
+	defer endpoint.inventory.release(peer.ID)
+
+	pieceIDs, more, err := listPieceIDs(ctx, endpoint.store, peer.ID, req.Cursor, limit, req.CreatedAfter, req.CreatedBefore)
+	if err != nil {
+		return rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+	mon.IntVal("piece_inventory_listed").Observe(int64(len(pieceIDs)))
+
+	batchSize := endpoint.config.Inventory.BatchSize
+	for {
+		batch := pieceIDs
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+		pieceIDs = pieceIDs[len(batch):]
+
+		if err := limiter.WaitN(ctx, len(batch)); err != nil {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		err := stream.Send(&internalpb.ListPiecesResponse{
+			PieceIds: batch,
+			More:     more && len(pieceIDs) == 0,
+		})
+		if err != nil {
+			return rpcstatus.Wrap(rpcstatus.Internal, err)
+		}
+
+		if len(pieceIDs) == 0 {
+			return nil
+		}
+	}
+}
+
+// Exists checks which of the requested pieces are stored for the calling
+// satellite and returns the indexes of the missing ones.
+func (endpoint *Endpoint) Exists(ctx context.Context, req *internalpb.ExistsRequest) (_ *internalpb.ExistsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	err = endpoint.trust.VerifySatelliteID(ctx, peer.ID)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "piece exists called with untrusted ID")
+	}
+
+	if len(req.PieceIds) == 0 {
+		return &internalpb.ExistsResponse{}, nil
+	}
+	if len(req.PieceIds) > endpoint.config.Inventory.ExistsMaxPieces {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "too many piece ids: %d > %d",
+			len(req.PieceIds), endpoint.config.Inventory.ExistsMaxPieces)
+	}
+
+	if !endpoint.inventory.allowExists(peer.ID, len(req.PieceIds)) {
+		mon.Counter("piece_exists_rate_limited").Inc(1)
+		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "piece exists rate limit exceeded")
+	}
+
+	var missing []uint32
+	for index, pieceID := range req.PieceIds {
+		_, err := endpoint.store.Stat(ctx, peer.ID, pieceID)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				missing = append(missing, uint32(index))
+				continue
+			}
+			return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+		}
+	}
+
+	mon.IntVal("piece_exists_checked").Observe(int64(len(req.PieceIds)))
+	mon.IntVal("piece_exists_missing").Observe(int64(len(missing)))
+	if len(missing) > 0 {
+		endpoint.log.Debug("satellite requested missing pieces",
+			zap.Stringer("Satellite ID", peer.ID),
+			zap.Int("Checked", len(req.PieceIds)),
+			zap.Int("Missing", len(missing)))
+	}
+
+	return &internalpb.ExistsResponse{Missing: missing}, nil
+}
+
+// listPieceIDs returns up to limit piece ids greater than the cursor, which
+// are stored for the satellite and were created within the window, in
+// ascending order. more is true when there are more matching pieces.
+//
+// Pieces are walked in directory order, so the whole namespace is walked
+// while keeping only the smallest matching ids.
+func listPieceIDs(ctx context.Context, store *pieces.Store, satelliteID storj.NodeID, cursor storj.PieceID, limit int, createdAfter, createdBefore *time.Time) (_ []storj.PieceID, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	smallest := make(pieceIDHeap, 0, limit+1)
+	err = store.WalkSatellitePieces(ctx, satelliteID, func(access pieces.StoredPieceAccess) error {
+		pieceID := access.PieceID()
+		if bytes.Compare(pieceID[:], cursor[:]) <= 0 {
+			return nil
+		}
+		if len(smallest) > limit && !smallest.less(pieceID, smallest[0]) {
+			return nil
+		}
+
+		if createdAfter != nil || createdBefore != nil {
+			// ModTime is used instead of the more precise CreationTime,
+			// because it doesn't need to read the piece header.
+			modTime, err := access.ModTime(ctx)
+			if err != nil {
+				return err
+			}
+			if createdAfter != nil && modTime.Before(*createdAfter) {
+				return nil
+			}
+			if createdBefore != nil && !modTime.Before(*createdBefore) {
+				return nil
+			}
+		}
+
+		heap.Push(&smallest, pieceID)
+		if len(smallest) > limit+1 {
+			heap.Pop(&smallest)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	pieceIDs := []storj.PieceID(smallest)
+	sort.Slice(pieceIDs, func(i, k int) bool {
+		return bytes.Compare(pieceIDs[i][:], pieceIDs[k][:]) < 0
+	})
+	if len(pieceIDs) > limit {
+		return pieceIDs[:limit], true, nil
+	}
+	return pieceIDs, false, nil
+}
+
+// pieceIDHeap is a max-heap of piece ids.
+type pieceIDHeap []storj.PieceID
+
+func (h pieceIDHeap) less(a, b storj.PieceID) bool { return bytes.Compare(a[:], b[:]) < 0 }
+
+func (h pieceIDHeap) Len() int            { return len(h) }
+func (h pieceIDHeap) Less(i, k int) bool  { return h.less(h[k], h[i]) }
+func (h pieceIDHeap) Swap(i, k int)       { h[i], h[k] = h[k], h[i] }
+func (h *pieceIDHeap) Push(x interface{}) { *h = append(*h, x.(storj.PieceID)) }
+func (h *pieceIDHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/errs2"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/internalpb"
+)
+
+func TestListPieces(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		writePiece := func(satelliteID storj.NodeID) storj.PieceID {
+			pieceID := testrand.PieceID()
+			writer, err := node.Storage2.Store.Writer(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(100))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+			return pieceID
+		}
+
+		var expected []storj.PieceID
+		for i := 0; i < 20; i++ {
+			expected = append(expected, writePiece(satellite.ID()))
+			// pieces of other satellites must not be listed.
+			writePiece(testrand.NodeID())
+		}
+		sort.Slice(expected, func(i, k int) bool {
+			return bytes.Compare(expected[i][:], expected[k][:]) < 0
+		})
+
+		conn, err := satellite.Dialer.DialNodeURL(ctx, node.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := internalpb.NewDRPCPieceInventoryClient(conn)
+
+		list := func(req *internalpb.ListPiecesRequest) (pieceIDs []storj.PieceID, more bool) {
+			stream, err := client.ListPieces(ctx, req)
+			require.NoError(t, err)
+			defer ctx.Check(stream.Close)
+
+			for {
+				resp, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					return pieceIDs, more
+				}
+				require.NoError(t, err)
+				require.False(t, more, "only the last response should have more set")
+				pieceIDs = append(pieceIDs, resp.PieceIds...)
+				more = resp.More
+			}
+		}
+
+		all, more := list(&internalpb.ListPiecesRequest{})
+		require.False(t, more)
+		require.Equal(t, expected, all)
+
+		var paged []storj.PieceID
+		cursor := storj.PieceID{}
+		for {
+			pieceIDs, more := list(&internalpb.ListPiecesRequest{Cursor: cursor, Limit: 7})
+			require.LessOrEqual(t, len(pieceIDs), 7)
+			paged = append(paged, pieceIDs...)
+			if !more {
+				break
+			}
+			cursor = pieceIDs[len(pieceIDs)-1]
+		}
+		require.Equal(t, expected, paged)
+
+		future := time.Now().Add(time.Hour)
+		pieceIDs, _ := list(&internalpb.ListPiecesRequest{CreatedAfter: &future})
+		require.Empty(t, pieceIDs)
+		pieceIDs, _ = list(&internalpb.ListPiecesRequest{CreatedBefore: &future})
+		require.Equal(t, expected, pieceIDs)
+
+		t.Run("untrusted", func(t *testing.T) {
+			conn, err := planet.Uplinks[0].Dialer.DialNodeURL(ctx, node.NodeURL())
+			require.NoError(t, err)
+			defer ctx.Check(conn.Close)
+
+			stream, err := internalpb.NewDRPCPieceInventoryClient(conn).ListPieces(ctx, &internalpb.ListPiecesRequest{})
+			require.NoError(t, err)
+			defer ctx.Check(stream.Close)
+
+			_, err = stream.Recv()
+			require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+		})
+	})
+}
+
+func TestExists(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.Storage2.Inventory.ExistsMaxPieces = 10
+				config.Storage2.Inventory.ExistsPerSecond = 0.001
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		var stored []storj.PieceID
+		for i := 0; i < 3; i++ {
+			pieceID := testrand.PieceID()
+			writer, err := node.Storage2.Store.Writer(ctx, satellite.ID(), pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(100))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+			stored = append(stored, pieceID)
+		}
+
+		conn, err := satellite.Dialer.DialNodeURL(ctx, node.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := internalpb.NewDRPCPieceInventoryClient(conn)
+
+		pieceIDs := []storj.PieceID{stored[0], testrand.PieceID(), stored[1], testrand.PieceID(), stored[2]}
+		resp, err := client.Exists(ctx, &internalpb.ExistsRequest{PieceIds: pieceIDs})
+		require.NoError(t, err)
+		require.Equal(t, []uint32{1, 3}, resp.Missing)
+
+		_, err = client.Exists(ctx, &internalpb.ExistsRequest{PieceIds: make([]storj.PieceID, 11)})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		// the first request used up half of the rate limit burst.
+		_, err = client.Exists(ctx, &internalpb.ExistsRequest{PieceIds: make([]storj.PieceID, 10)})
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+
+		t.Run("untrusted", func(t *testing.T) {
+			conn, err := planet.Uplinks[0].Dialer.DialNodeURL(ctx, node.NodeURL())
+			require.NoError(t, err)
+			defer ctx.Check(conn.Close)
+
+			_, err = internalpb.NewDRPCPieceInventoryClient(conn).Exists(ctx, &internalpb.ExistsRequest{PieceIds: stored})
+			require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+		})
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// slotLimiter limits the number of concurrent requests and shares the slots
+// fairly between satellites.
+//
+// A share of the slots is reserved evenly for the trusted satellites. A
+// satellite, which already holds its reserved slots, can only take the slots
+// which aren't reserved for the others, so a burst of requests from one
+// satellite can't take the slots of the others.
+//
+// When no slot is available, a request may wait for a slot to be released.
+// A released slot is handed over to the waiting satellite which holds the
+// fewest slots.
+type slotLimiter struct {
+	limit      int
+	reserved   float64
+	wait       time.Duration
+	satellites func() []storj.NodeID
+
+	mu      sync.Mutex
+	used    int
+	held    map[storj.NodeID]int
+	waiting []*slotWaiter
+}
+
+// slotWaiter is a request waiting for a slot.
+type slotWaiter struct {
+	satelliteID storj.NodeID
+	granted     chan struct{}
+}
+
+// newSlotLimiter creates a limiter with limit slots, 0 represents unlimited.
+// The reserved portion of the slots is reserved evenly for the satellites
+// returned by satellites. A request waits at most wait for a slot to be
+// released.
+func newSlotLimiter(limit int, reserved float64, wait time.Duration, satellites func() []storj.NodeID) *slotLimiter {
+	return &slotLimiter{
+		limit:      limit,
+		reserved:   reserved,
+		wait:       wait,
+		satellites: satellites,
+		held:       make(map[storj.NodeID]int),
+	}
+}
+
+// acquire takes a slot for the satellite. It returns false when no slot was
+// available within the wait duration. A taken slot must be released.
+func (limiter *slotLimiter) acquire(ctx context.Context, satelliteID storj.NodeID) bool {
+	limiter.mu.Lock()
+	if limiter.available(satelliteID) {
+		limiter.take(satelliteID)
+		limiter.mu.Unlock()
+		return true
+	}
+	if limiter.wait <= 0 {
+		limiter.mu.Unlock()
+		return false
+	}
+
+	waiter := &slotWaiter{
+		satelliteID: satelliteID,
+		granted:     make(chan struct{}),
+	}
+	limiter.waiting = append(limiter.waiting, waiter)
+	limiter.mu.Unlock()
+
+	timer := time.NewTimer(limiter.wait)
+	defer timer.Stop()
+
+	select {
+	case <-waiter.granted:
+		return true
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	select {
+	case <-waiter.granted:
+		// the slot was handed over while giving up.
+		return true
+	default:
+	}
+
+	for i, other := range limiter.waiting {
+		if other == waiter {
+			limiter.waiting = append(limiter.waiting[:i], limiter.waiting[i+1:]...)
+			break
+		}
+	}
+	return false
+}
+
+// release releases a slot of the satellite and hands it over to the next
+// waiting request.
+func (limiter *slotLimiter) release(satelliteID storj.NodeID) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	limiter.used--
+	if limiter.held[satelliteID] <= 1 {
+		delete(limiter.held, satelliteID)
+	} else {
+		limiter.held[satelliteID]--
+	}
+
+	// pick the oldest request of the waiting satellite holding the fewest
+	// slots, which can take a slot.
+	next := -1
+	for i, waiter := range limiter.waiting {
+		if next >= 0 && limiter.held[waiter.satelliteID] >= limiter.held[limiter.waiting[next].satelliteID] {
+			continue
+		}
+		if limiter.available(waiter.satelliteID) {
+			next = i
+		}
+	}
+	if next < 0 {
+		return
+	}
+
+	waiter := limiter.waiting[next]
+	limiter.waiting = append(limiter.waiting[:next], limiter.waiting[next+1:]...)
+	limiter.take(waiter.satelliteID)
+	close(waiter.granted)
+}
+
+// available returns whether the satellite can take a slot. The mutex must be
+// held.
+func (limiter *slotLimiter) available(satelliteID storj.NodeID) bool {
+	if limiter.limit <= 0 {
+		return true
+	}
+	if limiter.used >= limiter.limit {
+		return false
+	}
+
+	satellites := limiter.satellites()
+	if len(satellites) == 0 {
+		return true
+	}
+	perSatellite := int(float64(limiter.limit) * limiter.reserved / float64(len(satellites)))
+	if perSatellite <= 0 || limiter.held[satelliteID] < perSatellite {
+		return true
+	}
+
+	// the satellite holds its reserved slots already, so it can only take a
+	// slot which isn't reserved for the other satellites.
+	unfilled := 0
+	for _, other := range satellites {
+		if other != satelliteID && limiter.held[other] < perSatellite {
+			unfilled += perSatellite - limiter.held[other]
+		}
+	}
+	return limiter.limit-limiter.used > unfilled
+}
+
+// take takes a slot for the satellite. The mutex must be held.
+func (limiter *slotLimiter) take(satelliteID storj.NodeID) {
+	limiter.used++
+	limiter.held[satelliteID]++
+}
+
+// count returns the number of slots in use.
+func (limiter *slotLimiter) count() int {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	return limiter.used
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+)
+
+func TestSlotLimiter(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	t.Run("unlimited", func(t *testing.T) {
+		limiter := newSlotLimiter(0, 0, 0, noSatellites)
+		satelliteID := testrand.NodeID()
+		for i := 0; i < 10; i++ {
+			require.True(t, limiter.acquire(ctx, satelliteID))
+		}
+		require.Equal(t, 10, limiter.count())
+	})
+
+	t.Run("rejects without waiting", func(t *testing.T) {
+		limiter := newSlotLimiter(2, 0.5, 0, noSatellites)
+		satelliteID := testrand.NodeID()
+		require.True(t, limiter.acquire(ctx, satelliteID))
+		require.True(t, limiter.acquire(ctx, satelliteID))
+		require.False(t, limiter.acquire(ctx, satelliteID))
+
+		limiter.release(satelliteID)
+		require.True(t, limiter.acquire(ctx, satelliteID))
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		limiter := newSlotLimiter(1, 0.5, 10*time.Millisecond, noSatellites)
+		satelliteID := testrand.NodeID()
+		require.True(t, limiter.acquire(ctx, satelliteID))
+		require.False(t, limiter.acquire(ctx, satelliteID))
+		require.Empty(t, limiter.waiting)
+		require.Equal(t, 1, limiter.count())
+	})
+
+	t.Run("fair between satellites", func(t *testing.T) {
+		busy, quiet := testrand.NodeID(), testrand.NodeID()
+		limiter := newSlotLimiter(2, 0, time.Hour, func() []storj.NodeID {
+			return []storj.NodeID{busy, quiet}
+		})
+
+		// the busy satellite takes all the slots and queues more requests
+		// before the quiet one.
+		require.True(t, limiter.acquire(ctx, busy))
+		require.True(t, limiter.acquire(ctx, busy))
+
+		acquired := make(chan string, 2)
+		wait := func(name string, satelliteID storj.NodeID) {
+			ctx.Go(func() error {
+				if limiter.acquire(ctx, satelliteID) {
+					acquired <- name
+				}
+				return nil
+			})
+		}
+		wait("busy", busy)
+		waitForWaiters(t, limiter, 1)
+		wait("quiet", quiet)
+		waitForWaiters(t, limiter, 2)
+
+		// the released slot goes to the satellite holding the fewest slots.
+		limiter.release(busy)
+		require.Equal(t, "quiet", <-acquired)
+
+		limiter.release(busy)
+		require.Equal(t, "busy", <-acquired)
+
+		limiter.release(busy)
+		limiter.release(quiet)
+		require.Equal(t, 0, limiter.count())
+		require.Empty(t, limiter.held)
+	})
+
+	t.Run("reserves slots for each satellite", func(t *testing.T) {
+		busy, quiet := testrand.NodeID(), testrand.NodeID()
+		limiter := newSlotLimiter(4, 0.5, 0, func() []storj.NodeID {
+			return []storj.NodeID{busy, quiet}
+		})
+
+		// the busy satellite can't take the slot reserved for the quiet one.
+		require.True(t, limiter.acquire(ctx, busy))
+		require.True(t, limiter.acquire(ctx, busy))
+		require.True(t, limiter.acquire(ctx, busy))
+		require.False(t, limiter.acquire(ctx, busy))
+
+		require.True(t, limiter.acquire(ctx, quiet))
+		require.False(t, limiter.acquire(ctx, quiet))
+
+		// the unreserved slots are shared.
+		limiter.release(busy)
+		limiter.release(busy)
+		require.True(t, limiter.acquire(ctx, quiet))
+		require.True(t, limiter.acquire(ctx, quiet))
+		require.False(t, limiter.acquire(ctx, quiet))
+		require.Equal(t, 4, limiter.count())
+	})
+}
+
+func TestMaxConcurrentRequestsFallback(t *testing.T) {
+	endpoint, err := NewEndpoint(zaptest.NewLogger(t), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, Config{
+		MaxConcurrentRequests: 3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, endpoint.uploads.limit)
+	require.Equal(t, 0, endpoint.downloads.limit)
+
+	endpoint, err = NewEndpoint(zaptest.NewLogger(t), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, Config{
+		MaxConcurrentRequests: 3,
+		MaxConcurrentUploads:  5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 5, endpoint.uploads.limit)
+}
+
+func noSatellites() []storj.NodeID { return nil }
+
+func waitForWaiters(t *testing.T, limiter *slotLimiter, n int) {
+	require.Eventually(t, func() bool {
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+		return len(limiter.waiting) == n
+	}, 10*time.Second, time.Millisecond)
+}
@@ -158,6 +158,18 @@ func (table *Table) DeleteExpired(now time.Time) {
 	table.memoryUsed -= memory.Size(fullToDelete) * FullSize
 }
 
+// DeleteSatellite deletes all the serial numbers of the satellite.
+func (table *Table) DeleteSatellite(satelliteID storj.NodeID) {
+	table.mu.Lock()
+	defer table.mu.Unlock()
+
+	for _, list := range table.serials[satelliteID] {
+		table.memoryUsed -= memory.Size(len(list.partialSerials)) * PartialSize
+		table.memoryUsed -= memory.Size(len(list.fullSerials)) * FullSize
+	}
+	delete(table.serials, satelliteID)
+}
+
 // Exists determines whether a serial number exists in the table.
 func (table *Table) Exists(satelliteID storj.NodeID, serialNumber storj.SerialNumber, expiration time.Time) bool {
 	table.mu.Lock()
@@ -159,6 +159,28 @@ func TestUsedSerialsMemory(t *testing.T) {
 	}
 }
 
+// TestUsedSerialsDeleteSatellite ensures that deleting the serials of a satellite frees their memory.
+func TestUsedSerialsDeleteSatellite(t *testing.T) {
+	usedSerials := usedserials.NewTable(3 * usedserials.PartialSize)
+
+	expiration := time.Now().Add(time.Hour)
+	forgotten, kept := testrand.NodeID(), testrand.NodeID()
+	for i := 0; i < 3; i++ {
+		err := usedSerials.Add(forgotten, createExpirationSerial(testrand.SerialNumber(), expiration), expiration)
+		require.NoError(t, err)
+	}
+
+	usedSerials.DeleteSatellite(forgotten)
+	require.Zero(t, usedSerials.Count())
+
+	// no serials are deleted randomly, when the table isn't full.
+	for i := 0; i < 3; i++ {
+		err := usedSerials.Add(kept, createExpirationSerial(testrand.SerialNumber(), expiration), expiration)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, usedSerials.Count())
+}
+
 func createExpirationSerial(originalSerial storj.SerialNumber, expiration time.Time) storj.SerialNumber {
 	serialWithExp := storj.SerialNumber{}
 	copy(serialWithExp[:], originalSerial[:])
@@ -9,11 +9,13 @@ import (
 	"time"
 
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 
 	"storj.io/common/errs2"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/signing"
+	"storj.io/common/storj"
 )
 
 var (
@@ -80,6 +82,22 @@ func (endpoint *Endpoint) verifyOrderLimit(ctx context.Context, limit *pb.OrderL
 	return nil
 }
 
+// verifyEgressCap verifies that the satellite didn't reach its monthly egress cap.
+func (endpoint *Endpoint) verifyEgressCap(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reached, err := endpoint.egressCaps.Reached(ctx, satelliteID)
+	if err != nil {
+		// don't reject downloads because the usage couldn't be read.
+		endpoint.log.Error("failed to check egress cap", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+		return nil
+	}
+	if reached {
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "monthly egress cap of the satellite is reached")
+	}
+	return nil
+}
+
 // VerifyOrder verifies that the order corresponds to the order limit and has all the necessary fields.
 func (endpoint *Endpoint) VerifyOrder(ctx context.Context, limit *pb.OrderLimit, order *pb.Order, largestOrderAmount int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
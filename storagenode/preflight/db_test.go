@@ -71,3 +71,9 @@ func TestPreflightSchema(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestCheckIntegrity(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		require.NoError(t, db.CheckIntegrity(ctx))
+	})
+}
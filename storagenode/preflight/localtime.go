@@ -6,12 +6,14 @@ package preflight
 import (
 	"context"
 	"math"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"storj.io/common/errs2"
 	"storj.io/common/pb"
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
@@ -100,6 +102,49 @@ func (localTime *LocalTime) Check(ctx context.Context) (err error) {
 	return nil
 }
 
+// Skew returns the smallest difference between the local system clock and the
+// system clock of the trusted satellites, which could be reached.
+func (localTime *LocalTime) Skew(ctx context.Context) (_ time.Duration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellites := localTime.trust.GetSatellites(ctx)
+	if len(satellites) == 0 {
+		return 0, errs.New("no trusted satellites")
+	}
+
+	var mu sync.Mutex
+	skew := time.Duration(math.MaxInt64)
+	var group errs2.Group
+	for _, satellite := range satellites {
+		satellite := satellite
+		group.Go(func() error {
+			currentLocalTime := time.Now().UTC()
+			satelliteTime, err := localTime.getSatelliteTime(ctx, satellite)
+			if err != nil {
+				return err
+			}
+
+			diff := satelliteTime.GetTimestamp().Sub(currentLocalTime)
+			if diff < 0 {
+				diff = -diff
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if diff < skew {
+				skew = diff
+			}
+			return nil
+		})
+	}
+
+	allErrs := group.Wait()
+	if len(allErrs) == len(satellites) {
+		return 0, errs.New("unable to get the system time of any trusted satellite: %v", errs.Combine(allErrs...))
+	}
+	return skew, nil
+}
+
 func (localTime *LocalTime) getSatelliteTime(ctx context.Context, satelliteID storj.NodeID) (_ *pb.GetTimeResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -17,6 +17,8 @@ type DB interface {
 	Store(ctx context.Context, stats Pricing) error
 	// Get retrieves pricing model for specific satellite.
 	Get(ctx context.Context, satelliteID storj.NodeID) (*Pricing, error)
+	// Delete removes pricing model for specific satellite.
+	Delete(ctx context.Context, satelliteID storj.NodeID) error
 }
 
 // Pricing consist pricing model for storagenode.
@@ -14,8 +14,9 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
-	"storj.io/common/bloomfilter"
 	"storj.io/common/storj"
+	"storj.io/storj/private/retainfilter"
+	"storj.io/storj/storage/filestore"
 	"storj.io/storj/storagenode/pieces"
 )
 
@@ -37,7 +38,14 @@ type Config struct {
 type Request struct {
 	SatelliteID   storj.NodeID
 	CreatedBefore time.Time
-	Filter        *bloomfilter.Filter
+	Filter        retainfilter.Filter
+}
+
+// queueKey identifies a queued request. The shards of a split filter are
+// queued separately, so that they don't replace each other.
+type queueKey struct {
+	satelliteID storj.NodeID
+	shard       int
 }
 
 // Status is a type defining the enabled/disabled status of retain requests.
@@ -92,7 +100,7 @@ type Service struct {
 	config Config
 
 	cond    sync.Cond
-	queued  map[storj.NodeID]Request
+	queued  map[queueKey]Request
 	working map[storj.NodeID]struct{}
 	group   errgroup.Group
 
@@ -110,7 +118,7 @@ func NewService(log *zap.Logger, store *pieces.Store, config Config) *Service {
 		config: config,
 
 		cond:    *sync.NewCond(&sync.Mutex{}),
-		queued:  make(map[storj.NodeID]Request),
+		queued:  make(map[queueKey]Request),
 		working: make(map[storj.NodeID]struct{}),
 		closed:  make(chan struct{}),
 
@@ -119,7 +127,7 @@ func NewService(log *zap.Logger, store *pieces.Store, config Config) *Service {
 }
 
 // Queue adds a retain request to the queue.
-// It discards a request for a satellite that already has a queued request.
+// It replaces a queued request for the same satellite and filter shard.
 // true is returned if the request is queued and false is returned if it is discarded.
 func (s *Service) Queue(req Request) bool {
 	s.cond.L.Lock()
@@ -131,7 +139,8 @@ func (s *Service) Queue(req Request) bool {
 	default:
 	}
 
-	s.queued[req.SatelliteID] = req
+	shard, _ := retainfilter.ShardIndex(req.Filter)
+	s.queued[queueKey{satelliteID: req.SatelliteID, shard: shard}] = req
 	s.cond.Broadcast()
 
 	return true
@@ -343,6 +352,10 @@ func (s *Service) Status() Status {
 // nontrivial amount, mtimes on existing blobs should also be adjusted (by the same interval,
 // ideally, but just running "touch" on all blobs is sufficient to avoid incorrect deletion of
 // data).
+//
+// Pieces stored with filestore.FormatV2 or greater keep the creation time at a fixed offset of
+// the piece header, so it's read without unmarshaling the header and the precise CreationTime is
+// used for them instead of ModTime.
 func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 	// if retain status is disabled, return immediately
 	if s.config.Status == Disabled {
@@ -367,9 +380,12 @@ func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 	mon.IntVal("garbage_collection_filter_size").Observe(filter.Size())
 	mon.IntVal("garbage_collection_started").Observe(started.Unix())
 
+	shard, shardCount := retainfilter.ShardIndex(filter)
 	s.log.Info("Prepared to run a Retain request.",
 		zap.Time("Created Before", createdBefore),
 		zap.Int64("Filter Size", filter.Size()),
+		zap.Int("Filter Shard", shard),
+		zap.Int("Filter Shard Count", shardCount),
 		zap.Stringer("Satellite ID", satelliteID))
 
 	err = s.store.WalkSatellitePieces(ctx, satelliteID, func(access pieces.StoredPieceAccess) (err error) {
@@ -379,21 +395,35 @@ func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 		// We call Gosched() when done because the GC process is expected to be long and we want to keep it at low priority,
 		// so other goroutines can continue serving requests.
 		defer runtime.Gosched()
+
+		// The filter is checked before the mtime, so that the pieces in the filter,
+		// including the pieces of the other shards of a split filter, aren't stat'ed.
+		pieceID := access.PieceID()
+		if filter.Contains(pieceID) {
+			// The storage format is known from the walk, so only the kept pieces
+			// of older formats are read when pieces.rewrite-headers is set.
+			if s.store.RewritesHeader(access.StorageFormatVersion()) {
+				if _, err := s.store.HeaderInfo(ctx, satelliteID, pieceID); err != nil {
+					s.log.Warn("failed to read piece header info",
+						zap.Stringer("Satellite ID", satelliteID),
+						zap.Stringer("Piece ID", pieceID),
+						zap.Error(err))
+				}
+			}
+			return nil
+		}
+
 		// See the comment above the retainPieces() function for a discussion on the correctness
 		// of using ModTime in place of the more precise CreationTime.
-		mTime, err := access.ModTime(ctx)
+		cTime, err := s.creationTime(ctx, satelliteID, access)
 		if err != nil {
 			piecesSkipped++
-			s.log.Warn("failed to determine mtime of blob", zap.Error(err))
+			s.log.Warn("failed to determine creation time of blob", zap.Error(err))
 			// but continue iterating.
 			return nil
 		}
 
-		if !mTime.Before(createdBefore) {
-			return nil
-		}
-		pieceID := access.PieceID()
-		if !filter.Contains(pieceID) {
+		if cTime.Before(createdBefore) {
 			s.log.Debug("About to move piece to trash",
 				zap.Stringer("Satellite ID", satelliteID),
 				zap.Stringer("Piece ID", pieceID),
@@ -435,6 +465,19 @@ func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 	return nil
 }
 
+// creationTime returns the creation time of the piece from the header of pieces stored with
+// filestore.FormatV2 or greater, and the mtime of the blob otherwise.
+func (s *Service) creationTime(ctx context.Context, satelliteID storj.NodeID, access pieces.StoredPieceAccess) (time.Time, error) {
+	if access.StorageFormatVersion() < filestore.FormatV2 {
+		return access.ModTime(ctx)
+	}
+	info, err := s.store.HeaderInfo(ctx, satelliteID, access.PieceID())
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.CreationTime, nil
+}
+
 // trash wraps retains piece deletion to monitor moving retained piece to trash error during garbage collection.
 func (s *Service) trash(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
 	defer mon.Task()(&ctx, satelliteID)(&err)
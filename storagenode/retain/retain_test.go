@@ -21,6 +21,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/storj/private/retainfilter"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/filestore"
 	"storj.io/storj/storagenode"
@@ -194,6 +195,152 @@ func TestRetainPieces(t *testing.T) {
 	})
 }
 
+func TestRetainPiecesSplitFilter(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		store := pieces.NewStore(zaptest.NewLogger(t), db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+		testStore := pieces.StoreForTest{Store: store}
+
+		const numPieces = 100
+		const numPiecesToKeep = 90
+
+		// a small maximum size splits the filter into multiple shards.
+		filters := retainfilter.NewOptimal(retainfilter.Version2, numPieces, 0.000000001, 64*memory.B)
+		require.Greater(t, len(filters), 1)
+
+		satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+
+		pieceIDs := generateTestIDs(numPieces)
+		for index, id := range pieceIDs {
+			if index < numPiecesToKeep {
+				filters[retainfilter.ShardOf(id, len(filters))].Add(id)
+			}
+
+			w, err := testStore.WriterForFormatVersion(ctx, satellite.ID, id, filestore.FormatV1)
+			require.NoError(t, err)
+			_, err = w.Write(testrand.Bytes(100 * memory.B))
+			require.NoError(t, err)
+			require.NoError(t, w.Commit(ctx, &pb.PieceHeader{CreationTime: time.Now()}))
+		}
+
+		service := retain.NewService(zaptest.NewLogger(t), store, retain.Config{
+			Status:      retain.Enabled,
+			Concurrency: 1,
+			MaxTimeSkew: 0,
+		})
+
+		// the shards are queued separately, so none of them replaces another.
+		createdBefore := time.Now()
+		for _, filter := range filters {
+			decoded, err := retainfilter.Decode(filter.Bytes())
+			require.NoError(t, err)
+
+			require.True(t, service.Queue(retain.Request{
+				SatelliteID:   satellite.ID,
+				CreatedBefore: createdBefore,
+				Filter:        decoded,
+			}))
+		}
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var group errgroup.Group
+		group.Go(func() error {
+			return service.Run(runCtx)
+		})
+		service.TestWaitUntilEmpty()
+
+		satellitePieces, err := getAllPieceIDs(ctx, store, satellite.ID)
+		require.NoError(t, err)
+		require.ElementsMatch(t, pieceIDs[:numPiecesToKeep], satellitePieces)
+
+		cancel()
+		err = group.Wait()
+		require.True(t, errs2.IsCanceled(err))
+	})
+}
+
+func TestRetainPiecesFormatV2(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		v1Blobs, err := filestore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), filestore.DefaultConfig)
+		require.NoError(t, err)
+		defer ctx.Check(v1Blobs.Close)
+		v1Store := pieces.NewStore(zaptest.NewLogger(t), v1Blobs, nil, db.PieceExpirationDB(), nil, pieces.DefaultConfig)
+
+		v2Config := filestore.DefaultConfig
+		v2Config.WriteFormatV2 = true
+		v2Blobs, err := filestore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), v2Config)
+		require.NoError(t, err)
+		defer ctx.Check(v2Blobs.Close)
+
+		config := pieces.DefaultConfig
+		config.RewriteHeaders = true
+		store := pieces.NewStore(zaptest.NewLogger(t), v2Blobs, nil, db.PieceExpirationDB(), nil, config)
+
+		satellite := testrand.NodeID()
+		now := time.Now()
+
+		writePiece := func(store *pieces.Store, pieceID storj.PieceID, creationTime time.Time) {
+			w, err := store.Writer(ctx, satellite, pieceID)
+			require.NoError(t, err)
+			_, err = w.Write(testrand.Bytes(100 * memory.B))
+			require.NoError(t, err)
+			require.NoError(t, w.Commit(ctx, &pb.PieceHeader{CreationTime: creationTime}))
+		}
+
+		var (
+			keptV1  = testrand.PieceID()
+			kept    = testrand.PieceID()
+			old     = testrand.PieceID()
+			recent  = testrand.PieceID()
+			filter  = bloomfilter.NewOptimal(10, 0.000000001)
+			created = now.Add(-2 * time.Hour)
+		)
+		filter.Add(keptV1)
+		filter.Add(kept)
+
+		writePiece(v1Store, keptV1, created)
+		writePiece(store, kept, created)
+		// the mtime of the blob is recent, but its creation time is old.
+		writePiece(store, old, created)
+		writePiece(store, recent, now)
+
+		service := retain.NewService(zaptest.NewLogger(t), store, retain.Config{
+			Status:      retain.Enabled,
+			Concurrency: 1,
+			MaxTimeSkew: 0,
+		})
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var group errgroup.Group
+		group.Go(func() error {
+			return service.Run(runCtx)
+		})
+
+		require.True(t, service.Queue(retain.Request{
+			SatelliteID:   satellite,
+			CreatedBefore: now.Add(-time.Hour),
+			Filter:        filter,
+		}))
+		service.TestWaitUntilEmpty()
+
+		satellitePieces, err := getAllPieceIDs(ctx, store, satellite)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []storj.PieceID{keptV1, kept, recent}, satellitePieces)
+
+		// the kept v1 piece is rewritten with the written storage format.
+		info, err := store.Stat(ctx, satellite, keptV1)
+		require.NoError(t, err)
+		require.Equal(t, filestore.FormatV2, info.StorageFormatVersion())
+
+		cancel()
+		err = group.Wait()
+		require.True(t, errs2.IsCanceled(err))
+	})
+}
+
 func getAllPieceIDs(ctx context.Context, store *pieces.Store, satellite storj.NodeID) (pieceIDs []storj.PieceID, err error) {
 	err = store.WalkSatellitePieces(ctx, satellite, func(pieceAccess pieces.StoredPieceAccess) error {
 		pieceIDs = append(pieceIDs, pieceAccess.PieceID())
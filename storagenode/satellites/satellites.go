@@ -24,6 +24,8 @@ const (
 	ExitSucceeded = 3
 	// ExitFailed reflects a graceful exit that failed.
 	ExitFailed = 4
+	// Forgotten reflects a satellite which was removed by the operator.
+	Forgotten = 5
 )
 
 // ExitProgress contains the status of a graceful exit.
@@ -52,8 +54,12 @@ type DB interface {
 	SetAddress(ctx context.Context, satelliteID storj.NodeID, address string) error
 	// GetSatellite retrieves that satellite by ID
 	GetSatellite(ctx context.Context, satelliteID storj.NodeID) (satellite Satellite, err error)
+	// GetSatellites retrieves all satellites.
+	GetSatellites(ctx context.Context) (satellites []Satellite, err error)
 	// GetSatellitesUrls retrieves all satellite's id and urls.
 	GetSatellitesUrls(ctx context.Context) (satelliteURLs []storj.NodeURL, err error)
+	// ForgetSatellite marks the satellite as forgotten and removes its graceful exit progress.
+	ForgetSatellite(ctx context.Context, satelliteID storj.NodeID) error
 	// InitiateGracefulExit updates the database to reflect the beginning of a graceful exit
 	InitiateGracefulExit(ctx context.Context, satelliteID storj.NodeID, intitiatedAt time.Time, startingDiskUsage int64) error
 	// CancelGracefulExit removes that satellite by ID
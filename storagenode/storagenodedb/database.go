@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -40,6 +41,22 @@ import (
 // VersionTable is the table that stores the version info in each db.
 const VersionTable = "versions"
 
+// ConsolidatedDBName is the name of the database, which contains all the
+// tables when the consolidated backend is used.
+const ConsolidatedDBName = "storagenode"
+
+// Backend is the layout of the storage node databases on the disk.
+type Backend string
+
+const (
+	// BackendSQLite stores the tables in a separate SQLite database per component.
+	BackendSQLite = Backend("sqlite")
+	// BackendSQLiteConsolidated stores all the tables in a single SQLite database.
+	// It avoids the overhead of many database files on busy nodes, however it
+	// can only be chosen when the node is set up.
+	BackendSQLiteConsolidated = Backend("sqlite-consolidated")
+)
+
 var (
 	mon = monkit.Package()
 
@@ -83,6 +100,46 @@ type Config struct {
 	Driver    string // if unset, uses sqlite3
 	Pieces    string
 	Filestore filestore.Config
+
+	// Backend is the layout of the databases, if unset, uses BackendSQLite.
+	Backend Backend
+	// JournalMode is the SQLite journal mode, if unset, uses WAL.
+	JournalMode string
+	// Synchronous is the SQLite synchronous setting, if unset, uses the SQLite default.
+	Synchronous string
+	// BusyTimeout is how long to wait for a locked database, if unset, uses 10s.
+	BusyTimeout time.Duration
+}
+
+// Verify verifies whether the configuration is valid.
+func (config Config) Verify() error {
+	switch config.Backend {
+	case "", BackendSQLite, BackendSQLiteConsolidated:
+	default:
+		return ErrDatabase.New("unknown backend %q", config.Backend)
+	}
+	if config.BusyTimeout < 0 {
+		return ErrDatabase.New("busy timeout must not be negative")
+	}
+	return nil
+}
+
+// dsn returns the SQLite connection string of the database at the specified path.
+func (config Config) dsn(path string) string {
+	journalMode := config.JournalMode
+	if journalMode == "" {
+		journalMode = "WAL"
+	}
+	busyTimeout := config.BusyTimeout
+	if busyTimeout == 0 {
+		busyTimeout = 10 * time.Second
+	}
+
+	dsn := "file:" + path + "?_journal=" + journalMode + "&_busy_timeout=" + strconv.FormatInt(busyTimeout.Milliseconds(), 10)
+	if config.Synchronous != "" {
+		dsn += "&_sync=" + config.Synchronous
+	}
+	return dsn
 }
 
 // DB contains access to different database tables.
@@ -109,11 +166,19 @@ type DB struct {
 	pricingDB         *pricingDB
 	apiKeysDB         *apiKeysDB
 
+	// consolidatedDB is the database shared by all the containers, when the
+	// consolidated backend is used.
+	consolidatedDB tagsql.DB
+
 	SQLDBs map[string]DBContainer
 }
 
 // OpenNew creates a new master database for storage node.
 func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
+	if err := config.Verify(); err != nil {
+		return nil, err
+	}
+
 	piecesDir, err := filestore.NewDir(log, config.Pieces)
 	if err != nil {
 		return nil, err
@@ -182,6 +247,10 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 
 // OpenExisting opens an existing master database for storage node.
 func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
+	if err := config.Verify(); err != nil {
+		return nil, err
+	}
+
 	piecesDir, err := filestore.OpenDir(log, config.Pieces)
 	if err != nil {
 		return nil, err
@@ -260,6 +329,10 @@ func (db *DB) openDatabases(ctx context.Context) error {
 	// The reason it was done this way was because there's some outside consumers that are
 	// taking a reference to the business object.
 
+	if err := db.checkBackend(); err != nil {
+		return err
+	}
+
 	dbs := []string{
 		DeprecatedInfoDBName,
 		BandwidthDBName,
@@ -291,6 +364,33 @@ func (db *DB) rawDatabaseFromName(dbName string) tagsql.DB {
 	return db.SQLDBs[dbName].GetDB()
 }
 
+// consolidated returns whether all the tables are stored in a single database.
+func (db *DB) consolidated() bool {
+	return db.config.Backend == BackendSQLiteConsolidated
+}
+
+// checkBackend checks that the existing databases were created with the
+// configured backend, because the backend can't be changed afterwards.
+func (db *DB) checkBackend() error {
+	separatePath := filepath.Join(db.dbDirectory, db.filenameFromDBName(DeprecatedInfoDBName))
+	consolidatedPath := filepath.Join(db.dbDirectory, db.filenameFromDBName(ConsolidatedDBName))
+
+	unexpectedPath, backend := consolidatedPath, BackendSQLiteConsolidated
+	if db.consolidated() {
+		unexpectedPath, backend = separatePath, BackendSQLite
+	}
+
+	_, err := os.Stat(unexpectedPath)
+	switch {
+	case err == nil:
+		return ErrDatabase.New("databases were set up with the %q backend (%q exists)", backend, unexpectedPath)
+	case os.IsNotExist(err):
+		return nil
+	default:
+		return ErrDatabase.New("%q couldn't be read: %w", unexpectedPath, err)
+	}
+}
+
 // openExistingDatabase opens existing database at the specified path.
 func (db *DB) openExistingDatabase(ctx context.Context, dbName string) error {
 	path := db.filepathFromDBName(dbName)
@@ -319,14 +419,27 @@ func (db *DB) openDatabase(ctx context.Context, dbName string) error {
 		return ErrDatabase.Wrap(err)
 	}
 
-	sqlDB, err := tagsql.Open(ctx, driver, "file:"+path+"?_journal=WAL&_busy_timeout=10000")
+	mDB := db.SQLDBs[dbName]
+
+	// all the containers share the connection of the consolidated database.
+	if db.consolidated() && db.consolidatedDB != nil {
+		mDB.Configure(db.consolidatedDB)
+		return nil
+	}
+
+	sqlDB, err := tagsql.Open(ctx, driver, db.config.dsn(path))
 	if err != nil {
 		return ErrDatabase.New("%s opening file %q failed: %w", dbName, path, err)
 	}
 
-	mDB := db.SQLDBs[dbName]
 	mDB.Configure(sqlDB)
 
+	if db.consolidated() {
+		db.consolidatedDB = sqlDB
+		dbutil.Configure(ctx, sqlDB, ConsolidatedDBName, mon)
+		return nil
+	}
+
 	dbutil.Configure(ctx, sqlDB, dbName, mon)
 
 	return nil
@@ -338,6 +451,9 @@ func (db *DB) filenameFromDBName(dbName string) string {
 }
 
 func (db *DB) filepathFromDBName(dbName string) string {
+	if db.consolidated() {
+		dbName = ConsolidatedDBName
+	}
 	return filepath.Join(db.dbDirectory, db.filenameFromDBName(dbName))
 }
 
@@ -349,61 +465,104 @@ func (db *DB) MigrateToLatest(ctx context.Context) error {
 
 // Preflight conducts a pre-flight check to ensure correct schemas and minimal read+write functionality of the database tables.
 func (db *DB) Preflight(ctx context.Context) (err error) {
+	schemas := Schema()
+	if db.consolidated() {
+		return db.preflight(ctx, ConsolidatedDBName, db.consolidatedDB, schemas)
+	}
+
 	for dbName, dbContainer := range db.SQLDBs {
-		if err := db.preflight(ctx, dbName, dbContainer); err != nil {
+		expectedSchemas := map[string]*dbschema.Schema{dbName: schemas[dbName]}
+		if err := db.preflight(ctx, dbName, dbContainer.GetDB(), expectedSchemas); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (db *DB) preflight(ctx context.Context, dbName string, dbContainer DBContainer) error {
-	nextDB := dbContainer.GetDB()
-	// Preflight stage 1: test schema correctness
-	schema, err := sqliteutil.QuerySchema(ctx, nextDB)
-	if err != nil {
-		return ErrPreflight.New("database %q: schema check failed: %v", dbName, err)
+// CheckIntegrity checks the integrity of the databases, without modifying them.
+func (db *DB) CheckIntegrity(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if db.consolidated() {
+		return checkIntegrity(ctx, ConsolidatedDBName, db.consolidatedDB)
 	}
-	// we don't care about changes in versions table
-	schema.DropTable("versions")
-	// if there was a previous pre-flight failure, test_table might still be in the schema
-	schema.DropTable("test_table")
 
-	// If tables and indexes of the schema are empty, set to nil
-	// to help with comparison to the snapshot.
-	if len(schema.Tables) == 0 {
-		schema.Tables = nil
+	for dbName, dbContainer := range db.SQLDBs {
+		if err := checkIntegrity(ctx, dbName, dbContainer.GetDB()); err != nil {
+			return err
+		}
 	}
-	if len(schema.Indexes) == 0 {
-		schema.Indexes = nil
+	return nil
+}
+
+func checkIntegrity(ctx context.Context, dbName string, sqlDB tagsql.DB) error {
+	var result string
+	err := sqlDB.QueryRowContext(ctx, "PRAGMA quick_check(1)").Scan(&result)
+	if err != nil {
+		return ErrDatabase.New("database %q: integrity check failed: %w", dbName, err)
 	}
+	if result != "ok" {
+		return ErrDatabase.New("database %q: integrity check failed: %s", dbName, result)
+	}
+	return nil
+}
 
-	// get expected schema
-	expectedSchema := Schema()[dbName]
+// splitSchema splits the schema of a database by the expected schemas of the
+// databases, which tables it contains.
+func splitSchema(schema *dbschema.Schema, expectedSchemas map[string]*dbschema.Schema) (map[string]*dbschema.Schema, error) {
+	if len(expectedSchemas) == 1 {
+		for dbName := range expectedSchemas {
+			return map[string]*dbschema.Schema{dbName: schema}, nil
+		}
+	}
 
-	// find extra indexes
-	var extraIdxs []*dbschema.Index
-	for _, idx := range schema.Indexes {
-		if _, exists := expectedSchema.FindIndex(idx.Name); exists {
-			continue
+	schemas := make(map[string]*dbschema.Schema, len(expectedSchemas))
+	tableDBNames := make(map[string]string)
+	for dbName, expectedSchema := range expectedSchemas {
+		schemas[dbName] = &dbschema.Schema{}
+		for _, table := range expectedSchema.Tables {
+			tableDBNames[table.Name] = dbName
 		}
+	}
 
-		extraIdxs = append(extraIdxs, idx)
+	for _, table := range schema.Tables {
+		dbName, ok := tableDBNames[table.Name]
+		if !ok {
+			return nil, errs.New("unexpected table %q", table.Name)
+		}
+		schemas[dbName].Tables = append(schemas[dbName].Tables, table)
 	}
-	// drop index from schema if it is not unique to not fail preflight
-	for _, idx := range extraIdxs {
-		if !idx.Unique {
-			schema.DropIndex(idx.Name)
+	for _, index := range schema.Indexes {
+		dbName, ok := tableDBNames[index.Table]
+		if !ok {
+			return nil, errs.New("unexpected index %q", index.Name)
 		}
+		schemas[dbName].Indexes = append(schemas[dbName].Indexes, index)
 	}
-	// warn that schema contains unexpected indexes
-	if len(extraIdxs) > 0 {
-		db.log.Warn(fmt.Sprintf("database %q: schema contains unexpected indices %v", dbName, extraIdxs))
+	return schemas, nil
+}
+
+func (db *DB) preflight(ctx context.Context, dbName string, nextDB tagsql.DB, expectedSchemas map[string]*dbschema.Schema) error {
+	// Preflight stage 1: test schema correctness
+	schema, err := sqliteutil.QuerySchema(ctx, nextDB)
+	if err != nil {
+		return ErrPreflight.New("database %q: schema check failed: %v", dbName, err)
 	}
+	// we don't care about changes in versions table
+	schema.DropTable("versions")
+	// if there was a previous pre-flight failure, test_table might still be in the schema
+	schema.DropTable("test_table")
 
-	// expect expected schema to match actual schema
-	if diff := cmp.Diff(expectedSchema, schema); diff != "" {
-		return ErrPreflight.New("database %q: expected schema does not match actual: %s", dbName, diff)
+	// the consolidated database contains the tables of all the databases,
+	// which are compared separately.
+	schemas, err := splitSchema(schema, expectedSchemas)
+	if err != nil {
+		return ErrPreflight.New("database %q: schema check failed: %v", dbName, err)
+	}
+	for name, expectedSchema := range expectedSchemas {
+		if err := db.checkSchema(name, schemas[name], expectedSchema); err != nil {
+			return err
+		}
 	}
 
 	// Preflight stage 2: test basic read/write access
@@ -455,6 +614,44 @@ func (db *DB) preflight(ctx context.Context, dbName string, dbContainer DBContai
 	return nil
 }
 
+// checkSchema compares the schema of a database to the expected schema.
+func (db *DB) checkSchema(dbName string, schema, expectedSchema *dbschema.Schema) error {
+	// If tables and indexes of the schema are empty, set to nil
+	// to help with comparison to the snapshot.
+	if len(schema.Tables) == 0 {
+		schema.Tables = nil
+	}
+	if len(schema.Indexes) == 0 {
+		schema.Indexes = nil
+	}
+
+	// find extra indexes
+	var extraIdxs []*dbschema.Index
+	for _, idx := range schema.Indexes {
+		if _, exists := expectedSchema.FindIndex(idx.Name); exists {
+			continue
+		}
+
+		extraIdxs = append(extraIdxs, idx)
+	}
+	// drop index from schema if it is not unique to not fail preflight
+	for _, idx := range extraIdxs {
+		if !idx.Unique {
+			schema.DropIndex(idx.Name)
+		}
+	}
+	// warn that schema contains unexpected indexes
+	if len(extraIdxs) > 0 {
+		db.log.Warn(fmt.Sprintf("database %q: schema contains unexpected indices %v", dbName, extraIdxs))
+	}
+
+	// expect expected schema to match actual schema
+	if diff := cmp.Diff(expectedSchema, schema); diff != "" {
+		return ErrPreflight.New("database %q: expected schema does not match actual: %s", dbName, diff)
+	}
+	return nil
+}
+
 // Close closes any resources.
 func (db *DB) Close() error {
 	return db.closeDatabases()
@@ -462,6 +659,18 @@ func (db *DB) Close() error {
 
 // closeDatabases closes all the SQLite database connections and removes them from the associated maps.
 func (db *DB) closeDatabases() error {
+	if db.consolidated() {
+		if db.consolidatedDB == nil {
+			return nil
+		}
+		err := db.consolidatedDB.Close()
+		db.consolidatedDB = nil
+		if err != nil {
+			return ErrDatabase.New("%s close failed: %w", ConsolidatedDBName, err)
+		}
+		return nil
+	}
+
 	var errlist errs.Group
 
 	for k := range db.SQLDBs {
@@ -476,6 +685,10 @@ func (db *DB) closeDatabase(dbName string) (err error) {
 	if !ok {
 		return ErrDatabase.New("no database with name %s found. database was never opened or already closed.", dbName)
 	}
+	// the consolidated database is shared, it's closed only with all the databases.
+	if db.consolidated() {
+		return nil
+	}
 	// if an error occurred during openDatabase, there will be no internal DB to close
 	dbHandle := mdb.GetDB()
 	if dbHandle == nil {
@@ -563,7 +776,14 @@ func (db *DB) RawDatabases() map[string]DBContainer {
 // deprecatedInfoDB to the specified new db. It first closes and deletes any
 // existing database to guarantee idempotence. After migration it also closes
 // and re-opens the new database to allow the system to recover used disk space.
+//
+// With the consolidated backend the tables are already in the same database,
+// hence it does nothing.
 func (db *DB) migrateToDB(ctx context.Context, dbName string, tablesToKeep ...string) error {
+	if db.consolidated() {
+		return nil
+	}
+
 	err := db.closeDatabase(dbName)
 	if err != nil {
 		return ErrDatabase.Wrap(err)
@@ -1115,6 +1335,13 @@ func (db *DB) Migration(ctx context.Context) *migrate.Migration {
 					// may have successfully dropped and we would experience unrecoverable data loss.
 					// This way if step 22 completes it never gets replayed even if a drop table or
 					// VACUUM call fails.
+					//
+					// With the consolidated backend the migrated tables are in the same database,
+					// hence only the table, which wasn't migrated, is dropped.
+					if db.consolidated() {
+						_, err := tx.ExecContext(ctx, "DROP TABLE IF EXISTS certificate")
+						return ErrDatabase.Wrap(err)
+					}
 					if err := sqliteutil.KeepTables(ctx, db.rawDatabaseFromName(DeprecatedInfoDBName), VersionTable); err != nil {
 						return ErrDatabase.Wrap(err)
 					}
@@ -2002,6 +2229,27 @@ func (db *DB) Migration(ctx context.Context) *migrate.Migration {
 					 UPDATE satellites SET address = 'satellite.stefan-benten.de:7777' WHERE node_id = X'004ae89e970e703df42ba4ab1416a3b30b7e1d8e14aa0e558f7ee26800000000'`,
 				},
 			},
+			{
+				DB:          &db.payoutDB.DB,
+				Description: "Add receipt_status to payments",
+				Version:     54,
+				Action: migrate.SQL{
+					`ALTER TABLE payments ADD COLUMN receipt_status INTEGER NOT NULL DEFAULT 0`,
+				},
+			},
+			{
+				DB:          &db.pieceExpirationDB.DB,
+				Description: "Index piece_expirations by expiry hour",
+				Version:     55,
+				Action: migrate.SQL{
+					`ALTER TABLE piece_expirations ADD COLUMN expiry_hour INTEGER NOT NULL DEFAULT 0`,
+					`UPDATE piece_expirations SET expiry_hour = CAST(strftime('%s', piece_expiration) AS INTEGER) / 3600`,
+					`DROP INDEX idx_piece_expirations_piece_expiration`,
+					`CREATE INDEX idx_piece_expirations_expiry_hour
+						ON piece_expirations(expiry_hour)
+						WHERE trash = 0`,
+				},
+			},
 		},
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
+	"storj.io/private/tagsql"
 	"storj.io/storj/storagenode/payouts"
 )
 
@@ -324,7 +325,8 @@ func (db *payoutDB) AllPeriods(ctx context.Context) (_ []string, err error) {
 func (db *payoutDB) StorePayment(ctx context.Context, payment payouts.Payment) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	query := `INSERT OR REPLACE INTO payments (
+	// NB: the receipt status is kept unless the receipt changed.
+	query := `INSERT INTO payments (
 			id,
 			created_at,
 			satellite_id,
@@ -332,7 +334,15 @@ func (db *payoutDB) StorePayment(ctx context.Context, payment payouts.Payment) (
 			amount,
 			receipt,
 			notes
-		) VALUES(?,?,?,?,?,?,?)`
+		) VALUES(?,?,?,?,?,?,?)
+		ON CONFLICT(id) DO UPDATE SET
+			created_at = excluded.created_at,
+			satellite_id = excluded.satellite_id,
+			period = excluded.period,
+			amount = excluded.amount,
+			notes = excluded.notes,
+			receipt_status = CASE WHEN payments.receipt IS excluded.receipt THEN payments.receipt_status ELSE 0 END,
+			receipt = excluded.receipt`
 
 	_, err = db.ExecContext(ctx, query,
 		payment.ID,
@@ -400,6 +410,89 @@ func (db *payoutDB) GetReceipt(ctx context.Context, satelliteID storj.NodeID, pe
 	return receipt, nil
 }
 
+// GetReceiptStatus retrieves receipt verification status for a specific satellite and period.
+func (db *payoutDB) GetReceiptStatus(ctx context.Context, satelliteID storj.NodeID, period string) (status payouts.ReceiptStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rowPayment := db.QueryRowContext(ctx,
+		`SELECT receipt_status FROM payments WHERE satellite_id = ? AND period = ?`,
+		satelliteID, period,
+	)
+
+	err = rowPayment.Scan(&status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return payouts.ReceiptUnverified, payouts.ErrNoPayStubForPeriod.Wrap(err)
+		}
+		return payouts.ReceiptUnverified, ErrPayout.Wrap(err)
+	}
+
+	return status, nil
+}
+
+// UnverifiedPayments returns all payments with a receipt, which wasn't verified yet.
+func (db *payoutDB) UnverifiedPayments(ctx context.Context) (_ []payouts.Payment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `SELECT
+			id,
+			created_at,
+			satellite_id,
+			period,
+			amount,
+			receipt,
+			notes,
+			receipt_status
+		FROM payments
+		WHERE receipt_status = ? AND receipt IS NOT NULL AND receipt != ''
+		ORDER BY id`
+
+	rows, err := db.QueryContext(ctx, query, payouts.ReceiptUnverified)
+	if err != nil {
+		return nil, ErrPayout.Wrap(err)
+	}
+
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var payments []payouts.Payment
+	for rows.Next() {
+		var payment payouts.Payment
+		var period, receipt, notes sql.NullString
+
+		err := rows.Scan(
+			&payment.ID,
+			&payment.Created,
+			&payment.SatelliteID,
+			&period,
+			&payment.Amount,
+			&receipt,
+			&notes,
+			&payment.ReceiptStatus,
+		)
+		if err != nil {
+			return nil, ErrPayout.Wrap(err)
+		}
+
+		payment.Period = period.String
+		payment.Receipt = receipt.String
+		payment.Notes = notes.String
+		payments = append(payments, payment)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, ErrPayout.Wrap(err)
+	}
+
+	return payments, nil
+}
+
+// SetReceiptStatus sets the receipt verification status of a payment.
+func (db *payoutDB) SetReceiptStatus(ctx context.Context, paymentID int64, status payouts.ReceiptStatus) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, `UPDATE payments SET receipt_status = ? WHERE id = ?`, status, paymentID)
+	return ErrPayout.Wrap(err)
+}
+
 // GetTotalEarned returns total earned value for node from all paystubs.
 func (db *payoutDB) GetTotalEarned(ctx context.Context) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -766,3 +859,17 @@ func (db *payoutDB) HeldAmountHistory(ctx context.Context) (_ []payouts.HeldAmou
 
 	return heldHistories, nil
 }
+
+// DeleteSatellite removes all paystubs and payments of specific satellite.
+func (db *payoutDB) DeleteSatellite(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return ErrPayout.Wrap(withTx(ctx, db.GetDB(), func(tx tagsql.Tx) error {
+		_, err := tx.ExecContext(ctx, "DELETE FROM paystubs WHERE satellite_id = ?", satelliteID)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, "DELETE FROM payments WHERE satellite_id = ?", satelliteID)
+		return err
+	}))
+}
@@ -5,6 +5,7 @@ package storagenodedb
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -19,6 +20,17 @@ var ErrPieceExpiration = errs.Class("pieceexpirationdb")
 // PieceExpirationDBName represents the database filename.
 const PieceExpirationDBName = "piece_expiration"
 
+// pieceExpirationBatchSize is the maximum number of pieces written or deleted
+// with a single statement, which keeps the number of variables under the
+// SQLite limit.
+const pieceExpirationBatchSize = 200
+
+// expiryHour returns the hour of the expiration time, which indexes the
+// expirations so that the expired pieces are looked up by ranges of hours.
+func expiryHour(expiresAt time.Time) int64 {
+	return expiresAt.Unix() / int64(time.Hour/time.Second)
+}
+
 type pieceExpirationDB struct {
 	dbContainerImpl
 }
@@ -30,11 +42,12 @@ func (db *pieceExpirationDB) GetExpired(ctx context.Context, expiresBefore time.
 	rows, err := db.QueryContext(ctx, `
 		SELECT satellite_id, piece_id
 			FROM piece_expirations
-			WHERE piece_expiration < ?
+			WHERE expiry_hour <= ?
+				AND piece_expiration < ?
 				AND ((deletion_failed_at IS NULL) OR deletion_failed_at <> ?)
 				AND trash = 0
 			LIMIT ?
-	`, expiresBefore.UTC(), expiresBefore.UTC(), limit)
+	`, expiryHour(expiresBefore), expiresBefore.UTC(), expiresBefore.UTC(), limit)
 	if err != nil {
 		return nil, ErrPieceExpiration.Wrap(err)
 	}
@@ -60,11 +73,79 @@ func (db *pieceExpirationDB) GetExpired(ctx context.Context, expiresBefore time.
 func (db *pieceExpirationDB) SetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, expiresAt time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	_, err = db.ExecContext(ctx, `
-		INSERT INTO piece_expirations(satellite_id, piece_id, piece_expiration)
-			VALUES (?,?,?)
-	`, satellite, pieceID, expiresAt.UTC())
-	return ErrPieceExpiration.Wrap(err)
+	return db.SetExpirations(ctx, []pieces.ExpirationInfo{{
+		SatelliteID:     satellite,
+		PieceID:         pieceID,
+		PieceExpiration: expiresAt,
+	}})
+}
+
+// SetExpirations sets the expiration times of a batch of pieces. When a piece
+// appears more than once, or already has an expiration time, the last
+// expiration time wins.
+func (db *pieceExpirationDB) SetExpirations(ctx context.Context, expirations []pieces.ExpirationInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// compact the batch, keeping the last expiration time of every piece.
+	type key struct {
+		satelliteID storj.NodeID
+		pieceID     storj.PieceID
+	}
+	latest := make(map[key]int, len(expirations))
+	for i, expiration := range expirations {
+		latest[key{expiration.SatelliteID, expiration.PieceID}] = i
+	}
+	compacted := make([]pieces.ExpirationInfo, 0, len(latest))
+	for i, expiration := range expirations {
+		if latest[key{expiration.SatelliteID, expiration.PieceID}] == i {
+			compacted = append(compacted, expiration)
+		}
+	}
+	if len(compacted) == 0 {
+		return nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return ErrPieceExpiration.Wrap(err)
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			err = errs.Combine(err, tx.Rollback())
+		}
+		err = ErrPieceExpiration.Wrap(err)
+	}()
+
+	for len(compacted) > 0 {
+		batch := compacted
+		if len(batch) > pieceExpirationBatchSize {
+			batch = batch[:pieceExpirationBatchSize]
+		}
+		compacted = compacted[len(batch):]
+
+		values := make([]string, 0, len(batch))
+		args := make([]interface{}, 0, 4*len(batch))
+		for _, expiration := range batch {
+			values = append(values, "(?,?,?,?)")
+			args = append(args, expiration.SatelliteID, expiration.PieceID,
+				expiration.PieceExpiration.UTC(), expiryHour(expiration.PieceExpiration))
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO piece_expirations(satellite_id, piece_id, piece_expiration, expiry_hour)
+				VALUES `+strings.Join(values, ",")+`
+				ON CONFLICT(satellite_id, piece_id) DO UPDATE SET
+					piece_expiration = excluded.piece_expiration,
+					expiry_hour = excluded.expiry_hour,
+					deletion_failed_at = NULL
+		`, args...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // DeleteExpiration removes an expiration record for the given piece ID on the given satellite.
@@ -85,6 +166,34 @@ func (db *pieceExpirationDB) DeleteExpiration(ctx context.Context, satelliteID s
 	return numRows > 0, nil
 }
 
+// DeleteExpirations removes the expiration records of a batch of pieces.
+func (db *pieceExpirationDB) DeleteExpirations(ctx context.Context, expired []pieces.ExpiredInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for len(expired) > 0 {
+		batch := expired
+		if len(batch) > pieceExpirationBatchSize {
+			batch = batch[:pieceExpirationBatchSize]
+		}
+		expired = expired[len(batch):]
+
+		conditions := make([]string, 0, len(batch))
+		args := make([]interface{}, 0, 2*len(batch))
+		for _, info := range batch {
+			conditions = append(conditions, "(satellite_id = ? AND piece_id = ?)")
+			args = append(args, info.SatelliteID, info.PieceID)
+		}
+
+		_, err = db.ExecContext(ctx, `
+			DELETE FROM piece_expirations
+				WHERE `+strings.Join(conditions, " OR "), args...)
+		if err != nil {
+			return ErrPieceExpiration.Wrap(err)
+		}
+	}
+	return nil
+}
+
 // DeleteFailed marks an expiration record as having experienced a failure in deleting the piece
 // from the disk.
 func (db *pieceExpirationDB) DeleteFailed(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, when time.Time) (err error) {
@@ -112,6 +221,17 @@ func (db *pieceExpirationDB) Trash(ctx context.Context, satelliteID storj.NodeID
 	return ErrPieceExpiration.Wrap(err)
 }
 
+// DeleteSatellite removes the expiration records of all the pieces of the satellite.
+func (db *pieceExpirationDB) DeleteSatellite(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, `
+		DELETE FROM piece_expirations
+			WHERE satellite_id = ?
+	`, satelliteID)
+	return ErrPieceExpiration.Wrap(err)
+}
+
 // Restore restores all trashed pieces.
 func (db *pieceExpirationDB) RestoreTrash(ctx context.Context, satelliteID storj.NodeID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -59,6 +59,28 @@ func (db *satellitesDB) GetSatellite(ctx context.Context, satelliteID storj.Node
 	return satellite, rows.Err()
 }
 
+// GetSatellites retrieves all satellites.
+func (db *satellitesDB) GetSatellites(ctx context.Context) (_ []satellites.Satellite, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.QueryContext(ctx, "SELECT node_id, added_at, status FROM satellites")
+	if err != nil {
+		return nil, ErrSatellitesDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []satellites.Satellite
+	for rows.Next() {
+		var satellite satellites.Satellite
+		err := rows.Scan(&satellite.SatelliteID, &satellite.AddedAt, &satellite.Status)
+		if err != nil {
+			return nil, ErrSatellitesDB.Wrap(err)
+		}
+		list = append(list, satellite)
+	}
+	return list, ErrSatellitesDB.Wrap(rows.Err())
+}
+
 // GetSatellitesUrls retrieves all satellite's id and urls.
 func (db *satellitesDB) GetSatellitesUrls(ctx context.Context) (satelliteURLs []storj.NodeURL, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -93,6 +115,20 @@ func (db *satellitesDB) GetSatellitesUrls(ctx context.Context) (satelliteURLs []
 	return urls, nil
 }
 
+// ForgetSatellite marks the satellite as forgotten and removes its graceful exit progress.
+func (db *satellitesDB) ForgetSatellite(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return ErrSatellitesDB.Wrap(withTx(ctx, db.GetDB(), func(tx tagsql.Tx) error {
+		query := `INSERT INTO satellites (node_id, added_at, status) VALUES(?,?,?) ON CONFLICT (node_id) DO UPDATE SET status = EXCLUDED.status`
+		_, err = tx.ExecContext(ctx, query, satelliteID, time.Now().UTC(), satellites.Forgotten)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, "DELETE FROM satellite_exit_progress WHERE satellite_id = ?", satelliteID)
+		return err
+	}))
+}
+
 // InitiateGracefulExit updates the database to reflect the beginning of a graceful exit.
 func (db *satellitesDB) InitiateGracefulExit(ctx context.Context, satelliteID storj.NodeID, intitiatedAt time.Time, startingDiskUsage int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
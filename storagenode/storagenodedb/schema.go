@@ -103,6 +103,11 @@ func Schema() map[string]*dbschema.Schema {
 							Type:       "TEXT",
 							IsNullable: true,
 						},
+						{
+							Name:       "receipt_status",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
 						{
 							Name:       "satellite_id",
 							Type:       "bytea",
@@ -370,6 +375,11 @@ func Schema() map[string]*dbschema.Schema {
 							Type:       "TIMESTAMP",
 							IsNullable: true,
 						},
+						{
+							Name:       "expiry_hour",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
 						{
 							Name:       "piece_expiration",
 							Type:       "TIMESTAMP",
@@ -395,7 +405,7 @@ func Schema() map[string]*dbschema.Schema {
 			},
 			Indexes: []*dbschema.Index{
 				{Name: "idx_piece_expirations_deletion_failed_at", Table: "piece_expirations", Columns: []string{"deletion_failed_at"}, Unique: false, Partial: ""},
-				{Name: "idx_piece_expirations_piece_expiration", Table: "piece_expirations", Columns: []string{"piece_expiration"}, Unique: false, Partial: ""},
+				{Name: "idx_piece_expirations_expiry_hour", Table: "piece_expirations", Columns: []string{"expiry_hour"}, Unique: false, Partial: "trash = 0"},
 				{Name: "idx_piece_expirations_trashed", Table: "piece_expirations", Columns: []string{"satellite_id", "trash"}, Unique: false, Partial: "trash = 1"},
 			},
 		},
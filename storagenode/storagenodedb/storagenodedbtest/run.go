@@ -26,33 +26,45 @@ func init() {
 // Run method will iterate over all supported databases. Will establish
 // connection and will create tables for each DB.
 func Run(t *testing.T, test func(ctx *testcontext.Context, t *testing.T, db storagenode.DB)) {
-	t.Run("Sqlite", func(t *testing.T) {
-		t.Parallel()
-		ctx := testcontext.New(t)
-		defer ctx.Cleanup()
-
-		log := zaptest.NewLogger(t)
-
-		storageDir := ctx.Dir("storage")
-		cfg := storagenodedb.Config{
-			Storage: storageDir,
-			Info:    filepath.Join(storageDir, "piecestore.db"),
-			Info2:   filepath.Join(storageDir, "info.db"),
-			Driver:  "sqlite3+utccheck",
-			Pieces:  storageDir,
-		}
-
-		db, err := storagenodedb.OpenNew(ctx, log, cfg)
-		if err != nil {
-			t.Fatal(err)
-		}
-		defer ctx.Check(db.Close)
-
-		err = db.MigrateToLatest(ctx)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		test(ctx, t, db)
-	})
+	backends := []struct {
+		name    string
+		backend storagenodedb.Backend
+	}{
+		{"Sqlite", storagenodedb.BackendSQLite},
+		{"SqliteConsolidated", storagenodedb.BackendSQLiteConsolidated},
+	}
+
+	for _, backend := range backends {
+		backend := backend
+		t.Run(backend.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := testcontext.New(t)
+			defer ctx.Cleanup()
+
+			log := zaptest.NewLogger(t)
+
+			storageDir := ctx.Dir("storage")
+			cfg := storagenodedb.Config{
+				Storage: storageDir,
+				Info:    filepath.Join(storageDir, "piecestore.db"),
+				Info2:   filepath.Join(storageDir, "info.db"),
+				Driver:  "sqlite3+utccheck",
+				Pieces:  storageDir,
+				Backend: backend.backend,
+			}
+
+			db, err := storagenodedb.OpenNew(ctx, log, cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ctx.Check(db.Close)
+
+			err = db.MigrateToLatest(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			test(ctx, t, db)
+		})
+	}
 }
@@ -83,6 +83,51 @@ func TestInMemoryConcurrency(t *testing.T) {
 	testConcurrency(t, ctx, db)
 }
 
+func TestConsolidatedBackend(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	log := zaptest.NewLogger(t)
+
+	storageDir := ctx.Dir("storage")
+	cfg := storagenodedb.Config{
+		Pieces:    storageDir,
+		Storage:   storageDir,
+		Info:      filepath.Join(storageDir, "piecestore.db"),
+		Info2:     filepath.Join(storageDir, "info.db"),
+		Filestore: filestore.DefaultConfig,
+		Backend:   storagenodedb.BackendSQLiteConsolidated,
+	}
+
+	db, err := storagenodedb.OpenNew(ctx, log, cfg)
+	require.NoError(t, err)
+	require.NoError(t, db.MigrateToLatest(ctx))
+	require.NoError(t, db.Close())
+
+	// all the tables are in a single database.
+	require.FileExists(t, filepath.Join(storageDir, storagenodedb.ConsolidatedDBName+".db"))
+	require.NoFileExists(t, filepath.Join(storageDir, storagenodedb.DeprecatedInfoDBName+".db"))
+	require.NoFileExists(t, filepath.Join(storageDir, storagenodedb.BandwidthDBName+".db"))
+
+	db, err = storagenodedb.OpenExisting(ctx, log, cfg)
+	require.NoError(t, err)
+	require.NoError(t, db.CheckVersion(ctx))
+	require.NoError(t, db.Preflight(ctx))
+	require.NoError(t, db.CheckIntegrity(ctx))
+	require.NoError(t, db.Close())
+
+	// the backend can't be changed after the node is set up.
+	separateCfg := cfg
+	separateCfg.Backend = storagenodedb.BackendSQLite
+	_, err = storagenodedb.OpenExisting(ctx, log, separateCfg)
+	require.Error(t, err)
+
+	invalidCfg := cfg
+	invalidCfg.Backend = "unknown"
+	_, err = storagenodedb.OpenNew(ctx, log, invalidCfg)
+	require.Error(t, err)
+}
+
 func testConcurrency(t *testing.T, ctx *testcontext.Context, db *storagenodedb.DB) {
 	t.Run("Sqlite", func(t *testing.T) {
 		runtime.GOMAXPROCS(2)
@@ -152,3 +152,11 @@ func (db *storageUsageDB) SatelliteSummary(ctx context.Context, satelliteID stor
 	err = db.QueryRowContext(ctx, query, satelliteID, from.UTC(), to.UTC()).Scan(&summary)
 	return summary.Float64, err
 }
+
+// Delete removes all storage usage stamps for a particular satellite.
+func (db *storageUsageDB) Delete(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx, satelliteID)(&err)
+
+	_, err = db.ExecContext(ctx, "DELETE FROM storage_usage WHERE satellite_id = ?", satelliteID)
+	return err
+}
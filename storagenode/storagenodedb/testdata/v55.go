@@ -0,0 +1,98 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import "storj.io/storj/storagenode/storagenodedb"
+
+var v55 = MultiDBState{
+	Version: 55,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:    v54.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName:   v54.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName:     v54.DBStates[storagenodedb.ReputationDBName],
+		storagenodedb.PieceSpaceUsedDBName: v54.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:      v54.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: &DBState{
+			SQL: `
+				-- table to hold expiration data (and only expirations. no other pieceinfo)
+				CREATE TABLE piece_expirations (
+					satellite_id       BLOB      NOT NULL,
+					piece_id           BLOB      NOT NULL,
+					piece_expiration   TIMESTAMP NOT NULL, -- date when it can be deleted
+					deletion_failed_at TIMESTAMP,
+					trash              INTEGER NOT NULL DEFAULT 0,
+					expiry_hour        INTEGER NOT NULL DEFAULT 0,
+					PRIMARY KEY ( satellite_id, piece_id )
+				);
+				CREATE INDEX idx_piece_expirations_deletion_failed_at ON piece_expirations(deletion_failed_at);
+				CREATE INDEX idx_piece_expirations_trashed ON piece_expirations(satellite_id, trash) WHERE trash = 1;
+				CREATE INDEX idx_piece_expirations_expiry_hour ON piece_expirations(expiry_hour) WHERE trash = 0;
+			`,
+			// the expiry hour of the existing expirations is set by the migration.
+			OldData: `
+				INSERT INTO piece_expirations (satellite_id, piece_id, piece_expiration, deletion_failed_at, trash) VALUES
+				                              (X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000', X'0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20', '2022-08-01 10:30:00.123456789+00:00', NULL, 0);
+			`,
+			NewData: `
+				INSERT INTO piece_expirations (satellite_id, piece_id, piece_expiration, deletion_failed_at, trash, expiry_hour) VALUES
+				                              (X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000', X'0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20', '2022-08-01 10:30:00.123456789+00:00', NULL, 0, 460930);
+			`,
+		},
+		storagenodedb.OrdersDBName:         v54.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:      v54.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName:     v54.DBStates[storagenodedb.SatellitesDBName],
+		storagenodedb.DeprecatedInfoDBName: v54.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:  v54.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.HeldAmountDBName: &DBState{
+			SQL: `
+				-- tables to hold payments and paystub data
+				CREATE TABLE paystubs (
+					period text NOT NULL,
+					satellite_id bytea NOT NULL,
+					created_at timestamp NOT NULL,
+					codes text NOT NULL,
+					usage_at_rest double precision NOT NULL,
+					usage_get bigint NOT NULL,
+					usage_put bigint NOT NULL,
+					usage_get_repair bigint NOT NULL,
+					usage_put_repair bigint NOT NULL,
+					usage_get_audit bigint NOT NULL,
+					comp_at_rest bigint NOT NULL,
+					comp_get bigint NOT NULL,
+					comp_put bigint NOT NULL,
+					comp_get_repair bigint NOT NULL,
+					comp_put_repair bigint NOT NULL,
+					comp_get_audit bigint NOT NULL,
+					surge_percent bigint NOT NULL,
+					held bigint NOT NULL,
+					owed bigint NOT NULL,
+					disposed bigint NOT NULL,
+					paid bigint NOT NULL,
+					distributed bigint NOT NULL,
+					PRIMARY KEY ( period, satellite_id )
+				);
+				CREATE TABLE payments (
+					id bigserial NOT NULL,
+					created_at timestamp NOT NULL,
+					satellite_id bytea NOT NULL,
+					period text,
+					amount bigint NOT NULL,
+					receipt text,
+					notes text,
+					receipt_status INTEGER NOT NULL DEFAULT 0,
+					PRIMARY KEY ( id )
+				);
+			INSERT INTO paystubs (period,    satellite_id, created_at,                    codes, usage_at_rest, usage_get, usage_put, usage_get_repair, usage_put_repair, usage_get_audit, comp_at_rest, comp_get, comp_put, comp_get_repair, comp_put_repair, comp_get_audit, surge_percent, held, owed, disposed, paid, distributed) VALUES
+			                     ('2020-10', 'foo',        '2020-04-07T00:00:00.000000Z', 'X',   100,           200,       300,       400,              500,              600,             700,          800,      900,      1000,            1100,            1200,           1300,          1400, 1500, 1600,     1700, 1700),
+			                     ('2020-11', 'foo',        '2020-04-07T00:00:00.000000Z', 'X',   101,           201,       301,       401,              501,              601,             701,          801,      901,      1010,            1101,            1201,           1301,          1401, 1501, 1601,     1701, 1701),
+			                     ('2020-12', 'foo',        '2020-04-07T00:00:00.000000Z', 'X',   102,           202,       302,       402,              502,              602,             702,          802,      902,      1020,            1102,            1202,           1302,          1402, 1502, 1602,     1702, 0),
+			                     ('2021-01', 'foo',        '2020-04-07T00:00:00.000000Z', 'X',   103,           203,       303,       403,              503,              603,             703,          803,      903,      1030,            1103,            1203,           1303,          1403, 1503, 1603,     1703, 0);
+				INSERT INTO payments (id, created_at,                    satellite_id, period,    amount, receipt,        notes, receipt_status) VALUES
+				                     (1,  '2020-12-07T00:00:00.000000Z', 'foo',        '2020-11', 1701,   'zksync:0x123', '',    1);
+			`,
+		},
+		storagenodedb.PricingDBName: v54.DBStates[storagenodedb.PricingDBName],
+		storagenodedb.APIKeysDBName: v54.DBStates[storagenodedb.APIKeysDBName],
+	},
+}
@@ -163,6 +163,10 @@ func (pool *Pool) GetSignee(ctx context.Context, id storj.NodeID) (_ signing.Sig
 // GetSatellites returns a slice containing all trusted satellites.
 func (pool *Pool) GetSatellites(ctx context.Context) (satellites []storj.NodeID) {
 	defer mon.Task()(&ctx)(nil)
+
+	pool.satellitesMu.RLock()
+	defer pool.satellitesMu.RUnlock()
+
 	for sat := range pool.satellites {
 		satellites = append(satellites, sat)
 	}
@@ -189,12 +193,21 @@ func (pool *Pool) Refresh(ctx context.Context) error {
 		return err
 	}
 
+	forgotten, err := pool.forgottenSatellites(ctx)
+	if err != nil {
+		return err
+	}
+
 	pool.satellitesMu.Lock()
 	defer pool.satellitesMu.Unlock()
 
 	// add/update trusted IDs
 	trustedIDs := make(map[storj.NodeID]struct{})
 	for _, url := range urls {
+		if _, ok := forgotten[url.ID]; ok {
+			pool.log.Debug("Satellite is forgotten", zap.String("id", url.ID.String()))
+			continue
+		}
 		trustedIDs[url.ID] = struct{}{}
 
 		info, ok := pool.satellites[url.ID]
@@ -229,6 +242,43 @@ func (pool *Pool) Refresh(ctx context.Context) error {
 	return nil
 }
 
+// ForgetSatellite stops trusting the satellite right away. The satellite must
+// be marked as forgotten in the satellites database as well, otherwise it is
+// trusted again on the next refresh.
+func (pool *Pool) ForgetSatellite(ctx context.Context, id storj.NodeID) {
+	defer mon.Task()(&ctx)(nil)
+
+	pool.satellitesMu.Lock()
+	defer pool.satellitesMu.Unlock()
+
+	if _, ok := pool.satellites[id]; ok {
+		pool.log.Debug("Satellite is forgotten", zap.String("id", id.String()))
+		delete(pool.satellites, id)
+	}
+}
+
+// forgottenSatellites returns the IDs of the satellites which were forgotten
+// by the operator.
+func (pool *Pool) forgottenSatellites(ctx context.Context) (_ map[storj.NodeID]struct{}, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	forgotten := make(map[storj.NodeID]struct{})
+	if pool.satellitesDB == nil {
+		return forgotten, nil
+	}
+
+	list, err := pool.satellitesDB.GetSatellites(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, satellite := range list {
+		if satellite.Status == satellites.Forgotten {
+			forgotten[satellite.SatelliteID] = struct{}{}
+		}
+	}
+	return forgotten, nil
+}
+
 func (pool *Pool) getInfo(id storj.NodeID) (*satelliteInfoCache, error) {
 	pool.satellitesMu.RLock()
 	defer pool.satellitesMu.RUnlock()
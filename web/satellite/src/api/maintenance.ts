@@ -0,0 +1,54 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+import { MaintenanceStatus, MaintenanceWindow } from '@/types/maintenance';
+import { HttpClient } from '@/utils/httpClient';
+
+/**
+ * MaintenanceHttpApi is a console Maintenance API.
+ * Exposes the maintenance windows of the satellite.
+ */
+export class MaintenanceHttpApi {
+    private readonly http: HttpClient = new HttpClient();
+    private readonly ROOT_PATH: string = '/api/v0/maintenance';
+
+    /**
+     * Fetch the active and upcoming maintenance windows.
+     *
+     * @returns MaintenanceStatus
+     * @throws Error
+     */
+    public async get(): Promise<MaintenanceStatus> {
+        const response = await this.http.get(this.ROOT_PATH);
+
+        if (!response.ok) {
+            throw new Error('Can not get maintenance status');
+        }
+
+        const result = await response.json();
+
+        return new MaintenanceStatus(
+            this.getWindows(result.active),
+            this.getWindows(result.upcoming),
+            result.writesFrozen,
+        );
+    }
+
+    /**
+     * Method for mapping maintenance windows from json to MaintenanceWindow type.
+     *
+     * @param windows anonymous objects from json
+     */
+    private getWindows(windows: any[]): MaintenanceWindow[] { // eslint-disable-line @typescript-eslint/no-explicit-any
+        if (!windows) {
+            return [];
+        }
+
+        return windows.map(window => new MaintenanceWindow(
+            new Date(window.start),
+            new Date(window.end),
+            window.message,
+            window.writeFreeze,
+        ));
+    }
+}
@@ -0,0 +1,25 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/**
+ * MaintenanceWindow is a scheduled maintenance of the satellite.
+ */
+export class MaintenanceWindow {
+    public constructor(
+        public start: Date = new Date(),
+        public end: Date = new Date(),
+        public message: string = '',
+        public writeFreeze: boolean = false,
+    ) { }
+}
+
+/**
+ * MaintenanceStatus holds the active and upcoming maintenance windows of the satellite.
+ */
+export class MaintenanceStatus {
+    public constructor(
+        public active: MaintenanceWindow[] = [],
+        public upcoming: MaintenanceWindow[] = [],
+        public writesFrozen: boolean = false,
+    ) { }
+}
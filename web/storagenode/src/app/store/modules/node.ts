@@ -25,6 +25,7 @@ export const NODE_MUTATIONS = {
 export const NODE_ACTIONS = {
     GET_NODE_INFO: 'GET_NODE_INFO',
     SELECT_SATELLITE: 'SELECT_SATELLITE',
+    FORGET_SATELLITE: 'FORGET_SATELLITE',
 };
 
 export const StatusOnline = 'Online';
@@ -62,7 +63,8 @@ export function newNodeModule(service: StorageNodeService): StoreModule<StorageN
                     nodeInfo.walletFeatures,
                     nodeInfo.isUpToDate,
                     nodeInfo.quicEnabled,
-                    nodeInfo.configuredPort
+                    nodeInfo.configuredPort,
+                    nodeInfo.reachability,
                 );
 
                 state.utilization = new Utilization(
@@ -131,6 +133,13 @@ export function newNodeModule(service: StorageNodeService): StoreModule<StorageN
 
                 commit(NODE_MUTATIONS.SET_DAILY_DATA, response);
             },
+            [NODE_ACTIONS.FORGET_SATELLITE]: async function ({commit}: StorageNodeContext, id: string): Promise<void> {
+                await service.forgetSatellite(id);
+
+                const dashboard = await service.dashboard();
+
+                commit(NODE_MUTATIONS.POPULATE_STORE, dashboard);
+            },
         },
         getters: {
             monthsOnNetwork: (state): number => {
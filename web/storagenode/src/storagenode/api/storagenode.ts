@@ -3,6 +3,7 @@
 
 import {
     Dashboard,
+    Reachability,
     Satellite,
     SatelliteByDayInfo,
     SatelliteInfo,
@@ -41,11 +42,28 @@ export class StorageNodeApi {
             return new SatelliteInfo(satellite.id, satellite.url, disqualified, suspended);
         });
 
-        const diskSpace: Traffic = new Traffic(data.diskSpace.used, data.diskSpace.available, data.diskSpace.trash, data.diskSpace.overused);
+        const diskSpace: Traffic = new Traffic(data.diskSpace.used, data.diskSpace.available, data.diskSpace.trash, data.diskSpace.overused, data.diskSpace.warning);
         const bandwidth: Traffic = new Traffic(data.bandwidth.used);
 
+        const reachability: Reachability = new Reachability(data.reachability.address, data.reachability.ipv4, data.reachability.ipv6);
+
         return new Dashboard(data.nodeID, data.wallet, data.walletFeatures || [], satellites, diskSpace, bandwidth,
-            new Date(data.lastPinged), new Date(data.startedAt), data.version, data.allowedVersion, data.upToDate, data.quicEnabled, data.configuredPort);
+            new Date(data.lastPinged), new Date(data.startedAt), data.version, data.allowedVersion, data.upToDate, data.quicEnabled, data.configuredPort,
+            reachability);
+    }
+
+    /**
+     * Stops trusting the satellite and moves all its data to the trash.
+     * @param id - satellite id
+     */
+    public async forgetSatellite(id: string): Promise<void> {
+        const url = `${this.ROOT_PATH}/satellite/${id}/forget`;
+
+        const response = await this.client.post(url, null);
+
+        if (!response.ok) {
+            throw new Error('can not forget satellite');
+        }
     }
 
     /**
@@ -30,6 +30,14 @@ export class StorageNodeService {
         return await this.node.satellite(id);
     }
 
+    /**
+     * Stops trusting the satellite and moves all its data to the trash.
+     * @param id - satellite id
+     */
+    public async forgetSatellite(id: string): Promise<void> {
+        return await this.node.forgetSatellite(id);
+    }
+
     /**
      * Gets all satellites data from server.
      */
@@ -19,6 +19,18 @@ export class Node {
         public isLastVersion: boolean = false,
         public quicEnabled: boolean = false,
         public configuredPort: string = '',
+        public reachability: Reachability = new Reachability(),
+    ) {}
+}
+
+/**
+ * Holds over which IP versions the node is advertised to the satellites.
+ */
+export class Reachability {
+    public constructor(
+        public address: string = '',
+        public ipv4: boolean = false,
+        public ipv6: boolean = false,
     ) {}
 }
 
@@ -41,6 +53,7 @@ export class Traffic {
         public available: number = 1,
         public trash: number = 0,
         public overused: number = 0,
+        public warning: string = '',
     ) {}
 }
 
@@ -77,6 +90,7 @@ export class Dashboard {
         public isUpToDate: boolean,
         public quicEnabled: boolean,
         public configuredPort: string,
+        public reachability: Reachability = new Reachability(),
     ) { }
 }
 